@@ -57,7 +57,7 @@ mesheryctl completion fish > ~/.config/fish/completions/mesheryctl.fish`
 var completionCmd = &cobra.Command{
 	Use:     "completion [bash|zsh|fish]",
 	Short:   "Output shell completion code",
-	Long:    "Output shell completion code",
+	Long:    "Output shell completion code. The names of the models, components and relationships registered with Meshery are completed by querying Meshery Server.",
 	Example: example,
 	Args: func(_ *cobra.Command, args []string) error {
 		const errMsg = `Usage: mesheryctl completion [bash|zsh|fish]`
@@ -0,0 +1,34 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshkit/errors"
+)
+
+const (
+	ErrPolicyViolationsCode = "1200"
+	ErrEvaluatePoliciesCode = "1201"
+)
+
+func ErrPolicyViolations(count int) error {
+	return errors.New(ErrPolicyViolationsCode, errors.Alert, []string{"The design does not satisfy the policies"}, []string{fmt.Sprintf("%d violations of the policies were found", count)}, []string{"The design violates a validation policy", "With --strict, the relationship policies suggest patching components or removing relationships"}, []string{"Fix the design as reported by the violations and run the policies again"})
+}
+
+func ErrEvaluatePolicies(err error) error {
+	return errors.New(ErrEvaluatePoliciesCode, errors.Alert, []string{"Unable to evaluate the policies"}, []string{err.Error()}, []string{"One of the policies is not a valid Rego module", "The design file is not valid"}, []string{"Check the policies with `opa check`", "Make sure the design file is a valid Meshery design"})
+}
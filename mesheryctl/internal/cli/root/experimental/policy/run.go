@@ -0,0 +1,199 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/server/meshmodel/kubernetes"
+	"github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/spf13/cobra"
+)
+
+var (
+	designFile       string
+	policyPaths      []string
+	strict           bool
+	outputFormatFlag string
+)
+
+var runCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Run the policies against a design",
+	Long: `Evaluate a design file against the relationship policies shipped with mesheryctl, and against the validation
+policies of the Rego files given with --policy, without a running Meshery Server.
+Validation policies declare the deny rule in the meshery.designs package, every value of the rule is a violation.
+The command fails when the design violates any of the validation policies, so that CI pipelines can gate merges on
+the validity of designs. With --strict, it also fails when the relationship policies suggest patching the components
+of the design or removing its relationships.`,
+	Example: `
+// evaluate the relationship policies against a design
+mesheryctl exp policy run -f design.yaml
+
+// evaluate the validation policies of a directory along with the relationship policies
+mesheryctl exp policy run -f design.yaml --policy ./policies
+
+// fail on the patches and the relationship removals suggested by the relationship policies, and print the report as JSON
+mesheryctl exp policy run -f design.yaml --strict -o json
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if outputFormatFlag != "" && outputFormatFlag != "json" && outputFormatFlag != "yaml" {
+			utils.Log.Error(utils.ErrOutFormatFlag())
+			return nil
+		}
+
+		content, err := os.ReadFile(designFile)
+		if err != nil {
+			utils.Log.Error(utils.ErrFileRead(err))
+			return nil
+		}
+		pattern, err := core.NewPatternFile(content)
+		if err != nil {
+			return ErrEvaluatePolicies(err)
+		}
+
+		engine, err := newPolicyEngine(policyPaths)
+		if err != nil {
+			return ErrEvaluatePolicies(err)
+		}
+		report, err := engine.Evaluate(context.Background(), pattern)
+		if err != nil {
+			return ErrEvaluatePolicies(err)
+		}
+
+		if outputFormatFlag != "" {
+			output, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				utils.Log.Error(utils.ErrMarshalIndent(err))
+				return nil
+			}
+			if outputFormatFlag == "yaml" {
+				if output, err = yaml.JSONToYAML(output); err != nil {
+					utils.Log.Error(utils.ErrMarshal(err))
+					return nil
+				}
+			}
+			utils.Log.Info(string(output))
+		} else {
+			printReport(report)
+		}
+
+		if violations := countViolations(report, strict); violations > 0 {
+			return ErrPolicyViolations(violations)
+		}
+		return nil
+	},
+}
+
+// newPolicyEngine loads the relationship policies embedded in mesheryctl and the Rego files of the paths,
+// which are either files or directories
+func newPolicyEngine(paths []string) (*meshmodel.DesignPolicyEngine, error) {
+	policies, err := fs.Sub(kubernetes.Policies, "policies")
+	if err != nil {
+		return nil, err
+	}
+	relationships, err := fs.Sub(kubernetes.Relationships, "relationships")
+	if err != nil {
+		return nil, err
+	}
+	engine, err := meshmodel.NewDesignPolicyEngine(policies, relationships)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range paths {
+		err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			// the files given explicitly are loaded whatever their extension
+			if d.IsDir() || (p != path && filepath.Ext(p) != ".rego") {
+				return nil
+			}
+			module, err := os.ReadFile(p)
+			if err != nil {
+				return err
+			}
+			engine.AddModule(p, string(module))
+			return nil
+		})
+		if err != nil {
+			return nil, utils.ErrFileRead(err)
+		}
+	}
+	return engine, nil
+}
+
+// countViolations returns the number of reasons the design does not satisfy the policies
+func countViolations(report meshmodel.DesignPolicyReport, strict bool) int {
+	count := len(report.Violations)
+	if !strict {
+		return count
+	}
+	for _, rel := range report.Relationships {
+		if rel.Action == meshmodel.RelationshipActionRemove {
+			count++
+		}
+	}
+	return count + len(report.Patches)
+}
+
+func printReport(report meshmodel.DesignPolicyReport) {
+	for _, violation := range report.Violations {
+		utils.Log.Info("violation: " + violation)
+	}
+
+	if len(report.Relationships) > 0 {
+		rows := make([][]string, 0, len(report.Relationships))
+		for _, rel := range report.Relationships {
+			via := ""
+			if rel.Via != nil {
+				via = rel.Via.Name
+			}
+			rows = append(rows, []string{rel.From.Name, rel.To.Name, rel.Kind, rel.SubType, via, rel.Action, rel.Policy})
+		}
+		utils.PrintToTable([]string{"FROM", "TO", "KIND", "SUBTYPE", "VIA", "ACTION", "POLICY"}, rows)
+	}
+
+	if len(report.Patches) > 0 {
+		rows := [][]string{}
+		for _, patch := range report.Patches {
+			for _, op := range patch.Operations {
+				rows = append(rows, []string{patch.Component.Name, strings.ToUpper(op.Op), op.Path})
+			}
+		}
+		utils.PrintToTable([]string{"COMPONENT", "PATCH", "PATH"}, rows)
+	}
+
+	utils.Log.Info(fmt.Sprintf("%d violations, %d suggested relationships, %d components to patch", len(report.Violations), len(report.Relationships), len(report.Patches)))
+}
+
+func init() {
+	runCmd.Flags().StringVarP(&designFile, "file", "f", "", "Path to the design file")
+	runCmd.Flags().StringArrayVarP(&policyPaths, "policy", "p", []string{}, "Path to a Rego file or to a directory of Rego files of validation policies, can be repeated")
+	runCmd.Flags().BoolVar(&strict, "strict", false, "Fail when the relationship policies suggest patching components or removing relationships")
+	runCmd.Flags().StringVarP(&outputFormatFlag, "output-format", "o", "", "Print the report in the format, json or yaml")
+	_ = runCmd.MarkFlagRequired("file")
+}
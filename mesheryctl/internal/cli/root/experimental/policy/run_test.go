@@ -0,0 +1,46 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/layer5io/meshery/server/models/meshmodel"
+)
+
+func TestNewPolicyEngine(t *testing.T) {
+	dir := t.TempDir()
+	policy := []byte("package meshery.designs\n\ndeny[msg] {\n\tinput.name == \"\"\n\tmsg := \"designs must be named\"\n}\n")
+	if err := os.WriteFile(filepath.Join(dir, "named.rego"), policy, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("# policies"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// the relationship policies embedded in mesheryctl compile along with the policies of the directory
+	if _, err := newPolicyEngine([]string{dir}); err != nil {
+		t.Errorf("newPolicyEngine() error = %v", err)
+	}
+	if _, err := newPolicyEngine([]string{filepath.Join(dir, "missing.rego")}); err == nil {
+		t.Error("newPolicyEngine() error = nil, want the missing file to be reported")
+	}
+}
+
+func TestCountViolations(t *testing.T) {
+	report := meshmodel.DesignPolicyReport{
+		PolicyEvaluation: meshmodel.PolicyEvaluation{
+			Relationships: []meshmodel.SuggestedRelationship{
+				{Action: meshmodel.RelationshipActionAdd},
+				{Action: meshmodel.RelationshipActionRemove},
+			},
+			Patches: []meshmodel.ComponentPatch{{}},
+		},
+		Violations: []string{"designs must be named"},
+	}
+	if got := countViolations(report, false); got != 1 {
+		t.Errorf("countViolations() = %d, want 1", got)
+	}
+	if got := countViolations(report, true); got != 3 {
+		t.Errorf("countViolations(strict) = %d, want 3", got)
+	}
+}
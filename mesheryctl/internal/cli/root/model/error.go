@@ -0,0 +1,58 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshkit/errors"
+)
+
+const (
+	ErrInvalidRelationshipFileCode = "1190"
+	ErrRelationshipLintCode        = "1191"
+	ErrFetchRelationshipsCode      = "1192"
+	ErrRelationshipNotFoundCode    = "1193"
+	ErrRegisterRelationshipsCode   = "1194"
+	ErrMissingArgumentsCode        = "1202"
+)
+
+func ErrInvalidRelationshipFile(path string, err error) error {
+	return errors.New(ErrInvalidRelationshipFileCode, errors.Alert, []string{"Invalid relationship definition file"}, []string{fmt.Sprintf("Unable to parse %s: %s", path, err.Error())}, []string{"The file is not a valid JSON or YAML relationship definition"}, []string{"Check that the file contains a single relationship definition in JSON or YAML format"})
+}
+
+func ErrRelationshipLint(errCount int) error {
+	return errors.New(ErrRelationshipLintCode, errors.Alert, []string{"Relationship definitions failed linting"}, []string{fmt.Sprintf("%d errors found in the relationship definitions", errCount)}, []string{"The selectors reference models or components which are not registered, or can never match"}, []string{"Fix the reported errors and lint the relationship definitions again"})
+}
+
+func ErrFetchRelationships(err error) error {
+	return errors.New(ErrFetchRelationshipsCode, errors.Alert, []string{"Unable to fetch the registered relationships"}, []string{err.Error()}, []string{"Meshery Server is not reachable", "The model or query parameters are invalid"}, []string{"Make sure Meshery Server is running with `mesheryctl system status`", "Check the values of the flags"})
+}
+
+func ErrRelationshipNotFound(model, kind, subType string) error {
+	cause := fmt.Sprintf("No relationship of kind %s is registered for model %s", kind, model)
+	if subType != "" {
+		cause = fmt.Sprintf("No relationship of kind %s and subtype %s is registered for model %s", kind, subType, model)
+	}
+	return errors.New(ErrRelationshipNotFoundCode, errors.Alert, []string{"Relationship not found"}, []string{cause}, []string{"The model, kind or subtype is misspelled", "The relationship is not registered"}, []string{"List the registered relationships of the model with `mesheryctl model relationship list --model " + model + "`"})
+}
+
+func ErrRegisterRelationships(err error) error {
+	return errors.New(ErrRegisterRelationshipsCode, errors.Alert, []string{"Unable to register the relationship definitions"}, []string{err.Error()}, []string{"One or more definitions are invalid, violate the relationship policies or duplicate registered relationships"}, []string{"Fix the reported errors and register the relationship definitions again", "Pass --force to register definitions duplicating registered relationships"})
+}
+
+func ErrMissingArguments(what string) error {
+	return errors.New(ErrMissingArgumentsCode, errors.Alert, []string{"Missing arguments"}, []string{fmt.Sprintf("Pass %s as arguments", what)}, []string{"The arguments can only be selected from a prompt when mesheryctl runs in a terminal"}, []string{"Pass the arguments, run `mesheryctl model relationship view --help` for usage details"})
+}
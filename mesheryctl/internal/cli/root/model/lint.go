@@ -0,0 +1,185 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// relationshipLintDiagnostic is a diagnostic reported by the server along with the file of the offending definition
+type relationshipLintDiagnostic struct {
+	File string `json:"file"`
+	meshmodel.RelationshipLintDiagnostic
+}
+
+var lintCmd = &cobra.Command{
+	Use:   "lint [path...]",
+	Short: "Lint relationship definitions",
+	Long: `Lint relationship definition files against the models registered with Meshery.
+Reports selectors referencing unknown models or component kinds, selectors which can never match and selectors without a deny list.
+Directories are searched for JSON and YAML files recursively. The command fails when any error is reported.`,
+	Example: `
+// lint a relationship definition
+mesheryctl model relationship lint relationships/network_edge.json
+
+// lint every relationship definition of a model and print the diagnostics as JSON
+mesheryctl model relationship lint server/meshmodel/kubernetes/relationships -o json
+	`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if outputFormatFlag != "" && outputFormatFlag != "json" && outputFormatFlag != "yaml" {
+			utils.Log.Error(utils.ErrOutFormatFlag())
+			return nil
+		}
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+
+		files, rels, err := readRelationshipFiles(args)
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+		if len(rels) == 0 {
+			utils.Log.Info("No relationship definitions found")
+			return nil
+		}
+
+		body, err := json.Marshal(models.MeshmodelRelationshipsLintRequest{Relationships: rels})
+		if err != nil {
+			utils.Log.Error(utils.ErrMarshal(err))
+			return nil
+		}
+		req, err := utils.NewRequest("POST", mctlCfg.GetBaseMesheryURL()+"/api/meshmodels/relationships/lint", bytes.NewBuffer(body))
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+		res, err := utils.MakeRequest(req)
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+		defer res.Body.Close()
+		data, err := io.ReadAll(res.Body)
+		if err != nil {
+			utils.Log.Error(utils.ErrReadResponseBody(err))
+			return nil
+		}
+		var response models.MeshmodelRelationshipsLintResponse
+		if err := json.Unmarshal(data, &response); err != nil {
+			utils.Log.Error(utils.ErrUnmarshal(err))
+			return nil
+		}
+
+		diagnostics := make([]relationshipLintDiagnostic, 0, len(response.Diagnostics))
+		for _, d := range response.Diagnostics {
+			file := ""
+			if d.Index >= 0 && d.Index < len(files) {
+				file = files[d.Index]
+			}
+			diagnostics = append(diagnostics, relationshipLintDiagnostic{File: file, RelationshipLintDiagnostic: d})
+		}
+
+		if outputFormatFlag != "" {
+			out, _ := json.MarshalIndent(diagnostics, "", "  ")
+			if outputFormatFlag == "yaml" {
+				out, _ = yaml.JSONToYAML(out)
+			}
+			utils.Log.Info(string(out))
+		} else if len(diagnostics) > 0 {
+			rows := make([][]string, 0, len(diagnostics))
+			for _, d := range diagnostics {
+				rows = append(rows, []string{d.File, d.Kind, d.Severity, d.Rule, d.Path, d.Message})
+			}
+			utils.PrintToTable([]string{"FILE", "KIND", "SEVERITY", "RULE", "PATH", "MESSAGE"}, rows)
+			utils.Log.Info(fmt.Sprintf("\n%d errors, %d warnings in %d relationship definitions", response.Errors, response.Warnings, len(rels)))
+		} else {
+			utils.Log.Info(fmt.Sprintf("No issues found in %d relationship definitions", len(rels)))
+		}
+
+		if response.Errors > 0 {
+			return ErrRelationshipLint(response.Errors)
+		}
+		return nil
+	},
+}
+
+// readRelationshipFiles reads the relationship definitions from the given files, directories are searched recursively for JSON and YAML files.
+// The returned slices are parallel, the file a definition was read from is at the same index as the definition.
+func readRelationshipFiles(paths []string) ([]string, []v1alpha1.RelationshipDefinition, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, nil, utils.ErrFileRead(err)
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			switch filepath.Ext(p) {
+			case ".json", ".yaml", ".yml":
+				if !d.IsDir() {
+					files = append(files, p)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, nil, utils.ErrFileRead(err)
+		}
+	}
+
+	rels := make([]v1alpha1.RelationshipDefinition, 0, len(files))
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, nil, utils.ErrFileRead(err)
+		}
+		// YAML is a superset of JSON, both are converted to JSON before being decoded
+		content, err = yaml.YAMLToJSON(content)
+		if err != nil {
+			return nil, nil, ErrInvalidRelationshipFile(file, err)
+		}
+		var rel v1alpha1.RelationshipDefinition
+		if err := json.Unmarshal(content, &rel); err != nil {
+			return nil, nil, ErrInvalidRelationshipFile(file, err)
+		}
+		rels = append(rels, rel)
+	}
+	return files, rels, nil
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReadRelationshipFiles(t *testing.T) {
+	dir := filepath.Join("testdata", "relationships")
+	files, rels, err := readRelationshipFiles([]string{dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedFiles := []string{
+		filepath.Join(dir, "network", "network_edge.yaml"),
+		filepath.Join(dir, "sibling.json"),
+	}
+	if !reflect.DeepEqual(files, expectedFiles) {
+		t.Fatalf("expected files %v, got %v", expectedFiles, files)
+	}
+	expectedSubTypes := []string{"Network", "Sibling"}
+	for i, rel := range rels {
+		if rel.SubType != expectedSubTypes[i] {
+			t.Errorf("expected subType %s for %s, got %s", expectedSubTypes[i], files[i], rel.SubType)
+		}
+		if rel.Model.Name != "kubernetes" {
+			t.Errorf("expected model kubernetes for %s, got %s", files[i], rel.Model.Name)
+		}
+	}
+
+	if _, _, err := readRelationshipFiles([]string{filepath.Join(dir, "missing.json")}); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
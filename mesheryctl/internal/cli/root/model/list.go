@@ -0,0 +1,177 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	pageNumber     int
+	pageSize       int
+	modelFlag      string
+	kindFlag       string
+	subTypeFlag    string
+	versionFlag    string
+	registrantFlag string
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List registered relationships",
+	Long: `List the relationships registered with Meshery, 25 per page by default.
+The relationships can be narrowed down to a model, a kind, a subtype or a registrant.`,
+	Example: `
+// list the registered relationships
+mesheryctl model relationship list
+
+// list the second page of the edge relationships of the kubernetes model
+mesheryctl model relationship list --model kubernetes --kind edge --page 2
+
+// list every relationship as YAML
+mesheryctl model relationship list --pagesize 0 -o yaml
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if outputFormatFlag != "" && outputFormatFlag != "json" && outputFormatFlag != "yaml" {
+			utils.Log.Error(utils.ErrOutFormatFlag())
+			return nil
+		}
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+
+		query := relationshipsQuery()
+		query.Set("kind", kindFlag)
+		query.Set("subtype", subTypeFlag)
+		query.Set("registrant", registrantFlag)
+		response, err := fetchRelationships(relationshipsURL(mctlCfg.GetBaseMesheryURL(), modelFlag), query)
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+		printRelationships(response)
+		return nil
+	},
+}
+
+// relationshipsQuery returns the query of the version and pagination flags, the empty values are dropped by encodeQuery
+func relationshipsQuery() url.Values {
+	query := url.Values{}
+	query.Set("version", versionFlag)
+	query.Set("page", fmt.Sprint(pageNumber))
+	if pageSize <= 0 {
+		query.Set("pagesize", "all")
+	} else {
+		query.Set("pagesize", fmt.Sprint(pageSize))
+	}
+	return query
+}
+
+// relationshipsURL returns the endpoint listing the relationships of the model, or of every model when model is empty
+func relationshipsURL(baseURL, model string) string {
+	if model == "" {
+		return baseURL + "/api/meshmodels/relationships"
+	}
+	return baseURL + "/api/meshmodels/models/" + url.PathEscape(model) + "/relationships"
+}
+
+// encodeQuery encodes the query without the parameters which are not set
+func encodeQuery(query url.Values) string {
+	for key, values := range query {
+		if len(values) == 0 || values[0] == "" {
+			query.Del(key)
+		}
+	}
+	return query.Encode()
+}
+
+// fetchRelationships fetches a page of the relationships from the endpoint
+func fetchRelationships(endpoint string, query url.Values) (*models.MeshmodelRelationshipsAPIResponse, error) {
+	if q := encodeQuery(query); q != "" {
+		endpoint += "?" + q
+	}
+	utils.Log.Debug(endpoint)
+
+	req, err := utils.NewRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, ErrFetchRelationships(err)
+	}
+	res, err := utils.MakeRequest(req)
+	if err != nil {
+		return nil, ErrFetchRelationships(err)
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, utils.ErrReadResponseBody(err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, ErrFetchRelationships(fmt.Errorf("server returned with status code %d: %s", res.StatusCode, string(body)))
+	}
+	var response models.MeshmodelRelationshipsAPIResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, utils.ErrUnmarshal(err)
+	}
+	return &response, nil
+}
+
+// printRelationships prints the page of relationships as a table, or in the format of the output-format flag
+func printRelationships(response *models.MeshmodelRelationshipsAPIResponse) {
+	if outputFormatFlag != "" {
+		out, _ := json.MarshalIndent(response, "", "  ")
+		if outputFormatFlag == "yaml" {
+			out, _ = yaml.JSONToYAML(out)
+		}
+		utils.Log.Info(string(out))
+		return
+	}
+	if len(response.Relationships) == 0 {
+		utils.Log.Info("No relationships found")
+		return
+	}
+
+	rows := make([][]string, 0, len(response.Relationships))
+	for _, rel := range response.Relationships {
+		rows = append(rows, []string{rel.Model.Name, rel.Model.Version, rel.Kind, rel.SubType, rel.HostName})
+	}
+	utils.PrintToTableWithFooter([]string{"MODEL", "VERSION", "KIND", "SUBTYPE", "REGISTRANT"}, rows, []string{"Total", fmt.Sprint(response.Count), "", "", ""})
+	if response.PageSize > 0 && int64(response.Page*response.PageSize) < response.Count {
+		utils.Log.Info(fmt.Sprintf("\nPage %d of %d, use --page %d to list the next relationships", response.Page, (response.Count+int64(response.PageSize)-1)/int64(response.PageSize), response.Page+1))
+	}
+}
+
+func init() {
+	listCmd.Flags().StringVarP(&modelFlag, "model", "m", "", "(optional) list only the relationships of the model")
+	listCmd.Flags().StringVarP(&kindFlag, "kind", "k", "", "(optional) list only the relationships of the kind, eg: edge, hierarchical")
+	listCmd.Flags().StringVarP(&subTypeFlag, "subtype", "s", "", "(optional) list only the relationships of the subtype, eg: network, parent")
+	listCmd.Flags().StringVarP(&registrantFlag, "registrant", "r", "", "(optional) list only the relationships registered by the registrant")
+
+	_ = listCmd.RegisterFlagCompletionFunc("model", utils.CompleteModelNames)
+	_ = listCmd.RegisterFlagCompletionFunc("kind", utils.CompleteRelationshipKinds(func() string { return modelFlag }))
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+func TestRelationshipsQuery(t *testing.T) {
+	pageNumber, pageSize, versionFlag = 2, 0, ""
+	query := relationshipsQuery()
+	query.Set("kind", "Edge")
+	query.Set("subtype", "")
+	if got, want := encodeQuery(query), "kind=Edge&page=2&pagesize=all"; got != want {
+		t.Errorf("encodeQuery() = %s, want %s", got, want)
+	}
+
+	if got, want := relationshipsURL("http://localhost:9081", "kubernetes"), "http://localhost:9081/api/meshmodels/models/kubernetes/relationships"; got != want {
+		t.Errorf("relationshipsURL() = %s, want %s", got, want)
+	}
+	if got, want := relationshipsURL("http://localhost:9081", ""), "http://localhost:9081/api/meshmodels/relationships"; got != want {
+		t.Errorf("relationshipsURL() = %s, want %s", got, want)
+	}
+}
+
+func TestFilterRelationshipsBySubType(t *testing.T) {
+	rels := []v1alpha1.RelationshipDefinition{{SubType: "Network"}, {SubType: "Mount"}}
+	if got := filterRelationshipsBySubType(rels, ""); len(got) != 2 {
+		t.Errorf("expected every relationship without subtype, got %v", got)
+	}
+	if got := filterRelationshipsBySubType(rels, "network"); len(got) != 1 || got[0].SubType != "Network" {
+		t.Errorf("expected the Network relationship, got %v", got)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	availableSubcommands []*cobra.Command
+	outputFormatFlag     string
+)
+
+// ModelCmd represents the root command for model commands
+var ModelCmd = &cobra.Command{
+	Use:   "model",
+	Short: "Meshery Models Management",
+	Long:  `Manage the models, components and relationships registered with Meshery`,
+	Example: `
+// List the registered relationships
+mesheryctl model relationship list
+
+// Lint relationship definition files against the registered models
+mesheryctl model relationship lint [path to relationship file | directory]
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return cmd.Help()
+		}
+		if ok := utils.IsValidSubcommand(availableSubcommands, args[0]); !ok {
+			return errors.New(utils.ModelError(fmt.Sprintf("'%s' is a invalid command.  Use 'mesheryctl model --help' to display usage guide.\n", args[0])))
+		}
+		return nil
+	},
+}
+
+func init() {
+	ModelCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token file default from current context")
+	ModelCmd.PersistentFlags().StringVarP(&outputFormatFlag, "output-format", "o", "", "(optional) format to display in [json|yaml]")
+
+	availableSubcommands = []*cobra.Command{relationshipCmd}
+	ModelCmd.AddCommand(availableSubcommands...)
+}
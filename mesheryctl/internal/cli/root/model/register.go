@@ -0,0 +1,147 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshkit/models/meshmodel/registry"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var forceFlag bool
+
+// relationshipRegistrationResult is the outcome of the registration of a definition along with the file it was read from
+type relationshipRegistrationResult struct {
+	File string `json:"file"`
+	models.MeshmodelEntityRegistrationResult
+}
+
+var registerCmd = &cobra.Command{
+	Use:   "register [path...]",
+	Short: "Register relationship definitions",
+	Long: `Register the relationship definitions of the given files with Meshery.
+Directories are searched for JSON and YAML files recursively. The definitions are registered together, when any of them
+is invalid, violates the relationship policies or duplicates a registered relationship none of them is registered.`,
+	Example: `
+// register a relationship definition
+mesheryctl model relationship register relationships/network_edge.json
+
+// register every relationship definition of a directory, even those duplicating registered relationships
+mesheryctl model relationship register relationships/ --force
+	`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if outputFormatFlag != "" && outputFormatFlag != "json" && outputFormatFlag != "yaml" {
+			utils.Log.Error(utils.ErrOutFormatFlag())
+			return nil
+		}
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+
+		files, rels, err := readRelationshipFiles(args)
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+		if len(rels) == 0 {
+			utils.Log.Info("No relationship definitions found")
+			return nil
+		}
+
+		body, err := json.Marshal(models.MeshmodelRelationshipsBulkRegistrationRequest{
+			Host:          registry.Host{Hostname: "mesheryctl"},
+			Relationships: rels,
+		})
+		if err != nil {
+			utils.Log.Error(utils.ErrMarshal(err))
+			return nil
+		}
+		endpoint := mctlCfg.GetBaseMesheryURL() + "/api/meshmodels/relationships/bulk"
+		if forceFlag {
+			endpoint += "?force=true"
+		}
+		req, err := utils.NewRequest("POST", endpoint, bytes.NewBuffer(body))
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+		res, err := utils.MakeRequest(req)
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+		defer res.Body.Close()
+		data, err := io.ReadAll(res.Body)
+		if err != nil {
+			utils.Log.Error(utils.ErrReadResponseBody(err))
+			return nil
+		}
+		var response models.MeshmodelRelationshipsBulkRegistrationResponse
+		if err := json.Unmarshal(data, &response); err != nil {
+			// errors which are not about the definitions are returned as plain text
+			return ErrRegisterRelationships(fmt.Errorf("server returned with status code %d: %s", res.StatusCode, string(data)))
+		}
+
+		results := make([]relationshipRegistrationResult, 0, len(response.Results))
+		for _, r := range response.Results {
+			file := ""
+			if r.Index >= 0 && r.Index < len(files) {
+				file = files[r.Index]
+			}
+			results = append(results, relationshipRegistrationResult{File: file, MeshmodelEntityRegistrationResult: r})
+		}
+
+		if outputFormatFlag != "" {
+			out, _ := json.MarshalIndent(results, "", "  ")
+			if outputFormatFlag == "yaml" {
+				out, _ = yaml.JSONToYAML(out)
+			}
+			utils.Log.Info(string(out))
+		} else {
+			rows := make([][]string, 0, len(results))
+			for _, r := range results {
+				status := "registered"
+				if !r.Registered {
+					status = "not registered"
+				}
+				rows = append(rows, []string{r.File, r.Model, r.Kind, status, r.Error})
+			}
+			utils.PrintToTable([]string{"FILE", "MODEL", "KIND", "STATUS", "ERROR"}, rows)
+			utils.Log.Info(fmt.Sprintf("\n%d of %d relationship definitions registered", response.Registered, len(rels)))
+		}
+
+		if res.StatusCode != http.StatusOK || response.Failed > 0 {
+			return ErrRegisterRelationships(fmt.Errorf("%d of %d relationship definitions could not be registered", response.Failed, len(rels)))
+		}
+		return nil
+	},
+}
+
+func init() {
+	registerCmd.Flags().BoolVarP(&forceFlag, "force", "f", false, "(optional) register the definitions even if they duplicate registered relationships")
+}
@@ -0,0 +1,67 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var relationshipSubcommands []*cobra.Command
+
+var relationshipCmd = &cobra.Command{
+	Use:   "relationship",
+	Short: "Manage relationship definitions",
+	Long:  `Manage the relationships defined between the components of the registered models`,
+	Example: `
+// List the registered relationships
+mesheryctl model relationship list
+
+// View the relationships of a kind of a model
+mesheryctl model relationship view [model] [kind]
+
+// Search the registered relationships
+mesheryctl model relationship search [term]
+
+// Register relationship definition files
+mesheryctl model relationship register [path to relationship file | directory]
+
+// Lint relationship definition files against the registered models
+mesheryctl model relationship lint [path to relationship file | directory]
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return cmd.Help()
+		}
+		if ok := utils.IsValidSubcommand(relationshipSubcommands, args[0]); !ok {
+			return errors.New(utils.ModelError(fmt.Sprintf("'%s' is a invalid command.  Use 'mesheryctl model relationship --help' to display usage guide.\n", args[0])))
+		}
+		return nil
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{listCmd, searchCmd} {
+		cmd.Flags().IntVarP(&pageNumber, "page", "p", 1, "(optional) list the next set of relationships with --page")
+		cmd.Flags().IntVar(&pageSize, "pagesize", 25, "(optional) number of relationships per page, 0 to list all of them")
+		cmd.Flags().StringVar(&versionFlag, "version", "", "(optional) list only the relationships of the model version")
+	}
+
+	relationshipSubcommands = []*cobra.Command{listCmd, viewCmd, searchCmd, registerCmd, lintCmd}
+	relationshipCmd.AddCommand(relationshipSubcommands...)
+}
@@ -0,0 +1,77 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var searchFieldFlag string
+
+var searchCmd = &cobra.Command{
+	Use:   "search [term]",
+	Short: "Search registered relationships",
+	Long: `Search the relationships registered with Meshery for a term.
+The term is looked up in the kind, description, subtype, evaluation query and selector component kinds of the relationships,
+the search can be restricted to one of them with --field.`,
+	Example: `
+// search the relationships mentioning pods
+mesheryctl model relationship search pod
+
+// search the relationships of the kubernetes model whose selectors reference a Service
+mesheryctl model relationship search Service --field selectorKind --model kubernetes
+	`,
+	Args: cobra.ExactArgs(1),
+	// the kinds of the components are completed for the search of the component kinds of the selectors
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 || searchFieldFlag != "selectorKind" {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return utils.CompleteComponentNames(func() string { return modelFlag })(cmd, args, toComplete)
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if outputFormatFlag != "" && outputFormatFlag != "json" && outputFormatFlag != "yaml" {
+			utils.Log.Error(utils.ErrOutFormatFlag())
+			return nil
+		}
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+
+		query := relationshipsQuery()
+		query.Set("search", args[0])
+		query.Set("searchField", searchFieldFlag)
+		response, err := fetchRelationships(relationshipsURL(mctlCfg.GetBaseMesheryURL(), modelFlag), query)
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+		printRelationships(response)
+		return nil
+	},
+}
+
+func init() {
+	searchCmd.Flags().StringVarP(&searchFieldFlag, "field", "f", "", "(optional) search only the field in [kind|description|subType|evaluationQuery|selectorKind]")
+	searchCmd.Flags().StringVarP(&modelFlag, "model", "m", "", "(optional) search only the relationships of the model")
+
+	_ = searchCmd.RegisterFlagCompletionFunc("model", utils.CompleteModelNames)
+	_ = searchCmd.RegisterFlagCompletionFunc("field", cobra.FixedCompletions([]string{"kind", "description", "subType", "evaluationQuery", "selectorKind"}, cobra.ShellCompDirectiveNoFileComp))
+}
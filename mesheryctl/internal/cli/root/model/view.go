@@ -0,0 +1,160 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var viewCmd = &cobra.Command{
+	Use:   "view [model] [kind]",
+	Short: "View relationship definitions",
+	Long: `Display the definitions of the relationships of the given kind of a model, as YAML by default.
+A model may define several relationships of the same kind, they are narrowed down with --subtype.
+When the model or the kind is omitted, they are selected from the registered ones with a fuzzy search prompt.`,
+	Example: `
+// view the edge relationships of the kubernetes model
+mesheryctl model relationship view kubernetes edge
+
+// view the network edge relationship of version v1.25.2 of the kubernetes model as JSON
+mesheryctl model relationship view kubernetes edge --subtype network --version v1.25.2 -o json
+
+// select the model and the kind of the relationships to view
+mesheryctl model relationship view
+	`,
+	Args: cobra.MaximumNArgs(2),
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		switch len(args) {
+		case 0:
+			return utils.CompleteModelNames(cmd, args, toComplete)
+		case 1:
+			return utils.CompleteRelationshipKinds(func() string { return args[0] })(cmd, args, toComplete)
+		}
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if outputFormatFlag != "" && outputFormatFlag != "json" && outputFormatFlag != "yaml" {
+			utils.Log.Error(utils.ErrOutFormatFlag())
+			return nil
+		}
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+
+		model, kind, err := selectModelAndKind(args)
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+		query := url.Values{}
+		query.Set("version", versionFlag)
+		query.Set("pagesize", "all")
+		response, err := fetchRelationships(relationshipsURL(mctlCfg.GetBaseMesheryURL(), model)+"/"+url.PathEscape(kind), query)
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+
+		rels := filterRelationshipsBySubType(response.Relationships, subTypeFlag)
+		if len(rels) == 0 {
+			utils.Log.Error(ErrRelationshipNotFound(model, kind, subTypeFlag))
+			return nil
+		}
+
+		var out []byte
+		if len(rels) == 1 {
+			out, err = json.MarshalIndent(rels[0], "", "  ")
+		} else {
+			out, err = json.MarshalIndent(rels, "", "  ")
+		}
+		if err != nil {
+			utils.Log.Error(utils.ErrMarshalIndent(err))
+			return nil
+		}
+		if outputFormatFlag != "json" {
+			if out, err = yaml.JSONToYAML(out); err != nil {
+				utils.Log.Error(utils.ErrJSONToYAML(err))
+				return nil
+			}
+		}
+		utils.Log.Info(string(out))
+		if len(rels) > 1 {
+			utils.Log.Info(fmt.Sprintf("%d relationships of kind %s found, use --subtype to view only one of them", len(rels), kind))
+		}
+		return nil
+	},
+}
+
+// selectModelAndKind returns the model and the kind of the arguments, prompting the user to select the missing ones
+func selectModelAndKind(args []string) (string, string, error) {
+	if len(args) == 2 {
+		return args[0], args[1], nil
+	}
+	if !utils.IsInteractive() {
+		return "", "", ErrMissingArguments("the model and the kind of the relationships")
+	}
+	var model string
+	if len(args) == 1 {
+		model = args[0]
+	} else {
+		names, err := utils.ModelNames()
+		if err != nil {
+			return "", "", err
+		}
+		if model, err = utils.FuzzySelect("Model", names); err != nil {
+			return "", "", err
+		}
+	}
+	kinds, err := utils.RelationshipKinds(model)
+	if err != nil {
+		return "", "", err
+	}
+	kind, err := utils.FuzzySelect("Relationship kind", kinds)
+	if err != nil {
+		return "", "", err
+	}
+	return model, kind, nil
+}
+
+// filterRelationshipsBySubType returns the relationships of the subtype, case insensitively, or all of them when subType is empty
+func filterRelationshipsBySubType(rels []v1alpha1.RelationshipDefinition, subType string) []v1alpha1.RelationshipDefinition {
+	if subType == "" {
+		return rels
+	}
+	filtered := make([]v1alpha1.RelationshipDefinition, 0, len(rels))
+	for _, rel := range rels {
+		if strings.EqualFold(rel.SubType, subType) {
+			filtered = append(filtered, rel)
+		}
+	}
+	return filtered
+}
+
+func init() {
+	viewCmd.Flags().StringVarP(&subTypeFlag, "subtype", "s", "", "(optional) view only the relationship of the subtype, eg: network, parent")
+	viewCmd.Flags().StringVar(&versionFlag, "version", "", "(optional) version of the model, defaults to every version")
+}
@@ -25,6 +25,7 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
@@ -36,8 +37,10 @@ import (
 )
 
 var (
-	skipSave    bool // skip saving a pattern
-	patternFile string
+	skipSave     bool // skip saving a pattern
+	patternFile  string
+	watch        bool // watch the rollout of the pattern after applying it
+	watchTimeout time.Duration
 )
 
 var linkDocPatternApply = map[string]string{
@@ -55,6 +58,13 @@ mesheryctl pattern apply -f [file | URL]
 
 // deploy a saved pattern
 mesheryctl pattern apply [pattern-name]
+
+// apply a pattern file and watch the status of its components until all of them are ready
+mesheryctl pattern apply -f [file] --watch --timeout 10m
+
+// apply a pattern file in several Kubernetes contexts connected to Meshery, or in all of them, in parallel
+mesheryctl pattern apply -f [file] --k8s-context staging-east,staging-west
+mesheryctl pattern apply -f [file] --k8s-context all
 	`,
 	Annotations: linkDocPatternApply,
 	Args:        cobra.MinimumNArgs(0),
@@ -233,13 +243,21 @@ mesheryctl pattern apply [pattern-name]
 			}
 		}
 
-		req, err = utils.NewRequest("POST", deployURL, bytes.NewBuffer([]byte(patternFile)))
+		pf, err := core.NewPatternFile([]byte(patternFile))
 		if err != nil {
 			utils.Log.Error(err)
 			return nil
 		}
 
-		pf, err := core.NewPatternFile([]byte(patternFile))
+		if len(k8sContexts) > 0 {
+			contextIDs, err := deployInContexts(mctlCfg.GetBaseMesheryURL(), http.MethodPost, patternFile)
+			if err != nil || !watch {
+				return err
+			}
+			return watchRollout(mctlCfg.GetBaseMesheryURL(), patternFile, contextIDs, watchTimeout)
+		}
+
+		req, err = utils.NewRequest("POST", deployURL, bytes.NewBuffer([]byte(patternFile)))
 		if err != nil {
 			utils.Log.Error(err)
 			return nil
@@ -264,8 +282,11 @@ mesheryctl pattern apply [pattern-name]
 		if res.StatusCode == 200 {
 			utils.Log.Info("pattern successfully applied")
 		}
-		utils.Log.Info(string(body))
-		return nil
+		if !watch {
+			utils.Log.Info(string(body))
+			return nil
+		}
+		return watchRollout(mctlCfg.GetBaseMesheryURL(), patternFile, nil, watchTimeout)
 	},
 }
 
@@ -303,4 +324,7 @@ func multiplePatternsConfirmation(profiles []models.MesheryPattern) int {
 func init() {
 	applyCmd.Flags().StringVarP(&file, "file", "f", "", "Path to pattern file")
 	applyCmd.Flags().BoolVarP(&skipSave, "skip-save", "", false, "Skip saving a pattern")
+	applyCmd.Flags().BoolVarP(&watch, "watch", "w", false, "Watch the status of the components of the pattern until all of them are ready or one of them fails")
+	applyCmd.Flags().StringSliceVar(&k8sContexts, "k8s-context", nil, "Names or IDs of the Kubernetes contexts connected to Meshery to apply the pattern in, in parallel, or all")
+	applyCmd.Flags().DurationVar(&watchTimeout, "timeout", 5*time.Minute, "Time to wait for the rollout of the pattern with --watch, 0 waits forever")
 }
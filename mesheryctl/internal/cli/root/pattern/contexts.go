@@ -0,0 +1,80 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pattern
+
+import (
+	"bytes"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+)
+
+// the Kubernetes contexts connected to Meshery the pattern is deployed to or undeployed from, by name or ID
+var k8sContexts []string
+
+// deployInContexts deploys the pattern, or undeploys it when method is DELETE, in each of the Kubernetes contexts in
+// parallel, prints the summary of the outcomes and returns the IDs of the contexts it succeeded in
+func deployInContexts(baseURL, method, patternFile string) ([]string, error) {
+	contexts, err := utils.MesheryK8sContexts(baseURL, k8sContexts)
+	if err != nil {
+		return nil, err
+	}
+	names := utils.K8sContextNames(contexts)
+	ids := make(map[string]string, len(contexts))
+	for i, k8sContext := range contexts {
+		ids[names[i]] = k8sContext.ID
+	}
+
+	done := "pattern applied"
+	if method == http.MethodDelete {
+		done = "pattern deleted"
+	}
+	s := utils.CreateDefaultSpinner("Running in "+pluralContexts(len(contexts)), "")
+	s.Start()
+	results := utils.RunInContexts(names, func(name string) (string, error) {
+		req, err := utils.NewRequest(method, baseURL+"/api/pattern/deploy?contexts="+url.QueryEscape(ids[name]), bytes.NewBufferString(patternFile))
+		if err != nil {
+			return "", err
+		}
+		resp, err := utils.MakeRequest(req)
+		if err != nil {
+			return "", err
+		}
+		resp.Body.Close()
+		return done, nil
+	})
+	s.Stop()
+
+	failed := utils.PrintContextResults(results)
+	succeeded := make([]string, 0, len(results)-failed)
+	for _, result := range results {
+		if result.Err == nil {
+			succeeded = append(succeeded, ids[result.Context])
+		}
+	}
+	if failed > 0 {
+		return succeeded, utils.ErrFailedInContexts(failed, len(results))
+	}
+	return succeeded, nil
+}
+
+func pluralContexts(n int) string {
+	if n == 1 {
+		return "1 Kubernetes context"
+	}
+	return strconv.Itoa(n) + " Kubernetes contexts"
+}
@@ -39,6 +39,9 @@ var deleteCmd = &cobra.Command{
 	Example: `
 // delete a pattern file
 mesheryctl pattern delete [file | URL]
+
+// delete a pattern file from several Kubernetes contexts connected to Meshery, or from all of them, in parallel
+mesheryctl pattern delete -f [file] --k8s-context staging-east,staging-west
 	`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var req *http.Request
@@ -138,6 +141,11 @@ mesheryctl pattern delete [file | URL]
 			patternFile = response[0].PatternFile
 		}
 
+		if len(k8sContexts) > 0 {
+			_, err := deployInContexts(mctlCfg.GetBaseMesheryURL(), http.MethodDelete, patternFile)
+			return err
+		}
+
 		req, err = utils.NewRequest("DELETE", deployURL, bytes.NewBuffer([]byte(patternFile)))
 		if err != nil {
 			utils.Log.Error(err)
@@ -165,4 +173,5 @@ mesheryctl pattern delete [file | URL]
 
 func init() {
 	deleteCmd.Flags().StringVarP(&file, "file", "f", "", "Path to pattern file")
+	deleteCmd.Flags().StringSliceVar(&k8sContexts, "k8s-context", nil, "Names or IDs of the Kubernetes contexts connected to Meshery to delete the pattern from, in parallel, or all")
 }
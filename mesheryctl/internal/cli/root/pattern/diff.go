@@ -0,0 +1,246 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pattern
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	diffManifestsFlag    bool
+	diffOutputFormatFlag string
+)
+
+// patternDiff is the difference between two designs, along with the difference between their rendered manifests when requested
+type patternDiff struct {
+	core.PatternDiff
+	Manifests []core.ComponentChange `json:"manifests,omitempty"`
+}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff [pattern-file | pattern-name | ID] [pattern-file | pattern-name | ID]",
+	Short: "Compare two patterns",
+	Long: `Display the components added, removed or modified from a pattern to another, along with the fields changed.
+Each pattern is either a local pattern file or a pattern saved in Meshery, by name or ID.
+With --manifests, the manifests Meshery would apply for both patterns are rendered with a dry run and compared as well.`,
+	Example: `
+// compare a local pattern file with the saved pattern it was exported from
+mesheryctl pattern diff ./bookinfo.yaml bookinfo
+
+// compare two saved patterns, along with their rendered manifests, as JSON
+mesheryctl design diff 8f1a5f0b 2d3e4c6a --manifests -o json
+	`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if diffOutputFormatFlag != "" && diffOutputFormatFlag != "json" && diffOutputFormatFlag != "yaml" {
+			utils.Log.Error(utils.ErrOutFormatFlag())
+			return nil
+		}
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+		baseURL := mctlCfg.GetBaseMesheryURL()
+
+		from, err := resolvePatternFile(baseURL, args[0])
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+		to, err := resolvePatternFile(baseURL, args[1])
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+
+		var diff patternDiff
+		diff.PatternDiff, err = core.DiffPatternFiles(from, to)
+		if err != nil {
+			utils.Log.Error(ErrInvalidPatternFile(err))
+			return nil
+		}
+		if diffManifestsFlag {
+			fromManifests, err := renderManifests(baseURL, from)
+			if err != nil {
+				utils.Log.Error(err)
+				return nil
+			}
+			toManifests, err := renderManifests(baseURL, to)
+			if err != nil {
+				utils.Log.Error(err)
+				return nil
+			}
+			diff.Manifests = core.DiffManifests(fromManifests, toManifests)
+		}
+
+		if diffOutputFormatFlag != "" {
+			out, _ := json.MarshalIndent(diff, "", "  ")
+			if diffOutputFormatFlag == "yaml" {
+				out, _ = yaml.JSONToYAML(out)
+			}
+			utils.Log.Info(string(out))
+			return nil
+		}
+		printPatternDiff(diff)
+		return nil
+	},
+}
+
+// resolvePatternFile returns the content of the pattern file at the path, or of the saved pattern with the name or ID
+func resolvePatternFile(baseURL, arg string) ([]byte, error) {
+	if info, err := os.Stat(arg); err == nil && !info.IsDir() {
+		content, err := os.ReadFile(arg)
+		if err != nil {
+			return nil, utils.ErrFileRead(err)
+		}
+		return content, nil
+	}
+
+	pattern, isID, err := utils.ValidId(baseURL, arg, "pattern")
+	if err != nil {
+		return nil, ErrPatternInvalidNameOrID(err)
+	}
+	url := baseURL + "/api/pattern?search=" + pattern
+	if isID {
+		url = baseURL + "/api/pattern/" + pattern
+	}
+	body, err := requestPatternAPI("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if isID {
+		var p models.MesheryPattern
+		if err := json.Unmarshal(body, &p); err != nil {
+			return nil, utils.ErrUnmarshal(err)
+		}
+		return []byte(p.PatternFile), nil
+	}
+	var page models.MesheryPatternPage
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, utils.ErrUnmarshal(err)
+	}
+	// the first match is used when searching by pattern name, like pattern view does
+	if len(page.Patterns) == 0 {
+		return nil, ErrPatternNotFound()
+	}
+	return []byte(page.Patterns[0].PatternFile), nil
+}
+
+// renderManifests returns the manifests Meshery would apply for the components of the pattern, by component and Kubernetes context
+func renderManifests(baseURL string, patternFile []byte) (map[string]interface{}, error) {
+	body, err := requestPatternAPI("POST", baseURL+"/api/pattern/deploy?dryRun=true", bytes.NewBuffer(patternFile))
+	if err != nil {
+		return nil, ErrRenderManifests(err)
+	}
+	var response struct {
+		Manifests map[string]interface{} `json:"manifests"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, utils.ErrUnmarshal(err)
+	}
+	return response.Manifests, nil
+}
+
+func requestPatternAPI(method, url string, body io.Reader) ([]byte, error) {
+	req, err := utils.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	res, err := utils.MakeRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, utils.ErrReadResponseBody(err)
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("server returned with status code %d: %s", res.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return data, nil
+}
+
+// printPatternDiff prints the changed fields of the patterns and of their components, and of the rendered manifests, as tables
+func printPatternDiff(diff patternDiff) {
+	if len(diff.Fields) == 0 && len(diff.Components) == 0 && len(diff.Manifests) == 0 {
+		utils.Log.Info("The patterns are identical")
+		return
+	}
+
+	header := []string{"COMPONENT", "CHANGE", "FIELD", "FROM", "TO"}
+	rows := make([][]string, 0, len(diff.Fields))
+	for _, f := range diff.Fields {
+		rows = append(rows, []string{"", "", f.Path, diffValue(f.From), diffValue(f.To)})
+	}
+	rows = append(rows, componentChangeRows(diff.Components)...)
+	if len(rows) > 0 {
+		utils.PrintToTable(header, rows)
+	}
+	if len(diff.Manifests) > 0 {
+		utils.Log.Info("\nRendered manifests:")
+		utils.PrintToTable(header, componentChangeRows(diff.Manifests))
+	}
+	utils.Log.Info(fmt.Sprintf("\n%d fields and %d components changed", len(diff.Fields), len(diff.Components)))
+}
+
+func componentChangeRows(changes []core.ComponentChange) [][]string {
+	var rows [][]string
+	for _, c := range changes {
+		if len(c.Fields) == 0 {
+			rows = append(rows, []string{c.Name, c.Change, "", "", ""})
+		}
+		for _, f := range c.Fields {
+			rows = append(rows, []string{c.Name, c.Change, f.Path, diffValue(f.From), diffValue(f.To)})
+		}
+	}
+	return rows
+}
+
+// diffValue formats a value of a changed field for a table, nil stands for a field added or removed
+func diffValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return "-"
+	case string:
+		return val
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(out)
+}
+
+func init() {
+	diffCmd.Flags().BoolVarP(&diffManifestsFlag, "manifests", "m", false, "(optional) compare the manifests rendered for the patterns as well")
+	diffCmd.Flags().StringVarP(&diffOutputFormatFlag, "output-format", "o", "", "(optional) format to display in [json|yaml]")
+}
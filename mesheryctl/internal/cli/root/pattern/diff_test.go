@@ -0,0 +1,41 @@
+package pattern
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+func TestResolvePatternFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "design.yaml")
+	content := []byte("name: web\nservices: {}\n")
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+	// local files are read without reaching Meshery Server
+	got, err := resolvePatternFile("http://localhost:9081", path)
+	if err != nil || string(got) != string(content) {
+		t.Errorf("resolvePatternFile() = %s, %v, want the content of the file", got, err)
+	}
+}
+
+func TestComponentChangeRows(t *testing.T) {
+	changes := []core.ComponentChange{
+		{Name: "db", Change: core.ComponentRemoved},
+		{Name: "web", Change: core.ComponentModified, Fields: []core.ValueChange{
+			{Path: "settings.spec.ports", From: []interface{}{80}, To: []interface{}{80, 443}},
+			{Path: "settings.spec.replicas", From: 1},
+		}},
+	}
+	want := [][]string{
+		{"db", "removed", "", "", ""},
+		{"web", "modified", "settings.spec.ports", "[80]", "[80,443]"},
+		{"web", "modified", "settings.spec.replicas", "1", "-"},
+	}
+	if got := componentChangeRows(changes); !reflect.DeepEqual(got, want) {
+		t.Errorf("componentChangeRows() = %v, want %v", got, want)
+	}
+}
@@ -14,12 +14,23 @@
 
 package pattern
 
-import "github.com/layer5io/meshkit/errors"
+import (
+	"fmt"
+	"time"
+
+	"github.com/layer5io/meshkit/errors"
+)
 
 const (
 	ErrPatternsNotFoundCode       = "1115"
 	ErrInvalidPatternFileCode     = "1116"
 	ErrPatternInvalidNameOrIDCode = "1117"
+	ErrRenderManifestsCode        = "1195"
+	ErrRolloutFailedCode          = "1198"
+	ErrRolloutTimeoutCode         = "1199"
+	ErrLintFailedCode             = "1208"
+	ErrExecSessionCode            = "1209"
+	ErrExecExitedCode             = "1210"
 )
 
 func ErrPatternNotFound() error {
@@ -39,3 +50,27 @@ func ErrPatternInvalidNameOrID(err error) error {
 		[]string{"Invalid pattern name or ID"},
 		[]string{"Run `mesheryctl pattern view -a` to view all available patterns."})
 }
+
+func ErrRenderManifests(err error) error {
+	return errors.New(ErrRenderManifestsCode, errors.Alert, []string{"Unable to render the manifests of the pattern"}, []string{err.Error()}, []string{"Meshery Server is not reachable", "The pattern is not valid or is rejected by the dry run of the Kubernetes server"}, []string{"Make sure Meshery Server is running with `mesheryctl system status`", "Run the dry run of the pattern from Meshery UI to see its errors"})
+}
+
+func ErrRolloutFailed(err error) error {
+	return errors.New(ErrRolloutFailedCode, errors.Fatal, []string{"The rollout of the pattern failed"}, []string{err.Error()}, []string{"The containers of a component cannot pull their image or keep crashing", "A deployment exceeded its progress deadline or a job failed"}, []string{"Inspect the failed resources with `kubectl describe` and apply the fixed pattern again"})
+}
+
+func ErrRolloutTimeout(timeout time.Duration) error {
+	return errors.New(ErrRolloutTimeoutCode, errors.Fatal, []string{"Timed out waiting for the rollout of the pattern"}, []string{fmt.Sprintf("the components of the pattern were not ready after %s", timeout)}, []string{"The resources of the components take longer than the timeout to become ready", "The cluster does not have enough capacity to schedule the pods of the pattern"}, []string{"Wait longer with `--timeout`, or watch the rollout again with `mesheryctl pattern apply --watch`"})
+}
+
+func ErrLintFailed(errs int) error {
+	return errors.New(ErrLintFailedCode, errors.Fatal, []string{"The pattern has errors"}, []string{fmt.Sprintf("%d error(s) found while linting the pattern", errs)}, []string{"The pattern does not pass the validations Meshery Server runs before deploying it"}, []string{"Fix the errors reported by `mesheryctl pattern lint` and lint the pattern again"})
+}
+
+func ErrExecSession(err error) error {
+	return errors.New(ErrExecSessionCode, errors.Fatal, []string{"Unable to open a terminal in the deployed pattern"}, []string{err.Error()}, []string{"The deployed pattern, the pod or the container does not exist", "The credentials of the Kubernetes context of the pattern do not allow running commands in the pod", "Meshery Server is not reachable"}, []string{"Check the ID of the deployed pattern and the name and namespace of the pod", "Make sure Meshery Server is running with `mesheryctl system status`"})
+}
+
+func ErrExecExited(code int) error {
+	return errors.New(ErrExecExitedCode, errors.Fatal, []string{"The command failed in the container"}, []string{fmt.Sprintf("the command exited with code %d", code)}, []string{"The command did not exit successfully"}, []string{"Check the output of the command"})
+}
@@ -0,0 +1,195 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pattern
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+)
+
+var (
+	execPod       string
+	execNamespace string
+	execContainer string
+)
+
+// terminalMessage is a control message of the terminal session, the input and the output of the terminal are
+// sent as binary messages
+type terminalMessage struct {
+	Type string `json:"type"`
+	Cols uint16 `json:"cols,omitempty"`
+	Rows uint16 `json:"rows,omitempty"`
+	Code int    `json:"code"`
+	// Error is the reason why the command did not exit
+	Error string `json:"error,omitempty"`
+}
+
+var execCmd = &cobra.Command{
+	Use:   "exec [deployed-pattern-id] -- [command]",
+	Short: "Open a terminal in a container of a deployed pattern",
+	Long: `Run a command in a terminal in a container of a pod of a pattern deployed by Meshery, sh when no command is given,
+to debug the workloads of the pattern. The pod must be one of the resources of the pattern or be controlled by one,
+eg: a pod of one of its deployments, and the credentials of the Kubernetes context of the pattern must allow running
+commands in it. The command fails with the exit code of the command when it does not exit successfully.`,
+	Example: `
+// open a shell in a pod of a deployed pattern
+mesheryctl pattern exec 8f4c2b1e-3d5a-4e6f-9a7b-1c2d3e4f5a6b --pod productpage-v1-6b746f74dc-9r7kx -n bookinfo
+
+// run a command in a container of the pod
+mesheryctl pattern exec 8f4c2b1e-3d5a-4e6f-9a7b-1c2d3e4f5a6b --pod productpage-v1-6b746f74dc-9r7kx -n bookinfo -c istio-proxy -- pilot-agent request GET stats
+	`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+		execURL, err := patternExecURL(mctlCfg.GetBaseMesheryURL(), args[0], execPod, execNamespace, execContainer, args[1:])
+		if err != nil {
+			return ErrExecSession(err)
+		}
+		// the request carries the token of the current context
+		req, err := utils.NewRequest("GET", execURL, nil)
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+		conn, resp, err := websocket.DefaultDialer.Dial(execURL, req.Header)
+		if err != nil {
+			if resp != nil {
+				body, _ := io.ReadAll(resp.Body)
+				err = fmt.Errorf("server returned with status code %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+			}
+			return ErrExecSession(err)
+		}
+		defer conn.Close()
+
+		var size *terminalMessage
+		if fd := int(os.Stdin.Fd()); term.IsTerminal(fd) {
+			state, err := term.MakeRaw(fd)
+			if err != nil {
+				return ErrExecSession(err)
+			}
+			defer func() {
+				_ = term.Restore(fd, state)
+			}()
+			if cols, rows, err := term.GetSize(int(os.Stdout.Fd())); err == nil {
+				size = &terminalMessage{Type: "resize", Cols: uint16(cols), Rows: uint16(rows)}
+			}
+		}
+
+		exit, err := streamTerminal(conn, os.Stdin, os.Stdout, size)
+		if err != nil {
+			return ErrExecSession(err)
+		}
+		if exit.Error != "" {
+			return ErrExecSession(fmt.Errorf("%s", exit.Error))
+		}
+		if exit.Code != 0 {
+			return ErrExecExited(exit.Code)
+		}
+		return nil
+	},
+}
+
+// patternExecURL returns the URL of the WebSocket of the terminal session running the command in the pod of the deployed pattern
+func patternExecURL(baseURL, deployedPatternID, pod, namespace, container string, command []string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	default:
+		u.Scheme = "ws"
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/api/pattern/deployed/" + url.PathEscape(deployedPatternID) + "/exec"
+	query := url.Values{"pod": {pod}}
+	if namespace != "" {
+		query.Set("namespace", namespace)
+	}
+	if container != "" {
+		query.Set("container", container)
+	}
+	if len(command) > 0 {
+		query["command"] = command
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// streamTerminal sends the size of the terminal, if any, and the input read from stdin to the terminal session, and
+// writes the output of the session to stdout until the command ends. It returns the exit message of the session.
+func streamTerminal(conn *websocket.Conn, stdin io.Reader, stdout io.Writer, size *terminalMessage) (terminalMessage, error) {
+	if size != nil {
+		if err := conn.WriteJSON(size); err != nil {
+			return terminalMessage{}, err
+		}
+	}
+	go func() {
+		buf := make([]byte, 4096)
+		for {
+			n, err := stdin.Read(buf)
+			if n > 0 {
+				if werr := conn.WriteMessage(websocket.BinaryMessage, buf[:n]); werr != nil {
+					return
+				}
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		messageType, data, err := conn.ReadMessage()
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				return terminalMessage{Code: -1, Error: "the terminal session was closed before the command ended"}, nil
+			}
+			return terminalMessage{}, err
+		}
+		if messageType == websocket.BinaryMessage {
+			if _, err := stdout.Write(data); err != nil {
+				return terminalMessage{}, err
+			}
+			continue
+		}
+		var msg terminalMessage
+		if err := json.Unmarshal(data, &msg); err == nil && msg.Type == "exit" {
+			return msg, nil
+		}
+	}
+}
+
+func init() {
+	execCmd.Flags().StringVarP(&execPod, "pod", "p", "", "Name of the pod of the deployed pattern to run the command in")
+	execCmd.Flags().StringVarP(&execNamespace, "namespace", "n", "default", "Namespace of the pod")
+	execCmd.Flags().StringVarP(&execContainer, "container", "c", "", "Container of the pod to run the command in, the default container of the pod if omitted")
+	_ = execCmd.MarkFlagRequired("pod")
+}
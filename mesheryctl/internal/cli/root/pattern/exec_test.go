@@ -0,0 +1,99 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pattern
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestPatternExecURL(t *testing.T) {
+	tests := []struct {
+		baseURL   string
+		namespace string
+		container string
+		command   []string
+		want      string
+	}{
+		{"http://localhost:9081", "", "", nil, "ws://localhost:9081/api/pattern/deployed/8f4c2b1e/exec?pod=web-0"},
+		{"https://meshery.example.com/", "bookinfo", "istio-proxy", []string{"pilot-agent", "request", "GET", "stats"},
+			"wss://meshery.example.com/api/pattern/deployed/8f4c2b1e/exec?command=pilot-agent&command=request&command=GET&command=stats&container=istio-proxy&namespace=bookinfo&pod=web-0"},
+	}
+	for _, tt := range tests {
+		got, err := patternExecURL(tt.baseURL, "8f4c2b1e", "web-0", tt.namespace, tt.container, tt.command)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("patternExecURL(%q) = %s, want %s", tt.baseURL, got, tt.want)
+		}
+	}
+}
+
+func TestStreamTerminal(t *testing.T) {
+	resized := make(chan terminalMessage, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		// the server echoes the input in upper case until it reads exit
+		var input string
+		for !strings.Contains(input, "exit") {
+			messageType, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if messageType == websocket.TextMessage {
+				var msg terminalMessage
+				_ = json.Unmarshal(data, &msg)
+				resized <- msg
+				continue
+			}
+			input += string(data)
+			_ = conn.WriteMessage(websocket.BinaryMessage, bytes.ToUpper(data))
+		}
+		_ = conn.WriteJSON(terminalMessage{Type: "exit", Code: 3})
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	var stdout bytes.Buffer
+	exit, err := streamTerminal(conn, strings.NewReader("ls\nexit\n"), &stdout, &terminalMessage{Type: "resize", Cols: 120, Rows: 40})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exit.Code != 3 {
+		t.Errorf("exit code = %d, want 3", exit.Code)
+	}
+	if stdout.String() != "LS\nEXIT\n" {
+		t.Errorf("output = %q, want the echoed input", stdout.String())
+	}
+	if size := <-resized; size.Type != "resize" || size.Cols != 120 || size.Rows != 40 {
+		t.Errorf("size sent = %+v, want 120x40", size)
+	}
+}
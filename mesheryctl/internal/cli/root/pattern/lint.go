@@ -0,0 +1,156 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pattern
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var lintOutputFormatFlag string
+
+// the line of the errors of the YAML parser, eg: "yaml: line 4: mapping values are not allowed in this context"
+var yamlErrorLine = regexp.MustCompile(`line (\d+)`)
+
+var lintCmd = &cobra.Command{
+	Use:   "lint [pattern-file]",
+	Short: "Lint a pattern file",
+	Long: `Run against a pattern file the validations Meshery Server runs before deploying it, without deploying it.
+The settings of the components are validated against the schema of their registered definition, the relationships
+the pattern declares are checked against the registered relationships, and the pattern is evaluated against the
+relationship and the validation policies. Mistakes are reported as errors, the suggestions of the relationship
+policies as warnings. The command fails when the pattern has errors.
+With -o sarif, the diagnostics are printed as a SARIF log, which GitHub code scanning can upload.`,
+	Example: `
+// lint a pattern file
+mesheryctl pattern lint ./bookinfo.yaml
+
+// lint a design in CI and upload the diagnostics to GitHub code scanning
+mesheryctl design lint ./bookinfo.yaml -o sarif > design-lint.sarif
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if lintOutputFormatFlag != "" && lintOutputFormatFlag != "json" && lintOutputFormatFlag != "yaml" && lintOutputFormatFlag != "sarif" {
+			utils.Log.Error(utils.ErrOutFormatFlag())
+			return nil
+		}
+		content, err := os.ReadFile(args[0])
+		if err != nil {
+			utils.Log.Error(utils.ErrFileRead(err))
+			return nil
+		}
+
+		response, err := lintPattern(content)
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+
+		switch lintOutputFormatFlag {
+		case "":
+			printLintDiagnostics(response)
+		case "sarif":
+			out, _ := json.MarshalIndent(toSARIF(args[0], content, response.Diagnostics), "", "  ")
+			fmt.Println(string(out))
+		default:
+			out, _ := json.MarshalIndent(response, "", "  ")
+			if lintOutputFormatFlag == "yaml" {
+				out, _ = yaml.JSONToYAML(out)
+			}
+			fmt.Println(string(out))
+		}
+
+		if response.Errors > 0 {
+			return ErrLintFailed(response.Errors)
+		}
+		return nil
+	},
+}
+
+// lintPattern lints the pattern file with Meshery Server. Pattern files which cannot be parsed are reported without
+// reaching the server, as a diagnostic of the syntax rule.
+func lintPattern(content []byte) (models.PatternLintResponse, error) {
+	if _, err := core.NewPatternFile(content); err != nil {
+		return models.PatternLintResponse{
+			Errors: 1,
+			Diagnostics: []meshmodel.DesignLintDiagnostic{{
+				Rule:     meshmodel.DesignLintRuleSyntax,
+				Severity: meshmodel.DesignLintError,
+				Message:  err.Error(),
+			}},
+		}, nil
+	}
+
+	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+	if err != nil {
+		return models.PatternLintResponse{}, err
+	}
+	body, err := requestPatternAPI("POST", mctlCfg.GetBaseMesheryURL()+"/api/pattern/lint", bytes.NewBuffer(content))
+	if err != nil {
+		return models.PatternLintResponse{}, err
+	}
+	var response models.PatternLintResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return models.PatternLintResponse{}, utils.ErrUnmarshal(err)
+	}
+	return response, nil
+}
+
+// diagnosticLine returns the line of the pattern file the diagnostic is about, 1 when the whole pattern is
+func diagnosticLine(content []byte, d meshmodel.DesignLintDiagnostic) int {
+	if d.Rule == meshmodel.DesignLintRuleSyntax {
+		if m := yamlErrorLine.FindStringSubmatch(d.Message); m != nil {
+			if line, err := strconv.Atoi(m[1]); err == nil && line > 0 {
+				return line
+			}
+		}
+		return 1
+	}
+	return componentLine(content, d.Component)
+}
+
+func printLintDiagnostics(response models.PatternLintResponse) {
+	if len(response.Diagnostics) == 0 {
+		utils.Log.Info("No problems found in the pattern")
+		return
+	}
+	rows := make([][]string, 0, len(response.Diagnostics))
+	for _, d := range response.Diagnostics {
+		component := d.Component
+		if component == "" {
+			component = "-"
+		}
+		rows = append(rows, []string{component, d.Severity, d.Rule, d.Message})
+	}
+	utils.PrintToTable([]string{"COMPONENT", "SEVERITY", "RULE", "MESSAGE"}, rows)
+	utils.Log.Info(fmt.Sprintf("\n%d error(s), %d warning(s)", response.Errors, response.Warnings))
+}
+
+func init() {
+	lintCmd.Flags().StringVarP(&lintOutputFormatFlag, "output-format", "o", "", "(optional) format to display in [json|yaml|sarif]")
+}
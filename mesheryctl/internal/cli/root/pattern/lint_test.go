@@ -0,0 +1,81 @@
+package pattern
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshery/server/models/meshmodel"
+)
+
+const lintPatternFile = `name: web
+services:
+  # the frontend
+  web:
+    type: Deployment
+    settings:
+      db: {}
+  "db":
+    type: Pod
+`
+
+func TestComponentLine(t *testing.T) {
+	tests := []struct {
+		component string
+		want      int
+	}{
+		{"web", 4},
+		{"db", 8},
+		{"", 1},
+		{"cache", 1},
+	}
+	for _, tt := range tests {
+		if got := componentLine([]byte(lintPatternFile), tt.component); got != tt.want {
+			t.Errorf("componentLine(%q) = %d, want %d", tt.component, got, tt.want)
+		}
+	}
+}
+
+func TestToSARIF(t *testing.T) {
+	diagnostics := []meshmodel.DesignLintDiagnostic{
+		{Rule: meshmodel.DesignLintRulePolicy, Severity: meshmodel.DesignLintError, Message: "db: pods should be managed by a Deployment"},
+		{Component: "db", Rule: meshmodel.DesignLintRuleComponentSchema, Severity: meshmodel.DesignLintError, Message: "invalid settings"},
+		{Component: "web", Rule: meshmodel.DesignLintRulePolicySuggestion, Severity: meshmodel.DesignLintWarning, Message: "patch web"},
+	}
+	log := toSARIF("./designs/web.yaml", []byte(lintPatternFile), diagnostics)
+
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("toSARIF() = %+v, want a single SARIF 2.1.0 run", log)
+	}
+	type result struct {
+		rule, level, uri string
+		line             int
+	}
+	got := []result{}
+	for _, r := range log.Runs[0].Results {
+		loc := r.Locations[0].PhysicalLocation
+		got = append(got, result{r.RuleID, r.Level, loc.ArtifactLocation.URI, loc.Region.StartLine})
+	}
+	want := []result{
+		{meshmodel.DesignLintRulePolicy, "error", "designs/web.yaml", 1},
+		{meshmodel.DesignLintRuleComponentSchema, "error", "designs/web.yaml", 8},
+		{meshmodel.DesignLintRulePolicySuggestion, "warning", "designs/web.yaml", 4},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("results = %+v, want %+v", got, want)
+	}
+}
+
+func TestLintPatternSyntax(t *testing.T) {
+	// pattern files which cannot be parsed are reported without reaching Meshery Server
+	content := []byte("name: web\nservices:\n  web: [\n")
+	response, err := lintPattern(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.Errors != 1 || len(response.Diagnostics) != 1 || response.Diagnostics[0].Rule != meshmodel.DesignLintRuleSyntax {
+		t.Fatalf("lintPattern() = %+v, want a syntax error", response)
+	}
+	if line := diagnosticLine(content, response.Diagnostics[0]); line < 1 {
+		t.Errorf("diagnosticLine() = %d, want the line of the syntax error", line)
+	}
+}
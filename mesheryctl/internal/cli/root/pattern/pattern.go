@@ -29,9 +29,10 @@ var (
 
 // PatternCmd represents the root command for pattern commands
 var PatternCmd = &cobra.Command{
-	Use:   "pattern",
-	Short: "Cloud Native Patterns Management",
-	Long:  `Manage service meshes using predefined patterns`,
+	Use:     "pattern",
+	Aliases: []string{"design"},
+	Short:   "Cloud Native Patterns Management",
+	Long:    `Manage service meshes using predefined patterns`,
 	Example: `
 // Apply pattern file
 mesheryctl pattern apply --file [path to pattern file | URL of the file]
@@ -42,8 +43,17 @@ mesheryctl pattern delete --file [path to pattern file]
 // View pattern file
 mesheryctl pattern view [pattern name | ID]
 
+// Compare two patterns, local files or saved in Meshery
+mesheryctl pattern diff [pattern-file | pattern name | ID] [pattern-file | pattern name | ID]
+
 // List all patterns
 mesheryctl pattern list
+
+// Lint pattern file
+mesheryctl pattern lint [path to pattern file]
+
+// Open a terminal in a pod of a deployed pattern
+mesheryctl pattern exec [deployed pattern ID] --pod [pod] --namespace [namespace]
 	`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if len(args) == 0 {
@@ -59,6 +69,6 @@ mesheryctl pattern list
 func init() {
 	PatternCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token file default from current context")
 
-	availableSubcommands = []*cobra.Command{applyCmd, deleteCmd, viewCmd, listCmd}
+	availableSubcommands = []*cobra.Command{applyCmd, deleteCmd, viewCmd, listCmd, diffCmd, lintCmd, execCmd}
 	PatternCmd.AddCommand(availableSubcommands...)
 }
@@ -0,0 +1,166 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pattern
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/constants"
+	"github.com/layer5io/meshery/server/models/meshmodel"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+// the rules of the design linter, as reported in SARIF logs
+var lintRules = []sarifRule{
+	{ID: meshmodel.DesignLintRuleSyntax, ShortDescription: sarifMessage{Text: "The design file is a valid pattern file"}},
+	{ID: meshmodel.DesignLintRuleUnknownComponent, ShortDescription: sarifMessage{Text: "The components of the design are registered with Meshery"}},
+	{ID: meshmodel.DesignLintRuleComponentSchema, ShortDescription: sarifMessage{Text: "The settings of the components conform to the schema of their definition"}},
+	{ID: meshmodel.DesignLintRuleRelationship, ShortDescription: sarifMessage{Text: "The relationships declared by the design are allowed by the registered relationships"}},
+	{ID: meshmodel.DesignLintRulePolicy, ShortDescription: sarifMessage{Text: "The design violates none of the validation policies"}},
+	{ID: meshmodel.DesignLintRulePolicySuggestion, ShortDescription: sarifMessage{Text: "The design satisfies the relationship policies"}},
+}
+
+// sarifLog is a Static Analysis Results Interchange Format (SARIF) 2.1.0 log, the format GitHub code scanning uploads
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// toSARIF converts the diagnostics of the pattern file at the path to a SARIF log. The diagnostics of a component are
+// located at the line declaring the component, the ones of the whole pattern at the first line.
+func toSARIF(path string, content []byte, diagnostics []meshmodel.DesignLintDiagnostic) sarifLog {
+	uri := strings.TrimPrefix(filepath.ToSlash(filepath.Clean(path)), "./")
+	results := make([]sarifResult, 0, len(diagnostics))
+	for _, d := range diagnostics {
+		// the levels of SARIF are named like the severities of the linter
+		results = append(results, sarifResult{
+			RuleID:  d.Rule,
+			Level:   d.Severity,
+			Message: sarifMessage{Text: d.Message},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: uri},
+					Region:           sarifRegion{StartLine: diagnosticLine(content, d)},
+				},
+			}},
+		})
+	}
+	return sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "mesheryctl",
+				Version:        constants.GetMesheryctlVersion(),
+				InformationURI: "https://docs.meshery.io/reference/mesheryctl/pattern/lint",
+				Rules:          lintRules,
+			}},
+			Results: results,
+		}},
+	}
+}
+
+// componentLine returns the line number of the key of the component under the services of the pattern file, 1 when
+// the component is empty or not found
+func componentLine(content []byte, component string) int {
+	if component == "" {
+		return 1
+	}
+	servicesIndent, componentIndent := -1, -1
+	for i, line := range strings.Split(string(content), "\n") {
+		trimmed := strings.TrimLeft(line, " ")
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		indent := len(line) - len(trimmed)
+		if servicesIndent == -1 {
+			if strings.HasPrefix(trimmed, "services:") {
+				servicesIndent = indent
+			}
+			continue
+		}
+		if indent <= servicesIndent {
+			// past the services
+			return 1
+		}
+		// the components are the keys of the services, the deeper keys are their fields
+		if componentIndent == -1 {
+			componentIndent = indent
+		}
+		if indent != componentIndent {
+			continue
+		}
+		key, _, ok := strings.Cut(strings.TrimRight(trimmed, " \r"), ":")
+		if ok && strings.Trim(key, `"'`) == component {
+			return i + 1
+		}
+	}
+	return 1
+}
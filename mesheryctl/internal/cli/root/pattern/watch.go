@@ -0,0 +1,123 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pattern
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+// watchRollout prints the status of the components of the design whenever it changes, until all of them are ready,
+// one of them failed or the timeout elapsed, like kubectl rollout status. The rollout is watched in the Kubernetes
+// contexts with the IDs, in the default context of Meshery Server when there are none.
+func watchRollout(baseURL, patternFile string, contextIDs []string, timeout time.Duration) error {
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	rolloutURL := baseURL + "/api/pattern/rollout"
+	if len(contextIDs) > 0 {
+		rolloutURL += "?" + url.Values{"contexts": contextIDs}.Encode()
+	}
+	req, err := utils.NewRequest("POST", rolloutURL, bytes.NewBufferString(patternFile))
+	if err != nil {
+		return err
+	}
+	resp, err := utils.MakeRequest(req.WithContext(ctx))
+	if err != nil {
+		if ctx.Err() != nil {
+			return ErrRolloutTimeout(timeout)
+		}
+		return err
+	}
+	defer resp.Body.Close()
+
+	rollout, err := readRollout(resp.Body, printRolloutChanges())
+	if ctx.Err() != nil {
+		return ErrRolloutTimeout(timeout)
+	}
+	if err != nil {
+		return utils.ErrReadResponseBody(err)
+	}
+	switch rollout.Status {
+	case core.RolloutReady:
+		utils.Log.Info("design successfully rolled out")
+		return nil
+	case core.RolloutFailed:
+		return ErrRolloutFailed(failedComponents(rollout))
+	}
+	return ErrRolloutFailed(fmt.Errorf("the rollout status stream ended before the design converged"))
+}
+
+// readRollout reads the statuses of the rollout streamed as Server-Sent Events, passing each of them to onRollout,
+// and returns the last one
+func readRollout(r io.Reader, onRollout func(core.DesignRollout)) (core.DesignRollout, error) {
+	var rollout core.DesignRollout
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+		if !ok {
+			continue
+		}
+		if err := json.Unmarshal([]byte(data), &rollout); err != nil {
+			return rollout, utils.ErrUnmarshal(err)
+		}
+		onRollout(rollout)
+	}
+	return rollout, scanner.Err()
+}
+
+// printRolloutChanges returns a function printing the components whose status changed since the previous rollout
+func printRolloutChanges() func(core.DesignRollout) {
+	last := map[string]core.ComponentRollout{}
+	return func(rollout core.DesignRollout) {
+		for _, component := range rollout.Components {
+			key := component.ContextID + "/" + component.Component
+			if previous, ok := last[key]; ok && previous == component {
+				continue
+			}
+			last[key] = component
+			line := fmt.Sprintf("%s (%s/%s): %s", component.Component, strings.ToLower(component.Kind), component.Name, component.Status)
+			if component.Message != "" {
+				line += ", " + component.Message
+			}
+			utils.Log.Info(line)
+		}
+	}
+}
+
+func failedComponents(rollout core.DesignRollout) error {
+	failed := []string{}
+	for _, component := range rollout.Components {
+		if component.Status == core.RolloutFailed {
+			failed = append(failed, fmt.Sprintf("%s: %s", component.Component, component.Message))
+		}
+	}
+	return fmt.Errorf("%s", strings.Join(failed, "\n"))
+}
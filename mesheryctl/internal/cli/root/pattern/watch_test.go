@@ -0,0 +1,31 @@
+package pattern
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+func TestReadRollout(t *testing.T) {
+	stream := `data: {"status":"progressing","components":[{"component":"web","kind":"Deployment","name":"web","status":"progressing"}]}
+
+: keep-alive
+
+data: {"status":"ready","components":[{"component":"web","kind":"Deployment","name":"web","status":"ready"}]}
+
+`
+	var statuses []string
+	rollout, err := readRollout(strings.NewReader(stream), func(r core.DesignRollout) {
+		statuses = append(statuses, r.Status)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(statuses, ",") != "progressing,ready" {
+		t.Errorf("statuses = %v, want [progressing ready]", statuses)
+	}
+	if rollout.Status != core.RolloutReady || rollout.Components[0].Component != "web" {
+		t.Errorf("rollout = %+v, want the last status streamed", rollout)
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package perf
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/url"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// the p-value below which the difference of the mean latencies of two results is significant
+const significanceLevel = 0.05
+
+var (
+	compareThreshold float64
+	failOnRegression bool
+)
+
+// metricComparison is the change of a metric from the baseline to the candidate result
+type metricComparison struct {
+	Metric    string  `json:"metric"`
+	Baseline  float64 `json:"baseline"`
+	Candidate float64 `json:"candidate"`
+	Delta     float64 `json:"delta"`
+	// DeltaPercent is the change relative to the baseline, 0 when the baseline is 0
+	DeltaPercent float64 `json:"deltaPercent"`
+	// Regression tells if the metric got worse beyond the threshold
+	Regression bool `json:"regression"`
+}
+
+// significance is the outcome of Welch's t-test on the mean latencies of two results
+type significance struct {
+	T           float64 `json:"t"`
+	PValue      float64 `json:"pValue"`
+	Significant bool    `json:"significant"`
+}
+
+// resultComparison is the comparison of a candidate performance result with a baseline
+type resultComparison struct {
+	Baseline     string             `json:"baseline"`
+	Candidate    string             `json:"candidate"`
+	Threshold    float64            `json:"threshold"`
+	Latencies    []metricComparison `json:"latencies"`
+	Throughput   metricComparison   `json:"throughput"`
+	Significance significance       `json:"significance"`
+	// Regressions are the metrics which regressed, the latencies only when their difference is significant
+	Regressions []string `json:"regressions"`
+}
+
+var linkDocPerfCompare = map[string]string{
+	"link":    "![perf-compare-usage](/assets/img/mesheryctl/perf-compare.png)",
+	"caption": "Usage of mesheryctl perf compare",
+}
+
+var compareCmd = &cobra.Command{
+	Use:   "compare [baseline-result-id] [candidate-result-id]",
+	Short: "Compare two performance test results",
+	Long: `Compare the latencies and the throughput of a performance test result, the candidate, with the ones of another, the baseline.
+The difference of the mean latencies is tested for significance with Welch's t-test, and the metrics which got worse by more
+than the threshold are reported as regressions. Use --fail-on-regression to exit with an error on regressions, eg: in CI.`,
+	Args: cobra.ExactArgs(2),
+	Example: `
+// Compare two performance results
+mesheryctl perf compare 8a73e1f9-d7a7-4a11-b5b3-18cd3f5a6b7e 0d6a5ff1-74c4-44b1-8ba7-7a0bbf54a9b2
+
+// Compare in JSON, failing if the candidate regressed by more than 5%
+mesheryctl perf compare 8a73e1f9-d7a7-4a11-b5b3-18cd3f5a6b7e 0d6a5ff1-74c4-44b1-8ba7-7a0bbf54a9b2 --threshold 5 --fail-on-regression -o json
+`,
+	Annotations: linkDocPerfCompare,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// setting up for error formatting
+		cmdUsed = "compare"
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+
+		baseline, err := fetchPerformanceResult(mctlCfg.GetBaseMesheryURL(), args[0])
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+		candidate, err := fetchPerformanceResult(mctlCfg.GetBaseMesheryURL(), args[1])
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+
+		comparison := compareResults(baseline, candidate, compareThreshold)
+		comparison.Baseline, comparison.Candidate = args[0], args[1]
+
+		switch outputFormatFlag {
+		case "":
+			printComparison(comparison)
+		case "json", "yaml":
+			body, err := json.MarshalIndent(comparison, "", "  ")
+			if err != nil {
+				utils.Log.Error(ErrFailMarshal(err))
+				return nil
+			}
+			if outputFormatFlag == "yaml" {
+				if body, err = yaml.JSONToYAML(body); err != nil {
+					utils.Log.Error(ErrFailMarshal(err))
+					return nil
+				}
+			}
+			fmt.Println(string(body))
+		default:
+			utils.Log.Error(ErrInvalidOutputChoice())
+			return nil
+		}
+
+		if failOnRegression && len(comparison.Regressions) > 0 {
+			return ErrPerformanceRegression(comparison.Regressions)
+		}
+		return nil
+	},
+}
+
+// fetchPerformanceResult fetches the performance result with the histogram of its latencies
+func fetchPerformanceResult(baseURL, id string) (*models.PerformanceResult, error) {
+	req, err := utils.NewRequest("GET", baseURL+"/api/perf/profile/result/"+url.PathEscape(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := utils.MakeRequest(req)
+	if err != nil {
+		return nil, ErrPerformanceResult(id, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, utils.PerfError("failed to read response body"))
+	}
+	var result models.PerformanceResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, ErrFailUnmarshal(err)
+	}
+	if result.RunnerResults.DurationHistogram.Count == 0 {
+		return nil, ErrPerformanceResult(id, fmt.Errorf("the result has no latency histogram"))
+	}
+	return &result, nil
+}
+
+// compareResults compares the latencies, in milliseconds, and the throughput of the candidate with the ones of the
+// baseline. A latency regresses when it grows by more than threshold percent, the throughput when it drops by more.
+func compareResults(baseline, candidate *models.PerformanceResult, threshold float64) resultComparison {
+	b, c := baseline.RunnerResults.DurationHistogram, candidate.RunnerResults.DurationHistogram
+	comparison := resultComparison{
+		Threshold:   threshold,
+		Latencies:   []metricComparison{},
+		Regressions: []string{},
+	}
+
+	// the load generators record the latencies in seconds
+	latency := func(metric string, baseline, candidate float64) {
+		m := compareMetric(metric, baseline*1000, candidate*1000)
+		m.Regression = m.DeltaPercent > threshold
+		comparison.Latencies = append(comparison.Latencies, m)
+	}
+	latency("min", b.Min, c.Min)
+	latency("avg", b.Average, c.Average)
+	for _, bp := range b.Percentiles {
+		for _, cp := range c.Percentiles {
+			if bp.Percentile == cp.Percentile {
+				latency(fmt.Sprintf("p%g", bp.Percentile), bp.Value, cp.Value)
+			}
+		}
+	}
+	latency("max", b.Max, c.Max)
+
+	comparison.Throughput = compareMetric("qps", baseline.RunnerResults.QPS, candidate.RunnerResults.QPS)
+	comparison.Throughput.Regression = -comparison.Throughput.DeltaPercent > threshold
+
+	comparison.Significance = welchTTest(b.Average, b.StdDev, b.Count, c.Average, c.StdDev, c.Count)
+
+	// a single slow request makes the max regress, the latencies only count as regressions when the difference of
+	// their distributions is significant
+	if comparison.Significance.Significant {
+		for _, m := range comparison.Latencies {
+			if m.Regression {
+				comparison.Regressions = append(comparison.Regressions, m.Metric)
+			}
+		}
+	}
+	if comparison.Throughput.Regression {
+		comparison.Regressions = append(comparison.Regressions, comparison.Throughput.Metric)
+	}
+	return comparison
+}
+
+func compareMetric(metric string, baseline, candidate float64) metricComparison {
+	m := metricComparison{Metric: metric, Baseline: baseline, Candidate: candidate, Delta: candidate - baseline}
+	if baseline != 0 {
+		m.DeltaPercent = m.Delta / baseline * 100
+	}
+	return m
+}
+
+// welchTTest tests if the means of two samples of the sizes and standard deviations differ. The samples of load tests
+// being thousands of requests, the p-value is the one of the normal distribution the t distribution converges to.
+func welchTTest(mean1, stddev1 float64, n1 int64, mean2, stddev2 float64, n2 int64) significance {
+	if n1 < 2 || n2 < 2 {
+		return significance{PValue: 1}
+	}
+	stderr := math.Sqrt(stddev1*stddev1/float64(n1) + stddev2*stddev2/float64(n2))
+	if stderr == 0 {
+		if mean1 == mean2 {
+			return significance{PValue: 1}
+		}
+		// identical requests in both samples, t is infinite
+		return significance{Significant: true}
+	}
+	t := (mean2 - mean1) / stderr
+	p := math.Erfc(math.Abs(t) / math.Sqrt2)
+	return significance{T: t, PValue: p, Significant: p < significanceLevel}
+}
+
+func printComparison(comparison resultComparison) {
+	metrics := append(append([]metricComparison{}, comparison.Latencies...), comparison.Throughput)
+	data := make([][]string, 0, len(metrics))
+	for _, m := range metrics {
+		name := m.Metric
+		if m.Metric != comparison.Throughput.Metric {
+			name += " (ms)"
+		}
+		regression := ""
+		if m.Regression {
+			regression = "yes"
+		}
+		data = append(data, []string{name, fmt.Sprintf("%.3f", m.Baseline), fmt.Sprintf("%.3f", m.Candidate), fmt.Sprintf("%+.3f", m.Delta), fmt.Sprintf("%+.2f%%", m.DeltaPercent), regression})
+	}
+	utils.PrintToTable([]string{"METRIC", "BASELINE", "CANDIDATE", "DELTA", "DELTA %", "REGRESSION"}, data)
+
+	s := comparison.Significance
+	if s.Significant {
+		utils.Log.Info(fmt.Sprintf("\nThe difference of the mean latencies is significant (Welch's t-test, t=%.2f, p=%.4f)", s.T, s.PValue))
+	} else {
+		utils.Log.Info(fmt.Sprintf("\nThe difference of the mean latencies is not significant (Welch's t-test, t=%.2f, p=%.4f)", s.T, s.PValue))
+	}
+	if len(comparison.Regressions) > 0 {
+		utils.Log.Info(fmt.Sprintf("Regressions beyond %g%%: %v", comparison.Threshold, comparison.Regressions))
+	}
+}
+
+func init() {
+	compareCmd.Flags().Float64Var(&compareThreshold, "threshold", 10, "(optional) change in percent beyond which a metric regresses")
+	compareCmd.Flags().BoolVar(&failOnRegression, "fail-on-regression", false, "(optional) exit with an error if the candidate regressed")
+}
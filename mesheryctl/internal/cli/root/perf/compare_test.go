@@ -0,0 +1,83 @@
+package perf
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshery/server/models"
+)
+
+func performanceResult(t *testing.T, qps, avg, stddev, p99 float64, count int64) *models.PerformanceResult {
+	t.Helper()
+	body, err := json.Marshal(map[string]interface{}{
+		"runner_results": map[string]interface{}{
+			"ActualQPS": qps,
+			"DurationHistogram": map[string]interface{}{
+				"Count":  count,
+				"Min":    avg / 2,
+				"Avg":    avg,
+				"Max":    avg * 4,
+				"StdDev": stddev,
+				"Percentiles": []map[string]interface{}{
+					{"Percentile": 50, "Value": avg},
+					{"Percentile": 99, "Value": p99},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result models.PerformanceResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		t.Fatal(err)
+	}
+	return &result
+}
+
+func TestCompareResults(t *testing.T) {
+	baseline := performanceResult(t, 100, 0.010, 0.002, 0.020, 3000)
+
+	tests := []struct {
+		name             string
+		candidate        *models.PerformanceResult
+		wantSignificant  bool
+		wantRegressions  []string
+		wantP99DeltaPerc float64
+	}{
+		{"same result", performanceResult(t, 100, 0.010, 0.002, 0.020, 3000), false, []string{}, 0},
+		{"slower latencies", performanceResult(t, 100, 0.012, 0.002, 0.030, 3000), true, []string{"min", "avg", "p50", "p99", "max"}, 50},
+		{"noisy latencies", performanceResult(t, 100, 0.0102, 0.050, 0.030, 30), false, []string{}, 50},
+		{"lower throughput", performanceResult(t, 80, 0.010, 0.002, 0.020, 3000), false, []string{"qps"}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comparison := compareResults(baseline, tt.candidate, 10)
+			if comparison.Significance.Significant != tt.wantSignificant {
+				t.Errorf("significance = %+v, want significant %v", comparison.Significance, tt.wantSignificant)
+			}
+			if !reflect.DeepEqual(comparison.Regressions, tt.wantRegressions) {
+				t.Errorf("regressions = %v, want %v", comparison.Regressions, tt.wantRegressions)
+			}
+			if len(comparison.Latencies) != 5 {
+				t.Fatalf("latencies = %+v, want min, avg, p50, p99 and max", comparison.Latencies)
+			}
+			if p99 := comparison.Latencies[3]; p99.Metric != "p99" || p99.DeltaPercent != tt.wantP99DeltaPerc {
+				t.Errorf("p99 = %+v, want a change of %v%%", p99, tt.wantP99DeltaPerc)
+			}
+		})
+	}
+}
+
+func TestWelchTTest(t *testing.T) {
+	if s := welchTTest(1, 0, 10, 1, 0, 10); s.Significant || s.PValue != 1 {
+		t.Errorf("identical samples: %+v, want not significant", s)
+	}
+	if s := welchTTest(1, 0, 10, 2, 0, 10); !s.Significant {
+		t.Errorf("constant different samples: %+v, want significant", s)
+	}
+	if s := welchTTest(1, 1, 1, 2, 1, 1); s.Significant {
+		t.Errorf("single requests: %+v, want not significant", s)
+	}
+}
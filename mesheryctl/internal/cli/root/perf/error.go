@@ -2,6 +2,7 @@ package perf
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/layer5io/meshkit/errors"
 )
@@ -25,6 +26,8 @@ const (
 	ErrInvalidJSONFileCode          = "1130"
 	ErrHealthCheckerCode            = "1131"
 	ErrPerformanceProfileResultCode = "1132"
+	ErrPerformanceResultCode        = "1203"
+	ErrPerformanceRegressionCode    = "1204"
 )
 
 func ErrReadFilepath(err error) error {
@@ -117,6 +120,8 @@ func formatErrorWithReference() string {
 		return fmt.Sprintf("\nSee %s for usage details\n", baseURL+"/profile")
 	case "result":
 		return fmt.Sprintf("\nSee %s for usage details\n", baseURL+"/result")
+	case "compare":
+		return fmt.Sprintf("\nSee %s for usage details\n", baseURL+"/compare")
 	}
 	return fmt.Sprintf("\nSee %s for usage details\n", baseURL)
 }
@@ -154,3 +159,19 @@ func ErrPerformanceProfileResult(err error) error {
 		[]string{"Failed to fetch results for a specific profile"},
 		[]string{"Check your network connection and ensure Meshery is running .", formatErrorWithReference()})
 }
+
+func ErrPerformanceResult(id string, err error) error {
+	return errors.New(ErrPerformanceResultCode, errors.Alert,
+		[]string{"Unable to fetch performance result"},
+		[]string{fmt.Sprintf("Unable to fetch the performance result %s: %s", id, err.Error())},
+		[]string{"The result does not exist or it has no latency histogram", "Meshery Server is not reachable"},
+		[]string{"Ensure the ID is the one of a performance result, eg: listed by `mesheryctl perf result <profile-name> -o json`", formatErrorWithReference()})
+}
+
+func ErrPerformanceRegression(metrics []string) error {
+	return errors.New(ErrPerformanceRegressionCode, errors.Alert,
+		[]string{"Performance regression"},
+		[]string{"The candidate result regressed on " + strings.Join(metrics, ", ")},
+		[]string{"The latencies or the throughput of the candidate result are worse than the ones of the baseline beyond the threshold"},
+		[]string{"Investigate the changes measured by the candidate result, or raise the threshold with --threshold", formatErrorWithReference()})
+}
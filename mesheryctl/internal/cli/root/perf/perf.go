@@ -47,6 +47,9 @@ mesheryctl perf profile sam-test
 // List performance results
 mesheryctl perf result sam-test
 
+// Compare two performance results
+mesheryctl perf compare 8a73e1f9-d7a7-4a11-b5b3-18cd3f5a6b7e 0d6a5ff1-74c4-44b1-8ba7-7a0bbf54a9b2
+
 // Display Perf profile in JSON or YAML
 mesheryctl perf result -o json
 mesheryctl perf result -o yaml
@@ -70,7 +73,7 @@ mesheryctl perf result -o yaml
 			return cmd.Help()
 		}
 		if ok := utils.IsValidSubcommand(availableSubcommands, args[0]); !ok {
-			availableSubCmds := []string{"apply", "profile", "result"}
+			availableSubCmds := []string{"apply", "profile", "result", "compare"}
 
 			suggestedCmd := utils.FindClosestArg(args[0], availableSubCmds)
 			if suggestedCmd != "" && suggestedCmd[0] == args[0][0] {
@@ -87,6 +90,6 @@ func init() {
 	PerfCmd.PersistentFlags().StringVarP(&outputFormatFlag, "output-format", "o", "", "(optional) format to display in [json|yaml]")
 	PerfCmd.PersistentFlags().BoolVarP(&utils.SilentFlag, "yes", "y", false, "(optional) assume yes for user interactive prompts.")
 
-	availableSubcommands = []*cobra.Command{profileCmd, resultCmd, applyCmd}
+	availableSubcommands = []*cobra.Command{profileCmd, resultCmd, applyCmd, compareCmd}
 	PerfCmd.AddCommand(availableSubcommands...)
 }
@@ -0,0 +1,34 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshkit/errors"
+)
+
+const (
+	ErrExportRegistryCode = "1196"
+	ErrImportRegistryCode = "1197"
+)
+
+func ErrExportRegistry(err error) error {
+	return errors.New(ErrExportRegistryCode, errors.Alert, []string{"Unable to export the registry"}, []string{err.Error()}, []string{"Meshery Server is not reachable", "The bundle could not be written to the given file"}, []string{"Make sure Meshery Server is running with `mesheryctl system status`", "Check that the directory of the file exists and is writable"})
+}
+
+func ErrImportRegistry(failed, total int) error {
+	return errors.New(ErrImportRegistryCode, errors.Alert, []string{"Registry bundle partially imported"}, []string{fmt.Sprintf("%d of %d entities of the bundle could not be imported", failed, total)}, []string{"Some entities of the bundle are invalid or conflict with the registered ones"}, []string{"Check the errors reported for the entities which failed and import the bundle again"})
+}
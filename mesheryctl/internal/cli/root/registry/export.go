@@ -0,0 +1,111 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	exportFileFlag  string
+	exportModelFlag string
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the registry into a bundle",
+	Long: `Export every model, component and relationship registered with Meshery into a single gzip compressed tarball.
+The bundle is laid out as <model>/<version>/model.json, <model>/<version>/components and <model>/<version>/relationships,
+and is imported into other Meshery deployments with 'mesheryctl registry import'.`,
+	Example: `
+// export the registry into meshery-registry-<date>.tar.gz
+mesheryctl registry export
+
+// export the kubernetes model into a given file
+mesheryctl registry export --model kubernetes -f kubernetes.tar.gz
+	`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+
+		endpoint := mctlCfg.GetBaseMesheryURL() + "/api/meshmodels/export"
+		if exportModelFlag != "" {
+			endpoint += "?model=" + url.QueryEscape(exportModelFlag)
+		}
+		req, err := utils.NewRequest("GET", endpoint, nil)
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+		s := utils.CreateDefaultSpinner("Exporting the registry", "")
+		s.Start()
+		res, err := utils.MakeRequest(req)
+		if err != nil {
+			s.Stop()
+			utils.Log.Error(err)
+			return nil
+		}
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		s.Stop()
+		if err != nil {
+			utils.Log.Error(utils.ErrReadResponseBody(err))
+			return nil
+		}
+		if res.StatusCode != http.StatusOK {
+			utils.Log.Error(ErrExportRegistry(fmt.Errorf("server returned with status code %d: %s", res.StatusCode, string(body))))
+			return nil
+		}
+
+		file := exportFileFlag
+		if file == "" {
+			file = bundleFileName(res.Header.Get("Content-Disposition"))
+		}
+		if err := os.WriteFile(file, body, 0644); err != nil {
+			utils.Log.Error(ErrExportRegistry(err))
+			return nil
+		}
+		utils.Log.Info(fmt.Sprintf("Registry exported to %s", file))
+		return nil
+	},
+}
+
+// bundleFileName returns the file name Meshery Server suggests for the bundle, or a default one
+func bundleFileName(contentDisposition string) string {
+	if _, params, err := mime.ParseMediaType(contentDisposition); err == nil && params["filename"] != "" {
+		return params["filename"]
+	}
+	return "meshery-registry.tar.gz"
+}
+
+func init() {
+	exportCmd.Flags().StringVarP(&exportFileFlag, "file", "f", "", "(optional) file to write the bundle to, defaults to the name suggested by Meshery Server")
+	exportCmd.Flags().StringVarP(&exportModelFlag, "model", "m", "", "(optional) export only the entities of the model")
+	_ = exportCmd.RegisterFlagCompletionFunc("model", utils.CompleteModelNames)
+}
@@ -0,0 +1,15 @@
+package registry
+
+import "testing"
+
+func TestBundleFileName(t *testing.T) {
+	for header, want := range map[string]string{
+		`attachment; filename="meshery-registry-20231016.tar.gz"`: "meshery-registry-20231016.tar.gz",
+		"attachment": "meshery-registry.tar.gz",
+		"":           "meshery-registry.tar.gz",
+	} {
+		if got := bundleFileName(header); got != want {
+			t.Errorf("bundleFileName(%q) = %s, want %s", header, got, want)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import [path to bundle]",
+	Short: "Import a registry bundle",
+	Long: `Import a bundle exported with 'mesheryctl registry export' into Meshery.
+The entities of the bundle which are not registered are added, the registered components and relationships which differ
+from those of the bundle are updated and the others are skipped. The outcome of the import of every entity is reported.`,
+	Example: `
+// import a registry bundle
+mesheryctl registry import meshery-registry-20231016.tar.gz
+
+// import a registry bundle and print the outcome of the import of every entity as JSON
+mesheryctl registry import meshery-registry-20231016.tar.gz -o json
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if outputFormatFlag != "" && outputFormatFlag != "json" && outputFormatFlag != "yaml" {
+			utils.Log.Error(utils.ErrOutFormatFlag())
+			return nil
+		}
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+
+		bundle, err := os.Open(args[0])
+		if err != nil {
+			utils.Log.Error(utils.ErrFileRead(err))
+			return nil
+		}
+		defer bundle.Close()
+		req, err := utils.NewRequest("POST", mctlCfg.GetBaseMesheryURL()+"/api/meshmodels/import", bundle)
+		if err != nil {
+			utils.Log.Error(err)
+			return nil
+		}
+		req.Header.Set("Content-Type", "application/gzip")
+
+		s := utils.CreateDefaultSpinner("Importing the registry bundle", "")
+		s.Start()
+		res, err := utils.MakeRequest(req)
+		if err != nil {
+			s.Stop()
+			utils.Log.Error(err)
+			return nil
+		}
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		s.Stop()
+		if err != nil {
+			utils.Log.Error(utils.ErrReadResponseBody(err))
+			return nil
+		}
+		if res.StatusCode != http.StatusOK {
+			utils.Log.Error(fmt.Errorf("server returned with status code %d: %s", res.StatusCode, string(body)))
+			return nil
+		}
+		var report meshmodel.RegistryImportReport
+		if err := json.Unmarshal(body, &report); err != nil {
+			utils.Log.Error(utils.ErrUnmarshal(err))
+			return nil
+		}
+
+		if outputFormatFlag != "" {
+			out, _ := json.MarshalIndent(report, "", "  ")
+			if outputFormatFlag == "yaml" {
+				out, _ = yaml.JSONToYAML(out)
+			}
+			utils.Log.Info(string(out))
+		} else {
+			printImportReport(report)
+		}
+		if report.Failed > 0 {
+			return ErrImportRegistry(report.Failed, len(report.Results))
+		}
+		return nil
+	},
+}
+
+// printImportReport prints the entities which were added, updated or failed to import, the skipped ones are only counted
+func printImportReport(report meshmodel.RegistryImportReport) {
+	rows := [][]string{}
+	for _, r := range report.Results {
+		if r.Status == meshmodel.BundleEntitySkipped {
+			continue
+		}
+		rows = append(rows, []string{r.EntityType, r.Model, r.Version, r.Kind, r.Status, r.Error})
+	}
+	if len(rows) > 0 {
+		utils.PrintToTable([]string{"TYPE", "MODEL", "VERSION", "KIND", "STATUS", "ERROR"}, rows)
+		utils.Log.Info("")
+	}
+	utils.Log.Info(fmt.Sprintf("%d added, %d updated, %d skipped, %d failed", report.Added, report.Updated, report.Skipped, report.Failed))
+}
+
+func init() {
+	importCmd.Flags().StringVarP(&outputFormatFlag, "output-format", "o", "", "(optional) format to display in [json|yaml]")
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	availableSubcommands []*cobra.Command
+	outputFormatFlag     string
+)
+
+// RegistryCmd represents the root command for registry commands
+var RegistryCmd = &cobra.Command{
+	Use:   "registry",
+	Short: "Meshery Registry Management",
+	Long: `Move the models, components and relationships registered with Meshery between Meshery deployments.
+The registry is exported as a single bundle, which can be imported into deployments without access to the internet.`,
+	Example: `
+// Export the registry into a bundle
+mesheryctl registry export
+
+// Import a registry bundle
+mesheryctl registry import [path to bundle]
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return cmd.Help()
+		}
+		if ok := utils.IsValidSubcommand(availableSubcommands, args[0]); !ok {
+			return errors.New(utils.RegistryError(fmt.Sprintf("'%s' is a invalid command.  Use 'mesheryctl registry --help' to display usage guide.\n", args[0])))
+		}
+		return nil
+	},
+}
+
+func init() {
+	RegistryCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token file default from current context")
+
+	availableSubcommands = []*cobra.Command{exportCmd, importCmd}
+	RegistryCmd.AddCommand(availableSubcommands...)
+}
@@ -21,10 +21,13 @@ import (
 
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/app"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/experimental"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/filter"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/mesh"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/model"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/pattern"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/perf"
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/registry"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/system"
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
 	log "github.com/sirupsen/logrus"
@@ -108,8 +111,10 @@ func init() {
 		perf.PerfCmd,
 		mesh.MeshCmd,
 		app.AppCmd,
-		// experimental.ExpCmd,
+		experimental.ExpCmd,
 		filter.FilterCmd,
+		model.ModelCmd,
+		registry.RegistryCmd,
 	}
 
 	RootCmd.AddCommand(availableSubcommands...)
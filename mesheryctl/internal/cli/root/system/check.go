@@ -138,6 +138,10 @@ mesheryctl system check --adapters
 
 // Verify the health of Meshery Operator's deployment with MeshSync and Broker
 mesheryctl system check --operator
+
+// Check the Kubernetes clusters of several contexts of the kubeconfig, or of all of them, in parallel
+mesheryctl system check --k8s-context kind-dev,gke-prod
+mesheryctl system check --k8s-context all
 	`,
 	Annotations: linkDocCheck,
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -152,6 +156,11 @@ mesheryctl system check --operator
 			return nil
 		}
 
+		// if --k8s-context has been passed we check the clusters of the contexts in parallel
+		if len(checkK8sContexts) > 0 {
+			return runKubernetesChecksInContexts(checkK8sContexts)
+		}
+
 		// if --pre or --preflight has been passed we run preflight checks
 		if pre || preflight {
 			// Run preflight checks
@@ -705,4 +714,5 @@ func init() {
 	checkCmd.Flags().BoolVarP(&adaptersFlag, "adapters", "", false, "Check status of meshery adapters")
 	checkCmd.Flags().StringVarP(&adapter, "adapter", "", "", "Check status of specified meshery adapter")
 	checkCmd.Flags().BoolVarP(&operatorsFlag, "operator", "", false, "Verify the health of Meshery Operator's deployment with MeshSync and Broker")
+	checkCmd.Flags().StringSliceVar(&checkK8sContexts, "k8s-context", nil, "Check the Kubernetes clusters of the contexts of the kubeconfig, or of all of them, in parallel")
 }
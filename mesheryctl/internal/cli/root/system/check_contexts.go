@@ -0,0 +1,88 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package system
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// the time a cluster has to answer each of the queries of the checks
+const contextCheckTimeout = 10 * time.Second
+
+// the contexts of the kubeconfig whose clusters are checked
+var checkK8sContexts []string
+
+// runKubernetesChecksInContexts checks in parallel that the clusters of the contexts of the kubeconfig, all of them
+// when the only name is "all", can be queried and run a version of Kubernetes supported by Meshery
+func runKubernetesChecksInContexts(names []string) error {
+	kubeconfig, err := clientcmd.NewDefaultClientConfigLoadingRules().Load()
+	if err != nil {
+		return ErrK8sConfig(err)
+	}
+	if len(names) == 1 && names[0] == "all" {
+		names = make([]string, 0, len(kubeconfig.Contexts))
+		for name := range kubeconfig.Contexts {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	for _, name := range names {
+		if _, ok := kubeconfig.Contexts[name]; !ok {
+			return utils.ErrK8sContextNotFound(name)
+		}
+	}
+
+	results := utils.RunInContexts(names, func(name string) (string, error) {
+		return checkKubernetesContext(kubeconfig, name)
+	})
+	if failed := utils.PrintContextResults(results); failed > 0 {
+		return utils.ErrFailedInContexts(failed, len(results))
+	}
+	return nil
+}
+
+// checkKubernetesContext runs the Kubernetes API and version checks against the cluster of the context
+func checkKubernetesContext(kubeconfig *clientcmdapi.Config, name string) (string, error) {
+	restConfig, err := clientcmd.NewNonInteractiveClientConfig(*kubeconfig, name, &clientcmd.ConfigOverrides{}, nil).ClientConfig()
+	if err != nil {
+		return "", ErrK8sConfig(err)
+	}
+	restConfig.Timeout = contextCheckTimeout
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return "", ErrK8sConfig(err)
+	}
+
+	if _, err := client.CoreV1().Pods("").List(context.TODO(), v1.ListOptions{Limit: 1}); err != nil {
+		return "", ErrK8SQuery(err)
+	}
+	info, err := client.Discovery().ServerVersion()
+	if err != nil {
+		return "", ErrK8SQuery(err)
+	}
+	if err := utils.CheckK8sVersion(info); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("can query the Kubernetes API, running Kubernetes %s", info.GitVersion), nil
+}
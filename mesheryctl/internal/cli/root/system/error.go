@@ -55,6 +55,7 @@ const (
 	ErrValidProviderCode                 = "1160"
 	ErrUnmarshallConfigCode              = "1161"
 	ErrUploadFileParamsCode              = "1162"
+	ErrServerHealthCode                  = "1205"
 )
 
 var (
@@ -337,3 +338,13 @@ func ErrUploadFileParams(err error) error {
 		[]string{"Ensure you have a strong network connection and the right configuration set in your Meshconfig file." + FormatErrorReference()})
 
 }
+
+func ErrServerHealth(err error) error {
+	return errors.New(
+		ErrServerHealthCode,
+		errors.Alert,
+		[]string{"Unable to get the health of Meshery Server"},
+		[]string{err.Error()},
+		[]string{"Meshery Server is not reachable", "The version of Meshery Server does not report the health of its subsystems"},
+		[]string{"Ensure Meshery is running and up to date with `mesheryctl system status` and `mesheryctl system update`." + FormatErrorReference()})
+}
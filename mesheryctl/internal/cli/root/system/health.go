@@ -0,0 +1,101 @@
+// Copyright 2023 Layer5, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package system
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+)
+
+// the order the subsystems of Meshery Server are listed in, the ones not listed come last
+var healthSubsystems = []string{"database", "provider", "adapter", "broker", "kubernetes"}
+
+// serverHealth is the readiness report of Meshery Server, as returned by GET /readyz
+type serverHealth struct {
+	// Status is pass, warn or fail, fail as soon as one of the checks fails
+	Status string        `json:"status"`
+	Checks []healthCheck `json:"checks"`
+}
+
+// healthCheck is the outcome of a check of a subsystem of Meshery Server
+type healthCheck struct {
+	Name      string  `json:"name"`
+	Subsystem string  `json:"subsystem"`
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latencyMs"`
+	Output    string  `json:"output,omitempty"`
+}
+
+// fetchServerHealth fetches the readiness report of the Meshery Server at baseURL
+func fetchServerHealth(baseURL string) (*serverHealth, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Get(baseURL + "/readyz")
+	if err != nil {
+		return nil, ErrServerHealth(err)
+	}
+	defer resp.Body.Close()
+
+	// the server answers 503 with the report when one of the checks fails
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusServiceUnavailable:
+	case http.StatusNotFound:
+		return nil, ErrServerHealth(fmt.Errorf("the version of Meshery Server does not report the health of its subsystems, update it with `mesheryctl system update`"))
+	default:
+		return nil, ErrServerHealth(utils.ErrFailReqStatus(resp.StatusCode))
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ErrServerHealth(err)
+	}
+	health := &serverHealth{}
+	if err := json.Unmarshal(data, health); err != nil {
+		return nil, ErrServerHealth(err)
+	}
+	return health, nil
+}
+
+// printServerHealth prints the health of the subsystems of Meshery Server grouped by subsystem
+func printServerHealth(health *serverHealth) {
+	rank := func(subsystem string) int {
+		for i, s := range healthSubsystems {
+			if s == subsystem {
+				return i
+			}
+		}
+		return len(healthSubsystems)
+	}
+	checks := append([]healthCheck{}, health.Checks...)
+	sort.SliceStable(checks, func(i, j int) bool {
+		if rank(checks[i].Subsystem) != rank(checks[j].Subsystem) {
+			return rank(checks[i].Subsystem) < rank(checks[j].Subsystem)
+		}
+		return checks[i].Name < checks[j].Name
+	})
+
+	var data [][]string
+	for _, check := range checks {
+		data = append(data, []string{check.Subsystem, check.Name, strings.ToUpper(check.Status), fmt.Sprintf("%.2fms", check.LatencyMs), check.Output})
+	}
+	utils.Log.Info(fmt.Sprintf("\nMeshery Server health: %s", strings.ToUpper(health.Status)))
+	utils.PrintToTable([]string{"Subsystem", "Name", "Status", "Latency", "Message"}, data)
+}
@@ -0,0 +1,43 @@
+package system
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+)
+
+func TestFetchServerHealth(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		wantStatus string
+		wantChecks int
+		wantErr    bool
+	}{
+		{"ready", http.StatusOK, `{"status": "pass", "checks": [{"name": "database", "subsystem": "database", "status": "pass", "latencyMs": 0.4}]}`, "pass", 1, false},
+		{"not ready", http.StatusServiceUnavailable, `{"status": "fail", "checks": [{"name": "database", "subsystem": "database", "status": "pass"}, {"name": "nats", "subsystem": "broker", "status": "fail", "output": "not connected"}]}`, "fail", 2, false},
+		{"older server", http.StatusNotFound, "404 page not found", "", 0, true},
+		{"invalid report", http.StatusOK, "ok", "", 0, true},
+	}
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	baseURL := "http://localhost:9081"
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpmock.RegisterResponder("GET", baseURL+"/readyz", httpmock.NewStringResponder(tt.statusCode, tt.body))
+
+			health, err := fetchServerHealth(baseURL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("fetchServerHealth() error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if health.Status != tt.wantStatus || len(health.Checks) != tt.wantChecks {
+				t.Errorf("fetchServerHealth() = %+v, want status %s with %d checks", health, tt.wantStatus, tt.wantChecks)
+			}
+		})
+	}
+}
@@ -42,12 +42,12 @@ var linkDocStatus = map[string]string{
 var statusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Check Meshery status",
-	Long:  `Check status of Meshery and Meshery components.`,
+	Long:  `Check status of Meshery and Meshery components. With --verbose, the health of the subsystems of Meshery Server is checked too.`,
 	Example: `
 // Check status of Meshery, Meshery adapters, Meshery Operator and its controllers.
 mesheryctl system status
 
-// (optional) Extra data in status table
+// (optional) Extra data in status table, and the health of the subsystems of Meshery Server
 mesheryctl system status --verbose
 	`,
 	Annotations: linkDocStatus,
@@ -208,10 +208,19 @@ mesheryctl system status --verbose
 
 			log.Info("\nMeshery endpoint is " + currCtx.GetEndpoint())
 		}
+
+		if verboseStatus {
+			health, err := fetchServerHealth(mctlCfg.GetBaseMesheryURL())
+			if err != nil {
+				utils.Log.Error(err)
+				return nil
+			}
+			printServerHealth(health)
+		}
 		return nil
 	},
 }
 
 func init() {
-	statusCmd.Flags().BoolVarP(&verboseStatus, "verbose", "v", false, "(optional) Extra data in status table")
+	statusCmd.Flags().BoolVarP(&verboseStatus, "verbose", "v", false, "(optional) Extra data in status table, and the health of the subsystems of Meshery Server: database, providers, adapters, broker and Kubernetes contexts")
 }
@@ -21,6 +21,10 @@ import (
 	"github.com/spf13/viper"
 )
 
+// APITokenEnv is the environment variable holding the API token the requests to Meshery Server are authenticated with,
+// in place of the token file of the current context
+const APITokenEnv = "MESHERY_API_TOKEN"
+
 type Provider struct {
 	ProviderURL  string `json:"provider_url,omitempty"`
 	ProviderName string `json:"provider_name,omitempty"`
@@ -35,6 +39,12 @@ func NewRequest(method string, url string, body io.Reader) (*http.Request, error
 		return nil, ErrCreatingRequest(err)
 	}
 
+	// an API token of Meshery Server authenticates the requests without a token file, eg: in CI systems
+	if apiToken := os.Getenv(APITokenEnv); apiToken != "" {
+		req.Header.Set("Authorization", "Bearer "+apiToken)
+		return req, nil
+	}
+
 	// Grab token from the flag --token
 	tokenPath := TokenFlag
 	if tokenPath == "" { // token was not passed with the flag
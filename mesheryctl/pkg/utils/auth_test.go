@@ -61,5 +61,16 @@ func TestAuth(t *testing.T) {
 			t.Fatal(err)
 		}
 	})
+
+	t.Run("NewRequestWithAPIToken", func(t *testing.T) {
+		t.Setenv(APITokenEnv, "mshy_token")
+		req, err := NewRequest("GET", server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := req.Header.Get("Authorization"); got != "Bearer mshy_token" {
+			t.Errorf("Authorization = %q, want %q", got, "Bearer mshy_token")
+		}
+	})
 	//@Aisuko Need a token file to do other testings
 }
@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// The shell waits for the completions, the requests for the names of the registry give up after this long
+const completionTimeout = 3 * time.Second
+
+// ModelNames returns the names of the models registered with Meshery
+func ModelNames() ([]string, error) {
+	var response models.MeshmodelsAPIResponse
+	if err := getRegistry("/api/meshmodels/models", url.Values{"pagesize": {"all"}}, &response); err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(response.Models))
+	for _, model := range response.Models {
+		names = append(names, model.Name)
+	}
+	return uniqueSorted(names), nil
+}
+
+// ComponentNames returns the kinds of the components of the model registered with Meshery, of every model when model is empty
+func ComponentNames(model string) ([]string, error) {
+	path := "/api/meshmodels/components"
+	if model != "" {
+		path = "/api/meshmodels/models/" + url.PathEscape(model) + "/components"
+	}
+	var response models.MeshmodelComponentsAPIResponse
+	if err := getRegistry(path, url.Values{"pagesize": {"all"}, "trim": {"true"}}, &response); err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(response.Components))
+	for _, component := range response.Components {
+		names = append(names, component.Kind)
+	}
+	return uniqueSorted(names), nil
+}
+
+// RelationshipKinds returns the kinds of the relationships of the model registered with Meshery, of every model when model is empty
+func RelationshipKinds(model string) ([]string, error) {
+	path := "/api/meshmodels/relationships"
+	if model != "" {
+		path = "/api/meshmodels/models/" + url.PathEscape(model) + "/relationships"
+	}
+	var response models.MeshmodelRelationshipsAPIResponse
+	if err := getRegistry(path, url.Values{"pagesize": {"all"}}, &response); err != nil {
+		return nil, err
+	}
+	kinds := make([]string, 0, len(response.Relationships))
+	for _, rel := range response.Relationships {
+		kinds = append(kinds, rel.Kind)
+	}
+	return uniqueSorted(kinds), nil
+}
+
+// CompleteModelNames completes the names of the registered models, for the arguments and the flags naming a model
+func CompleteModelNames(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	names, err := ModelNames()
+	return completions(names, toComplete, err)
+}
+
+// CompleteComponentNames returns a completion function of the kinds of the components of the model model returns,
+// eg: the value of a --model flag, which is only known once the command line is parsed
+func CompleteComponentNames(model func() string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		names, err := ComponentNames(model())
+		return completions(names, toComplete, err)
+	}
+}
+
+// CompleteRelationshipKinds returns a completion function of the kinds of the relationships of the model model returns
+func CompleteRelationshipKinds(model func() string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		kinds, err := RelationshipKinds(model())
+		return completions(kinds, toComplete, err)
+	}
+}
+
+// IsInteractive tells if mesheryctl reads from a terminal, so that the user can be prompted for the missing arguments
+func IsInteractive() bool {
+	info, err := os.Stdin.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// FuzzySelect prompts the user to select one of the items, narrowing them down as the user types a fuzzy search
+func FuzzySelect(label string, items []string) (string, error) {
+	if len(items) == 0 {
+		return "", fmt.Errorf("no %s to select from", strings.ToLower(label))
+	}
+	prompt := promptui.Select{
+		Label: label,
+		Items: items,
+		Size:  10,
+		Searcher: func(input string, index int) bool {
+			return FuzzyMatch(input, items[index])
+		},
+		StartInSearchMode: true,
+	}
+	_, result, err := prompt.Run()
+	return result, err
+}
+
+// FuzzyMatch tells if the characters of the term, except spaces, appear in the candidate in the same order, case insensitively
+func FuzzyMatch(term, candidate string) bool {
+	candidate = strings.ToLower(candidate)
+	for _, c := range strings.ToLower(strings.ReplaceAll(term, " ", "")) {
+		i := strings.IndexRune(candidate, c)
+		if i == -1 {
+			return false
+		}
+		candidate = candidate[i+len(string(c)):]
+	}
+	return true
+}
+
+// getRegistry decodes the response of the registry API of Meshery Server at the path into response
+func getRegistry(path string, query url.Values, response interface{}) error {
+	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+	if err != nil {
+		return err
+	}
+	req, err := NewRequest("GET", mctlCfg.GetBaseMesheryURL()+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+	resp, err := MakeRequest(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ErrReadResponseBody(err)
+	}
+	if err := json.Unmarshal(body, response); err != nil {
+		return ErrUnmarshal(err)
+	}
+	return nil
+}
+
+// completions returns the names starting with toComplete, without falling back to the completion of file names
+func completions(names []string, toComplete string, err error) ([]string, cobra.ShellCompDirective) {
+	if err != nil {
+		cobra.CompDebugln(err.Error(), false)
+		return nil, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveError
+	}
+	matches := []string{}
+	for _, name := range names {
+		if strings.HasPrefix(strings.ToLower(name), strings.ToLower(toComplete)) {
+			matches = append(matches, name)
+		}
+	}
+	return matches, cobra.ShellCompDirectiveNoFileComp
+}
+
+func uniqueSorted(names []string) []string {
+	seen := make(map[string]bool, len(names))
+	unique := make([]string, 0, len(names))
+	for _, name := range names {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			unique = append(unique, name)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
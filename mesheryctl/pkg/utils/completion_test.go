@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	for _, tt := range []struct {
+		term, candidate string
+		want            bool
+	}{
+		{"", "kubernetes", true},
+		{"kube", "kubernetes", true},
+		{"k8s", "kubernetes", false},
+		{"kbnts", "kubernetes", true},
+		{"IST", "istio-base", true},
+		{"istio base", "istio-base", true},
+		{"baseistio", "istio-base", false},
+	} {
+		if got := FuzzyMatch(tt.term, tt.candidate); got != tt.want {
+			t.Errorf("FuzzyMatch(%q, %q) = %v, want %v", tt.term, tt.candidate, got, tt.want)
+		}
+	}
+}
+
+func TestCompletions(t *testing.T) {
+	names := uniqueSorted([]string{"kubernetes", "istio-base", "Kuma", "kubernetes", ""})
+	if want := []string{"Kuma", "istio-base", "kubernetes"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("uniqueSorted() = %v, want %v", names, want)
+	}
+
+	got, directive := completions(names, "ku", nil)
+	if want := []string{"Kuma", "kubernetes"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("completions() = %v, want %v", got, want)
+	}
+	if directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Errorf("directive = %v, want no file completion", directive)
+	}
+}
@@ -36,6 +36,8 @@ var (
 	ErrParseGithubFileCode    = "1185"
 	ErrReadTokenCode          = "1186"
 	ErrRequestResponseCode    = "1187"
+	ErrK8sContextNotFoundCode = "1206"
+	ErrFailedInContextsCode   = "1207"
 )
 
 // RootError returns a formatted error message with a link to 'root' command usage page at
@@ -183,6 +185,16 @@ func AppViewError(msg string) string {
 	return formatError(msg, cmdAppView)
 }
 
+// ModelError returns a formatted error message with a link to 'model' command usage page in addition to the error message
+func ModelError(msg string) string {
+	return formatError(msg, cmdModel)
+}
+
+// RegistryError returns a formatted error message with a link to 'registry' command usage page in addition to the error message
+func RegistryError(msg string) string {
+	return formatError(msg, cmdRegistry)
+}
+
 // formatError returns a formatted error message with a link to the meshery command URL
 func formatError(msg string, cmd cmdType) string {
 	switch cmd {
@@ -254,6 +266,10 @@ func formatError(msg string, cmd cmdType) string {
 		return fmt.Sprintf("%s\nSee %s for usage details\n", msg, providerUsageURL)
 	case cmdToken:
 		return fmt.Sprintf("%s\nSee %s for usage details\n", msg, tokenUsageURL)
+	case cmdModel:
+		return fmt.Sprintf("%s\nSee %s for usage details\n", msg, modelUsageURL)
+	case cmdRegistry:
+		return fmt.Sprintf("%s\nSee %s for usage details\n", msg, registryUsageURL)
 	default:
 		return fmt.Sprintf("%s\n", msg)
 	}
@@ -457,3 +473,19 @@ func ErrRequestResponse(err error) error {
 		[]string{"Error occurred while generating a response"},
 		[]string{"Check your network connection and the status of Meshery Server via `mesheryctl system status`."})
 }
+
+func ErrK8sContextNotFound(name string) error {
+	return errors.New(ErrK8sContextNotFoundCode, errors.Alert,
+		[]string{"Kubernetes context not found"},
+		[]string{fmt.Sprintf("No Kubernetes context named %s or with the ID %s", name, name)},
+		[]string{"The Kubernetes context is not connected to Meshery or is not in the kubeconfig"},
+		[]string{"List the Kubernetes contexts connected to Meshery with `mesheryctl system config`, or pass `all` to select all of them."})
+}
+
+func ErrFailedInContexts(failed, total int) error {
+	return errors.New(ErrFailedInContextsCode, errors.Alert,
+		[]string{"Failed in Kubernetes contexts"},
+		[]string{fmt.Sprintf("The operation failed in %d of %d Kubernetes contexts", failed, total)},
+		[]string{"The clusters of the contexts are not reachable or rejected the operation"},
+		[]string{"See the summary for the error of each context, and retry with `--k8s-context` set to the failed ones."})
+}
@@ -82,6 +82,8 @@ const (
 	providerResetURL  = docsBaseURL + "reference/mesheryctl/system/provider/reset"
 	providerSwitchURL = docsBaseURL + "reference/mesheryctl/system/provider/switch"
 	tokenUsageURL     = docsBaseURL + "reference/mesheryctl/system/token"
+	modelUsageURL     = docsBaseURL + "reference/mesheryctl/model"
+	registryUsageURL  = docsBaseURL + "reference/mesheryctl/registry"
 
 	// Meshery Server Location
 	EndpointProtocol = "http"
@@ -124,6 +126,8 @@ const (
 	cmdProviderList   cmdType = "provider list"
 	cmdProviderReset  cmdType = "provider reset"
 	cmdToken          cmdType = "token"
+	cmdModel          cmdType = "model"
+	cmdRegistry       cmdType = "registry"
 )
 
 const (
@@ -0,0 +1,136 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/layer5io/meshery/server/models"
+)
+
+// MaxParallelContexts is the number of Kubernetes contexts the commands acting on several contexts act on at once
+const MaxParallelContexts = 8
+
+// the number of Kubernetes contexts fetched from Meshery Server per request
+const k8sContextsPageSize = 100
+
+// ContextResult is the outcome of an operation in a Kubernetes context
+type ContextResult struct {
+	Context  string
+	Message  string
+	Err      error
+	Duration time.Duration
+}
+
+// RunInContexts runs the operation in each of the contexts, MaxParallelContexts at most at once, and returns their
+// outcomes in the order of the contexts
+func RunInContexts(contexts []string, operation func(context string) (string, error)) []ContextResult {
+	results := make([]ContextResult, len(contexts))
+	slots := make(chan struct{}, MaxParallelContexts)
+	var wg sync.WaitGroup
+	for i, context := range contexts {
+		wg.Add(1)
+		go func(i int, context string) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			start := time.Now()
+			message, err := operation(context)
+			results[i] = ContextResult{Context: context, Message: message, Err: err, Duration: time.Since(start).Round(time.Millisecond)}
+		}(i, context)
+	}
+	wg.Wait()
+	return results
+}
+
+// PrintContextResults prints the summary of the outcomes of an operation in several contexts, and returns the number
+// of contexts the operation failed in
+func PrintContextResults(results []ContextResult) int {
+	failed := 0
+	data := make([][]string, 0, len(results))
+	for _, result := range results {
+		status, message := "OK", result.Message
+		if result.Err != nil {
+			failed++
+			status, message = "FAILED", result.Err.Error()
+		}
+		// only the first line of the multi-line errors fits in the table
+		message, _, _ = strings.Cut(strings.TrimSpace(message), "\n")
+		data = append(data, []string{result.Context, status, result.Duration.String(), message})
+	}
+	PrintToTable([]string{"CONTEXT", "STATUS", "DURATION", "MESSAGE"}, data)
+	return failed
+}
+
+// MesheryK8sContexts returns the Kubernetes contexts connected to the Meshery Server at baseURL with the names or the
+// IDs, all of them when the only name is "all"
+func MesheryK8sContexts(baseURL string, names []string) ([]models.K8sContext, error) {
+	var connected []models.K8sContext
+	for page := 0; ; page++ {
+		req, err := NewRequest("GET", fmt.Sprintf("%s/api/system/kubernetes/contexts?page=%d&pagesize=%d", baseURL, page, k8sContextsPageSize), nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := MakeRequest(req)
+		if err != nil {
+			return nil, err
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, ErrReadResponseBody(err)
+		}
+		var contexts models.MesheryK8sContextPage
+		if err := json.Unmarshal(body, &contexts); err != nil {
+			return nil, ErrUnmarshal(err)
+		}
+		for _, k8sContext := range contexts.Contexts {
+			if k8sContext != nil {
+				connected = append(connected, *k8sContext)
+			}
+		}
+		if len(contexts.Contexts) < k8sContextsPageSize || len(connected) >= contexts.TotalCount {
+			break
+		}
+	}
+	if len(names) == 1 && names[0] == "all" {
+		return connected, nil
+	}
+
+	contexts := make([]models.K8sContext, 0, len(names))
+	for _, name := range names {
+		found := false
+		for _, k8sContext := range connected {
+			if k8sContext.Name == name || k8sContext.ID == name {
+				contexts = append(contexts, k8sContext)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, ErrK8sContextNotFound(name)
+		}
+	}
+	return contexts, nil
+}
+
+// K8sContextNames returns the names of the contexts, suffixed with their IDs when several contexts have the same name
+func K8sContextNames(contexts []models.K8sContext) []string {
+	count := map[string]int{}
+	for _, k8sContext := range contexts {
+		count[k8sContext.Name]++
+	}
+	names := make([]string, 0, len(contexts))
+	for _, k8sContext := range contexts {
+		name := k8sContext.Name
+		if count[name] > 1 {
+			name = fmt.Sprintf("%s (%s)", name, k8sContext.ID)
+		}
+		names = append(names, name)
+	}
+	return names
+}
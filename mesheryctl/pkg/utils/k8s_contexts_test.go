@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/layer5io/meshery/server/models"
+)
+
+func TestRunInContexts(t *testing.T) {
+	contexts := make([]string, 3*MaxParallelContexts)
+	for i := range contexts {
+		contexts[i] = fmt.Sprintf("cluster-%d", i)
+	}
+
+	// the operations return one at a time once MaxParallelContexts of them run, so that running fewer at once hangs
+	// the test and the contexts run in the freed slots can exceed the limit
+	var running, maxRunning int32
+	entered := make(chan struct{}, len(contexts))
+	release := make(chan struct{})
+	done := make(chan []ContextResult)
+	go func() {
+		done <- RunInContexts(contexts, func(context string) (string, error) {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				max := atomic.LoadInt32(&maxRunning)
+				if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+					break
+				}
+			}
+			entered <- struct{}{}
+			<-release
+			if context == "cluster-1" {
+				return "", fmt.Errorf("unreachable")
+			}
+			return "checked " + context, nil
+		})
+	}()
+	for i := range contexts {
+		if i >= MaxParallelContexts {
+			release <- struct{}{}
+		}
+		select {
+		case <-entered:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("ran in %d contexts at once, want %d", atomic.LoadInt32(&running), MaxParallelContexts)
+		}
+	}
+	for i := 0; i < MaxParallelContexts; i++ {
+		release <- struct{}{}
+	}
+	var results []ContextResult
+	select {
+	case results = <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the operations did not return")
+	}
+
+	if maxRunning != MaxParallelContexts {
+		t.Errorf("ran in %d contexts at once, want %d", maxRunning, MaxParallelContexts)
+	}
+	if len(results) != len(contexts) {
+		t.Fatalf("got %d results, want %d", len(results), len(contexts))
+	}
+	for i, result := range results {
+		if result.Context != contexts[i] {
+			t.Errorf("results[%d] is the one of %s, want %s", i, result.Context, contexts[i])
+		}
+		if (result.Err != nil) != (result.Context == "cluster-1") {
+			t.Errorf("results[%d] error = %v", i, result.Err)
+		}
+	}
+	if failed := PrintContextResults(results); failed != 1 {
+		t.Errorf("PrintContextResults() = %d, want 1 failure", failed)
+	}
+}
+
+func TestK8sContextNames(t *testing.T) {
+	contexts := []models.K8sContext{
+		{ID: "1", Name: "kind-dev"},
+		{ID: "2", Name: "prod"},
+		{ID: "3", Name: "prod"},
+	}
+	want := []string{"kind-dev", "prod (2)", "prod (3)"}
+	if got := K8sContextNames(contexts); !reflect.DeepEqual(got, want) {
+		t.Errorf("K8sContextNames() = %v, want %v", got, want)
+	}
+}
@@ -18,6 +18,15 @@ const (
 	ErrCleaningUpLocalProviderCode                = "1011"
 	ErrClosingDatabaseInstanceCode                = "1012"
 	ErrInitializingRegistryManagerCode            = "1013"
+	ErrRegistryGRPCServerCode                     = "1549"
+	ErrInitTracingCode                            = "1578"
+	ErrShutdownServerCode                         = "1582"
+	ErrInterruptDeploymentsCode                   = "1583"
+	ErrMTLSCode                                   = "1617"
+	ErrListenAndServeMTLSCode                     = "1618"
+	ErrSeedPerformanceProfileTemplatesCode        = "1630"
+	ErrTrustedProxiesCode                         = "1633"
+	ErrScopeRelationshipRevisionsCode             = "1636"
 )
 
 func ErrInitializingRegistryManager(err error) error {
@@ -67,3 +76,39 @@ func ErrCleaningUpLocalProvider(err error) error {
 func ErrClosingDatabaseInstance(err error) error {
 	return errors.New(ErrClosingDatabaseInstanceCode, errors.Alert, []string{"Error closing database instance"}, []string{"Error closing database instance: ", err.Error()}, []string{}, []string{})
 }
+
+func ErrRegistryGRPCServer(err error) error {
+	return errors.New(ErrRegistryGRPCServerCode, errors.Alert, []string{"Unable to serve the registry over gRPC"}, []string{err.Error()}, []string{"The port configured with REGISTRY_GRPC_PORT might already be in use"}, []string{"Make sure the port configured with REGISTRY_GRPC_PORT is available"})
+}
+
+func ErrInitTracing(err error) error {
+	return errors.New(ErrInitTracingCode, errors.Alert, []string{"Unable to initialize the exporter of the traces"}, []string{err.Error()}, []string{"The OpenTelemetry exporter configured with the OTEL_EXPORTER_OTLP_* environment variables is invalid"}, []string{"Make sure OTEL_EXPORTER_OTLP_ENDPOINT is the address of an OTLP/gRPC collector"})
+}
+
+func ErrShutdownServer(err error) error {
+	return errors.New(ErrShutdownServerCode, errors.Alert, []string{"Meshery Server did not shut down gracefully"}, []string{err.Error()}, []string{"Requests were still active when the shutdown timeout expired"}, []string{"Increase SHUTDOWN_TIMEOUT to give the active requests more time to complete"})
+}
+
+func ErrInterruptDeployments(err error) error {
+	return errors.New(ErrInterruptDeploymentsCode, errors.Alert, []string{"Unable to mark the unfinished design deployments as interrupted"}, []string{err.Error()}, []string{"Meshery Database handler is not accessible to perform operations"}, []string{"Resume the unfinished deployments by their ID once Meshery Server is restarted"})
+}
+
+func ErrMTLS(err error) error {
+	return errors.New(ErrMTLSCode, errors.Fatal, []string{"Unable to load the certificates of mutual TLS"}, []string{err.Error()}, []string{"The files of MTLS_CERT_FILE, MTLS_KEY_FILE or MTLS_CA_FILE are missing or are not PEM encoded", "MTLS_ALLOWED_IDS lists IDs which are not SPIFFE IDs"}, []string{"Make sure the certificate, its key and the CA bundle are mounted at the configured paths", "List the SPIFFE IDs of the trusted workloads, eg: spiffe://meshery.example.com/ns/meshery/sa/*"})
+}
+
+func ErrListenAndServeMTLS(err error) error {
+	return errors.New(ErrListenAndServeMTLSCode, errors.Alert, []string{"Unable to serve the API over mutual TLS"}, []string{err.Error()}, []string{"The port configured with MTLS_PORT might already be in use"}, []string{"Make sure the port configured with MTLS_PORT is available"})
+}
+
+func ErrSeedPerformanceProfileTemplates(err error) error {
+	return errors.New(ErrSeedPerformanceProfileTemplatesCode, errors.Alert, []string{"Unable to seed the built-in performance profile templates"}, []string{err.Error()}, []string{"Meshery Database handler is not accessible to perform operations"}, []string{"Restart Meshery Server, the built-in templates are seeded on every start"})
+}
+
+func ErrTrustedProxies(err error) error {
+	return errors.New(ErrTrustedProxiesCode, errors.Fatal, []string{"Invalid trusted proxies of the rate limiter"}, []string{err.Error()}, []string{"RATE_LIMIT_TRUSTED_PROXIES lists entries which are neither IPs nor CIDRs"}, []string{"List the IPs or CIDRs of the reverse proxies in front of Meshery Server, eg: 10.0.0.0/8"})
+}
+
+func ErrScopeRelationshipRevisions(err error) error {
+	return errors.New(ErrScopeRelationshipRevisionsCode, errors.Alert, []string{"Unable to record the organization of the prior revisions of the relationships"}, []string{err.Error()}, []string{"Meshery Database handler is not accessible to perform operations", "The definition of a revision is not a valid relationship definition"}, []string{"Restart Meshery Server, the revisions without an organization are scoped on every start"})
+}
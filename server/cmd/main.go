@@ -2,11 +2,13 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/url"
 	"os"
 	"os/signal"
 	"path"
+	"syscall"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -14,10 +16,19 @@ import (
 	"github.com/layer5io/meshery/server/helpers"
 	"github.com/layer5io/meshery/server/helpers/utils"
 	"github.com/layer5io/meshery/server/internal/graphql"
+	"github.com/layer5io/meshery/server/internal/mtls"
+	"github.com/layer5io/meshery/server/internal/ratelimit"
+	"github.com/layer5io/meshery/server/internal/redact"
 	"github.com/layer5io/meshery/server/internal/store"
+	"github.com/layer5io/meshery/server/internal/tracing"
+	"github.com/layer5io/meshery/server/meshes"
 	meshmodelhelper "github.com/layer5io/meshery/server/meshmodel"
+	meshmodelregistry "github.com/layer5io/meshery/server/meshmodel/registry"
 	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/credstore"
+	"github.com/layer5io/meshery/server/models/imagescan"
 	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshery/server/models/pattern/core"
 	"github.com/layer5io/meshery/server/router"
 	"github.com/layer5io/meshkit/broker/nats"
 	"github.com/layer5io/meshkit/logger"
@@ -46,6 +57,15 @@ const (
 	DefaultProviderURL = "https://meshery.layer5.io"
 	PoliciesPath       = "../meshmodel/kubernetes/policies"
 	RelationshipsPath  = "../meshmodel/kubernetes/relationships"
+
+	relationshipUsageIndexInterval = 30 * time.Minute
+	patternScheduleInterval        = time.Minute
+	patternDriftInterval           = 5 * time.Minute
+	auditRetentionInterval         = time.Hour
+	kubernetesEventsInterval       = 30 * time.Second
+	jobPollInterval                = 5 * time.Second
+	// time the deployments cancelled by the shutdown are given to reach their checkpoint
+	deploymentCheckpointGrace = 10 * time.Second
 )
 
 func main() {
@@ -61,6 +81,8 @@ func main() {
 		logrus.Error(err)
 		os.Exit(1)
 	}
+	// the secrets in the lines logged are masked
+	log = redact.Logger(log)
 
 	instanceID, err := uuid.NewV4()
 	if err != nil {
@@ -84,11 +106,120 @@ func main() {
 	viper.SetDefault("COMMITSHA", commitsha)
 	viper.SetDefault("RELEASE_CHANNEL", releasechannel)
 	viper.SetDefault("INSTANCE_ID", &instanceID)
+	// the servers sharing a database record the deployments they run under their SERVER_ID, which must be stable across
+	// restarts, so that a server starting up only interrupts the deployments it left unfinished
+	hostname, _ := os.Hostname()
+	viper.SetDefault("SERVER_ID", hostname)
 	viper.SetDefault("PROVIDER", "")
 	viper.SetDefault("REGISTER_STATIC_K8S", true)
 	viper.SetDefault("SKIP_DOWNLOAD_CONTENT", false)
 	viper.SetDefault("SKIP_COMP_GEN", false)
+	viper.SetDefault("WATCH_STATIC_RELATIONSHIPS", false)
+	viper.SetDefault("REGISTRY_GRPC_PORT", 0)
+	// the adapters and the registrants are authenticated with mutual TLS when MTLS_CERT_FILE is set: the adapters are
+	// dialed over mutual TLS, the registry gRPC server requires client certificates, and the API is also served over
+	// mutual TLS on MTLS_PORT, the only port registering entities without session when REGISTRY_REQUIRE_MTLS is set.
+	// The peers are restricted to the SPIFFE IDs of MTLS_TRUST_DOMAIN and of MTLS_ALLOWED_IDS when set.
+	viper.SetDefault("MTLS_CERT_FILE", "")
+	viper.SetDefault("MTLS_KEY_FILE", "")
+	viper.SetDefault("MTLS_CA_FILE", "")
+	viper.SetDefault("MTLS_TRUST_DOMAIN", "")
+	viper.SetDefault("MTLS_ALLOWED_IDS", []string{})
+	viper.SetDefault("MTLS_REFRESH_INTERVAL", time.Minute)
+	viper.SetDefault("MTLS_PORT", 9443)
+	viper.SetDefault("REGISTRY_REQUIRE_MTLS", false)
 	viper.SetDefault("PLAYGROUND", false)
+	viper.SetDefault("MAX_CONCURRENT_DEPLOYMENTS_PER_CLUSTER", 5)
+	// the on-demand prices of a vCPU and a GiB of memory of serverless containers, for an hour
+	viper.SetDefault("COST_CURRENCY", "USD")
+	viper.SetDefault("COST_CPU_CORE_HOUR", 0.04048)
+	viper.SetDefault("COST_MEMORY_GIB_HOUR", 0.004445)
+	viper.SetDefault("COST_PRICING_URL", "")
+	// the images of designs are scanned before they are deployed with IMAGE_SCANNER, trivy or grype, when it is set
+	viper.SetDefault("IMAGE_SCANNER", "")
+	viper.SetDefault("IMAGE_SCAN_FAIL_ON", "")
+	viper.SetDefault("IMAGE_SCAN_SBOM", true)
+	// the entries of the audit log older than AUDIT_RETENTION are deleted, 0 keeps them forever
+	viper.SetDefault("AUDIT_RETENTION", 90*24*time.Hour)
+	// the calls of the API are limited per provider token and per client IP, a rate of 0 disables the limit
+	viper.SetDefault("RATE_LIMIT_TOKEN_RPS", 25)
+	viper.SetDefault("RATE_LIMIT_TOKEN_BURST", 100)
+	viper.SetDefault("RATE_LIMIT_IP_RPS", 50)
+	viper.SetDefault("RATE_LIMIT_IP_BURST", 200)
+	// the client IP of the calls forwarded by the proxies of RATE_LIMIT_TRUSTED_PROXIES, IPs or CIDRs, is read from
+	// their X-Forwarded-For header
+	viper.SetDefault("RATE_LIMIT_TRUSTED_PROXIES", []string{})
+	// on shutdown the running requests and deployments are given SHUTDOWN_TIMEOUT to complete
+	viper.SetDefault("SHUTDOWN_TIMEOUT", 30*time.Second)
+	// the database is SQLite in USER_DATA_FOLDER unless DB_ENGINE is postgres, the replicas of Meshery Server then share it
+	viper.SetDefault("DB_ENGINE", "sqlite")
+	viper.SetDefault("DB_HOST", "localhost")
+	viper.SetDefault("DB_PORT", "5432")
+	viper.SetDefault("DB_USER", "meshery")
+	viper.SetDefault("DB_NAME", "meshery")
+	viper.SetDefault("DB_SSLMODE", "prefer")
+	viper.SetDefault("DB_MAX_OPEN_CONNS", 25)
+	viper.SetDefault("DB_MAX_IDLE_CONNS", 5)
+	viper.SetDefault("DB_CONN_MAX_LIFETIME", 30*time.Minute)
+	// the lookups of the registry are cached for REGISTRY_CACHE_TTL, 0 disables the cache
+	viper.SetDefault("REGISTRY_CACHE_TTL", 5*time.Minute)
+	viper.SetDefault("REGISTRY_CACHE_MAX_ENTRIES", 512)
+	// at most JOB_WORKERS background jobs run at once, the failed ones are attempted JOB_MAX_ATTEMPTS times by default
+	viper.SetDefault("JOB_WORKERS", 4)
+	viper.SetDefault("JOB_MAX_ATTEMPTS", 3)
+	// the secrets of the credentials are stored in CREDENTIALS_BACKEND: the database, vault or kubernetes
+	viper.SetDefault("CREDENTIALS_BACKEND", "database")
+	viper.SetDefault("VAULT_ADDR", "")
+	viper.SetDefault("VAULT_TOKEN", "")
+	viper.SetDefault("VAULT_NAMESPACE", "")
+	viper.SetDefault("VAULT_KV_MOUNT", "secret")
+	viper.SetDefault("VAULT_KV_PREFIX", "meshery/credentials")
+	// the Kubernetes Secrets are stored in the cluster Meshery Server runs in, unless CREDENTIALS_KUBECONFIG is set
+	viper.SetDefault("CREDENTIALS_KUBECONFIG", "")
+	viper.SetDefault("CREDENTIALS_NAMESPACE", "meshery")
+	// the secrets in the responses of the API are masked, except in the ones of REDACT_EXEMPT_PATHS returning them on purpose
+	viper.SetDefault("REDACT_RESPONSES", true)
+	viper.SetDefault("REDACT_EXEMPT_PATHS", []string{"/api/user/token", "/api/token", "/api/integrations/credentials", "/api/user/api-tokens", "/api/webhooks"})
+	// the roles of the users are enforced unless RBAC_ENABLED is false, the users bound to no role have RBAC_DEFAULT_ROLE,
+	// and the users of RBAC_ADMINS, by ID or by user name, are admins whatever their bindings
+	viper.SetDefault("RBAC_ENABLED", true)
+	viper.SetDefault("RBAC_DEFAULT_ROLE", "admin")
+	viper.SetDefault("RBAC_ADMINS", []string{})
+	// the API tokens created without expiry expire after API_TOKEN_TTL, 0 lets them never expire
+	viper.SetDefault("API_TOKEN_TTL", 90*24*time.Hour)
+	// the deliveries of the webhooks failing are attempted WEBHOOK_MAX_ATTEMPTS times, WEBHOOK_RETRY_BACKOFF after the first
+	// attempt and twice as late after every other, and are kept WEBHOOK_DELIVERY_RETENTION once succeeded or failed
+	viper.SetDefault("WEBHOOK_MAX_ATTEMPTS", 5)
+	viper.SetDefault("WEBHOOK_RETRY_BACKOFF", 30*time.Second)
+	viper.SetDefault("WEBHOOK_TIMEOUT", 10*time.Second)
+	viper.SetDefault("WEBHOOK_DELIVERY_RETENTION", 30*24*time.Hour)
+	// the webhooks are not allowed to reach the loopback, link-local and private addresses unless WEBHOOK_ALLOW_PRIVATE_NETWORKS
+	viper.SetDefault("WEBHOOK_ALLOW_PRIVATE_NETWORKS", false)
+	// the notifications of the email channels are sent through the SMTP server NOTIFICATION_SMTP_HOST, authenticating with
+	// PLAIN when NOTIFICATION_SMTP_USERNAME is set
+	viper.SetDefault("NOTIFICATION_SMTP_HOST", "")
+	viper.SetDefault("NOTIFICATION_SMTP_PORT", 587)
+	viper.SetDefault("NOTIFICATION_SMTP_USERNAME", "")
+	viper.SetDefault("NOTIFICATION_SMTP_PASSWORD", "")
+	viper.SetDefault("NOTIFICATION_SMTP_FROM", "")
+	viper.SetDefault("NOTIFICATION_TIMEOUT", 10*time.Second)
+	// the users of the local provider sign in with the OpenID Connect issuer OIDC_ISSUER when it is set, eg: Dex, Keycloak
+	// or Okta, and have the roles of their groups of OIDC_GROUP_ROLES, eg: platform-admins=admin,developers=operator, or
+	// RBAC_DEFAULT_ROLE when none of their groups is mapped
+	viper.SetDefault("OIDC_ISSUER", "")
+	viper.SetDefault("OIDC_CLIENT_ID", "")
+	viper.SetDefault("OIDC_CLIENT_SECRET", "")
+	viper.SetDefault("OIDC_REDIRECT_URL", "")
+	viper.SetDefault("OIDC_SCOPES", []string{"profile", "email", "groups"})
+	viper.SetDefault("OIDC_GROUPS_CLAIM", "groups")
+	viper.SetDefault("OIDC_GROUP_ROLES", "")
+	viper.SetDefault("OIDC_SESSION_TTL", 24*time.Hour)
+	viper.SetDefault("OIDC_SESSION_SECRET", "")
+	// the GraphQL operations nested deeper than GRAPHQL_MAX_DEPTH or more complex than GRAPHQL_MAX_COMPLEXITY are rejected,
+	// 0 disables the limit, and the last GRAPHQL_PERSISTED_QUERIES queries sent are kept to be sent by hash
+	viper.SetDefault("GRAPHQL_MAX_DEPTH", 15)
+	viper.SetDefault("GRAPHQL_MAX_COMPLEXITY", 1000)
+	viper.SetDefault("GRAPHQL_PERSISTED_QUERIES", 1000)
 	store.Initialize()
 
 	log.Info("Local Provider capabilities are: ", version)
@@ -111,7 +242,11 @@ func main() {
 		os.Exit(1)
 	}
 
-	log.Info("Meshery Database is at: ", viper.GetString("USER_DATA_FOLDER"))
+	if viper.GetString("DB_ENGINE") == "postgres" {
+		log.Info("Meshery Database is PostgreSQL at: ", viper.GetString("DB_HOST"), ":", viper.GetString("DB_PORT"))
+	} else {
+		log.Info("Meshery Database is at: ", viper.GetString("USER_DATA_FOLDER"))
+	}
 	if viper.GetString("KUBECONFIG_FOLDER") == "" {
 		if err != nil {
 			log.Error(ErrRetrievingUserHomeDirectory(err))
@@ -126,6 +261,15 @@ func main() {
 	}
 	log.Info("Log level: ", logrus.GetLevel())
 
+	shutdownTracing, err := tracing.Init(ctx, "meshery-server", version)
+	if err != nil {
+		log.Error(ErrInitTracing(err))
+		shutdownTracing = func(context.Context) error { return nil }
+	}
+	defer func() {
+		_ = shutdownTracing(context.Background())
+	}()
+
 	adapterURLs := viper.GetStringSlice("ADAPTER_URLS")
 
 	adapterTracker := helpers.NewAdaptersTracker(adapterURLs)
@@ -177,12 +321,42 @@ func main() {
 		&models.SmiResultWithID{},
 		models.K8sContext{},
 		_events.Event{},
+		&mesherymeshmodel.RelationshipRevision{},
+		&mesherymeshmodel.RelationshipPolicy{},
+		&models.PatternDeployment{},
+		&models.MesheryPatternRevision{},
+		&models.GitOpsLink{},
+		&models.PatternSchedule{},
+		&models.DeployedPattern{},
+		&models.PatternImageScan{},
+		&models.AuditRecord{},
+		&models.Job{},
+		&models.ClusterMetadata{},
+		&models.RoleBinding{},
+		&models.APIToken{},
+		&models.Webhook{},
+		&models.WebhookDelivery{},
+		&models.NotificationChannel{},
+		&models.NotificationRule{},
+		&models.PerformanceProfileTemplate{},
 	)
 	if err != nil {
 		log.Error(ErrDatabaseAutoMigration(err))
 		os.Exit(1)
 	}
 
+	// the deployments left unfinished by a crash of Meshery Server are reported as interrupted, so that they are resumed
+	deploymentPersister := &models.PatternDeploymentPersister{DB: dbHandler, ServerID: viper.GetString("SERVER_ID")}
+	if _, err := deploymentPersister.InterruptPatternDeployments(); err != nil {
+		log.Error(ErrInterruptDeployments(err))
+	}
+	if err := (&models.PerformanceProfileTemplatePersister{DB: dbHandler}).SeedPerformanceProfileTemplates(); err != nil {
+		log.Error(ErrSeedPerformanceProfileTemplates(err))
+	}
+	if err := mesherymeshmodel.ScopeRelationshipRevisions(dbHandler); err != nil {
+		log.Error(ErrScopeRelationshipRevisions(err))
+	}
+
 	lProv := &models.DefaultLocalProvider{
 		ProviderBaseURL:                 DefaultProviderURL,
 		MapPreferencePersister:          preferencePersister,
@@ -198,8 +372,64 @@ func main() {
 		EventsPersister:                 &models.EventsPersister{DB: dbHandler},
 		GenericPersister:                dbHandler,
 	}
+	if issuer := viper.GetString("OIDC_ISSUER"); issuer != "" {
+		groupRoles, err := models.ParseOIDCGroupRoles(viper.GetString("OIDC_GROUP_ROLES"))
+		if err != nil {
+			log.Error(err)
+			os.Exit(1)
+		}
+		lProv.OIDC, err = models.NewOIDCAuthenticator(models.OIDCConfig{
+			Issuer:        issuer,
+			ClientID:      viper.GetString("OIDC_CLIENT_ID"),
+			ClientSecret:  viper.GetString("OIDC_CLIENT_SECRET"),
+			RedirectURL:   viper.GetString("OIDC_REDIRECT_URL"),
+			Scopes:        viper.GetStringSlice("OIDC_SCOPES"),
+			GroupsClaim:   viper.GetString("OIDC_GROUPS_CLAIM"),
+			GroupRoles:    groupRoles,
+			SessionTTL:    viper.GetDuration("OIDC_SESSION_TTL"),
+			SessionSecret: viper.GetString("OIDC_SESSION_SECRET"),
+		})
+		if err != nil {
+			log.Error(err)
+			os.Exit(1)
+		}
+	}
 	lProv.Initialize()
 
+	// the cost of designs is estimated with the prices of the pricing API when one is configured
+	var pricing core.Pricing = core.Prices{
+		Currency:      viper.GetString("COST_CURRENCY"),
+		CPUCoreHour:   viper.GetFloat64("COST_CPU_CORE_HOUR"),
+		MemoryGiBHour: viper.GetFloat64("COST_MEMORY_GIB_HOUR"),
+	}
+	if pricingURL := viper.GetString("COST_PRICING_URL"); pricingURL != "" {
+		pricing = core.PricingAPI{URL: pricingURL, Client: &http.Client{Timeout: 10 * time.Second}}
+	}
+
+	imageScanPolicy, err := imagescan.NewPolicy(viper.GetString("IMAGE_SCANNER"), viper.GetString("IMAGE_SCAN_FAIL_ON"), viper.GetBool("IMAGE_SCAN_SBOM"))
+	if err != nil {
+		log.Error(err)
+		os.Exit(1)
+	}
+
+	credentialStore, err := credstore.NewStore(viper.GetString("CREDENTIALS_BACKEND"), credstore.Config{
+		Vault: credstore.VaultConfig{
+			Address:   viper.GetString("VAULT_ADDR"),
+			Token:     viper.GetString("VAULT_TOKEN"),
+			Namespace: viper.GetString("VAULT_NAMESPACE"),
+			Mount:     viper.GetString("VAULT_KV_MOUNT"),
+			Prefix:    viper.GetString("VAULT_KV_PREFIX"),
+		},
+		Kubernetes: credstore.KubernetesConfig{
+			Kubeconfig: viper.GetString("CREDENTIALS_KUBECONFIG"),
+			Namespace:  viper.GetString("CREDENTIALS_NAMESPACE"),
+		},
+	})
+	if err != nil {
+		log.Error(err)
+		os.Exit(1)
+	}
+
 	hc := &models.HandlerConfig{
 		Providers:              provs,
 		ProviderCookieName:     "meshery-provider",
@@ -224,18 +454,100 @@ func main() {
 		EventBroadcaster:          models.NewBroadcaster(),
 		DashboardK8sResourcesChan: models.NewDashboardK8sResourcesHelper(),
 		MeshModelSummaryChannel:   mesherymeshmodel.NewSummaryHelper(),
+		MeshModelEventsChannel:    mesherymeshmodel.NewRegistryEventsChannel(),
+		RelationshipUsageIndexer:  models.NewRelationshipUsageIndexer(dbHandler, regManager, log),
+		DeploymentQueue:           models.NewDeploymentQueue(viper.GetInt("MAX_CONCURRENT_DEPLOYMENTS_PER_CLUSTER")),
+		ShutdownManager:           models.NewShutdownManager(deploymentCheckpointGrace),
+		JobRunner:                 models.NewJobRunner(dbHandler, log, viper.GetInt("JOB_WORKERS"), viper.GetInt("JOB_MAX_ATTEMPTS")),
+		RegistryCache:             mesherymeshmodel.NewRegistryCache(mesherymeshmodel.OrgScopedRegistry{EntitiesGetter: regManager, DB: dbHandler, Log: log}, viper.GetDuration("REGISTRY_CACHE_TTL"), viper.GetInt("REGISTRY_CACHE_MAX_ENTRIES")),
+		Pricing:                   pricing,
+		ImageScanPolicy:           imageScanPolicy,
+		CredentialStore:           credentialStore,
 
 		K8scontextChannel: models.NewContextHelper(),
 		OperatorTracker:   models.NewOperatorTracker(viper.GetBool("DISABLE_OPERATOR")),
 	}
+	// the registry events are published whenever entities are registered, updated or deleted
+	hc.MeshModelEventsChannel.OnPublish(func(mesherymeshmodel.RegistryEvent) {
+		hc.RegistryCache.Invalidate()
+	})
+	// the events and the registry events are delivered to the webhooks subscribing to them
+	webhookDispatcher := models.NewWebhookDispatcher(dbHandler, log, models.WebhookDispatcherOptions{
+		MaxAttempts:          viper.GetInt("WEBHOOK_MAX_ATTEMPTS"),
+		Backoff:              viper.GetDuration("WEBHOOK_RETRY_BACKOFF"),
+		Timeout:              viper.GetDuration("WEBHOOK_TIMEOUT"),
+		Retention:            viper.GetDuration("WEBHOOK_DELIVERY_RETENTION"),
+		AllowPrivateNetworks: viper.GetBool("WEBHOOK_ALLOW_PRIVATE_NETWORKS"),
+	})
+	hc.EventBroadcaster.OnPublish(webhookDispatcher.NotifyEvent)
+	hc.MeshModelEventsChannel.OnPublish(webhookDispatcher.NotifyRegistryEvent)
+	go webhookDispatcher.Run(ctx)
+	// the events are sent to the notification channels of the users their notification rules route them to
+	hc.Notifier = models.NewNotifier(dbHandler, log, models.NotifierOptions{
+		SMTP: models.SMTPConfig{
+			Host:     viper.GetString("NOTIFICATION_SMTP_HOST"),
+			Port:     viper.GetInt("NOTIFICATION_SMTP_PORT"),
+			Username: viper.GetString("NOTIFICATION_SMTP_USERNAME"),
+			Password: viper.GetString("NOTIFICATION_SMTP_PASSWORD"),
+			From:     viper.GetString("NOTIFICATION_SMTP_FROM"),
+		},
+		Timeout: viper.GetDuration("NOTIFICATION_TIMEOUT"),
+	})
+	hc.EventBroadcaster.OnPublish(hc.Notifier.NotifyEvent)
+	go hc.Notifier.Run(ctx)
+	registerMetrics(hc, dbHandler)
 
 	//seed the local meshmodel components
 	ch := meshmodelhelper.NewEntityRegistrationHelper(hc, regManager, log)
 	go func() {
 		ch.SeedComponents()
+		hc.RegistryCache.Invalidate()
 		go hc.MeshModelSummaryChannel.Publish()
+		// index the usage of relationships in saved designs once the relationships are seeded
+		go hc.RelationshipUsageIndexer.Run(ctx, relationshipUsageIndexInterval)
+		// register relationships dropped into the static relationship directories at runtime
+		if viper.GetBool("WATCH_STATIC_RELATIONSHIPS") {
+			if err := ch.WatchRelationships(ctx, dbHandler); err != nil {
+				log.Error(err)
+			}
+		}
 	}()
 
+	if _, err := ratelimit.ParseTrustedProxies(viper.GetStringSlice("RATE_LIMIT_TRUSTED_PROXIES")); err != nil {
+		log.Error(ErrTrustedProxies(err))
+		os.Exit(1)
+	}
+
+	var mtlsSource *mtls.Source
+	if viper.GetString("MTLS_CERT_FILE") != "" {
+		mtlsSource, err = mtls.NewSource(mtls.Config{
+			CertFile:        viper.GetString("MTLS_CERT_FILE"),
+			KeyFile:         viper.GetString("MTLS_KEY_FILE"),
+			CAFile:          viper.GetString("MTLS_CA_FILE"),
+			TrustDomain:     viper.GetString("MTLS_TRUST_DOMAIN"),
+			AllowedIDs:      viper.GetStringSlice("MTLS_ALLOWED_IDS"),
+			RefreshInterval: viper.GetDuration("MTLS_REFRESH_INTERVAL"),
+		})
+		if err != nil {
+			log.Error(ErrMTLS(err))
+			os.Exit(1)
+		}
+		meshes.UseTLS(mtlsSource.ClientConfig())
+	}
+
+	// expose the registry over gRPC for adapters and external registrants
+	if grpcPort := viper.GetInt("REGISTRY_GRPC_PORT"); grpcPort != 0 {
+		go func() {
+			registryServer := meshmodelregistry.NewServer(regManager, dbHandler, hc.MeshModelEventsChannel, log)
+			if mtlsSource != nil {
+				registryServer.UseTLS(mtlsSource.ServerConfig())
+			}
+			if err := registryServer.Start(ctx, fmt.Sprintf(":%d", grpcPort)); err != nil {
+				log.Error(ErrRegistryGRPCServer(err))
+			}
+		}()
+	}
+
 	lProv.SeedContent(log)
 	provs[lProv.Name()] = lProv
 
@@ -274,6 +586,17 @@ func main() {
 		logrus.Warn("error creating rego instance, policies will not be evaluated")
 	}
 	h := handlers.NewHandlerInstance(hc, meshsyncCh, log, brokerConn, k8sComponentsRegistrationHelper, mctrlHelper, dbHandler, events.NewEventStreamer(), regManager, viper.GetString("PROVIDER"), rego)
+	go h.RunPatternSchedules(ctx, patternScheduleInterval)
+	go h.RunPatternDriftDetection(ctx, patternDriftInterval)
+	go h.RunAuditRetention(ctx, auditRetentionInterval)
+	go h.RunKubernetesEventsCorrelation(ctx, kubernetesEventsInterval)
+	// the jobs are stopped on shutdown, they are queued again and resumed after the restart
+	jobsCtx, stopJobs := context.WithCancel(ctx)
+	jobsStopped := make(chan struct{})
+	go func() {
+		hc.JobRunner.Run(jobsCtx, jobPollInterval)
+		close(jobsStopped)
+	}()
 
 	b := broadcast.NewBroadcaster(100)
 	defer b.Close()
@@ -283,6 +606,10 @@ func main() {
 		Logger:      log,
 		BrokerConn:  brokerConn,
 		Broadcaster: b,
+
+		MaxDepth:                viper.GetInt("GRAPHQL_MAX_DEPTH"),
+		MaxComplexity:           viper.GetInt("GRAPHQL_MAX_COMPLEXITY"),
+		PersistedQueryCacheSize: viper.GetInt("GRAPHQL_PERSISTED_QUERIES"),
 	})
 
 	gp := graphql.NewPlayground(graphql.Options{
@@ -292,16 +619,55 @@ func main() {
 	port := viper.GetInt("PORT")
 	r := router.NewRouter(ctx, h, port, g, gp)
 	c := make(chan os.Signal, 1)
-	signal.Notify(c, os.Interrupt)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 
 	go func() {
 		log.Info("Meshery Server listening on: ", port)
-		if err := r.Run(); err != nil {
+		if err := r.Run(); err != nil && err != http.ErrServerClosed {
 			log.Error(ErrListenAndServe(err))
 			os.Exit(1)
 		}
 	}()
+	if mtlsSource != nil {
+		mtlsPort := viper.GetInt("MTLS_PORT")
+		r.WithTLS(mtlsPort, mtlsSource.ServerConfig())
+		go func() {
+			log.Info("Meshery Server listening over mutual TLS on: ", mtlsPort)
+			if err := r.RunTLS(); err != nil && err != http.ErrServerClosed {
+				log.Error(ErrListenAndServeMTLS(err))
+			}
+		}()
+	}
 	<-c
+
+	// stop accepting requests and wait for the running deployments to finish, the ones outliving the timeout
+	// are stopped at their next checkpoint so that they are resumed instead of being left half applied
+	log.Info("Shutting down Meshery Server, draining the running deployments...")
+	shutdownTimeout := viper.GetDuration("SHUTDOWN_TIMEOUT")
+	serverCtx, cancelServer := context.WithTimeout(ctx, shutdownTimeout+deploymentCheckpointGrace)
+	serverShutdown := make(chan error, 1)
+	go func() {
+		serverShutdown <- r.Shutdown(serverCtx)
+	}()
+	drainCtx, cancelDrain := context.WithTimeout(ctx, shutdownTimeout)
+	for _, d := range hc.ShutdownManager.Drain(drainCtx) {
+		log.Warn(fmt.Errorf("deployment %s of design '%s' did not reach its checkpoint before shutdown", d.ID, d.Name))
+		if err := deploymentPersister.SetPatternDeploymentStatus(d.ID, models.PatternDeploymentInterrupted); err != nil {
+			log.Error(ErrInterruptDeployments(err))
+		}
+	}
+	cancelDrain()
+	stopJobs()
+	select {
+	case <-jobsStopped:
+	case <-time.After(deploymentCheckpointGrace):
+		log.Warn(fmt.Errorf("the background jobs did not stop before shutdown, they are resumed after the restart"))
+	}
+	if err := <-serverShutdown; err != nil {
+		log.Error(ErrShutdownServer(err))
+	}
+	cancelServer()
+
 	regManager.Cleanup()
 	log.Info("Doing seeded content cleanup...")
 
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"github.com/layer5io/meshery/server/internal/metrics"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+// registerMetrics registers the gauges of the state of Meshery Server, which are computed when the metrics are scraped
+func registerMetrics(hc *models.HandlerConfig, dbHandler *database.Handler) {
+	metrics.RegisterGaugeVec("registry_entities", "Number of entities registered in the registry, by kind.", "kind", func() map[string]float64 {
+		tables := map[string]interface{}{
+			"model":        &v1alpha1.ModelDB{},
+			"component":    &v1alpha1.ComponentDefinitionDB{},
+			"relationship": &v1alpha1.RelationshipDefinitionDB{},
+			"policy":       &v1alpha1.PolicyDefinitionDB{},
+		}
+		counts := make(map[string]float64, len(tables))
+		for kind, table := range tables {
+			var count int64
+			if err := dbHandler.Model(table).Count(&count).Error; err == nil {
+				counts[kind] = float64(count)
+			}
+		}
+		return counts
+	})
+
+	metrics.RegisterGaugeVec("deployments", "Number of design deployments running or waiting in the deployment queue, by state.", "state", func() map[string]float64 {
+		counts := map[string]float64{models.DeploymentRunning: 0, models.DeploymentQueued: 0}
+		for _, d := range hc.DeploymentQueue.List("") {
+			counts[d.State]++
+		}
+		return counts
+	})
+
+	metrics.RegisterGaugeVec("registry_cache_lookups", "Number of lookups of the registry, by whether the cache served them.", "result", func() map[string]float64 {
+		stats := hc.RegistryCache.Stats()
+		return map[string]float64{"hit": float64(stats.Hits), "miss": float64(stats.Misses)}
+	})
+
+	metrics.RegisterGauge("event_queue_depth", "Number of events published and not yet received by the subscribers.", func() float64 {
+		return float64(hc.EventBroadcaster.QueueDepth())
+	})
+}
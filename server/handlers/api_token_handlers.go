@@ -0,0 +1,234 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/spf13/viper"
+)
+
+// the time the API tokens are last used at is recorded at most once in apiTokenTouchInterval
+const apiTokenTouchInterval = time.Minute
+
+// apiTokenRequest returns the request authenticated with the API token of its Authorization header, if any, carrying the
+// API token in its context and the token of the session of the provider the API token was created with in its cookie,
+// so that the providers authenticate it as they authenticate the sessions. The error response is written and false is
+// returned when the API token is not valid.
+func (h *Handler) apiTokenRequest(w http.ResponseWriter, req *http.Request) (*http.Request, *models.APIToken, bool) {
+	scheme, value, _ := strings.Cut(req.Header.Get("Authorization"), " ")
+	value = strings.TrimSpace(value)
+	if !strings.EqualFold(scheme, "bearer") || !strings.HasPrefix(value, models.APITokenPrefix) {
+		return req, nil, true
+	}
+
+	persister := &models.APITokenPersister{DB: h.dbHandler}
+	token, err := persister.GetAPITokenByDigest(models.APITokenDigest(value))
+	if err != nil {
+		h.log.Error(ErrAPIToken(err))
+		http.Error(w, ErrAPIToken(err).Error(), http.StatusInternalServerError)
+		return nil, nil, false
+	}
+	now := time.Now()
+	if token == nil {
+		err = models.ErrInvalidAPIToken("the token is unknown")
+	} else {
+		err = token.Validate(now)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return nil, nil, false
+	}
+	providerToken, err := token.OpenProviderToken(value)
+	if err != nil {
+		h.log.Error(ErrAPIToken(err))
+		http.Error(w, ErrAPIToken(err).Error(), http.StatusUnauthorized)
+		return nil, nil, false
+	}
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) > apiTokenTouchInterval {
+		go func(id uuid.UUID) {
+			if err := persister.TouchAPIToken(id, now); err != nil {
+				h.log.Error(ErrAPIToken(err))
+			}
+		}(token.ID)
+	}
+
+	// the cookie of a session of the provider sent along is replaced by the one of the session of the token
+	cookies := req.Cookies()
+	req = req.Clone(context.WithValue(req.Context(), models.APITokenCtxKey, token))
+	req.Header.Del("Cookie")
+	req.Header.Del("Authorization")
+	for _, c := range cookies {
+		if c.Name != "token" {
+			req.AddCookie(c)
+		}
+	}
+	req.AddCookie(&http.Cookie{Name: "token", Value: providerToken})
+	return req, token, true
+}
+
+// swagger:route GET /api/user/api-tokens UserAPI idGetAPITokens
+// Handle GET request for the API tokens of the user
+//
+// Returns the API tokens of the user, most recent first, without their values. The revoked tokens are listed along
+// with the others when the include_revoked query parameter is true.
+// responses:
+//
+//	200: apiTokensRespWrapper
+//	500:
+func (h *Handler) GetAPITokensHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	includeRevoked := r.URL.Query().Get("include_revoked") == "true"
+	tokens, err := (&models.APITokenPersister{DB: h.dbHandler}).GetAPITokens(user.ID, includeRevoked)
+	if err != nil {
+		h.log.Error(ErrAPIToken(err))
+		http.Error(rw, ErrAPIToken(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(tokens); err != nil {
+		h.log.Error(models.ErrEncoding(err, "API tokens"))
+		http.Error(rw, models.ErrEncoding(err, "API tokens").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route POST /api/user/api-tokens UserAPI idCreateAPIToken
+// Handle POST request for creating an API token
+//
+// Creates an API token of the user, to call the API without a session, eg: from CI systems or mesheryctl, with the
+// ```Authorization: Bearer <token>``` header. The token is restricted to the scopes of the body, the permissions of the
+// roles, and expires at expires_at, or API_TOKEN_TTL after its creation, eg:
+// {"name": "ci", "scopes": ["view", "deploy"], "expires_at": "2025-01-01T00:00:00Z"}. The calls made with the token
+// are also restricted by the role of the user. Every scope grants the view permission along with its own. The value of
+// the token is only returned by this request, only its digest is stored. API tokens cannot be created with API tokens.
+// responses:
+//
+//	201: createdAPITokenRespWrapper
+//	400:
+//	403:
+//	500:
+func (h *Handler) CreateAPITokenHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if _, ok := r.Context().Value(models.APITokenCtxKey).(*models.APIToken); ok {
+		http.Error(rw, "API tokens cannot be created with API tokens", http.StatusForbidden)
+		return
+	}
+	var body models.APITokenRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if strings.TrimSpace(body.Name) == "" {
+		http.Error(rw, "name is required", http.StatusBadRequest)
+		return
+	}
+	if len(body.Scopes) == 0 {
+		body.Scopes = []models.Permission{models.ViewPermission}
+	}
+	now := time.Now()
+	expiresAt := body.ExpiresAt
+	if expiresAt == nil {
+		if ttl := viper.GetDuration("API_TOKEN_TTL"); ttl > 0 {
+			t := now.Add(ttl)
+			expiresAt = &t
+		}
+	} else if !expiresAt.After(now) {
+		http.Error(rw, "expires_at is in the past", http.StatusBadRequest)
+		return
+	}
+
+	providerToken, err := provider.GetProviderToken(r)
+	if err != nil {
+		h.log.Error(ErrRetrieveUserToken(err))
+		http.Error(rw, ErrRetrieveUserToken(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	value, digest, err := models.NewAPITokenValue()
+	if err != nil {
+		h.log.Error(ErrAPIToken(err))
+		http.Error(rw, ErrAPIToken(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	token := models.APIToken{
+		UserID:       user.ID,
+		Name:         strings.TrimSpace(body.Name),
+		Prefix:       value[:len(models.APITokenPrefix)+6],
+		Digest:       digest,
+		UserName:     user.UserID,
+		ProviderName: provider.Name(),
+		ExpiresAt:    expiresAt,
+	}
+	if err := token.SetScopes(body.Scopes); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := token.SealProviderToken(value, providerToken); err != nil {
+		h.log.Error(ErrAPIToken(err))
+		http.Error(rw, ErrAPIToken(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := (&models.APITokenPersister{DB: h.dbHandler}).SaveAPIToken(&token); err != nil {
+		h.log.Error(ErrAPIToken(err))
+		http.Error(rw, ErrAPIToken(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(rw).Encode(models.CreatedAPIToken{APIToken: token, Value: value}); err != nil {
+		h.log.Error(models.ErrEncoding(err, "API token"))
+	}
+}
+
+// swagger:route DELETE /api/user/api-tokens/{id} UserAPI idRevokeAPIToken
+// Handle DELETE request for revoking an API token
+//
+// Revokes the API token of the user with the given ID, the calls made with it are then rejected. The revoked tokens
+// are kept, and listed by idGetAPITokens with the include_revoked query parameter.
+// responses:
+//
+//	204:
+//	400:
+//	404:
+//	500:
+func (h *Handler) RevokeAPITokenHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("invalid API token id %q", mux.Vars(r)["id"]), http.StatusBadRequest)
+		return
+	}
+	revoked, err := (&models.APITokenPersister{DB: h.dbHandler}).RevokeAPIToken(id, user.ID)
+	if err != nil {
+		h.log.Error(ErrAPIToken(err))
+		http.Error(rw, ErrAPIToken(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if !revoked {
+		http.Error(rw, fmt.Sprintf("API token %s not found", id), http.StatusNotFound)
+		return
+	}
+	rw.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/felixge/httpsnoop"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/spf13/viper"
+)
+
+// auditedMethods are the methods of the calls modifying the state of Meshery Server
+var auditedMethods = map[string]bool{
+	http.MethodPost:   true,
+	http.MethodPut:    true,
+	http.MethodPatch:  true,
+	http.MethodDelete: true,
+}
+
+// the queries of the GraphQL API are sent with POST requests too, the ones of the UI would flood the audit log
+const graphqlPathPrefix = "/api/system/graphql/"
+
+// AuditMiddleware records in the audit log the calls of the routes of the router it is used by which modify the state
+// of Meshery Server. The user of the call is filled in by SessionInjectorMiddleware, calls which are not authenticated
+// are recorded without a user.
+func (h *Handler) AuditMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := mux.CurrentRoute(r)
+		if !auditedMethods[r.Method] || route == nil || strings.HasPrefix(r.URL.Path, graphqlPathPrefix) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		record := &models.AuditRecord{
+			Method:     r.Method,
+			Endpoint:   r.URL.Path,
+			Path:       r.URL.Path,
+			EntityID:   auditEntityID(mux.Vars(r)),
+			RemoteAddr: r.RemoteAddr,
+			CreatedAt:  time.Now(),
+		}
+		if tmpl, err := route.GetPathTemplate(); err == nil {
+			record.Endpoint = tmpl
+		}
+		if r.Body != nil && r.Body != http.NoBody && !skipAuditedBody(r) {
+			digest, truncated, err := digestAuditedBody(r)
+			if err != nil {
+				h.log.Error(ErrRequestBody(err))
+				http.Error(w, ErrRequestBody(err).Error(), http.StatusBadRequest)
+				return
+			}
+			record.PayloadDigest = digest
+			record.PayloadTruncated = truncated
+		}
+
+		ctx := context.WithValue(r.Context(), models.AuditRecordCtxKey, record)
+		m := httpsnoop.CaptureMetrics(next, w, r.WithContext(ctx))
+
+		record.StatusCode = m.Code
+		record.Result = models.AuditResultSuccess
+		if m.Code >= http.StatusBadRequest {
+			record.Result = models.AuditResultFailure
+		}
+		go h.saveAuditRecord(record)
+	})
+}
+
+// maxAuditedBodySize is the number of bytes of the body of a call the digest of the audit log is computed over
+const maxAuditedBodySize = 1 << 20
+
+// skipAuditedBody reports whether the body of the call is left out of the audit log, multipart bodies are uploads
+// of files and bodies announced larger than maxAuditedBodySize would only be digested partially
+func skipAuditedBody(r *http.Request) bool {
+	if strings.HasPrefix(strings.ToLower(r.Header.Get("Content-Type")), "multipart/") {
+		return true
+	}
+	return r.ContentLength > maxAuditedBodySize
+}
+
+// digestAuditedBody returns the hex encoded SHA-256 digest of at most maxAuditedBodySize bytes of the body of the call,
+// truncated is true when the body is larger. The body is restored for the next handlers, the bytes past the limit are
+// streamed from the original body.
+func digestAuditedBody(r *http.Request) (digest string, truncated bool, err error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxAuditedBodySize+1))
+	if err != nil {
+		_ = r.Body.Close()
+		return "", false, err
+	}
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+	if len(body) > maxAuditedBodySize {
+		body, truncated = body[:maxAuditedBodySize], true
+	}
+	if len(body) == 0 {
+		return "", false, nil
+	}
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:]), truncated, nil
+}
+
+// auditEntityID returns the ID of the entity of the path variables of a call, the id variable if any
+// or else the first of the variables named after an ID, eg: patternID
+func auditEntityID(vars map[string]string) string {
+	if id := vars["id"]; id != "" {
+		return id
+	}
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		if strings.HasSuffix(strings.ToLower(name), "id") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		return ""
+	}
+	return vars[names[0]]
+}
+
+func (h *Handler) saveAuditRecord(record *models.AuditRecord) {
+	persister := &models.AuditRecordPersister{DB: h.dbHandler}
+	if err := persister.SaveAuditRecord(record); err != nil {
+		h.log.Error(ErrAuditLog(err))
+	}
+}
+
+// swagger:route GET /api/system/audit SystemAPI idGetAuditRecords
+// Handle GET request for the audit log.
+//
+// Returns the calls of the API modifying the state of Meshery Server, most recent first. The calls can be filtered with the
+// user_id, method, endpoint, entity_id and result (success or failure) query parameters, and by the time they were made
+// with the since and until query parameters, RFC 3339 timestamps. The page, pagesize and order (asc or desc) query
+// parameters paginate the calls.
+// responses:
+//
+//	200: auditRecordsRespWrapper
+//	400:
+//	500:
+func (h *Handler) GetAuditRecordsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	_ models.Provider,
+) {
+	page, offset, limit, _, order, _, _ := getPaginationParams(r)
+	query := r.URL.Query()
+	filter := models.AuditFilter{
+		UserID:   query.Get("user_id"),
+		Method:   strings.ToUpper(query.Get("method")),
+		Endpoint: query.Get("endpoint"),
+		EntityID: query.Get("entity_id"),
+		Result:   query.Get("result"),
+		Offset:   offset,
+		Limit:    limit,
+		Order:    order,
+	}
+	for param, t := range map[string]*time.Time{"since": &filter.Since, "until": &filter.Until} {
+		value := query.Get(param)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			h.log.Error(ErrAuditFilter(err, param))
+			http.Error(rw, ErrAuditFilter(err, param).Error(), http.StatusBadRequest)
+			return
+		}
+		*t = parsed
+	}
+
+	persister := &models.AuditRecordPersister{DB: h.dbHandler}
+	records, count, err := persister.GetAuditRecords(filter)
+	if err != nil {
+		h.log.Error(ErrAuditLog(err))
+		http.Error(rw, ErrAuditLog(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(models.AuditRecordsPage{
+		Page:       page,
+		PageSize:   limit,
+		TotalCount: count,
+		Records:    records,
+	}); err != nil {
+		h.log.Error(models.ErrEncoding(err, "audit records"))
+		http.Error(rw, models.ErrEncoding(err, "audit records").Error(), http.StatusInternalServerError)
+	}
+}
+
+// RunAuditRetention deletes at every interval the entries of the audit log older than the AUDIT_RETENTION duration,
+// until the context is done. The entries are kept forever when AUDIT_RETENTION is 0.
+func (h *Handler) RunAuditRetention(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if retention := viper.GetDuration("AUDIT_RETENTION"); retention > 0 {
+			persister := &models.AuditRecordPersister{DB: h.dbHandler}
+			deleted, err := persister.DeleteAuditRecordsBefore(time.Now().Add(-retention))
+			if err != nil {
+				h.log.Error(ErrAuditLog(err))
+			} else if deleted > 0 {
+				h.log.Debug("deleted ", deleted, " entries of the audit log older than ", retention)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
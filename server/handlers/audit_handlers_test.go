@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAuditEntityID(t *testing.T) {
+	tests := []struct {
+		vars map[string]string
+		want string
+	}{
+		{map[string]string{"id": "0e3fa1c2", "name": "web"}, "0e3fa1c2"},
+		{map[string]string{"patternID": "0e3fa1c2", "scheduleId": "7d1c9a40"}, "0e3fa1c2"},
+		{map[string]string{"name": "web"}, ""},
+		{nil, ""},
+	}
+	for _, tt := range tests {
+		if got := auditEntityID(tt.vars); got != tt.want {
+			t.Errorf("auditEntityID(%v) = %q, want %q", tt.vars, got, tt.want)
+		}
+	}
+}
+
+func TestDigestAuditedBody(t *testing.T) {
+	large := strings.Repeat("a", maxAuditedBodySize+10)
+	largeSum := sha256.Sum256([]byte(large[:maxAuditedBodySize]))
+	smallSum := sha256.Sum256([]byte(`{"name":"web"}`))
+	tests := []struct {
+		name      string
+		body      string
+		digest    string
+		truncated bool
+	}{
+		{"empty body", "", "", false},
+		{"small body", `{"name":"web"}`, hex.EncodeToString(smallSum[:]), false},
+		{"body larger than the limit", large, hex.EncodeToString(largeSum[:]), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/api/pattern", strings.NewReader(tt.body))
+			digest, truncated, err := digestAuditedBody(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if digest != tt.digest || truncated != tt.truncated {
+				t.Errorf("digestAuditedBody() = %q, %t, want %q, %t", digest, truncated, tt.digest, tt.truncated)
+			}
+			body, err := io.ReadAll(r.Body)
+			if err != nil || string(body) != tt.body {
+				t.Errorf("the body restored for the next handlers has %d bytes, want %d: %v", len(body), len(tt.body), err)
+			}
+		})
+	}
+}
+
+func TestSkipAuditedBody(t *testing.T) {
+	upload := httptest.NewRequest(http.MethodPost, "/api/pattern/file", strings.NewReader("--x--"))
+	upload.Header.Set("Content-Type", "multipart/form-data; boundary=x")
+	large := httptest.NewRequest(http.MethodPost, "/api/pattern", strings.NewReader("{}"))
+	large.ContentLength = maxAuditedBodySize + 1
+	small := httptest.NewRequest(http.MethodPost, "/api/pattern", strings.NewReader("{}"))
+	small.Header.Set("Content-Type", "application/json")
+
+	for r, want := range map[*http.Request]bool{upload: true, large: true, small: false} {
+		if got := skipAuditedBody(r); got != want {
+			t.Errorf("skipAuditedBody(%s, %d bytes) = %t, want %t", r.Header.Get("Content-Type"), r.ContentLength, got, want)
+		}
+	}
+}
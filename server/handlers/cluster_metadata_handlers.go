@@ -0,0 +1,275 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/internal/sql"
+	"github.com/layer5io/meshery/server/models"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ClusterLabelsRequestBody is the body of the requests setting the labels of a cluster
+type ClusterLabelsRequestBody struct {
+	Labels map[string]string `json:"labels"`
+}
+
+// swagger:route GET /api/system/kubernetes/metadata SystemAPI idGetClustersMetadata
+// Handle GET request for the metadata of the clusters of the Kubernetes contexts
+//
+// Returns the metadata of the clusters of the Kubernetes contexts of the user: whether they are reachable, their version
+// and their labels, the ones discovered by probing them (cloud, region and distro) and the ones set by the users.
+// The clusters are discovered when their kubeconfig is uploaded and on demand, see idDiscoverClusters.
+// The clusters designs are deployed to can be chosen by their labels with the selector query parameter, a Kubernetes
+// label selector matched against their effective labels, eg: ```?selector=cloud=aws,region in (us-east-1,us-west-2)```.
+// responses:
+//
+//	200: clustersMetadataRespWrapper
+//	400:
+//	500:
+func (h *Handler) GetClustersMetadataHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	provider models.Provider,
+) {
+	selector := labels.Everything()
+	if s := r.URL.Query().Get("selector"); s != "" {
+		var err error
+		selector, err = labels.Parse(s)
+		if err != nil {
+			http.Error(rw, fmt.Sprintf("invalid selector %q: %s", s, err), http.StatusBadRequest)
+			return
+		}
+	}
+
+	token, _ := r.Context().Value(models.TokenCtxKey).(string)
+	contexts, err := provider.LoadAllK8sContext(token)
+	if err != nil {
+		h.log.Error(ErrClusterMetadata(err))
+		http.Error(rw, ErrClusterMetadata(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	ids := make([]string, 0, len(contexts))
+	for _, kc := range contexts {
+		ids = append(ids, kc.ID)
+	}
+	all, err := (&models.ClusterMetadataPersister{DB: h.dbHandler}).GetClustersMetadata(ids)
+	if err != nil {
+		h.log.Error(ErrClusterMetadata(err))
+		http.Error(rw, ErrClusterMetadata(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	clusters := []models.ClusterMetadata{}
+	for _, cm := range all {
+		if selector.Matches(cm.EffectiveLabels()) {
+			clusters = append(clusters, cm)
+		}
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(clusters); err != nil {
+		h.log.Error(models.ErrEncoding(err, "clusters metadata"))
+		http.Error(rw, models.ErrEncoding(err, "clusters metadata").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route POST /api/system/kubernetes/metadata/discover SystemAPI idDiscoverClusters
+// Handle POST request for discovering the clusters of the Kubernetes contexts
+//
+// Probes the clusters of the Kubernetes contexts of the user concurrently, and stores whether they are reachable,
+// their version and the labels discovered on them. The labels set by the users are kept.
+// responses:
+//
+//	200: clustersMetadataRespWrapper
+//	500:
+func (h *Handler) DiscoverClustersHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	provider models.Provider,
+) {
+	token, _ := r.Context().Value(models.TokenCtxKey).(string)
+	contexts, err := provider.LoadAllK8sContext(token)
+	if err != nil {
+		h.log.Error(ErrClusterMetadata(err))
+		http.Error(rw, ErrClusterMetadata(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.discoverClusters(r.Context(), contexts)
+
+	ids := make([]string, 0, len(contexts))
+	for _, kc := range contexts {
+		ids = append(ids, kc.ID)
+	}
+	clusters, err := (&models.ClusterMetadataPersister{DB: h.dbHandler}).GetClustersMetadata(ids)
+	if err != nil {
+		h.log.Error(ErrClusterMetadata(err))
+		http.Error(rw, ErrClusterMetadata(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(clusters); err != nil {
+		h.log.Error(models.ErrEncoding(err, "clusters metadata"))
+		http.Error(rw, models.ErrEncoding(err, "clusters metadata").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route GET /api/system/kubernetes/contexts/{id}/metadata SystemAPI idGetClusterMetadata
+// Handle GET request for the metadata of the cluster of a Kubernetes context
+//
+// Returns the metadata of the cluster of the Kubernetes context with the given ID, see idGetClustersMetadata.
+// The metadata of a cluster which was not discovered yet has no version and no labels.
+// responses:
+//
+//	200: clusterMetadataRespWrapper
+//	404:
+//	500:
+func (h *Handler) GetClusterMetadataHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	provider models.Provider,
+) {
+	cm, ok := h.userClusterMetadata(rw, r, provider)
+	if !ok {
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(cm); err != nil {
+		h.log.Error(models.ErrEncoding(err, "cluster metadata"))
+		http.Error(rw, models.ErrEncoding(err, "cluster metadata").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route PUT /api/system/kubernetes/contexts/{id}/metadata SystemAPI idUpdateClusterMetadata
+// Handle PUT request for setting the labels of the cluster of a Kubernetes context
+//
+// Replaces the labels set by the users on the cluster of the Kubernetes context with the given ID with the labels of the body,
+// eg: {"labels": {"env": "production", "region": "eu-west-1"}}. The labels set by the users override the discovered
+// labels with the same keys. The keys and the values of the labels follow the syntax of the labels of Kubernetes.
+// responses:
+//
+//	200: clusterMetadataRespWrapper
+//	400:
+//	404:
+//	500:
+func (h *Handler) UpdateClusterMetadataHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	provider models.Provider,
+) {
+	var body ClusterLabelsRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := validateClusterLabels(body.Labels); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	cm, ok := h.userClusterMetadata(rw, r, provider)
+	if !ok {
+		return
+	}
+	cm.Labels = sql.Map{}
+	for k, v := range body.Labels {
+		cm.Labels[k] = v
+	}
+	if err := (&models.ClusterMetadataPersister{DB: h.dbHandler}).SaveClusterMetadata(cm); err != nil {
+		h.log.Error(ErrClusterMetadata(err))
+		http.Error(rw, ErrClusterMetadata(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(cm); err != nil {
+		h.log.Error(models.ErrEncoding(err, "cluster metadata"))
+		http.Error(rw, models.ErrEncoding(err, "cluster metadata").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route DELETE /api/system/kubernetes/contexts/{id}/metadata SystemAPI idDeleteClusterMetadata
+// Handle DELETE request for the metadata of the cluster of a Kubernetes context
+//
+// Deletes the metadata of the cluster of the Kubernetes context with the given ID, including the labels set by the users.
+// The cluster is discovered again the next time the clusters are discovered.
+// responses:
+//
+//	204:
+//	404:
+//	500:
+func (h *Handler) DeleteClusterMetadataHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	provider models.Provider,
+) {
+	cm, ok := h.userClusterMetadata(rw, r, provider)
+	if !ok {
+		return
+	}
+	if err := (&models.ClusterMetadataPersister{DB: h.dbHandler}).DeleteClusterMetadata(cm.ContextID); err != nil {
+		h.log.Error(ErrClusterMetadata(err))
+		http.Error(rw, ErrClusterMetadata(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// userClusterMetadata returns the metadata of the cluster of the Kubernetes context of the id path variable if the context
+// belongs to the user, with no labels if it was not discovered yet, or writes the error response and returns false
+func (h *Handler) userClusterMetadata(rw http.ResponseWriter, r *http.Request, provider models.Provider) (*models.ClusterMetadata, bool) {
+	id := mux.Vars(r)["id"]
+	token, _ := r.Context().Value(models.TokenCtxKey).(string)
+	kc, err := provider.GetK8sContext(token, id)
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("Kubernetes context %s not found", id), http.StatusNotFound)
+		return nil, false
+	}
+	cm, err := (&models.ClusterMetadataPersister{DB: h.dbHandler}).GetClusterMetadata(kc.ID)
+	if err != nil {
+		h.log.Error(ErrClusterMetadata(err))
+		http.Error(rw, ErrClusterMetadata(err).Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	if cm == nil {
+		cm = &models.ClusterMetadata{ContextID: kc.ID, Name: kc.Name, Server: kc.Server}
+	}
+	return cm, true
+}
+
+// discoverClusters probes the clusters of the contexts and stores their metadata, keeping the labels set by the users
+func (h *Handler) discoverClusters(ctx context.Context, contexts []*models.K8sContext) {
+	persister := &models.ClusterMetadataPersister{DB: h.dbHandler}
+	for _, cm := range models.DiscoverClusters(ctx, contexts) {
+		cm := cm
+		if err := persister.SaveDiscoveredClusterMetadata(&cm); err != nil {
+			h.log.Error(ErrClusterMetadata(err))
+		}
+	}
+}
+
+// validateClusterLabels returns an error if the keys or the values of the labels do not follow the syntax of the labels of Kubernetes
+func validateClusterLabels(clusterLabels map[string]string) error {
+	for k, v := range clusterLabels {
+		if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+			return fmt.Errorf("invalid label key %q: %s", k, strings.Join(errs, ", "))
+		}
+		if errs := validation.IsValidLabelValue(v); len(errs) > 0 {
+			return fmt.Errorf("invalid value %q of label %s: %s", v, k, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
@@ -1,13 +1,16 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
 	"github.com/layer5io/meshery/server/helpers/utils"
 	"github.com/layer5io/meshery/server/models"
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
 	meshkitmodels "github.com/layer5io/meshkit/models"
 	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
 	meshmodel "github.com/layer5io/meshkit/models/meshmodel/registry"
@@ -22,6 +25,16 @@ type componentGenerationPayload struct {
 	Data []generationPayloadItem `json:"data"`
 }
 
+type crdComponentGenerationPayload struct {
+	// CRD is the YAML of one or more CustomResourceDefinitions
+	CRD string `json:"crd"`
+	// Name is the name of a CustomResourceDefinition of the connected clusters, eg: certificates.cert-manager.io
+	Name string `json:"name"`
+	// Model the components belong to, derived from the CRDs when empty
+	Model    v1alpha1.Model `json:"model"`
+	Register bool           `json:"register"`
+}
+
 type componentGenerationResponseDataItem struct {
 	Name       string                         `json:"name"`
 	Components []v1alpha1.ComponentDefinition `json:"components"`
@@ -72,7 +85,7 @@ func (h *Handler) MeshModelGenerationHandler(rw http.ResponseWriter, r *http.Req
 			for _, comp := range comps {
 				utils.WriteSVGsOnFileSystem(&comp)
 				host := fmt.Sprintf("%s.artifacthub.meshery", gpi.Name)
-				err = h.registryManager.RegisterEntity(meshmodel.Host{
+				err = h.registerEntity(r.Context(), meshmodel.Host{
 					IHost:    meshmodel.ArtifactHub{},
 					Hostname: meshmodel.ArtifactHub{}.String(),
 					Metadata: host,
@@ -109,3 +122,119 @@ func generateComponents(pm meshkitmodels.PackageManager) ([]v1alpha1.ComponentDe
 	}
 	return components, nil
 }
+
+// swagger:route POST /api/meshmodels/generate/crd MeshmodelComponentGenerate idPostMeshModelCRDComponentGenerate
+// Handle POST request for generating components from CustomResourceDefinitions
+//
+// Generates a component for every CustomResourceDefinition given as YAML, or for the CustomResourceDefinition of the
+// connected clusters with the given name. The schema of a component is the openAPIV3Schema of the storage version of
+// its CRD. The components are registered when register is set, so that operators missing from the registry can be
+// used in designs.
+// responses:
+// 	200:
+//	400:
+//	404:
+
+// request body should be json
+// request body should be of format - {crd: string, name: string, model: {name: string, version: string}, register: boolean}
+// response format - {name: string, components: [component], errors: [string]}
+func (h *Handler) CRDComponentGenerationHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	_ models.Provider,
+) {
+	var pld crdComponentGenerationPayload
+	if err := json.NewDecoder(r.Body).Decode(&pld); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if (pld.CRD == "") == (pld.Name == "") {
+		http.Error(rw, "either the crd or the name of a CustomResourceDefinition of the connected clusters is required", http.StatusBadRequest)
+		return
+	}
+
+	crd := []byte(pld.CRD)
+	host := meshmodel.Host{IHost: meshmodel.Kubernetes{}, Hostname: meshmodel.Kubernetes{}.String()}
+	if pld.Name != "" {
+		var contextID string
+		var err error
+		crd, contextID, err = getClusterCRD(r.Context(), pld.Name)
+		if err != nil {
+			h.log.Error(ErrGenerateComponents(err))
+			http.Error(rw, ErrGenerateComponents(err).Error(), http.StatusNotFound)
+			return
+		}
+		host.Metadata = contextID
+	}
+
+	comps, err := mesherymeshmodel.GenerateCRDComponents(crd, pld.Model)
+	if err != nil {
+		h.log.Error(ErrGenerateComponents(err))
+		http.Error(rw, ErrGenerateComponents(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := componentGenerationResponseDataItem{Name: pld.Name, Components: comps}
+	if pld.Register {
+		for _, comp := range comps {
+			_, modelCount, _ := h.getRegistryModels(r.Context(), &v1alpha1.ModelFilter{
+				Name:    comp.Model.Name,
+				Version: comp.Model.Version,
+				Limit:   1,
+			})
+			if err := h.registerEntity(r.Context(), host, comp); err != nil {
+				h.log.Error(ErrGenerateComponents(err))
+				response.Errors = append(response.Errors, err.Error())
+				continue
+			}
+			if modelCount == 0 {
+				h.config.MeshModelEventsChannel.Publish(mesherymeshmodel.RegistryEvent{
+					Action:       mesherymeshmodel.RegistryEventRegistered,
+					EntityType:   mesherymeshmodel.RegistryEntityModel,
+					Model:        comp.Model.Name,
+					ModelVersion: comp.Model.Version,
+				})
+			}
+			h.config.MeshModelEventsChannel.Publish(mesherymeshmodel.RegistryEvent{
+				Action:       mesherymeshmodel.RegistryEventRegistered,
+				EntityType:   mesherymeshmodel.RegistryEntityComponent,
+				Kind:         comp.Kind,
+				Model:        comp.Model.Name,
+				ModelVersion: comp.Model.Version,
+			})
+			h.log.Info(comp.DisplayName, " component generated from the CustomResourceDefinition")
+		}
+		go h.config.MeshModelSummaryChannel.Publish()
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(response); err != nil {
+		h.log.Error(ErrGenerateComponents(err))
+		http.Error(rw, ErrGenerateComponents(err).Error(), http.StatusInternalServerError)
+	}
+}
+
+// getClusterCRD returns the CustomResourceDefinition with the given name from the first of the connected clusters
+// it is found in, and the id of the Kubernetes context of the cluster
+func getClusterCRD(ctx context.Context, name string) ([]byte, string, error) {
+	k8scontexts, ok := ctx.Value(models.KubeClustersKey).([]models.K8sContext)
+	if !ok || len(k8scontexts) == 0 {
+		return nil, "", ErrInvalidKubeHandler(fmt.Errorf("failed to find k8s handler"), "no Kubernetes cluster is connected")
+	}
+	for _, k8scontext := range k8scontexts {
+		kubeclient, err := k8scontext.GenerateKubeHandler()
+		if err != nil {
+			continue
+		}
+		crd, err := kubeclient.KubeClient.RESTClient().Get().
+			RequestURI("/apis/apiextensions.k8s.io/v1/customresourcedefinitions/" + url.PathEscape(name)).
+			Do(ctx).Raw()
+		if err == nil {
+			return crd, k8scontext.ID, nil
+		}
+	}
+	return nil, "", fmt.Errorf("CustomResourceDefinition %s not found in the connected clusters", name)
+}
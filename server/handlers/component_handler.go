@@ -7,7 +7,9 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/layer5io/meshery/server/helpers/utils"
+	"github.com/layer5io/meshery/server/internal/jsonstream"
 	"github.com/layer5io/meshery/server/models"
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
 	"github.com/layer5io/meshery/server/models/pattern/core"
 	"github.com/layer5io/meshkit/models/meshmodel/core/types"
 	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
@@ -37,7 +39,6 @@ const DefaultPageSizeForMeshModelComponents = 25
 //	200: []meshmodelModelsDuplicateResponseWrapper
 func (h *Handler) GetMeshmodelModelsByCategories(rw http.ResponseWriter, r *http.Request) {
 	rw.Header().Add("Content-Type", "application/json")
-	enc := json.NewEncoder(rw)
 	cat := mux.Vars(r)["category"]
 	limitstr := r.URL.Query().Get("pagesize")
 	var limit int
@@ -65,7 +66,7 @@ func (h *Handler) GetMeshmodelModelsByCategories(rw http.ResponseWriter, r *http
 		filter.Greedy = true
 		filter.DisplayName = r.URL.Query().Get("search")
 	}
-	meshmodels, count, _ := h.registryManager.GetModels(h.dbHandler, filter)
+	meshmodels, count, _ := h.getRegistryModels(r.Context(), filter)
 
 	var pgSize int64
 	if limitstr == "all" {
@@ -81,7 +82,7 @@ func (h *Handler) GetMeshmodelModelsByCategories(rw http.ResponseWriter, r *http
 		Models:   models.FindDuplicateModels(meshmodels),
 	}
 
-	if err := enc.Encode(res); err != nil {
+	if err := jsonstream.Encode(rw, res); err != nil {
 		h.log.Error(ErrGetMeshModels(err)) //TODO: Add appropriate meshkit error
 		http.Error(rw, ErrGetMeshModels(err).Error(), http.StatusInternalServerError)
 	}
@@ -107,7 +108,6 @@ func (h *Handler) GetMeshmodelModelsByCategories(rw http.ResponseWriter, r *http
 //	200: []meshmodelModelsDuplicateResponseWrapper
 func (h *Handler) GetMeshmodelModelsByCategoriesByModel(rw http.ResponseWriter, r *http.Request) {
 	rw.Header().Add("Content-Type", "application/json")
-	enc := json.NewEncoder(rw)
 	cat := mux.Vars(r)["category"]
 	model := mux.Vars(r)["model"]
 	var greedy bool
@@ -128,7 +128,7 @@ func (h *Handler) GetMeshmodelModelsByCategoriesByModel(rw http.ResponseWriter,
 		page = 1
 	}
 	offset := (page - 1) * limit
-	meshmodels, count, _ := h.registryManager.GetModels(h.dbHandler, &v1alpha1.ModelFilter{
+	meshmodels, count, _ := h.getRegistryModels(r.Context(), &v1alpha1.ModelFilter{
 		Category: cat,
 		Name:     model,
 		Version:  r.URL.Query().Get("version"),
@@ -153,7 +153,7 @@ func (h *Handler) GetMeshmodelModelsByCategoriesByModel(rw http.ResponseWriter,
 		Models:   models.FindDuplicateModels(meshmodels),
 	}
 
-	if err := enc.Encode(res); err != nil {
+	if err := jsonstream.Encode(rw, res); err != nil {
 		h.log.Error(ErrGetMeshModels(err)) //TODO: Add appropriate meshkit error
 		http.Error(rw, ErrGetMeshModels(err).Error(), http.StatusInternalServerError)
 	}
@@ -180,7 +180,6 @@ func (h *Handler) GetMeshmodelModelsByCategoriesByModel(rw http.ResponseWriter,
 //	200: meshmodelModelsDuplicateResponseWrapper
 func (h *Handler) GetMeshmodelModels(rw http.ResponseWriter, r *http.Request) {
 	rw.Header().Add("Content-Type", "application/json")
-	enc := json.NewEncoder(rw)
 	v := r.URL.Query().Get("version")
 	limitstr := r.URL.Query().Get("pagesize")
 	var limit int
@@ -208,7 +207,7 @@ func (h *Handler) GetMeshmodelModels(rw http.ResponseWriter, r *http.Request) {
 		filter.Greedy = true
 	}
 
-	meshmodels, count, _ := h.registryManager.GetModels(h.dbHandler, filter)
+	meshmodels, count, _ := h.getRegistryModels(r.Context(), filter)
 
 	var pgSize int64
 	if limitstr == "all" {
@@ -224,7 +223,7 @@ func (h *Handler) GetMeshmodelModels(rw http.ResponseWriter, r *http.Request) {
 		Models:   models.FindDuplicateModels(meshmodels),
 	}
 
-	if err := enc.Encode(res); err != nil {
+	if err := jsonstream.Encode(rw, res); err != nil {
 		h.log.Error(ErrGetMeshModels(err)) //TODO: Add appropriate meshkit error
 		http.Error(rw, ErrGetMeshModels(err).Error(), http.StatusInternalServerError)
 	}
@@ -251,7 +250,6 @@ func (h *Handler) GetMeshmodelModels(rw http.ResponseWriter, r *http.Request) {
 //	200: []meshmodelModelsDuplicateResponseWrapper
 func (h *Handler) GetMeshmodelModelsByName(rw http.ResponseWriter, r *http.Request) {
 	rw.Header().Add("Content-Type", "application/json")
-	enc := json.NewEncoder(rw)
 	name := mux.Vars(r)["model"]
 	var greedy bool
 	if r.URL.Query().Get("search") == "true" {
@@ -272,7 +270,7 @@ func (h *Handler) GetMeshmodelModelsByName(rw http.ResponseWriter, r *http.Reque
 		page = 1
 	}
 	offset := (page - 1) * limit
-	meshmodels, count, _ := h.registryManager.GetModels(h.dbHandler, &v1alpha1.ModelFilter{
+	meshmodels, count, _ := h.getRegistryModels(r.Context(), &v1alpha1.ModelFilter{
 		Name:    name,
 		Version: v,
 		Limit:   limit,
@@ -296,7 +294,7 @@ func (h *Handler) GetMeshmodelModelsByName(rw http.ResponseWriter, r *http.Reque
 		Models:   models.FindDuplicateModels(meshmodels),
 	}
 
-	if err := enc.Encode(res); err != nil {
+	if err := jsonstream.Encode(rw, res); err != nil {
 		h.log.Error(ErrGetMeshModels(err)) //TODO: Add appropriate meshkit error
 		http.Error(rw, ErrGetMeshModels(err).Error(), http.StatusInternalServerError)
 	}
@@ -319,7 +317,6 @@ func (h *Handler) GetMeshmodelModelsByName(rw http.ResponseWriter, r *http.Reque
 //	200: []meshmodelCategoriesResponseWrapper
 func (h *Handler) GetMeshmodelCategories(rw http.ResponseWriter, r *http.Request) {
 	rw.Header().Add("Content-Type", "application/json")
-	enc := json.NewEncoder(rw)
 	limitstr := r.URL.Query().Get("pagesize")
 	var limit int
 	if limitstr != "all" {
@@ -345,7 +342,7 @@ func (h *Handler) GetMeshmodelCategories(rw http.ResponseWriter, r *http.Request
 		filter.Name = r.URL.Query().Get("search")
 	}
 
-	categories, count := h.registryManager.GetCategories(h.dbHandler, filter)
+	categories, count := h.getRegistryCategories(r.Context(), filter)
 
 	var pgSize int64
 
@@ -362,7 +359,7 @@ func (h *Handler) GetMeshmodelCategories(rw http.ResponseWriter, r *http.Request
 		Categories: categories,
 	}
 
-	if err := enc.Encode(res); err != nil {
+	if err := jsonstream.Encode(rw, res); err != nil {
 		h.log.Error(ErrGetMeshModels(err)) //TODO: Add appropriate meshkit error
 		http.Error(rw, ErrGetMeshModels(err).Error(), http.StatusInternalServerError)
 	}
@@ -385,7 +382,6 @@ func (h *Handler) GetMeshmodelCategories(rw http.ResponseWriter, r *http.Request
 //	200: []meshmodelCategoriesResponseWrapper
 func (h *Handler) GetMeshmodelCategoriesByName(rw http.ResponseWriter, r *http.Request) {
 	rw.Header().Add("Content-Type", "application/json")
-	enc := json.NewEncoder(rw)
 	name := mux.Vars(r)["category"]
 	var greedy bool
 	if r.URL.Query().Get("search") == "true" {
@@ -405,7 +401,7 @@ func (h *Handler) GetMeshmodelCategoriesByName(rw http.ResponseWriter, r *http.R
 		page = 1
 	}
 	offset := (page - 1) * limit
-	categories, count := h.registryManager.GetCategories(h.dbHandler, &v1alpha1.CategoryFilter{
+	categories, count := h.getRegistryCategories(r.Context(), &v1alpha1.CategoryFilter{
 		Name:    name,
 		Limit:   limit,
 		Greedy:  greedy,
@@ -429,7 +425,7 @@ func (h *Handler) GetMeshmodelCategoriesByName(rw http.ResponseWriter, r *http.R
 		Categories: categories,
 	}
 
-	if err := enc.Encode(res); err != nil {
+	if err := jsonstream.Encode(rw, res); err != nil {
 		h.log.Error(ErrGetMeshModels(err)) //TODO: Add appropriate meshkit error
 		http.Error(rw, ErrGetMeshModels(err).Error(), http.StatusInternalServerError)
 	}
@@ -458,7 +454,6 @@ func (h *Handler) GetMeshmodelCategoriesByName(rw http.ResponseWriter, r *http.R
 // 200: []meshmodelComponentsDuplicateResponseWrapper
 func (h *Handler) GetMeshmodelComponentsByNameByModelByCategory(rw http.ResponseWriter, r *http.Request) {
 	rw.Header().Add("Content-Type", "application/json")
-	enc := json.NewEncoder(rw)
 	name := mux.Vars(r)["name"]
 	var greedy bool
 	if r.URL.Query().Get("search") == "true" {
@@ -481,7 +476,7 @@ func (h *Handler) GetMeshmodelComponentsByNameByModelByCategory(rw http.Response
 		page = 1
 	}
 	offset := (page - 1) * limit
-	entities, count, _ := h.registryManager.GetEntities(&v1alpha1.ComponentFilter{
+	entities, count, _ := h.getRegistryEntities(r.Context(), &v1alpha1.ComponentFilter{
 		Name:         name,
 		CategoryName: cat,
 		ModelName:    typ,
@@ -520,7 +515,7 @@ func (h *Handler) GetMeshmodelComponentsByNameByModelByCategory(rw http.Response
 		Components: models.FindDuplicateComponents(comps),
 	}
 
-	if err := enc.Encode(response); err != nil {
+	if err := jsonstream.Encode(rw, response); err != nil {
 		h.log.Error(ErrGetMeshModels(err)) //TODO: Add appropriate meshkit error
 		http.Error(rw, ErrGetMeshModels(err).Error(), http.StatusInternalServerError)
 	}
@@ -552,7 +547,6 @@ func (h *Handler) GetMeshmodelComponentsByNameByModelByCategory(rw http.Response
 //	200: []meshmodelComponentsDuplicateResponseWrapper
 func (h *Handler) GetMeshmodelComponentsByNameByCategory(rw http.ResponseWriter, r *http.Request) {
 	rw.Header().Add("Content-Type", "application/json")
-	enc := json.NewEncoder(rw)
 	name := mux.Vars(r)["name"]
 	var greedy bool
 	if r.URL.Query().Get("search") == "true" {
@@ -574,7 +568,7 @@ func (h *Handler) GetMeshmodelComponentsByNameByCategory(rw http.ResponseWriter,
 		page = 1
 	}
 	offset := (page - 1) * limit
-	entities, count, _ := h.registryManager.GetEntities(&v1alpha1.ComponentFilter{
+	entities, count, _ := h.getRegistryEntities(r.Context(), &v1alpha1.ComponentFilter{
 		Name:         name,
 		ModelName:    r.URL.Query().Get("model"),
 		CategoryName: cat,
@@ -613,7 +607,7 @@ func (h *Handler) GetMeshmodelComponentsByNameByCategory(rw http.ResponseWriter,
 		Components: models.FindDuplicateComponents(comps),
 	}
 
-	if err := enc.Encode(response); err != nil {
+	if err := jsonstream.Encode(rw, response); err != nil {
 		h.log.Error(ErrGetMeshModels(err)) //TODO: Add appropriate meshkit error
 		http.Error(rw, ErrGetMeshModels(err).Error(), http.StatusInternalServerError)
 	}
@@ -643,7 +637,6 @@ func (h *Handler) GetMeshmodelComponentsByNameByCategory(rw http.ResponseWriter,
 //	200: []meshmodelComponentsDuplicateResponseWrapper
 func (h *Handler) GetMeshmodelComponentsByNameByModel(rw http.ResponseWriter, r *http.Request) {
 	rw.Header().Add("Content-Type", "application/json")
-	enc := json.NewEncoder(rw)
 	name := mux.Vars(r)["name"]
 	var greedy bool
 	if r.URL.Query().Get("search") == "true" {
@@ -665,7 +658,7 @@ func (h *Handler) GetMeshmodelComponentsByNameByModel(rw http.ResponseWriter, r
 		page = 1
 	}
 	offset := (page - 1) * limit
-	entities, count, _ := h.registryManager.GetEntities(&v1alpha1.ComponentFilter{
+	entities, count, _ := h.getRegistryEntities(r.Context(), &v1alpha1.ComponentFilter{
 		Name:       name,
 		ModelName:  typ,
 		APIVersion: r.URL.Query().Get("apiVersion"),
@@ -703,7 +696,7 @@ func (h *Handler) GetMeshmodelComponentsByNameByModel(rw http.ResponseWriter, r
 		Components: models.FindDuplicateComponents(comps),
 	}
 
-	if err := enc.Encode(response); err != nil {
+	if err := jsonstream.Encode(rw, response); err != nil {
 		h.log.Error(ErrGetMeshModels(err)) //TODO: Add appropriate meshkit error
 		http.Error(rw, ErrGetMeshModels(err).Error(), http.StatusInternalServerError)
 	}
@@ -736,7 +729,6 @@ func (h *Handler) GetMeshmodelComponentsByNameByModel(rw http.ResponseWriter, r
 // 200: []meshmodelComponentsDuplicateResponseWrapper
 func (h *Handler) GetAllMeshmodelComponentsByName(rw http.ResponseWriter, r *http.Request) {
 	rw.Header().Add("Content-Type", "application/json")
-	enc := json.NewEncoder(rw)
 	name := mux.Vars(r)["name"]
 	var greedy bool
 	if r.URL.Query().Get("search") == "true" {
@@ -757,7 +749,7 @@ func (h *Handler) GetAllMeshmodelComponentsByName(rw http.ResponseWriter, r *htt
 		page = 1
 	}
 	offset := (page - 1) * limit
-	entities, count, _ := h.registryManager.GetEntities(&v1alpha1.ComponentFilter{
+	entities, count, _ := h.getRegistryEntities(r.Context(), &v1alpha1.ComponentFilter{
 		Name:       name,
 		Trim:       r.URL.Query().Get("trim") == "true",
 		APIVersion: r.URL.Query().Get("apiVersion"),
@@ -796,7 +788,7 @@ func (h *Handler) GetAllMeshmodelComponentsByName(rw http.ResponseWriter, r *htt
 		Components: models.FindDuplicateComponents(comps),
 	}
 
-	if err := enc.Encode(response); err != nil {
+	if err := jsonstream.Encode(rw, response); err != nil {
 		h.log.Error(ErrGetMeshModels(err)) //TODO: Add appropriate meshkit error
 		http.Error(rw, ErrGetMeshModels(err).Error(), http.StatusInternalServerError)
 	}
@@ -827,7 +819,6 @@ func (h *Handler) GetAllMeshmodelComponentsByName(rw http.ResponseWriter, r *htt
 // 200: []meshmodelComponentsDuplicateResponseWrapper
 func (h *Handler) GetMeshmodelComponentByModel(rw http.ResponseWriter, r *http.Request) {
 	rw.Header().Add("Content-Type", "application/json")
-	enc := json.NewEncoder(rw)
 	typ := mux.Vars(r)["model"]
 	v := r.URL.Query().Get("version")
 	limitstr := r.URL.Query().Get("pagesize")
@@ -858,7 +849,7 @@ func (h *Handler) GetMeshmodelComponentByModel(rw http.ResponseWriter, r *http.R
 		filter.Greedy = true
 		filter.DisplayName = r.URL.Query().Get("search")
 	}
-	entities, count, _ := h.registryManager.GetEntities(filter)
+	entities, count, _ := h.getRegistryEntities(r.Context(), filter)
 	var comps []v1alpha1.ComponentDefinition
 	for _, r := range entities {
 		comp, ok := r.(v1alpha1.ComponentDefinition)
@@ -886,7 +877,7 @@ func (h *Handler) GetMeshmodelComponentByModel(rw http.ResponseWriter, r *http.R
 		Components: models.FindDuplicateComponents(comps),
 	}
 
-	if err := enc.Encode(response); err != nil {
+	if err := jsonstream.Encode(rw, response); err != nil {
 		h.log.Error(ErrGetMeshModels(err)) //TODO: Add appropriate meshkit error
 		http.Error(rw, ErrGetMeshModels(err).Error(), http.StatusInternalServerError)
 	}
@@ -919,7 +910,6 @@ func (h *Handler) GetMeshmodelComponentByModel(rw http.ResponseWriter, r *http.R
 // 200: []meshmodelComponentsDuplicateResponseWrapper
 func (h *Handler) GetMeshmodelComponentByModelByCategory(rw http.ResponseWriter, r *http.Request) {
 	rw.Header().Add("Content-Type", "application/json")
-	enc := json.NewEncoder(rw)
 	typ := mux.Vars(r)["model"]
 	cat := mux.Vars(r)["category"]
 	v := r.URL.Query().Get("version")
@@ -952,7 +942,7 @@ func (h *Handler) GetMeshmodelComponentByModelByCategory(rw http.ResponseWriter,
 		filter.Greedy = true
 		filter.DisplayName = r.URL.Query().Get("search")
 	}
-	entities, count, _ := h.registryManager.GetEntities(filter)
+	entities, count, _ := h.getRegistryEntities(r.Context(), filter)
 	var comps []v1alpha1.ComponentDefinition
 	for _, r := range entities {
 		comp, ok := r.(v1alpha1.ComponentDefinition)
@@ -980,7 +970,7 @@ func (h *Handler) GetMeshmodelComponentByModelByCategory(rw http.ResponseWriter,
 		Components: models.FindDuplicateComponents(comps),
 	}
 
-	if err := enc.Encode(response); err != nil {
+	if err := jsonstream.Encode(rw, response); err != nil {
 		h.log.Error(ErrGetMeshModels(err)) //TODO: Add appropriate meshkit error
 		http.Error(rw, ErrGetMeshModels(err).Error(), http.StatusInternalServerError)
 	}
@@ -1011,7 +1001,6 @@ func (h *Handler) GetMeshmodelComponentByModelByCategory(rw http.ResponseWriter,
 //	200: []meshmodelComponentsDuplicateResponseWrapper
 func (h *Handler) GetMeshmodelComponentByCategory(rw http.ResponseWriter, r *http.Request) {
 	rw.Header().Add("Content-Type", "application/json")
-	enc := json.NewEncoder(rw)
 	cat := mux.Vars(r)["category"]
 	v := r.URL.Query().Get("version")
 	limitstr := r.URL.Query().Get("pagesize")
@@ -1042,7 +1031,7 @@ func (h *Handler) GetMeshmodelComponentByCategory(rw http.ResponseWriter, r *htt
 		filter.Greedy = true
 		filter.DisplayName = r.URL.Query().Get("search")
 	}
-	entities, count, _ := h.registryManager.GetEntities(filter)
+	entities, count, _ := h.getRegistryEntities(r.Context(), filter)
 	var comps []v1alpha1.ComponentDefinition
 	for _, r := range entities {
 		comp, ok := r.(v1alpha1.ComponentDefinition)
@@ -1070,7 +1059,7 @@ func (h *Handler) GetMeshmodelComponentByCategory(rw http.ResponseWriter, r *htt
 		Components: models.FindDuplicateComponents(comps),
 	}
 
-	if err := enc.Encode(response); err != nil {
+	if err := jsonstream.Encode(rw, response); err != nil {
 		h.log.Error(ErrGetMeshModels(err)) //TODO: Add appropriate meshkit error
 		http.Error(rw, ErrGetMeshModels(err).Error(), http.StatusInternalServerError)
 	}
@@ -1101,7 +1090,6 @@ func (h *Handler) GetMeshmodelComponentByCategory(rw http.ResponseWriter, r *htt
 
 func (h *Handler) GetAllMeshmodelComponents(rw http.ResponseWriter, r *http.Request) {
 	rw.Header().Add("Content-Type", "application/json")
-	enc := json.NewEncoder(rw)
 	v := r.URL.Query().Get("version")
 	limitstr := r.URL.Query().Get("pagesize")
 	var limit int
@@ -1131,7 +1119,7 @@ func (h *Handler) GetAllMeshmodelComponents(rw http.ResponseWriter, r *http.Requ
 		filter.Greedy = true
 		filter.DisplayName = r.URL.Query().Get("search")
 	}
-	entities, count, _ := h.registryManager.GetEntities(filter)
+	entities, count, _ := h.getRegistryEntities(r.Context(), filter)
 	var comps []v1alpha1.ComponentDefinition
 	for _, r := range entities {
 		comp, ok := r.(v1alpha1.ComponentDefinition)
@@ -1164,7 +1152,7 @@ func (h *Handler) GetAllMeshmodelComponents(rw http.ResponseWriter, r *http.Requ
 		Components: models.FindDuplicateComponents(comps),
 	}
 
-	if err := enc.Encode(res); err != nil {
+	if err := jsonstream.Encode(rw, res); err != nil {
 		h.log.Error(ErrGetMeshModels(err)) //TODO: Add appropriate meshkit error
 		http.Error(rw, ErrGetMeshModels(err).Error(), http.StatusInternalServerError)
 	}
@@ -1196,7 +1184,29 @@ func (h *Handler) RegisterMeshmodelComponents(rw http.ResponseWriter, r *http.Re
 			return
 		}
 		utils.WriteSVGsOnFileSystem(&c)
-		err = h.registryManager.RegisterEntity(cc.Host, c)
+		_, modelCount, _ := h.getRegistryModels(r.Context(), &v1alpha1.ModelFilter{
+			Name:    c.Model.Name,
+			Version: c.Model.Version,
+			Limit:   1,
+		})
+		err = h.registerEntity(r.Context(), cc.Host, c)
+		if err == nil {
+			if modelCount == 0 {
+				h.config.MeshModelEventsChannel.Publish(mesherymeshmodel.RegistryEvent{
+					Action:       mesherymeshmodel.RegistryEventRegistered,
+					EntityType:   mesherymeshmodel.RegistryEntityModel,
+					Model:        c.Model.Name,
+					ModelVersion: c.Model.Version,
+				})
+			}
+			h.config.MeshModelEventsChannel.Publish(mesherymeshmodel.RegistryEvent{
+				Action:       mesherymeshmodel.RegistryEventRegistered,
+				EntityType:   mesherymeshmodel.RegistryEntityComponent,
+				Kind:         c.Kind,
+				Model:        c.Model.Name,
+				ModelVersion: c.Model.Version,
+			})
+		}
 	}
 	if err != nil {
 		http.Error(rw, err.Error(), http.StatusBadRequest)
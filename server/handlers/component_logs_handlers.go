@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshery/server/models/pattern/patterns/k8s"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// swagger:route GET /api/system/logs SystemAPI idGetComponentLogs
+// Handle GET request for the logs of a component of a deployed design
+//
+// Streams the logs of the containers of the pods of the component query parameter of the deployed design of the designID
+// query parameter as Server-Sent Events, one event per line. The pods of a component are the pod itself for a pod, and the
+// pods selected by the resource of the component for the workloads, eg: deployments, and the services.
+// The lines of the containers are interleaved as they are read, each event names the pod and the container of its line.
+//
+// The follow query parameter keeps streaming the new lines until the client goes away, the tail query parameter limits the
+// logs of every container to their last lines and the since query parameter to the lines written since a duration,
+// eg: 10m, or an RFC 3339 time. Only the pods which exist when the request is received are streamed.
+// responses:
+//
+//	200: componentLogsResponseWrapper
+//	400:
+//	404:
+//	500:
+func (h *Handler) GetComponentLogsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		h.log.Error(ErrEventStreamingNotSupported)
+		http.Error(rw, "Event streaming is not supported at the moment.", http.StatusInternalServerError)
+		return
+	}
+
+	query := r.URL.Query()
+	id, err := uuid.FromString(query.Get("designID"))
+	if err != nil {
+		http.Error(rw, "invalid deployed design id", http.StatusBadRequest)
+		return
+	}
+	name := query.Get("component")
+	if name == "" {
+		http.Error(rw, "the component query parameter is required", http.StatusBadRequest)
+		return
+	}
+	opts, err := podLogOptions(query)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	deployed, err := (&models.DeployedPatternPersister{DB: h.dbHandler}).GetDeployedPattern(id)
+	if err != nil {
+		h.log.Error(ErrComponentLogs(err))
+		http.Error(rw, ErrComponentLogs(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if deployed == nil || deployed.UserID != user.ID {
+		http.Error(rw, fmt.Sprintf("deployed design %s not found", id), http.StatusNotFound)
+		return
+	}
+	pattern, err := core.NewPatternFile([]byte(deployed.PatternFile))
+	if err != nil {
+		h.log.Error(ErrPatternFile(err))
+		http.Error(rw, ErrPatternFile(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	svcName := ""
+	for key, svc := range pattern.Services {
+		if svc.Name == name {
+			svcName = key
+			break
+		}
+	}
+	if svcName == "" {
+		http.Error(rw, fmt.Sprintf("component %s of deployed design %s not found", name, id), http.StatusNotFound)
+		return
+	}
+	comp, err := pattern.GetApplicationComponent(svcName)
+	if err != nil {
+		h.log.Error(ErrComponentLogs(err))
+		http.Error(rw, ErrComponentLogs(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	token, _ := r.Context().Value(models.TokenCtxKey).(string)
+	k8sctx, err := provider.GetK8sContext(token, deployed.ContextID)
+	if err != nil {
+		h.log.Error(ErrComponentLogs(err))
+		http.Error(rw, ErrComponentLogs(err).Error(), http.StatusNotFound)
+		return
+	}
+	client, err := k8sctx.GenerateKubeHandler()
+	if err != nil {
+		h.log.Error(ErrComponentLogs(err))
+		http.Error(rw, ErrComponentLogs(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	pods, err := k8s.ComponentPods(r.Context(), client, comp)
+	if err != nil {
+		h.log.Error(ErrComponentLogs(err))
+		http.Error(rw, ErrComponentLogs(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.Header().Set("Connection", "keep-alive")
+	flusher.Flush()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	lines := make(chan k8s.LogLine)
+	go func() {
+		k8s.StreamLogs(ctx, client, pods, opts, lines)
+		close(lines)
+	}()
+	for line := range lines {
+		data, err := json.Marshal(line)
+		if err != nil {
+			h.log.Error(models.ErrMarshal(err, "log line"))
+			continue
+		}
+		if _, err := fmt.Fprintf(rw, "data: %s\n\n", data); err != nil {
+			// the client went away, the streams stop and close the channel
+			cancel()
+			continue
+		}
+		flusher.Flush()
+	}
+}
+
+// podLogOptions returns the options of the logs of the follow, tail and since query parameters
+func podLogOptions(query url.Values) (corev1.PodLogOptions, error) {
+	opts := corev1.PodLogOptions{}
+	if follow := query.Get("follow"); follow != "" {
+		f, err := strconv.ParseBool(follow)
+		if err != nil {
+			return opts, fmt.Errorf("invalid follow query parameter %q", follow)
+		}
+		opts.Follow = f
+	}
+	if tail := query.Get("tail"); tail != "" {
+		lines, err := strconv.ParseInt(tail, 10, 64)
+		if err != nil || lines < 0 {
+			return opts, fmt.Errorf("invalid tail query parameter %q, it must be a number of lines", tail)
+		}
+		opts.TailLines = &lines
+	}
+	if since := query.Get("since"); since != "" {
+		if d, err := time.ParseDuration(since); err == nil && d > 0 {
+			seconds := int64(math.Ceil(d.Seconds()))
+			opts.SinceSeconds = &seconds
+		} else if t, err := time.Parse(time.RFC3339, since); err == nil {
+			sinceTime := metav1.NewTime(t)
+			opts.SinceTime = &sinceTime
+		} else {
+			return opts, fmt.Errorf("invalid since query parameter %q, it must be a duration, eg: 10m, or an RFC 3339 time", since)
+		}
+	}
+	return opts, nil
+}
@@ -47,8 +47,7 @@ func (h *Handler) SaveConnection(w http.ResponseWriter, req *http.Request, _ *mo
 			"error": _err,
 		}
 		event := eventBuilder.WithSeverity(events.Error).WithDescription(fmt.Sprintf("Error creating connection %s", connection.Name)).WithMetadata(metadata).Build()
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 
 		h.log.Error(_err)
 		http.Error(w, _err.Error(), http.StatusInternalServerError)
@@ -58,8 +57,7 @@ func (h *Handler) SaveConnection(w http.ResponseWriter, req *http.Request, _ *mo
 	description := fmt.Sprintf("Connection %s created.", connection.Name)
 
 	event := eventBuilder.WithSeverity(events.Informational).WithDescription(description).Build()
-	_ = provider.PersistEvent(event)
-	go h.config.EventBroadcaster.Publish(userID, event)
+	h.publishEvent(provider, userID, event)
 
 	h.log.Info(description)
 	w.WriteHeader(http.StatusCreated)
@@ -224,8 +222,7 @@ func (h *Handler) UpdateConnection(w http.ResponseWriter, req *http.Request, _ *
 			"error": _err,
 		}
 		event := eventBuilder.WithSeverity(events.Error).WithDescription("Error updating connection").WithMetadata(metadata).Build()
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 
 		h.log.Error(_err)
 		http.Error(w, _err.Error(), http.StatusInternalServerError)
@@ -235,8 +232,7 @@ func (h *Handler) UpdateConnection(w http.ResponseWriter, req *http.Request, _ *
 	description := fmt.Sprintf("Connection %s updated.", updatedConnection.Name)
 
 	event := eventBuilder.WithSeverity(events.Informational).WithDescription(description).Build()
-	_ = provider.PersistEvent(event)
-	go h.config.EventBroadcaster.Publish(userID, event)
+	h.publishEvent(provider, userID, event)
 
 	h.log.Info(description)
 	w.WriteHeader(http.StatusOK)
@@ -245,7 +241,8 @@ func (h *Handler) UpdateConnection(w http.ResponseWriter, req *http.Request, _ *
 // swagger:route PUT /api/integrations/connections/{connectionId} PutConnectionById idPutConnectionById
 // Handle PUT request for updating an existing connection by connection ID
 //
-// Updates existing connection using ID
+// Updates existing connection using ID.
+// A status set in the body must be one the connection can transition to from its current status, see idGetConnectionTransitions.
 // responses:
 // 200: mesheryConnectionResponseWrapper
 func (h *Handler) UpdateConnectionById(w http.ResponseWriter, req *http.Request, _ *models.Preference, user *models.User, provider models.Provider) {
@@ -270,6 +267,25 @@ func (h *Handler) UpdateConnectionById(w http.ResponseWriter, req *http.Request,
 		return
 	}
 
+	if connection.Status != "" {
+		current, err := provider.GetConnectionByID(req, connectionID)
+		if err != nil {
+			h.log.Error(err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if err := models.ValidateConnectionTransition(current.Status, connection.Status); err != nil {
+			event := eventBuilder.WithSeverity(events.Error).WithDescription(fmt.Sprintf("Connection %s cannot transition from %s to %s", current.Name, current.Status, connection.Status)).WithMetadata(map[string]interface{}{
+				"error": err,
+			}).Build()
+			h.publishEvent(provider, userID, event)
+
+			h.log.Error(err)
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+	}
+
 	updatedConnection, err := provider.UpdateConnectionById(req, connection, mux.Vars(req)["connectionId"])
 	if err != nil {
 		_err := ErrFailToSave(err, obj)
@@ -277,8 +293,7 @@ func (h *Handler) UpdateConnectionById(w http.ResponseWriter, req *http.Request,
 			"error": _err,
 		}
 		event := eventBuilder.WithSeverity(events.Error).WithDescription("Error updating connection").WithMetadata(metadata).Build()
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 
 		h.log.Error(_err)
 		http.Error(w, _err.Error(), http.StatusInternalServerError)
@@ -288,8 +303,7 @@ func (h *Handler) UpdateConnectionById(w http.ResponseWriter, req *http.Request,
 	description := fmt.Sprintf("Connection %s updated.", updatedConnection.Name)
 	event := eventBuilder.WithSeverity(events.Informational).WithDescription(description).Build()
 
-	_ = provider.PersistEvent(event)
-	go h.config.EventBroadcaster.Publish(userID, event)
+	h.publishEvent(provider, userID, event)
 	h.log.Info(description)
 	w.WriteHeader(http.StatusOK)
 }
@@ -313,8 +327,7 @@ func (h *Handler) DeleteConnection(w http.ResponseWriter, req *http.Request, _ *
 			"error": _err,
 		}
 		event := eventBuilder.WithSeverity(events.Error).WithDescription("Error deleting connection").WithMetadata(metadata).Build()
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 
 		h.log.Error(_err)
 		http.Error(w, _err.Error(), http.StatusInternalServerError)
@@ -324,9 +337,120 @@ func (h *Handler) DeleteConnection(w http.ResponseWriter, req *http.Request, _ *
 	description := fmt.Sprintf("Connection %s deleted.", deletedConnection.Name)
 	event := eventBuilder.WithSeverity(events.Informational).WithDescription(description).Build()
 
-	_ = provider.PersistEvent(event)
-	go h.config.EventBroadcaster.Publish(userID, event)
+	h.publishEvent(provider, userID, event)
 
 	h.log.Info("connection deleted successfully")
 	w.WriteHeader(http.StatusOK)
 }
+
+// swagger:route GET /api/integrations/connections/{connectionId}/transitions GetConnectionTransitions idGetConnectionTransitions
+// Handle GET request for the statuses a connection can transition to
+//
+// Returns the current status of the connection and the statuses it can transition to. A connection is discovered,
+// registered, then connected; connected connections can be put under maintenance, get disconnected or not be found
+// anymore; ignored connections can be registered again and deleted connections cannot transition anymore.
+// responses:
+// 200: ConnectionTransitions
+func (h *Handler) GetConnectionTransitions(w http.ResponseWriter, req *http.Request, _ *models.Preference, _ *models.User, provider models.Provider) {
+	connectionID := uuid.FromStringOrNil(mux.Vars(req)["connectionId"])
+	connection, err := provider.GetConnectionByID(req, connectionID)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	transitions := models.ConnectionTransitions{Status: connection.Status, Transitions: connection.Status.Transitions()}
+	if err := json.NewEncoder(w).Encode(transitions); err != nil {
+		obj := "connection transitions"
+		h.log.Error(models.ErrEncoding(err, obj))
+		http.Error(w, models.ErrEncoding(err, obj).Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route POST /api/integrations/connections/{connectionId}/transitions TransitionConnection idPostConnectionTransition
+// Handle POST request for transitioning a connection to a status
+//
+// Transitions the connection to the status of the body, eg: {"status": "connected"}, if the connection can transition to
+// it from its current status, see idGetConnectionTransitions, and responds with 409 otherwise. Transitioning a connection
+// to the deleted status deletes it. Every transition is published as an event of the connection.
+// responses:
+// 200: mesheryConnectionResponseWrapper
+// 400:
+// 409:
+func (h *Handler) TransitionConnection(w http.ResponseWriter, req *http.Request, _ *models.Preference, user *models.User, provider models.Provider) {
+	connectionID := uuid.FromStringOrNil(mux.Vars(req)["connectionId"])
+	userID := uuid.FromStringOrNil(user.ID)
+
+	var body models.ConnectionTransitionRequestBody
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(w, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if !body.Status.IsValid() {
+		http.Error(w, fmt.Sprintf("%q is not a status of the lifecycle of the connections", body.Status), http.StatusBadRequest)
+		return
+	}
+
+	connection, err := provider.GetConnectionByID(req, connectionID)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	transition := models.ConnectionTransition{From: connection.Status, To: body.Status}
+	eventBuilder := events.NewEvent().ActedUpon(connectionID).FromUser(userID).FromSystem(*h.SystemID).WithCategory("connection").WithAction("transition")
+	if err := models.ValidateConnectionTransition(transition.From, transition.To); err != nil {
+		event := eventBuilder.WithSeverity(events.Error).WithDescription(fmt.Sprintf("Connection %s cannot transition from %s to %s", connection.Name, transition.From, transition.To)).WithMetadata(map[string]interface{}{
+			"error":      err,
+			"transition": transition,
+		}).Build()
+		h.publishEvent(provider, userID, event)
+
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	if transition.From != transition.To {
+		if transition.To == models.DELETED {
+			connection, err = provider.DeleteConnection(req, connectionID)
+		} else {
+			connection, err = provider.UpdateConnectionById(req, &models.ConnectionPayload{
+				Kind:     connection.Kind,
+				SubType:  connection.SubType,
+				Type:     connection.Type,
+				MetaData: connection.Metadata,
+				Status:   transition.To,
+				Name:     connection.Name,
+			}, connectionID.String())
+		}
+		if err != nil {
+			_err := ErrFailToSave(err, "connection")
+			event := eventBuilder.WithSeverity(events.Error).WithDescription("Error transitioning connection").WithMetadata(map[string]interface{}{
+				"error":      _err,
+				"transition": transition,
+			}).Build()
+			h.publishEvent(provider, userID, event)
+
+			h.log.Error(_err)
+			http.Error(w, _err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		description := fmt.Sprintf("Connection %s transitioned from %s to %s.", connection.Name, transition.From, transition.To)
+		event := eventBuilder.WithSeverity(events.Informational).WithDescription(description).WithMetadata(map[string]interface{}{
+			"transition": transition,
+		}).Build()
+		h.publishEvent(provider, userID, event)
+		h.log.Info(description)
+	}
+
+	if err := json.NewEncoder(w).Encode(connection); err != nil {
+		obj := "connection"
+		h.log.Error(models.ErrEncoding(err, obj))
+		http.Error(w, models.ErrEncoding(err, obj).Error(), http.StatusInternalServerError)
+	}
+}
@@ -95,11 +95,12 @@ func (mc *ContentModifier) isPatternSupported(ctx context.Context, patternfile s
 		patternFile,
 		mc.prefObj,
 		mc.userID,
-		false,
-		true,
-		false,
-		true,
-		true,
+		patternDeployOptions{
+			verify:        true,
+			skipCRD:       true,
+			skipPrintLogs: true,
+		},
+		nil,
 		nil,
 		nil,
 		nil,
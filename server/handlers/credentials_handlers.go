@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/credstore"
 )
 
 func (h *Handler) SaveUserCredential(w http.ResponseWriter, req *http.Request, _ *models.Preference, user *models.User, provider models.Provider) {
@@ -32,8 +34,25 @@ func (h *Handler) SaveUserCredential(w http.ResponseWriter, req *http.Request, _
 		return
 	}
 
+	// the secret is stored under the id of the credential, which is known before the credential is saved
+	if h.config.CredentialStore != nil && credential.ID == uuid.Nil {
+		credential.ID, _ = uuid.NewV4()
+	}
+	secret := credential.Secret
+	credential.Secret, err = credstore.Externalize(req.Context(), h.config.CredentialStore, credential.ID.String(), secret)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(w, "unable to store the secret of the credential", http.StatusInternalServerError)
+		return
+	}
+
 	err = provider.SaveUserCredential(req, &credential)
 	if err != nil {
+		if credstore.IsRef(credential.Secret) && !credstore.IsRef(secret) {
+			if err := credstore.Remove(req.Context(), h.config.CredentialStore, credential.ID.String()); err != nil {
+				h.log.Error(err)
+			}
+		}
 		h.log.Error(fmt.Errorf("error saving user credentials: %v", err))
 		http.Error(w, "unable to save user credentials", http.StatusInternalServerError)
 		return
@@ -73,6 +92,10 @@ func (h *Handler) GetUserCredentials(w http.ResponseWriter, req *http.Request, _
 		return
 	}
 
+	for _, credential := range credentialsPage.Credentials {
+		h.resolveCredentialSecret(req.Context(), credential)
+	}
+
 	if err := json.NewEncoder(w).Encode(credentialsPage); err != nil {
 		h.log.Error(fmt.Errorf("error encoding user credentials: %v", err))
 		http.Error(w, "unable to encode user credentials", http.StatusInternalServerError)
@@ -100,6 +123,15 @@ func (h *Handler) UpdateUserCredential(w http.ResponseWriter, req *http.Request,
 		return
 	}
 
+	if len(credential.Secret) > 0 {
+		credential.Secret, err = credstore.Externalize(req.Context(), h.config.CredentialStore, credential.ID.String(), credential.Secret)
+		if err != nil {
+			h.log.Error(err)
+			http.Error(w, "unable to store the secret of the credential", http.StatusInternalServerError)
+			return
+		}
+	}
+
 	_, err = provider.UpdateUserCredential(req, credential)
 	if err != nil {
 		h.log.Error(fmt.Errorf("error getting user credential: %v", err))
@@ -122,6 +154,24 @@ func (h *Handler) DeleteUserCredential(w http.ResponseWriter, req *http.Request,
 		return
 	}
 
+	if err := credstore.Remove(req.Context(), h.config.CredentialStore, credentialID.String()); err != nil {
+		h.log.Error(err)
+	}
+
 	h.log.Info("credential deleted successfully")
 	w.WriteHeader(http.StatusOK)
 }
+
+// resolveCredentialSecret replaces the reference to the secret of the credential in the credential store by the secret.
+// The reference is left when the secret cannot be read, for the other credentials to be read.
+func (h *Handler) resolveCredentialSecret(ctx context.Context, credential *models.Credential) {
+	if credential == nil {
+		return
+	}
+	secret, err := credstore.Resolve(ctx, h.config.CredentialStore, credential.Secret)
+	if err != nil {
+		h.log.Error(err)
+		return
+	}
+	credential.Secret = secret
+}
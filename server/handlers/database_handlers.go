@@ -8,6 +8,7 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/layer5io/meshery/server/models"
@@ -60,9 +61,16 @@ func (h *Handler) GetSystemDatabase(w http.ResponseWriter, r *http.Request, _ *m
 
 	tableFinder := h.dbHandler.DB.Table("sqlite_schema").
 		Where("type = ?", "table")
+	nameColumn := "name"
+	postgres := models.IsPostgres(h.dbHandler.DB)
+	if postgres {
+		tableFinder = h.dbHandler.DB.Table("information_schema.tables").
+			Where("table_schema = current_schema() AND table_type = ?", "BASE TABLE")
+		nameColumn = "table_name"
+	}
 
 	if search != "" {
-		tableFinder = tableFinder.Where("name LIKE ?", "%"+search+"%")
+		tableFinder = tableFinder.Where("lower("+nameColumn+") LIKE ?", "%"+strings.ToLower(search)+"%")
 	}
 
 	tableFinder.Count(&totalTables)
@@ -81,6 +89,10 @@ func (h *Handler) GetSystemDatabase(w http.ResponseWriter, r *http.Request, _ *m
 		}
 	}
 
+	if postgres {
+		// the tables are listed with the columns of sqlite_schema
+		tableFinder = tableFinder.Select("table_name AS name, 'table' AS type")
+	}
 	tableFinder.Find(&tables)
 
 	for _, table := range tables {
@@ -120,35 +132,38 @@ func (h *Handler) GetSystemDatabase(w http.ResponseWriter, r *http.Request, _ *m
 // Reset the system database to its initial state.
 func (h *Handler) ResetSystemDatabase(w http.ResponseWriter, r *http.Request, _ *models.Preference, _ *models.User, provider models.Provider) {
 
-	mesherydbPath := path.Join(utils.GetHome(), ".meshery/config")
-	err := os.Mkdir(path.Join(mesherydbPath, ".archive"), os.ModePerm)
-	if err != nil && os.IsNotExist(err) {
-		http.Error(w, "Directory could not be created due to a non-existent path.", http.StatusInternalServerError)
-		return
-	}
-	src := path.Join(mesherydbPath, "mesherydb.sql")
-	currentTime := time.Now().Format("20060102150407")
-	newFileName := ".archive/mesherydb" + currentTime + ".sql"
-	dst := path.Join(mesherydbPath, newFileName)
+	// the SQLite database is archived before it is reset, a PostgreSQL database is backed up by its operators
+	if !models.IsPostgres(h.dbHandler.DB) {
+		mesherydbPath := path.Join(utils.GetHome(), ".meshery/config")
+		err := os.Mkdir(path.Join(mesherydbPath, ".archive"), os.ModePerm)
+		if err != nil && os.IsNotExist(err) {
+			http.Error(w, "Directory could not be created due to a non-existent path.", http.StatusInternalServerError)
+			return
+		}
+		src := path.Join(mesherydbPath, "mesherydb.sql")
+		currentTime := time.Now().Format("20060102150407")
+		newFileName := ".archive/mesherydb" + currentTime + ".sql"
+		dst := path.Join(mesherydbPath, newFileName)
 
-	fin, err := os.Open(src)
-	if err != nil {
-		http.Error(w, "The database does not exist or you don't have enough permission to access it", http.StatusInternalServerError)
-		return
-	}
-	defer fin.Close()
+		fin, err := os.Open(src)
+		if err != nil {
+			http.Error(w, "The database does not exist or you don't have enough permission to access it", http.StatusInternalServerError)
+			return
+		}
+		defer fin.Close()
 
-	fout, err := os.Create(dst)
-	if err != nil {
-		http.Error(w, "Destination file can not be created", http.StatusInternalServerError)
-		return
-	}
-	defer fout.Close()
+		fout, err := os.Create(dst)
+		if err != nil {
+			http.Error(w, "Destination file can not be created", http.StatusInternalServerError)
+			return
+		}
+		defer fout.Close()
 
-	_, err = io.Copy(fout, fin)
-	if err != nil {
-		http.Error(w, "Can not copy file from source to destination", http.StatusInternalServerError)
-		return
+		_, err = io.Copy(fout, fin)
+		if err != nil {
+			http.Error(w, "Can not copy file from source to destination", http.StatusInternalServerError)
+			return
+		}
 	}
 
 	dbHandler := provider.GetGenericPersister()
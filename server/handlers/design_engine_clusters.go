@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/imagescan"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshery/server/models/pattern/stages"
+	events "github.com/layer5io/meshkit/models/events"
+)
+
+// Statuses of the deployment of a design on a cluster
+const (
+	ClusterDeploymentCompleted = "completed"
+	ClusterDeploymentFailed    = "failed"
+)
+
+// ClusterDeploymentResult is the outcome of the deployment of a design on one of the Kubernetes contexts of the request
+type ClusterDeploymentResult struct {
+	ContextID   string `json:"contextID"`
+	ContextName string `json:"contextName"`
+	Server      string `json:"server,omitempty"`
+	Status      string `json:"status"`
+	Error       string `json:"error,omitempty"`
+	// Response of the pattern engine for the context, as returned for the deployment of a design on a single context
+	Response map[string]interface{} `json:"response,omitempty"`
+}
+
+// patternDeployOptions are the options a design is processed with by _processPattern
+type patternDeployOptions struct {
+	// undeploys the design instead of deploying it
+	isDelete bool
+	// validates the design without provisioning its components
+	verify bool
+	// renders the manifests of the components and dry runs them instead of applying them
+	dryRun bool
+	// reports the changes deploying the design makes to the resources of the clusters
+	diff bool
+	// estimates the cost of the design when set
+	pricing core.Pricing
+	// scans the images of the components against the policy when set
+	imageScan *imagescan.Policy
+	// skips the deployment of the CRDs and of the operators of the components
+	skipCRD bool
+	// reverts the components provisioned so far when the deployment fails
+	rollback bool
+	// skips printing the logs of the stages
+	skipPrintLogs bool
+}
+
+// deployPatternToClusters deploys the design to every Kubernetes context of the request concurrently, each deployment
+// running its own chain of stages, so that a failure on one cluster neither stops nor rolls back the others.
+// The response holds the result of every context under clusters, and is sent with the status 207 when the deployment
+// failed on some of the contexts only.
+func (h *Handler) deployPatternToClusters(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+	patternFile core.Pattern,
+	k8scontexts []models.K8sContext,
+	opts patternDeployOptions,
+	action string,
+) {
+	results := make([]ClusterDeploymentResult, len(k8scontexts))
+	var wg sync.WaitGroup
+	for i, k8sctx := range k8scontexts {
+		wg.Add(1)
+		go func(i int, k8sctx models.K8sContext) {
+			defer wg.Done()
+			result := ClusterDeploymentResult{
+				ContextID:   k8sctx.ID,
+				ContextName: k8sctx.Name,
+				Server:      k8sctx.Server,
+				Status:      ClusterDeploymentCompleted,
+			}
+			ctx := context.WithValue(r.Context(), models.KubeClustersKey, []models.K8sContext{k8sctx})
+			response, err := h.deployPatternToCluster(ctx, prefObj, user, provider, patternFile, opts)
+			result.Response = response
+			if err != nil {
+				h.log.Error(err)
+				result.Status = ClusterDeploymentFailed
+				result.Error = err.Error()
+			}
+			results[i] = result
+		}(i, k8sctx)
+	}
+	wg.Wait()
+
+	failed := 0
+	for _, result := range results {
+		if result.Status == ClusterDeploymentFailed {
+			failed++
+		}
+	}
+
+	userID := uuid.FromStringOrNil(user.ID)
+	patternID := uuid.FromStringOrNil(patternFile.PatternID)
+	eventBuilder := events.NewEvent().ActedUpon(patternID).FromUser(userID).FromSystem(*h.SystemID).WithCategory("pattern").WithAction(action).
+		WithMetadata(withWorkspace(r, map[string]interface{}{
+			"clusters": results,
+		}))
+	if failed > 0 {
+		eventBuilder.WithSeverity(events.Error).WithDescription(fmt.Sprintf("%s of design '%s' failed on %d of %d clusters", action, patternFile.Name, failed, len(results)))
+	} else {
+		eventBuilder.WithSeverity(events.Informational).WithDescription(fmt.Sprintf("%s of design '%s' completed on %d clusters", action, patternFile.Name, len(results)))
+	}
+	event := eventBuilder.Build()
+	h.publishEvent(provider, userID, event)
+
+	status := http.StatusOK
+	switch {
+	case failed == len(results):
+		status = http.StatusInternalServerError
+	case failed > 0:
+		status = http.StatusMultiStatus
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(status)
+	_ = json.NewEncoder(rw).Encode(map[string]interface{}{
+		"clusters":  results,
+		"completed": len(results) - failed,
+		"failed":    failed,
+	})
+}
+
+// deployPatternToCluster deploys a copy of the design to the Kubernetes context of ctx, checkpointing the deployment and
+// waiting for a free slot on its cluster like the deployment of a design on a single context does
+func (h *Handler) deployPatternToCluster(
+	ctx context.Context,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+	patternFile core.Pattern,
+	opts patternDeployOptions,
+) (map[string]interface{}, error) {
+	// the stages modify the pattern they process, every cluster gets its own copy
+	pattern, err := copyPattern(patternFile)
+	if err != nil {
+		return nil, ErrPatternFile(err)
+	}
+
+	var checkpoint *deploymentCheckpoint
+	if !opts.verify && !opts.dryRun {
+		checkpoint, err = h.newDeploymentCheckpoint(pattern, user.ID, opts.isDelete, opts.skipCRD, opts.rollback)
+		if err != nil {
+			return nil, ErrPatternDeployment(err)
+		}
+		var done func()
+		ctx, done, err = h.trackDeployment(ctx, checkpoint)
+		if err != nil {
+			return nil, err
+		}
+		defer done()
+		release, err := h.waitForDeploymentSlot(ctx, provider, user.ID, checkpoint)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+	}
+
+	response, err := _processPattern(
+		ctx,
+		provider,
+		pattern,
+		prefObj,
+		user.ID,
+		opts,
+		checkpoint,
+		h.registryManager,
+		h.config.EventBroadcaster,
+		h.log,
+	)
+	h.attachImageScans(pattern, response)
+	if err != nil {
+		return response, ErrCompConfigPairs(err)
+	}
+	if !opts.verify && !opts.dryRun {
+		h.trackDeployedPattern(ctx, provider, user, pattern, opts.isDelete)
+	}
+	return response, nil
+}
+
+// copyPattern returns a deep copy of the pattern
+func copyPattern(pattern core.Pattern) (core.Pattern, error) {
+	snapshot, err := stages.MarshalData(&stages.Data{
+		Pattern: &pattern,
+		Other:   map[string]interface{}{},
+	})
+	if err != nil {
+		return core.Pattern{}, err
+	}
+	data, err := stages.UnmarshalData(snapshot)
+	if err != nil {
+		return core.Pattern{}, err
+	}
+	return *data.Pattern, nil
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+func TestCopyPattern(t *testing.T) {
+	pattern := core.Pattern{
+		Name:      "web",
+		PatternID: "design",
+		Services: map[string]*core.Service{
+			"web": {
+				Name:        "web",
+				Type:        "Deployment",
+				APIVersion:  "apps/v1",
+				Namespace:   "default",
+				Annotations: map[string]string{"team": "a"},
+				Settings:    map[string]interface{}{"spec": map[string]interface{}{"replicas": float64(1)}},
+			},
+		},
+	}
+
+	copied, err := copyPattern(pattern)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if copied.Name != "web" || copied.PatternID != "design" || copied.Services["web"] == nil {
+		t.Fatalf("the copy of the design is %+v", copied)
+	}
+
+	// the stages of the deployment to a cluster write to the services, the other clusters must not see it
+	copied.Services["web"].Namespace = "cluster-b"
+	copied.Services["web"].Annotations["team"] = "b"
+	copied.Services["web"].Settings["spec"].(map[string]interface{})["replicas"] = float64(2)
+	copied.Services["db"] = &core.Service{Name: "db"}
+
+	svc := pattern.Services["web"]
+	if svc.Namespace != "default" || svc.Annotations["team"] != "a" || svc.Settings["spec"].(map[string]interface{})["replicas"] != float64(1) {
+		t.Errorf("the service of the design was changed through its copy: %+v", svc)
+	}
+	if _, ok := pattern.Services["db"]; ok {
+		t.Error("a service added to the copy was added to the design")
+	}
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,9 +13,11 @@ import (
 
 	"github.com/ghodss/yaml"
 	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
 	"github.com/layer5io/meshery/server/helpers/utils"
 	"github.com/layer5io/meshery/server/meshes"
 	"github.com/layer5io/meshery/server/models"
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
 	"github.com/layer5io/meshery/server/models/pattern/core"
 	"github.com/layer5io/meshery/server/models/pattern/patterns"
 	"github.com/layer5io/meshery/server/models/pattern/patterns/k8s"
@@ -25,6 +28,7 @@ import (
 	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
 	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
@@ -32,13 +36,36 @@ import (
 // Handle POST request for Pattern Deploy
 //
 // Deploy an attached pattern with the request
+//
+// Designs declaring relationships between their components, through dependsOn or their parent, which the registered
+// relationships deny or do not allow are rejected with the list of violations.
+// With the ```rollback``` query parameter set to true, the components deployed so far are deleted when the deployment fails.
+// With the ```dryRun``` query parameter set to true, nothing is deployed and the response holds the manifests the deployment would apply
+// under ```manifests```, rendered by the Kubernetes server with a dry run where it can be reached.
+// The progress of every stage and component of the deployment is published as events with the ```progress``` action while it runs.
+// Deployments wait for a free slot when their clusters already run MAX_CONCURRENT_DEPLOYMENTS_PER_CLUSTER deployments, see ```/api/pattern/deploy/queue```.
+// With the ```diff``` query parameter set to true, the response holds under ```changeset``` the changes the deployment makes to the resources of the clusters, computed before any of them is deployed.
+// The response holds under ```security``` the insecure settings of the components of the design, see ```/api/pattern/security```.
+// When IMAGE_SCANNER is set, the container images of the design are scanned for vulnerabilities before it is deployed, see
+// ```/api/pattern/image-scan```, and the deployment fails when they have vulnerabilities of the severity IMAGE_SCAN_FAIL_ON sets or higher.
+// With the ```estimateCost``` query parameter set to true, the response holds under ```cost``` the cost of the design estimated before it is deployed, see ```/api/pattern/cost```.
+// The references to the variables of the design, {{ .vars.<name> }}, are rendered with the values of the ```var``` query parameters,
+// given as ```var=<name>=<value>``` and converted to the types declared under ```variables``` in the design, or with their defaults.
+// When several Kubernetes contexts are given with the ```contexts``` query parameter, or ```contexts=all```, the design is deployed to
+// each of them concurrently and independently: a failure on one context neither stops nor rolls back the deployments on the others.
+// The response then holds the result of every context under ```clusters```, and its status is 207 when only some of them failed.
 // responses:
 // 	200:
+//	207:
 
 // swagger:route DELETE /api/pattern/deploy PatternsAPI idDeleteDeployPattern
 // Handle DELETE request for Pattern Deploy
 //
 // Delete a deployed pattern with the request
+//
+// The resources labeled with the id of the pattern which are not among its components anymore, eg: the resources of the
+// components removed from the pattern since it was deployed, are deleted as well and listed under ```orphans```.
+// See ```/api/pattern/undeploy/preview``` for the resources the request deletes.
 // responses:
 // 	200:
 
@@ -92,52 +119,566 @@ func (h *Handler) PatternFileHandler(
 		http.Error(rw, ErrPatternFile(err).Error(), http.StatusInternalServerError)
 		return
 	}
+	if err := setVariableValues(&patternFile, r); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	opts := patternDeployOptions{
+		isDelete:  isDel,
+		verify:    r.URL.Query().Get("verify") == "true",
+		dryRun:    isDryRun,
+		diff:      r.URL.Query().Get("diff") == "true",
+		imageScan: h.config.ImageScanPolicy,
+		skipCRD:   r.URL.Query().Get("skipCRD") == "true",
+		rollback:  r.URL.Query().Get("rollback") == "true",
+	}
+	if r.URL.Query().Get("estimateCost") == "true" {
+		opts.pricing = h.config.Pricing
+	}
+
+	// designs deployed to several contexts are deployed to each of them on its own
+	if k8scontexts, _ := r.Context().Value(models.KubeClustersKey).([]models.K8sContext); len(k8scontexts) > 1 {
+		h.deployPatternToClusters(rw, r, prefObj, user, provider, patternFile, k8scontexts, opts, action)
+		return
+	}
+
+	// deployments which change the cluster are checkpointed, so that they can be resumed if they are interrupted
+	ctx := r.Context()
+	var checkpoint *deploymentCheckpoint
+	if !opts.verify && !opts.dryRun {
+		checkpoint, err = h.newDeploymentCheckpoint(patternFile, user.ID, opts.isDelete, opts.skipCRD, opts.rollback)
+		if err != nil {
+			h.log.Error(ErrPatternDeployment(err))
+			http.Error(rw, ErrPatternDeployment(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		var done func()
+		ctx, done, err = h.trackDeployment(ctx, checkpoint)
+		if err != nil {
+			h.log.Error(err)
+			http.Error(rw, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		defer done()
+		release, err := h.waitForDeploymentSlot(ctx, provider, user.ID, checkpoint)
+		if err != nil {
+			h.log.Error(err)
+			http.Error(rw, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		defer release()
+	}
 
 	response, err := _processPattern(
-		r.Context(),
+		ctx,
 		provider,
 		patternFile,
 		prefObj,
 		user.ID,
-		isDel,
-		r.URL.Query().Get("verify") == "true",
-		isDryRun,
-		r.URL.Query().Get("skipCRD") == "true",
-		false,
+		opts,
+		checkpoint,
 		h.registryManager,
 		h.config.EventBroadcaster,
 		h.log,
 	)
+	h.attachImageScans(patternFile, response)
 
 	patternID := uuid.FromStringOrNil(patternFile.PatternID)
 	eventBuilder := events.NewEvent().ActedUpon(patternID).FromUser(userID).FromSystem(*h.SystemID).WithCategory("pattern").WithAction(action)
 
 	if err != nil {
 		err := ErrCompConfigPairs(err)
-		metadata := map[string]interface{}{
+		metadata := withWorkspace(r, map[string]interface{}{
 			"error": err,
-		}
+		})
 
 		event := eventBuilder.WithSeverity(events.Error).WithDescription(fmt.Sprintf("%s error for design '%s'", action, patternFile.Name)).WithMetadata(metadata).Build()
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 
 		h.log.Error(err)
 		http.Error(rw, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	metadata := map[string]interface{}{
-		"summary": response,
+	if !opts.verify && !opts.dryRun {
+		h.trackDeployedPattern(r.Context(), provider, user, patternFile, opts.isDelete)
 	}
 
+	metadata := withWorkspace(r, map[string]interface{}{
+		"summary": response,
+	})
+
 	event := eventBuilder.WithSeverity(events.Informational).WithDescription(description).WithMetadata(metadata).Build()
-	_ = provider.PersistEvent(event)
-	go h.config.EventBroadcaster.Publish(userID, event)
+	h.publishEvent(provider, userID, event)
 
 	ec := json.NewEncoder(rw)
 	_ = ec.Encode(response)
 }
+
+// swagger:route POST /api/pattern/diff PatternsAPI idPostDiffPattern
+// Handle POST request for Pattern Diff
+//
+// Compares the components of the attached pattern against the resources of the clusters, like kubectl diff, without deploying it.
+// The response holds under ```changeset``` the resources the deployment would create, update or delete along with the fields it would change.
+// With the ```delete``` query parameter set to true, the changes undeploying the pattern would make are returned instead,
+// along with the orphaned resources it would delete under ```orphans```.
+// The variables of the pattern are rendered with the values of the ```var``` query parameters, like when deploying it.
+// responses:
+// 	200:
+
+// PatternDiffHandler returns the changes the deployment of a pattern would make to the resources of the clusters
+func (h *Handler) PatternDiffHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	if r.Header.Get("Content-Type") == "application/json" {
+		body, err = yaml.JSONToYAML(body)
+		if err != nil {
+			h.log.Error(ErrPatternFile(err))
+			http.Error(rw, ErrPatternFile(err).Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	patternFile, err := core.NewPatternFile(body)
+	if err != nil {
+		h.log.Error(ErrPatternFile(err))
+		http.Error(rw, ErrPatternFile(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := setVariableValues(&patternFile, r); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := _processPattern(
+		r.Context(),
+		provider,
+		patternFile,
+		prefObj,
+		user.ID,
+		patternDeployOptions{
+			isDelete: r.URL.Query().Get("delete") == "true",
+			verify:   true,
+			diff:     true,
+			skipCRD:  r.URL.Query().Get("skipCRD") == "true",
+		},
+		nil,
+		h.registryManager,
+		h.config.EventBroadcaster,
+		h.log,
+	)
+	if err != nil {
+		err := ErrCompConfigPairs(err)
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	ec := json.NewEncoder(rw)
+	_ = ec.Encode(response)
+}
+
+// swagger:route POST /api/pattern/undeploy/preview PatternsAPI idPostUndeployPreviewPattern
+// Handle POST request for previewing the undeployment of a pattern
+//
+// Lists the resources of the clusters undeploying the attached pattern would delete, without deleting them.
+// The response holds under ```resources``` the resources of the components of the pattern, and under ```orphans```
+// the resources labeled with the id of the pattern which are not among its components anymore, eg: the resources of the
+// components removed from the pattern since it was deployed, which are deleted along with it.
+// responses:
+// 	200: undeployPreviewResponseWrapper
+
+// PatternUndeployPreviewHandler returns the resources of the clusters the undeployment of a pattern would delete
+func (h *Handler) PatternUndeployPreviewHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	if r.Header.Get("Content-Type") == "application/json" {
+		body, err = yaml.JSONToYAML(body)
+		if err != nil {
+			h.log.Error(ErrPatternFile(err))
+			http.Error(rw, ErrPatternFile(err).Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	patternFile, err := core.NewPatternFile(body)
+	if err != nil {
+		h.log.Error(ErrPatternFile(err))
+		http.Error(rw, ErrPatternFile(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := setVariableValues(&patternFile, r); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := _processPattern(
+		r.Context(),
+		provider,
+		patternFile,
+		prefObj,
+		user.ID,
+		patternDeployOptions{
+			isDelete: true,
+			verify:   true,
+			diff:     true,
+			skipCRD:  r.URL.Query().Get("skipCRD") == "true",
+		},
+		nil,
+		h.registryManager,
+		h.config.EventBroadcaster,
+		h.log,
+	)
+	if err != nil {
+		err := ErrCompConfigPairs(err)
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	preview := UndeployPreview{
+		Resources: []core.ResourceChange{},
+		Orphans:   []core.ResourceChange{},
+	}
+	changes, _ := response["changeset"].([]core.ResourceChange)
+	for _, change := range changes {
+		// the resources of the components which are not deployed are not deleted
+		if change.Operation == core.ResourceDelete {
+			preview.Resources = append(preview.Resources, change)
+		}
+	}
+	if orphans, ok := response["orphans"].([]core.ResourceChange); ok {
+		preview.Orphans = orphans
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(preview)
+}
+
+// UndeployPreview lists the resources the undeployment of a design deletes
+type UndeployPreview struct {
+	// Resources of the components of the design
+	Resources []core.ResourceChange `json:"resources"`
+	// Orphans are the resources labeled with the id of the design which are not among its components anymore
+	Orphans []core.ResourceChange `json:"orphans"`
+}
+
+// swagger:route POST /api/pattern/deploy/{id}/resume PatternsAPI idResumePatternDeployment
+// Handle POST request for resuming a design deployment
+//
+// Resumes the deployment with the ID, returned by the deploy request as ```deploymentID```, from its last completed stage.
+// Deployments interrupted by a restart of Meshery Server or which failed can be resumed, the completed ones cannot.
+// responses:
+// 	200:
+// 	404:
+// 	409:
+
+// ResumePatternDeploymentHandler resumes an interrupted design deployment from its last checkpoint
+func (h *Handler) ResumePatternDeploymentHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	userID := uuid.FromStringOrNil(user.ID)
+	id := uuid.FromStringOrNil(mux.Vars(r)["id"])
+
+	persister := h.patternDeploymentPersister()
+	deployment, err := persister.GetPatternDeployment(id)
+	if err != nil {
+		h.log.Error(ErrPatternDeployment(err))
+		http.Error(rw, ErrPatternDeployment(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if deployment == nil || deployment.UserID != user.ID {
+		http.Error(rw, fmt.Sprintf("design deployment %s not found", mux.Vars(r)["id"]), http.StatusNotFound)
+		return
+	}
+	if deployment.Status == models.PatternDeploymentCompleted {
+		http.Error(rw, fmt.Sprintf("design deployment %s is already completed", id), http.StatusConflict)
+		return
+	}
+	data, err := stages.UnmarshalData(deployment.Snapshot)
+	if err != nil {
+		h.log.Error(ErrPatternDeployment(err))
+		http.Error(rw, ErrPatternDeployment(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := persister.SetPatternDeploymentStatus(id, models.PatternDeploymentRunning); err != nil {
+		h.log.Error(ErrPatternDeployment(err))
+		http.Error(rw, ErrPatternDeployment(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	checkpoint := &deploymentCheckpoint{persister: persister, deployment: deployment}
+	ctx, done, err := h.trackDeployment(r.Context(), checkpoint)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	defer done()
+	release, err := h.waitForDeploymentSlot(ctx, provider, user.ID, checkpoint)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	defer release()
+
+	response, err := _processPattern(
+		ctx,
+		provider,
+		*data.Pattern,
+		prefObj,
+		user.ID,
+		patternDeployOptions{
+			isDelete:  deployment.IsDelete,
+			imageScan: h.config.ImageScanPolicy,
+			skipCRD:   deployment.SkipCRD,
+			rollback:  deployment.Rollback,
+		},
+		checkpoint,
+		h.registryManager,
+		h.config.EventBroadcaster,
+		h.log,
+	)
+	h.attachImageScans(*data.Pattern, response)
+
+	patternID := uuid.FromStringOrNil(data.Pattern.PatternID)
+	eventBuilder := events.NewEvent().ActedUpon(patternID).FromUser(userID).FromSystem(*h.SystemID).WithCategory("pattern").WithAction("Resume")
+	if err != nil {
+		err := ErrCompConfigPairs(err)
+		event := eventBuilder.WithSeverity(events.Error).WithDescription(fmt.Sprintf("Resume error for design '%s'", data.Pattern.Name)).WithMetadata(map[string]interface{}{
+			"error": err,
+		}).Build()
+		h.publishEvent(provider, userID, event)
+
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.trackDeployedPattern(r.Context(), provider, user, *data.Pattern, deployment.IsDelete)
+
+	event := eventBuilder.WithSeverity(events.Informational).WithDescription(fmt.Sprintf("Resumed deployment of design '%s'", data.Pattern.Name)).WithMetadata(map[string]interface{}{
+		"summary": response,
+	}).Build()
+	h.publishEvent(provider, userID, event)
+
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
+// deploymentCheckpoint saves the progress of a deployment, see ResumePatternDeploymentHandler
+// publishDeploymentProgress returns the ProgressFunc publishing the progress of the deployment of the pattern as events,
+// so that clients can show its timeline while it is deployed
+func publishDeploymentProgress(ec *models.Broadcast, userID string, pattern core.Pattern, deploymentID *uuid.UUID) stages.ProgressFunc {
+	systemID, _ := viper.Get("INSTANCE_ID").(*uuid.UUID)
+	userUUID := uuid.FromStringOrNil(userID)
+	patternID := uuid.FromStringOrNil(pattern.PatternID)
+	return func(p stages.Progress) {
+		severity := events.Informational
+		if p.Status == stages.ProgressFailed || p.Status == stages.ProgressTerminated {
+			severity = events.Error
+		}
+		subject := fmt.Sprintf("stage %s", p.StageName)
+		if p.Component != "" {
+			subject = fmt.Sprintf("component %s", p.Component)
+		}
+		metadata := map[string]interface{}{
+			"progress": p,
+		}
+		if deploymentID != nil {
+			metadata["deploymentID"] = *deploymentID
+		}
+
+		eventBuilder := events.NewEvent().ActedUpon(patternID).FromUser(userUUID).WithCategory("pattern").WithAction("progress").
+			WithSeverity(severity).WithDescription(fmt.Sprintf("Design '%s': %s %s", pattern.Name, subject, p.Status)).WithMetadata(metadata)
+		if systemID != nil {
+			eventBuilder.FromSystem(*systemID)
+		}
+		go ec.Publish(userUUID, eventBuilder.Build())
+	}
+}
+
+type deploymentCheckpoint struct {
+	persister  *models.PatternDeploymentPersister
+	deployment *models.PatternDeployment
+}
+
+// newDeploymentCheckpoint stores a new deployment of the pattern along with the snapshot its processing starts from
+func (h *Handler) newDeploymentCheckpoint(pattern core.Pattern, userID string, isDelete, skipCRD, rollback bool) (*deploymentCheckpoint, error) {
+	snapshot, err := stages.MarshalData(&stages.Data{
+		Pattern: &pattern,
+		Other:   map[string]interface{}{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	deployment := &models.PatternDeployment{
+		UserID:          userID,
+		Name:            pattern.Name,
+		Status:          models.PatternDeploymentRunning,
+		IsDelete:        isDelete,
+		SkipCRD:         skipCRD,
+		Rollback:        rollback,
+		CompletedStages: "[]",
+		Snapshot:        snapshot,
+	}
+	persister := h.patternDeploymentPersister()
+	if err := persister.SavePatternDeployment(deployment); err != nil {
+		return nil, err
+	}
+	return &deploymentCheckpoint{persister: persister, deployment: deployment}, nil
+}
+
+// patternDeploymentPersister returns the persister of the deployments run by this Meshery Server
+func (h *Handler) patternDeploymentPersister() *models.PatternDeploymentPersister {
+	return &models.PatternDeploymentPersister{DB: h.dbHandler, ServerID: viper.GetString("SERVER_ID")}
+}
+
+// trackDeployment registers the deployment with the shutdown manager, so that a shutdown of Meshery Server waits for it
+// to finish or to reach a checkpoint. The deployment must run with the returned context, the returned function is called once it is done.
+func (h *Handler) trackDeployment(ctx context.Context, checkpoint *deploymentCheckpoint) (context.Context, func(), error) {
+	if h.config.ShutdownManager == nil {
+		return ctx, func() {}, nil
+	}
+	deployment := checkpoint.deployment
+	ctx, done, err := h.config.ShutdownManager.Track(ctx, deployment.ID, deployment.Name)
+	if err != nil {
+		// the deployment did not start, it can be resumed once Meshery Server restarts
+		if serr := checkpoint.persister.SetPatternDeploymentStatus(deployment.ID, models.PatternDeploymentInterrupted); serr != nil {
+			h.log.Error(ErrPatternDeployment(serr))
+		}
+		return nil, nil, err
+	}
+	return ctx, done, nil
+}
+
+// waitForDeploymentSlot waits in the deployment queue until the clusters of the request can run the deployment,
+// the returned function frees its slots once it is done. Queued deployments are reported through their status and an event.
+func (h *Handler) waitForDeploymentSlot(ctx context.Context, provider models.Provider, userID string, checkpoint *deploymentCheckpoint) (func(), error) {
+	if h.config.DeploymentQueue == nil {
+		return func() {}, nil
+	}
+	k8scontexts, _ := ctx.Value(models.KubeClustersKey).([]models.K8sContext)
+	clusters := make([]string, 0, len(k8scontexts))
+	for _, k8sctx := range k8scontexts {
+		// contexts of the same cluster share its slots
+		switch {
+		case k8sctx.KubernetesServerID != nil:
+			clusters = append(clusters, k8sctx.KubernetesServerID.String())
+		case k8sctx.Server != "":
+			clusters = append(clusters, k8sctx.Server)
+		default:
+			clusters = append(clusters, k8sctx.ID)
+		}
+	}
+
+	deployment := checkpoint.deployment
+	queued := false
+	release, err := h.config.DeploymentQueue.Acquire(ctx, deployment.ID, userID, deployment.Name, clusters, func(qd models.QueuedDeployment) {
+		queued = true
+		if err := checkpoint.persister.SetPatternDeploymentStatus(deployment.ID, models.PatternDeploymentQueued); err != nil {
+			h.log.Error(ErrPatternDeployment(err))
+		}
+		userUUID := uuid.FromStringOrNil(userID)
+		event := events.NewEvent().ActedUpon(deployment.ID).FromUser(userUUID).FromSystem(*h.SystemID).WithCategory("pattern").WithAction("queue").
+			WithSeverity(events.Informational).WithDescription(fmt.Sprintf("Design '%s' is queued for deployment at position %d", deployment.Name, qd.Position)).
+			WithMetadata(map[string]interface{}{"deploymentID": deployment.ID, "queue": qd}).Build()
+		h.publishEvent(provider, userUUID, event)
+	})
+	if err != nil {
+		status := models.PatternDeploymentFailed
+		if models.InterruptedByShutdown(ctx) {
+			status = models.PatternDeploymentInterrupted
+		}
+		if serr := checkpoint.persister.SetPatternDeploymentStatus(deployment.ID, status); serr != nil {
+			h.log.Error(ErrPatternDeployment(serr))
+		}
+		return nil, ErrDeploymentQueue(err, deployment.Name)
+	}
+	if queued {
+		if err := checkpoint.persister.SetPatternDeploymentStatus(deployment.ID, models.PatternDeploymentRunning); err != nil {
+			h.log.Error(ErrPatternDeployment(err))
+		}
+	}
+	return release, nil
+}
+
+// swagger:route GET /api/pattern/deploy/queue PatternsAPI idGetDeploymentQueue
+// Handle GET request for the design deployment queue
+//
+// Returns the design deployments of the user which are running or waiting for a free slot on their clusters,
+// along with the maximum number of deployments running concurrently on a cluster, 0 if there is no limit.
+// responses:
+// 	200:
+
+// DeploymentQueueHandler returns the status of the user's deployments in the deployment queue
+func (h *Handler) DeploymentQueueHandler(
+	rw http.ResponseWriter,
+	_ *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	resp := map[string]interface{}{
+		"limit":       0,
+		"deployments": []models.QueuedDeployment{},
+	}
+	if h.config.DeploymentQueue != nil {
+		resp["limit"] = h.config.DeploymentQueue.Limit()
+		resp["deployments"] = h.config.DeploymentQueue.List(user.ID)
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(resp); err != nil {
+		h.log.Error(models.ErrMarshal(err, "deployment queue"))
+		http.Error(rw, models.ErrMarshal(err, "deployment queue").Error(), http.StatusInternalServerError)
+	}
+}
+
+// setVariableValues sets the values of the variables of the pattern supplied by the ```var``` query parameters, as <name>=<value>.
+// The values are converted to the types of their variables when the variables are rendered.
+func setVariableValues(pattern *core.Pattern, r *http.Request) error {
+	for _, v := range r.URL.Query()["var"] {
+		name, value, ok := strings.Cut(v, "=")
+		if !ok || name == "" {
+			return core.ErrInvalidVariables(fmt.Errorf("malformed var query parameter %q, expected <name>=<value>", v))
+		}
+		if pattern.Vars == nil {
+			pattern.Vars = map[string]interface{}{}
+		}
+		pattern.Vars[name] = value
+	}
+	return nil
+}
+
 func mergeMsgs(msgs []string) string {
 	var finalMsgs []string
 
@@ -156,11 +697,8 @@ func _processPattern(
 	pattern core.Pattern,
 	prefObj *models.Preference,
 	userID string,
-	isDelete bool,
-	verify bool,
-	dryRun bool,
-	skipCrdAndOperator bool,
-	skipPrintLogs bool,
+	opts patternDeployOptions,
+	checkpoint *deploymentCheckpoint,
 	registry *meshmodel.RegistryManager,
 	ec *models.Broadcast,
 	l logger.Handler,
@@ -172,6 +710,9 @@ func _processPattern(
 	if !ok {
 		return nil, ErrRetrieveUserToken(fmt.Errorf("token not found in the context"))
 	}
+	// the relationships the design is validated against are those visible to the organization of the user, the global
+	// ones only for the designs processed in the background
+	orgID, _ := ctx.Value(models.OrgIDCtxKey).(string)
 	// // Get the kubehandler from the context
 	k8scontexts, ok := ctx.Value(models.KubeClustersKey).([]models.K8sContext)
 	if !ok || len(k8scontexts) == 0 {
@@ -202,7 +743,7 @@ func _processPattern(
 		sip := &serviceInfoProvider{
 			token:      token,
 			provider:   provider,
-			opIsDelete: isDelete,
+			opIsDelete: opts.isDelete,
 		}
 		sap := &serviceActionProvider{
 			token:    token,
@@ -210,60 +751,173 @@ func _processPattern(
 			provider: provider,
 			prefObj:  prefObj,
 			// kubeClient:    kubeClient,
-			opIsDelete: isDelete,
+			opIsDelete: opts.isDelete,
 			userID:     userID,
 			registry:   registry,
+			orgID:      orgID,
 			// kubeconfig:    kubecfg,
 			// kubecontext:   mk8scontext,
-			skipPrintLogs:      skipPrintLogs,
-			skipCrdAndOperator: skipCrdAndOperator,
+			skipPrintLogs:      opts.skipPrintLogs,
+			skipCrdAndOperator: opts.skipCRD,
 			ctxTokubeconfig:    ctxToconfig,
 			accumulatedMsgs:    []string{},
 			err:                nil,
 			eventsChannel:      ec,
 			patternName:        strings.ToLower(pattern.Name),
 		}
-		chain := stages.CreateChain()
-		chain.
-			Add(stages.Import(sip, sap)).
-			Add(stages.ServiceIdentifierAndMutator(sip, sap)).
-			Add(stages.Filler(skipPrintLogs)).
+		isDryRun := func(*stages.Data) bool { return opts.dryRun }
+		isDiff := func(*stages.Data) bool { return opts.diff }
+		isCost := func(*stages.Data) bool { return opts.pricing != nil }
+		isImageScan := func(*stages.Data) bool { return opts.imageScan != nil && !opts.isDelete }
+		isSecurity := func(*stages.Data) bool { return !opts.isDelete }
+		isCapabilities := func(*stages.Data) bool { return !opts.isDelete }
+		// the provision stage renders the manifests it would apply in case of dryRun
+		isProvision := func(*stages.Data) bool { return !opts.verify }
+		isDeploy := func(*stages.Data) bool { return !opts.verify && !opts.dryRun }
+		isOrphans := func(*stages.Data) bool { return opts.isDelete && (opts.diff || (!opts.verify && !opts.dryRun)) }
+		chain := stages.CreateChain().
+			AddNamed("import", stages.Import(sip, sap), nil).
+			AddNamed("variables", stages.Variables(), nil).
+			AddNamed("identify", stages.ServiceIdentifierAndMutator(sip, sap), nil).
+			AddNamed("fill", stages.Filler(opts.skipPrintLogs), nil).
 			// Calling this stage `The Validation stage` is a bit deceiving considering
 			// that the validation stage also formats the `data` (chain function parameter) that the
 			// subsequent stages depend on.
 			// We are skipping the `Validation` part in case of dryRun
-			Add(stages.Validator(sip, sap, dryRun))
-		if dryRun {
-			chain.Add(stages.DryRun(sip, sap))
-		}
-		if !verify && !dryRun {
-			chain.
-				Add(stages.Provision(sip, sap)).
-				Add(stages.Persist(sip, sap))
-		}
-		chain.
-			Add(func(data *stages.Data, err error, next stages.ChainStageNextFunction) {
-				data.Lock.Lock()
-				for k, v := range data.Other {
-					if strings.HasSuffix(k, stages.ProvisionSuffixKey) {
-						msg, ok := v.(string)
-						if ok {
-							sap.accumulatedMsgs = append(sap.accumulatedMsgs, msg)
-						}
-					}
-					if k == stages.DryRunResponseKey {
-						if v != nil {
-							resp["dryRunResponse"] = v
-						}
-					}
-				}
-				data.Lock.Unlock()
-				sap.err = err
+			AddNamed("validate", stages.Validator(sip, sap, opts.dryRun), nil).
+			AddNamed("relationships", stages.ValidateRelationships(sip, sap), nil).
+			// the components whose kinds the clusters do not serve, eg: the custom resources of operators which are not
+			// installed, fail the deployment before any of the components is provisioned
+			AddNamed("capabilities", stages.Capabilities(sip, sap, !opts.verify && !opts.dryRun), isCapabilities).
+			AddNamed("dry-run", stages.DryRun(sip, sap), isDryRun).
+			AddNamed("diff", stages.Diff(sip, sap), isDiff).
+			AddNamed("cost", stages.Cost(sip, sap, opts.pricing), isCost).
+			AddNamed("security", stages.SecurityAnalysis(sip, sap), isSecurity).
+			// the images failing the vulnerability scan fail the deployment before any of the components is provisioned
+			AddNamed("image-scan", stages.ImageScan(sip, sap, opts.imageScan, !opts.verify && !opts.dryRun), isImageScan).
+			// the resources of the Helm hooks of designs originating from Helm charts are provisioned before and
+			// after the other resources, like Helm installing the chart would
+			AddStage(stages.ChainStage{
+				Name:       "pre-install",
+				Fn:         stages.HelmHook(stages.HelmHookPreInstall, sip, sap),
+				When:       isProvision,
+				Compensate: stages.Deprovision(sap),
+			}).
+			AddStage(stages.ChainStage{
+				Name:       "provision",
+				Fn:         stages.Provision(sip, sap),
+				When:       isProvision,
+				Compensate: stages.Deprovision(sap),
 			}).
-			Process(&stages.Data{
-				Pattern: &pattern,
-				Other:   map[string]interface{}{},
-			})
+			AddStage(stages.ChainStage{
+				Name:       "post-install",
+				Fn:         stages.HelmHook(stages.HelmHookPostInstall, sip, sap),
+				When:       isProvision,
+				Compensate: stages.Deprovision(sap),
+			}).
+			// the resources labeled with the id of the design which are not part of it anymore are removed with it
+			AddNamed("orphans", stages.Orphans(sip, sap, !opts.verify && !opts.dryRun), isOrphans).
+			AddNamed("persist", stages.Persist(sip, sap), isDeploy)
+		if opts.rollback {
+			// a failed deployment reverts the services provisioned so far instead of leaving the design partially deployed
+			chain.WithErrorPolicy(stages.Rollback)
+		}
+		if ec != nil {
+			var deploymentID *uuid.UUID
+			if checkpoint != nil {
+				deploymentID = &checkpoint.deployment.ID
+			}
+			chain.WithProgress(publishDeploymentProgress(ec, userID, pattern, deploymentID))
+		}
+		data := &stages.Data{
+			Pattern: &pattern,
+			Other:   map[string]interface{}{},
+			DryRun:  opts.dryRun,
+		}
+		var result *stages.ChainResult
+		if checkpoint != nil {
+			// a new deployment is checkpointed before its first stage, so that it is always resumed from its snapshot
+			resp["deploymentID"] = checkpoint.deployment.ID
+			restored, err := stages.UnmarshalData(checkpoint.deployment.Snapshot)
+			if err != nil {
+				return nil, ErrPatternDeployment(err)
+			}
+			data = restored
+			result = chain.
+				WithCheckpointer(checkpoint.persister.Checkpointer(checkpoint.deployment.ID)).
+				Resume(ctx, data, checkpoint.deployment.Completed())
+		} else {
+			result = chain.Process(ctx, data)
+		}
+		resp["timings"] = result.Timings
+
+		data.Lock.Lock()
+		for k, v := range data.Other {
+			if strings.HasSuffix(k, stages.ProvisionSuffixKey) {
+				msg, ok := v.(string)
+				if ok {
+					sap.accumulatedMsgs = append(sap.accumulatedMsgs, msg)
+				}
+			}
+			if k == stages.DryRunResponseKey {
+				if v != nil {
+					resp["dryRunResponse"] = v
+				}
+			}
+			if k == stages.RenderedManifestsKey {
+				resp["manifests"] = v
+			}
+			if k == stages.CapabilitiesKey {
+				resp["capabilities"] = v
+			}
+			if k == stages.ChangesetKey {
+				resp["changeset"] = v
+			}
+			if k == stages.SecurityReportKey {
+				resp["security"] = v
+			}
+			if k == stages.ImageScanKey {
+				resp["imageScan"] = v
+			}
+			if k == stages.CostEstimateKey {
+				resp["cost"] = v
+			}
+			if k == stages.OrphansKey {
+				resp["orphans"] = v
+			}
+		}
+		data.Lock.Unlock()
+		// stages which terminate the deployment report their error through the action provider,
+		// the ones passing it on stop the chain and are reported by the result
+		if err := result.Err(); err != nil {
+			sap.err = err
+		}
+		for _, stageErr := range result.Errors {
+			// the stack of a panicking stage is logged rather than returned to the user
+			var panicErr *stages.PanicError
+			if errors.As(stageErr, &panicErr) {
+				l.Error(fmt.Errorf("stage %d of the deployment of design '%s' panicked: %v\n%s", stageErr.Stage, pattern.Name, panicErr.Value, panicErr.Stack))
+			}
+		}
+		for _, err := range result.CompensationErrors {
+			sap.accumulatedMsgs = append(sap.accumulatedMsgs, fmt.Sprintf("failed to roll back: %s", err))
+		}
+		if checkpoint != nil {
+			for _, err := range result.CheckpointErrors {
+				l.Error(ErrPatternDeployment(err))
+			}
+			status := models.PatternDeploymentCompleted
+			switch {
+			case models.InterruptedByShutdown(ctx):
+				// the deployment stopped at its last checkpoint, it is resumed once Meshery Server restarts
+				status = models.PatternDeploymentInterrupted
+			case sap.err != nil:
+				status = models.PatternDeploymentFailed
+			}
+			if err := checkpoint.persister.SetPatternDeploymentStatus(checkpoint.deployment.ID, status); err != nil {
+				l.Error(ErrPatternDeployment(err))
+			}
+		}
 		resp["messages"] = mergeMsgs(sap.accumulatedMsgs)
 		return resp, sap.err
 	}
@@ -320,6 +974,7 @@ type serviceActionProvider struct {
 	err                error
 	eventsChannel      *models.Broadcast
 	registry           *meshmodel.RegistryManager
+	orgID              string
 	patternName        string
 }
 
@@ -327,6 +982,17 @@ func (sap *serviceActionProvider) GetRegistry() *meshmodel.RegistryManager {
 	return sap.registry
 }
 
+func (sap *serviceActionProvider) GetOrgScopedRegistry() mesherymeshmodel.EntitiesGetter {
+	if sap.registry == nil {
+		return nil
+	}
+	return mesherymeshmodel.OrgScopedRegistry{EntitiesGetter: sap.registry, DB: sap.provider.GetGenericPersister(), Log: sap.log}
+}
+
+func (sap *serviceActionProvider) GetOrgID() string {
+	return sap.orgID
+}
+
 func (sap *serviceActionProvider) Log(msg string) {
 	if sap.log != nil {
 		sap.log.Info(msg)
@@ -352,6 +1018,31 @@ func (sap *serviceActionProvider) Mutate(p *core.Pattern) {
 	}
 }
 
+// Deprovision reverts the provisioning of the component by performing the opposite operation
+func (sap *serviceActionProvider) Deprovision(ccp stages.CompConfigPair) (string, error) {
+	inverse := *sap
+	inverse.opIsDelete = !sap.opIsDelete
+	return inverse.Provision(ccp)
+}
+
+// Render returns the manifests provisioning the component would apply to every Kubernetes context, without applying them
+func (sap *serviceActionProvider) Render(ccp stages.CompConfigPair) (map[string]interface{}, error) {
+	manifests := make(map[string]interface{})
+	for ctxID, kc := range sap.ctxTokubeconfig {
+		// the manifest is rendered locally if the context cannot be connected to
+		cl, err := meshkube.New([]byte(kc))
+		if err != nil {
+			cl = nil
+		}
+		manifest, err := k8s.RenderManifest(cl, ccp.Component)
+		if err != nil {
+			return nil, err
+		}
+		manifests[ctxID] = manifest
+	}
+	return manifests, nil
+}
+
 // NOTE: Currently tied to kubernetes
 // Returns ComponentName->ContextID->Response
 func (sap *serviceActionProvider) DryRun(comps []v1alpha1.Component) (resp map[string]map[string]core.DryRunResponseWrapper, err error) {
@@ -406,6 +1097,75 @@ func (sap *serviceActionProvider) DryRun(comps []v1alpha1.Component) (resp map[s
 	return
 }
 
+// Diff returns the changes deploying the components makes to the resources of every Kubernetes context
+func (sap *serviceActionProvider) Diff(ctx context.Context, comps []v1alpha1.Component) ([]core.ResourceChange, error) {
+	changes := make([]core.ResourceChange, 0, len(comps))
+	for ctxID, kc := range sap.ctxTokubeconfig {
+		cl, err := meshkube.New([]byte(kc))
+		if err != nil {
+			return nil, err
+		}
+		for _, cmp := range comps {
+			change, err := k8s.Diff(ctx, cl, cmp, sap.opIsDelete)
+			if err != nil {
+				return nil, err
+			}
+			change.ContextID = ctxID
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
+
+// Orphans returns the resources of every Kubernetes context labeled with the id of the design which are none of the
+// components, and deletes them when del is true. The resources which could not be deleted are still returned.
+func (sap *serviceActionProvider) Orphans(ctx context.Context, patternID string, comps []v1alpha1.Component, del bool) ([]core.ResourceChange, error) {
+	changes := []core.ResourceChange{}
+	errs := []string{}
+	for ctxID, kc := range sap.ctxTokubeconfig {
+		cl, err := meshkube.New([]byte(kc))
+		if err != nil {
+			return nil, err
+		}
+		orphans, err := k8s.Orphans(ctx, cl, patternID, comps)
+		if err != nil {
+			return nil, err
+		}
+		for _, orphan := range orphans {
+			if del {
+				if err := k8s.DeleteOrphan(ctx, cl, orphan); err != nil {
+					errs = append(errs, err.Error())
+				} else {
+					sap.Log(fmt.Sprintf("Deleted %s %s, which is not part of the design anymore", orphan.Kind, orphan.Name))
+				}
+			}
+			orphan.ContextID = ctxID
+			changes = append(changes, orphan.ResourceChange)
+		}
+	}
+	if len(errs) > 0 {
+		return changes, fmt.Errorf(strings.Join(errs, "\n"))
+	}
+	return changes, nil
+}
+
+// Capabilities returns whether every Kubernetes context serves the kinds of the components
+func (sap *serviceActionProvider) Capabilities(_ context.Context, comps []v1alpha1.Component) ([]core.ComponentCapability, error) {
+	capabilities := make([]core.ComponentCapability, 0, len(comps))
+	for ctxID, kc := range sap.ctxTokubeconfig {
+		cl, err := meshkube.New([]byte(kc))
+		if err != nil {
+			return nil, err
+		}
+		resources, err := k8s.APIResources(cl)
+		if err != nil {
+			return nil, err
+		}
+		capabilities = append(capabilities, core.ComponentCapabilities(comps, ctxID, resources)...)
+	}
+	return capabilities, nil
+}
+
 func convertRawDryRunResponse(componentName string, status map[string]interface{}) (*core.DryRunResponse, error) {
 	response := core.DryRunResponse{}
 
@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	events "github.com/layer5io/meshkit/models/events"
+)
+
+// DesignDeployOptions are the options of the deployments started with DeployDesign, see PatternFileHandler
+type DesignDeployOptions struct {
+	// IDs of the Kubernetes contexts to deploy to, "all" for every context, the first context when empty
+	K8sContextIDs []string
+	// Values of the variables of the design, converted to the types declared under variables in the design
+	Variables map[string]interface{}
+	DryRun    bool
+	SkipCRD   bool
+	Rollback  bool
+}
+
+// providerRequest returns a request carrying the token of the session of the user, for the clients which do not serve
+// an http request, eg: GraphQL subscriptions, as the remote provider reads the token from its cookie
+func providerRequest(ctx context.Context) (*http.Request, error) {
+	token, ok := ctx.Value(models.TokenCtxKey).(string)
+	if !ok {
+		return nil, ErrRetrieveUserToken(fmt.Errorf("token not found in the context"))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.AddCookie(&http.Cookie{Name: "token", Value: token})
+	return req, nil
+}
+
+// GetDesign returns the saved design of the user
+func (h *Handler) GetDesign(ctx context.Context, provider models.Provider, designID uuid.UUID) (*models.MesheryPattern, error) {
+	req, err := providerRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := provider.GetMesheryPattern(req, designID.String())
+	if err != nil {
+		return nil, ErrGetPattern(err)
+	}
+	var design models.MesheryPattern
+	if err := json.Unmarshal(resp, &design); err != nil {
+		return nil, ErrDecodePattern(err)
+	}
+	return &design, nil
+}
+
+// SaveDesign saves the design of the user like the POST requests on /api/pattern, creating it when it has no ID and
+// updating it otherwise
+func (h *Handler) SaveDesign(ctx context.Context, provider models.Provider, user *models.User, design *models.MesheryPattern) (*models.MesheryPattern, error) {
+	userID := uuid.FromStringOrNil(user.ID)
+	action := "create"
+	actedUpon := userID
+	if design.ID != nil {
+		action = "update"
+		actedUpon = *design.ID
+	}
+	eventBuilder := events.NewEvent().FromUser(userID).FromSystem(*h.SystemID).WithCategory("pattern").WithAction(action).ActedUpon(actedUpon)
+
+	design, err := h.saveDesign(ctx, provider, user, design)
+	if err != nil {
+		event := eventBuilder.WithSeverity(events.Error).WithMetadata(map[string]interface{}{
+			"error": err,
+		}).WithDescription(fmt.Sprintf("Unable to %s design", action)).Build()
+		h.publishEvent(provider, userID, event)
+		return nil, err
+	}
+
+	event := eventBuilder.ActedUpon(*design.ID).WithSeverity(events.Informational).WithDescription(fmt.Sprintf("Design '%s' %sd", design.Name, action)).Build()
+	h.publishEvent(provider, userID, event)
+	go h.config.PatternChannel.Publish(userID, struct{}{})
+	return design, nil
+}
+
+func (h *Handler) saveDesign(ctx context.Context, provider models.Provider, user *models.User, design *models.MesheryPattern) (*models.MesheryPattern, error) {
+	if err := core.IsValidPattern(design.PatternFile); err != nil {
+		return nil, ErrInvalidPattern(err)
+	}
+	if design.Name == "" {
+		name, err := models.GetPatternName(design.PatternFile)
+		if err != nil {
+			return nil, ErrSavePattern(err)
+		}
+		design.Name = name
+	}
+	if design.Location == nil {
+		design.Location = map[string]interface{}{
+			"host":   "",
+			"path":   "",
+			"type":   "local",
+			"branch": "",
+		}
+	}
+
+	req, err := providerRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, _ := ctx.Value(models.TokenCtxKey).(string)
+	resp, err := provider.SaveMesheryPattern(token, design)
+	if err != nil {
+		return nil, ErrSavePattern(err)
+	}
+	saved := []models.MesheryPattern{}
+	if err := json.Unmarshal(resp, &saved); err != nil {
+		return nil, ErrDecodePattern(err)
+	}
+	if len(saved) == 0 || saved[0].ID == nil {
+		return nil, ErrSavePattern(fmt.Errorf("the provider did not return the saved design"))
+	}
+	h.pushSavedPattern(req, provider, user, resp)
+	return &saved[0], nil
+}
+
+// DeployDesign deploys the saved design of the user, or undeploys it, like the requests on /api/pattern/deploy.
+// The progress of the deployment is published as events with the progress action while it runs.
+func (h *Handler) DeployDesign(ctx context.Context, provider models.Provider, user *models.User, prefObj *models.Preference, designID uuid.UUID, isDelete bool, opts DesignDeployOptions) (map[string]interface{}, error) {
+	design, err := h.GetDesign(ctx, provider, designID)
+	if err != nil {
+		return nil, err
+	}
+	patternFile, err := core.NewPatternFile([]byte(design.PatternFile))
+	if err != nil {
+		return nil, ErrPatternFile(err)
+	}
+	patternFile.PatternID = designID.String()
+	for name, value := range opts.Variables {
+		if patternFile.Vars == nil {
+			patternFile.Vars = map[string]interface{}{}
+		}
+		patternFile.Vars[name] = value
+	}
+
+	ctx, err = KubernetesMiddleware(ctx, h, provider, user, opts.K8sContextIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	action := "Deploy"
+	if isDelete {
+		action = "Undeploy"
+	}
+	description := fmt.Sprintf("%sed design '%s'", action, patternFile.Name)
+	if opts.DryRun {
+		action = "Dry Run"
+		description = fmt.Sprintf("%s design '%s'", action, patternFile.Name)
+	}
+
+	var checkpoint *deploymentCheckpoint
+	if !opts.DryRun {
+		checkpoint, err = h.newDeploymentCheckpoint(patternFile, user.ID, isDelete, opts.SkipCRD, opts.Rollback)
+		if err != nil {
+			return nil, ErrPatternDeployment(err)
+		}
+		var done func()
+		ctx, done, err = h.trackDeployment(ctx, checkpoint)
+		if err != nil {
+			return nil, err
+		}
+		defer done()
+		release, err := h.waitForDeploymentSlot(ctx, provider, user.ID, checkpoint)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+	}
+
+	response, err := _processPattern(
+		ctx,
+		provider,
+		patternFile,
+		prefObj,
+		user.ID,
+		patternDeployOptions{
+			isDelete:  isDelete,
+			dryRun:    opts.DryRun,
+			imageScan: h.config.ImageScanPolicy,
+			skipCRD:   opts.SkipCRD,
+			rollback:  opts.Rollback,
+		},
+		checkpoint,
+		h.registryManager,
+		h.config.EventBroadcaster,
+		h.log,
+	)
+	h.attachImageScans(patternFile, response)
+
+	userID := uuid.FromStringOrNil(user.ID)
+	eventBuilder := events.NewEvent().ActedUpon(designID).FromUser(userID).FromSystem(*h.SystemID).WithCategory("pattern").WithAction(action)
+	if err != nil {
+		err = ErrCompConfigPairs(err)
+		event := eventBuilder.WithSeverity(events.Error).WithDescription(fmt.Sprintf("%s error for design '%s'", action, patternFile.Name)).WithMetadata(map[string]interface{}{
+			"error": err,
+		}).Build()
+		h.publishEvent(provider, userID, event)
+		return nil, err
+	}
+	if !opts.DryRun {
+		h.trackDeployedPattern(ctx, provider, user, patternFile, isDelete)
+	}
+
+	event := eventBuilder.WithSeverity(events.Informational).WithDescription(description).WithMetadata(map[string]interface{}{
+		"summary": response,
+	}).Build()
+	h.publishEvent(provider, userID, event)
+	return response, nil
+}
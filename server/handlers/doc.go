@@ -34,7 +34,12 @@ import (
 	"bytes"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/layer5io/meshery/server/internal/health"
 	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/imagescan"
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshery/server/models/pattern/patterns/k8s"
 	"github.com/layer5io/meshkit/models/events"
 	SMP "github.com/layer5io/service-mesh-performance/spec"
 	v1 "k8s.io/api/core/v1"
@@ -121,6 +126,27 @@ type performanceProfileResponseWrapper struct {
 	Body models.PerformanceProfile
 }
 
+// Returns the evaluation of the SLOs of the profile of a performance result
+// swagger:response perfResultSLORespWrapper
+type perfResultSLORespWrapper struct {
+	// in: body
+	Body models.PerformanceSLOReport
+}
+
+// Returns the built-in performance profile templates and the ones of the user
+// swagger:response performanceProfileTemplatesRespWrapper
+type performanceProfileTemplatesRespWrapper struct {
+	// in: body
+	Body []models.PerformanceProfileTemplate
+}
+
+// Returns a performance profile template
+// swagger:response performanceProfileTemplateRespWrapper
+type performanceProfileTemplateRespWrapper struct {
+	// in: body
+	Body models.PerformanceProfileTemplate
+}
+
 // Save a performance profile
 // swagger:parameters idSavePerformanceProfile
 type performanceProfileParameterWrapper struct {
@@ -334,6 +360,13 @@ type mesheryVersionRespWrapper struct {
 	Body Version
 }
 
+// Returns the status of the subsystems of Meshery Server
+// swagger:response healthReportRespWrapper
+type healthReportRespWrapper struct {
+	// in: body
+	Body health.Report
+}
+
 // Returns the response of the application files
 // swagger:response applicationFilesResponseWrapper
 type applicationFilesResponseWrapper struct {
@@ -444,6 +477,332 @@ type meshmodelRelationshipsResponseWrapper struct {
 	Body *models.MeshmodelRelationshipsAPIResponse
 }
 
+// Returns per-item outcome of a bulk relationship registration
+// swagger:response meshmodelRelationshipsBulkRegistrationResponseWrapper
+type meshmodelRelationshipsBulkRegistrationResponseWrapper struct {
+	// in: body
+	Body *models.MeshmodelRelationshipsBulkRegistrationResponse
+}
+
+// Returns the graph of components connected by the relationships of a model
+// swagger:response meshmodelRelationshipsGraphResponseWrapper
+type meshmodelRelationshipsGraphResponseWrapper struct {
+	// in: body
+	Body *mesherymeshmodel.RelationshipGraph
+}
+
+// Returns the number of saved designs using each registered relationship
+// swagger:response meshmodelRelationshipsUsageResponseWrapper
+type meshmodelRelationshipsUsageResponseWrapper struct {
+	// in: body
+	Body *models.RelationshipUsageReport
+}
+
+// Returns the pairs of components matched by a candidate relationship
+// swagger:response meshmodelRelationshipEvaluationResponseWrapper
+type meshmodelRelationshipEvaluationResponseWrapper struct {
+	// in: body
+	Body *models.MeshmodelRelationshipEvaluationResponse
+}
+
+// Parameters for exporting a design
+// swagger:parameters idExportMesheryPattern
+type patternExportParamsWrapper struct {
+	// in: query
+	Format string `json:"format"`
+	// in: body
+	Body *models.MesheryPatternExportRequestBody
+}
+
+// Returns the revisions of a design
+// swagger:response mesheryPatternRevisionsResponseWrapper
+type mesheryPatternRevisionsResponseWrapper struct {
+	// in: body
+	Body models.MesheryPatternRevisionsAPIResponse
+}
+
+// Returns the difference between two revisions of a design
+// swagger:response mesheryPatternRevisionsDiffResponseWrapper
+type mesheryPatternRevisionsDiffResponseWrapper struct {
+	// in: body
+	Body models.MesheryPatternRevisionsDiffAPIResponse
+}
+
+// Parameters for the difference between two revisions of a design
+// swagger:parameters idGetMesheryPatternRevisionsDiff
+type patternRevisionsDiffParamsWrapper struct {
+	// in: query
+	From int `json:"from"`
+	// in: query
+	To int `json:"to"`
+}
+
+// Returns the merged design and the conflicts left unresolved
+// swagger:response patternMergeResponseWrapper
+type patternMergeResponseWrapper struct {
+	// in: body
+	Body models.PatternMergeResponse
+}
+
+// Parameters for merging two designs
+// swagger:parameters idMergeMesheryPatterns
+type patternMergeParamsWrapper struct {
+	// in: body
+	Body *models.MesheryPatternMergeRequestBody
+}
+
+// Returns a link of designs to a Git repository
+// swagger:response gitOpsLinkResponseWrapper
+type gitOpsLinkResponseWrapper struct {
+	// in: body
+	Body GitOpsLinkResponse
+}
+
+// Returns the links of designs to Git repositories
+// swagger:response gitOpsLinksResponseWrapper
+type gitOpsLinksResponseWrapper struct {
+	// in: body
+	Body []GitOpsLinkResponse
+}
+
+// Parameters for linking designs to a Git repository
+// swagger:parameters idCreateGitOpsLink
+type gitOpsLinkParamsWrapper struct {
+	// in: body
+	Body *models.GitOpsLinkRequestBody
+}
+
+// Returns a schedule of design deployments
+// swagger:response patternScheduleResponseWrapper
+type patternScheduleResponseWrapper struct {
+	// in: body
+	Body models.PatternSchedule
+}
+
+// Returns the schedules of design deployments
+// swagger:response patternSchedulesResponseWrapper
+type patternSchedulesResponseWrapper struct {
+	// in: body
+	Body []models.PatternSchedule
+}
+
+// Parameters for scheduling the deployment of a design
+// swagger:parameters idCreatePatternSchedule
+type patternScheduleParamsWrapper struct {
+	// in: body
+	Body *models.PatternScheduleRequestBody
+}
+
+// Returns the migration of the saved designs to the current version of the pattern file format
+// swagger:response patternMigrationResponseWrapper
+type patternMigrationResponseWrapper struct {
+	// in: body
+	Body models.PatternMigrationResponse
+}
+
+// Returns a design deployed to a Kubernetes context and its drift
+// swagger:response deployedPatternResponseWrapper
+type deployedPatternResponseWrapper struct {
+	// in: body
+	Body models.DeployedPattern
+}
+
+// Returns the status of the rollout of a deployed pattern, streamed whenever it changes
+// swagger:response patternRolloutResponseWrapper
+type patternRolloutResponseWrapper struct {
+	// in: body
+	Body core.DesignRollout
+}
+
+// Returns the metadata of the clusters of the Kubernetes contexts
+// swagger:response clustersMetadataRespWrapper
+type clustersMetadataRespWrapper struct {
+	// in: body
+	Body []models.ClusterMetadata
+}
+
+// Returns the metadata of the cluster of a Kubernetes context
+// swagger:response clusterMetadataRespWrapper
+type clusterMetadataRespWrapper struct {
+	// in: body
+	Body models.ClusterMetadata
+}
+
+// Returns the lines of the logs of the pods of a component of a deployed design, streamed as they are read
+// swagger:response componentLogsResponseWrapper
+type componentLogsResponseWrapper struct {
+	// in: body
+	Body k8s.LogLine
+}
+
+// Returns the designs deployed to Kubernetes contexts and their drift
+// swagger:response deployedPatternsResponseWrapper
+type deployedPatternsResponseWrapper struct {
+	// in: body
+	Body []models.DeployedPattern
+}
+
+// Returns the resources the undeployment of a design deletes
+// swagger:response undeployPreviewResponseWrapper
+type undeployPreviewResponseWrapper struct {
+	// in: body
+	Body UndeployPreview
+}
+
+// Returns the estimated cost of running a design
+// swagger:response patternCostResponseWrapper
+type patternCostResponseWrapper struct {
+	// in: body
+	Body core.CostEstimate
+}
+
+// Returns the scan of the container images of a design
+// swagger:response imageScanResponseWrapper
+type imageScanResponseWrapper struct {
+	// in: body
+	Body imagescan.Result
+}
+
+// Returns the scans of the container images of a saved design
+// swagger:response patternImageScansResponseWrapper
+type patternImageScansResponseWrapper struct {
+	// in: body
+	Body []models.PatternImageScan
+}
+
+// Returns the security posture of a design
+// swagger:response patternSecurityResponseWrapper
+type patternSecurityResponseWrapper struct {
+	// in: body
+	Body core.SecurityReport
+}
+
+// Returns the capability of every component of a design in every Kubernetes context
+// swagger:response patternCapabilitiesResponseWrapper
+type patternCapabilitiesResponseWrapper struct {
+	// in: body
+	Body core.CapabilityMatrix
+}
+
+// Returns the design converted from the imported file
+// swagger:response patternImportResponseWrapper
+type patternImportResponseWrapper struct {
+	// in: body
+	Body *models.PatternImportResponse
+}
+
+// Returns the relationships and configuration patches the evaluation policies suggest for a design
+// swagger:response patternEvaluationResponseWrapper
+type patternEvaluationResponseWrapper struct {
+	// in: body
+	Body *mesherymeshmodel.PolicyEvaluation
+}
+
+// Returns the mistakes found in the linted relationship definitions
+// swagger:response meshmodelRelationshipsLintResponseWrapper
+type meshmodelRelationshipsLintResponseWrapper struct {
+	// in: body
+	Body *models.MeshmodelRelationshipsLintResponse
+}
+
+// Returns a page of the audit log
+// swagger:response auditRecordsRespWrapper
+type auditRecordsRespWrapper struct {
+	// in: body
+	Body models.AuditRecordsPage
+}
+
+// Returns the role and the permissions of the user
+// swagger:response userPermissionsRespWrapper
+type userPermissionsRespWrapper struct {
+	// in: body
+	Body models.UserPermissions
+}
+
+// Returns the roles with their permissions
+// swagger:response rolesRespWrapper
+type rolesRespWrapper struct {
+	// in: body
+	Body []models.RoleDefinition
+}
+
+// Returns the bindings of the roles
+// swagger:response roleBindingsRespWrapper
+type roleBindingsRespWrapper struct {
+	// in: body
+	Body []models.RoleBinding
+}
+
+// Returns the binding of a role
+// swagger:response roleBindingRespWrapper
+type roleBindingRespWrapper struct {
+	// in: body
+	Body models.RoleBinding
+}
+
+// Returns the API tokens of the user, without their values
+// swagger:response apiTokensRespWrapper
+type apiTokensRespWrapper struct {
+	// in: body
+	Body []models.APIToken
+}
+
+// Returns the API token created, with its value
+// swagger:response createdAPITokenRespWrapper
+type createdAPITokenRespWrapper struct {
+	// in: body
+	Body models.CreatedAPIToken
+}
+
+// Returns a page of the background jobs
+// swagger:response jobsRespWrapper
+type jobsRespWrapper struct {
+	// in: body
+	Body models.JobsPage
+}
+
+// Returns a background job
+// swagger:response jobRespWrapper
+type jobRespWrapper struct {
+	// in: body
+	Body *models.Job
+}
+
+// Returns the mistakes found in the linted design
+// swagger:response patternLintResponseWrapper
+type patternLintResponseWrapper struct {
+	// in: body
+	Body *models.PatternLintResponse
+}
+
+// Returns the registrant, source and time of registration of the registered relationships
+// swagger:response meshmodelRelationshipsProvenanceResponseWrapper
+type meshmodelRelationshipsProvenanceResponseWrapper struct {
+	// in: body
+	Body *models.MeshmodelRelationshipsProvenanceAPIResponse
+}
+
+// Returns the outcome of the import of every entity of a registry bundle
+// swagger:response registryImportResponseWrapper
+type registryImportResponseWrapper struct {
+	// in: body
+	Body mesherymeshmodel.RegistryImportReport
+}
+
+// Returns the prior revisions of a meshmodel relationship
+// swagger:response meshmodelRelationshipHistoryResponseWrapper
+type meshmodelRelationshipHistoryResponseWrapper struct {
+	// in: body
+	Body *models.MeshmodelRelationshipHistoryAPIResponse
+}
+
+// Returns the policies relationship definitions are validated against
+// swagger:response meshmodelRelationshipPoliciesResponseWrapper
+type meshmodelRelationshipPoliciesResponseWrapper struct {
+	// in: body
+	Body *models.MeshmodelRelationshipPoliciesAPIResponse
+}
+
 // Returns meshmodel policies
 // swagger:response meshmodelPoliciesResponseWrapper
 type meshmodelPoliciesResponseWrapper struct {
@@ -527,3 +886,59 @@ type eventsResponseWrapper struct {
 	// in: body
 	Body *models.EventsResponse
 }
+
+// Returns the webhooks of the user, without their secrets
+// swagger:response webhooksRespWrapper
+type webhooksRespWrapper struct {
+	// in: body
+	Body []models.Webhook
+}
+
+// Returns the webhook
+// swagger:response webhookRespWrapper
+type webhookRespWrapper struct {
+	// in: body
+	Body models.Webhook
+}
+
+// Returns the webhook registered, with its secret
+// swagger:response createdWebhookRespWrapper
+type createdWebhookRespWrapper struct {
+	// in: body
+	Body models.CreatedWebhook
+}
+
+// Returns a page of the deliveries of a webhook
+// swagger:response webhookDeliveriesRespWrapper
+type webhookDeliveriesRespWrapper struct {
+	// in: body
+	Body models.WebhookDeliveriesPage
+}
+
+// Returns the notification channels of the user
+// swagger:response notificationChannelsRespWrapper
+type notificationChannelsRespWrapper struct {
+	// in: body
+	Body []models.NotificationChannel
+}
+
+// Returns the notification channel
+// swagger:response notificationChannelRespWrapper
+type notificationChannelRespWrapper struct {
+	// in: body
+	Body models.NotificationChannel
+}
+
+// Returns the notification rules of the user
+// swagger:response notificationRulesRespWrapper
+type notificationRulesRespWrapper struct {
+	// in: body
+	Body []models.NotificationRule
+}
+
+// Returns the notification rule
+// swagger:response notificationRuleRespWrapper
+type notificationRuleRespWrapper struct {
+	// in: body
+	Body models.NotificationRule
+}
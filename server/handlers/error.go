@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/layer5io/meshery/server/models"
 	"github.com/layer5io/meshkit/errors"
 )
 
@@ -124,6 +125,41 @@ const (
 	ErrUnsupportedEventStatusCode       = "1129"
 	ErrBulkUpdateEventCode              = "1537"
 	ErrBulkDeleteEventCode              = "1538"
+	ErrRegisterRelationshipCode         = "1539"
+	ErrDeleteRelationshipCode           = "1540"
+	ErrUpdateRelationshipCode           = "1541"
+	ErrInvalidRelationshipCode          = "1542"
+	ErrExportRelationshipsCode          = "1543"
+	ErrFetchRelationshipProvenanceCode  = "1545"
+	ErrEventStreamingNotSupportedCode   = "1546"
+	ErrFetchRelationshipHistoryCode     = "1547"
+	ErrRelationshipPolicyCode           = "1548"
+	ErrPatternDeploymentCode            = "1550"
+	ErrDeploymentQueueCode              = "1553"
+	ErrPolicyEngineUnavailableCode      = "1554"
+	ErrPatternRevisionCode              = "1559"
+	ErrGitOpsLinkCode                   = "1562"
+	ErrPatternScheduleCode              = "1563"
+	ErrPatternDriftCode                 = "1565"
+	ErrExportRegistryBundleCode         = "1574"
+	ErrImportRegistryBundleCode         = "1575"
+	ErrPatternRolloutCode               = "1576"
+	ErrPatternLintCode                  = "1577"
+	ErrAuditLogCode                     = "1579"
+	ErrAuditFilterCode                  = "1580"
+	ErrJobCode                          = "1587"
+	ErrExecDeployedPatternCode          = "1589"
+	ErrComponentLogsCode                = "1591"
+	ErrCorrelateKubernetesEventsCode    = "1593"
+	ErrClusterMetadataCode              = "1595"
+	ErrPermissionDeniedCode             = "1606"
+	ErrRoleBindingCode                  = "1607"
+	ErrAPITokenCode                     = "1609"
+	ErrAPITokenScopeCode                = "1612"
+	ErrWebhookCode                      = "1621"
+	ErrNotificationCode                 = "1624"
+	ErrPerformanceProfileTemplateCode   = "1629"
+	ErrRequestOrgCode                   = "1635"
 )
 
 var (
@@ -142,6 +178,8 @@ var (
 	ErrPerformanceTest     = errors.New(ErrPerformanceTestCode, errors.Alert, []string{"Load test error"}, []string{}, []string{"Load test endpoint could be not reachable"}, []string{"Make sure load test endpoint is reachable"})
 )
 
+var ErrEventStreamingNotSupported = errors.New(ErrEventStreamingNotSupportedCode, errors.Alert, []string{"Event streaming is not supported"}, []string{"The response writer does not support flushing"}, []string{"A proxy or middleware in front of Meshery Server buffers the response"}, []string{"Make sure the proxies in front of Meshery Server allow streaming responses"})
+
 func ErrGenerateComponents(err error) error {
 	return errors.New(ErrGenerateComponentsCode, errors.Alert, []string{"failed to generate components for the given payload"}, []string{err.Error()}, []string{}, []string{"Make sure the payload is valid"})
 }
@@ -540,3 +578,139 @@ func ErrBulkDeleteEvent(err error) error {
 func ErrUnsupportedEventStatus(err error, status string) error {
 	return errors.New(ErrUnsupportedEventStatusCode, errors.Alert, []string{fmt.Sprintf("Event status '%s' is not a supported status.", status)}, []string{err.Error()}, []string{"Unsupported event status for your current version of Meshery Server."}, []string{"Confirm that the status you are using is valid and a supported event status. Refer to Meshery Docs for a list of event statuses.", "Check for availability of a new version of Meshery Server. Try upgrading to the latest version." })
 }
+
+func ErrRegisterRelationship(err error) error {
+	return errors.New(ErrRegisterRelationshipCode, errors.Alert, []string{"Could not register one or more relationship definitions."}, []string{err.Error()}, []string{"Relationship definition is missing required fields.", "Meshery Database is not reachable or corrupt."}, []string{"Verify the relationship definitions against the relationship schema.", "Visit Settings and reset the Meshery database."})
+}
+
+func ErrDeleteRelationship(err error, name string) error {
+	return errors.New(ErrDeleteRelationshipCode, errors.Alert, []string{fmt.Sprintf("Could not delete relationship %s", name)}, []string{err.Error()}, []string{"Relationship definition has been deleted or does not exist.", "Meshery Database is not reachable or corrupt."}, []string{"Verify that the relationship is registered.", "Visit Settings and reset the Meshery database."})
+}
+
+func ErrUpdateRelationship(err error, name string) error {
+	return errors.New(ErrUpdateRelationshipCode, errors.Alert, []string{fmt.Sprintf("Could not update relationship %s", name)}, []string{err.Error()}, []string{"The update is not valid JSON or attempts to change the model of the relationship.", "Meshery Database is not reachable or corrupt."}, []string{"Verify the request body against the relationship schema.", "Visit Settings and reset the Meshery database."})
+}
+
+func ErrInvalidRelationship(err error) error {
+	return errors.New(ErrInvalidRelationshipCode, errors.Alert, []string{"Relationship definition does not conform to the relationship schema"}, []string{err.Error()}, []string{"One or more selectors reference an invalid path or are missing the model.", "Metadata contains fields of an unexpected type."}, []string{"Fix the fields listed in the response and register the relationship again."})
+}
+
+func ErrExportRelationships(err error, model string) error {
+	return errors.New(ErrExportRelationshipsCode, errors.Alert, []string{fmt.Sprintf("Could not export relationships of model %s", model)}, []string{err.Error()}, []string{"Relationship definitions could not be serialized into the archive."}, []string{"Verify the registered relationship definitions of the model are valid."})
+}
+
+func ErrFetchRelationshipProvenance(err error) error {
+	return errors.New(ErrFetchRelationshipProvenanceCode, errors.Alert, []string{"Could not fetch the provenance of the registered relationships"}, []string{err.Error()}, []string{"Meshery Database is not reachable or corrupt."}, []string{"Visit Settings and reset the Meshery database."})
+}
+
+func ErrFetchRelationshipHistory(err error, name string) error {
+	return errors.New(ErrFetchRelationshipHistoryCode, errors.Alert, []string{fmt.Sprintf("Could not fetch the revisions of relationship %s", name)}, []string{err.Error()}, []string{"Meshery Database is not reachable or corrupt."}, []string{"Visit Settings and reset the Meshery database."})
+}
+
+func ErrPatternDeployment(err error) error {
+	return errors.New(ErrPatternDeploymentCode, errors.Alert, []string{"Could not checkpoint or resume the design deployment"}, []string{err.Error()}, []string{"The progress of the deployment could not be stored.", "Meshery Database is not reachable or corrupt."}, []string{"Deploy the design again.", "Visit Settings and reset the Meshery database."})
+}
+
+func ErrDeploymentQueue(err error, name string) error {
+	return errors.New(ErrDeploymentQueueCode, errors.Alert, []string{fmt.Sprintf("Design %s left the deployment queue before it was deployed", name)}, []string{err.Error()}, []string{"The request was cancelled while the deployment was waiting for the deployments running on its clusters to finish."}, []string{"Deploy the design again.", "Increase MAX_CONCURRENT_DEPLOYMENTS_PER_CLUSTER to deploy more designs at once."})
+}
+
+func ErrPolicyEngineUnavailable() error {
+	return errors.New(ErrPolicyEngineUnavailableCode, errors.Alert, []string{"The relationship evaluation policies are not available"}, []string{"The policy engine was not initialized"}, []string{"The policies could not be loaded from the policies directory when Meshery Server started."}, []string{"Check the logs of Meshery Server for errors loading the policies and restart it."})
+}
+
+func ErrRelationshipPolicy(err error) error {
+	return errors.New(ErrRelationshipPolicyCode, errors.Alert, []string{"Could not process the relationship policies"}, []string{err.Error()}, []string{"The policy is not a valid Rego module.", "Meshery Database is not reachable or corrupt."}, []string{"Make sure the policy is a valid Rego module declaring the deny rule in the meshery.relationships package.", "Visit Settings and reset the Meshery database."})
+}
+
+func ErrPatternRevision(err error, patternID string) error {
+	return errors.New(ErrPatternRevisionCode, errors.Alert, []string{fmt.Sprintf("Could not process the revisions of design %s", patternID)}, []string{err.Error()}, []string{"The design or the requested revision does not exist.", "The revisions of the design are malformed."}, []string{"Check if the design ID and the revision are correct."})
+}
+
+func ErrGitOpsLink(err error) error {
+	return errors.New(ErrGitOpsLinkCode, errors.Alert, []string{"Could not store the link of the designs to the Git repository"}, []string{err.Error()}, []string{"Meshery Database is not reachable or corrupt."}, []string{"Visit Settings and reset the Meshery database."})
+}
+
+func ErrPatternSchedule(err error) error {
+	return errors.New(ErrPatternScheduleCode, errors.Alert, []string{"Could not process the schedule of the design"}, []string{err.Error()}, []string{"Meshery Database is not reachable or corrupt.", "The design of the schedule no longer exists or cannot be deployed."}, []string{"Check the last run error of the schedule.", "Visit Settings and reset the Meshery database."})
+}
+
+func ErrPatternDrift(err error) error {
+	return errors.New(ErrPatternDriftCode, errors.Alert, []string{"Could not detect the drift of the deployed design"}, []string{err.Error()}, []string{"The Kubernetes context of the deployed design is not connected or not reachable.", "The session the design was deployed with expired.", "Meshery Database is not reachable or corrupt."}, []string{"Make sure the Kubernetes context is connected.", "Deploy the design again to refresh its session."})
+}
+
+func ErrExportRegistryBundle(err error) error {
+	return errors.New(ErrExportRegistryBundleCode, errors.Alert, []string{"Could not export the registry bundle"}, []string{err.Error()}, []string{"The registered entities could not be serialized into the archive.", "Meshery Database is not reachable or corrupt."}, []string{"Verify the registered models, components and relationships are valid.", "Visit Settings and reset the Meshery database."})
+}
+
+func ErrImportRegistryBundle(err error) error {
+	return errors.New(ErrImportRegistryBundleCode, errors.Alert, []string{"Could not import the registry bundle"}, []string{err.Error()}, []string{"The request body is not a gzip compressed tarball exported by Meshery.", "One of the entities of the bundle is not valid JSON."}, []string{"Export the bundle again with `mesheryctl registry export` and import the exported file as is."})
+}
+
+func ErrPatternRollout(err error) error {
+	return errors.New(ErrPatternRolloutCode, errors.Alert, []string{"Could not watch the rollout of the design"}, []string{err.Error()}, []string{"The Kubernetes context the design was deployed to is not reachable.", "Meshery does not have permission to read the resources of the design."}, []string{"Make sure the Kubernetes context is connected.", "Ensure Meshery has permission to read the resources of the design."})
+}
+
+func ErrPatternLint(err error) error {
+	return errors.New(ErrPatternLintCode, errors.Alert, []string{"Could not lint the design"}, []string{err.Error()}, []string{"The policies the design is evaluated against are invalid."}, []string{"Check the Rego modules of the policies for syntax errors."})
+}
+
+func ErrAuditLog(err error) error {
+	return errors.New(ErrAuditLogCode, errors.Alert, []string{"Could not access the audit log"}, []string{err.Error()}, []string{"The database of Meshery Server may be unavailable."}, []string{"Check the logs of Meshery Server for the errors of the database."})
+}
+
+func ErrAuditFilter(err error, param string) error {
+	return errors.New(ErrAuditFilterCode, errors.Alert, []string{"Invalid filter of the audit log: ", param}, []string{err.Error()}, []string{"The timestamps filtering the audit log are not in the RFC 3339 format."}, []string{"Pass timestamps like 2006-01-02T15:04:05Z07:00 in the since and until query parameters."})
+}
+
+func ErrJob(err error) error {
+	return errors.New(ErrJobCode, errors.Alert, []string{"Could not process the background jobs"}, []string{err.Error()}, []string{"Meshery Database is not reachable or corrupt."}, []string{"Visit Settings and reset the Meshery database."})
+}
+
+func ErrExecDeployedPattern(err error) error {
+	return errors.New(ErrExecDeployedPatternCode, errors.Alert, []string{"Could not open a terminal session in the deployed design"}, []string{err.Error()}, []string{"The Kubernetes context of the design is not connected or not reachable.", "The pod or the container does not exist anymore.", "The WebSocket connection was refused or interrupted."}, []string{"Ensure the Kubernetes context of the design is connected and the pod is running.", "Open the terminal session again."})
+}
+
+func ErrComponentLogs(err error) error {
+	return errors.New(ErrComponentLogsCode, errors.Alert, []string{"Could not stream the logs of the component of the deployed design"}, []string{err.Error()}, []string{"The Kubernetes context of the design is not connected or not reachable.", "The resource of the component does not exist or does not select pods."}, []string{"Ensure the Kubernetes context of the design is connected and the component is deployed.", "Ensure the component is a workload, eg: a deployment, a pod or a service."})
+}
+
+func ErrCorrelateKubernetesEvents(err error) error {
+	return errors.New(ErrCorrelateKubernetesEventsCode, errors.Alert, []string{"Could not read the Kubernetes events of the deployed designs"}, []string{err.Error()}, []string{"The Kubernetes context the designs are deployed to is not connected or not reachable.", "The credentials of the Kubernetes context do not allow listing its events."}, []string{"Ensure the Kubernetes contexts of the deployed designs are connected.", "Ensure the credentials of the Kubernetes contexts allow listing the events and reading the resources of the designs."})
+}
+
+func ErrClusterMetadata(err error) error {
+	return errors.New(ErrClusterMetadataCode, errors.Alert, []string{"Could not read or store the metadata of the clusters of the Kubernetes contexts"}, []string{err.Error()}, []string{"The Kubernetes contexts of the user could not be read from the provider.", "The database of Meshery Server is not reachable."}, []string{"Ensure the provider is reachable and retry.", "Restart Meshery Server if the error persists."})
+}
+
+func ErrPermissionDenied(role models.Role, permission models.Permission) error {
+	return errors.New(ErrPermissionDeniedCode, errors.Alert, []string{fmt.Sprintf("The %s role does not grant the %s permission", role, permission)}, []string{fmt.Sprintf("The request requires the %s permission, which the role of the user in the workspace of the request does not grant.", permission)}, []string{"The role bound to the user is lower than the one required by the request.", "The request is made in a workspace the user has no role in."}, []string{"Ask an admin of Meshery Server to bind a higher role to the user.", "Check the permissions of the user with the permissions API."})
+}
+
+func ErrRoleBinding(err error) error {
+	return errors.New(ErrRoleBindingCode, errors.Alert, []string{"Could not read or store the bindings of the roles"}, []string{err.Error()}, []string{"The database of Meshery Server is not reachable."}, []string{"Restart Meshery Server if the error persists."})
+}
+
+func ErrAPIToken(err error) error {
+	return errors.New(ErrAPITokenCode, errors.Alert, []string{"Could not read or store the API tokens"}, []string{err.Error()}, []string{"The database of Meshery Server is not reachable."}, []string{"Restart Meshery Server if the error persists."})
+}
+
+func ErrAPITokenScope(permission models.Permission) error {
+	return errors.New(ErrAPITokenScopeCode, errors.Alert, []string{fmt.Sprintf("The API token is not allowed the %s permission", permission)}, []string{fmt.Sprintf("The request requires the %s permission, which is not among the scopes of the API token it is authenticated with.", permission)}, []string{"The API token was created with narrower scopes than the request requires."}, []string{fmt.Sprintf("Create an API token with the %s scope.", permission)})
+}
+
+func ErrWebhook(err error) error {
+	return errors.New(ErrWebhookCode, errors.Alert, []string{"Unable to manage the webhooks"}, []string{err.Error()}, []string{"Meshery Database handler is not accessible to perform operations."}, []string{"Restart Meshery Server or check the accessibility of the database."})
+}
+
+func ErrNotification(err error) error {
+	return errors.New(ErrNotificationCode, errors.Alert, []string{"Unable to manage the notification channels and rules"}, []string{err.Error()}, []string{"Meshery Database handler is not accessible to perform operations."}, []string{"Restart Meshery Server or check the accessibility of the database."})
+}
+
+func ErrPerformanceProfileTemplate(err error) error {
+	return errors.New(ErrPerformanceProfileTemplateCode, errors.Alert, []string{"Unable to manage the performance profile templates"}, []string{err.Error()}, []string{"Meshery Database handler is not accessible to perform operations."}, []string{"Restart Meshery Server or check the accessibility of the database."})
+}
+
+func ErrRequestOrg(err error) error {
+	return errors.New(ErrRequestOrgCode, errors.Alert, []string{"Unable to determine the organization of the user"}, []string{err.Error()}, []string{"The provider token of the request is missing, expired or invalid.", "The provider is not reachable."}, []string{"Sign in again to refresh the provider token.", "Make sure the provider is reachable."})
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/layer5io/meshkit/models/events"
 	_events "github.com/layer5io/meshkit/utils/events"
 	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
 )
 
 var (
@@ -27,7 +28,7 @@ var (
 )
 
 type eventStatusPayload struct {
-	Status    string `json:"status"`
+	Status    string       `json:"status"`
 	StatusIDs []*uuid.UUID `json:"ids"`
 }
 
@@ -35,13 +36,20 @@ type statusIDs struct {
 	IDs []*uuid.UUID `json:"ids"`
 }
 
+// eventsCount is the response of the bulk operations applied to the events matching a filter
+type eventsCount struct {
+	Count int64 `json:"count"`
+}
+
 // swagger:route GET /api/v2/events EventsAPI idGetEventStreamer
 // Handle GET request for events.
 // ```search={description}``` If search is non empty then a search is performed on event description
 // ```?category=[eventcategory] Returns event belonging to provided categories ```
 // ```?action=[eventaction] Returns events belonging to provided actions ```
-// ```?status={[read/unread]}``` Return events filtered on event status Default is unread````
+// ```?status={[read/unread/acknowledged]}``` Return events filtered on event status Default is unread````
 // ```?severity=[eventseverity] Returns events belonging to provided severities ```
+// ```?acted_upon=[entityid] Returns events linked to the provided entities, eg: designs or connections ```
+// ```?since={RFC 3339 time}&until={RFC 3339 time} Returns events created in the time range ```
 // ```?sort={field} order the records based on passed field, defaults to updated_at```
 // ```?order={[asc/desc]}``` Default behavior is desc
 // ```?page={page-number}``` Default page number is 1
@@ -104,7 +112,7 @@ func (h *Handler) GetEventTypes(w http.ResponseWriter, req *http.Request, prefOb
 
 // swagger:route PUT /api/events/status/{id} idGetEventStreamer
 // Handle PUT request to update event status.
-// Updates event status for the event associated with the id.
+// Updates event status for the event associated with the id, to read, unread or acknowledged.
 // responses:
 // 	200: eventResponseWrapper
 
@@ -130,11 +138,16 @@ func (h *Handler) UpdateEventStatus(w http.ResponseWriter, req *http.Request, pr
 		http.Error(w, ErrUpdateEvent(fmt.Errorf("unable to parse provided event status %s", status), eventID.String()).Error(), http.StatusInternalServerError)
 		return
 	}
-	event, err := provider.UpdateEventStatus(eventID, status)
+	if !models.ValidEventStatus(status) {
+		_err := ErrUnsupportedEventStatus(fmt.Errorf("the event statuses are %v", models.EventStatuses), status)
+		http.Error(w, _err.Error(), http.StatusBadRequest)
+		return
+	}
+	event, err := provider.UpdateEventStatus(eventID, uuid.FromStringOrNil(user.ID), status)
 	if err != nil {
 		_err := ErrUpdateEvent(err, eventID.String())
 		h.log.Error(_err)
-		http.Error(w, _err.Error(), http.StatusInternalServerError)
+		http.Error(w, _err.Error(), eventErrorStatus(err))
 		return
 	}
 	err = json.NewEncoder(w).Encode(event)
@@ -166,7 +179,12 @@ func (h *Handler) BulkUpdateEventStatus(w http.ResponseWriter, req *http.Request
 	}
 
 	_ = json.Unmarshal(body, &reqBody)
-	event, err := provider.BulkUpdateEventStatus(reqBody.StatusIDs, reqBody.Status)
+	if !models.ValidEventStatus(reqBody.Status) {
+		_err := ErrUnsupportedEventStatus(fmt.Errorf("the event statuses are %v", models.EventStatuses), reqBody.Status)
+		http.Error(w, _err.Error(), http.StatusBadRequest)
+		return
+	}
+	event, err := provider.BulkUpdateEventStatus(reqBody.StatusIDs, uuid.FromStringOrNil(user.ID), reqBody.Status)
 	if err != nil {
 		_err := ErrBulkUpdateEvent(err)
 		h.log.Error(_err)
@@ -181,33 +199,53 @@ func (h *Handler) BulkUpdateEventStatus(w http.ResponseWriter, req *http.Request
 	}
 }
 
+// swagger:route POST /api/events/acknowledge EventsAPI idAcknowledgeEvents
+// Handle POST request to acknowledge events in bulk.
+// Acknowledges the events associated with the ```ids``` of the body, or the events matching the filters of the query,
+// the same as the ones of ```GET /api/v2/events```, eg: ```?severity=["error"]&until=2024-01-01T00:00:00Z```.
+// The acknowledged events are left out of the counts by severity of the notification center.
+// At least one id or one filter is required. Returns the number of events acknowledged.
+// responses:
+// 	200:
+// 	400:
+
+func (h *Handler) AcknowledgeEvents(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	filter, ok := h.bulkEventFilter(w, req)
+	if !ok {
+		return
+	}
+	count, err := provider.UpdateFilteredEventsStatus(filter, uuid.FromStringOrNil(user.ID), string(models.EventAcknowledged))
+	if err != nil {
+		_err := ErrBulkUpdateEvent(err)
+		h.log.Error(_err)
+		http.Error(w, _err.Error(), http.StatusInternalServerError)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(eventsCount{Count: count})
+}
+
 // swagger:route DELETE /api/events/bulk idGetEventStreamer
 // Handle DELETE request to delete events in bulk.
-// Bulk delete events associated with the ids.
+// Bulk delete events associated with the ids, or, when the body has none, the events matching the filters of the query,
+// the same as the ones of ```GET /api/v2/events```, eg: ```?status=acknowledged&until=2024-01-01T00:00:00Z```.
+// At least one id or one filter is required. Returns the number of events deleted.
 // responses:
 // 	200:
+// 	400:
 
 func (h *Handler) BulkDeleteEvent(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
-	defer func() {
-		_ = req.Body.Close()
-	}()
-
-	var reqBody statusIDs
-	body, err := io.ReadAll(req.Body)
-	if err != nil {
-		h.log.Error(ErrRequestBody(err))
-		http.Error(w, ErrRequestBody(err).Error(), http.StatusInternalServerError)
+	filter, ok := h.bulkEventFilter(w, req)
+	if !ok {
 		return
 	}
-
-	_ = json.Unmarshal(body, &reqBody)
-	err = provider.BulkDeleteEvent(reqBody.IDs)
+	count, err := provider.DeleteFilteredEvents(filter, uuid.FromStringOrNil(user.ID))
 	if err != nil {
 		_err := ErrBulkDeleteEvent(err)
 		h.log.Error(_err)
 		http.Error(w, _err.Error(), http.StatusInternalServerError)
 		return
 	}
+	_ = json.NewEncoder(w).Encode(eventsCount{Count: count})
 }
 
 // swagger:route DELETE /api/events/{id} idGetEventStreamer
@@ -218,22 +256,76 @@ func (h *Handler) BulkDeleteEvent(w http.ResponseWriter, req *http.Request, pref
 
 func (h *Handler) DeleteEvent(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
 	eventID := uuid.FromStringOrNil(mux.Vars(req)["id"])
-	err := provider.DeleteEvent(eventID)
+	err := provider.DeleteEvent(eventID, uuid.FromStringOrNil(user.ID))
 	if err != nil {
 		_err := ErrDeleteEvent(err, eventID.String())
 		h.log.Error(_err)
-		http.Error(w, _err.Error(), http.StatusInternalServerError)
+		http.Error(w, _err.Error(), eventErrorStatus(err))
 		return
 	}
 }
 
-func getEventFilter(req *http.Request) (*events.EventsFilter, error) {
+// bulkEventFilter returns the filter of the events a bulk operation applies to: the ones of the ids of the body, along
+// with the filters of the query. The error response is written and false is returned when the filter would select
+// every event of the user.
+func (h *Handler) bulkEventFilter(w http.ResponseWriter, req *http.Request) (*models.EventsFilter, bool) {
+	defer func() {
+		_ = req.Body.Close()
+	}()
+
+	var reqBody statusIDs
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(w, ErrRequestBody(err).Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	_ = json.Unmarshal(body, &reqBody)
+
+	filter, err := getEventFilter(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+	for _, id := range reqBody.IDs {
+		if id != nil {
+			filter.IDs = append(filter.IDs, *id)
+		}
+	}
+	filter.Search = req.URL.Query().Get("search")
+	filter.Status = events.EventStatus(req.URL.Query().Get("status"))
+	if !filter.Selective() {
+		http.Error(w, "the ids of the events or at least one filter are required", http.StatusBadRequest)
+		return nil, false
+	}
+	return filter, true
+}
+
+// eventErrorStatus returns the status code of the responses of the failures to update or to delete an event
+func eventErrorStatus(err error) int {
+	if err == gorm.ErrRecordNotFound {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+// publishEvent persists the event before publishing it to the user, so that the notification center lists it whether
+// the user is connected or not. The failures to persist it are logged.
+func (h *Handler) publishEvent(provider models.Provider, userID uuid.UUID, event *events.Event) {
+	if err := provider.PersistEvent(event); err != nil {
+		h.log.Error(err)
+	}
+	go h.config.EventBroadcaster.Publish(userID, event)
+}
+
+func getEventFilter(req *http.Request) (*models.EventsFilter, error) {
 	urlValues := req.URL.Query()
 	category := urlValues.Get("category")
 	action := urlValues.Get("action")
 	severity := urlValues.Get("severity")
+	actedUpon := urlValues.Get("acted_upon")
 
-	eventFilter := &events.EventsFilter{}
+	eventFilter := &models.EventsFilter{}
 	if category != "" {
 		err := json.Unmarshal([]byte(category), &eventFilter.Category)
 		if err != nil {
@@ -255,6 +347,23 @@ func getEventFilter(req *http.Request) (*events.EventsFilter, error) {
 		}
 	}
 
+	if actedUpon != "" {
+		err := json.Unmarshal([]byte(actedUpon), &eventFilter.ActedUpon)
+		if err != nil {
+			return eventFilter, models.ErrUnmarshal(err, "event acted upon filter")
+		}
+	}
+
+	for param, bound := range map[string]**time.Time{"since": &eventFilter.Since, "until": &eventFilter.Until} {
+		if value := urlValues.Get(param); value != "" {
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return eventFilter, models.ErrUnmarshal(err, fmt.Sprintf("event %s filter", param))
+			}
+			*bound = &t
+		}
+	}
+
 	return eventFilter, nil
 }
 
@@ -2,8 +2,10 @@
 package handlers
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gofrs/uuid"
 	"github.com/gorilla/mux"
@@ -15,7 +17,8 @@ import (
 // swagger:route GET /api/user/performance/profiles/{id}/results PerformanceAPI idGETProfileResults
 // Handle GET request for results of a profile
 //
-// Fetches pages of results from provider for the given id
+// Fetches pages of results from provider for the given id. The runner results of the results of profiles with SLOs
+// hold their evaluation in slo_report, see idGetPerfResultSLO.
 //
 // ```?order={field}``` orders on the passed field
 //
@@ -112,7 +115,8 @@ func (h *Handler) FetchAllResultsHandler(w http.ResponseWriter, req *http.Reques
 // swagger:route GET /api/perf/profile/result/{id} PerfAPI idGetSinglePerfResult
 // Handles GET requests for perf result
 //
-// Returns an individual result from provider
+// Returns an individual result from provider, as a performance spec in YAML, or as recorded by the load generator
+// when the request accepts ```application/json```
 //
 // responses:
 // 	200: perfSingleResultRespWrapper
@@ -145,6 +149,15 @@ func (h *Handler) GetResultHandler(w http.ResponseWriter, req *http.Request, _ *
 		http.Error(w, "error while getting load test results", http.StatusInternalServerError)
 		return
 	}
+	// the raw result keeps the histogram of the latencies, which the spec summarizes
+	if strings.Contains(req.Header.Get("Accept"), "application/json") {
+		w.Header().Set("content-type", "application/json")
+		if err := json.NewEncoder(w).Encode(bdr); err != nil {
+			logrus.Error(models.ErrMarshal(err, "test result"))
+			http.Error(w, "error while getting test result", http.StatusInternalServerError)
+		}
+		return
+	}
 	sp, err := bdr.ConvertToSpec()
 	if err != nil {
 		logrus.Error(ErrConvertToSpec(err))
@@ -162,6 +175,44 @@ func (h *Handler) GetResultHandler(w http.ResponseWriter, req *http.Request, _ *
 	_, _ = w.Write(b)
 }
 
+// swagger:route GET /api/perf/profile/result/{id}/slo PerfAPI idGetPerfResultSLO
+// Handles GET requests for the evaluation of the SLOs of a perf result
+//
+// Returns whether the result met the SLOs of its performance profile, along with the value of the metric of every SLO,
+// evaluated when the result was recorded, eg: {"passed": false, "slos": [{"metric": "p99", "threshold": 250,
+// "value": 312.4, "passed": false}]}. The CI pipelines gate on passed. Responds with 404 when the profile of the result
+// had no SLOs.
+// responses:
+//
+//	200: perfResultSLORespWrapper
+//	400:
+//	404:
+//	500:
+func (h *Handler) GetResultSLOHandler(w http.ResponseWriter, req *http.Request, _ *models.Preference, _ *models.User, p models.Provider) {
+	key := uuid.FromStringOrNil(mux.Vars(req)["id"])
+	if key == uuid.Nil {
+		http.Error(w, "please provide a valid result id", http.StatusBadRequest)
+		return
+	}
+	tokenString := req.Context().Value(models.TokenCtxKey).(string)
+
+	result, err := p.GetResult(tokenString, key)
+	if err != nil {
+		h.log.Error(ErrGetResult(err))
+		http.Error(w, ErrGetResult(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	report := result.SLOReport()
+	if report == nil {
+		http.Error(w, fmt.Sprintf("the profile of the result %s has no SLOs", key), http.StatusNotFound)
+		return
+	}
+	w.Header().Set("content-type", "application/json")
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		h.log.Error(models.ErrEncoding(err, "SLO report"))
+	}
+}
+
 // swagger:route GET /api/smi/results Smi idFetchSmiResults
 // Handle GET request for the results of all the smi conformance tests
 //
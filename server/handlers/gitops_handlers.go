@@ -0,0 +1,442 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/gitops"
+)
+
+// GitOpsLinkResponse is a link of designs to a Git repository and the path of the endpoint of its webhook
+type GitOpsLinkResponse struct {
+	models.GitOpsLink
+	WebhookPath string `json:"webhook_path"`
+}
+
+func gitOpsLinkResponse(link models.GitOpsLink) GitOpsLinkResponse {
+	return GitOpsLinkResponse{GitOpsLink: link, WebhookPath: fmt.Sprintf("/api/pattern/gitops/%s/webhook", link.ID)}
+}
+
+func gitOpsRemote(link *models.GitOpsLink) gitops.Remote {
+	return gitops.Remote{URL: link.RepoURL, Branch: link.Branch, Token: link.Token}
+}
+
+// swagger:route POST /api/pattern/gitops PatternsAPI idCreateGitOpsLink
+// Handle POST request for linking designs to a Git repository
+//
+// Links a design to a design file of a branch of a Git repository, or the designs of a directory of the branch to
+// a workspace of designs, and syncs them. Designs are pushed to the branch when saved if push_on_save is set, and
+// pulled from it when the webhook of the repository notifies a push, if webhook_secret is set.
+// responses:
+// 	201: gitOpsLinkResponseWrapper
+//	400:
+//	502:
+
+// CreateGitOpsLinkHandler links designs to a Git repository
+func (h *Handler) CreateGitOpsLinkHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	var req models.GitOpsLinkRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if req.RepoURL == "" || req.Branch == "" {
+		http.Error(rw, "repo_url and branch are required", http.StatusBadRequest)
+		return
+	}
+
+	link := &models.GitOpsLink{
+		UserID:        user.ID,
+		RepoURL:       req.RepoURL,
+		Branch:        req.Branch,
+		Path:          gitops.CleanPath(req.Path),
+		Token:         req.Token,
+		WebhookSecret: req.WebhookSecret,
+		PushOnSave:    req.PushOnSave,
+		Designs:       map[string]interface{}{},
+	}
+	direction := req.Sync
+	if req.PatternID != "" {
+		if !gitops.IsDesignFile(link.Path) {
+			http.Error(rw, "the path of a design must be a YAML file", http.StatusBadRequest)
+			return
+		}
+		link.Designs[link.Path] = req.PatternID
+		if direction == "" {
+			direction = models.GitOpsPush
+		}
+	} else if direction == "" {
+		direction = models.GitOpsPull
+	}
+	if direction != models.GitOpsPull && direction != models.GitOpsPush {
+		http.Error(rw, fmt.Sprintf("invalid sync direction %q, expected pull or push", direction), http.StatusBadRequest)
+		return
+	}
+
+	persister := &models.GitOpsLinkPersister{DB: h.dbHandler}
+	if err := persister.SaveGitOpsLink(link); err != nil {
+		h.log.Error(ErrGitOpsLink(err))
+		http.Error(rw, ErrGitOpsLink(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := h.syncGitOpsLink(r, provider, user, link, direction); err != nil {
+		// the link is only kept if the designs could be synced
+		_ = persister.DeleteGitOpsLink(link.ID)
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(rw).Encode(gitOpsLinkResponse(*link))
+}
+
+// swagger:route GET /api/pattern/gitops PatternsAPI idGetGitOpsLinks
+// Handle GET request for the links of designs to Git repositories
+//
+// Returns the links of the user, with the last commit synced
+// responses:
+// 	200: gitOpsLinksResponseWrapper
+
+// GetGitOpsLinksHandler returns the links of the user
+func (h *Handler) GetGitOpsLinksHandler(
+	rw http.ResponseWriter,
+	_ *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	links, err := (&models.GitOpsLinkPersister{DB: h.dbHandler}).GetGitOpsLinks(user.ID)
+	if err != nil {
+		h.log.Error(ErrGitOpsLink(err))
+		http.Error(rw, ErrGitOpsLink(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	response := make([]GitOpsLinkResponse, 0, len(links))
+	for _, link := range links {
+		response = append(response, gitOpsLinkResponse(link))
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(response)
+}
+
+// swagger:route DELETE /api/pattern/gitops/{id} PatternsAPI idDeleteGitOpsLink
+// Handle DELETE request for a link of designs to a Git repository
+//
+// Unlinks the designs from the repository, the designs and the repository are left as they are
+// responses:
+// 	200:
+//	404:
+
+// DeleteGitOpsLinkHandler deletes the link with the given id
+func (h *Handler) DeleteGitOpsLinkHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	link, ok := h.getUserGitOpsLink(rw, r, user)
+	if !ok {
+		return
+	}
+	if err := (&models.GitOpsLinkPersister{DB: h.dbHandler}).DeleteGitOpsLink(link.ID); err != nil {
+		h.log.Error(ErrGitOpsLink(err))
+		http.Error(rw, ErrGitOpsLink(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(gitOpsLinkResponse(*link))
+}
+
+// swagger:route POST /api/pattern/gitops/{id}/sync PatternsAPI idSyncGitOpsLink
+// Handle POST request for syncing the designs of a link with its Git repository
+//
+// Pulls the designs from the branch, or pushes them to it with ?direction=push
+// responses:
+// 	200: gitOpsLinkResponseWrapper
+//	400:
+//	404:
+//	502:
+
+// SyncGitOpsLinkHandler syncs the designs of the link with the given id
+func (h *Handler) SyncGitOpsLinkHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	direction := r.URL.Query().Get("direction")
+	if direction == "" {
+		direction = models.GitOpsPull
+	}
+	if direction != models.GitOpsPull && direction != models.GitOpsPush {
+		http.Error(rw, fmt.Sprintf("invalid sync direction %q, expected pull or push", direction), http.StatusBadRequest)
+		return
+	}
+	link, ok := h.getUserGitOpsLink(rw, r, user)
+	if !ok {
+		return
+	}
+	if err := h.syncGitOpsLink(r, provider, user, link, direction); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadGateway)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(gitOpsLinkResponse(*link))
+}
+
+// swagger:route POST /api/pattern/gitops/{id}/webhook PatternsAPI idGitOpsWebhook
+// Handle POST request for the webhook of the Git repository of a link
+//
+// Pulls the designs of the link when GitHub, GitLab or Gitea notify a push to its branch. The request must be signed
+// with the webhook secret of the link. Pulled designs are saved with the provider of the server, without the session
+// of the user who linked them.
+// responses:
+// 	200:
+//	401:
+//	404:
+//	502:
+
+// GitOpsWebhookHandler pulls the designs of the link with the given id when its branch changes
+func (h *Handler) GitOpsWebhookHandler(rw http.ResponseWriter, r *http.Request) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(rw, "invalid link id", http.StatusBadRequest)
+		return
+	}
+	persister := &models.GitOpsLinkPersister{DB: h.dbHandler}
+	link, err := persister.GetGitOpsLink(id)
+	if err != nil {
+		h.log.Error(ErrGitOpsLink(err))
+		http.Error(rw, ErrGitOpsLink(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if link == nil || link.WebhookSecret == "" {
+		http.Error(rw, fmt.Sprintf("link %s not found or has no webhook", id), http.StatusNotFound)
+		return
+	}
+
+	event, ok, err := gitops.ParseWebhook(r, link.WebhookSecret)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if !ok || event.Branch != link.Branch || event.SHA == link.LastCommitSHA {
+		rw.WriteHeader(http.StatusOK)
+		return
+	}
+
+	provider, ok := r.Context().Value(models.ProviderCtxKey).(models.Provider)
+	if !ok {
+		http.Error(rw, "no provider available to save the designs", http.StatusInternalServerError)
+		return
+	}
+	if err := h.pullGitOpsLink(r.Context(), provider, "", link); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadGateway)
+		return
+	}
+	go h.config.PatternChannel.Publish(uuid.FromStringOrNil(link.UserID), struct{}{})
+	rw.WriteHeader(http.StatusOK)
+}
+
+// getUserGitOpsLink returns the link of the id of the request if it belongs to the user, and writes the error otherwise
+func (h *Handler) getUserGitOpsLink(rw http.ResponseWriter, r *http.Request, user *models.User) (*models.GitOpsLink, bool) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(rw, "invalid link id", http.StatusBadRequest)
+		return nil, false
+	}
+	link, err := (&models.GitOpsLinkPersister{DB: h.dbHandler}).GetGitOpsLink(id)
+	if err != nil {
+		h.log.Error(ErrGitOpsLink(err))
+		http.Error(rw, ErrGitOpsLink(err).Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	if link == nil || link.UserID != user.ID {
+		http.Error(rw, fmt.Sprintf("link %s not found", id), http.StatusNotFound)
+		return nil, false
+	}
+	return link, true
+}
+
+func (h *Handler) syncGitOpsLink(r *http.Request, provider models.Provider, user *models.User, link *models.GitOpsLink, direction string) error {
+	if direction == models.GitOpsPush {
+		files, err := gitOpsFiles(r, provider, link)
+		if err != nil {
+			return err
+		}
+		return h.pushGitOpsLink(r.Context(), user, link, files)
+	}
+
+	token, err := provider.GetProviderToken(r)
+	if err != nil {
+		return ErrRetrieveUserToken(err)
+	}
+	if err := h.pullGitOpsLink(r.Context(), provider, token, link); err != nil {
+		return err
+	}
+	go h.config.PatternChannel.Publish(uuid.FromStringOrNil(user.ID), struct{}{})
+	return nil
+}
+
+// pullGitOpsLink saves the designs of the last commit of the branch of the link, linking the design files not linked yet
+// to new designs
+func (h *Handler) pullGitOpsLink(ctx context.Context, provider models.Provider, token string, link *models.GitOpsLink) error {
+	snapshot, err := gitops.Pull(ctx, gitOpsRemote(link), link.Path)
+	if err != nil {
+		return h.recordGitOpsSync(link, "", err)
+	}
+
+	designs := make(map[string]interface{}, len(snapshot.Files))
+	for p, content := range snapshot.Files {
+		name, err := models.GetPatternName(string(content))
+		if err != nil {
+			name = strings.TrimSuffix(path.Base(p), path.Ext(p))
+		}
+		resp, err := provider.SaveMesheryPattern(token, &models.MesheryPattern{
+			ID:          link.PatternID(p),
+			Name:        name,
+			PatternFile: string(content),
+			Location: map[string]interface{}{
+				"type":   "git",
+				"host":   link.RepoURL,
+				"path":   p,
+				"branch": link.Branch,
+			},
+		})
+		if err != nil {
+			return h.recordGitOpsSync(link, "", ErrSavePattern(err))
+		}
+		saved := []models.MesheryPattern{}
+		if err := json.Unmarshal(resp, &saved); err != nil || len(saved) == 0 || saved[0].ID == nil {
+			return h.recordGitOpsSync(link, "", ErrSavePattern(fmt.Errorf("the design of %s was not saved", p)))
+		}
+		designs[p] = saved[0].ID.String()
+	}
+	link.Designs = designs
+	return h.recordGitOpsSync(link, snapshot.SHA, nil)
+}
+
+// pushGitOpsLink pushes the design files to the branch of the link
+func (h *Handler) pushGitOpsLink(ctx context.Context, user *models.User, link *models.GitOpsLink, files map[string][]byte) error {
+	author := gitops.Author{Name: strings.TrimSpace(user.FirstName + " " + user.LastName), Email: user.Email}
+	if author.Name == "" {
+		author.Name = "Meshery"
+	}
+	sha, err := gitops.Push(ctx, gitOpsRemote(link), files, "Update designs from Meshery", author)
+	return h.recordGitOpsSync(link, sha, err)
+}
+
+// gitOpsPushPayload is the payload of the jobs pushing the designs saved by a user to the repository of a link
+type gitOpsPushPayload struct {
+	LinkID      uuid.UUID         `json:"link_id"`
+	Files       map[string][]byte `json:"files"`
+	AuthorName  string            `json:"author_name"`
+	AuthorEmail string            `json:"author_email"`
+}
+
+// runGitOpsPushJob pushes the design files of the payload of the job to the repository of its link
+func (h *Handler) runGitOpsPushJob(ctx context.Context, job *models.Job) (interface{}, error) {
+	var payload gitOpsPushPayload
+	if err := json.Unmarshal([]byte(job.Payload), &payload); err != nil {
+		return nil, models.ErrUnmarshal(err, "job payload")
+	}
+	link, err := (&models.GitOpsLinkPersister{DB: h.dbHandler}).GetGitOpsLink(payload.LinkID)
+	if err != nil {
+		return nil, ErrGitOpsLink(err)
+	}
+	user := &models.User{FirstName: payload.AuthorName, Email: payload.AuthorEmail}
+	if err := h.pushGitOpsLink(ctx, user, link, payload.Files); err != nil {
+		return nil, err
+	}
+	return map[string]string{"commit_sha": link.LastCommitSHA}, nil
+}
+
+// gitOpsFiles returns the current content of the design files of the link
+func gitOpsFiles(r *http.Request, provider models.Provider, link *models.GitOpsLink) (map[string][]byte, error) {
+	files := make(map[string][]byte, len(link.Designs))
+	for p := range link.Designs {
+		id := link.PatternID(p)
+		if id == nil {
+			continue
+		}
+		resp, err := provider.GetMesheryPattern(r, id.String())
+		if err != nil {
+			return nil, ErrGetPattern(err)
+		}
+		var pattern models.MesheryPattern
+		if err := json.Unmarshal(resp, &pattern); err != nil {
+			return nil, ErrDecodePattern(err)
+		}
+		files[p] = []byte(pattern.PatternFile)
+	}
+	return files, nil
+}
+
+// recordGitOpsSync stores the outcome of the sync of the link, and returns the error of the sync
+func (h *Handler) recordGitOpsSync(link *models.GitOpsLink, sha string, syncErr error) error {
+	now := time.Now()
+	link.LastSyncedAt = &now
+	link.LastSyncError = ""
+	if syncErr != nil {
+		link.LastSyncError = syncErr.Error()
+	} else {
+		link.LastCommitSHA = sha
+	}
+	if err := (&models.GitOpsLinkPersister{DB: h.dbHandler}).SaveGitOpsLink(link); err != nil {
+		h.log.Error(ErrGitOpsLink(err))
+	}
+	return syncErr
+}
+
+// pushSavedPattern pushes the links of the saved design which push it on save, in the background
+func (h *Handler) pushSavedPattern(r *http.Request, provider models.Provider, user *models.User, resp []byte) {
+	saved := []models.MesheryPattern{}
+	if err := json.Unmarshal(resp, &saved); err != nil {
+		return
+	}
+	persister := &models.GitOpsLinkPersister{DB: h.dbHandler}
+	for _, pattern := range saved {
+		if pattern.ID == nil {
+			continue
+		}
+		links, err := persister.GetGitOpsLinksOfPattern(user.ID, *pattern.ID)
+		if err != nil {
+			h.log.Error(ErrGitOpsLink(err))
+			return
+		}
+		for i := range links {
+			link := &links[i]
+			// the files are read while the request is being served, as the provider needs its session
+			files, err := gitOpsFiles(r, provider, link)
+			if err != nil {
+				h.log.Error(h.recordGitOpsSync(link, "", err))
+				continue
+			}
+			payload := gitOpsPushPayload{LinkID: link.ID, Files: files, AuthorName: strings.TrimSpace(user.FirstName + " " + user.LastName), AuthorEmail: user.Email}
+			if _, err := h.config.JobRunner.Enqueue(models.JobTypeGitOpsPush, user.ID, payload, models.JobOptions{}); err != nil {
+				h.log.Error(h.recordGitOpsSync(link, "", err))
+			}
+		}
+	}
+}
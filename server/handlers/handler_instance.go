@@ -68,5 +68,10 @@ func NewHandlerInstance(
 		Handler: h.CollectStaticMetrics,
 	})
 
+	if handlerConfig.JobRunner != nil {
+		handlerConfig.JobRunner.Register(models.JobTypePatternDrift, h.runPatternDriftJob)
+		handlerConfig.JobRunner.Register(models.JobTypeGitOpsPush, h.runGitOpsPushJob)
+	}
+
 	return h
 }
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/layer5io/meshery/server/internal/health"
+	"github.com/layer5io/meshery/server/models"
+)
+
+// the time each subsystem is given to respond to the readiness checks
+const readinessCheckTimeout = 5 * time.Second
+
+// the client the reachability of the remote providers is checked with
+var healthCheckClient = &http.Client{Timeout: readinessCheckTimeout}
+
+// swagger:route GET /healthz SystemAPI idGetHealthz
+// Handle GET request for the liveness of Meshery Server
+//
+// Responds as long as Meshery Server serves requests, without checking its subsystems
+// responses:
+//
+//	200: healthReportRespWrapper
+func (h *Handler) HealthzHandler(w http.ResponseWriter, _ *http.Request) {
+	writeHealthReport(w, health.Report{Status: health.Pass, Checks: []health.Check{}})
+}
+
+// swagger:route GET /readyz SystemAPI idGetReadyz
+// Handle GET request for the readiness of Meshery Server
+//
+// Checks the database, the reachability of the remote providers, the connection to Meshery Broker and the
+// reachability of the registered Kubernetes contexts. Responds with 503 when a subsystem Meshery Server cannot serve
+// requests without fails, degraded subsystems are reported with the warn status.
+// responses:
+//
+//	200: healthReportRespWrapper
+//	503: healthReportRespWrapper
+func (h *Handler) ReadyzHandler(w http.ResponseWriter, r *http.Request) {
+	report := health.Run(r.Context(), readinessCheckTimeout,
+		health.Database(h.dbHandler),
+		health.Providers(h.config.Providers, h.Provider, healthCheckClient),
+		health.Broker(h.MesheryCtrlsHelper),
+		health.Kubernetes(h.registeredK8sContexts),
+	)
+	writeHealthReport(w, report)
+}
+
+// registeredK8sContexts returns the Kubernetes contexts registered with this instance of Meshery Server
+func (h *Handler) registeredK8sContexts(ctx context.Context) ([]models.K8sContext, error) {
+	contexts := []models.K8sContext{}
+	if err := h.dbHandler.WithContext(ctx).Where("meshery_instance_id = ?", h.SystemID).Find(&contexts).Error; err != nil {
+		return nil, err
+	}
+	return contexts, nil
+}
+
+func writeHealthReport(w http.ResponseWriter, report health.Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if report.Status == health.Fail {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(report)
+}
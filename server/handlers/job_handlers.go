@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	"gorm.io/gorm"
+)
+
+// swagger:route GET /api/system/jobs SystemAPI idGetJobs
+// Handle GET request for the background jobs
+//
+// Returns the background jobs of the user and the ones of Meshery Server itself, eg: the checks of the drift of the
+// deployed designs, most recent first. The jobs can be filtered with the type and status (queued, running, succeeded,
+// failed or cancelled) query parameters, and paginated with the page and pagesize query parameters.
+// responses:
+//
+//	200: jobsRespWrapper
+//	500:
+func (h *Handler) GetJobsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	page, offset, limit, _, _, _, status := getPaginationParams(r)
+	persister := &models.JobPersister{DB: h.dbHandler}
+	jobs, count, err := persister.GetJobs(models.JobFilter{
+		UserIDs: []string{user.ID, ""},
+		Type:    r.URL.Query().Get("type"),
+		Status:  status,
+		Offset:  offset,
+		Limit:   limit,
+	})
+	if err != nil {
+		h.log.Error(ErrJob(err))
+		http.Error(rw, ErrJob(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(models.JobsPage{
+		Page:       page,
+		PageSize:   limit,
+		TotalCount: count,
+		Jobs:       jobs,
+	}); err != nil {
+		h.log.Error(models.ErrEncoding(err, "jobs"))
+		http.Error(rw, models.ErrEncoding(err, "jobs").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route GET /api/system/jobs/{id} SystemAPI idGetJob
+// Handle GET request for the status of a background job
+//
+// Returns the job with the given ID: its status, number of attempts, error of the last failed attempt and result once it succeeded.
+// responses:
+//
+//	200: jobRespWrapper
+//	404:
+//	500:
+func (h *Handler) GetJobHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	job, ok := h.userJob(rw, r, user)
+	if !ok {
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(job); err != nil {
+		h.log.Error(models.ErrEncoding(err, "job"))
+		http.Error(rw, models.ErrEncoding(err, "job").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route DELETE /api/system/jobs/{id} SystemAPI idCancelJob
+// Handle DELETE request to cancel a background job
+//
+// Cancels the job with the given ID if it is queued or running. A running job is stopped and is not attempted again.
+// responses:
+//
+//	200: jobRespWrapper
+//	404:
+//	409:
+//	500:
+func (h *Handler) CancelJobHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	job, ok := h.userJob(rw, r, user)
+	if !ok {
+		return
+	}
+	cancelled, err := h.config.JobRunner.Cancel(job.ID)
+	if err != nil {
+		h.log.Error(ErrJob(err))
+		http.Error(rw, ErrJob(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if !cancelled {
+		http.Error(rw, "the job is already "+job.Status, http.StatusConflict)
+		return
+	}
+
+	job, err = (&models.JobPersister{DB: h.dbHandler}).GetJob(job.ID)
+	if err != nil {
+		h.log.Error(ErrJob(err))
+		http.Error(rw, ErrJob(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(job); err != nil {
+		h.log.Error(models.ErrEncoding(err, "job"))
+		http.Error(rw, models.ErrEncoding(err, "job").Error(), http.StatusInternalServerError)
+	}
+}
+
+// userJob returns the job of the id path variable if it is a job of the user or of Meshery Server,
+// or writes the error response and returns false
+func (h *Handler) userJob(rw http.ResponseWriter, r *http.Request, user *models.User) (*models.Job, bool) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(rw, "invalid job id", http.StatusNotFound)
+		return nil, false
+	}
+	job, err := (&models.JobPersister{DB: h.dbHandler}).GetJob(id)
+	if err == gorm.ErrRecordNotFound || (err == nil && job.UserID != "" && job.UserID != user.ID) {
+		http.Error(rw, "job not found", http.StatusNotFound)
+		return nil, false
+	}
+	if err != nil {
+		h.log.Error(ErrJob(err))
+		http.Error(rw, ErrJob(err).Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	return job, true
+}
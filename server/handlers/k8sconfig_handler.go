@@ -115,13 +115,14 @@ func (h *Handler) addK8SConfig(user *models.User, _ *models.Preference, w http.R
 		}
 
 		event := eventBuilder.Build()
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 
 	}
 	if len(saveK8sContextResponse.InsertedContexts) > 0 || len(saveK8sContextResponse.UpdatedContexts) > 0 {
 		h.config.K8scontextChannel.PublishContext()
 	}
+	// the clusters of the uploaded contexts are labeled in the background
+	go h.discoverClusters(context.Background(), contexts)
 	if err := json.NewEncoder(w).Encode(saveK8sContextResponse); err != nil {
 		logrus.Error(models.ErrMarshal(err, "kubeconfig"))
 		http.Error(w, models.ErrMarshal(err, "kubeconfig").Error(), http.StatusInternalServerError)
@@ -311,6 +312,7 @@ func (h *Handler) LoadContextsAndPersist(userID string, token string, prov model
 		h.config.K8scontextChannel.PublishContext()
 		contexts = append(contexts, ctx)
 	}
+	go h.discoverClusters(context.Background(), contexts)
 	return contexts, nil
 }
 
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/pattern/patterns/k8s"
+	"github.com/layer5io/meshkit/models/events"
+	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
+)
+
+// kubernetesEventsCursor is the position of the reading of the Kubernetes events of a context
+type kubernetesEventsCursor struct {
+	// since is the second the events were last read at, the events seen since it are read again
+	since time.Time
+	// counts are the counts of the events read since that second, for the ones seen again to be skipped
+	counts map[string]int32
+}
+
+// RunKubernetesEventsCorrelation reads at every interval the warning events of the Kubernetes contexts the saved designs are
+// deployed to, until the context is done. The events of the resources of the components of the designs, and of the objects
+// they control, eg: the scheduling and image pull failures of the pods of their deployments, are published as events of
+// the deployed designs, which can be read with the events API.
+func (h *Handler) RunKubernetesEventsCorrelation(ctx context.Context, interval time.Duration) {
+	cursors := map[string]*kubernetesEventsCursor{}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		h.correlateKubernetesEvents(ctx, cursors, interval)
+	}
+}
+
+// correlateKubernetesEvents publishes the events of the deployed designs seen since the cursors of their contexts
+func (h *Handler) correlateKubernetesEvents(ctx context.Context, cursors map[string]*kubernetesEventsCursor, interval time.Duration) {
+	deployed, err := (&models.DeployedPatternPersister{DB: h.dbHandler}).GetAllDeployedPatterns()
+	if err != nil {
+		h.log.Error(ErrCorrelateKubernetesEvents(err))
+		return
+	}
+	// the resources of the designs deployed without being saved are not labeled with their id
+	byContext := map[string][]models.DeployedPattern{}
+	for _, dp := range deployed {
+		if dp.PatternID != "" {
+			byContext[dp.ContextID] = append(byContext[dp.ContextID], dp)
+		}
+	}
+	for contextID := range cursors {
+		if _, ok := byContext[contextID]; !ok {
+			delete(cursors, contextID)
+		}
+	}
+
+	for contextID, designs := range byContext {
+		if ctx.Err() != nil {
+			return
+		}
+		cursor, ok := cursors[contextID]
+		if !ok {
+			// the events seen before Meshery Server started, or before the first design was deployed to the context, are not read
+			cursor = &kubernetesEventsCursor{since: time.Now().Add(-interval).Truncate(time.Second)}
+			cursors[contextID] = cursor
+		}
+		read := time.Now().Truncate(time.Second)
+		client, err := h.deployedPatternKubeClient(&designs[0])
+		if err != nil {
+			// the context is disconnected, its events are read once it is connected again
+			h.log.Debug(ErrCorrelateKubernetesEvents(err))
+			continue
+		}
+		componentEvents, err := k8s.DesignEvents(ctx, client, cursor.since)
+		if err != nil {
+			h.log.Error(ErrCorrelateKubernetesEvents(err))
+			continue
+		}
+
+		counts := map[string]int32{}
+		for _, ev := range componentEvents {
+			if !ev.LastSeen.Before(read) {
+				counts[ev.UID] = ev.Count
+			}
+			if count, ok := cursor.counts[ev.UID]; ok && count >= ev.Count {
+				continue
+			}
+			for i := range designs {
+				if designs[i].PatternID == ev.PatternID {
+					h.publishKubernetesEvent(&designs[i], ev)
+				}
+			}
+		}
+		cursor.since = read
+		cursor.counts = counts
+	}
+}
+
+// deployedPatternKubeClient returns the client of the Kubernetes context of the deployed design, with the credentials of its user
+func (h *Handler) deployedPatternKubeClient(deployed *models.DeployedPattern) (*meshkube.Client, error) {
+	provider, ok := h.config.Providers[deployed.ProviderName]
+	if !ok {
+		return nil, fmt.Errorf("provider %s is not available", deployed.ProviderName)
+	}
+	k8sctx, err := provider.GetK8sContext(deployed.Token, deployed.ContextID)
+	if err != nil {
+		return nil, err
+	}
+	return k8sctx.GenerateKubeHandler()
+}
+
+// publishKubernetesEvent persists and publishes the Kubernetes event of a component as an event of the deployed design
+func (h *Handler) publishKubernetesEvent(deployed *models.DeployedPattern, ev k8s.ComponentEvent) {
+	userID := uuid.FromStringOrNil(deployed.UserID)
+	event := events.NewEvent().ActedUpon(deployed.ID).FromUser(userID).FromSystem(*h.SystemID).WithCategory("pattern").WithAction("kubernetes_event").
+		WithSeverity(events.Warning).
+		WithDescription(fmt.Sprintf("%s of %s %s/%s of component '%s' of design '%s': %s", ev.Reason, ev.Kind, ev.Namespace, ev.Name, ev.Component, deployed.Name, ev.Message)).
+		WithMetadata(map[string]interface{}{
+			"deployedPatternID": deployed.ID,
+			"contextID":         deployed.ContextID,
+			"event":             ev,
+		}).Build()
+	if provider, ok := h.config.Providers[deployed.ProviderName]; ok {
+		_ = provider.PersistEvent(event)
+	}
+	go h.config.EventBroadcaster.Publish(userID, event)
+}
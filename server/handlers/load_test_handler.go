@@ -22,6 +22,7 @@ import (
 	"github.com/layer5io/meshery/server/helpers"
 	"github.com/layer5io/meshery/server/helpers/utils"
 	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshkit/models/events"
 	SMP "github.com/layer5io/service-mesh-performance/spec"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -213,6 +214,13 @@ func (h *Handler) LoadTestHandler(w http.ResponseWriter, req *http.Request, pref
 		return
 	}
 
+	loadTestOptions.SLOs, err = performanceProfile.GetSLOs()
+	if err != nil {
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	options, ok := performanceProfile.Metadata["additional_options"].(string)
 
 	if ok {
@@ -443,6 +451,9 @@ func (h *Handler) executeLoadTest(ctx context.Context, req *http.Request, profil
 	}
 
 	resultsMap["load-generator"] = loadTestOptions.LoadGenerator
+	if len(loadTestOptions.SLOs) > 0 {
+		resultsMap[models.PerformanceSLOReportKey] = models.EvaluatePerformanceSLOs(loadTestOptions.SLOs, resultsMap)
+	}
 
 	mk8sContexts, ok := req.Context().Value(models.KubeClustersKey).([]models.K8sContext)
 	if !ok {
@@ -591,9 +602,18 @@ func (h *Handler) persistPerformanceTestResult(ctx context.Context, req *http.Re
 		key, _ = uuid.NewV4()
 	}
 	result.ID = key
+	message := ""
+	if report := result.SLOReport(); report != nil {
+		message = "The result met the SLOs of the profile"
+		if !report.Passed {
+			message = "The result violated the SLOs of the profile"
+			h.publishSLOViolations(req, provider, result, profileID, report)
+		}
+	}
 	respChan <- &models.LoadTestResponse{
-		Status: models.LoadTestSuccess,
-		Result: result,
+		Status:  models.LoadTestSuccess,
+		Message: message,
+		Result:  result,
 	}
 
 	if h.config.PerformanceChannel != nil {
@@ -605,6 +625,30 @@ func (h *Handler) persistPerformanceTestResult(ctx context.Context, req *http.Re
 	}
 }
 
+// publishSLOViolations publishes an event listing the SLOs of the performance profile the result violated
+func (h *Handler) publishSLOViolations(req *http.Request, provider models.Provider, result *models.MesheryResult, profileID string, report *models.PerformanceSLOReport) {
+	user, _ := req.Context().Value(models.UserCtxKey).(*models.User)
+	if user == nil {
+		return
+	}
+	userID := uuid.FromStringOrNil(user.ID)
+	actedUpon := uuid.FromStringOrNil(profileID)
+	if actedUpon == uuid.Nil {
+		actedUpon = result.ID
+	}
+	violations := []string{}
+	for _, slo := range report.Violations() {
+		violations = append(violations, slo.String())
+	}
+	event := events.NewEvent().ActedUpon(actedUpon).FromUser(userID).FromSystem(*h.SystemID).WithCategory("performance").WithAction("slo_violation").
+		WithSeverity(events.Warning).WithDescription(fmt.Sprintf("Performance test '%s' violated %s", result.Name, strings.Join(violations, ", "))).
+		WithMetadata(map[string]interface{}{
+			"result_id":  result.ID,
+			"violations": report.Violations(),
+		}).Build()
+	h.publishEvent(provider, userID, event)
+}
+
 // CollectStaticMetrics is used for collecting static metrics from prometheus and submitting it to Remote Provider
 func (h *Handler) CollectStaticMetrics(config *models.SubmitMetricsConfig) error {
 	h.log.Debug("initiating collecting prometheus static board metrics for test id: ", config.TestUUID)
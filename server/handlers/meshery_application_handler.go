@@ -158,8 +158,7 @@ func (h *Handler) handleApplicationPOST(
 			"error": ErrRetrieveUserToken(err),
 		}).WithDescription("No auth token provided in the request.").Build()
 
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 		http.Error(rw, ErrRetrieveUserToken(err).Error(), http.StatusInternalServerError)
 		addMeshkitErr(&res, ErrRetrieveData(err))
 		go h.EventsBuffer.Publish(&res)
@@ -198,8 +197,7 @@ func (h *Handler) handleApplicationPOST(
 						"error": conversionErr,
 					}).WithDescription(fmt.Sprintf("Failed converting Docker Compose application %s", mesheryApplication.Name)).Build()
 
-					_ = provider.PersistEvent(event)
-					go h.config.EventBroadcaster.Publish(userID, event)
+					h.publishEvent(provider, userID, event)
 
 					http.Error(rw, conversionErr.Error(), http.StatusInternalServerError)
 					addMeshkitErr(&res, ErrApplicationFailure(err, obj))
@@ -226,8 +224,7 @@ func (h *Handler) handleApplicationPOST(
 				event := eventBuilder.WithSeverity(events.Error).WithMetadata(map[string]interface{}{
 					"error": conversionErr,
 				}).WithDescription(fmt.Sprintf("Failed converting Docker Compose application %s to design file format.", mesheryApplication.Name)).Build()
-				_ = provider.PersistEvent(event)
-				go h.config.EventBroadcaster.Publish(userID, event)
+				h.publishEvent(provider, userID, event)
 
 				http.Error(rw, conversionErr.Error(), http.StatusInternalServerError)
 				addMeshkitErr(&res, err) //this error is already a meshkit error so no further wrapping required
@@ -243,8 +240,7 @@ func (h *Handler) handleApplicationPOST(
 				event := eventBuilder.WithSeverity(events.Error).WithMetadata(map[string]interface{}{
 					"error": conversionErr,
 				}).WithDescription(fmt.Sprintf("Failed converting Docker Compose application %s to design file format.", mesheryApplication.Name)).Build()
-				_ = provider.PersistEvent(event)
-				go h.config.EventBroadcaster.Publish(userID, event)
+				h.publishEvent(provider, userID, event)
 
 				http.Error(rw, conversionErr.Error(), http.StatusInternalServerError)
 				addMeshkitErr(&res, ErrApplicationFailure(err, obj))
@@ -260,8 +256,7 @@ func (h *Handler) handleApplicationPOST(
 			event := eventBuilder.WithSeverity(events.Error).WithMetadata(map[string]interface{}{
 				"error": sourceTypeErr,
 			}).WithDescription(fmt.Sprintf("Source Type \"%s\" not supported.", sourcetype)).Build()
-			_ = provider.PersistEvent(event)
-			go h.config.EventBroadcaster.Publish(userID, event)
+			h.publishEvent(provider, userID, event)
 
 			http.Error(rw, sourceTypeErr.Error(), http.StatusInternalServerError)
 			addMeshkitErr(&res, ErrApplicationFailure(err, obj))
@@ -299,8 +294,7 @@ func (h *Handler) handleApplicationPOST(
 					"error": ErrSaveApplication(err),
 				}).WithDescription(fmt.Sprintf("error reading application from the remote URL %s, URL is malformed or not reachable.", parsedBody.URL)).Build()
 
-				_ = provider.PersistEvent(event)
-				go h.config.EventBroadcaster.Publish(userID, event)
+				h.publishEvent(provider, userID, event)
 				addMeshkitErr(&res, ErrSaveApplication(fmt.Errorf("error reading body")))
 				go h.EventsBuffer.Publish(&res)
 				return
@@ -317,8 +311,7 @@ func (h *Handler) handleApplicationPOST(
 					"error": importErr,
 				}).WithDescription(fmt.Sprintf("error converting helm chart %s to Kubernetes manifest, URL might be malformed or not reachable.", parsedBody.URL)).Build()
 
-				_ = provider.PersistEvent(event)
-				go h.config.EventBroadcaster.Publish(userID, event)
+				h.publishEvent(provider, userID, event)
 
 				http.Error(rw, importErr.Error(), http.StatusInternalServerError)
 				addMeshkitErr(&res, ErrApplicationFailure(err, obj))
@@ -336,8 +329,7 @@ func (h *Handler) handleApplicationPOST(
 					"error": convertErr,
 				}).WithDescription(fmt.Sprintf("Failed converting Helm Chart %s to design file format", parsedBody.URL)).Build()
 
-				_ = provider.PersistEvent(event)
-				go h.config.EventBroadcaster.Publish(userID, event)
+				h.publishEvent(provider, userID, event)
 				addMeshkitErr(&res, err)
 				go h.EventsBuffer.Publish(&res)
 				return
@@ -354,8 +346,7 @@ func (h *Handler) handleApplicationPOST(
 					"error": convertErr,
 				}).WithDescription(fmt.Sprintf("Failed converting Helm Chart %s to design file format", parsedBody.URL)).Build()
 
-				_ = provider.PersistEvent(event)
-				go h.config.EventBroadcaster.Publish(userID, event)
+				h.publishEvent(provider, userID, event)
 				addMeshkitErr(&res, ErrApplicationFailure(err, obj))
 				go h.EventsBuffer.Publish(&res)
 				return
@@ -387,8 +378,7 @@ func (h *Handler) handleApplicationPOST(
 				}).WithDescription(fmt.Sprintf("Invalid URL provided %s", parsedBody.URL)).Build()
 				addMeshkitErr(&res, ErrSaveApplication(fmt.Errorf("error parsing URL")))
 				go h.EventsBuffer.Publish(&res)
-				_ = provider.PersistEvent(event)
-				go h.config.EventBroadcaster.Publish(userID, event)
+				h.publishEvent(provider, userID, event)
 				return
 			}
 
@@ -422,8 +412,7 @@ func (h *Handler) handleApplicationPOST(
 						"error": err,
 					}).WithDescription(fmt.Sprintf("Failed to retrieve remote application at %s", parsedBody.URL)).Build()
 
-					_ = provider.PersistEvent(event)
-					go h.config.EventBroadcaster.Publish(userID, event)
+					h.publishEvent(provider, userID, event)
 					addMeshkitErr(&res, err) //error guaranteed to be meshkit error
 					go h.EventsBuffer.Publish(&res)
 					return
@@ -440,8 +429,7 @@ func (h *Handler) handleApplicationPOST(
 					event := eventBuilder.WithSeverity(events.Error).WithMetadata(map[string]interface{}{
 						"error": err,
 					}).WithDescription(fmt.Sprintf("Failed to retrieve remote application at %s", parsedBody.URL)).Build()
-					_ = provider.PersistEvent(event)
-					go h.config.EventBroadcaster.Publish(userID, event)
+					h.publishEvent(provider, userID, event)
 					addMeshkitErr(&res, err) //error guaranteed to be meshkit error
 					go h.EventsBuffer.Publish(&res)
 					return
@@ -456,8 +444,7 @@ func (h *Handler) handleApplicationPOST(
 			event := eventBuilder.WithSeverity(events.Error).WithMetadata(map[string]interface{}{
 				"error": sourceTypeErr,
 			}).WithDescription(fmt.Sprintf("Source Type \"%s\" not supported.", sourcetype)).Build()
-			_ = provider.PersistEvent(event)
-			go h.config.EventBroadcaster.Publish(userID, event)
+			h.publishEvent(provider, userID, event)
 
 			http.Error(rw, sourceTypeErr.Error(), http.StatusInternalServerError)
 			addMeshkitErr(&res, ErrApplicationFailure(fmt.Errorf("error parsing URL"), obj))
@@ -481,8 +468,7 @@ func (h *Handler) handleApplicationPOST(
 				"error": saveErr,
 			}).WithDescription(fmt.Sprintf("Failed persisting application %s", parsedBody.Name)).Build()
 
-			_ = provider.PersistEvent(event)
-			go h.config.EventBroadcaster.Publish(userID, event)
+			h.publishEvent(provider, userID, event)
 			addMeshkitErr(&res, ErrApplicationFailure(err, obj))
 			go h.EventsBuffer.Publish(&res)
 			return
@@ -517,8 +503,7 @@ func (h *Handler) handleApplicationPOST(
 				"error": uploadSourceContentErr,
 			}).WithDescription("Failed uploading original application content to remote provider.").Build()
 
-			_ = provider.PersistEvent(event)
-			go h.config.EventBroadcaster.Publish(userID, event)
+			h.publishEvent(provider, userID, event)
 			addMeshkitErr(&res, ErrApplicationSourceContent(err, obj))
 			go h.EventsBuffer.Publish(&res)
 			return
@@ -540,8 +525,7 @@ func (h *Handler) handleApplicationPOST(
 	h.formatApplicationOutput(rw, byt, format, &res, eventBuilder)
 
 	event := eventBuilder.Build()
-	_ = provider.PersistEvent(event)
-	go h.config.EventBroadcaster.Publish(userID, event)
+	h.publishEvent(provider, userID, event)
 }
 
 func (h *Handler) handleApplicationUpdate(rw http.ResponseWriter,
@@ -571,8 +555,7 @@ func (h *Handler) handleApplicationUpdate(rw http.ResponseWriter,
 			"error": ErrSaveApplication(fmt.Errorf("missing route variable \"source-type\" (one of %s, %s, %s)", models.K8sManifest, models.DockerCompose, models.HelmChart)),
 		}).WithDescription("Please provide application source-type").Build()
 
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 		go h.EventsBuffer.Publish(&res)
 		return
 	}
@@ -596,8 +579,7 @@ func (h *Handler) handleApplicationUpdate(rw http.ResponseWriter,
 			"error": ErrRetrieveUserToken(err),
 		}).WithDescription("No auth token provided in the request.").Build()
 
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 		http.Error(rw, ErrRetrieveUserToken(err).Error(), http.StatusInternalServerError)
 		return
 	}
@@ -614,8 +596,7 @@ func (h *Handler) handleApplicationUpdate(rw http.ResponseWriter,
 				"error": errAppSave,
 			}).WithDescription(fmt.Sprintf("Error saving application %s", parsedBody.ApplicationData.Name)).Build()
 
-			_ = provider.PersistEvent(event)
-			go h.config.EventBroadcaster.Publish(userID, event)
+			h.publishEvent(provider, userID, event)
 			addMeshkitErr(&res, ErrSavePattern(err))
 			go h.EventsBuffer.Publish(&res)
 			return
@@ -668,8 +649,7 @@ func (h *Handler) handleApplicationUpdate(rw http.ResponseWriter,
 					"error": errAppSave,
 				}).WithDescription(fmt.Sprintf("Error saving application %s", parsedBody.ApplicationData.Name)).Build()
 
-				_ = provider.PersistEvent(event)
-				go h.config.EventBroadcaster.Publish(userID, event)
+				h.publishEvent(provider, userID, event)
 				addMeshkitErr(&res, ErrSavePattern(err))
 				go h.EventsBuffer.Publish(&res)
 				return
@@ -714,8 +694,7 @@ func (h *Handler) handleApplicationUpdate(rw http.ResponseWriter,
 			"error": errAppSave,
 		}).WithDescription(fmt.Sprintf("Error saving application %s", parsedBody.ApplicationData.Name)).Build()
 
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 		addMeshkitErr(&res, ErrApplicationFailure(err, obj))
 		go h.EventsBuffer.Publish(&res)
 		return
@@ -725,8 +704,7 @@ func (h *Handler) handleApplicationUpdate(rw http.ResponseWriter,
 	eventBuilder.WithSeverity(events.Informational)
 	h.formatApplicationOutput(rw, resp, format, &res, eventBuilder)
 	event := eventBuilder.Build()
-	_ = provider.PersistEvent(event)
-	go h.config.EventBroadcaster.Publish(userID, event)
+	h.publishEvent(provider, userID, event)
 
 }
 
@@ -769,8 +747,7 @@ func (h *Handler) GetMesheryApplicationsHandler(
 			"error": errAppFetch,
 		}).WithDescription("Error fetching applications").Build()
 		http.Error(rw, errAppFetch.Error(), http.StatusInternalServerError)
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 		return
 	}
 
@@ -808,14 +785,12 @@ func (h *Handler) DeleteMesheryApplicationHandler(
 			"error": errAppDelete,
 		}).WithDescription("Error deleting application.").Build()
 		http.Error(rw, errAppDelete.Error(), http.StatusInternalServerError)
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 		return
 	}
 
 	event := eventBuilder.WithSeverity(events.Informational).WithDescription(fmt.Sprintf("Application %s deleted.", mesheryApplication.Name)).Build()
-	_ = provider.PersistEvent(event)
-	go h.config.EventBroadcaster.Publish(userID, event)
+	h.publishEvent(provider, userID, event)
 
 	go h.config.ApplicationChannel.Publish(userID, struct{}{})
 	rw.Header().Set("Content-Type", "application/json")
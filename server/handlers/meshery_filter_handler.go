@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -109,8 +110,7 @@ func (h *Handler) handleFilterPOST(
 			"error": invalidReqBody,
 		}).WithDescription(fmt.Sprintf("Filter %s is corrupted.", parsedBody.FilterData.Name)).Build()
 
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 
 		http.Error(rw, ErrSaveFilter(err).Error(), http.StatusBadRequest)
 		addMeshkitErr(&res, ErrGetFilter(err))
@@ -130,8 +130,7 @@ func (h *Handler) handleFilterPOST(
 			"error": ErrRetrieveUserToken(err),
 		}).WithDescription("No auth token provided in the request.").Build()
 
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 		http.Error(rw, ErrRetrieveUserToken(err).Error(), http.StatusInternalServerError)
 		addMeshkitErr(&res, ErrRetrieveUserToken(err))
 		go h.EventsBuffer.Publish(&res)
@@ -140,7 +139,7 @@ func (h *Handler) handleFilterPOST(
 
 	format := r.URL.Query().Get("output")
 
-	filterResource, err := h.generateFilterComponent(parsedBody.Config)
+	filterResource, err := h.generateFilterComponent(r.Context(), parsedBody.Config)
 	if err != nil {
 		h.log.Error(ErrEncodeFilter(err))
 		http.Error(rw, ErrEncodeFilter(err).Error(), http.StatusInternalServerError)
@@ -185,8 +184,7 @@ func (h *Handler) handleFilterPOST(
 					"error": errFilterSave,
 				}).WithDescription(fmt.Sprintf("Failed persisting filter %s", parsedBody.FilterData.Name)).Build()
 
-				_ = provider.PersistEvent(event)
-				go h.config.EventBroadcaster.Publish(userID, event)
+				h.publishEvent(provider, userID, event)
 				addMeshkitErr(&res, ErrSaveFilter(err))
 				go h.EventsBuffer.Publish(&res)
 				return
@@ -531,8 +529,8 @@ func (h *Handler) FilterFileHandler(
 	h.PatternFileHandler(rw, r, prefObj, user, provider)
 }
 
-func (h *Handler) generateFilterComponent(config string) (string, error) {
-	res, _, _ := h.registryManager.GetEntities(&v1alpha1.ComponentFilter{
+func (h *Handler) generateFilterComponent(ctx context.Context, config string) (string, error) {
+	res, _, _ := h.getRegistryEntities(ctx, &v1alpha1.ComponentFilter{
 		Name:       "WASMFilter",
 		Trim:       false,
 		APIVersion: "core.meshery.io/v1alpha1",
@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/gofrs/uuid"
@@ -13,8 +15,12 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/layer5io/meshery/server/models/pattern/compose"
 	pCore "github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshery/server/models/pattern/export"
 	"github.com/layer5io/meshery/server/models/pattern/stages"
+	"github.com/layer5io/meshery/server/models/pattern/terraform"
+	meshmodel "github.com/layer5io/meshkit/models/meshmodel/registry"
 	"github.com/sirupsen/logrus"
 )
 
@@ -85,8 +91,7 @@ func (h *Handler) handlePatternPOST(
 			"error": ErrRequestBody(err),
 		}).WithDescription("Unable to parse uploaded pattern.").Build()
 
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 		return
 	}
 
@@ -105,8 +110,7 @@ func (h *Handler) handlePatternPOST(
 			"error": ErrRetrieveUserToken(err),
 		}).WithDescription("No auth token provided in the request.").Build()
 
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 
 		return
 	}
@@ -122,8 +126,7 @@ func (h *Handler) handlePatternPOST(
 				"error": ErrSavePattern(err),
 			}).WithDescription("Pattern save failed, cytoJSON could be malformed.").Build()
 
-			_ = provider.PersistEvent(event)
-			go h.config.EventBroadcaster.Publish(userID, event)
+			h.publishEvent(provider, userID, event)
 			return
 		}
 
@@ -135,8 +138,7 @@ func (h *Handler) handlePatternPOST(
 				"error": ErrSavePattern(err),
 			}).WithDescription(ErrSavePattern(err).Error()).Build()
 
-			_ = provider.PersistEvent(event)
-			go h.config.EventBroadcaster.Publish(userID, event)
+			h.publishEvent(provider, userID, event)
 			return
 		}
 
@@ -148,8 +150,7 @@ func (h *Handler) handlePatternPOST(
 				"error": ErrSavePattern(err),
 			}).WithDescription("unable to get \"name\" from the pattern.").Build()
 
-			_ = provider.PersistEvent(event)
-			go h.config.EventBroadcaster.Publish(userID, event)
+			h.publishEvent(provider, userID, event)
 			return
 		}
 
@@ -176,17 +177,17 @@ func (h *Handler) handlePatternPOST(
 					"error": ErrSavePattern(err),
 				}).WithDescription(ErrSavePattern(err).Error()).Build()
 
-				_ = provider.PersistEvent(event)
-				go h.config.EventBroadcaster.Publish(userID, event)
+				h.publishEvent(provider, userID, event)
 				return
 			}
 
-			h.formatPatternOutput(rw, resp, format, &res, eventBuilder)
+			h.formatPatternOutput(r.Context(), rw, resp, format, &res, eventBuilder)
 			event := eventBuilder.Build()
 			_ = provider.PersistEvent(event)
 			// Do not send pattern save event if pattern is in cyto format as user is on meshmap and every node move will result in save request flooding user's screen.
 			// go h.config.EventBroadcaster.Publish(userID, event)
 			go h.config.PatternChannel.Publish(uuid.FromStringOrNil(user.ID), struct{}{})
+			h.pushSavedPattern(r, provider, user, resp)
 			return
 		}
 
@@ -199,7 +200,7 @@ func (h *Handler) handlePatternPOST(
 			return
 		}
 
-		h.formatPatternOutput(rw, byt, format, &res, eventBuilder)
+		h.formatPatternOutput(r.Context(), rw, byt, format, &res, eventBuilder)
 
 		return
 	}
@@ -224,8 +225,7 @@ func (h *Handler) handlePatternPOST(
 					"error": ErrSavePattern(err),
 				}).WithDescription("unable to get \"name\" from the pattern.").Build()
 
-				_ = provider.PersistEvent(event)
-				go h.config.EventBroadcaster.Publish(userID, event)
+				h.publishEvent(provider, userID, event)
 				return
 			}
 			parsedBody.PatternData.Name = patternName
@@ -253,16 +253,15 @@ func (h *Handler) handlePatternPOST(
 					"error": ErrSavePattern(err),
 				}).WithDescription(ErrSavePattern(err).Error()).Build()
 
-				_ = provider.PersistEvent(event)
-				go h.config.EventBroadcaster.Publish(userID, event)
+				h.publishEvent(provider, userID, event)
 				return
 			}
 
-			h.formatPatternOutput(rw, resp, format, &res, eventBuilder)
+			h.formatPatternOutput(r.Context(), rw, resp, format, &res, eventBuilder)
 			event := eventBuilder.Build()
-			_ = provider.PersistEvent(event)
-			go h.config.EventBroadcaster.Publish(userID, event)
+			h.publishEvent(provider, userID, event)
 			go h.config.PatternChannel.Publish(uuid.FromStringOrNil(user.ID), struct{}{})
+			h.pushSavedPattern(r, provider, user, resp)
 			return
 		}
 
@@ -275,10 +274,9 @@ func (h *Handler) handlePatternPOST(
 			return
 		}
 
-		h.formatPatternOutput(rw, byt, format, &res, eventBuilder)
+		h.formatPatternOutput(r.Context(), rw, byt, format, &res, eventBuilder)
 		event := eventBuilder.Build()
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 		return
 	}
 
@@ -292,15 +290,13 @@ func (h *Handler) handlePatternPOST(
 				"error": ErrImportPattern(err),
 			}).WithDescription(ErrImportPattern(err).Error()).Build()
 
-			_ = provider.PersistEvent(event)
-			go h.config.EventBroadcaster.Publish(userID, event)
+			h.publishEvent(provider, userID, event)
 			return
 		}
 
-		h.formatPatternOutput(rw, resp, format, &res, eventBuilder)
+		h.formatPatternOutput(r.Context(), rw, resp, format, &res, eventBuilder)
 		event := eventBuilder.Build()
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 		return
 	}
 	//Depracated: The below logic was used when applications were stored as k8s_manifests.
@@ -483,15 +479,13 @@ func (h *Handler) DeleteMesheryPatternHandler(
 			"error": errPatternDelete,
 		}).WithDescription("Error deleting pattern.").Build()
 		http.Error(rw, errPatternDelete.Error(), http.StatusInternalServerError)
-		_ = provider.PersistEvent(event)
-		go h.config.EventBroadcaster.Publish(userID, event)
+		h.publishEvent(provider, userID, event)
 		return
 	}
 
 	_ = json.Unmarshal(resp, &mesheryPattern)
 	event := eventBuilder.WithSeverity(events.Informational).WithDescription(fmt.Sprintf("Pattern %s deleted.", mesheryPattern.Name)).Build()
-	_ = provider.PersistEvent(event)
-	go h.config.EventBroadcaster.Publish(userID, event)
+	h.publishEvent(provider, userID, event)
 	go h.config.PatternChannel.Publish(uuid.FromStringOrNil(user.ID), struct{}{})
 
 	rw.Header().Set("Content-Type", "application/json")
@@ -539,6 +533,77 @@ func (h *Handler) DownloadMesheryPatternHandler(
 	}
 }
 
+// swagger:route POST /api/pattern/{id}/export PatternsAPI idExportMesheryPattern
+// Handle POST request for exporting the Meshery Pattern with the given id
+//
+// Exports the design as a gzip compressed tarball in the format given by the ```format``` query parameter:
+//   - ```kustomize```: a Kustomize base rendered with the values of the variables saved in the design, and an overlay
+//     for every environment of the request body, patching the base with the values of the variables of the environment
+//   - ```helm```: a Helm chart with a template for every component, whose namespace, labels, annotations and settings
+//     are extracted into the values of the chart
+//
+// responses:
+//
+//	200:
+//	400:
+//	404:
+
+// ExportMesheryPatternHandler exports the pattern with the given id
+func (h *Handler) ExportMesheryPatternHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	provider models.Provider,
+) {
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	var body models.MesheryPatternExportRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && err != io.EOF {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	patternID := mux.Vars(r)["id"]
+	resp, err := provider.GetMesheryPattern(r, patternID)
+	if err != nil {
+		h.log.Error(ErrGetPattern(err))
+		http.Error(rw, ErrGetPattern(err).Error(), http.StatusNotFound)
+		return
+	}
+	pattern := &models.MesheryPattern{}
+	if err := json.Unmarshal(resp, &pattern); err != nil {
+		obj := "export pattern"
+		h.log.Error(models.ErrUnmarshal(err, obj))
+		http.Error(rw, models.ErrUnmarshal(err, obj).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var buf bytes.Buffer
+	format := r.URL.Query().Get("format")
+	switch format {
+	case "kustomize":
+		err = export.Kustomize(&buf, pattern.Name, []byte(pattern.PatternFile), body.Environments)
+	case "helm":
+		err = export.Helm(&buf, pattern.Name, []byte(pattern.PatternFile))
+	default:
+		http.Error(rw, fmt.Sprintf("unsupported export format %q, supported formats: kustomize, helm", format), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/gzip")
+	rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s-%s.tar.gz", patternID, format)))
+	_, _ = rw.Write(buf.Bytes())
+}
+
 // swagger:route POST /api/pattern/clone/{id} PatternsAPI idCloneMesheryPattern
 // Handle Clone for a Meshery Pattern
 //
@@ -711,7 +776,7 @@ func (h *Handler) GetMesheryPatternHandler(
 	fmt.Fprint(rw, string(resp))
 }
 
-func (h *Handler) formatPatternOutput(rw http.ResponseWriter, content []byte, format string, res *meshes.EventsResponse, eventBuilder *events.EventBuilder) {
+func (h *Handler) formatPatternOutput(ctx context.Context, rw http.ResponseWriter, content []byte, format string, res *meshes.EventsResponse, eventBuilder *events.EventBuilder) {
 	contentMesheryPatternSlice := make([]models.MesheryPattern, 0)
 
 	if err := json.Unmarshal(content, &contentMesheryPatternSlice); err != nil {
@@ -739,7 +804,7 @@ func (h *Handler) formatPatternOutput(rw http.ResponseWriter, content []byte, fo
 			}
 
 			//TODO: The below line has to go away once the client fully supports referencing variables  and pattern imports inside design
-			newpatternfile := evalImportAndReferenceStage(&patternFile)
+			newpatternfile := evalImportAndReferenceStage(ctx, &patternFile)
 
 			cyjs, _ := newpatternfile.ToCytoscapeJS()
 
@@ -778,21 +843,22 @@ func (h *Handler) formatPatternOutput(rw http.ResponseWriter, content []byte, fo
 
 // Since the client currently does not support pattern imports and externalized variables, the first(import) stage of pattern engine
 // is evaluated here to simplify the pattern file such that it is valid when a deploy takes place
-func evalImportAndReferenceStage(p *pCore.Pattern) (newp pCore.Pattern) {
+func evalImportAndReferenceStage(ctx context.Context, p *pCore.Pattern) (newp pCore.Pattern) {
 	sap := &serviceActionProvider{}
 	sip := &serviceInfoProvider{}
-	chain := stages.CreateChain()
-	chain.
+	data := &stages.Data{
+		Pattern: p,
+	}
+	stages.CreateChain().
 		Add(stages.Import(sip, sap)).
 		Add(stages.Filler(false)).
-		Add(func(data *stages.Data, err error, next stages.ChainStageNextFunction) {
-			data.Lock.Lock()
-			newp = *data.Pattern
-			data.Lock.Unlock()
-		}).
-		Process(&stages.Data{
-			Pattern: p,
-		})
+		Process(ctx, data)
+	if sap.err != nil {
+		return
+	}
+	data.Lock.Lock()
+	newp = *data.Pattern
+	data.Lock.Unlock()
 	return newp
 }
 
@@ -807,3 +873,94 @@ func addMeshkitErr(res *meshes.EventsResponse, err error) {
 		res.ErrorCode = errors.GetCode(err)
 	}
 }
+
+// swagger:route POST /api/pattern/import/compose PatternsAPI idPostImportComposePattern
+// Handle POST request for converting a Docker Compose file into a design
+//
+// Converts the Docker Compose file sent as the request body into a design, which is returned without being saved.
+// Every service becomes a Deployment, its ports a Service, its named volumes PersistentVolumeClaims, and its links and
+// depends_on dependencies of its Deployment. The name of the design is given by the ```name``` query parameter.
+// responses:
+//	200: patternImportResponseWrapper
+//	400:
+
+// ImportComposePatternHandler converts a Docker Compose file into a design
+func (h *Handler) ImportComposePatternHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	_ models.Provider,
+) {
+	h.importPattern(rw, r, "docker-compose", compose.NewPatternFile)
+}
+
+// swagger:route POST /api/pattern/import/terraform PatternsAPI idPostImportTerraformPattern
+// Handle POST request for converting a Terraform configuration into a design
+//
+// Converts the Terraform configuration sent as the request body into a design, which is returned without being saved.
+// The resources of the kubernetes provider become the components registered for their kind, and the charts of the
+// helm_release resources are rendered into the components they install. References to variables and locals are
+// replaced by their defaults, and references between resources become dependencies of the components.
+// Resources of other providers and expressions which cannot be resolved are reported as warnings.
+// The name of the design is given by the ```name``` query parameter.
+// responses:
+//	200: patternImportResponseWrapper
+//	400:
+
+// ImportTerraformPatternHandler converts a Terraform configuration into a design
+func (h *Handler) ImportTerraformPatternHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	_ models.Provider,
+) {
+	h.importPattern(rw, r, "terraform", terraform.NewPatternFile)
+}
+
+// importPattern converts the body of the request into a design with the given converter
+func (h *Handler) importPattern(
+	rw http.ResponseWriter,
+	r *http.Request,
+	defaultName string,
+	convert func(name string, data []byte, reg *meshmodel.RegistryManager) (pCore.Pattern, []string, error),
+) {
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		name = defaultName
+	}
+	pattern, warnings, err := convert(name, body, h.registryManager)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	patternFile, err := pattern.ToYAML()
+	if err != nil {
+		h.log.Error(models.ErrMarshal(err, "design file"))
+		http.Error(rw, models.ErrMarshal(err, "design file").Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(models.PatternImportResponse{
+		Name:        pattern.Name,
+		PatternFile: string(patternFile),
+		Warnings:    warnings,
+	}); err != nil {
+		h.log.Error(models.ErrEncoding(err, "design import response"))
+		http.Error(rw, models.ErrEncoding(err, "design import response").Error(), http.StatusInternalServerError)
+	}
+}
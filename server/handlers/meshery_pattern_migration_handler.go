@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+// the page size the saved designs are read with
+const patternMigrationPageSize = 100
+
+// swagger:route POST /api/pattern/migrate PatternsAPI idMigrateMesheryPatterns
+// Handle POST request for migrating the saved designs to the current version of the pattern file format
+//
+// Upgrades the pattern files of the saved designs of the user whose schemaVersion is older than the current one, and
+// saves them. The response reports the changes made to every design and the incompatibilities which could not be
+// migrated and need to be fixed by hand. With the ```dryRun``` query parameter set to true, the designs are not saved.
+// Designs of older versions are migrated when they are read anyway, this makes it explicit and permanent.
+// responses:
+// 	200: patternMigrationResponseWrapper
+
+// MigrateMesheryPatternsHandler migrates the saved designs of the user to the current version of the format
+func (h *Handler) MigrateMesheryPatternsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	token, ok := r.Context().Value(models.TokenCtxKey).(string)
+	if !ok {
+		err := ErrRetrieveUserToken(fmt.Errorf("token not found in the context"))
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	dryRun := r.URL.Query().Get("dryRun") == "true"
+
+	// all the designs are read before any of them is saved, as saving a design changes the pages
+	patterns := []models.MesheryPattern{}
+	for page := 0; ; page++ {
+		resp, err := provider.GetMesheryPatterns(token, strconv.Itoa(page), strconv.Itoa(patternMigrationPageSize), "", "", "")
+		if err != nil {
+			h.log.Error(ErrFetchPattern(err))
+			http.Error(rw, ErrFetchPattern(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		var patternsPage models.PatternsAPIResponse
+		if err := json.Unmarshal(resp, &patternsPage); err != nil {
+			h.log.Error(ErrDecodePattern(err))
+			http.Error(rw, ErrDecodePattern(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		patterns = append(patterns, patternsPage.Patterns...)
+		if len(patternsPage.Patterns) < patternMigrationPageSize || uint(len(patterns)) >= patternsPage.TotalCount {
+			break
+		}
+	}
+
+	response := models.PatternMigrationResponse{
+		SchemaVersion: core.CurrentSchemaVersion,
+		Designs:       []models.PatternMigrationResult{},
+	}
+	for i := range patterns {
+		pattern := &patterns[i]
+		result := models.PatternMigrationResult{Name: pattern.Name}
+		if pattern.ID != nil {
+			result.ID = pattern.ID.String()
+		}
+		migrated, report, err := core.MigratePatternFile([]byte(pattern.PatternFile))
+		result.MigrationReport = report
+		if err != nil {
+			result.Error = err.Error()
+			response.Failed++
+			response.Designs = append(response.Designs, result)
+			continue
+		}
+		if !report.Migrated() {
+			continue
+		}
+		response.Migrated++
+		if len(report.Incompatibilities) > 0 {
+			response.Incompatible++
+		}
+		if !dryRun {
+			pattern.PatternFile = string(migrated)
+			if _, err := provider.SaveMesheryPattern(token, pattern); err != nil {
+				h.log.Error(ErrSavePattern(err))
+				result.Error = ErrSavePattern(err).Error()
+				response.Failed++
+			} else {
+				result.Saved = true
+			}
+		}
+		response.Designs = append(response.Designs, result)
+	}
+
+	if response.Migrated > 0 && !dryRun {
+		go h.config.PatternChannel.Publish(uuid.FromStringOrNil(user.ID), struct{}{})
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(response)
+}
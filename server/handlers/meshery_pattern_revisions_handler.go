@@ -0,0 +1,294 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	pCore "github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshkit/models/events"
+)
+
+// swagger:route GET /api/pattern/{id}/revisions PatternsAPI idGetMesheryPatternRevisions
+// Handle GET request for the revisions of the Meshery Pattern with the given id
+//
+// Returns the revisions recorded every time the design was saved, most recent first,
+// with the changes every revision made to the previous one
+// responses:
+// 	200: mesheryPatternRevisionsResponseWrapper
+//	404:
+
+// GetMesheryPatternRevisionsHandler returns the history of the pattern with the given id
+func (h *Handler) GetMesheryPatternRevisionsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	provider models.Provider,
+) {
+	patternID := mux.Vars(r)["id"]
+	revisions, err := getPatternRevisions(r, provider, patternID)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	response := models.MesheryPatternRevisionsAPIResponse{
+		PatternID: patternID,
+		Revisions: make([]models.MesheryPatternRevisionWithDiff, 0, len(revisions)),
+	}
+	for i, revision := range revisions {
+		previous := ""
+		if i+1 < len(revisions) {
+			previous = revisions[i+1].PatternFile
+		}
+		diff, err := pCore.DiffPatternFiles([]byte(previous), []byte(revision.PatternFile))
+		if err != nil {
+			h.log.Error(ErrPatternRevision(err, patternID))
+			http.Error(rw, ErrPatternRevision(err, patternID).Error(), http.StatusInternalServerError)
+			return
+		}
+		response.Revisions = append(response.Revisions, models.MesheryPatternRevisionWithDiff{
+			MesheryPatternRevision: revision,
+			Diff:                   diff,
+		})
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(response); err != nil {
+		h.log.Error(ErrEncodePattern(err))
+		http.Error(rw, ErrEncodePattern(err).Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route GET /api/pattern/{id}/revisions/diff PatternsAPI idGetMesheryPatternRevisionsDiff
+// Handle GET request for the difference between two revisions of the Meshery Pattern with the given id
+//
+// Returns the changes made to the design from revision ?from= to revision ?to=, which defaults to the latest revision
+// responses:
+// 	200: mesheryPatternRevisionsDiffResponseWrapper
+//	400:
+//	404:
+
+// GetMesheryPatternRevisionsDiffHandler returns the structured diff between two revisions of the pattern
+func (h *Handler) GetMesheryPatternRevisionsDiffHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	provider models.Provider,
+) {
+	patternID := mux.Vars(r)["id"]
+	q := r.URL.Query()
+	from, err := strconv.Atoi(q.Get("from"))
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("invalid revision %q to diff from", q.Get("from")), http.StatusBadRequest)
+		return
+	}
+	to := 0
+	if q.Get("to") != "" {
+		to, err = strconv.Atoi(q.Get("to"))
+		if err != nil {
+			http.Error(rw, fmt.Sprintf("invalid revision %q to diff to", q.Get("to")), http.StatusBadRequest)
+			return
+		}
+	}
+
+	revisions, err := getPatternRevisions(r, provider, patternID)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusNotFound)
+		return
+	}
+	if to == 0 {
+		to = revisions[0].Revision
+	}
+	var fromRevision, toRevision *models.MesheryPatternRevision
+	for i := range revisions {
+		if revisions[i].Revision == from {
+			fromRevision = &revisions[i]
+		}
+		if revisions[i].Revision == to {
+			toRevision = &revisions[i]
+		}
+	}
+	if fromRevision == nil || toRevision == nil {
+		http.Error(rw, fmt.Sprintf("design %s has no revision %d or %d", patternID, from, to), http.StatusNotFound)
+		return
+	}
+
+	diff, err := pCore.DiffPatternFiles([]byte(fromRevision.PatternFile), []byte(toRevision.PatternFile))
+	if err != nil {
+		h.log.Error(ErrPatternRevision(err, patternID))
+		http.Error(rw, ErrPatternRevision(err, patternID).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(models.MesheryPatternRevisionsDiffAPIResponse{
+		PatternID: patternID,
+		From:      from,
+		To:        to,
+		Diff:      diff,
+	}); err != nil {
+		h.log.Error(ErrEncodePattern(err))
+		http.Error(rw, ErrEncodePattern(err).Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route POST /api/pattern/{id}/revisions/{revision}/restore PatternsAPI idRestoreMesheryPatternRevision
+// Handle POST request for restoring the Meshery Pattern with the given id to one of its revisions
+//
+// Saves the revision as the current version of the design. Restoring records a new revision, so it can be rolled back as well.
+// responses:
+// 	200: mesheryPatternResponseWrapper
+//	400:
+//	404:
+
+// RestoreMesheryPatternRevisionHandler restores the pattern with the given id to the given revision
+func (h *Handler) RestoreMesheryPatternRevisionHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	patternID := mux.Vars(r)["id"]
+	revision, err := strconv.Atoi(mux.Vars(r)["revision"])
+	if err != nil || revision < 1 {
+		http.Error(rw, fmt.Sprintf("invalid revision %q", mux.Vars(r)["revision"]), http.StatusBadRequest)
+		return
+	}
+	userID := uuid.FromStringOrNil(user.ID)
+	eventBuilder := events.NewEvent().FromUser(userID).FromSystem(*h.SystemID).WithCategory("pattern").WithAction("restore").ActedUpon(uuid.FromStringOrNil(patternID))
+
+	resp, err := provider.RestoreMesheryPatternRevision(r, patternID, revision)
+	if err != nil {
+		errPatternRevision := ErrPatternRevision(err, patternID)
+		h.log.Error(errPatternRevision)
+		event := eventBuilder.WithSeverity(events.Error).WithMetadata(map[string]interface{}{
+			"error": errPatternRevision,
+		}).WithDescription(fmt.Sprintf("Error restoring revision %d of design.", revision)).Build()
+		h.publishEvent(provider, userID, event)
+		http.Error(rw, errPatternRevision.Error(), http.StatusNotFound)
+		return
+	}
+
+	event := eventBuilder.WithSeverity(events.Informational).WithDescription(fmt.Sprintf("Design restored to revision %d.", revision)).Build()
+	h.publishEvent(provider, userID, event)
+	go h.config.PatternChannel.Publish(userID, struct{}{})
+
+	rw.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(rw, string(resp))
+}
+
+// getPatternRevisions returns the revisions of the pattern, most recent first
+func getPatternRevisions(r *http.Request, provider models.Provider, patternID string) ([]models.MesheryPatternRevision, error) {
+	resp, err := provider.GetMesheryPatternRevisions(r, patternID)
+	if err != nil {
+		return nil, ErrPatternRevision(err, patternID)
+	}
+	revisions := []models.MesheryPatternRevision{}
+	if err := json.Unmarshal(resp, &revisions); err != nil {
+		return nil, ErrPatternRevision(err, patternID)
+	}
+	if len(revisions) == 0 {
+		return nil, ErrPatternRevision(fmt.Errorf("design has no revisions"), patternID)
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision > revisions[j].Revision
+	})
+	return revisions, nil
+}
+
+// swagger:route POST /api/pattern/merge PatternsAPI idMergeMesheryPatterns
+// Handle POST request for merging two designs
+//
+// Merges the changes made by two designs to the design they were derived from, component by component and field by field.
+// The designs and their base can be given as pattern files or as revisions of a saved design. The fields both designs
+// changed differently are reported as conflicts and taken from our design, until the merge is requested again with their
+// resolutions.
+// responses:
+// 	200: patternMergeResponseWrapper
+//	400:
+//	404:
+
+// MergeMesheryPatternsHandler merges two designs and reports their conflicts
+func (h *Handler) MergeMesheryPatternsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	provider models.Provider,
+) {
+	var req models.MesheryPatternMergeRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	if req.PatternID != "" {
+		if req.Ours == "" {
+			resp, err := provider.GetMesheryPattern(r, req.PatternID)
+			if err != nil {
+				h.log.Error(ErrGetPattern(err))
+				http.Error(rw, ErrGetPattern(err).Error(), http.StatusNotFound)
+				return
+			}
+			var pattern models.MesheryPattern
+			if err := json.Unmarshal(resp, &pattern); err != nil {
+				h.log.Error(ErrDecodePattern(err))
+				http.Error(rw, ErrDecodePattern(err).Error(), http.StatusInternalServerError)
+				return
+			}
+			req.Ours = pattern.PatternFile
+		}
+		if req.BaseRevision != 0 || req.TheirsRevision != 0 {
+			revisions, err := getPatternRevisions(r, provider, req.PatternID)
+			if err != nil {
+				h.log.Error(err)
+				http.Error(rw, err.Error(), http.StatusNotFound)
+				return
+			}
+			for _, revision := range revisions {
+				if revision.Revision == req.BaseRevision && req.Base == "" {
+					req.Base = revision.PatternFile
+				}
+				if revision.Revision == req.TheirsRevision && req.Theirs == "" {
+					req.Theirs = revision.PatternFile
+				}
+			}
+			if (req.BaseRevision != 0 && req.Base == "") || (req.TheirsRevision != 0 && req.Theirs == "") {
+				http.Error(rw, fmt.Sprintf("design %s has no revision %d or %d", req.PatternID, req.BaseRevision, req.TheirsRevision), http.StatusNotFound)
+				return
+			}
+		}
+	}
+	if req.Ours == "" || req.Theirs == "" {
+		http.Error(rw, "both the designs to merge are required, as pattern files or revisions of the saved design", http.StatusBadRequest)
+		return
+	}
+
+	result, err := pCore.MergePatternFiles([]byte(req.Base), []byte(req.Ours), []byte(req.Theirs), req.Resolutions)
+	if err != nil {
+		h.log.Error(ErrParsePattern(err))
+		http.Error(rw, ErrParsePattern(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(models.PatternMergeResponse{
+		PatternFile: string(result.PatternFile),
+		Conflicts:   result.Conflicts,
+	}); err != nil {
+		h.log.Error(ErrEncodePattern(err))
+		http.Error(rw, ErrEncodePattern(err).Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/layer5io/meshery/server/models"
+)
+
+// Proxies close idle connections, a comment is written on the stream whenever it has been idle for this long
+const meshmodelEventsKeepAliveInterval = 30 * time.Second
+
+// swagger:route GET /api/meshmodels/events MeshmodelEventsAPI idGetMeshmodelEvents
+// Handle GET request for streaming the changes made to the registry.
+//
+// Streams a Server-Sent Event whenever a component, model or relationship is registered, updated or deleted,
+// so that clients can refresh their caches incrementally instead of polling the summary.
+// The changes made to the relationships of an organization are only streamed to its members.
+// responses:
+//
+//	200:
+func (h *Handler) GetMeshmodelEvents(rw http.ResponseWriter, r *http.Request) {
+	orgID, err := h.getRequestOrgID(r)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		h.log.Error(ErrEventStreamingNotSupported)
+		http.Error(rw, "Event streaming is not supported at the moment.", http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.Header().Set("Connection", "keep-alive")
+	rw.Header().Set("Access-Control-Allow-Origin", "*")
+	flusher.Flush()
+
+	events, unsubscribe := h.config.MeshModelEventsChannel.Subscribe()
+	defer unsubscribe()
+
+	keepAlive := time.NewTicker(meshmodelEventsKeepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case event := <-events:
+			if !event.VisibleTo(orgID) {
+				continue
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				h.log.Error(models.ErrMarshal(err, "meshmodel event"))
+				continue
+			}
+			_, _ = fmt.Fprintf(rw, "data: %s\n\n", data)
+			flusher.Flush()
+			keepAlive.Reset(meshmodelEventsKeepAliveInterval)
+		case <-keepAlive.C:
+			_, _ = fmt.Fprint(rw, ": keep-alive\n\n")
+			flusher.Flush()
+		case <-r.Context().Done():
+			h.log.Debug("meshmodel events stream closed")
+			return
+		}
+	}
+}
@@ -3,9 +3,12 @@ package handlers
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/layer5io/meshery/server/models"
 	"github.com/sirupsen/logrus"
@@ -19,8 +22,15 @@ func (h *Handler) ProviderMiddleware(next http.Handler) http.Handler {
 	fn := func(w http.ResponseWriter, req *http.Request) {
 		var providerName string
 		var provider models.Provider
+		req, apiToken, ok := h.apiTokenRequest(w, req)
+		if !ok {
+			return
+		}
 		ck, err := req.Cookie(h.config.ProviderCookieName)
-		if err == nil {
+		if apiToken != nil {
+			// the calls made with an API token use the provider it was created with
+			providerName = apiToken.ProviderName
+		} else if err == nil {
 			providerName = ck.Value
 		} else {
 			providerName = req.Header.Get(h.config.ProviderCookieName)
@@ -95,8 +105,9 @@ func (h *Handler) AuthMiddleware(next http.Handler, auth models.AuthenticationMe
 					provider.HandleUnAuthenticated(w, req)
 					return
 				}
-				// Local Provider
-				h.LoginHandler(w, req, provider, true)
+				// Local Provider, whose sessions are only invalid when the users sign in with OpenID Connect
+				provider.HandleUnAuthenticated(w, req)
+				return
 			}
 		}
 		next.ServeHTTP(w, req)
@@ -146,6 +157,7 @@ func (h *Handler) KubernetesMiddleware(next func(http.ResponseWriter, *http.Requ
 }
 
 // SessionInjectorMiddleware - is a middleware which injects user and session object
+// along with the organization of the user, which scopes the relationships of the registry the requests are served with
 func (h *Handler) SessionInjectorMiddleware(next func(http.ResponseWriter, *http.Request, *models.Preference, *models.User, models.Provider)) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		providerI := req.Context().Value(models.ProviderCtxKey)
@@ -182,6 +194,18 @@ func (h *Handler) SessionInjectorMiddleware(next func(http.ResponseWriter, *http
 			http.Error(w, "unable to get user details", http.StatusUnauthorized)
 			return
 		}
+		if record, ok := req.Context().Value(models.AuditRecordCtxKey).(*models.AuditRecord); ok {
+			record.UserID = user.ID
+		}
+		if !h.authorize(w, req, user) {
+			return
+		}
+		orgID, err := h.getRequestOrgID(req)
+		if err != nil {
+			h.log.Error(err)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
 		prefObj, err := provider.ReadFromPersister(user.UserID)
 		if err != nil {
 			logrus.Warn("unable to read session from the session persister, starting with a new one")
@@ -191,8 +215,10 @@ func (h *Handler) SessionInjectorMiddleware(next func(http.ResponseWriter, *http
 		ctx := context.WithValue(req.Context(), models.TokenCtxKey, token)
 		ctx = context.WithValue(ctx, models.PerfObjCtxKey, prefObj)
 		ctx = context.WithValue(ctx, models.UserCtxKey, user)
+		ctx = context.WithValue(ctx, models.OrgIDCtxKey, orgID)
 		ctx = context.WithValue(ctx, models.RegistryManagerKey, h.registryManager)
 		ctx = context.WithValue(ctx, models.HandlerKey, h)
+		ctx = context.WithValue(ctx, models.AuthorizerCtxKey, h.authorizer(req, user))
 		req1 := req.WithContext(ctx)
 		next(w, req1, prefObj, user, provider)
 	})
@@ -272,6 +298,33 @@ func KubernetesMiddleware(ctx context.Context, h *Handler, provider models.Provi
 	// next(w, req1, prefObj, user, provider)
 }
 
+// sessionlessContext returns the context the background tasks of a user, eg: scheduled deployments, run with, carrying
+// the token of a session the user created them with in place of the session of a request, along with the provider,
+// user and preferences of the context
+func (h *Handler) sessionlessContext(ctx context.Context, providerName, userID, userName, token string, k8sContextIDs []string) (context.Context, models.Provider, *models.User, *models.Preference, error) {
+	provider, ok := h.config.Providers[providerName]
+	if !ok {
+		return nil, nil, nil, nil, fmt.Errorf("provider %s is not available", providerName)
+	}
+	user := &models.User{ID: userID, UserID: userName}
+	prefObj, err := provider.ReadFromPersister(user.UserID)
+	if err != nil || prefObj == nil {
+		h.log.Warn(fmt.Errorf("unable to read the preferences of user %s, starting with new ones", user.UserID))
+		prefObj = &models.Preference{}
+	}
+
+	ctx = context.WithValue(ctx, models.TokenCtxKey, token)
+	ctx = context.WithValue(ctx, models.UserCtxKey, user)
+	ctx = context.WithValue(ctx, models.PerfObjCtxKey, prefObj)
+	ctx = context.WithValue(ctx, models.RegistryManagerKey, h.registryManager)
+	ctx = context.WithValue(ctx, models.HandlerKey, h)
+	ctx, err = KubernetesMiddleware(ctx, h, provider, user, k8sContextIDs)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return ctx, provider, user, prefObj, nil
+}
+
 func MesheryControllersMiddleware(ctx context.Context, h *Handler) (context.Context, error) {
 	mk8sContexts, ok := ctx.Value(models.AllKubeClusterKey).([]models.K8sContext)
 	if !ok || len(mk8sContexts) == 0 {
@@ -287,3 +340,78 @@ func MesheryControllersMiddleware(ctx context.Context, h *Handler) (context.Cont
 	ctx = context.WithValue(ctx, models.MeshSyncDataHandlersKey, h.MesheryCtrlsHelper.GetMeshSyncDataHandlersForEachContext())
 	return ctx, nil
 }
+
+// ETagMiddleware is a middleware which tags the successful responses of the registry with an ETag derived from the version
+// of the registry, the organization of the caller and the request, responding with 304 Not Modified without serving the
+// request when the client already holds the representation of the current version of the registry.
+// This spares clients polling the registry from downloading unchanged results again.
+func (h *Handler) ETagMiddleware(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, req *http.Request) {
+		if (req.Method != http.MethodGet && req.Method != http.MethodHead) || h.config == nil || h.config.RegistryCache == nil {
+			next.ServeHTTP(w, req)
+			return
+		}
+		orgID, err := h.getRequestOrgID(req)
+		if err != nil {
+			// the handlers refuse the requests whose organization can not be determined
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		sum := sha256.Sum256([]byte(h.config.RegistryCache.Version() + "\n" + orgID + "\n" + req.URL.RequestURI()))
+		etag := fmt.Sprintf("%q", hex.EncodeToString(sum[:16]))
+		if etagMatches(req.Header.Get("If-None-Match"), etag) {
+			w.Header().Set("ETag", etag)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		next.ServeHTTP(&etagResponseWriter{ResponseWriter: w, etag: etag}, req)
+	}
+	return http.HandlerFunc(fn)
+}
+
+// etagMatches reports whether the If-None-Match header lists the etag, weak validators are compared by their opaque tag.
+// The wildcard never matches, since whether the request is served successfully is only known once it is served.
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// etagResponseWriter tags the response with the etag when it is successful, the response is streamed to the client as
+// it is written
+type etagResponseWriter struct {
+	http.ResponseWriter
+	etag        string
+	wroteHeader bool
+}
+
+func (e *etagResponseWriter) WriteHeader(status int) {
+	if !e.wroteHeader {
+		e.wroteHeader = true
+		if status == http.StatusOK {
+			e.Header().Set("ETag", e.etag)
+		}
+	}
+	e.ResponseWriter.WriteHeader(status)
+}
+
+func (e *etagResponseWriter) Write(p []byte) (int, error) {
+	if !e.wroteHeader {
+		e.WriteHeader(http.StatusOK)
+	}
+	return e.ResponseWriter.Write(p)
+}
+
+func (e *etagResponseWriter) Flush() {
+	if flusher, ok := e.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
@@ -1,7 +1,14 @@
 package handlers
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
+
+	"github.com/layer5io/meshery/server/models"
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
 )
 
 func TestAuthMiddleWare(t *testing.T) {
@@ -15,3 +22,74 @@ func TestAuthMiddleWare(t *testing.T) {
 	//	t.Errorf("AuthMiddleWare() failed with error: %s", err)
 	//}
 }
+
+func TestETagMiddleware(t *testing.T) {
+	cache := mesherymeshmodel.NewRegistryCache(nil, time.Minute, 10)
+	h := &Handler{config: &models.HandlerConfig{RegistryCache: cache}}
+	served := 0
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+		if r.URL.Query().Get("missing") != "" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"total_count":1}`))
+	})
+	handler := h.ETagMiddleware(next)
+	serve := func(method, target, ifNoneMatch, org string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, target, nil)
+		r = r.WithContext(context.WithValue(r.Context(), models.ProviderCtxKey, orgProvider{orgID: org}))
+		r.Header.Set("If-None-Match", ifNoneMatch)
+		rw := httptest.NewRecorder()
+		handler.ServeHTTP(rw, r)
+		return rw
+	}
+
+	first := serve(http.MethodGet, "/api/meshmodels/models", "", "org-a")
+	etag := first.Header().Get("ETag")
+	if first.Code != http.StatusOK || etag == "" || first.Body.Len() == 0 {
+		t.Fatalf("the first response = %d, ETag %q, want the body tagged", first.Code, etag)
+	}
+
+	tests := []struct {
+		name        string
+		method      string
+		target      string
+		ifNoneMatch string
+		org         string
+		status      int
+	}{
+		{"unchanged registry", http.MethodGet, "/api/meshmodels/models", etag, "org-a", http.StatusNotModified},
+		{"weak validator", http.MethodGet, "/api/meshmodels/models", `"other", W/` + etag, "org-a", http.StatusNotModified},
+		{"representation of another organization", http.MethodGet, "/api/meshmodels/models", etag, "org-b", http.StatusOK},
+		{"representation of another query", http.MethodGet, "/api/meshmodels/models?page=2", etag, "org-a", http.StatusOK},
+		{"stale validator", http.MethodGet, "/api/meshmodels/models", `"stale"`, "org-a", http.StatusOK},
+		{"failed request", http.MethodGet, "/api/meshmodels/models?missing=true", "*", "org-a", http.StatusNotFound},
+		{"request which is not a GET", http.MethodPost, "/api/meshmodels/models", etag, "org-a", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			served = 0
+			rw := serve(tt.method, tt.target, tt.ifNoneMatch, tt.org)
+			if rw.Code != tt.status {
+				t.Fatalf("the response = %d, want %d", rw.Code, tt.status)
+			}
+			if rw.Code == http.StatusNotModified && (rw.Body.Len() != 0 || served != 0) {
+				t.Errorf("the request was served along with 304: %q", rw.Body.String())
+			}
+			if rw.Code != http.StatusNotModified && (rw.Body.Len() == 0 || served != 1) {
+				t.Error("the request was not served")
+			}
+			if rw.Code != http.StatusOK && rw.Code != http.StatusNotModified && rw.Header().Get("ETag") != "" {
+				t.Errorf("the failed response is tagged %q", rw.Header().Get("ETag"))
+			}
+		})
+	}
+
+	// the representations held by the clients are stale once the registry changes
+	cache.Invalidate()
+	rw := serve(http.MethodGet, "/api/meshmodels/models", etag, "org-a")
+	if rw.Code != http.StatusOK || rw.Header().Get("ETag") == etag {
+		t.Errorf("the response once the registry changed = %d, ETag %q, want a new representation", rw.Code, rw.Header().Get("ETag"))
+	}
+}
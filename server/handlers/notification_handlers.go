@@ -0,0 +1,406 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshkit/models/events"
+)
+
+// swagger:route GET /api/notifications/channels NotificationsAPI idGetNotificationChannels
+// Handle GET request for the notification channels of the user
+//
+// Returns the notification channels of the user, most recent first. The URLs of the incoming webhooks of the Slack and
+// the Teams channels are returned without their path, which authorizes posting to the channel.
+// responses:
+//
+//	200: notificationChannelsRespWrapper
+//	500:
+func (h *Handler) GetNotificationChannelsHandler(
+	rw http.ResponseWriter,
+	_ *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	channels, err := (&models.NotificationPersister{DB: h.dbHandler}).GetNotificationChannels(user.ID)
+	if err != nil {
+		h.log.Error(ErrNotification(err))
+		http.Error(rw, ErrNotification(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(channels); err != nil {
+		h.log.Error(models.ErrEncoding(err, "notification channels"))
+		http.Error(rw, models.ErrEncoding(err, "notification channels").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route POST /api/notifications/channels NotificationsAPI idCreateNotificationChannel
+// Handle POST request for registering a notification channel
+//
+// Registers a destination of the notifications of the user, eg:
+// {"name": "oncall", "type": "slack", "target": "https://hooks.slack.com/services/..."}.
+// The types are slack and teams, whose target is the URL of an incoming webhook of the channel, and email, whose target
+// is the comma separated addresses of the recipients, mailed through the SMTP server of NOTIFICATION_SMTP_HOST.
+// The events are routed to the channels by the notification rules.
+// responses:
+//
+//	201: notificationChannelRespWrapper
+//	400:
+//	500:
+func (h *Handler) CreateNotificationChannelHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	var body models.NotificationChannelRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	channel := models.NotificationChannel{UserID: user.ID}
+	if err := channel.Apply(body); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := (&models.NotificationPersister{DB: h.dbHandler}).SaveNotificationChannel(&channel); err != nil {
+		h.log.Error(ErrNotification(err))
+		http.Error(rw, ErrNotification(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(rw).Encode(channel); err != nil {
+		h.log.Error(models.ErrEncoding(err, "notification channel"))
+	}
+}
+
+// swagger:route PUT /api/notifications/channels/{id} NotificationsAPI idUpdateNotificationChannel
+// Handle PUT request for updating a notification channel
+//
+// Replaces the name, the type and the target of the notification channel of the user with the given ID.
+// responses:
+//
+//	200: notificationChannelRespWrapper
+//	400:
+//	404:
+//	500:
+func (h *Handler) UpdateNotificationChannelHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	channel, ok := h.userNotificationChannel(rw, r, user)
+	if !ok {
+		return
+	}
+	var body models.NotificationChannelRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := channel.Apply(body); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := (&models.NotificationPersister{DB: h.dbHandler}).SaveNotificationChannel(channel); err != nil {
+		h.log.Error(ErrNotification(err))
+		http.Error(rw, ErrNotification(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(channel); err != nil {
+		h.log.Error(models.ErrEncoding(err, "notification channel"))
+	}
+}
+
+// swagger:route DELETE /api/notifications/channels/{id} NotificationsAPI idDeleteNotificationChannel
+// Handle DELETE request for deleting a notification channel
+//
+// Deletes the notification channel of the user with the given ID along with the rules routing to it.
+// responses:
+//
+//	204:
+//	400:
+//	404:
+//	500:
+func (h *Handler) DeleteNotificationChannelHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("invalid notification channel id %q", mux.Vars(r)["id"]), http.StatusBadRequest)
+		return
+	}
+	deleted, err := (&models.NotificationPersister{DB: h.dbHandler}).DeleteNotificationChannel(id, user.ID)
+	if err != nil {
+		h.log.Error(ErrNotification(err))
+		http.Error(rw, ErrNotification(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if !deleted {
+		http.Error(rw, fmt.Sprintf("notification channel %s not found", id), http.StatusNotFound)
+		return
+	}
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// swagger:route POST /api/notifications/channels/{id}/test NotificationsAPI idTestNotificationChannel
+// Handle POST request for sending a test notification to a notification channel
+//
+// Sends a test notification, rendered with the default template, to the notification channel of the user with the given
+// ID, and returns the error of the channel when it cannot be sent.
+// responses:
+//
+//	204:
+//	400:
+//	404:
+//	502:
+func (h *Handler) TestNotificationChannelHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	channel, ok := h.userNotificationChannel(rw, r, user)
+	if !ok {
+		return
+	}
+	event := events.NewEvent().FromUser(uuid.FromStringOrNil(user.ID)).FromSystem(*h.SystemID).WithCategory("notification").
+		WithAction("test").WithSeverity(events.Informational).
+		WithDescription(fmt.Sprintf("Notifications of Meshery are sent to the channel %s", channel.Name)).Build()
+	message, err := (&models.NotificationRule{}).Render(event)
+	if err == nil {
+		err = h.config.Notifier.Send(r.Context(), channel, message)
+	}
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadGateway)
+		return
+	}
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// swagger:route GET /api/notifications/rules NotificationsAPI idGetNotificationRules
+// Handle GET request for the notification rules of the user
+//
+// Returns the notification rules of the user, most recent first, the ones of the workspace of the workspace query
+// parameter only when it is set.
+// responses:
+//
+//	200: notificationRulesRespWrapper
+//	500:
+func (h *Handler) GetNotificationRulesHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	rules, err := (&models.NotificationPersister{DB: h.dbHandler}).GetNotificationRules(user.ID, r.URL.Query().Get("workspace"))
+	if err != nil {
+		h.log.Error(ErrNotification(err))
+		http.Error(rw, ErrNotification(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(rules); err != nil {
+		h.log.Error(models.ErrEncoding(err, "notification rules"))
+		http.Error(rw, models.ErrEncoding(err, "notification rules").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route POST /api/notifications/rules NotificationsAPI idCreateNotificationRule
+// Handle POST request for registering a notification rule
+//
+// Routes the events of the user to a notification channel of the user, eg:
+// {"channel_id": "...", "workspace": "production", "min_severity": "error", "categories": ["pattern"], "actions": ["deploy", "drift"]}.
+// The events at least as severe as min_severity, error by default, of the categories and the actions, every one when
+// they are empty, are sent. The events are of a workspace when they are published by requests acting in it, see the
+// X-Meshery-Workspace header, the rules without workspace route the events of every workspace.
+// The messages are rendered with the Go template of template, executed with the event, eg:
+// "{{ .Description }} ({{ .Severity }})", "[{{ upper .Severity }}] {{ .Category }} {{ .Action }}: {{ .Description }}" by default.
+// An event is sent once to a channel, with the template of the oldest rule routing it there.
+// responses:
+//
+//	201: notificationRuleRespWrapper
+//	400:
+//	500:
+func (h *Handler) CreateNotificationRuleHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	var body models.NotificationRuleRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	rule := models.NotificationRule{UserID: user.ID, Enabled: true}
+	if !h.applyNotificationRule(rw, &rule, body, user) {
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(rw).Encode(rule); err != nil {
+		h.log.Error(models.ErrEncoding(err, "notification rule"))
+	}
+}
+
+// swagger:route PUT /api/notifications/rules/{id} NotificationsAPI idUpdateNotificationRule
+// Handle PUT request for updating a notification rule
+//
+// Replaces the channel, the workspace, the minimum severity, the categories, the actions and the template of the
+// notification rule of the user with the given ID, and enables or disables it with enabled.
+// responses:
+//
+//	200: notificationRuleRespWrapper
+//	400:
+//	404:
+//	500:
+func (h *Handler) UpdateNotificationRuleHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("invalid notification rule id %q", mux.Vars(r)["id"]), http.StatusBadRequest)
+		return
+	}
+	rule, err := (&models.NotificationPersister{DB: h.dbHandler}).GetNotificationRule(id, user.ID)
+	if err != nil {
+		h.log.Error(ErrNotification(err))
+		http.Error(rw, ErrNotification(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if rule == nil {
+		http.Error(rw, fmt.Sprintf("notification rule %s not found", id), http.StatusNotFound)
+		return
+	}
+	var body models.NotificationRuleRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if !h.applyNotificationRule(rw, rule, body, user) {
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(rule); err != nil {
+		h.log.Error(models.ErrEncoding(err, "notification rule"))
+	}
+}
+
+// swagger:route DELETE /api/notifications/rules/{id} NotificationsAPI idDeleteNotificationRule
+// Handle DELETE request for deleting a notification rule
+//
+// Deletes the notification rule of the user with the given ID.
+// responses:
+//
+//	204:
+//	400:
+//	404:
+//	500:
+func (h *Handler) DeleteNotificationRuleHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("invalid notification rule id %q", mux.Vars(r)["id"]), http.StatusBadRequest)
+		return
+	}
+	deleted, err := (&models.NotificationPersister{DB: h.dbHandler}).DeleteNotificationRule(id, user.ID)
+	if err != nil {
+		h.log.Error(ErrNotification(err))
+		http.Error(rw, ErrNotification(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if !deleted {
+		http.Error(rw, fmt.Sprintf("notification rule %s not found", id), http.StatusNotFound)
+		return
+	}
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// userNotificationChannel returns the notification channel of the user with the id of the route, the error response is
+// written and false is returned when there is none
+func (h *Handler) userNotificationChannel(rw http.ResponseWriter, r *http.Request, user *models.User) (*models.NotificationChannel, bool) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("invalid notification channel id %q", mux.Vars(r)["id"]), http.StatusBadRequest)
+		return nil, false
+	}
+	channel, err := (&models.NotificationPersister{DB: h.dbHandler}).GetNotificationChannel(id, user.ID)
+	if err != nil {
+		h.log.Error(ErrNotification(err))
+		http.Error(rw, ErrNotification(err).Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	if channel == nil {
+		http.Error(rw, fmt.Sprintf("notification channel %s not found", id), http.StatusNotFound)
+		return nil, false
+	}
+	return channel, true
+}
+
+// applyNotificationRule validates the body, checks its channel is one of the user and stores the rule, the error response
+// is written and false is returned when it fails
+func (h *Handler) applyNotificationRule(rw http.ResponseWriter, rule *models.NotificationRule, body models.NotificationRuleRequestBody, user *models.User) bool {
+	if err := rule.Apply(body); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return false
+	}
+	persister := &models.NotificationPersister{DB: h.dbHandler}
+	channel, err := persister.GetNotificationChannel(rule.ChannelID, user.ID)
+	if err != nil {
+		h.log.Error(ErrNotification(err))
+		http.Error(rw, ErrNotification(err).Error(), http.StatusInternalServerError)
+		return false
+	}
+	if channel == nil {
+		http.Error(rw, fmt.Sprintf("notification channel %s not found", rule.ChannelID), http.StatusBadRequest)
+		return false
+	}
+	if err := persister.SaveNotificationRule(rule); err != nil {
+		h.log.Error(ErrNotification(err))
+		http.Error(rw, ErrNotification(err).Error(), http.StatusInternalServerError)
+		return false
+	}
+	return true
+}
+
+// withWorkspace adds the workspace the request acts in, if any, to the metadata of the events it publishes, so that the
+// notification rules of the workspace route them
+func withWorkspace(r *http.Request, metadata map[string]interface{}) map[string]interface{} {
+	if workspace := requestWorkspace(r); workspace != "" {
+		metadata[models.EventWorkspaceKey] = workspace
+	}
+	return metadata
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+// swagger:route POST /api/pattern/capabilities PatternsAPI idPostPatternCapabilities
+// Handle POST request for the capability matrix of a pattern
+//
+// Checks, without deploying the attached pattern, whether the clusters of the selected Kubernetes contexts serve the kinds
+// of its components, so that the missing operators and CustomResourceDefinitions can be installed before deploying it.
+// The response holds the capability of every component in every context under ```components```, by component name and
+// context id, and the components which cannot be deployed under ```undeployable```, with the reason why.
+// The kinds defined by the CustomResourceDefinitions of the pattern are deployable. The deployment of a pattern holds
+// the same capabilities under ```capabilities```, and fails before provisioning any component when some are undeployable.
+// responses:
+// 	200: patternCapabilitiesResponseWrapper
+
+// PatternCapabilitiesHandler returns whether the clusters of the Kubernetes contexts can deploy the components of a pattern
+func (h *Handler) PatternCapabilitiesHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	if r.Header.Get("Content-Type") == "application/json" {
+		body, err = yaml.JSONToYAML(body)
+		if err != nil {
+			h.log.Error(ErrPatternFile(err))
+			http.Error(rw, ErrPatternFile(err).Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	patternFile, err := core.NewPatternFile(body)
+	if err != nil {
+		h.log.Error(ErrPatternFile(err))
+		http.Error(rw, ErrPatternFile(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := setVariableValues(&patternFile, r); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := _processPattern(
+		r.Context(),
+		provider,
+		patternFile,
+		prefObj,
+		user.ID,
+		patternDeployOptions{
+			verify:  true,
+			skipCRD: r.URL.Query().Get("skipCRD") == "true",
+		},
+		nil,
+		h.registryManager,
+		h.config.EventBroadcaster,
+		h.log,
+	)
+	if err != nil {
+		err := ErrCompConfigPairs(err)
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	capabilities, _ := response["capabilities"].([]core.ComponentCapability)
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(core.NewCapabilityMatrix(capabilities))
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+// swagger:route POST /api/pattern/cost PatternsAPI idPostPatternCost
+// Handle POST request for estimating the cost of a pattern
+//
+// Estimates the cost of running the attached pattern without deploying it, from the CPU and memory requested by the
+// pods of its workloads and the prices configured with COST_CPU_CORE_HOUR, COST_MEMORY_GIB_HOUR and COST_CURRENCY,
+// or fetched from the pricing API COST_PRICING_URL points to. The response holds the cost of every workload of the
+// pattern, for an hour and a month. The variables of the pattern are rendered with the values of the ```var``` query
+// parameters, like when deploying it.
+// responses:
+// 	200: patternCostResponseWrapper
+
+// PatternCostHandler returns the estimated cost of running a pattern
+func (h *Handler) PatternCostHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	if r.Header.Get("Content-Type") == "application/json" {
+		body, err = yaml.JSONToYAML(body)
+		if err != nil {
+			h.log.Error(ErrPatternFile(err))
+			http.Error(rw, ErrPatternFile(err).Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	patternFile, err := core.NewPatternFile(body)
+	if err != nil {
+		h.log.Error(ErrPatternFile(err))
+		http.Error(rw, ErrPatternFile(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := setVariableValues(&patternFile, r); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := _processPattern(
+		r.Context(),
+		provider,
+		patternFile,
+		prefObj,
+		user.ID,
+		patternDeployOptions{
+			verify:  true,
+			pricing: h.config.Pricing,
+			skipCRD: r.URL.Query().Get("skipCRD") == "true",
+		},
+		nil,
+		h.registryManager,
+		h.config.EventBroadcaster,
+		h.log,
+	)
+	if err != nil {
+		err := ErrCompConfigPairs(err)
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	estimate, ok := response["cost"].(core.CostEstimate)
+	if !ok {
+		estimate = core.CostEstimate{Components: []core.ComponentCost{}}
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(estimate)
+}
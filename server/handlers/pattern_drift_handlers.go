@@ -0,0 +1,327 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshkit/models/events"
+	"gopkg.in/yaml.v2"
+)
+
+// swagger:route GET /api/pattern/drift PatternsAPI idGetPatternDrift
+// Handle GET request for the drift of the deployed designs
+//
+// Returns the designs of the user deployed to Kubernetes contexts, with the resources which were changed or deleted
+// outside of Meshery since the design was deployed, as found by the last check. Deployed designs are checked in the
+// background, and deployed again when their drift is detected if the driftAutoReconcile preference of the user is set.
+// Only the drifted designs are returned with ```?drifted=true```.
+// responses:
+// 	200: deployedPatternsResponseWrapper
+
+// GetPatternDriftHandler returns the deployed designs of the user and their drift
+func (h *Handler) GetPatternDriftHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	drifted := r.URL.Query().Get("drifted") == "true"
+	deployed, err := (&models.DeployedPatternPersister{DB: h.dbHandler}).GetDeployedPatterns(user.ID, drifted)
+	if err != nil {
+		h.log.Error(ErrPatternDrift(err))
+		http.Error(rw, ErrPatternDrift(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(deployed)
+}
+
+// swagger:route POST /api/pattern/drift/{id}/check PatternsAPI idCheckPatternDrift
+// Handle POST request for checking the drift of a deployed design
+//
+// Compares the deployed design with the resources of its Kubernetes context right away
+// responses:
+// 	200: deployedPatternResponseWrapper
+//	404:
+
+// CheckPatternDriftHandler checks the drift of the deployed design with the given id
+func (h *Handler) CheckPatternDriftHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	deployed, ok := h.getUserDeployedPattern(rw, r, user)
+	if !ok {
+		return
+	}
+	h.checkPatternDrift(r.Context(), deployed, false)
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(deployed)
+}
+
+// swagger:route POST /api/pattern/drift/{id}/reconcile PatternsAPI idReconcilePatternDrift
+// Handle POST request for reconciling a drifted design
+//
+// Deploys the design again to its Kubernetes context, reverting the changes made to its resources and creating the
+// deleted ones
+// responses:
+// 	200: deployedPatternResponseWrapper
+//	404:
+//	500:
+
+// ReconcilePatternDriftHandler deploys the design with the given id again
+func (h *Handler) ReconcilePatternDriftHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	deployed, ok := h.getUserDeployedPattern(rw, r, user)
+	if !ok {
+		return
+	}
+	if err := h.reconcilePatternDrift(r.Context(), deployed); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(deployed)
+}
+
+// getUserDeployedPattern returns the deployed design of the id of the request if it belongs to the user, and writes the error otherwise
+func (h *Handler) getUserDeployedPattern(rw http.ResponseWriter, r *http.Request, user *models.User) (*models.DeployedPattern, bool) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(rw, "invalid deployed design id", http.StatusBadRequest)
+		return nil, false
+	}
+	deployed, err := (&models.DeployedPatternPersister{DB: h.dbHandler}).GetDeployedPattern(id)
+	if err != nil {
+		h.log.Error(ErrPatternDrift(err))
+		http.Error(rw, ErrPatternDrift(err).Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	if deployed == nil || deployed.UserID != user.ID {
+		http.Error(rw, fmt.Sprintf("deployed design %s not found", id), http.StatusNotFound)
+		return nil, false
+	}
+	return deployed, true
+}
+
+// trackDeployedPattern records the design deployed to the Kubernetes contexts of ctx, for its drift to be detected,
+// or forgets it if it was undeployed
+func (h *Handler) trackDeployedPattern(ctx context.Context, provider models.Provider, user *models.User, pattern core.Pattern, isDelete bool) {
+	token, _ := ctx.Value(models.TokenCtxKey).(string)
+	k8scontexts, _ := ctx.Value(models.KubeClustersKey).([]models.K8sContext)
+	patternFile, err := yaml.Marshal(pattern)
+	if err != nil {
+		h.log.Error(ErrPatternDrift(err))
+		return
+	}
+
+	persister := &models.DeployedPatternPersister{DB: h.dbHandler}
+	for _, k8sctx := range k8scontexts {
+		deployed, err := persister.FindDeployedPattern(user.ID, k8sctx.ID, pattern.PatternID, pattern.Name)
+		if err != nil {
+			h.log.Error(ErrPatternDrift(err))
+			continue
+		}
+		if isDelete {
+			if deployed != nil {
+				if err := persister.DeleteDeployedPattern(deployed.ID); err != nil {
+					h.log.Error(ErrPatternDrift(err))
+				}
+			}
+			continue
+		}
+		if deployed == nil {
+			deployed = &models.DeployedPattern{
+				UserID:    user.ID,
+				PatternID: pattern.PatternID,
+				ContextID: k8sctx.ID,
+			}
+		}
+		deployed.Name = pattern.Name
+		deployed.PatternFile = string(patternFile)
+		deployed.UserName = user.UserID
+		deployed.ProviderName = provider.Name()
+		deployed.Token = token
+		deployed.Drift = ""
+		deployed.Drifted = false
+		deployed.LastCheckError = ""
+		if err := persister.SaveDeployedPattern(deployed); err != nil {
+			h.log.Error(ErrPatternDrift(err))
+		}
+	}
+}
+
+// RunPatternDriftDetection enqueues at every interval the job checking the drift of the deployed designs until the context is done.
+// The check is skipped when the previous one is still queued or running.
+func (h *Handler) RunPatternDriftDetection(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := h.config.JobRunner.Enqueue(models.JobTypePatternDrift, "", nil, models.JobOptions{MaxAttempts: 1, Unique: true}); err != nil {
+			h.log.Error(ErrPatternDrift(err))
+		}
+	}
+}
+
+// runPatternDriftJob checks the drift of all the deployed designs, and returns the number of designs checked
+func (h *Handler) runPatternDriftJob(ctx context.Context, _ *models.Job) (interface{}, error) {
+	deployed, err := (&models.DeployedPatternPersister{DB: h.dbHandler}).GetAllDeployedPatterns()
+	if err != nil {
+		return nil, ErrPatternDrift(err)
+	}
+	for i := range deployed {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		h.checkPatternDrift(ctx, &deployed[i], true)
+	}
+	return map[string]int{"checked": len(deployed)}, nil
+}
+
+// checkPatternDrift compares the deployed design with the resources of its Kubernetes context and stores its drift.
+// When reconcile is true, designs which drifted are deployed again if their user prefers so.
+func (h *Handler) checkPatternDrift(ctx context.Context, deployed *models.DeployedPattern, reconcile bool) {
+	wasDrifted := deployed.Drifted
+	changes, prefObj, err := h.patternDrift(ctx, deployed)
+	now := time.Now()
+	deployed.LastCheckedAt = &now
+	deployed.LastCheckError = ""
+	if err != nil {
+		deployed.LastCheckError = err.Error()
+	} else {
+		drift, _ := json.Marshal(changes)
+		deployed.Drift = string(drift)
+		deployed.Drifted = len(changes) > 0
+	}
+	if err := (&models.DeployedPatternPersister{DB: h.dbHandler}).SaveDeployedPattern(deployed); err != nil {
+		h.log.Error(ErrPatternDrift(err))
+	}
+	if err != nil || !deployed.Drifted {
+		return
+	}
+
+	if !wasDrifted {
+		userID := uuid.FromStringOrNil(deployed.UserID)
+		event := events.NewEvent().ActedUpon(deployed.ID).FromUser(userID).FromSystem(*h.SystemID).WithCategory("pattern").WithAction("drift").
+			WithSeverity(events.Warning).WithDescription(fmt.Sprintf("%d resources of design '%s' drifted from the design", len(changes), deployed.Name)).
+			WithMetadata(map[string]interface{}{
+				"deployedPatternID": deployed.ID,
+				"contextID":         deployed.ContextID,
+				"drift":             changes,
+			}).Build()
+		if provider, ok := h.config.Providers[deployed.ProviderName]; ok {
+			_ = provider.PersistEvent(event)
+		}
+		go h.config.EventBroadcaster.Publish(userID, event)
+	}
+	if reconcile && prefObj != nil && prefObj.DriftAutoReconcile {
+		if err := h.reconcilePatternDrift(ctx, deployed); err != nil {
+			h.log.Error(err)
+		}
+	}
+}
+
+// patternDrift returns the resources of the Kubernetes context of the deployed design which differ from its components,
+// along with the preferences of its user
+func (h *Handler) patternDrift(ctx context.Context, deployed *models.DeployedPattern) ([]core.ResourceChange, *models.Preference, error) {
+	ctx, provider, user, prefObj, err := h.sessionlessContext(ctx, deployed.ProviderName, deployed.UserID, deployed.UserName, deployed.Token, []string{deployed.ContextID})
+	if err != nil {
+		return nil, nil, err
+	}
+	if k8scontexts, _ := ctx.Value(models.KubeClustersKey).([]models.K8sContext); len(k8scontexts) == 0 {
+		return nil, prefObj, fmt.Errorf("kubernetes context %s is not connected", deployed.ContextID)
+	}
+	pattern, err := core.NewPatternFile([]byte(deployed.PatternFile))
+	if err != nil {
+		return nil, prefObj, ErrPatternFile(err)
+	}
+
+	// the components are compared with the resources without deploying them
+	response, err := _processPattern(
+		ctx,
+		provider,
+		pattern,
+		prefObj,
+		user.ID,
+		patternDeployOptions{
+			verify:        true,
+			diff:          true,
+			skipCRD:       true,
+			skipPrintLogs: true,
+		},
+		nil,
+		h.registryManager,
+		nil,
+		h.log,
+	)
+	if err != nil {
+		return nil, prefObj, ErrPatternDrift(err)
+	}
+	all, _ := response["changeset"].([]core.ResourceChange)
+	changes := []core.ResourceChange{}
+	for _, change := range all {
+		if change.Operation != core.ResourceUnchanged {
+			changes = append(changes, change)
+		}
+	}
+	return changes, prefObj, nil
+}
+
+// reconcilePatternDrift deploys the deployed design again to its Kubernetes context
+func (h *Handler) reconcilePatternDrift(ctx context.Context, deployed *models.DeployedPattern) error {
+	ctx, provider, user, prefObj, err := h.sessionlessContext(ctx, deployed.ProviderName, deployed.UserID, deployed.UserName, deployed.Token, []string{deployed.ContextID})
+	if err != nil {
+		return ErrPatternDrift(err)
+	}
+	if k8scontexts, _ := ctx.Value(models.KubeClustersKey).([]models.K8sContext); len(k8scontexts) == 0 {
+		return ErrPatternDrift(fmt.Errorf("kubernetes context %s is not connected", deployed.ContextID))
+	}
+	pattern, err := core.NewPatternFile([]byte(deployed.PatternFile))
+	if err != nil {
+		return ErrPatternFile(err)
+	}
+
+	response, err := h.deployPatternToCluster(ctx, prefObj, user, provider, pattern, patternDeployOptions{imageScan: h.config.ImageScanPolicy})
+	userID := uuid.FromStringOrNil(user.ID)
+	eventBuilder := events.NewEvent().ActedUpon(deployed.ID).FromUser(userID).FromSystem(*h.SystemID).WithCategory("pattern").WithAction("reconcile")
+	if err != nil {
+		event := eventBuilder.WithSeverity(events.Error).WithDescription(fmt.Sprintf("Reconciliation of design '%s' failed", deployed.Name)).
+			WithMetadata(map[string]interface{}{"error": err}).Build()
+		h.publishEvent(provider, userID, event)
+		return err
+	}
+	event := eventBuilder.WithSeverity(events.Informational).WithDescription(fmt.Sprintf("Reconciled the drifted resources of design '%s'", deployed.Name)).
+		WithMetadata(map[string]interface{}{"summary": response}).Build()
+	h.publishEvent(provider, userID, event)
+
+	// the deployment tracked the design again, clearing its drift
+	now := time.Now()
+	deployed.LastReconciledAt = &now
+	deployed.Drift = ""
+	deployed.Drifted = false
+	if err := (&models.DeployedPatternPersister{DB: h.dbHandler}).SaveDeployedPattern(deployed); err != nil {
+		h.log.Error(ErrPatternDrift(err))
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+)
+
+func TestPatternDriftHandlersScoping(t *testing.T) {
+	db, err := database.New(database.Options{Filename: filepath.Join(t.TempDir(), "mesherydb.sql"), Engine: database.SQLITE})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&models.DeployedPattern{}); err != nil {
+		t.Fatal(err)
+	}
+	log, err := logger.New("meshery-test", logger.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{log: log, dbHandler: &db}
+	persister := &models.DeployedPatternPersister{DB: &db}
+	alice, bob := &models.User{ID: "alice"}, &models.User{ID: "bob"}
+	deployed := &models.DeployedPattern{UserID: alice.ID, Name: "web", ContextID: "c1", Drifted: true, Token: "secret"}
+	if err := persister.SaveDeployedPattern(deployed); err != nil {
+		t.Fatal(err)
+	}
+
+	for user, want := range map[*models.User]int{alice: 1, bob: 0} {
+		rw := httptest.NewRecorder()
+		h.GetPatternDriftHandler(rw, httptest.NewRequest(http.MethodGet, "/api/pattern/drift?drifted=true", nil), nil, user, nil)
+		var got []models.DeployedPattern
+		if err := json.Unmarshal(rw.Body.Bytes(), &got); err != nil {
+			t.Fatalf("the response %q is not a list of deployed designs: %v", rw.Body.String(), err)
+		}
+		if len(got) != want {
+			t.Errorf("%d deployed designs are listed to %s, want %d", len(got), user.ID, want)
+		}
+	}
+
+	handlers := map[string]func(http.ResponseWriter, *http.Request, *models.Preference, *models.User, models.Provider){
+		"check":     h.CheckPatternDriftHandler,
+		"reconcile": h.ReconcilePatternDriftHandler,
+	}
+	for name, handler := range handlers {
+		rw := httptest.NewRecorder()
+		r := mux.SetURLVars(httptest.NewRequest(http.MethodPost, "/api/pattern/drift/"+deployed.ID.String()+"/"+name, nil), map[string]string{"id": deployed.ID.String()})
+		handler(rw, r, nil, bob, nil)
+		if rw.Code != http.StatusNotFound {
+			t.Errorf("the %s of the design of alice by bob = %d, want 404", name, rw.Code)
+		}
+
+		rw = httptest.NewRecorder()
+		r = mux.SetURLVars(httptest.NewRequest(http.MethodPost, "/api/pattern/drift/invalid/"+name, nil), map[string]string{"id": "invalid"})
+		handler(rw, r, nil, alice, nil)
+		if rw.Code != http.StatusBadRequest {
+			t.Errorf("the %s of an invalid id = %d, want 400", name, rw.Code)
+		}
+	}
+}
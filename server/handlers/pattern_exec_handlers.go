@@ -0,0 +1,246 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/pattern/patterns/k8s"
+	"github.com/layer5io/meshkit/models/events"
+)
+
+// the command run in the container when none is given
+var defaultExecCommand = []string{"sh"}
+
+// the origin of the browsers opening terminal sessions must be the one of Meshery Server, the origin of other
+// clients, eg: mesheryctl, is not checked
+var execUpgrader = websocket.Upgrader{
+	ReadBufferSize:  4096,
+	WriteBufferSize: 4096,
+}
+
+// maximum size of the messages sent by the clients of terminal sessions
+const execMaxMessageSize = 64 * 1024
+
+// terminal session messages
+const (
+	terminalResize = "resize"
+	terminalExit   = "exit"
+)
+
+// terminalMessage is a control message of a terminal session, sent as a text message of the WebSocket.
+// The input and the output of the terminal are sent as binary messages.
+type terminalMessage struct {
+	Type string `json:"type"`
+	// Cols and Rows are the size of the terminal of a resize message
+	Cols uint16 `json:"cols,omitempty"`
+	Rows uint16 `json:"rows,omitempty"`
+	// Code is the exit code of the command of an exit message, -1 if the command did not exit
+	Code  int    `json:"code"`
+	Error string `json:"error,omitempty"`
+}
+
+// swagger:route GET /api/pattern/deployed/{id}/exec PatternsAPI idExecDeployedPattern
+// Handle GET request for an interactive terminal session in a container of a deployed design
+//
+// Upgrades the connection to a WebSocket streaming a terminal running a command in a container of a pod of the deployed
+// design, eg: to debug its workloads. The pod and namespace query parameters select the pod, which must be a resource of
+// the design or be controlled by one, the container query parameter its container, the default container of the pod if
+// it is omitted, and the repeated command query parameter the command, sh if it is omitted.
+// The credentials of the Kubernetes context of the design must allow creating the exec subresource of the pod.
+//
+// The client sends the input of the terminal as binary messages and its size as {"type": "resize", "cols": 80, "rows": 24}
+// text messages, it receives the output of the terminal as binary messages and, once the command ended,
+// an {"type": "exit", "code": 0} text message before the WebSocket is closed.
+// responses:
+//
+//	101:
+//	400:
+//	403:
+//	404:
+//	500:
+func (h *Handler) ExecDeployedPatternHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	deployed, ok := h.getUserDeployedPattern(rw, r, user)
+	if !ok {
+		return
+	}
+	query := r.URL.Query()
+	namespace, pod := query.Get("namespace"), query.Get("pod")
+	if pod == "" {
+		http.Error(rw, "the pod query parameter is required", http.StatusBadRequest)
+		return
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+	command := query["command"]
+	if len(command) == 0 {
+		command = defaultExecCommand
+	}
+
+	token, _ := r.Context().Value(models.TokenCtxKey).(string)
+	k8sctx, err := provider.GetK8sContext(token, deployed.ContextID)
+	if err != nil {
+		h.log.Error(ErrExecDeployedPattern(err))
+		http.Error(rw, ErrExecDeployedPattern(err).Error(), http.StatusNotFound)
+		return
+	}
+	client, err := k8sctx.GenerateKubeHandler()
+	if err != nil {
+		h.log.Error(ErrExecDeployedPattern(err))
+		http.Error(rw, ErrExecDeployedPattern(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	allowed, reason, err := k8s.CanExec(r.Context(), client, namespace, pod)
+	if err != nil {
+		h.log.Error(ErrExecDeployedPattern(err))
+		http.Error(rw, ErrExecDeployedPattern(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if !allowed {
+		http.Error(rw, fmt.Sprintf("the credentials of the Kubernetes context do not allow running commands in pod %s/%s: %s", namespace, pod, reason), http.StatusForbidden)
+		return
+	}
+	isDesignPod, err := k8s.IsDesignPod(r.Context(), client, deployed.PatternID, namespace, pod)
+	if err != nil {
+		h.log.Error(ErrExecDeployedPattern(err))
+		http.Error(rw, ErrExecDeployedPattern(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if !isDesignPod {
+		http.Error(rw, fmt.Sprintf("pod %s/%s of deployed design %s not found", namespace, pod, deployed.ID), http.StatusNotFound)
+		return
+	}
+
+	conn, err := execUpgrader.Upgrade(rw, r, nil)
+	if err != nil {
+		// the upgrader already replied with the error
+		h.log.Error(ErrExecDeployedPattern(err))
+		return
+	}
+	defer conn.Close()
+	conn.SetReadLimit(execMaxMessageSize)
+
+	userID := uuid.FromStringOrNil(user.ID)
+	event := events.NewEvent().ActedUpon(deployed.ID).FromUser(userID).FromSystem(*h.SystemID).WithCategory("pattern").WithAction("exec").
+		WithSeverity(events.Informational).WithDescription(fmt.Sprintf("Terminal session started in pod %s/%s of design '%s'", namespace, pod, deployed.Name)).
+		WithMetadata(map[string]interface{}{
+			"deployedPatternID": deployed.ID,
+			"contextID":         deployed.ContextID,
+			"namespace":         namespace,
+			"pod":               pod,
+			"container":         query.Get("container"),
+			"command":           command,
+		}).Build()
+	h.publishEvent(provider, userID, event)
+
+	// the request context is not cancelled when the client of the hijacked connection goes away, the session is
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	session := newTerminalSession(conn, cancel)
+	go session.readMessages()
+
+	err = k8s.Exec(ctx, client, k8s.ExecOptions{
+		Namespace: namespace,
+		Pod:       pod,
+		Container: query.Get("container"),
+		Command:   command,
+		Stdin:     session.stdin,
+		Stdout:    session,
+		Resize:    session.resize,
+	})
+	exit := terminalMessage{Type: terminalExit, Code: k8s.ExitCode(err)}
+	if err != nil && exit.Code == -1 && ctx.Err() == nil {
+		exit.Error = err.Error()
+		h.log.Error(ErrExecDeployedPattern(err))
+	}
+	session.close(exit)
+}
+
+// terminalSession bridges the WebSocket of a terminal session with the streams of the command running in the container
+type terminalSession struct {
+	conn *websocket.Conn
+	// cancel stops the command when the client goes away
+	cancel context.CancelFunc
+
+	stdin      *io.PipeReader
+	stdinPipe  *io.PipeWriter
+	resize     chan k8s.TerminalSize
+	writeMutex sync.Mutex
+}
+
+func newTerminalSession(conn *websocket.Conn, cancel context.CancelFunc) *terminalSession {
+	stdin, stdinPipe := io.Pipe()
+	return &terminalSession{
+		conn:      conn,
+		cancel:    cancel,
+		stdin:     stdin,
+		stdinPipe: stdinPipe,
+		resize:    make(chan k8s.TerminalSize, 1),
+	}
+}
+
+// readMessages passes the input and the sizes of the terminal sent by the client to the command, until the client goes away
+func (ts *terminalSession) readMessages() {
+	defer func() {
+		_ = ts.stdinPipe.Close()
+		close(ts.resize)
+		ts.cancel()
+	}()
+	for {
+		messageType, data, err := ts.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		if messageType == websocket.BinaryMessage {
+			if _, err := ts.stdinPipe.Write(data); err != nil {
+				return
+			}
+			continue
+		}
+		var msg terminalMessage
+		if err := json.Unmarshal(data, &msg); err != nil || msg.Type != terminalResize {
+			continue
+		}
+		size := k8s.TerminalSize{Width: msg.Cols, Height: msg.Rows}
+		// only the last size matters, the previous one is dropped if the command did not take it yet
+		select {
+		case <-ts.resize:
+		default:
+		}
+		ts.resize <- size
+	}
+}
+
+// Write sends the output of the terminal to the client
+func (ts *terminalSession) Write(p []byte) (int, error) {
+	ts.writeMutex.Lock()
+	defer ts.writeMutex.Unlock()
+	if err := ts.conn.WriteMessage(websocket.BinaryMessage, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// close sends the exit message to the client and closes the WebSocket
+func (ts *terminalSession) close(exit terminalMessage) {
+	ts.writeMutex.Lock()
+	defer ts.writeMutex.Unlock()
+	if data, err := json.Marshal(exit); err == nil {
+		_ = ts.conn.WriteMessage(websocket.TextMessage, data)
+	}
+	_ = ts.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+}
@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ghodss/yaml"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/imagescan"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+// swagger:route POST /api/pattern/image-scan PatternsAPI idPostPatternImageScan
+// Handle POST request for scanning the container images of a pattern
+//
+// Scans the container images of the workloads of the attached pattern for vulnerabilities with the scanner IMAGE_SCANNER
+// names, trivy or grype, and generates their SBOMs unless IMAGE_SCAN_SBOM is false, without deploying the pattern.
+// The response lists under ```violations``` the reasons the deployment of the pattern would fail with the severity
+// threshold IMAGE_SCAN_FAIL_ON sets. The reports are attached to the saved pattern the attached one is a copy of.
+// When IMAGE_SCANNER is set, the images of patterns are scanned this way every time they are deployed.
+// responses:
+// 	200: imageScanResponseWrapper
+// 	501:
+
+// ScanPatternImagesHandler scans the container images of a pattern for vulnerabilities
+func (h *Handler) ScanPatternImagesHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	if h.config.ImageScanPolicy == nil {
+		http.Error(rw, "no image scanner is configured, set IMAGE_SCANNER to trivy or grype", http.StatusNotImplemented)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	if r.Header.Get("Content-Type") == "application/json" {
+		body, err = yaml.JSONToYAML(body)
+		if err != nil {
+			h.log.Error(ErrPatternFile(err))
+			http.Error(rw, ErrPatternFile(err).Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	patternFile, err := core.NewPatternFile(body)
+	if err != nil {
+		h.log.Error(ErrPatternFile(err))
+		http.Error(rw, ErrPatternFile(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := setVariableValues(&patternFile, r); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := _processPattern(
+		r.Context(),
+		provider,
+		patternFile,
+		prefObj,
+		user.ID,
+		patternDeployOptions{
+			verify:    true,
+			imageScan: h.config.ImageScanPolicy,
+			skipCRD:   r.URL.Query().Get("skipCRD") == "true",
+		},
+		nil,
+		h.registryManager,
+		h.config.EventBroadcaster,
+		h.log,
+	)
+	if err != nil {
+		err := ErrCompConfigPairs(err)
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h.attachImageScans(patternFile, response)
+
+	result, ok := response["imageScan"].(imagescan.Result)
+	if !ok {
+		result = imagescan.Result{Reports: []imagescan.Report{}}
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(result)
+}
+
+// swagger:route GET /api/pattern/{id}/image-scans PatternsAPI idGetPatternImageScans
+// Handle GET request for the scans of the container images of a design
+//
+// Returns the latest scan of every container image of the saved design with the ID, from its deployments or from
+// ```/api/pattern/image-scan```. The SBOMs of the images are returned by ```/api/pattern/{id}/image-scans/sbom```.
+// responses:
+// 	200: patternImageScansResponseWrapper
+
+// GetPatternImageScansHandler returns the scans of the container images of a saved design
+func (h *Handler) GetPatternImageScansHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	provider models.Provider,
+) {
+	patternID := mux.Vars(r)["id"]
+	if _, err := provider.GetMesheryPattern(r, patternID); err != nil {
+		h.log.Error(ErrFetchPattern(err))
+		http.Error(rw, ErrFetchPattern(err).Error(), http.StatusNotFound)
+		return
+	}
+	scans, err := (&models.PatternImageScanPersister{DB: h.dbHandler}).GetPatternImageScans(patternID)
+	if err != nil {
+		h.log.Error(ErrFetchPattern(err))
+		http.Error(rw, ErrFetchPattern(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(scans)
+}
+
+// swagger:route GET /api/pattern/{id}/image-scans/sbom PatternsAPI idGetPatternImageSBOM
+// Handle GET request for the SBOM of a container image of a design
+//
+// Returns the CycloneDX JSON SBOM of the container image of the saved design with the ID given by the ```image``` query
+// parameter, as generated by its latest scan.
+// responses:
+// 	200:
+// 	404:
+
+// GetPatternImageSBOMHandler returns the SBOM of a container image of a saved design
+func (h *Handler) GetPatternImageSBOMHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	provider models.Provider,
+) {
+	patternID := mux.Vars(r)["id"]
+	image := r.URL.Query().Get("image")
+	if _, err := provider.GetMesheryPattern(r, patternID); err != nil {
+		h.log.Error(ErrFetchPattern(err))
+		http.Error(rw, ErrFetchPattern(err).Error(), http.StatusNotFound)
+		return
+	}
+	scan, err := (&models.PatternImageScanPersister{DB: h.dbHandler}).GetPatternImageScan(patternID, image)
+	if err != nil {
+		h.log.Error(ErrFetchPattern(err))
+		http.Error(rw, ErrFetchPattern(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if scan == nil || scan.SBOM == "" {
+		http.Error(rw, fmt.Sprintf("no SBOM of the image %s of the design", image), http.StatusNotFound)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/vnd.cyclonedx+json")
+	_, _ = rw.Write([]byte(scan.SBOM))
+}
+
+// attachImageScans attaches the reports of the scan of the images of the design, run by its deployment, to the saved design
+func (h *Handler) attachImageScans(pattern core.Pattern, response map[string]interface{}) {
+	result, ok := response["imageScan"].(imagescan.Result)
+	if !ok || pattern.PatternID == "" {
+		return
+	}
+	if err := (&models.PatternImageScanPersister{DB: h.dbHandler}).SavePatternImageScans(pattern.PatternID, result.Reports); err != nil {
+		h.log.Error(ErrSavePattern(err))
+	}
+}
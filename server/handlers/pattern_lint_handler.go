@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"io/fs"
+	"net/http"
+
+	"github.com/layer5io/meshery/server/meshmodel/kubernetes"
+	"github.com/layer5io/meshery/server/models"
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshery/server/models/pattern/resource/selector"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+// swagger:route POST /api/pattern/lint PatternLint idPostPatternLint
+// Handle POST request for linting a design without deploying it.
+//
+// Runs against the design file of the request body the validations run before deploying designs: the settings of every
+// component are validated against the schema of its registered definition, the relationships the design declares are
+// checked against the registered relationships, and the design is evaluated against the relationship and the validation
+// policies shipped with Meshery. The mistakes are reported as errors, the suggestions of the relationship policies as warnings.
+// Only the relationships visible to the organization of the user, the global ones and those of the organization, are checked.
+// responses:
+//
+//	200: patternLintResponseWrapper
+//	400:
+//	401:
+//	500:
+func (h *Handler) PatternLintHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	_ models.Provider,
+) {
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	pattern, err := core.NewPatternFile(body)
+	if err != nil {
+		h.log.Error(ErrDecoding(err, "design file"))
+		http.Error(rw, ErrDecoding(err, "design file").Error(), http.StatusBadRequest)
+		return
+	}
+
+	orgID, err := h.getRequestOrgID(r)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	entities, _, _ := h.getRegistryEntities(r.Context(), &mesherymeshmodel.OrgRelationshipFilter{Org: orgID})
+	rels := make([]v1alpha1.RelationshipDefinition, 0, len(entities))
+	for _, entity := range entities {
+		if rel, ok := entity.(v1alpha1.RelationshipDefinition); ok {
+			rels = append(rels, rel)
+		}
+	}
+	policies, err := designPolicyEngine()
+	if err != nil {
+		h.log.Error(ErrPatternLint(err))
+		http.Error(rw, ErrPatternLint(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	linter := mesherymeshmodel.DesignLinter{
+		Component:     selector.New(h.registryManager, nil).Workload,
+		Relationships: rels,
+		Policies:      policies,
+	}
+	diagnostics, err := linter.Lint(r.Context(), pattern)
+	if err != nil {
+		h.log.Error(ErrPatternLint(err))
+		http.Error(rw, ErrPatternLint(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := models.PatternLintResponse{Diagnostics: diagnostics}
+	for _, d := range diagnostics {
+		if d.Severity == mesherymeshmodel.DesignLintError {
+			response.Errors++
+		} else {
+			response.Warnings++
+		}
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(response); err != nil {
+		h.log.Error(models.ErrEncoding(err, "pattern lint response"))
+		http.Error(rw, models.ErrEncoding(err, "pattern lint response").Error(), http.StatusInternalServerError)
+	}
+}
+
+// designPolicyEngine loads the policies embedded with the Kubernetes model, the ones mesheryctl evaluates designs against
+func designPolicyEngine() (*mesherymeshmodel.DesignPolicyEngine, error) {
+	policies, err := fs.Sub(kubernetes.Policies, "policies")
+	if err != nil {
+		return nil, err
+	}
+	relationships, err := fs.Sub(kubernetes.Relationships, "relationships")
+	if err != nil {
+		return nil, err
+	}
+	return mesherymeshmodel.NewDesignPolicyEngine(policies, relationships)
+}
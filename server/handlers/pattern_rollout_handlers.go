@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshery/server/models/pattern/patterns/k8s"
+	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
+)
+
+// The live resources of the components of a design are checked this often while its rollout is watched
+const patternRolloutPollInterval = 2 * time.Second
+
+// swagger:route POST /api/pattern/rollout PatternsAPI idPostPatternRollout
+// Handle POST request for watching the rollout of a deployed pattern
+//
+// Streams the status of the rollout of the resources of the components of the attached pattern in the clusters as
+// Server-Sent Events, like kubectl rollout status: deployments, statefulsets and daemonsets are ready once their
+// replicas are updated and available, pods once their containers are ready, jobs once they complete, and the
+// resources of the other kinds once they exist. An event is sent whenever the status of a component changes, and
+// the stream ends once all the components are ready or one of them failed, eg: its containers cannot pull their image.
+// responses:
+// 	200: patternRolloutResponseWrapper
+
+// PatternRolloutHandler streams the status of the rollout of a deployed pattern until it converges or fails
+func (h *Handler) PatternRolloutHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	flusher, ok := rw.(http.Flusher)
+	if !ok {
+		h.log.Error(ErrEventStreamingNotSupported)
+		http.Error(rw, "Event streaming is not supported at the moment.", http.StatusInternalServerError)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if r.Header.Get("Content-Type") == "application/json" {
+		body, err = yaml.JSONToYAML(body)
+		if err != nil {
+			h.log.Error(ErrPatternFile(err))
+			http.Error(rw, ErrPatternFile(err).Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	patternFile, err := core.NewPatternFile(body)
+	if err != nil {
+		h.log.Error(ErrPatternFile(err))
+		http.Error(rw, ErrPatternFile(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := setVariableValues(&patternFile, r); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// the resources of the components in every cluster are the ones the diff of the pattern compares them with
+	response, err := _processPattern(
+		r.Context(),
+		provider,
+		patternFile,
+		prefObj,
+		user.ID,
+		patternDeployOptions{
+			verify:        true,
+			diff:          true,
+			skipCRD:       true,
+			skipPrintLogs: true,
+		},
+		nil,
+		h.registryManager,
+		nil,
+		h.log,
+	)
+	if err != nil {
+		err := ErrCompConfigPairs(err)
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	resources, _ := response["changeset"].([]core.ResourceChange)
+
+	clients := map[string]*meshkube.Client{}
+	k8scontexts, _ := r.Context().Value(models.KubeClustersKey).([]models.K8sContext)
+	for _, k8sctx := range k8scontexts {
+		cfg, err := k8sctx.GenerateKubeConfig()
+		if err == nil {
+			clients[k8sctx.ID], err = meshkube.New(cfg)
+		}
+		if err != nil {
+			h.log.Error(ErrPatternRollout(err))
+			http.Error(rw, ErrPatternRollout(err).Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	rw.Header().Set("Content-Type", "text/event-stream")
+	rw.Header().Set("Cache-Control", "no-cache")
+	rw.Header().Set("Connection", "keep-alive")
+	flusher.Flush()
+
+	ticker := time.NewTicker(patternRolloutPollInterval)
+	defer ticker.Stop()
+	var last *core.DesignRollout
+	for {
+		rollout := patternRollout(r.Context(), clients, resources)
+		if last == nil || !reflect.DeepEqual(*last, rollout) {
+			data, err := json.Marshal(rollout)
+			if err != nil {
+				h.log.Error(models.ErrMarshal(err, "pattern rollout"))
+				return
+			}
+			_, _ = fmt.Fprintf(rw, "data: %s\n\n", data)
+			flusher.Flush()
+			last = &rollout
+		}
+		if rollout.Status == core.RolloutReady || rollout.Status == core.RolloutFailed {
+			return
+		}
+		select {
+		case <-ticker.C:
+		case <-r.Context().Done():
+			h.log.Debug("pattern rollout stream closed")
+			return
+		}
+	}
+}
+
+// patternRollout returns the status of the rollout of the resources of the components in their clusters. The resources
+// which could not be read are reported as progressing, the clusters being possibly unreachable for a moment.
+func patternRollout(ctx context.Context, clients map[string]*meshkube.Client, resources []core.ResourceChange) core.DesignRollout {
+	rollout := core.DesignRollout{Components: make([]core.ComponentRollout, 0, len(resources))}
+	for _, resource := range resources {
+		client, ok := clients[resource.ContextID]
+		if !ok {
+			continue
+		}
+		component, err := k8s.Rollout(ctx, client, resource)
+		if err != nil {
+			component.Status = core.RolloutProgressing
+			component.Message = err.Error()
+		}
+		rollout.Components = append(rollout.Components, component)
+	}
+	rollout.Status = core.DesignRolloutStatus(rollout.Components)
+	return rollout
+}
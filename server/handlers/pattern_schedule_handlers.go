@@ -0,0 +1,417 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/cron"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshkit/models/events"
+)
+
+// swagger:route POST /api/pattern/schedules PatternsAPI idCreatePatternSchedule
+// Handle POST request for scheduling the deployment of a design
+//
+// Deploys or undeploys the design on the given Kubernetes contexts every time the cron expression of the schedule matches,
+// eg: "0 20 * * MON-FRI" to deploy it at 8 PM on weekdays. The cron expression is evaluated in the IANA timezone of the
+// schedule, UTC by default. Scheduled runs are made with the session of the user who created or last resumed the schedule.
+// responses:
+// 	201: patternScheduleResponseWrapper
+//	400:
+
+// CreatePatternScheduleHandler schedules the deployment of a design
+func (h *Handler) CreatePatternScheduleHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	var req models.PatternScheduleRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	patternID, err := uuid.FromString(req.PatternID)
+	if err != nil {
+		http.Error(rw, "invalid pattern_id", http.StatusBadRequest)
+		return
+	}
+	if req.Action == "" {
+		req.Action = models.PatternScheduleDeploy
+	}
+	if req.Action != models.PatternScheduleDeploy && req.Action != models.PatternScheduleUndeploy {
+		http.Error(rw, fmt.Sprintf("invalid action %q, expected deploy or undeploy", req.Action), http.StatusBadRequest)
+		return
+	}
+	if req.Timezone == "" {
+		req.Timezone = "UTC"
+	}
+	if _, err := provider.GetMesheryPattern(r, patternID.String()); err != nil {
+		h.log.Error(ErrGetPattern(err))
+		http.Error(rw, ErrGetPattern(err).Error(), http.StatusBadRequest)
+		return
+	}
+	contexts, err := json.Marshal(req.Contexts)
+	if err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	schedule := &models.PatternSchedule{
+		UserID:         user.ID,
+		PatternID:      patternID,
+		Action:         req.Action,
+		CronExpression: req.CronExpression,
+		Timezone:       req.Timezone,
+		ContextIDs:     string(contexts),
+	}
+	if err := setPatternScheduleSession(r, provider, user, schedule); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if err := scheduleNextRun(schedule, time.Now()); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := (&models.PatternSchedulePersister{DB: h.dbHandler}).SavePatternSchedule(schedule); err != nil {
+		h.log.Error(ErrPatternSchedule(err))
+		http.Error(rw, ErrPatternSchedule(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(rw).Encode(schedule)
+}
+
+// swagger:route GET /api/pattern/schedules PatternsAPI idGetPatternSchedules
+// Handle GET request for the schedules of design deployments
+//
+// Returns the schedules of the user, of the design of ?pattern_id= if given, with their next and last runs
+// responses:
+// 	200: patternSchedulesResponseWrapper
+
+// GetPatternSchedulesHandler returns the schedules of the user
+func (h *Handler) GetPatternSchedulesHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	patternID := uuid.FromStringOrNil(r.URL.Query().Get("pattern_id"))
+	schedules, err := (&models.PatternSchedulePersister{DB: h.dbHandler}).GetPatternSchedules(user.ID, patternID)
+	if err != nil {
+		h.log.Error(ErrPatternSchedule(err))
+		http.Error(rw, ErrPatternSchedule(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(schedules)
+}
+
+// swagger:route DELETE /api/pattern/schedules/{id} PatternsAPI idDeletePatternSchedule
+// Handle DELETE request for a schedule of design deployments
+//
+// Deletes the schedule, the resources deployed by its past runs are left as they are
+// responses:
+// 	200: patternScheduleResponseWrapper
+//	404:
+
+// DeletePatternScheduleHandler deletes the schedule with the given id
+func (h *Handler) DeletePatternScheduleHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	schedule, ok := h.getUserPatternSchedule(rw, r, user)
+	if !ok {
+		return
+	}
+	if err := (&models.PatternSchedulePersister{DB: h.dbHandler}).DeletePatternSchedule(schedule.ID); err != nil {
+		h.log.Error(ErrPatternSchedule(err))
+		http.Error(rw, ErrPatternSchedule(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(schedule)
+}
+
+// swagger:route POST /api/pattern/schedules/{id}/pause PatternsAPI idPausePatternSchedule
+// Handle POST request for pausing a schedule of design deployments
+//
+// The design is not deployed by the schedule until it is resumed
+// responses:
+// 	200: patternScheduleResponseWrapper
+//	404:
+
+// PausePatternScheduleHandler pauses the schedule with the given id
+func (h *Handler) PausePatternScheduleHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	schedule, ok := h.getUserPatternSchedule(rw, r, user)
+	if !ok {
+		return
+	}
+	schedule.Paused = true
+	schedule.NextRunAt = nil
+	if err := (&models.PatternSchedulePersister{DB: h.dbHandler}).SavePatternSchedule(schedule); err != nil {
+		h.log.Error(ErrPatternSchedule(err))
+		http.Error(rw, ErrPatternSchedule(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(schedule)
+}
+
+// swagger:route POST /api/pattern/schedules/{id}/resume PatternsAPI idResumePatternSchedule
+// Handle POST request for resuming a paused schedule of design deployments
+//
+// The schedule runs next at the first time its cron expression matches after now, the runs missed while it was paused are
+// skipped. Its later runs are made with the session of the request.
+// responses:
+// 	200: patternScheduleResponseWrapper
+//	404:
+
+// ResumePatternScheduleHandler resumes the schedule with the given id
+func (h *Handler) ResumePatternScheduleHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	schedule, ok := h.getUserPatternSchedule(rw, r, user)
+	if !ok {
+		return
+	}
+	if err := setPatternScheduleSession(r, provider, user, schedule); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	schedule.Paused = false
+	if err := scheduleNextRun(schedule, time.Now()); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := (&models.PatternSchedulePersister{DB: h.dbHandler}).SavePatternSchedule(schedule); err != nil {
+		h.log.Error(ErrPatternSchedule(err))
+		http.Error(rw, ErrPatternSchedule(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(schedule)
+}
+
+// getUserPatternSchedule returns the schedule of the id of the request if it belongs to the user, and writes the error otherwise
+func (h *Handler) getUserPatternSchedule(rw http.ResponseWriter, r *http.Request, user *models.User) (*models.PatternSchedule, bool) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(rw, "invalid schedule id", http.StatusBadRequest)
+		return nil, false
+	}
+	schedule, err := (&models.PatternSchedulePersister{DB: h.dbHandler}).GetPatternSchedule(id)
+	if err != nil {
+		h.log.Error(ErrPatternSchedule(err))
+		http.Error(rw, ErrPatternSchedule(err).Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	if schedule == nil || schedule.UserID != user.ID {
+		http.Error(rw, fmt.Sprintf("schedule %s not found", id), http.StatusNotFound)
+		return nil, false
+	}
+	return schedule, true
+}
+
+// setPatternScheduleSession stores the session of the request in the schedule, for its runs to be made with
+func setPatternScheduleSession(r *http.Request, provider models.Provider, user *models.User, schedule *models.PatternSchedule) error {
+	token, err := provider.GetProviderToken(r)
+	if err != nil {
+		return ErrRetrieveUserToken(err)
+	}
+	schedule.Token = token
+	schedule.UserName = user.UserID
+	schedule.ProviderName = provider.Name()
+	return nil
+}
+
+// scheduleNextRun sets the next run of the schedule to the first time its cron expression matches after the given time
+func scheduleNextRun(schedule *models.PatternSchedule, after time.Time) error {
+	expr, err := cron.Parse(schedule.CronExpression)
+	if err != nil {
+		return err
+	}
+	location, err := time.LoadLocation(schedule.Timezone)
+	if err != nil {
+		return fmt.Errorf("invalid timezone %q: %w", schedule.Timezone, err)
+	}
+	next := expr.Next(after.In(location))
+	if next.IsZero() {
+		return fmt.Errorf("cron expression %q never matches", schedule.CronExpression)
+	}
+	next = next.UTC()
+	schedule.NextRunAt = &next
+	return nil
+}
+
+// RunPatternSchedules runs the schedules due at every interval until the context is done.
+// Runs missed while the server was down are made once when it starts.
+func (h *Handler) RunPatternSchedules(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		h.runDuePatternSchedules(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *Handler) runDuePatternSchedules(ctx context.Context) {
+	persister := &models.PatternSchedulePersister{DB: h.dbHandler}
+	now := time.Now()
+	schedules, err := persister.GetDuePatternSchedules(now)
+	if err != nil {
+		h.log.Error(ErrPatternSchedule(err))
+		return
+	}
+	for i := range schedules {
+		schedule := &schedules[i]
+		// the next run is saved before the deployment, so that a slow run is not started again by the next tick
+		runErr := scheduleNextRun(schedule, now)
+		if runErr != nil {
+			schedule.Paused = true
+			schedule.NextRunAt = nil
+		}
+		schedule.LastRunAt = &now
+		schedule.LastRunStatus = models.PatternDeploymentRunning
+		schedule.LastRunError = ""
+		if err := persister.SavePatternSchedule(schedule); err != nil {
+			h.log.Error(ErrPatternSchedule(err))
+			continue
+		}
+		if runErr != nil {
+			h.recordPatternScheduleRun(schedule, runErr)
+			continue
+		}
+		go func() {
+			h.recordPatternScheduleRun(schedule, h.runPatternSchedule(ctx, schedule))
+		}()
+	}
+}
+
+// runPatternSchedule deploys or undeploys the design of the schedule on its contexts
+func (h *Handler) runPatternSchedule(ctx context.Context, schedule *models.PatternSchedule) error {
+	ctx, provider, user, prefObj, err := h.sessionlessContext(ctx, schedule.ProviderName, schedule.UserID, schedule.UserName, schedule.Token, schedule.Contexts())
+	if err != nil {
+		return err
+	}
+
+	// the remote provider reads the token of the session from its cookie
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/", nil)
+	if err != nil {
+		return err
+	}
+	req.AddCookie(&http.Cookie{Name: "token", Value: schedule.Token})
+	resp, err := provider.GetMesheryPattern(req, schedule.PatternID.String())
+	if err != nil {
+		return ErrGetPattern(err)
+	}
+	var pattern models.MesheryPattern
+	if err := json.Unmarshal(resp, &pattern); err != nil {
+		return ErrDecodePattern(err)
+	}
+	patternFile, err := core.NewPatternFile([]byte(pattern.PatternFile))
+	if err != nil {
+		return ErrPatternFile(err)
+	}
+	patternFile.PatternID = schedule.PatternID.String()
+
+	isDelete := schedule.Action == models.PatternScheduleUndeploy
+	checkpoint, err := h.newDeploymentCheckpoint(patternFile, user.ID, isDelete, false, false)
+	if err != nil {
+		return ErrPatternDeployment(err)
+	}
+	ctx, done, err := h.trackDeployment(ctx, checkpoint)
+	if err != nil {
+		return err
+	}
+	defer done()
+	release, err := h.waitForDeploymentSlot(ctx, provider, user.ID, checkpoint)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	response, err := _processPattern(
+		ctx,
+		provider,
+		patternFile,
+		prefObj,
+		user.ID,
+		patternDeployOptions{
+			isDelete:      isDelete,
+			imageScan:     h.config.ImageScanPolicy,
+			skipPrintLogs: true,
+		},
+		checkpoint,
+		h.registryManager,
+		h.config.EventBroadcaster,
+		h.log,
+	)
+	h.attachImageScans(patternFile, response)
+
+	userID := uuid.FromStringOrNil(user.ID)
+	eventBuilder := events.NewEvent().ActedUpon(schedule.PatternID).FromUser(userID).FromSystem(*h.SystemID).WithCategory("pattern").WithAction("schedule")
+	if err != nil {
+		err = ErrCompConfigPairs(err)
+		event := eventBuilder.WithSeverity(events.Error).WithDescription(fmt.Sprintf("Scheduled %s of design '%s' failed", schedule.Action, patternFile.Name)).WithMetadata(map[string]interface{}{
+			"error":      err,
+			"scheduleID": schedule.ID,
+		}).Build()
+		h.publishEvent(provider, userID, event)
+		return err
+	}
+	h.trackDeployedPattern(ctx, provider, user, patternFile, isDelete)
+
+	event := eventBuilder.WithSeverity(events.Informational).WithDescription(fmt.Sprintf("Scheduled %s of design '%s' completed", schedule.Action, patternFile.Name)).WithMetadata(map[string]interface{}{
+		"summary":    response,
+		"scheduleID": schedule.ID,
+	}).Build()
+	h.publishEvent(provider, userID, event)
+	return nil
+}
+
+// recordPatternScheduleRun stores the outcome of the last run of the schedule
+func (h *Handler) recordPatternScheduleRun(schedule *models.PatternSchedule, runErr error) {
+	schedule.LastRunStatus = models.PatternDeploymentCompleted
+	if runErr != nil {
+		h.log.Error(ErrPatternSchedule(runErr))
+		schedule.LastRunStatus = models.PatternDeploymentFailed
+		schedule.LastRunError = runErr.Error()
+	}
+	// only the outcome of the run is saved, the schedule may have been paused or resumed meanwhile
+	persister := &models.PatternSchedulePersister{DB: h.dbHandler}
+	if err := persister.SetPatternScheduleRunStatus(schedule.ID, schedule.LastRunStatus, schedule.LastRunError); err != nil {
+		h.log.Error(ErrPatternSchedule(err))
+	}
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/ghodss/yaml"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+// swagger:route POST /api/pattern/security PatternsAPI idPostPatternSecurity
+// Handle POST request for analyzing the security posture of a pattern
+//
+// Checks the pods of the workloads of the attached pattern for insecure settings without deploying it: privileged
+// containers, containers sharing the namespaces of the node, hostPath volumes, dangerous capabilities, containers running
+// as root or allowed to escalate their privileges, containers without resource limits and images not pinned to a tag or
+// a digest. The response holds the findings ordered by severity and a score going from 100, for patterns without
+// findings, down to 0. The same analysis is part of the response of the deployment of a pattern, under ```security```.
+// responses:
+// 	200: patternSecurityResponseWrapper
+
+// PatternSecurityHandler returns the insecure settings of the components of a pattern
+func (h *Handler) PatternSecurityHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	if r.Header.Get("Content-Type") == "application/json" {
+		body, err = yaml.JSONToYAML(body)
+		if err != nil {
+			h.log.Error(ErrPatternFile(err))
+			http.Error(rw, ErrPatternFile(err).Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	patternFile, err := core.NewPatternFile(body)
+	if err != nil {
+		h.log.Error(ErrPatternFile(err))
+		http.Error(rw, ErrPatternFile(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := setVariableValues(&patternFile, r); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := _processPattern(
+		r.Context(),
+		provider,
+		patternFile,
+		prefObj,
+		user.ID,
+		patternDeployOptions{
+			verify:  true,
+			skipCRD: r.URL.Query().Get("skipCRD") == "true",
+		},
+		nil,
+		h.registryManager,
+		h.config.EventBroadcaster,
+		h.log,
+	)
+	if err != nil {
+		err := ErrCompConfigPairs(err)
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	report, ok := response["security"].(core.SecurityReport)
+	if !ok {
+		report = core.AnalyzeSecurity(nil)
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(report)
+}
@@ -0,0 +1,274 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+)
+
+// swagger:route GET /api/user/performance/templates PerformanceAPI idGetPerformanceProfileTemplates
+// Handle GET request for the performance profile templates
+//
+// Returns the built-in performance profile templates, seeded by Meshery Server, followed by the templates of the user.
+// The templates are filtered by name with the search query parameter.
+// responses:
+//
+//	200: performanceProfileTemplatesRespWrapper
+//	500:
+func (h *Handler) GetPerformanceProfileTemplatesHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	templates, err := (&models.PerformanceProfileTemplatePersister{DB: h.dbHandler}).GetPerformanceProfileTemplates(user.ID, r.URL.Query().Get("search"))
+	if err != nil {
+		h.log.Error(ErrPerformanceProfileTemplate(err))
+		http.Error(rw, ErrPerformanceProfileTemplate(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(templates); err != nil {
+		h.log.Error(models.ErrEncoding(err, "performance profile templates"))
+		http.Error(rw, models.ErrEncoding(err, "performance profile templates").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route GET /api/user/performance/templates/{id} PerformanceAPI idGetPerformanceProfileTemplate
+// Handle GET request for a performance profile template
+//
+// Returns the built-in template or the template of the user with the given ID.
+// responses:
+//
+//	200: performanceProfileTemplateRespWrapper
+//	400:
+//	404:
+//	500:
+func (h *Handler) GetPerformanceProfileTemplateHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	template, ok := h.performanceProfileTemplate(rw, r, user)
+	if !ok {
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(template); err != nil {
+		h.log.Error(models.ErrEncoding(err, "performance profile template"))
+	}
+}
+
+// swagger:route POST /api/user/performance/templates PerformanceAPI idCreatePerformanceProfileTemplate
+// Handle POST request for creating a performance profile template
+//
+// Creates a template of the user, eg: {"name": "checkout", "load_generator": "fortio", "load_pattern": "constant",
+// "qps": 50, "concurrent_request": 8, "duration": "2m", "slos": [{"metric": "p99", "threshold": 300}]}.
+// The load pattern is constant, sending the requests at the given qps, or max, sending them as fast as the connections
+// allow with a qps of 0. The SLOs are set on the p50, p90, p99, p99.9 and max latencies in milliseconds, on the
+// error_rate in percent, which must be below their threshold, and on the qps, which must be at least its threshold.
+// responses:
+//
+//	201: performanceProfileTemplateRespWrapper
+//	400:
+//	500:
+func (h *Handler) CreatePerformanceProfileTemplateHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	var body models.PerformanceProfileTemplateRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	template := models.PerformanceProfileTemplate{UserID: user.ID}
+	if err := template.Apply(body); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := (&models.PerformanceProfileTemplatePersister{DB: h.dbHandler}).SavePerformanceProfileTemplate(&template); err != nil {
+		h.log.Error(ErrPerformanceProfileTemplate(err))
+		http.Error(rw, ErrPerformanceProfileTemplate(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(rw).Encode(template); err != nil {
+		h.log.Error(models.ErrEncoding(err, "performance profile template"))
+	}
+}
+
+// swagger:route PUT /api/user/performance/templates/{id} PerformanceAPI idUpdatePerformanceProfileTemplate
+// Handle PUT request for updating a performance profile template
+//
+// Replaces the load options and the SLOs of the template of the user with the given ID. The built-in templates are
+// read-only, they are copied by creating a template with their fields. The profiles already created from the template
+// are left as they are.
+// responses:
+//
+//	200: performanceProfileTemplateRespWrapper
+//	400:
+//	403:
+//	404:
+//	500:
+func (h *Handler) UpdatePerformanceProfileTemplateHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	template, ok := h.performanceProfileTemplate(rw, r, user)
+	if !ok {
+		return
+	}
+	if template.Builtin {
+		http.Error(rw, fmt.Sprintf("the built-in template %s is read-only", template.Name), http.StatusForbidden)
+		return
+	}
+	var body models.PerformanceProfileTemplateRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := template.Apply(body); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := (&models.PerformanceProfileTemplatePersister{DB: h.dbHandler}).SavePerformanceProfileTemplate(template); err != nil {
+		h.log.Error(ErrPerformanceProfileTemplate(err))
+		http.Error(rw, ErrPerformanceProfileTemplate(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(template); err != nil {
+		h.log.Error(models.ErrEncoding(err, "performance profile template"))
+	}
+}
+
+// swagger:route DELETE /api/user/performance/templates/{id} PerformanceAPI idDeletePerformanceProfileTemplate
+// Handle DELETE request for deleting a performance profile template
+//
+// Deletes the template of the user with the given ID, the profiles created from it are kept. The built-in templates
+// cannot be deleted.
+// responses:
+//
+//	204:
+//	400:
+//	403:
+//	404:
+//	500:
+func (h *Handler) DeletePerformanceProfileTemplateHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	template, ok := h.performanceProfileTemplate(rw, r, user)
+	if !ok {
+		return
+	}
+	if template.Builtin {
+		http.Error(rw, fmt.Sprintf("the built-in template %s cannot be deleted", template.Name), http.StatusForbidden)
+		return
+	}
+	if _, err := (&models.PerformanceProfileTemplatePersister{DB: h.dbHandler}).DeletePerformanceProfileTemplate(template.ID, user.ID); err != nil {
+		h.log.Error(ErrPerformanceProfileTemplate(err))
+		http.Error(rw, ErrPerformanceProfileTemplate(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// swagger:route POST /api/user/performance/templates/{id}/instantiate PerformanceAPI idInstantiatePerformanceProfileTemplate
+// Handle POST request for creating a performance profile from a template
+//
+// Saves a performance profile testing the given endpoints with the load options of the template with the given ID,
+// eg: {"name": "checkout smoke test", "endpoints": ["http://checkout.shop.svc:8080/health"], "design_id": "..."}.
+// The ID of the template, its load pattern and its SLOs, and the ID of the design the endpoints are served by if any,
+// are kept in the metadata of the profile.
+// responses:
+//
+//	201: performanceProfileResponseWrapper
+//	400:
+//	404:
+//	500:
+func (h *Handler) InstantiatePerformanceProfileTemplateHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	template, ok := h.performanceProfileTemplate(rw, r, user)
+	if !ok {
+		return
+	}
+	var body models.PerformanceProfileTemplateInstance
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	profile, err := template.Instantiate(body)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	token, err := provider.GetProviderToken(r)
+	if err != nil {
+		h.log.Error(ErrRetrieveUserToken(err))
+		http.Error(rw, ErrRetrieveUserToken(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	resp, err := provider.SavePerformanceProfile(token, profile)
+	if err != nil {
+		obj := "performance profile"
+		h.log.Error(ErrFailToSave(err, obj))
+		http.Error(rw, ErrFailToSave(err, obj).Error(), http.StatusInternalServerError)
+		return
+	}
+	if h.config.PerformanceChannel != nil {
+		h.config.PerformanceChannel <- struct{}{}
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusCreated)
+	fmt.Fprint(rw, string(resp))
+}
+
+// performanceProfileTemplate returns the built-in template or the template of the user with the id of the route, the
+// error response is written and false is returned when there is none
+func (h *Handler) performanceProfileTemplate(rw http.ResponseWriter, r *http.Request, user *models.User) (*models.PerformanceProfileTemplate, bool) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("invalid performance profile template id %q", mux.Vars(r)["id"]), http.StatusBadRequest)
+		return nil, false
+	}
+	template, err := (&models.PerformanceProfileTemplatePersister{DB: h.dbHandler}).GetPerformanceProfileTemplate(id, user.ID)
+	if err != nil {
+		h.log.Error(ErrPerformanceProfileTemplate(err))
+		http.Error(rw, ErrPerformanceProfileTemplate(err).Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	if template == nil {
+		http.Error(rw, fmt.Sprintf("performance profile template %s not found", id), http.StatusNotFound)
+		return nil, false
+	}
+	return template, true
+}
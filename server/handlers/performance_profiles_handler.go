@@ -41,6 +41,11 @@ func (h *Handler) SavePerformanceProfileHandler(
 		return
 	}
 
+	if _, err := parsedBody.GetSLOs(); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	j, _ := json.Marshal(parsedBody)
 	h.log.Info("performance profile is ", string(j))
 
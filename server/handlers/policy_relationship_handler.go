@@ -8,6 +8,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/layer5io/meshery/server/models"
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
 	"github.com/layer5io/meshery/server/models/pattern/core"
 	"gopkg.in/yaml.v2"
 
@@ -76,6 +77,70 @@ func (h *Handler) GetRegoPolicyForDesignFile(
 	}
 }
 
+// swagger:route POST /api/pattern/evaluate PatternEvaluateHandler idPostPatternEvaluate
+// Handle POST request for evaluating the relationship policies against a design.
+//
+// The design is sent as the request body, in YAML or JSON. Returns the relationships the policies suggest adding to
+// or removing from the design and the patches they suggest applying to the configuration of its components.
+// The design is neither saved nor modified.
+// responses:
+//
+//	200: patternEvaluationResponseWrapper
+//	400:
+//	503:
+func (h *Handler) PatternEvaluateHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	_ models.Provider,
+) {
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	if h.Rego == nil {
+		h.log.Error(ErrPolicyEngineUnavailable())
+		http.Error(rw, ErrPolicyEngineUnavailable().Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	pattern, err := core.NewPatternFile(body)
+	if err != nil {
+		h.log.Error(ErrDecoding(err, "design file"))
+		http.Error(rw, ErrDecoding(err, "design file").Error(), http.StatusBadRequest)
+		return
+	}
+	for _, svc := range pattern.Services {
+		svc.Settings = core.Format.DePrettify(svc.Settings, false)
+	}
+
+	data, err := yaml.Marshal(pattern)
+	if err != nil {
+		h.log.Error(models.ErrEncoding(err, "design file"))
+		http.Error(rw, models.ErrEncoding(err, "design file").Error(), http.StatusInternalServerError)
+		return
+	}
+	results, err := h.Rego.RegoPolicyHandler("data.meshmodel_policy", data)
+	if err != nil {
+		h.log.Error(ErrResolvingRegoRelationship(err))
+		http.Error(rw, ErrResolvingRegoRelationship(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(mesherymeshmodel.InterpretPolicyEvaluation(pattern, results)); err != nil {
+		h.log.Error(models.ErrEncoding(err, "policy evaluation response"))
+		http.Error(rw, models.ErrEncoding(err, "policy evaluation response").Error(), http.StatusInternalServerError)
+	}
+}
+
 // swagger:route GET /api/meshmodels/models/{model}/policies/{name} GetMeshmodelPoliciesByName idGetMeshmodelPoliciesByName
 // Handle GET request for getting meshmodel policies of a specific model by name.
 //
@@ -116,7 +181,7 @@ func (h *Handler) GetAllMeshmodelPoliciesByName(rw http.ResponseWriter, r *http.
 		page = 1
 	}
 	offset := (page - 1) * limit
-	entities, _, _ := h.registryManager.GetEntities(&v1alpha1.PolicyFilter{
+	entities, _, _ := h.getRegistryEntities(r.Context(), &v1alpha1.PolicyFilter{
 		Kind:      name,
 		ModelName: typ,
 		Greedy:    greedy,
@@ -192,7 +257,7 @@ func (h *Handler) GetAllMeshmodelPolicies(rw http.ResponseWriter, r *http.Reques
 	if page <= 0 {
 		page = 1
 	}
-	entities, _, _ := h.registryManager.GetEntities(&v1alpha1.PolicyFilter{
+	entities, _, _ := h.getRegistryEntities(r.Context(), &v1alpha1.PolicyFilter{
 		ModelName: typ,
 		Greedy:    greedy,
 		Offset:    offset,
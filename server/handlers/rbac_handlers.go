@@ -0,0 +1,359 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/spf13/viper"
+)
+
+// routePermissions are the permissions required by the calls of the routes, by method and path template, the method
+// being * for every method. The calls of the other routes require the view permission if they read, the edit permission
+// otherwise, see requiredPermission.
+var routePermissions = map[string]models.Permission{
+	// the calls of the users on their own preferences, events and API tokens
+	"* /api/user/prefs":                models.ViewPermission,
+	"* /api/user/prefs/perf":           models.ViewPermission,
+	"PUT /api/events/status/bulk":      models.ViewPermission,
+	"PUT /api/events/status/{id}":      models.ViewPermission,
+	"DELETE /api/events/bulk":          models.ViewPermission,
+	"POST /api/events/acknowledge":     models.ViewPermission,
+	"DELETE /api/events/{id}":          models.ViewPermission,
+	"* /api/user/api-tokens":           models.ViewPermission,
+	"DELETE /api/user/api-tokens/{id}": models.ViewPermission,
+	// the GraphQL operations, see requiredPermission for the mutations
+	"* /api/system/graphql/query": models.ViewPermission,
+	"* /api/system/graphql":       models.ViewPermission,
+	// the analyses of the designs and of the models, which modify nothing
+	"POST /api/pattern/diff":                      models.ViewPermission,
+	"POST /api/pattern/cost":                      models.ViewPermission,
+	"POST /api/pattern/security":                  models.ViewPermission,
+	"POST /api/pattern/capabilities":              models.ViewPermission,
+	"POST /api/pattern/evaluate":                  models.ViewPermission,
+	"POST /api/pattern/lint":                      models.ViewPermission,
+	"POST /api/pattern/undeploy/preview":          models.ViewPermission,
+	"POST /api/meshmodels/validate":               models.ViewPermission,
+	"POST /api/meshmodels/relationships/evaluate": models.ViewPermission,
+	"POST /api/meshmodels/relationships/lint":     models.ViewPermission,
+	"POST /api/policies/run_policy":               models.ViewPermission,
+
+	// the calls acting on the clusters
+	"* /api/pattern/deploy":                       models.DeployPermission,
+	"POST /api/pattern/deploy/{id}/resume":        models.DeployPermission,
+	"POST /api/pattern/rollout":                   models.DeployPermission,
+	"POST /api/pattern/schedules":                 models.DeployPermission,
+	"POST /api/pattern/schedules/{id}/resume":     models.DeployPermission,
+	"POST /api/pattern/drift/{id}/reconcile":      models.DeployPermission,
+	"POST /api/pattern/gitops/{id}/sync":          models.DeployPermission,
+	"GET /api/pattern/deployed/{id}/exec":         models.DeployPermission,
+	"* /api/filter/deploy":                        models.DeployPermission,
+	"* /api/application/deploy":                   models.DeployPermission,
+	"POST /api/system/adapter/manage":             models.DeployPermission,
+	"POST /api/system/kubernetes":                 models.DeployPermission,
+	"POST /api/system/kubernetes/contexts":        models.DeployPermission,
+	"POST /api/system/kubernetes/register":        models.DeployPermission,
+	"DELETE /api/system/kubernetes/contexts/{id}": models.DeployPermission,
+	"* /api/perf/profile":                         models.DeployPermission,
+	"GET /api/user/performance/profiles/{id}/run": models.DeployPermission,
+
+	// the policies every registration of a relationship is validated against, and the bundles imported into the registry
+	"POST /api/meshmodels/relationships/policies":          models.ManageSystemPermission,
+	"DELETE /api/meshmodels/relationships/policies/{name}": models.ManageSystemPermission,
+	"POST /api/meshmodels/import":                          models.ManageSystemPermission,
+
+	"GET /api/system/audit":             models.ManageSystemPermission,
+	"GET /api/system/logs":              models.ManageSystemPermission,
+	"GET /api/system/database":          models.ManageSystemPermission,
+	"DELETE /api/system/database/reset": models.ManageSystemPermission,
+
+	"* /api/rbac/bindings":      models.ManageRolesPermission,
+	"* /api/rbac/bindings/{id}": models.ManageRolesPermission,
+}
+
+// requiredPermission returns the permission required by the request. The GraphQL operations require the view
+// permission, the mutations are authorized on their parsed operation by the GraphQL server, see models.Authorize.
+func requiredPermission(r *http.Request) models.Permission {
+	tmpl := r.URL.Path
+	if route := mux.CurrentRoute(r); route != nil {
+		if t, err := route.GetPathTemplate(); err == nil {
+			tmpl = t
+		}
+	}
+	if permission, ok := routePermissions[r.Method+" "+tmpl]; ok {
+		return permission
+	}
+	if permission, ok := routePermissions["* "+tmpl]; ok {
+		return permission
+	}
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return models.ViewPermission
+	}
+	return models.EditPermission
+}
+
+// requestWorkspace returns the workspace the request acts in, AllWorkspaces if it tells none
+func requestWorkspace(r *http.Request) string {
+	if workspace := r.Header.Get(models.WorkspaceHeader); workspace != "" {
+		return workspace
+	}
+	return r.URL.Query().Get("workspace")
+}
+
+// authorize reports whether the user is granted the permission the request requires, see checkPermission, and writes
+// the error response otherwise
+func (h *Handler) authorize(w http.ResponseWriter, r *http.Request, user *models.User) bool {
+	status, err := h.checkPermission(r, user, requiredPermission(r))
+	if err != nil {
+		if status == http.StatusInternalServerError {
+			h.log.Error(err)
+		}
+		http.Error(w, err.Error(), status)
+		return false
+	}
+	return true
+}
+
+// checkPermission returns an error, along with the status of its response, when the role of the user in the workspace
+// of the request, or the scopes of the API token the request is authenticated with if any, do not grant the permission.
+// The roles are not enforced when RBAC_ENABLED is false.
+func (h *Handler) checkPermission(r *http.Request, user *models.User, permission models.Permission) (int, error) {
+	if token, ok := r.Context().Value(models.APITokenCtxKey).(*models.APIToken); ok && !token.Grants(permission) {
+		return http.StatusForbidden, ErrAPITokenScope(permission)
+	}
+	if !viper.GetBool("RBAC_ENABLED") {
+		return http.StatusOK, nil
+	}
+	role, err := h.userRole(user, requestWorkspace(r))
+	if err != nil {
+		return http.StatusInternalServerError, ErrRoleBinding(err)
+	}
+	if !role.Can(permission) {
+		return http.StatusForbidden, ErrPermissionDenied(role, permission)
+	}
+	return http.StatusOK, nil
+}
+
+// authorizer returns the Authorizer of the user of the request, with which the handlers and the GraphQL resolvers check
+// the permissions of the actions the route of the request does not tell
+func (h *Handler) authorizer(r *http.Request, user *models.User) models.Authorizer {
+	return func(permission models.Permission) error {
+		_, err := h.checkPermission(r, user, permission)
+		return err
+	}
+}
+
+// userRole returns the role of the user in the workspace. The users listed in RBAC_ADMINS, by ID or by user name, are
+// admins whatever their bindings, so that Meshery Server cannot be left without admin.
+func (h *Handler) userRole(user *models.User, workspace string) (models.Role, error) {
+	for _, admin := range viper.GetStringSlice("RBAC_ADMINS") {
+		if admin != "" && (admin == user.ID || admin == user.UserID) {
+			return models.AdminRole, nil
+		}
+	}
+	defaultRole, err := models.ParseRole(viper.GetString("RBAC_DEFAULT_ROLE"))
+	if err != nil {
+		h.log.Warn(err)
+		defaultRole = models.ViewerRole
+	}
+	bindings, err := (&models.RoleBindingPersister{DB: h.dbHandler}).GetRoleBindings(user.ID, workspace)
+	if err != nil {
+		return "", err
+	}
+	return models.EffectiveRole(user, bindings, workspace, defaultRole), nil
+}
+
+// swagger:route GET /api/user/permissions UserAPI idGetUserPermissions
+// Handle GET request for the permissions of the user
+//
+// Returns the role of the user in the workspace of the workspace query parameter, or of the X-Meshery-Workspace header,
+// and the permissions it grants, so that the UI hides the actions the user is not allowed to take. The role is the highest
+// of the roles bound to the user in the workspace and in every workspace, and of the roles given by the provider.
+// responses:
+//
+//	200: userPermissionsRespWrapper
+//	500:
+func (h *Handler) GetUserPermissionsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	workspace := requestWorkspace(r)
+	permissions := models.UserPermissions{
+		UserID:    user.ID,
+		Workspace: workspace,
+		Role:      models.AdminRole,
+		Enforced:  viper.GetBool("RBAC_ENABLED"),
+	}
+	if permissions.Enforced {
+		role, err := h.userRole(user, workspace)
+		if err != nil {
+			h.log.Error(ErrRoleBinding(err))
+			http.Error(rw, ErrRoleBinding(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		permissions.Role = role
+	}
+	permissions.Permissions = permissions.Role.Permissions()
+	// the permissions of the calls made with an API token are restricted to its scopes
+	if token, ok := r.Context().Value(models.APITokenCtxKey).(*models.APIToken); ok {
+		scoped := []models.Permission{}
+		for _, p := range permissions.Permissions {
+			if token.Grants(p) {
+				scoped = append(scoped, p)
+			}
+		}
+		permissions.Permissions = scoped
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(permissions); err != nil {
+		h.log.Error(models.ErrEncoding(err, "user permissions"))
+		http.Error(rw, models.ErrEncoding(err, "user permissions").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route GET /api/rbac/roles RBACAPI idGetRoles
+// Handle GET request for the roles
+//
+// Returns the roles of the users from the lowest to the highest, viewer, operator and admin, with the permissions they
+// grant. A role grants the permissions of the lower roles along with its own.
+// responses:
+//
+//	200: rolesRespWrapper
+func (h *Handler) GetRolesHandler(
+	rw http.ResponseWriter,
+	_ *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	_ models.Provider,
+) {
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(models.RoleDefinitions()); err != nil {
+		h.log.Error(models.ErrEncoding(err, "roles"))
+		http.Error(rw, models.ErrEncoding(err, "roles").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route GET /api/rbac/bindings RBACAPI idGetRoleBindings
+// Handle GET request for the bindings of the roles
+//
+// Returns the bindings of the roles to the users, which can be filtered by user with the user_id query parameter and by
+// workspace with the workspace query parameter. The bindings to every workspace have an empty workspace.
+// responses:
+//
+//	200: roleBindingsRespWrapper
+//	500:
+func (h *Handler) GetRoleBindingsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	_ models.Provider,
+) {
+	query := r.URL.Query()
+	bindings, err := (&models.RoleBindingPersister{DB: h.dbHandler}).GetRoleBindings(query.Get("user_id"), query.Get("workspace"))
+	if err != nil {
+		h.log.Error(ErrRoleBinding(err))
+		http.Error(rw, ErrRoleBinding(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(bindings); err != nil {
+		h.log.Error(models.ErrEncoding(err, "role bindings"))
+		http.Error(rw, models.ErrEncoding(err, "role bindings").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route POST /api/rbac/bindings RBACAPI idSaveRoleBinding
+// Handle POST request for binding a role to a user
+//
+// Binds the role of the body to the user in the workspace, or in every workspace if the workspace is empty, eg:
+// {"user_id": "...", "workspace": "staging", "role": "operator"}. The role the user had in the workspace is replaced.
+// responses:
+//
+//	200: roleBindingRespWrapper
+//	400:
+//	500:
+func (h *Handler) SaveRoleBindingHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	var body models.RoleBindingRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if body.UserID == "" {
+		http.Error(rw, "user_id is required", http.StatusBadRequest)
+		return
+	}
+	role, err := models.ParseRole(body.Role)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	binding := &models.RoleBinding{
+		UserID:    body.UserID,
+		Workspace: body.Workspace,
+		Role:      role,
+		CreatedBy: user.ID,
+	}
+	if err := (&models.RoleBindingPersister{DB: h.dbHandler}).SaveRoleBinding(binding); err != nil {
+		h.log.Error(ErrRoleBinding(err))
+		http.Error(rw, ErrRoleBinding(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.log.Info(fmt.Sprintf("role %s bound to user %s in workspace %q by user %s", role, binding.UserID, binding.Workspace, user.ID))
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(binding); err != nil {
+		h.log.Error(models.ErrEncoding(err, "role binding"))
+		http.Error(rw, models.ErrEncoding(err, "role binding").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route DELETE /api/rbac/bindings/{id} RBACAPI idDeleteRoleBinding
+// Handle DELETE request for a binding of a role
+//
+// Deletes the binding with the given ID, the user then has the role of its other bindings, or the default role.
+// responses:
+//
+//	204:
+//	400:
+//	404:
+//	500:
+func (h *Handler) DeleteRoleBindingHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	_ *models.User,
+	_ models.Provider,
+) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("invalid role binding id %q", mux.Vars(r)["id"]), http.StatusBadRequest)
+		return
+	}
+	deleted, err := (&models.RoleBindingPersister{DB: h.dbHandler}).DeleteRoleBinding(id)
+	if err != nil {
+		h.log.Error(ErrRoleBinding(err))
+		http.Error(rw, ErrRoleBinding(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if !deleted {
+		http.Error(rw, fmt.Sprintf("role binding %s not found", id), http.StatusNotFound)
+		return
+	}
+	rw.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+)
+
+func TestRequiredPermission(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		body   string
+		want   models.Permission
+	}{
+		{http.MethodGet, "/api/pattern/0e3fa1c2", "", models.ViewPermission},
+		{http.MethodPost, "/api/pattern", `{}`, models.EditPermission},
+		{http.MethodDelete, "/api/pattern/0e3fa1c2", "", models.EditPermission},
+		{http.MethodPost, "/api/pattern/lint", `{}`, models.ViewPermission},
+		{http.MethodDelete, "/api/meshmodels/models/kubernetes/relationships/Edge", "", models.EditPermission},
+		{http.MethodPatch, "/api/meshmodels/models/kubernetes/relationships/Edge", `{}`, models.EditPermission},
+		{http.MethodPost, "/api/pattern/deploy", `{}`, models.DeployPermission},
+		{http.MethodDelete, "/api/pattern/deploy", `{}`, models.DeployPermission},
+		{http.MethodGet, "/api/pattern/deployed/0e3fa1c2/exec", "", models.DeployPermission},
+		{http.MethodGet, "/api/system/audit", "", models.ManageSystemPermission},
+		{http.MethodGet, "/api/rbac/bindings", "", models.ManageRolesPermission},
+		{http.MethodGet, "/api/meshmodels/relationships/policies", "", models.ViewPermission},
+		{http.MethodPost, "/api/meshmodels/relationships/policies", `{}`, models.ManageSystemPermission},
+		{http.MethodDelete, "/api/meshmodels/relationships/policies/no-mount", "", models.ManageSystemPermission},
+		{http.MethodPost, "/api/meshmodels/import", "", models.ManageSystemPermission},
+		{http.MethodPost, "/api/system/graphql/query", `{"query": "query { getAvailableNamespaces { namespace } }"}`, models.ViewPermission},
+		// the GraphQL mutations are authorized on their parsed operation, not on the route
+		{http.MethodPost, "/api/system/graphql/query", `{"query": " mutation { changeOperatorStatus(input: {}) }"}`, models.ViewPermission},
+		{http.MethodGet, "/api/system/graphql/query", "", models.ViewPermission},
+	}
+	for _, tt := range tests {
+		router := mux.NewRouter()
+		var got models.Permission
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			got = requiredPermission(r)
+		}
+		for _, tmpl := range []string{"/api/pattern", "/api/pattern/lint", "/api/pattern/deploy", "/api/pattern/{id}", "/api/pattern/deployed/{id}/exec", "/api/system/audit", "/api/rbac/bindings", "/api/system/graphql/query", "/api/meshmodels/models/{model}/relationships/{name}", "/api/meshmodels/relationships/policies", "/api/meshmodels/relationships/policies/{name}", "/api/meshmodels/import"} {
+			router.HandleFunc(tmpl, handler)
+		}
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body)))
+		if got != tt.want {
+			t.Errorf("requiredPermission(%s %s) = %q, want %q", tt.method, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestAuthorizer(t *testing.T) {
+	user := &models.User{ID: "0e3fa1c2"}
+	r := httptest.NewRequest(http.MethodPost, "/api/system/graphql/query", nil)
+	token := &models.APIToken{}
+	if err := token.SetScopes([]models.Permission{models.ViewPermission}); err != nil {
+		t.Fatal(err)
+	}
+	r = r.WithContext(context.WithValue(r.Context(), models.APITokenCtxKey, token))
+
+	authorize := (&Handler{}).authorizer(r, user)
+	if err := authorize(models.ViewPermission); err != nil {
+		t.Errorf("the view permission of a view token = %v", err)
+	}
+	if err := authorize(models.DeployPermission); err == nil {
+		t.Error("a view token was granted the deploy permission")
+	}
+}
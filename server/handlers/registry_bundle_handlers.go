@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/layer5io/meshery/server/models"
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	"github.com/layer5io/meshkit/models/meshmodel/registry"
+)
+
+// registrant of the entities imported from registry bundles
+const registryBundleHost = "registry-bundle"
+
+// swagger:route GET /api/meshmodels/export ExportRegistryBundle idExportRegistryBundle
+// Handle GET request for exporting the registry as a bundle.
+//
+// Every registered model, component and relationship is packaged as a gzip compressed tarball, so that the registry can be
+// imported into Meshery deployments without access to the internet through ```/api/meshmodels/import```.
+// Relationships scoped to an organization are only exported to the members of that organization.
+//
+// ```?model={model}``` Exports only the entities of the given model
+// responses:
+//
+//	200:
+func (h *Handler) ExportRegistryBundle(rw http.ResponseWriter, r *http.Request) {
+	model := r.URL.Query().Get("model")
+	orgID, err := h.getRequestOrgID(r)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	var bundle mesherymeshmodel.RegistryBundle
+	bundle.Models, _, _ = h.getRegistryModels(r.Context(), &v1alpha1.ModelFilter{Name: model, OrderOn: "name"})
+
+	entities, _, _ := h.getRegistryEntities(r.Context(), &v1alpha1.ComponentFilter{ModelName: model, OrderOn: "kind"})
+	for _, entity := range entities {
+		if comp, ok := entity.(v1alpha1.ComponentDefinition); ok {
+			bundle.Components = append(bundle.Components, comp)
+		}
+	}
+	entities, _, _ = h.getRegistryEntities(r.Context(), &mesherymeshmodel.OrgRelationshipFilter{
+		RelationshipFilter: v1alpha1.RelationshipFilter{ModelName: model, OrderOn: "relationship_definition_dbs.kind"},
+		Org:                orgID,
+	})
+	for _, entity := range entities {
+		if rel, ok := entity.(v1alpha1.RelationshipDefinition); ok {
+			bundle.Relationships = append(bundle.Relationships, rel)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := mesherymeshmodel.ExportRegistryBundle(&buf, bundle); err != nil {
+		h.log.Error(ErrExportRegistryBundle(err))
+		http.Error(rw, ErrExportRegistryBundle(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	name := "meshery-registry"
+	if model != "" {
+		name = model + "-registry"
+	}
+	rw.Header().Set("Content-Type", "application/gzip")
+	rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s-%s.tar.gz", name, time.Now().Format("20060102"))))
+	_, _ = rw.Write(buf.Bytes())
+}
+
+// swagger:route POST /api/meshmodels/import ImportRegistryBundle idImportRegistryBundle
+// Handle POST request for importing a registry bundle.
+//
+// The request body is a bundle exported by ```/api/meshmodels/export```. The entities of the bundle which are not registered
+// are added, the registered components and relationships which differ from those of the bundle are updated and the others
+// are skipped. The response reports the outcome of the import of every entity, entities failing to import do not prevent
+// the import of the others. Only the admins can import a bundle, as it writes to the whole registry.
+// responses:
+//
+//	200: registryImportResponseWrapper
+//	400:
+//	401:
+//	403:
+func (h *Handler) ImportRegistryBundle(rw http.ResponseWriter, r *http.Request, _ *models.Preference, _ *models.User, _ models.Provider) {
+	bundle, err := mesherymeshmodel.ReadRegistryBundle(r.Body)
+	if err != nil {
+		h.log.Error(ErrImportRegistryBundle(err))
+		http.Error(rw, ErrImportRegistryBundle(err).Error(), http.StatusBadRequest)
+		return
+	}
+	orgID, err := h.getRequestOrgID(r)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	for i := range bundle.Relationships {
+		mesherymeshmodel.SetRelationshipOrg(&bundle.Relationships[i], orgID)
+		mesherymeshmodel.SetRelationshipSource(&bundle.Relationships[i], mesherymeshmodel.RelationshipSourceBundle)
+	}
+
+	report := mesherymeshmodel.ImportRegistryBundle(h.dbHandler, h.registryManager, registry.Host{Hostname: registryBundleHost}, bundle)
+	for _, result := range report.Results {
+		action := ""
+		switch result.Status {
+		case mesherymeshmodel.BundleEntityAdded:
+			action = mesherymeshmodel.RegistryEventRegistered
+		case mesherymeshmodel.BundleEntityUpdated:
+			action = mesherymeshmodel.RegistryEventUpdated
+		default:
+			continue
+		}
+		event := mesherymeshmodel.RegistryEvent{
+			Action:       action,
+			EntityType:   result.EntityType,
+			Kind:         result.Kind,
+			Model:        result.Model,
+			ModelVersion: result.Version,
+		}
+		if result.EntityType == mesherymeshmodel.RegistryEntityRelationship {
+			event.Org = orgID
+		}
+		h.config.MeshModelEventsChannel.Publish(event)
+	}
+	if report.Added > 0 || report.Updated > 0 {
+		go h.config.MeshModelSummaryChannel.Publish()
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(report); err != nil {
+		h.log.Error(ErrImportRegistryBundle(err))
+		http.Error(rw, ErrImportRegistryBundle(err).Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/layer5io/meshery/server/internal/tracing"
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshkit/models/meshmodel/core/types"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	meshmodel "github.com/layer5io/meshkit/models/meshmodel/registry"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// The lookups of the registry manager are wrapped in spans, children of the span of the request being served, so that
+// the time spent querying the registry shows in the trace of the request. The lookups of entities go through the
+// registry cache, which the registrations invalidate. The relationships of an OrgRelationshipFilter are looked up in the
+// database directly, since the registry manager does not support filtering them by organization.
+
+func (h *Handler) getRegistryEntities(ctx context.Context, f types.Filter) ([]meshmodel.Entity, *int64, *int) {
+	_, span := tracing.Start(ctx, "registry.GetEntities", attribute.String("meshery.registry.filter", fmt.Sprintf("%T", f)))
+	defer span.End()
+	var entities []meshmodel.Entity
+	var count *int64
+	var unique *int
+	if h.config.RegistryCache != nil {
+		entities, count, unique = h.config.RegistryCache.GetEntities(f)
+	} else {
+		entities, count, unique = mesherymeshmodel.OrgScopedRegistry{EntitiesGetter: h.registryManager, DB: h.dbHandler, Log: h.log}.GetEntities(f)
+	}
+	span.SetAttributes(attribute.Int("meshery.registry.entities", len(entities)))
+	return entities, count, unique
+}
+
+func (h *Handler) getRegistryModels(ctx context.Context, f types.Filter) ([]v1alpha1.Model, int64, int) {
+	_, span := tracing.Start(ctx, "registry.GetModels")
+	defer span.End()
+	mms, count, unique := h.registryManager.GetModels(h.dbHandler, f)
+	span.SetAttributes(attribute.Int("meshery.registry.entities", len(mms)))
+	return mms, count, unique
+}
+
+func (h *Handler) getRegistryCategories(ctx context.Context, f types.Filter) ([]v1alpha1.Category, int64) {
+	_, span := tracing.Start(ctx, "registry.GetCategories")
+	defer span.End()
+	categories, count := h.registryManager.GetCategories(h.dbHandler, f)
+	span.SetAttributes(attribute.Int("meshery.registry.entities", len(categories)))
+	return categories, count
+}
+
+func (h *Handler) registerEntity(ctx context.Context, host meshmodel.Host, en meshmodel.Entity) error {
+	_, span := tracing.Start(ctx, "registry.RegisterEntity", attribute.String("meshery.registry.host", host.Hostname))
+	err := h.registryManager.RegisterEntity(host, en)
+	if err == nil {
+		h.config.RegistryCache.Invalidate()
+	}
+	tracing.End(span, err)
+	return err
+}
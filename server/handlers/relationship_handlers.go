@@ -1,15 +1,23 @@
 package handlers
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 
 	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/internal/jsonstream"
 	"github.com/layer5io/meshery/server/models"
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshery/server/models/pattern/core"
 	"github.com/layer5io/meshkit/models/meshmodel/core/types"
 	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
 	"github.com/layer5io/meshkit/models/meshmodel/registry"
+	"gorm.io/gorm"
 )
 
 // swagger:route GET /api/meshmodels/models/{model}/relationships/{name} GetMeshmodelRelationshipByName idGetMeshmodelRelationshipByName
@@ -17,9 +25,11 @@ import (
 //
 // Example: ```/api/meshmodels/models/kubernetes/relationships/Edge```
 //
+// Relationships scoped to an organization are only returned to the members of that organization.
+//
 // # Relationships can be further filtered through query parameter
 //
-// ```?version={version}```
+// ```?version={version}``` Model version of the relationships. ```version=all``` returns the relationships of every model version along with their prior revisions
 //
 // ```?order={field}``` orders on the passed field
 //
@@ -32,64 +42,121 @@ import (
 // ```?pagesize={pagesize}``` Default pagesize is 25. To return all results: ```pagesize=all```
 // responses:
 //
-//	200: []meshmodelRelationshipsResponseWrapper
+//	200: meshmodelRelationshipsResponseWrapper
 func (h *Handler) GetMeshmodelRelationshipByName(rw http.ResponseWriter, r *http.Request) {
 	rw.Header().Add("Content-Type", "application/json")
-	enc := json.NewEncoder(rw)
 	typ := mux.Vars(r)["model"]
 	name := mux.Vars(r)["name"]
 	var greedy bool
 	if r.URL.Query().Get("search") == "true" {
 		greedy = true
 	}
-	limitstr := r.URL.Query().Get("pagesize")
-	var limit int
-	if limitstr != "all" {
-		limit, _ = strconv.Atoi(limitstr)
-		if limit == 0 { //If limit is unspecified then it defaults to 25
-			limit = DefaultPageSizeForMeshModelComponents
-		}
+	version := r.URL.Query().Get("version")
+	allVersions := version == "all"
+	if allVersions {
+		version = ""
 	}
-	pagestr := r.URL.Query().Get("page")
-	page, _ := strconv.Atoi(pagestr)
-	if page <= 0 {
-		page = 1
+	orgID, err := h.getRequestOrgID(r)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
 	}
-	offset := (page - 1) * limit
-	entities, count, _ := h.registryManager.GetEntities(&v1alpha1.RelationshipFilter{
-		Version:   r.URL.Query().Get("version"),
-		Kind:      name,
-		ModelName: typ,
-		Greedy:    greedy,
-		Limit:     limit,
-		Offset:    offset,
-		OrderOn:   r.URL.Query().Get("order"),
-		Sort:      r.URL.Query().Get("sort"),
-	})
-	var rels []v1alpha1.RelationshipDefinition
-	for _, r := range entities {
-		rel, ok := r.(v1alpha1.RelationshipDefinition)
-		if ok {
-			rels = append(rels, rel)
+	page, offset, limit := getMeshmodelRelationshipsPaginationParams(r)
+	response := h.getMeshmodelRelationshipsPage(r.Context(), &mesherymeshmodel.OrgRelationshipFilter{
+		RelationshipFilter: v1alpha1.RelationshipFilter{
+			Version:   version,
+			Kind:      name,
+			ModelName: typ,
+			Greedy:    greedy,
+			Limit:     limit,
+			Offset:    offset,
+			OrderOn:   r.URL.Query().Get("order"),
+			Sort:      r.URL.Query().Get("sort"),
+		},
+		Org: orgID,
+	}, page, mesherymeshmodel.RelationshipQuery{})
+	if allVersions && !greedy {
+		revisions, _, err := mesherymeshmodel.GetRelationshipHistory(h.dbHandler, mesherymeshmodel.RelationshipHistoryFilter{
+			Model: typ,
+			Kind:  name,
+			Org:   orgID,
+		})
+		if err != nil {
+			h.log.Error(ErrFetchRelationshipHistory(err, name))
+			http.Error(rw, ErrFetchRelationshipHistory(err, name).Error(), http.StatusInternalServerError)
+			return
 		}
+		response.Revisions = revisions
 	}
 
-	var pgSize int64
-	if limitstr == "all" {
-		pgSize = *count
-	} else {
-		pgSize = int64(limit)
+	if err := jsonstream.Encode(rw, response); err != nil {
+		h.log.Error(ErrWorkloadDefinition(err)) //TODO: Add appropriate meshkit error
+		http.Error(rw, ErrWorkloadDefinition(err).Error(), http.StatusInternalServerError)
 	}
+}
 
-	response := models.MeshmodelRelationshipsAPIResponse{
-		Page:          page,
-		PageSize:      int(pgSize),
-		Count:         *count,
-		Relationships: rels,
+// swagger:route GET /api/meshmodels/models/{model}/relationships/{name}/history GetMeshmodelRelationshipHistory idGetMeshmodelRelationshipHistory
+// Handle GET request for getting the prior revisions of a meshmodel relationship, most recent first.
+//
+// A revision is recorded every time the relationship is updated, so that evaluation can be pinned to a specific version of the definition.
+//
+// ```?version={version}``` Model version of the relationship
+//
+// ```?subType={subType}``` SubType of the relationship
+//
+// ```?revision={revision}``` Returns only the revision at the given version of the relationship definition
+//
+// ```?page={page-number}``` Default page number is 1
+//
+// ```?pagesize={pagesize}``` Default pagesize is 25. To return all results: ```pagesize=all```
+//
+// Only the revisions of the relationships visible to the caller, the global ones and those of its organization, are returned.
+// responses:
+//
+//	200: meshmodelRelationshipHistoryResponseWrapper
+func (h *Handler) GetMeshmodelRelationshipHistory(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Add("Content-Type", "application/json")
+	name := mux.Vars(r)["name"]
+	orgID, err := h.getRequestOrgID(r)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	page, offset, limit := getMeshmodelRelationshipsPaginationParams(r)
+	filter := mesherymeshmodel.RelationshipHistoryFilter{
+		Model:        mux.Vars(r)["model"],
+		Kind:         name,
+		ModelVersion: r.URL.Query().Get("version"),
+		SubType:      r.URL.Query().Get("subType"),
+		Org:          orgID,
+		Limit:        limit,
+		Offset:       offset,
+	}
+	if revision := r.URL.Query().Get("revision"); revision != "" {
+		v, err := strconv.Atoi(revision)
+		if err != nil || v < 1 {
+			http.Error(rw, fmt.Sprintf("invalid revision %s, revisions start at 1", revision), http.StatusBadRequest)
+			return
+		}
+		filter.Version = v
 	}
 
-	if err := enc.Encode(response); err != nil {
-		h.log.Error(ErrWorkloadDefinition(err)) //TODO: Add appropriate meshkit error
+	revisions, count, err := mesherymeshmodel.GetRelationshipHistory(h.dbHandler, filter)
+	if err != nil {
+		h.log.Error(ErrFetchRelationshipHistory(err, name))
+		http.Error(rw, ErrFetchRelationshipHistory(err, name).Error(), http.StatusInternalServerError)
+		return
+	}
+	response := models.MeshmodelRelationshipHistoryAPIResponse{
+		Page:      page,
+		PageSize:  relationshipsPageSize(limit, count),
+		Count:     count,
+		Revisions: revisions,
+	}
+	if err := jsonstream.Encode(rw, response); err != nil {
+		h.log.Error(ErrWorkloadDefinition(err))
 		http.Error(rw, ErrWorkloadDefinition(err).Error(), http.StatusInternalServerError)
 	}
 }
@@ -97,10 +164,26 @@ func (h *Handler) GetMeshmodelRelationshipByName(rw http.ResponseWriter, r *http
 // swagger:route GET /api/meshmodels/relationships GetAllMeshmodelRelationships idGetAllMeshmodelRelationships
 // Handle GET request for getting all meshmodel relationships
 //
+// Relationships scoped to an organization are only returned to the members of that organization.
+//
 // # Relationships can be further filtered through query parameter
 //
 // ```?version={version}```
 //
+// ```?kind={kind}``` Returns only the relationships of the given kind. Eg: Hierarchical, Edge
+//
+// ```?subtype={subtype}``` Returns only the relationships of the given subtype. Eg: Parent, Network
+//
+// ```?annotation={[true/false]}``` Returns only the relationships which are (or are not) annotations
+//
+// ```?registrant={hostname}``` Returns only the relationships registered by the given registrant
+//
+// ```?selectorKind={kind}``` Returns only the relationships whose selectors reference the given component kind. Eg: Pod
+//
+// ```?search={term}``` Returns only the relationships containing the term in their kind, description, subType, evaluation query or selector component kinds
+//
+// ```?searchField={[kind/description/subType/evaluationQuery/selectorKind]}``` Restricts the search to the given field
+//
 // ```?order={field}``` orders on the passed field
 //
 // ```?sort={[asc/desc]}``` Default behavior is asc
@@ -116,10 +199,26 @@ func (h *Handler) GetMeshmodelRelationshipByName(rw http.ResponseWriter, r *http
 //
 // Example: ```/api/meshmodel/model/kubernetes/relationship```
 //
+// Relationships scoped to an organization are only returned to the members of that organization.
+//
 // # Relationships can be further filtered through query parameter
 //
 // ```?version={version}```
 //
+// ```?kind={kind}``` Returns only the relationships of the given kind. Eg: Hierarchical, Edge
+//
+// ```?subtype={subtype}``` Returns only the relationships of the given subtype. Eg: Parent, Network
+//
+// ```?annotation={[true/false]}``` Returns only the relationships which are (or are not) annotations
+//
+// ```?registrant={hostname}``` Returns only the relationships registered by the given registrant
+//
+// ```?selectorKind={kind}``` Returns only the relationships whose selectors reference the given component kind. Eg: Pod
+//
+// ```?search={term}``` Returns only the relationships containing the term in their kind, description, subType, evaluation query or selector component kinds
+//
+// ```?searchField={[kind/description/subType/evaluationQuery/selectorKind]}``` Restricts the search to the given field
+//
 // ```?order={field}``` orders on the passed field
 //
 // ```?sort={[asc/desc]}``` Default behavior is asc
@@ -132,66 +231,153 @@ func (h *Handler) GetMeshmodelRelationshipByName(rw http.ResponseWriter, r *http
 //	200: meshmodelRelationshipsResponseWrapper
 func (h *Handler) GetAllMeshmodelRelationships(rw http.ResponseWriter, r *http.Request) {
 	rw.Header().Add("Content-Type", "application/json")
-	enc := json.NewEncoder(rw)
 	typ := mux.Vars(r)["model"]
+	orgID, err := h.getRequestOrgID(r)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	page, offset, limit := getMeshmodelRelationshipsPaginationParams(r)
+	response := h.getMeshmodelRelationshipsPage(r.Context(), &mesherymeshmodel.OrgRelationshipFilter{
+		RelationshipFilter: v1alpha1.RelationshipFilter{
+			Version:   r.URL.Query().Get("version"),
+			Kind:      r.URL.Query().Get("kind"),
+			SubType:   r.URL.Query().Get("subtype"),
+			ModelName: typ,
+			Limit:     limit,
+			Offset:    offset,
+			OrderOn:   r.URL.Query().Get("order"),
+			Sort:      r.URL.Query().Get("sort"),
+		},
+		Org: orgID,
+	}, page, mesherymeshmodel.RelationshipQuery{
+		Annotation:   r.URL.Query().Get("annotation"),
+		Registrant:   r.URL.Query().Get("registrant"),
+		SelectorKind: r.URL.Query().Get("selectorKind"),
+		Search:       r.URL.Query().Get("search"),
+		SearchField:  r.URL.Query().Get("searchField"),
+	})
+
+	if err := jsonstream.Encode(rw, response); err != nil {
+		h.log.Error(ErrWorkloadDefinition(err)) //TODO: Add appropriate meshkit error
+		http.Error(rw, ErrWorkloadDefinition(err).Error(), http.StatusInternalServerError)
+	}
+}
+
+// getMeshmodelRelationshipsPaginationParams parses the page and pagesize query parameters.
+// A limit of 0 is returned when all results are requested using pagesize=all
+func getMeshmodelRelationshipsPaginationParams(r *http.Request) (page, offset, limit int) {
 	limitstr := r.URL.Query().Get("pagesize")
-	var limit int
 	if limitstr != "all" {
 		limit, _ = strconv.Atoi(limitstr)
-		if limit == 0 { //If limit is unspecified then it defaults to 25
+		if limit <= 0 { //If limit is unspecified then it defaults to 25
 			limit = DefaultPageSizeForMeshModelComponents
 		}
 	}
-	pagestr := r.URL.Query().Get("page")
-	page, _ := strconv.Atoi(pagestr)
+	page, _ = strconv.Atoi(r.URL.Query().Get("page"))
 	if page <= 0 {
 		page = 1
 	}
-	offset := (page - 1) * limit
-	entities, count, _ := h.registryManager.GetEntities(&v1alpha1.RelationshipFilter{
-		Version:   r.URL.Query().Get("version"),
-		ModelName: typ,
-		Limit:     limit,
-		Offset:    offset,
-		OrderOn:   r.URL.Query().Get("order"),
-		Sort:      r.URL.Query().Get("sort"),
-	})
-	var rels []v1alpha1.RelationshipDefinition
+	offset = (page - 1) * limit
+	return
+}
+
+// relationshipsPageSize returns the page size echoed by the relationship lists, the number of results when all of them
+// are requested using pagesize=all
+func relationshipsPageSize(limit int, count int64) int {
+	if limit == 0 {
+		return int(count)
+	}
+	return limit
+}
+
+// relationshipsPageBounds returns the bounds of the page at offset of a list of n results, every result from offset
+// onwards when limit is 0
+func relationshipsPageBounds(n, offset, limit int) (start, end int) {
+	start, end = offset, n
+	if start > n {
+		start = n
+	}
+	if limit != 0 && start+limit < end {
+		end = start + limit
+	}
+	return
+}
+
+// getMeshmodelRelationshipsPage fetches the relationships matching the filter and wraps them in a paginated envelope
+// along with the total number of matching relationships, so that clients do not need a second request to paginate.
+// The organization of the filter is applied by the database query, the filters of query are not, when present all the
+// relationships matching the filter are fetched, filtered and then paginated.
+func (h *Handler) getMeshmodelRelationshipsPage(ctx context.Context, filter *mesherymeshmodel.OrgRelationshipFilter, page int, query mesherymeshmodel.RelationshipQuery) models.MeshmodelRelationshipsAPIResponse {
+	limit, offset := filter.Limit, filter.Offset
+	if !query.IsEmpty() {
+		filter.Limit, filter.Offset = 0, 0
+	}
+
+	entities, count, _ := h.getRegistryEntities(ctx, filter)
+	rels := make([]v1alpha1.RelationshipDefinition, 0, len(entities))
 	for _, entity := range entities {
-		host := h.registryManager.GetRegistrant(entity)
 		rel, ok := entity.(v1alpha1.RelationshipDefinition)
 		if ok {
+			host := h.registryManager.GetRegistrant(entity)
 			rel.HostID = host.ID
 			rel.HostName = host.Hostname
 			rel.DisplayHostName = registry.HostnameToPascalCase(host.Hostname)
-			rels = append(rels, rel)
+			if query.Matches(rel) {
+				rels = append(rels, rel)
+			}
 		}
 	}
 
-	var pgSize int64
-	if limitstr == "all" {
-		pgSize = *count
-	} else {
-		pgSize = int64(limit)
+	var totalCount int64
+	if count != nil {
+		totalCount = *count
+	}
+	if !query.IsEmpty() {
+		totalCount = int64(len(rels))
+		start, end := relationshipsPageBounds(len(rels), offset, limit)
+		rels = rels[start:end]
 	}
 
-	response := models.MeshmodelRelationshipsAPIResponse{
+	return models.MeshmodelRelationshipsAPIResponse{
 		Page:          page,
-		PageSize:      int(pgSize),
-		Count:         *count,
+		PageSize:      relationshipsPageSize(limit, totalCount),
+		Count:         totalCount,
 		Relationships: rels,
 	}
-
-	if err := enc.Encode(response); err != nil {
-		h.log.Error(ErrWorkloadDefinition(err)) //TODO: Add appropriate meshkit error
-		http.Error(rw, ErrWorkloadDefinition(err).Error(), http.StatusInternalServerError)
-	}
 }
 
+// swagger:route POST /api/meshmodels/relationships RegisterMeshmodelRelationships idPostMeshmodelRelationships
+// Handle POST request for registering a meshmodel relationship.
+//
+// A relationship identical to a registered one (same model, model version, kind, subType and selectors) is rejected
+// with 409 and the registered relationship in the response body.
+// A relationship violating the registered relationship policies is rejected with 403 and the violations in the response body.
+//
+// ```?force=true``` Register the relationship even if it duplicates a registered one
+//
+// ```?source=adapter``` Meshery Adapters registering relationships should identify themselves, the source defaults to api
+// responses:
+//
+//	200:
+//	401:
+//	403:
+//	409: RelationshipDefinition
+//	422:
 func (h *Handler) RegisterMeshmodelRelationships(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	force := r.URL.Query().Get("force")
+	source := getRelationshipSource(r)
+	orgID, err := h.getRequestOrgID(r)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
 	dec := json.NewDecoder(r.Body)
 	var cc registry.MeshModelRegistrantData
-	err := dec.Decode(&cc)
+	err = dec.Decode(&cc)
 	if err != nil {
 		http.Error(rw, err.Error(), http.StatusBadRequest)
 		return
@@ -204,11 +390,620 @@ func (h *Handler) RegisterMeshmodelRelationships(rw http.ResponseWriter, r *http
 			http.Error(rw, err.Error(), http.StatusBadRequest)
 			return
 		}
-		err = h.registryManager.RegisterEntity(cc.Host, r)
+		if err = mesherymeshmodel.ValidateRelationshipDefinition(r); err != nil {
+			h.writeRelationshipValidationError(rw, err)
+			return
+		}
+		var evaluator *mesherymeshmodel.RelationshipPolicyEvaluator
+		evaluator, err = mesherymeshmodel.NewRelationshipPolicyEvaluator(ctx, h.dbHandler)
+		if err == nil {
+			err = evaluator.Evaluate(ctx, r)
+		}
+		if err != nil {
+			h.writeRelationshipPolicyError(rw, err)
+			return
+		}
+		mesherymeshmodel.SetRelationshipOrg(&r, orgID)
+		if force != "true" {
+			existing, err := mesherymeshmodel.FindDuplicateRelationship(h.dbHandler, r)
+			if err != nil {
+				h.log.Error(ErrRegisterRelationship(err))
+				http.Error(rw, ErrRegisterRelationship(err).Error(), http.StatusInternalServerError)
+				return
+			}
+			if existing != nil {
+				h.writeDuplicateRelationship(rw, *existing)
+				return
+			}
+		}
+		mesherymeshmodel.SetRelationshipSource(&r, source)
+		err = h.registerEntity(ctx, cc.Host, r)
+		if err == nil {
+			h.publishRelationshipEvent(mesherymeshmodel.RegistryEventRegistered, r)
+		}
+	}
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	go h.config.MeshModelSummaryChannel.Publish()
+}
+
+// swagger:route POST /api/meshmodels/relationships/bulk RegisterMeshmodelRelationshipsBulk idPostMeshmodelRelationshipsBulk
+// Handle POST request for registering multiple meshmodel relationships in a single request.
+//
+// Every relationship definition is validated against the relationship schema before registration, definitions failing validation
+// are reported with the path of every offending field. Definitions violating the relationship policies are reported with the violations.
+// Definitions duplicating a registered relationship are reported along with the registered relationship, unless ```?force=true``` is passed.
+// The definitions are registered in a single transaction, so either all of them are registered or none of them are.
+//
+// The definitions are registered by the users of a session, scoped to their organization, unlike the registrants calling
+// ```/api/meshmodels/relationships```.
+// responses:
+//
+//	200: meshmodelRelationshipsBulkRegistrationResponseWrapper
+//	400: meshmodelRelationshipsBulkRegistrationResponseWrapper
+//	401:
+//	403: meshmodelRelationshipsBulkRegistrationResponseWrapper
+//	409: meshmodelRelationshipsBulkRegistrationResponseWrapper
+//	422: meshmodelRelationshipsBulkRegistrationResponseWrapper
+func (h *Handler) RegisterMeshmodelRelationshipsBulk(rw http.ResponseWriter, r *http.Request, _ *models.Preference, _ *models.User, _ models.Provider) {
+	rw.Header().Add("Content-Type", "application/json")
+	var req models.MeshmodelRelationshipsBulkRegistrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	evaluator, err := mesherymeshmodel.NewRelationshipPolicyEvaluator(r.Context(), h.dbHandler)
+	if err != nil {
+		h.log.Error(ErrRelationshipPolicy(err))
+		http.Error(rw, ErrRelationshipPolicy(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	force := r.URL.Query().Get("force") == "true"
+	orgID, err := h.getRequestOrgID(r)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	for i := range req.Relationships {
+		mesherymeshmodel.SetRelationshipOrg(&req.Relationships[i], orgID)
+	}
+	invalid, forbidden := 0, 0
+	response := models.MeshmodelRelationshipsBulkRegistrationResponse{
+		Results: make([]models.MeshmodelEntityRegistrationResult, 0, len(req.Relationships)),
+	}
+	for i, rel := range req.Relationships {
+		result := models.MeshmodelEntityRegistrationResult{
+			Index: i,
+			Kind:  rel.Kind,
+			Model: rel.Model.Name,
+		}
+		if err := mesherymeshmodel.ValidateRelationshipDefinition(rel); err != nil {
+			result.Error = err.Error()
+			if verr, ok := err.(*mesherymeshmodel.RelationshipValidationError); ok {
+				result.ValidationErrors = verr.Errors
+			}
+			response.Failed++
+			invalid++
+		} else if err := evaluator.Evaluate(r.Context(), rel); err != nil {
+			perr, ok := err.(*mesherymeshmodel.RelationshipPolicyError)
+			if !ok {
+				h.log.Error(ErrRelationshipPolicy(err))
+				http.Error(rw, ErrRelationshipPolicy(err).Error(), http.StatusInternalServerError)
+				return
+			}
+			result.Error = perr.Error()
+			result.Violations = perr.Violations
+			response.Failed++
+			forbidden++
+		} else if !force {
+			existing, err := mesherymeshmodel.FindDuplicateRelationship(h.dbHandler, rel)
+			if err != nil {
+				h.log.Error(ErrRegisterRelationship(err))
+				http.Error(rw, ErrRegisterRelationship(err).Error(), http.StatusInternalServerError)
+				return
+			}
+			if existing != nil {
+				result.Error = fmt.Sprintf("relationship %s duplicates a registered relationship", rel.Kind)
+				result.Duplicate = existing
+				response.Failed++
+			}
+		}
+		response.Results = append(response.Results, result)
+	}
+
+	status := http.StatusOK
+	if response.Failed == 0 && len(req.Relationships) > 0 {
+		source := getRelationshipSource(r)
+		for i := range req.Relationships {
+			mesherymeshmodel.SetRelationshipSource(&req.Relationships[i], source)
+		}
+		failedIdx, err := mesherymeshmodel.RegisterRelationshipsInTransaction(h.dbHandler, req.Host, req.Relationships)
+		if err != nil {
+			h.log.Error(ErrRegisterRelationship(err))
+			if failedIdx >= 0 {
+				response.Results[failedIdx].Error = err.Error()
+			}
+			response.Failed = len(req.Relationships)
+			status = http.StatusBadRequest
+		} else {
+			for i := range response.Results {
+				response.Results[i].Registered = true
+			}
+			response.Registered = len(req.Relationships)
+			for _, rel := range req.Relationships {
+				h.publishRelationshipEvent(mesherymeshmodel.RegistryEventRegistered, rel)
+			}
+			go h.config.MeshModelSummaryChannel.Publish()
+		}
+	} else if invalid > 0 {
+		status = http.StatusUnprocessableEntity
+	} else if forbidden > 0 {
+		status = http.StatusForbidden
+	} else if response.Failed > 0 {
+		status = http.StatusConflict
+	}
+
+	rw.WriteHeader(status)
+	if err := json.NewEncoder(rw).Encode(response); err != nil {
+		h.log.Error(ErrWorkloadDefinition(err))
+		http.Error(rw, ErrWorkloadDefinition(err).Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route GET /api/meshmodels/relationships/provenance GetMeshmodelRelationshipsProvenance idGetMeshmodelRelationshipsProvenance
+// Handle GET request for auditing where the registered relationships originated.
+//
+// Lists the registrant, the source (static, adapter or api) and the time of registration of every relationship visible to the caller,
+// most recent first.
+//
+// ```?model={model}``` Returns only the relationships of the given model
+//
+// ```?registrant={hostname}``` Returns only the relationships registered by the given registrant
+//
+// ```?source={[static/adapter/api/bundle]}``` Returns only the relationships registered from the given source
+//
+// ```?page={page-number}``` Default page number is 1
+//
+// ```?pagesize={pagesize}``` Default pagesize is 25. To return all results: ```pagesize=all```
+// responses:
+//
+//	200: meshmodelRelationshipsProvenanceResponseWrapper
+func (h *Handler) GetMeshmodelRelationshipsProvenance(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Add("Content-Type", "application/json")
+	orgID, err := h.getRequestOrgID(r)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	page, offset, limit := getMeshmodelRelationshipsPaginationParams(r)
+	provenance, count, err := mesherymeshmodel.ListRelationshipProvenance(h.dbHandler, mesherymeshmodel.RelationshipProvenanceFilter{
+		Model:      r.URL.Query().Get("model"),
+		Registrant: r.URL.Query().Get("registrant"),
+		Source:     r.URL.Query().Get("source"),
+		Org:        orgID,
+		Limit:      limit,
+		Offset:     offset,
+	})
+	if err != nil {
+		h.log.Error(ErrFetchRelationshipProvenance(err))
+		http.Error(rw, ErrFetchRelationshipProvenance(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := models.MeshmodelRelationshipsProvenanceAPIResponse{
+		Page:       page,
+		PageSize:   relationshipsPageSize(limit, count),
+		Count:      count,
+		Provenance: provenance,
+	}
+	if err := json.NewEncoder(rw).Encode(response); err != nil {
+		h.log.Error(ErrWorkloadDefinition(err))
+		http.Error(rw, ErrWorkloadDefinition(err).Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route POST /api/meshmodels/relationships/evaluate EvaluateMeshmodelRelationship idPostMeshmodelRelationshipEvaluate
+// Handle POST request for evaluating a candidate relationship definition against a design without registering it.
+//
+// Returns the pairs of components of the design that the selectors of the relationship would match,
+// letting authors test their selectors before registering the relationship.
+// responses:
+//
+//	200: meshmodelRelationshipEvaluationResponseWrapper
+//	422:
+func (h *Handler) EvaluateMeshmodelRelationship(rw http.ResponseWriter, r *http.Request) {
+	var req models.MeshmodelRelationshipEvaluationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := mesherymeshmodel.ValidateRelationshipDefinition(req.Relationship); err != nil {
+		h.writeRelationshipValidationError(rw, err)
+		return
+	}
+	pattern, err := core.NewPatternFile([]byte(req.Design))
+	if err != nil {
+		h.log.Error(ErrDecoding(err, "design file"))
+		http.Error(rw, ErrDecoding(err, "design file").Error(), http.StatusBadRequest)
+		return
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	response := models.MeshmodelRelationshipEvaluationResponse{
+		Matches: mesherymeshmodel.MatchRelationship(req.Relationship, mesherymeshmodel.DesignComponents(pattern)),
+	}
+	if err := json.NewEncoder(rw).Encode(response); err != nil {
+		h.log.Error(ErrWorkloadDefinition(err))
+		http.Error(rw, ErrWorkloadDefinition(err).Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route POST /api/meshmodels/relationships/lint LintMeshmodelRelationships idPostMeshmodelRelationshipsLint
+// Handle POST request for linting relationship definitions against the registered models without registering them.
+//
+// Reports the selectors referencing models or component kinds which are not registered, the selectors which can never match
+// because of a missing counterpart or because the deny selectors exclude them, and the selectors without a deny list.
+// Every diagnostic identifies the definition by its index in the request and the offending field by its JSON pointer path.
+// responses:
+//
+//	200: meshmodelRelationshipsLintResponseWrapper
+//	400:
+func (h *Handler) LintMeshmodelRelationships(rw http.ResponseWriter, r *http.Request) {
+	var req models.MeshmodelRelationshipsLintRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	registered := mesherymeshmodel.RegisteredComponents{}
+	for _, model := range mesherymeshmodel.RelationshipSelectorModels(req.Relationships) {
+		entities, _, _ := h.getRegistryEntities(r.Context(), &v1alpha1.ComponentFilter{
+			ModelName: model,
+			Trim:      true,
+		})
+		for _, en := range entities {
+			if comp, ok := en.(v1alpha1.ComponentDefinition); ok {
+				registered.Add(model, comp.Kind)
+			}
+		}
+	}
+
+	response := models.MeshmodelRelationshipsLintResponse{
+		Diagnostics: mesherymeshmodel.LintRelationships(req.Relationships, registered),
+	}
+	for _, d := range response.Diagnostics {
+		if d.Severity == mesherymeshmodel.RelationshipLintError {
+			response.Errors++
+		} else {
+			response.Warnings++
+		}
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(response); err != nil {
+		h.log.Error(ErrWorkloadDefinition(err))
+		http.Error(rw, ErrWorkloadDefinition(err).Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route GET /api/meshmodels/relationships/usage GetMeshmodelRelationshipsUsage idGetMeshmodelRelationshipsUsage
+// Handle GET request for getting the number of saved designs using each registered relationship.
+//
+// A design uses a relationship when two of its components match the "from" and "to" selectors of the relationship.
+// Designs are indexed periodically in the background, the time of the latest indexing is reported as indexed_at.
+// Only the relationships visible to the caller, the global ones and those of its organization, are reported.
+//
+// ```?refresh=true``` Re-index the designs before responding
+//
+// ```?page={page-number}``` Default page number is 1
+//
+// ```?pagesize={pagesize}``` Default pagesize is 25. To return all results: ```pagesize=all```
+// responses:
+//
+//	200: meshmodelRelationshipsUsageResponseWrapper
+func (h *Handler) GetMeshmodelRelationshipsUsage(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Add("Content-Type", "application/json")
+	orgID, err := h.getRequestOrgID(r)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if r.URL.Query().Get("refresh") == "true" {
+		if err := h.config.RelationshipUsageIndexer.Index(); err != nil {
+			h.log.Error(models.ErrIndexRelationshipUsage(err))
+			http.Error(rw, models.ErrIndexRelationshipUsage(err).Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	page, offset, limit := getMeshmodelRelationshipsPaginationParams(r)
+	report := h.config.RelationshipUsageIndexer.Report(orgID)
+	start, end := relationshipsPageBounds(len(report.Relationships), offset, limit)
+	report.Relationships = report.Relationships[start:end]
+	report.Page, report.PageSize = page, relationshipsPageSize(limit, report.Count)
+	if err := json.NewEncoder(rw).Encode(report); err != nil {
+		h.log.Error(ErrWorkloadDefinition(err))
+		http.Error(rw, ErrWorkloadDefinition(err).Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route GET /api/meshmodels/models/{model}/relationships/graph GetMeshmodelRelationshipsGraph idGetMeshmodelRelationshipsGraph
+// Handle GET request for getting the graph of components connected by the relationships of a specific model.
+//
+// Nodes of the graph are component kinds and edges are the relationships allowed between them, identified by relationship kind and subType.
+// A node of kind "*" stands for any component of its model.
+//
+// Example: ```/api/meshmodels/models/kubernetes/relationships/graph```
+//
+// ```?version={version}``` If version is unspecified then relationships of all versions of the model are included
+// responses:
+//
+//	200: meshmodelRelationshipsGraphResponseWrapper
+func (h *Handler) GetMeshmodelRelationshipsGraph(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Add("Content-Type", "application/json")
+	orgID, err := h.getRequestOrgID(r)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	entities, _, _ := h.getRegistryEntities(r.Context(), &mesherymeshmodel.OrgRelationshipFilter{
+		RelationshipFilter: v1alpha1.RelationshipFilter{
+			ModelName: mux.Vars(r)["model"],
+			Version:   r.URL.Query().Get("version"),
+		},
+		Org: orgID,
+	})
+	rels := make([]v1alpha1.RelationshipDefinition, 0, len(entities))
+	for _, entity := range entities {
+		if rel, ok := entity.(v1alpha1.RelationshipDefinition); ok {
+			rels = append(rels, rel)
+		}
+	}
+
+	if err := json.NewEncoder(rw).Encode(mesherymeshmodel.BuildRelationshipGraph(rels)); err != nil {
+		h.log.Error(ErrWorkloadDefinition(err))
+		http.Error(rw, ErrWorkloadDefinition(err).Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route GET /api/meshmodels/models/{model}/relationships/export ExportMeshmodelRelationships idExportMeshmodelRelationships
+// Handle GET request for exporting the relationships of a specific model.
+//
+// The relationship definitions are packaged as a gzip compressed tarball laid out as the static relationships directory,
+// so that curated relationships can be moved between Meshery deployments.
+//
+// Example: ```/api/meshmodels/models/kubernetes/relationships/export```
+//
+// ```?version={version}``` If version is unspecified then relationships of all versions of the model are exported
+// responses:
+//
+//	200:
+//	404:
+func (h *Handler) ExportMeshmodelRelationships(rw http.ResponseWriter, r *http.Request) {
+	model := mux.Vars(r)["model"]
+	orgID, err := h.getRequestOrgID(r)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	entities, _, _ := h.getRegistryEntities(r.Context(), &mesherymeshmodel.OrgRelationshipFilter{
+		RelationshipFilter: v1alpha1.RelationshipFilter{
+			ModelName: model,
+			Version:   r.URL.Query().Get("version"),
+			OrderOn:   "relationship_definition_dbs.kind",
+		},
+		Org: orgID,
+	})
+	rels := make([]v1alpha1.RelationshipDefinition, 0, len(entities))
+	for _, entity := range entities {
+		if rel, ok := entity.(v1alpha1.RelationshipDefinition); ok {
+			rels = append(rels, rel)
+		}
+	}
+	if len(rels) == 0 {
+		http.Error(rw, fmt.Sprintf("no relationships registered for model %s", model), http.StatusNotFound)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := mesherymeshmodel.ExportRelationships(&buf, model, rels); err != nil {
+		h.log.Error(ErrExportRelationships(err, model))
+		http.Error(rw, ErrExportRelationships(err, model).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/gzip")
+	rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", model+"-relationships.tar.gz"))
+	_, _ = rw.Write(buf.Bytes())
+}
+
+// swagger:route DELETE /api/meshmodels/models/{model}/relationships/{name} DeleteMeshmodelRelationship idDeleteMeshmodelRelationship
+// Handle DELETE request for deregistering meshmodel relationships of a specific model by name.
+//
+// Example: ```/api/meshmodels/models/kubernetes/relationships/Edge```
+//
+// ```?version={version}``` If version is unspecified then the relationship is removed from all versions of the model
+//
+// Only the relationships of the organization of the caller are removed, the global ones when the caller has no organization.
+// responses:
+//
+//	200:
+//	401:
+//	404:
+func (h *Handler) DeleteMeshmodelRelationship(rw http.ResponseWriter, r *http.Request, _ *models.Preference, _ *models.User, _ models.Provider) {
+	model := mux.Vars(r)["model"]
+	name := mux.Vars(r)["name"]
+	orgID, err := h.getRequestOrgID(r)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
 	}
+	deleted, err := mesherymeshmodel.DeleteRelationships(h.dbHandler, model, name, r.URL.Query().Get("version"), orgID)
 	if err != nil {
+		h.log.Error(ErrDeleteRelationship(err, name))
+		http.Error(rw, ErrDeleteRelationship(err, name).Error(), http.StatusInternalServerError)
+		return
+	}
+	if deleted == 0 {
+		http.Error(rw, fmt.Sprintf("relationship %s not found for model %s", name, model), http.StatusNotFound)
+		return
+	}
+	h.config.MeshModelEventsChannel.Publish(mesherymeshmodel.RegistryEvent{
+		Action:       mesherymeshmodel.RegistryEventDeleted,
+		EntityType:   mesherymeshmodel.RegistryEntityRelationship,
+		Kind:         name,
+		Model:        model,
+		ModelVersion: r.URL.Query().Get("version"),
+		Org:          orgID,
+	})
+	go h.config.MeshModelSummaryChannel.Publish()
+	rw.Header().Add("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(map[string]int64{"deleted": deleted})
+}
+
+// swagger:route PATCH /api/meshmodels/models/{model}/relationships/{name} UpdateMeshmodelRelationship idPatchMeshmodelRelationship
+// Handle PATCH request for updating a registered meshmodel relationship.
+//
+// The request body is applied onto the registered definition as a JSON merge patch.
+//
+// ```?version={version}``` Model version of the relationship to update
+//
+// ```?subType={subType}``` SubType of the relationship to update, required when the model registers more than one relationship of the same kind
+//
+// Only the relationships of the organization of the caller are updated, the global ones when the caller has no organization.
+// responses:
+//
+//	200: RelationshipDefinition
+//	401:
+
+// swagger:route PUT /api/meshmodels/models/{model}/relationships/{name} UpdateMeshmodelRelationship idPutMeshmodelRelationship
+// Handle PUT request for replacing a registered meshmodel relationship.
+//
+// ```?version={version}``` Model version of the relationship to update
+//
+// ```?subType={subType}``` SubType of the relationship to update, required when the model registers more than one relationship of the same kind
+//
+// Only the relationships of the organization of the caller are updated, the global ones when the caller has no organization.
+// responses:
+//
+//	200: RelationshipDefinition
+//	401:
+func (h *Handler) UpdateMeshmodelRelationship(rw http.ResponseWriter, r *http.Request, _ *models.Preference, _ *models.User, _ models.Provider) {
+	model := mux.Vars(r)["model"]
+	name := mux.Vars(r)["name"]
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	orgID, err := h.getRequestOrgID(r)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	rdb, err := mesherymeshmodel.FindRelationship(h.dbHandler, model, name, r.URL.Query().Get("version"), r.URL.Query().Get("subType"), orgID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			http.Error(rw, fmt.Sprintf("relationship %s not found for model %s", name, model), http.StatusNotFound)
+			return
+		}
 		http.Error(rw, err.Error(), http.StatusBadRequest)
 		return
 	}
+
+	rel, err := mesherymeshmodel.UpdateRelationship(h.dbHandler, rdb, body, r.Method == http.MethodPatch)
+	if _, ok := err.(*mesherymeshmodel.RelationshipValidationError); ok {
+		h.writeRelationshipValidationError(rw, err)
+		return
+	}
+	if err != nil {
+		h.log.Error(ErrUpdateRelationship(err, name))
+		http.Error(rw, ErrUpdateRelationship(err, name).Error(), http.StatusBadRequest)
+		return
+	}
+	h.publishRelationshipEvent(mesherymeshmodel.RegistryEventUpdated, rel)
 	go h.config.MeshModelSummaryChannel.Publish()
+
+	rw.Header().Add("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(rel); err != nil {
+		h.log.Error(ErrWorkloadDefinition(err))
+		http.Error(rw, ErrWorkloadDefinition(err).Error(), http.StatusInternalServerError)
+	}
+}
+
+// writeRelationshipValidationError responds with 422 and the list of offending fields when err is a schema validation failure,
+// any other error is treated as a malformed request.
+func (h *Handler) writeRelationshipValidationError(rw http.ResponseWriter, err error) {
+	verr, ok := err.(*mesherymeshmodel.RelationshipValidationError)
+	if !ok {
+		h.log.Error(ErrInvalidRelationship(err))
+		http.Error(rw, ErrInvalidRelationship(err).Error(), http.StatusBadRequest)
+		return
+	}
+	h.log.Error(ErrInvalidRelationship(err))
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusUnprocessableEntity)
+	if err := json.NewEncoder(rw).Encode(verr); err != nil {
+		h.log.Error(ErrWorkloadDefinition(err))
+	}
+}
+
+// writeDuplicateRelationship responds with 409 and the registered relationship which the request duplicates
+func (h *Handler) writeDuplicateRelationship(rw http.ResponseWriter, existing v1alpha1.RelationshipDefinition) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusConflict)
+	if err := json.NewEncoder(rw).Encode(existing); err != nil {
+		h.log.Error(ErrWorkloadDefinition(err))
+	}
+}
+
+// publishRelationshipEvent notifies the subscribers of the registry events about the change made to the relationship
+func (h *Handler) publishRelationshipEvent(action string, rel v1alpha1.RelationshipDefinition) {
+	h.config.MeshModelEventsChannel.Publish(mesherymeshmodel.RegistryEvent{
+		Action:       action,
+		EntityType:   mesherymeshmodel.RegistryEntityRelationship,
+		Kind:         rel.Kind,
+		SubType:      rel.SubType,
+		Model:        rel.Model.Name,
+		ModelVersion: rel.Model.Version,
+		Org:          mesherymeshmodel.RelationshipOrg(rel.Metadata),
+	})
+}
+
+// getRequestOrgID returns the organization of the caller as claimed by the provider token, empty when the provider
+// does not scope its users to organizations. An error is returned when the organization of the caller can not be
+// determined, so that the request is refused rather than served as if the caller belonged to no organization.
+func (h *Handler) getRequestOrgID(r *http.Request) (string, error) {
+	provider, ok := r.Context().Value(models.ProviderCtxKey).(models.OrgScopedProvider)
+	if !ok {
+		return "", nil
+	}
+	orgID, err := provider.GetOrgID(r)
+	if err != nil {
+		return "", ErrRequestOrg(err)
+	}
+	return orgID, nil
+}
+
+// getRelationshipSource returns how the relationships in the request are being registered, as declared by the registrant
+func getRelationshipSource(r *http.Request) string {
+	if r.URL.Query().Get("source") == mesherymeshmodel.RelationshipSourceAdapter {
+		return mesherymeshmodel.RelationshipSourceAdapter
+	}
+	return mesherymeshmodel.RelationshipSourceAPI
 }
@@ -0,0 +1,574 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	meshmodel "github.com/layer5io/meshkit/models/meshmodel/registry"
+	"github.com/spf13/viper"
+)
+
+// relationshipsHandler returns a handler over a SQLite registry
+func relationshipsHandler(t *testing.T) *Handler {
+	t.Helper()
+	db, err := database.New(database.Options{Filename: filepath.Join(t.TempDir(), "mesherydb.sql"), Engine: database.SQLITE})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&mesherymeshmodel.RelationshipRevision{}, &mesherymeshmodel.RelationshipPolicy{}); err != nil {
+		t.Fatal(err)
+	}
+	regManager, err := meshmodel.NewRegistryManager(&db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log, err := logger.New("meshery-test", logger.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Handler{
+		config: &models.HandlerConfig{
+			MeshModelSummaryChannel: mesherymeshmodel.NewSummaryHelper(),
+			MeshModelEventsChannel:  mesherymeshmodel.NewRegistryEventsChannel(),
+		},
+		log:             log,
+		dbHandler:       &db,
+		registryManager: regManager,
+	}
+}
+
+// orgProvider is a provider whose users belong to the organization, or whose organization can not be determined
+// when err is set
+type orgProvider struct {
+	models.Provider
+	orgID string
+	err   error
+}
+
+func (p orgProvider) GetOrgID(*http.Request) (string, error) {
+	return p.orgID, p.err
+}
+
+// relationshipsRequest returns a request to the route made by a user of the organization
+func relationshipsRequest(method, target, body, orgID string, vars map[string]string) *http.Request {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	r = r.WithContext(context.WithValue(r.Context(), models.ProviderCtxKey, orgProvider{orgID: orgID}))
+	return mux.SetURLVars(r, vars)
+}
+
+func testRelationship(kind, subType, fromKind string) v1alpha1.RelationshipDefinition {
+	selector := func(kind string) map[string]interface{} {
+		return map[string]interface{}{"kind": kind, "model": "kubernetes"}
+	}
+	return v1alpha1.RelationshipDefinition{
+		TypeMeta: v1alpha1.TypeMeta{Kind: kind, APIVersion: "core.meshery.io/v1alpha1"},
+		Model:    v1alpha1.Model{Name: "kubernetes", Version: "v1.25.2"},
+		SubType:  subType,
+		Metadata: map[string]interface{}{"description": kind + " " + subType},
+		Selectors: map[string]interface{}{
+			"allow": map[string]interface{}{
+				"from": []interface{}{selector(fromKind)},
+				"to":   []interface{}{selector("Namespace")},
+			},
+		},
+	}
+}
+
+// registerRelationships registers the relationships in bulk as a user of the organization
+func registerRelationships(t *testing.T, h *Handler, orgID string, rels ...v1alpha1.RelationshipDefinition) (int, models.MeshmodelRelationshipsBulkRegistrationResponse) {
+	t.Helper()
+	body, err := json.Marshal(models.MeshmodelRelationshipsBulkRegistrationRequest{Host: meshmodel.Host{Hostname: "meshery-test"}, Relationships: rels})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rw := httptest.NewRecorder()
+	h.RegisterMeshmodelRelationshipsBulk(rw, relationshipsRequest(http.MethodPost, "/api/meshmodels/relationships/bulk", string(body), orgID, nil), nil, nil, nil)
+	var response models.MeshmodelRelationshipsBulkRegistrationResponse
+	if err := json.Unmarshal(rw.Body.Bytes(), &response); err != nil {
+		t.Fatalf("the response %q is not a registration report: %v", rw.Body.String(), err)
+	}
+	return rw.Code, response
+}
+
+// getRelationships returns the relationships of the kubernetes model listed to a user of the organization
+func getRelationships(t *testing.T, h *Handler, query, orgID string) models.MeshmodelRelationshipsAPIResponse {
+	t.Helper()
+	rw := httptest.NewRecorder()
+	h.GetAllMeshmodelRelationships(rw, relationshipsRequest(http.MethodGet, "/api/meshmodels/models/kubernetes/relationships?"+query, "", orgID, map[string]string{"model": "kubernetes"}))
+	var response models.MeshmodelRelationshipsAPIResponse
+	if err := json.Unmarshal(rw.Body.Bytes(), &response); err != nil {
+		t.Fatalf("the response %q is not a page of relationships: %v", rw.Body.String(), err)
+	}
+	return response
+}
+
+func TestRegisterMeshmodelRelationshipsBulk(t *testing.T) {
+	h := relationshipsHandler(t)
+
+	invalid := testRelationship("Edge", "Network", "Service")
+	invalid.Selectors["allow"].(map[string]interface{})["from"] = []interface{}{map[string]interface{}{"kind": "Service"}}
+	status, response := registerRelationships(t, h, "", testRelationship("Hierarchical", "Parent", "Pod"), invalid)
+	if status != http.StatusUnprocessableEntity || response.Registered != 0 || response.Failed != 1 {
+		t.Fatalf("the registration of an invalid relationship = %d %+v, want 422 and nothing registered", status, response)
+	}
+	if errs := response.Results[1].ValidationErrors; len(errs) == 0 || !strings.HasPrefix(errs[0].Path, "/selectors/allow/from") {
+		t.Errorf("the validation errors of the invalid relationship are %+v", errs)
+	}
+	if got := getRelationships(t, h, "", ""); got.Count != 0 {
+		t.Errorf("%d relationships were registered along with the invalid one", got.Count)
+	}
+
+	status, response = registerRelationships(t, h, "", testRelationship("Hierarchical", "Parent", "Pod"), testRelationship("Edge", "Network", "Service"))
+	if status != http.StatusOK || response.Registered != 2 || !response.Results[0].Registered || !response.Results[1].Registered {
+		t.Fatalf("the registration = %d %+v, want 2 relationships registered", status, response)
+	}
+
+	status, response = registerRelationships(t, h, "", testRelationship("Edge", "Network", "Service"))
+	if status != http.StatusConflict || response.Results[0].Duplicate == nil {
+		t.Errorf("the registration of a duplicate = %d %+v, want 409 and the registered relationship", status, response)
+	}
+}
+
+func TestRegisterMeshmodelRelationshipsBulkOrg(t *testing.T) {
+	h := relationshipsHandler(t)
+
+	rel := testRelationship("Edge", "Network", "Service")
+	rel.Metadata[mesherymeshmodel.RelationshipOrgKey] = "org-b"
+	if status, response := registerRelationships(t, h, "org-a", rel); status != http.StatusOK {
+		t.Fatalf("the registration = %d %+v", status, response)
+	}
+	got := getRelationships(t, h, "", "org-a")
+	if got.Count != 1 || mesherymeshmodel.RelationshipOrg(got.Relationships[0].Metadata) != "org-a" {
+		t.Errorf("the relationships of org-a are %+v, want the registered one scoped to org-a", got.Relationships)
+	}
+	if got := getRelationships(t, h, "", "org-b"); got.Count != 0 {
+		t.Errorf("the relationship of org-a is listed to org-b: %+v", got.Relationships)
+	}
+
+	global := testRelationship("Hierarchical", "Parent", "Pod")
+	global.Metadata[mesherymeshmodel.RelationshipOrgKey] = "org-b"
+	if status, response := registerRelationships(t, h, "", global); status != http.StatusOK {
+		t.Fatalf("the registration = %d %+v", status, response)
+	}
+	got = getRelationships(t, h, "kind=Hierarchical", "org-c")
+	if got.Count != 1 || mesherymeshmodel.RelationshipOrg(got.Relationships[0].Metadata) != "" {
+		t.Errorf("the relationships listed to org-c are %+v, want the one registered without an organization, global", got.Relationships)
+	}
+
+	// the pages are taken from the relationships visible to the organization
+	got = getRelationships(t, h, "pagesize=1&page=2&order=kind", "org-a")
+	if got.Count != 2 || len(got.Relationships) != 1 || got.Relationships[0].Kind != "Hierarchical" {
+		t.Errorf("the second page of the relationships of org-a = count %d, %+v, want the global Hierarchical relationship out of 2", got.Count, got.Relationships)
+	}
+}
+
+func TestGetMeshmodelRelationshipHistoryOrg(t *testing.T) {
+	h := relationshipsHandler(t)
+	if status, response := registerRelationships(t, h, "org-a", testRelationship("Edge", "Network", "Service")); status != http.StatusOK {
+		t.Fatalf("the registration = %d %+v", status, response)
+	}
+	vars := map[string]string{"model": "kubernetes", "name": "Edge"}
+	rw := httptest.NewRecorder()
+	h.UpdateMeshmodelRelationship(rw, relationshipsRequest(http.MethodPatch, "/api/meshmodels/models/kubernetes/relationships/Edge", `{"metadata": {"description": "updated"}}`, "org-a", vars), nil, nil, nil)
+	if rw.Code != http.StatusOK {
+		t.Fatalf("the update of the relationship of org-a = %d %s", rw.Code, rw.Body.String())
+	}
+
+	for orgID, want := range map[string]int64{"org-a": 1, "org-b": 0, "": 0} {
+		rw := httptest.NewRecorder()
+		h.GetMeshmodelRelationshipHistory(rw, relationshipsRequest(http.MethodGet, "/api/meshmodels/models/kubernetes/relationships/Edge/history", "", orgID, vars))
+		var response models.MeshmodelRelationshipHistoryAPIResponse
+		if err := json.Unmarshal(rw.Body.Bytes(), &response); err != nil {
+			t.Fatalf("the response %q is not a history: %v", rw.Body.String(), err)
+		}
+		if response.Count != want {
+			t.Errorf("%d revisions of the relationship of org-a are listed to %q, want %d", response.Count, orgID, want)
+		}
+		if response.Page != 1 || response.PageSize != DefaultPageSizeForMeshModelComponents {
+			t.Errorf("the history listed to %q is page %d of size %d, want the first page of size %d", orgID, response.Page, response.PageSize, DefaultPageSizeForMeshModelComponents)
+		}
+	}
+}
+
+func TestGetAllMeshmodelRelationships(t *testing.T) {
+	h := relationshipsHandler(t)
+	if status, response := registerRelationships(t, h, "",
+		testRelationship("Hierarchical", "Parent", "Pod"),
+		testRelationship("Hierarchical", "Inventory", "Deployment"),
+		testRelationship("Edge", "Network", "Service"),
+	); status != http.StatusOK {
+		t.Fatalf("the registration = %d %+v", status, response)
+	}
+
+	tests := []struct {
+		query     string
+		count     int64
+		pageSize  int
+		page      int
+		returned  int
+		wantKinds string
+	}{
+		{"kind=Hierarchical", 2, 25, 1, 2, "Hierarchical"},
+		{"kind=Hierarchical&subtype=Parent", 1, 25, 1, 1, "Hierarchical"},
+		{"subtype=Network", 1, 25, 1, 1, "Edge"},
+		{"pagesize=2&page=2&order=kind", 3, 2, 2, 1, "Hierarchical"},
+		{"pagesize=all", 3, 3, 1, 3, ""},
+		{"selectorKind=Deployment", 1, 25, 1, 1, "Hierarchical"},
+	}
+	for _, tt := range tests {
+		got := getRelationships(t, h, tt.query, "")
+		if got.Count != tt.count || got.PageSize != tt.pageSize || got.Page != tt.page || len(got.Relationships) != tt.returned {
+			t.Errorf("?%s = count %d, page size %d, page %d, %d relationships, want %d, %d, %d, %d",
+				tt.query, got.Count, got.PageSize, got.Page, len(got.Relationships), tt.count, tt.pageSize, tt.page, tt.returned)
+			continue
+		}
+		for _, rel := range got.Relationships {
+			if tt.wantKinds != "" && rel.Kind != tt.wantKinds {
+				t.Errorf("?%s returned a relationship of kind %s", tt.query, rel.Kind)
+			}
+		}
+	}
+}
+
+func TestDeleteMeshmodelRelationship(t *testing.T) {
+	h := relationshipsHandler(t)
+	if status, response := registerRelationships(t, h, "org-a", testRelationship("Edge", "Network", "Service")); status != http.StatusOK {
+		t.Fatalf("the registration = %d %+v", status, response)
+	}
+	vars := map[string]string{"model": "kubernetes", "name": "Edge"}
+
+	rw := httptest.NewRecorder()
+	h.DeleteMeshmodelRelationship(rw, relationshipsRequest(http.MethodDelete, "/api/meshmodels/models/kubernetes/relationships/Edge", "", "org-b", vars), nil, nil, nil)
+	if rw.Code != http.StatusNotFound {
+		t.Errorf("the deletion of the relationship of org-a by org-b = %d, want 404", rw.Code)
+	}
+
+	rw = httptest.NewRecorder()
+	h.DeleteMeshmodelRelationship(rw, relationshipsRequest(http.MethodDelete, "/api/meshmodels/models/kubernetes/relationships/Edge", "", "org-a", vars), nil, nil, nil)
+	if rw.Code != http.StatusOK || strings.TrimSpace(rw.Body.String()) != `{"deleted":1}` {
+		t.Errorf("the deletion of the relationship of org-a = %d %s", rw.Code, rw.Body.String())
+	}
+	if got := getRelationships(t, h, "", "org-a"); got.Count != 0 {
+		t.Errorf("the deleted relationship is listed: %+v", got.Relationships)
+	}
+}
+
+func TestRelationshipsRequestOrg(t *testing.T) {
+	h := relationshipsHandler(t)
+	if status, response := registerRelationships(t, h, "", testRelationship("Edge", "Network", "Service")); status != http.StatusOK {
+		t.Fatalf("the registration = %d %+v", status, response)
+	}
+	withProvider := func(r *http.Request, provider models.Provider) *http.Request {
+		return r.WithContext(context.WithValue(r.Context(), models.ProviderCtxKey, provider))
+	}
+	unknownOrg := orgProvider{err: fmt.Errorf("the token has expired")}
+
+	// the requests of the users whose organization can not be determined are refused, rather than served as global
+	body, err := json.Marshal(models.MeshmodelRelationshipsBulkRegistrationRequest{Host: meshmodel.Host{Hostname: "meshery-test"}, Relationships: []v1alpha1.RelationshipDefinition{testRelationship("Hierarchical", "Parent", "Pod")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rw := httptest.NewRecorder()
+	h.RegisterMeshmodelRelationshipsBulk(rw, withProvider(httptest.NewRequest(http.MethodPost, "/api/meshmodels/relationships/bulk", strings.NewReader(string(body))), unknownOrg), nil, nil, nil)
+	if rw.Code != http.StatusUnauthorized {
+		t.Errorf("the registration by a user of an unknown organization = %d, want 401", rw.Code)
+	}
+	vars := map[string]string{"model": "kubernetes", "name": "Edge"}
+	rw = httptest.NewRecorder()
+	h.DeleteMeshmodelRelationship(rw, withProvider(mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/api/meshmodels/models/kubernetes/relationships/Edge", nil), vars), unknownOrg), nil, nil, nil)
+	if rw.Code != http.StatusUnauthorized {
+		t.Errorf("the deletion by a user of an unknown organization = %d, want 401", rw.Code)
+	}
+	rw = httptest.NewRecorder()
+	h.GetAllMeshmodelRelationships(rw, withProvider(mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/api/meshmodels/models/kubernetes/relationships", nil), map[string]string{"model": "kubernetes"}), unknownOrg))
+	if rw.Code != http.StatusUnauthorized {
+		t.Errorf("the listing to a user of an unknown organization = %d, want 401", rw.Code)
+	}
+	if got := getRelationships(t, h, "", ""); got.Count != 1 || got.Relationships[0].Kind != "Edge" {
+		t.Errorf("the relationships are %+v, want the global Edge relationship only", got.Relationships)
+	}
+
+	// the providers which do not scope their users to organizations see and manage the global relationships
+	rw = httptest.NewRecorder()
+	h.DeleteMeshmodelRelationship(rw, withProvider(mux.SetURLVars(httptest.NewRequest(http.MethodDelete, "/api/meshmodels/models/kubernetes/relationships/Edge", nil), vars), struct{ models.Provider }{}), nil, nil, nil)
+	if rw.Code != http.StatusOK {
+		t.Errorf("the deletion of a global relationship by a user of a provider without organizations = %d %s", rw.Code, rw.Body.String())
+	}
+}
+
+func TestUpdateMeshmodelRelationship(t *testing.T) {
+	h := relationshipsHandler(t)
+	if status, response := registerRelationships(t, h, "org-a", testRelationship("Edge", "Network", "Service")); status != http.StatusOK {
+		t.Fatalf("the registration = %d %+v", status, response)
+	}
+	vars := map[string]string{"model": "kubernetes", "name": "Edge"}
+	patch := `{"metadata": {"description": "updated", "orgID": "org-b"}}`
+
+	rw := httptest.NewRecorder()
+	h.UpdateMeshmodelRelationship(rw, relationshipsRequest(http.MethodPatch, "/api/meshmodels/models/kubernetes/relationships/Edge", patch, "org-b", vars), nil, nil, nil)
+	if rw.Code != http.StatusNotFound {
+		t.Errorf("the update of the relationship of org-a by org-b = %d, want 404", rw.Code)
+	}
+
+	rw = httptest.NewRecorder()
+	h.UpdateMeshmodelRelationship(rw, relationshipsRequest(http.MethodPatch, "/api/meshmodels/models/kubernetes/relationships/Edge", patch, "org-a", vars), nil, nil, nil)
+	if rw.Code != http.StatusOK {
+		t.Fatalf("the update of the relationship of org-a = %d %s", rw.Code, rw.Body.String())
+	}
+	got := getRelationships(t, h, "", "org-a")
+	if got.Count != 1 {
+		t.Fatalf("the relationships of org-a are %+v", got.Relationships)
+	}
+	metadata := got.Relationships[0].Metadata
+	if metadata["description"] != "updated" || mesherymeshmodel.RelationshipVersion(got.Relationships[0]) != 2 || mesherymeshmodel.RelationshipOrg(metadata) != "org-a" {
+		t.Errorf("the metadata of the updated relationship are %v, want the description updated, the version 2 and the organization org-a", metadata)
+	}
+}
+
+func TestExportMeshmodelRelationships(t *testing.T) {
+	h := relationshipsHandler(t)
+	if status, response := registerRelationships(t, h, "", testRelationship("Edge", "Network", "Service")); status != http.StatusOK {
+		t.Fatalf("the registration = %d %+v", status, response)
+	}
+
+	rw := httptest.NewRecorder()
+	h.ExportMeshmodelRelationships(rw, relationshipsRequest(http.MethodGet, "/api/meshmodels/models/kubernetes/relationships/export", "", "", map[string]string{"model": "kubernetes"}))
+	if rw.Code != http.StatusOK || rw.Header().Get("Content-Type") != "application/gzip" || rw.Body.Len() == 0 {
+		t.Errorf("the export = %d %s, want a gzip tarball", rw.Code, rw.Header().Get("Content-Type"))
+	}
+
+	rw = httptest.NewRecorder()
+	h.ExportMeshmodelRelationships(rw, relationshipsRequest(http.MethodGet, "/api/meshmodels/models/istio/relationships/export", "", "", map[string]string{"model": "istio"}))
+	if rw.Code != http.StatusNotFound {
+		t.Errorf("the export of a model without relationships = %d, want 404", rw.Code)
+	}
+}
+
+func TestGetMeshmodelRelationshipsProvenance(t *testing.T) {
+	h := relationshipsHandler(t)
+	if status, response := registerRelationships(t, h, "org-a", testRelationship("Edge", "Network", "Service")); status != http.StatusOK {
+		t.Fatalf("the registration = %d %+v", status, response)
+	}
+	if status, response := registerRelationships(t, h, "", testRelationship("Hierarchical", "Parent", "Pod")); status != http.StatusOK {
+		t.Fatalf("the registration = %d %+v", status, response)
+	}
+
+	tests := []struct {
+		query string
+		orgID string
+		count int64
+	}{
+		{"", "org-a", 2},
+		{"", "org-b", 1},
+		{"registrant=Meshery-Test&source=api", "org-a", 2},
+		{"source=static", "org-a", 0},
+		{"pagesize=1&page=2", "org-a", 2},
+	}
+	for _, tt := range tests {
+		rw := httptest.NewRecorder()
+		h.GetMeshmodelRelationshipsProvenance(rw, relationshipsRequest(http.MethodGet, "/api/meshmodels/relationships/provenance?"+tt.query, "", tt.orgID, nil))
+		var response models.MeshmodelRelationshipsProvenanceAPIResponse
+		if err := json.Unmarshal(rw.Body.Bytes(), &response); err != nil {
+			t.Fatalf("the response %q is not a provenance page: %v", rw.Body.String(), err)
+		}
+		if response.Count != tt.count {
+			t.Errorf("?%s lists %d relationships to %q, want %d", tt.query, response.Count, tt.orgID, tt.count)
+		}
+		for _, p := range response.Provenance {
+			if p.Registrant != "meshery-test" || p.Source != mesherymeshmodel.RelationshipSourceAPI {
+				t.Errorf("?%s lists a relationship registered by %s through %q", tt.query, p.Registrant, p.Source)
+			}
+		}
+	}
+}
+
+func TestMeshmodelRelationshipPolicies(t *testing.T) {
+	h := relationshipsHandler(t)
+	policy := `package meshery.relationships
+
+deny[msg] {
+	input.subType == "Mount"
+	msg := "relationships of subType Mount are not allowed"
+}`
+	body, _ := json.Marshal(mesherymeshmodel.RelationshipPolicy{Name: "no-mount", Module: policy})
+	rw := httptest.NewRecorder()
+	h.SaveMeshmodelRelationshipPolicy(rw, relationshipsRequest(http.MethodPost, "/api/meshmodels/relationships/policies", string(body), "", nil), nil, nil, nil)
+	if rw.Code != http.StatusOK {
+		t.Fatalf("the registration of the policy = %d %s", rw.Code, rw.Body.String())
+	}
+	rw = httptest.NewRecorder()
+	h.SaveMeshmodelRelationshipPolicy(rw, relationshipsRequest(http.MethodPost, "/api/meshmodels/relationships/policies", `{"name": "invalid", "module": "package"}`, "", nil), nil, nil, nil)
+	if rw.Code != http.StatusBadRequest {
+		t.Errorf("the registration of a policy which does not compile = %d, want 400", rw.Code)
+	}
+	body, _ = json.Marshal(mesherymeshmodel.RelationshipPolicy{Name: "no-unused", Module: strings.ReplaceAll(policy, "Mount", "Unused")})
+	rw = httptest.NewRecorder()
+	h.SaveMeshmodelRelationshipPolicy(rw, relationshipsRequest(http.MethodPost, "/api/meshmodels/relationships/policies", string(body), "", nil), nil, nil, nil)
+	if rw.Code != http.StatusOK {
+		t.Fatalf("the registration of the policy = %d %s", rw.Code, rw.Body.String())
+	}
+	// the policies are listed by name
+	rw = httptest.NewRecorder()
+	h.GetMeshmodelRelationshipPolicies(rw, relationshipsRequest(http.MethodGet, "/api/meshmodels/relationships/policies?pagesize=1&page=2", "", "", nil))
+	var policies models.MeshmodelRelationshipPoliciesAPIResponse
+	if err := json.Unmarshal(rw.Body.Bytes(), &policies); err != nil {
+		t.Fatalf("the response %q is not a list of policies: %v", rw.Body.String(), err)
+	}
+	if policies.Page != 2 || policies.PageSize != 1 || policies.Count != 2 || len(policies.Policies) != 1 || policies.Policies[0].Name != "no-unused" {
+		t.Errorf("the second page of the policies = page %d of size %d, %d policies out of %d, want no-unused out of 2", policies.Page, policies.PageSize, len(policies.Policies), policies.Count)
+	}
+
+	status, response := registerRelationships(t, h, "", testRelationship("Edge", "Network", "Service"), testRelationship("Edge", "Mount", "Pod"))
+	if status != http.StatusForbidden || response.Registered != 0 {
+		t.Fatalf("the registration of a relationship violating a policy = %d %+v, want 403 and nothing registered", status, response)
+	}
+	if violations := response.Results[1].Violations; len(violations) != 1 || violations[0].Policy != "no-mount" {
+		t.Errorf("the violations of the relationship are %+v, want the one of no-mount", violations)
+	}
+
+	rw = httptest.NewRecorder()
+	h.DeleteMeshmodelRelationshipPolicy(rw, relationshipsRequest(http.MethodDelete, "/api/meshmodels/relationships/policies/no-mount", "", "", map[string]string{"name": "no-mount"}), nil, nil, nil)
+	if rw.Code != http.StatusOK {
+		t.Fatalf("the deletion of the policy = %d %s", rw.Code, rw.Body.String())
+	}
+	if status, response := registerRelationships(t, h, "", testRelationship("Edge", "Mount", "Pod")); status != http.StatusOK {
+		t.Errorf("the registration once the policy is deleted = %d %+v", status, response)
+	}
+	rw = httptest.NewRecorder()
+	h.DeleteMeshmodelRelationshipPolicy(rw, relationshipsRequest(http.MethodDelete, "/api/meshmodels/relationships/policies/no-mount", "", "", map[string]string{"name": "no-mount"}), nil, nil, nil)
+	if rw.Code != http.StatusNotFound {
+		t.Errorf("the deletion of a policy which does not exist = %d, want 404", rw.Code)
+	}
+}
+
+// sessionProvider is a provider whose session is the one of the user, in the organization
+type sessionProvider struct {
+	orgProvider
+	user *models.User
+}
+
+func (p sessionProvider) GetSession(*http.Request) error {
+	return nil
+}
+
+func (p sessionProvider) GetUserDetails(*http.Request) (*models.User, error) {
+	return p.user, nil
+}
+
+func (p sessionProvider) ReadFromPersister(string) (*models.Preference, error) {
+	return &models.Preference{}, nil
+}
+
+func (p sessionProvider) UpdateToken(http.ResponseWriter, *http.Request) string {
+	return ""
+}
+
+// sessionRequest serves the request of the user through the session of the route, which authorizes it
+func sessionRequest(h *Handler, method, tmpl, target, body string, user *models.User, token *models.APIToken, next func(http.ResponseWriter, *http.Request, *models.Preference, *models.User, models.Provider)) *httptest.ResponseRecorder {
+	router := mux.NewRouter()
+	router.Handle(tmpl, h.SessionInjectorMiddleware(next)).Methods(method)
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	ctx := context.WithValue(r.Context(), models.ProviderCtxKey, sessionProvider{user: user})
+	if token != nil {
+		ctx = context.WithValue(ctx, models.APITokenCtxKey, token)
+	}
+	rw := httptest.NewRecorder()
+	router.ServeHTTP(rw, r.WithContext(ctx))
+	return rw
+}
+
+func TestMeshmodelRelationshipPoliciesAdminOnly(t *testing.T) {
+	h := relationshipsHandler(t)
+	if err := h.dbHandler.AutoMigrate(&models.RoleBinding{}); err != nil {
+		t.Fatal(err)
+	}
+	viper.Set("RBAC_ENABLED", true)
+	viper.Set("RBAC_DEFAULT_ROLE", string(models.ViewerRole))
+	viper.Set("RBAC_ADMINS", []string{"admin"})
+	t.Cleanup(func() {
+		viper.Set("RBAC_ENABLED", false)
+		viper.Set("RBAC_DEFAULT_ROLE", "")
+		viper.Set("RBAC_ADMINS", nil)
+	})
+	viewer, admin := &models.User{ID: "viewer"}, &models.User{ID: "admin"}
+	viewToken := &models.APIToken{}
+	if err := viewToken.SetScopes([]models.Permission{models.ViewPermission}); err != nil {
+		t.Fatal(err)
+	}
+	body, _ := json.Marshal(mesherymeshmodel.RelationshipPolicy{Name: "no-mount", Module: `package meshery.relationships
+
+deny[msg] {
+	input.subType == "Mount"
+	msg := "relationships of subType Mount are not allowed"
+}`})
+	save := func(user *models.User, token *models.APIToken) int {
+		return sessionRequest(h, http.MethodPost, "/api/meshmodels/relationships/policies", "/api/meshmodels/relationships/policies", string(body), user, token, h.SaveMeshmodelRelationshipPolicy).Code
+	}
+	remove := func(user *models.User, token *models.APIToken) int {
+		return sessionRequest(h, http.MethodDelete, "/api/meshmodels/relationships/policies/{name}", "/api/meshmodels/relationships/policies/no-mount", "", user, token, h.DeleteMeshmodelRelationshipPolicy).Code
+	}
+
+	if code := save(viewer, nil); code != http.StatusForbidden {
+		t.Errorf("the registration of a policy by a viewer = %d, want 403", code)
+	}
+	if code := save(admin, viewToken); code != http.StatusForbidden {
+		t.Errorf("the registration of a policy with a view token = %d, want 403", code)
+	}
+	if code := save(admin, nil); code != http.StatusOK {
+		t.Fatalf("the registration of a policy by an admin = %d, want 200", code)
+	}
+	if code := remove(viewer, nil); code != http.StatusForbidden {
+		t.Errorf("the deletion of a policy by a viewer = %d, want 403", code)
+	}
+	if code := remove(admin, viewToken); code != http.StatusForbidden {
+		t.Errorf("the deletion of a policy with a view token = %d, want 403", code)
+	}
+	if code := remove(admin, nil); code != http.StatusOK {
+		t.Errorf("the deletion of a policy by an admin = %d, want 200", code)
+	}
+}
+
+func TestImportRegistryBundleAdminOnly(t *testing.T) {
+	h := relationshipsHandler(t)
+	if err := h.dbHandler.AutoMigrate(&models.RoleBinding{}); err != nil {
+		t.Fatal(err)
+	}
+	viper.Set("RBAC_ENABLED", true)
+	viper.Set("RBAC_DEFAULT_ROLE", string(models.OperatorRole))
+	viper.Set("RBAC_ADMINS", []string{"admin"})
+	t.Cleanup(func() {
+		viper.Set("RBAC_ENABLED", false)
+		viper.Set("RBAC_DEFAULT_ROLE", "")
+		viper.Set("RBAC_ADMINS", nil)
+	})
+	editToken := &models.APIToken{}
+	if err := editToken.SetScopes([]models.Permission{models.EditPermission}); err != nil {
+		t.Fatal(err)
+	}
+	importBundle := func(user *models.User, token *models.APIToken) int {
+		return sessionRequest(h, http.MethodPost, "/api/meshmodels/import", "/api/meshmodels/import", "not a bundle", user, token, h.ImportRegistryBundle).Code
+	}
+
+	if code := importBundle(&models.User{ID: "operator"}, nil); code != http.StatusForbidden {
+		t.Errorf("the import of a bundle by an operator = %d, want 403", code)
+	}
+	if code := importBundle(&models.User{ID: "admin"}, editToken); code != http.StatusForbidden {
+		t.Errorf("the import of a bundle with an edit token = %d, want 403", code)
+	}
+	// the admins get past the authorization, to the reading of the bundle
+	if code := importBundle(&models.User{ID: "admin"}, nil); code != http.StatusBadRequest {
+		t.Errorf("the import of an invalid bundle by an admin = %d, want 400", code)
+	}
+}
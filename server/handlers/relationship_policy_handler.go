@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
+)
+
+// swagger:route GET /api/meshmodels/relationships/policies GetMeshmodelRelationshipPolicies idGetMeshmodelRelationshipPolicies
+// Handle GET request for listing the policies relationship definitions are validated against before registration, by name.
+//
+// ```?page={page-number}``` Default page number is 1
+//
+// ```?pagesize={pagesize}``` Default pagesize is 25. To return all results: ```pagesize=all```
+// responses:
+//
+//	200: meshmodelRelationshipPoliciesResponseWrapper
+func (h *Handler) GetMeshmodelRelationshipPolicies(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Add("Content-Type", "application/json")
+	policies, err := mesherymeshmodel.ListRelationshipPolicies(h.dbHandler)
+	if err != nil {
+		h.log.Error(ErrRelationshipPolicy(err))
+		http.Error(rw, ErrRelationshipPolicy(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	page, offset, limit := getMeshmodelRelationshipsPaginationParams(r)
+	count := int64(len(policies))
+	start, end := relationshipsPageBounds(len(policies), offset, limit)
+	if err := json.NewEncoder(rw).Encode(models.MeshmodelRelationshipPoliciesAPIResponse{
+		Page:     page,
+		PageSize: relationshipsPageSize(limit, count),
+		Count:    count,
+		Policies: policies[start:end],
+	}); err != nil {
+		h.log.Error(ErrWorkloadDefinition(err))
+		http.Error(rw, ErrWorkloadDefinition(err).Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route POST /api/meshmodels/relationships/policies SaveMeshmodelRelationshipPolicy idPostMeshmodelRelationshipPolicy
+// Handle POST request for registering a Rego policy relationship definitions are validated against before registration.
+//
+// The policy is a Rego module in the ```meshery.relationships``` package. Every value of its ```deny``` set is reported as a violation,
+// and relationship definitions with violations are rejected with 403. A policy registered with the same name is replaced.
+// Only the admins can register the policies, as they gate the registration of every relationship.
+// responses:
+//
+//	200: RelationshipPolicy
+//	400:
+//	403:
+func (h *Handler) SaveMeshmodelRelationshipPolicy(rw http.ResponseWriter, r *http.Request, _ *models.Preference, _ *models.User, _ models.Provider) {
+	var policy mesherymeshmodel.RelationshipPolicy
+	if err := json.NewDecoder(r.Body).Decode(&policy); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := mesherymeshmodel.SaveRelationshipPolicy(h.dbHandler, &policy); err != nil {
+		h.log.Error(ErrRelationshipPolicy(err))
+		http.Error(rw, ErrRelationshipPolicy(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	rw.Header().Add("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(policy); err != nil {
+		h.log.Error(ErrWorkloadDefinition(err))
+		http.Error(rw, ErrWorkloadDefinition(err).Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route DELETE /api/meshmodels/relationships/policies/{name} DeleteMeshmodelRelationshipPolicy idDeleteMeshmodelRelationshipPolicy
+// Handle DELETE request for removing a relationship validation policy, which only the admins can do.
+// responses:
+//
+//	200:
+//	403:
+//	404:
+func (h *Handler) DeleteMeshmodelRelationshipPolicy(rw http.ResponseWriter, r *http.Request, _ *models.Preference, _ *models.User, _ models.Provider) {
+	name := mux.Vars(r)["name"]
+	deleted, err := mesherymeshmodel.DeleteRelationshipPolicy(h.dbHandler, name)
+	if err != nil {
+		h.log.Error(ErrRelationshipPolicy(err))
+		http.Error(rw, ErrRelationshipPolicy(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if !deleted {
+		http.Error(rw, fmt.Sprintf("relationship policy %s not found", name), http.StatusNotFound)
+		return
+	}
+	rw.WriteHeader(http.StatusOK)
+}
+
+// writeRelationshipPolicyError responds with 403 and the violations when err is a policy violation, any other error is an evaluation failure
+func (h *Handler) writeRelationshipPolicyError(rw http.ResponseWriter, err error) {
+	perr, ok := err.(*mesherymeshmodel.RelationshipPolicyError)
+	if !ok {
+		h.log.Error(ErrRelationshipPolicy(err))
+		http.Error(rw, ErrRelationshipPolicy(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.log.Info(perr.Error())
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusForbidden)
+	if err := json.NewEncoder(rw).Encode(perr); err != nil {
+		h.log.Error(ErrWorkloadDefinition(err))
+	}
+}
@@ -0,0 +1,303 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/spf13/viper"
+)
+
+// swagger:route GET /api/webhooks WebhooksAPI idGetWebhooks
+// Handle GET request for the webhooks of the user
+//
+// Returns the webhooks of the user, most recent first, without their secrets.
+// responses:
+//
+//	200: webhooksRespWrapper
+//	500:
+func (h *Handler) GetWebhooksHandler(
+	rw http.ResponseWriter,
+	_ *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	webhooks, err := (&models.WebhookPersister{DB: h.dbHandler}).GetWebhooks(user.ID)
+	if err != nil {
+		h.log.Error(ErrWebhook(err))
+		http.Error(rw, ErrWebhook(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(webhooks); err != nil {
+		h.log.Error(models.ErrEncoding(err, "webhooks"))
+		http.Error(rw, models.ErrEncoding(err, "webhooks").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route POST /api/webhooks WebhooksAPI idCreateWebhook
+// Handle POST request for registering a webhook
+//
+// Registers a URL the events of the given types are delivered to, eg:
+// {"name": "ci", "url": "https://ci.example.com/hooks/meshery", "event_types": ["deployment.finished", "drift.detected"]}.
+// The event types are deployment.finished, drift.detected and relationship.registered, the relationships registered in
+// an organization being delivered to the webhooks of its users only. The events are POSTed as JSON
+// payloads signed with the secret of the webhook in the X-Meshery-Signature header: sha256=<hex HMAC-SHA256 of the
+// X-Meshery-Timestamp header, a dot and the body>. A random secret is generated when the body has none, the secret is
+// only returned by this request. The deliveries failing are attempted again with an exponential backoff. The URLs of
+// loopback, link-local and private addresses are rejected unless WEBHOOK_ALLOW_PRIVATE_NETWORKS is true.
+// responses:
+//
+//	201: createdWebhookRespWrapper
+//	400:
+//	401:
+//	500:
+func (h *Handler) CreateWebhookHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	var body models.WebhookRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	orgID, err := h.getRequestOrgID(r)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	webhook := models.Webhook{UserID: user.ID, OrgID: orgID, Enabled: true}
+	if err := webhook.Apply(body, viper.GetBool("WEBHOOK_ALLOW_PRIVATE_NETWORKS")); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if webhook.Secret == "" {
+		secret, err := models.NewWebhookSecret()
+		if err != nil {
+			h.log.Error(ErrWebhook(err))
+			http.Error(rw, ErrWebhook(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		webhook.Secret = secret
+	}
+	if err := (&models.WebhookPersister{DB: h.dbHandler}).SaveWebhook(&webhook); err != nil {
+		h.log.Error(ErrWebhook(err))
+		http.Error(rw, ErrWebhook(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(rw).Encode(models.CreatedWebhook{Webhook: webhook, Secret: webhook.Secret}); err != nil {
+		h.log.Error(models.ErrEncoding(err, "webhook"))
+	}
+}
+
+// swagger:route PUT /api/webhooks/{id} WebhooksAPI idUpdateWebhook
+// Handle PUT request for updating a webhook
+//
+// Replaces the name, the URL and the event types of the webhook of the user with the given ID, and enables or disables
+// it with enabled. The secret is replaced when the body has one. The pending deliveries of a disabled webhook fail.
+// responses:
+//
+//	200: webhookRespWrapper
+//	400:
+//	401:
+//	404:
+//	500:
+func (h *Handler) UpdateWebhookHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	webhook, ok := h.userWebhook(rw, r, user)
+	if !ok {
+		return
+	}
+	var body models.WebhookRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := webhook.Apply(body, viper.GetBool("WEBHOOK_ALLOW_PRIVATE_NETWORKS")); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	orgID, err := h.getRequestOrgID(r)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	webhook.OrgID = orgID
+	if err := (&models.WebhookPersister{DB: h.dbHandler}).SaveWebhook(webhook); err != nil {
+		h.log.Error(ErrWebhook(err))
+		http.Error(rw, ErrWebhook(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(webhook); err != nil {
+		h.log.Error(models.ErrEncoding(err, "webhook"))
+	}
+}
+
+// swagger:route DELETE /api/webhooks/{id} WebhooksAPI idDeleteWebhook
+// Handle DELETE request for deleting a webhook
+//
+// Deletes the webhook of the user with the given ID along with its deliveries.
+// responses:
+//
+//	204:
+//	400:
+//	404:
+//	500:
+func (h *Handler) DeleteWebhookHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("invalid webhook id %q", mux.Vars(r)["id"]), http.StatusBadRequest)
+		return
+	}
+	deleted, err := (&models.WebhookPersister{DB: h.dbHandler}).DeleteWebhook(id, user.ID)
+	if err != nil {
+		h.log.Error(ErrWebhook(err))
+		http.Error(rw, ErrWebhook(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if !deleted {
+		http.Error(rw, fmt.Sprintf("webhook %s not found", id), http.StatusNotFound)
+		return
+	}
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+// swagger:route GET /api/webhooks/{id}/deliveries WebhooksAPI idGetWebhookDeliveries
+// Handle GET request for the delivery log of a webhook
+//
+// Returns the deliveries of the webhook of the user with the given ID, most recent first, along with their payload,
+// their number of attempts, and the status code or the error of their last attempt. The deliveries can be filtered with
+// the status query parameter: pending, succeeded or failed. The page and pagesize query parameters paginate them.
+// responses:
+//
+//	200: webhookDeliveriesRespWrapper
+//	400:
+//	404:
+//	500:
+func (h *Handler) GetWebhookDeliveriesHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	webhook, ok := h.userWebhook(rw, r, user)
+	if !ok {
+		return
+	}
+	page, offset, limit, _, _, _, status := getPaginationParams(r)
+	deliveries, count, err := (&models.WebhookPersister{DB: h.dbHandler}).GetWebhookDeliveries(webhook.ID, status, offset, limit)
+	if err != nil {
+		h.log.Error(ErrWebhook(err))
+		http.Error(rw, ErrWebhook(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(models.WebhookDeliveriesPage{
+		Page:       page,
+		PageSize:   limit,
+		TotalCount: count,
+		Deliveries: deliveries,
+	}); err != nil {
+		h.log.Error(models.ErrEncoding(err, "webhook deliveries"))
+		http.Error(rw, models.ErrEncoding(err, "webhook deliveries").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route POST /api/webhooks/{id}/deliveries/{deliveryId}/redeliver WebhooksAPI idRedeliverWebhookDelivery
+// Handle POST request for delivering an event to a webhook again
+//
+// Attempts the delivery of the webhook of the user with the given ID again, as many times as a new delivery,
+// eg: once the receiver of a failed delivery is fixed.
+// responses:
+//
+//	202:
+//	400:
+//	404:
+//	500:
+func (h *Handler) RedeliverWebhookDeliveryHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	_ *models.Preference,
+	user *models.User,
+	_ models.Provider,
+) {
+	webhook, ok := h.userWebhook(rw, r, user)
+	if !ok {
+		return
+	}
+	deliveryID, err := uuid.FromString(mux.Vars(r)["deliveryId"])
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("invalid delivery id %q", mux.Vars(r)["deliveryId"]), http.StatusBadRequest)
+		return
+	}
+	persister := &models.WebhookPersister{DB: h.dbHandler}
+	delivery, err := persister.GetWebhookDelivery(deliveryID, webhook.ID)
+	if err != nil {
+		h.log.Error(ErrWebhook(err))
+		http.Error(rw, ErrWebhook(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if delivery == nil {
+		http.Error(rw, fmt.Sprintf("delivery %s not found", deliveryID), http.StatusNotFound)
+		return
+	}
+	now := time.Now()
+	delivery.Status = models.WebhookDeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = &now
+	if err := persister.SaveWebhookDelivery(delivery); err != nil {
+		h.log.Error(ErrWebhook(err))
+		http.Error(rw, ErrWebhook(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.WriteHeader(http.StatusAccepted)
+}
+
+// userWebhook returns the webhook of the user with the id of the route, the error response is written and false is
+// returned when there is none
+func (h *Handler) userWebhook(rw http.ResponseWriter, r *http.Request, user *models.User) (*models.Webhook, bool) {
+	id, err := uuid.FromString(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(rw, fmt.Sprintf("invalid webhook id %q", mux.Vars(r)["id"]), http.StatusBadRequest)
+		return nil, false
+	}
+	webhook, err := (&models.WebhookPersister{DB: h.dbHandler}).GetWebhook(id, user.ID)
+	if err != nil {
+		h.log.Error(ErrWebhook(err))
+		http.Error(rw, ErrWebhook(err).Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	if webhook == nil {
+		http.Error(rw, fmt.Sprintf("webhook %s not found", id), http.StatusNotFound)
+		return nil, false
+	}
+	return webhook, true
+}
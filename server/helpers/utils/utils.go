@@ -113,6 +113,28 @@ func ToMapStringInterface(mp interface{}) map[string]interface{} {
 	return res
 }
 
+// MergePatch applies the given patch onto dest following JSON merge patch semantics (RFC 7386):
+// nested maps are merged recursively, nil values remove the key and any other value replaces the existing one
+func MergePatch(dest, patch map[string]interface{}) map[string]interface{} {
+	if dest == nil {
+		dest = map[string]interface{}{}
+	}
+	for k, v := range patch {
+		if v == nil {
+			delete(dest, k)
+			continue
+		}
+		patchMap, ok := v.(map[string]interface{})
+		if !ok {
+			dest[k] = v
+			continue
+		}
+		destMap, _ := dest[k].(map[string]interface{})
+		dest[k] = MergePatch(destMap, patchMap)
+	}
+	return dest
+}
+
 func IsClosed(ch chan struct{}) bool {
 	if ch == nil {
 		return true
@@ -0,0 +1,190 @@
+// Package compression compresses with gzip the responses of Meshery Server to the clients accepting it.
+package compression
+
+import (
+	"bufio"
+	"compress/gzip"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// minSize is the size under which responses are not compressed, the gzip header and footer would outweigh the savings
+const minSize = 1024
+
+var writers = sync.Pool{New: func() interface{} {
+	w, _ := gzip.NewWriterLevel(nil, gzip.DefaultCompression)
+	return w
+}}
+
+// Middleware compresses the responses with gzip when the request accepts it. The WebSocket upgrades, the event
+// streams, the responses already encoded and the small ones are not compressed. Flushing the response flushes the
+// compressed bytes, so that streamed responses keep being streamed.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !acceptsGzip(r) || r.Header.Get("Upgrade") != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Add("Vary", "Accept-Encoding")
+		gw := &responseWriter{ResponseWriter: w, method: r.Method}
+		defer gw.close()
+		next.ServeHTTP(gw, r)
+	})
+}
+
+func acceptsGzip(r *http.Request) bool {
+	for _, enc := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(enc), ";")
+		if strings.EqualFold(strings.TrimSpace(name), "gzip") && strings.ReplaceAll(strings.TrimSpace(params), " ", "") != "q=0" {
+			return true
+		}
+	}
+	return false
+}
+
+// responseWriter buffers the beginning of the response until it knows whether to compress it
+type responseWriter struct {
+	http.ResponseWriter
+	method string
+
+	status      int
+	wroteHeader bool
+	buf         []byte
+	// decided is set once the response is known to be compressed, gz is not nil, or not
+	decided bool
+	gz      *gzip.Writer
+}
+
+func (w *responseWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.status = status
+	// responses without a body are not compressed
+	if status < http.StatusOK || status == http.StatusNoContent || status == http.StatusNotModified || w.method == http.MethodHead {
+		_ = w.decide(false)
+	}
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if !w.decided {
+		w.buf = append(w.buf, b...)
+		if len(w.buf) < minSize {
+			return len(b), nil
+		}
+		if err := w.decide(w.compressible()); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+	if w.gz != nil {
+		return w.gz.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// compressible reports whether the response is worth compressing given its headers
+func (w *responseWriter) compressible() bool {
+	h := w.Header()
+	if h.Get("Content-Encoding") != "" {
+		return false
+	}
+	ct := h.Get("Content-Type")
+	if ct == "" {
+		ct = http.DetectContentType(w.buf)
+		h.Set("Content-Type", ct)
+	}
+	// the events are streamed, they must not wait for the compressor
+	if strings.HasPrefix(ct, "text/event-stream") {
+		return false
+	}
+	for _, prefix := range []string{"image/png", "image/jpeg", "image/gif", "application/zip", "application/gzip", "application/x-gzip", "application/octet-stream"} {
+		if strings.HasPrefix(ct, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// decide writes the header of the response, compressed or not, and the bytes buffered so far
+func (w *responseWriter) decide(compress bool) error {
+	w.decided = true
+	status := w.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	if compress {
+		h := w.Header()
+		h.Set("Content-Encoding", "gzip")
+		h.Del("Content-Length")
+		w.gz = writers.Get().(*gzip.Writer)
+		w.gz.Reset(w.ResponseWriter)
+	}
+	w.ResponseWriter.WriteHeader(status)
+	buf := w.buf
+	w.buf = nil
+	if len(buf) == 0 {
+		return nil
+	}
+	var err error
+	if w.gz != nil {
+		_, err = w.gz.Write(buf)
+	} else {
+		_, err = w.ResponseWriter.Write(buf)
+	}
+	return err
+}
+
+// Flush writes the buffered bytes of the response, compressing them if needed, and flushes the response.
+// A response flushed before its size is known is not compressed.
+func (w *responseWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if !w.decided {
+		_ = w.decide(false)
+	}
+	if w.gz != nil {
+		_ = w.gz.Flush()
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack lets the handlers take over the connection, the response is then not compressed
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	w.decided = true
+	return http.NewResponseController(w.ResponseWriter).Hijack()
+}
+
+// Unwrap returns the wrapped writer, for http.ResponseController
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *responseWriter) close() {
+	if !w.decided {
+		if !w.wroteHeader {
+			// the handler wrote nothing, the default status is written by net/http
+			if len(w.buf) == 0 {
+				return
+			}
+			w.status = http.StatusOK
+		}
+		// the whole response is smaller than minSize
+		_ = w.decide(false)
+	}
+	if w.gz != nil {
+		_ = w.gz.Close()
+		w.gz.Reset(nil)
+		writers.Put(w.gz)
+		w.gz = nil
+	}
+}
@@ -0,0 +1,111 @@
+package compression
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMiddleware(t *testing.T) {
+	large := `{"components":[` + strings.Repeat(`{"kind":"Deployment"},`, 100) + `{}]}`
+	tests := []struct {
+		name           string
+		acceptEncoding string
+		handler        http.HandlerFunc
+		wantGzip       bool
+		wantBody       string
+	}{
+		{
+			name:           "large response",
+			acceptEncoding: "gzip, deflate, br",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = io.WriteString(w, large)
+			},
+			wantGzip: true,
+			wantBody: large,
+		},
+		{
+			name:           "small response",
+			acceptEncoding: "gzip",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.WriteString(w, `{"page":0}`)
+			},
+			wantBody: `{"page":0}`,
+		},
+		{
+			name:           "gzip not accepted",
+			acceptEncoding: "gzip;q=0, deflate",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.WriteString(w, large)
+			},
+			wantBody: large,
+		},
+		{
+			name:           "archive",
+			acceptEncoding: "gzip",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/gzip")
+				_, _ = io.WriteString(w, large)
+			},
+			wantBody: large,
+		},
+		{
+			name:           "event stream",
+			acceptEncoding: "gzip",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/event-stream")
+				_, _ = io.WriteString(w, "data: ping\n\n")
+				w.(http.Flusher).Flush()
+				_, _ = io.WriteString(w, "data: "+large+"\n\n")
+			},
+			wantBody: "data: ping\n\ndata: " + large + "\n\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/meshmodels/components", nil)
+			req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			rec := httptest.NewRecorder()
+			Middleware(tt.handler).ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status %d", rec.Code)
+			}
+			body := rec.Body.String()
+			gotGzip := rec.Header().Get("Content-Encoding") == "gzip"
+			if gotGzip != tt.wantGzip {
+				t.Fatalf("Content-Encoding %q, want gzip %v", rec.Header().Get("Content-Encoding"), tt.wantGzip)
+			}
+			if gotGzip {
+				zr, err := gzip.NewReader(rec.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				b, err := io.ReadAll(zr)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = string(b)
+			}
+			if body != tt.wantBody {
+				t.Errorf("body %q, want %q", body, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestMiddlewareNoContent(t *testing.T) {
+	req := httptest.NewRequest(http.MethodDelete, "/api/pattern/1", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	rec := httptest.NewRecorder()
+	Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})).ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent || rec.Header().Get("Content-Encoding") != "" || rec.Body.Len() != 0 {
+		t.Errorf("status %d, Content-Encoding %q, body %q", rec.Code, rec.Header().Get("Content-Encoding"), rec.Body.String())
+	}
+}
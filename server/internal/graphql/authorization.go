@@ -0,0 +1,82 @@
+package graphql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const errForbidden = "FORBIDDEN"
+
+// mutationPermissions are the permissions required by the mutations which do not act on the clusters, the other
+// mutations require the deploy permission
+var mutationPermissions = map[string]models.Permission{
+	"createDesign": models.EditPermission,
+	"updateDesign": models.EditPermission,
+}
+
+// Authorization rejects the mutations whose permissions the user of the request is not granted, the routes of the
+// GraphQL API only requiring the view permission. The mutations are told from the parsed operation, so that neither a
+// comment nor another operation of the document hides them, whichever transport they are sent with.
+type Authorization struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = Authorization{}
+
+func (Authorization) ExtensionName() string {
+	return "Authorization"
+}
+
+func (Authorization) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (Authorization) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	if rc.Operation == nil || rc.Operation.Operation != ast.Mutation {
+		return nil
+	}
+	for _, field := range rootFields(rc.Operation.SelectionSet, map[string]bool{}) {
+		permission, ok := mutationPermissions[field]
+		if !ok {
+			permission = models.DeployPermission
+		}
+		if err := models.Authorize(ctx, permission); err != nil {
+			gqlErr := gqlerror.Errorf("%s: %s", field, err.Error())
+			gqlErr.Extensions = map[string]interface{}{
+				"code": errForbidden,
+			}
+			return gqlErr
+		}
+	}
+	return nil
+}
+
+// rootFields returns the names of the fields of the selections, along with those of the fragments they spread, leaving
+// out the introspection fields, visited holds the fragments being spread to not follow the fragments spreading themselves
+func rootFields(selections ast.SelectionSet, visited map[string]bool) []string {
+	fields := []string{}
+	for _, selection := range selections {
+		switch s := selection.(type) {
+		case *ast.Field:
+			if !strings.HasPrefix(s.Name, "__") {
+				fields = append(fields, s.Name)
+			}
+		case *ast.InlineFragment:
+			fields = append(fields, rootFields(s.SelectionSet, visited)...)
+		case *ast.FragmentSpread:
+			if s.Definition == nil || visited[s.Name] {
+				continue
+			}
+			visited[s.Name] = true
+			fields = append(fields, rootFields(s.Definition.SelectionSet, visited)...)
+			delete(visited, s.Name)
+		}
+	}
+	return fields
+}
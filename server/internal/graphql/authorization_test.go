@@ -0,0 +1,65 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+func operationContext(t *testing.T, query, operationName string) *graphql.OperationContext {
+	t.Helper()
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &graphql.OperationContext{RawQuery: query, Doc: doc, OperationName: operationName, Operation: doc.Operations.ForName(operationName)}
+}
+
+// roleContext returns the context of the requests of a user with the role
+func roleContext(role models.Role) context.Context {
+	return context.WithValue(context.Background(), models.AuthorizerCtxKey, models.Authorizer(func(permission models.Permission) error {
+		if !role.Can(permission) {
+			return models.ErrInvalidAPITokenScope(permission)
+		}
+		return nil
+	}))
+}
+
+func TestAuthorization(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		operationName string
+		role          models.Role
+		allowed       bool
+	}{
+		{"query", `query { getAvailableNamespaces { namespace } }`, "", models.ViewerRole, true},
+		{"mutation", `mutation { changeOperatorStatus(input: {}) }`, "", models.ViewerRole, false},
+		{"mutation after a comment", "# x\nmutation { changeOperatorStatus(input: {}) }", "", models.ViewerRole, false},
+		{"mutation of a document with a query", `query A { getAvailableNamespaces { namespace } } mutation B { deployDesign(input: {designID: "x"}) { designID } }`, "B", models.ViewerRole, false},
+		{"query of a document with a mutation", `query A { getAvailableNamespaces { namespace } } mutation B { deployDesign(input: {designID: "x"}) { designID } }`, "A", models.ViewerRole, true},
+		{"mutation in an inline fragment", `mutation { ... on Mutation { undeployDesign(input: {designID: "x"}) { designID } } }`, "", models.ViewerRole, false},
+		{"edit mutation of an operator", `mutation { createDesign(input: {designFile: ""}) { id } }`, "", models.OperatorRole, true},
+		{"deploy mutation of an operator", `mutation { deployDesign(input: {designID: "x"}) { designID } }`, "", models.OperatorRole, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Authorization{}.MutateOperationContext(roleContext(tt.role), operationContext(t, tt.query, tt.operationName))
+			if allowed := err == nil; allowed != tt.allowed {
+				t.Errorf("the %s role allowed = %t, want %t: %v", tt.role, allowed, tt.allowed, err)
+			}
+			if err != nil && err.Extensions["code"] != errForbidden {
+				t.Errorf("the error code is %v, want %s", err.Extensions["code"], errForbidden)
+			}
+		})
+	}
+
+	// the mutations sent without the session of a user are rejected
+	if err := (Authorization{}).MutateOperationContext(context.Background(), operationContext(t, `mutation { createDesign(input: {designFile: ""}) { id } }`, "")); err == nil {
+		t.Error("a mutation without the authorizer of a user was allowed")
+	}
+}
@@ -0,0 +1,76 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const errDepthLimitExceeded = "DEPTH_LIMIT_EXCEEDED"
+
+// DepthLimit rejects the operations whose selections are nested deeper than MaxDepth, before they are executed
+type DepthLimit struct {
+	MaxDepth int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = DepthLimit{}
+
+func (DepthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+func (d DepthLimit) Validate(schema graphql.ExecutableSchema) error {
+	if d.MaxDepth < 1 {
+		return fmt.Errorf("the max depth of the queries must be positive, got %d", d.MaxDepth)
+	}
+	return nil
+}
+
+func (d DepthLimit) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	depth := selectionSetDepth(rc.Operation.SelectionSet, map[string]bool{})
+	if depth > d.MaxDepth {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.MaxDepth)
+		err.Extensions = map[string]interface{}{
+			"code": errDepthLimitExceeded,
+		}
+		return err
+	}
+	return nil
+}
+
+// selectionSetDepth returns the depth of the deepest field of the selections, the fields of the fragments count at the
+// depth they are spread at and the introspection fields are left out, visited holds the fragments being spread to
+// not follow the fragments spreading themselves
+func selectionSetDepth(selections ast.SelectionSet, visited map[string]bool) int {
+	depth := 0
+	for _, selection := range selections {
+		d := 0
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			d = 1 + selectionSetDepth(s.SelectionSet, visited)
+		case *ast.InlineFragment:
+			d = selectionSetDepth(s.SelectionSet, visited)
+		case *ast.FragmentSpread:
+			if s.Definition == nil || visited[s.Name] {
+				continue
+			}
+			visited[s.Name] = true
+			d = selectionSetDepth(s.Definition.SelectionSet, visited)
+			delete(visited, s.Name)
+		}
+		if d > depth {
+			depth = d
+		}
+	}
+	return depth
+}
@@ -152,6 +152,29 @@ type ComplexityRoot struct {
 		Proxies func(childComplexity int) int
 	}
 
+	Design struct {
+		DesignFile func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Name       func(childComplexity int) int
+		UpdatedAt  func(childComplexity int) int
+	}
+
+	DesignDeployment struct {
+		DesignID func(childComplexity int) int
+		Summary  func(childComplexity int) int
+	}
+
+	DesignDeploymentProgress struct {
+		Component    func(childComplexity int) int
+		DeploymentID func(childComplexity int) int
+		DesignID     func(childComplexity int) int
+		Error        func(childComplexity int) int
+		Stage        func(childComplexity int) int
+		StageName    func(childComplexity int) int
+		Status       func(childComplexity int) int
+		Time         func(childComplexity int) int
+	}
+
 	Error struct {
 		Code        func(childComplexity int) int
 		Description func(childComplexity int) int
@@ -271,6 +294,10 @@ type ComplexityRoot struct {
 	Mutation struct {
 		ChangeAdapterStatus  func(childComplexity int, input *model.AdapterStatusInput) int
 		ChangeOperatorStatus func(childComplexity int, input *model.OperatorStatusInput) int
+		CreateDesign         func(childComplexity int, input model.DesignInput) int
+		DeployDesign         func(childComplexity int, input model.DesignDeployInput) int
+		UndeployDesign       func(childComplexity int, input model.DesignDeployInput) int
+		UpdateDesign         func(childComplexity int, id string, input model.DesignInput) int
 	}
 
 	NameSpace struct {
@@ -394,6 +421,23 @@ type ComplexityRoot struct {
 		ResyncCluster              func(childComplexity int, selector *model.ReSyncActions, k8scontextID string) int
 	}
 
+	RegistryChange struct {
+		Action       func(childComplexity int) int
+		EntityType   func(childComplexity int) int
+		Kind         func(childComplexity int) int
+		Model        func(childComplexity int) int
+		ModelVersion func(childComplexity int) int
+		SubType      func(childComplexity int) int
+		Timestamp    func(childComplexity int) int
+	}
+
+	RegistryUpdate struct {
+		Changes       func(childComplexity int) int
+		Components    func(childComplexity int) int
+		Models        func(childComplexity int) int
+		Relationships func(childComplexity int) int
+	}
+
 	Resource struct {
 		Count func(childComplexity int) int
 		Kind  func(childComplexity int) int
@@ -401,8 +445,10 @@ type ComplexityRoot struct {
 
 	Subscription struct {
 		ListenToOperatorState             func(childComplexity int, k8scontextIDs []string) int
+		RegistryUpdated                   func(childComplexity int) int
 		SubscribeClusterResources         func(childComplexity int, k8scontextIDs []string, namespace string) int
 		SubscribeConfiguration            func(childComplexity int, applicationSelector model.PageFilter, patternSelector model.PageFilter, filterSelector model.PageFilter) int
+		SubscribeDesignDeployment         func(childComplexity int, designID string) int
 		SubscribeEvents                   func(childComplexity int) int
 		SubscribeK8sContext               func(childComplexity int, selector model.PageFilter) int
 		SubscribeMeshModelSummary         func(childComplexity int, selector model.MeshModelSummarySelector) int
@@ -422,6 +468,10 @@ type ComplexityRoot struct {
 type MutationResolver interface {
 	ChangeOperatorStatus(ctx context.Context, input *model.OperatorStatusInput) (model.Status, error)
 	ChangeAdapterStatus(ctx context.Context, input *model.AdapterStatusInput) (model.Status, error)
+	CreateDesign(ctx context.Context, input model.DesignInput) (*model.Design, error)
+	UpdateDesign(ctx context.Context, id string, input model.DesignInput) (*model.Design, error)
+	DeployDesign(ctx context.Context, input model.DesignDeployInput) (*model.DesignDeployment, error)
+	UndeployDesign(ctx context.Context, input model.DesignDeployInput) (*model.DesignDeployment, error)
 }
 type QueryResolver interface {
 	GetAvailableAddons(ctx context.Context, filter *model.ServiceMeshFilter) ([]*model.AddonList, error)
@@ -453,6 +503,8 @@ type SubscriptionResolver interface {
 	SubscribeClusterResources(ctx context.Context, k8scontextIDs []string, namespace string) (<-chan *model.ClusterResources, error)
 	SubscribeK8sContext(ctx context.Context, selector model.PageFilter) (<-chan *model.K8sContextsPage, error)
 	SubscribeMeshModelSummary(ctx context.Context, selector model.MeshModelSummarySelector) (<-chan *model.MeshModelSummary, error)
+	RegistryUpdated(ctx context.Context) (<-chan *model.RegistryUpdate, error)
+	SubscribeDesignDeployment(ctx context.Context, designID string) (<-chan *model.DesignDeploymentProgress, error)
 	SubscribeEvents(ctx context.Context) (<-chan *model.Event, error)
 }
 
@@ -926,6 +978,104 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.DataPlane.Proxies(childComplexity), true
 
+	case "Design.designFile":
+		if e.complexity.Design.DesignFile == nil {
+			break
+		}
+
+		return e.complexity.Design.DesignFile(childComplexity), true
+
+	case "Design.id":
+		if e.complexity.Design.ID == nil {
+			break
+		}
+
+		return e.complexity.Design.ID(childComplexity), true
+
+	case "Design.name":
+		if e.complexity.Design.Name == nil {
+			break
+		}
+
+		return e.complexity.Design.Name(childComplexity), true
+
+	case "Design.updatedAt":
+		if e.complexity.Design.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Design.UpdatedAt(childComplexity), true
+
+	case "DesignDeployment.designID":
+		if e.complexity.DesignDeployment.DesignID == nil {
+			break
+		}
+
+		return e.complexity.DesignDeployment.DesignID(childComplexity), true
+
+	case "DesignDeployment.summary":
+		if e.complexity.DesignDeployment.Summary == nil {
+			break
+		}
+
+		return e.complexity.DesignDeployment.Summary(childComplexity), true
+
+	case "DesignDeploymentProgress.component":
+		if e.complexity.DesignDeploymentProgress.Component == nil {
+			break
+		}
+
+		return e.complexity.DesignDeploymentProgress.Component(childComplexity), true
+
+	case "DesignDeploymentProgress.deploymentID":
+		if e.complexity.DesignDeploymentProgress.DeploymentID == nil {
+			break
+		}
+
+		return e.complexity.DesignDeploymentProgress.DeploymentID(childComplexity), true
+
+	case "DesignDeploymentProgress.designID":
+		if e.complexity.DesignDeploymentProgress.DesignID == nil {
+			break
+		}
+
+		return e.complexity.DesignDeploymentProgress.DesignID(childComplexity), true
+
+	case "DesignDeploymentProgress.error":
+		if e.complexity.DesignDeploymentProgress.Error == nil {
+			break
+		}
+
+		return e.complexity.DesignDeploymentProgress.Error(childComplexity), true
+
+	case "DesignDeploymentProgress.stage":
+		if e.complexity.DesignDeploymentProgress.Stage == nil {
+			break
+		}
+
+		return e.complexity.DesignDeploymentProgress.Stage(childComplexity), true
+
+	case "DesignDeploymentProgress.stageName":
+		if e.complexity.DesignDeploymentProgress.StageName == nil {
+			break
+		}
+
+		return e.complexity.DesignDeploymentProgress.StageName(childComplexity), true
+
+	case "DesignDeploymentProgress.status":
+		if e.complexity.DesignDeploymentProgress.Status == nil {
+			break
+		}
+
+		return e.complexity.DesignDeploymentProgress.Status(childComplexity), true
+
+	case "DesignDeploymentProgress.time":
+		if e.complexity.DesignDeploymentProgress.Time == nil {
+			break
+		}
+
+		return e.complexity.DesignDeploymentProgress.Time(childComplexity), true
+
 	case "Error.code":
 		if e.complexity.Error.Code == nil {
 			break
@@ -1468,6 +1618,54 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.ChangeOperatorStatus(childComplexity, args["input"].(*model.OperatorStatusInput)), true
 
+	case "Mutation.createDesign":
+		if e.complexity.Mutation.CreateDesign == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createDesign_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateDesign(childComplexity, args["input"].(model.DesignInput)), true
+
+	case "Mutation.deployDesign":
+		if e.complexity.Mutation.DeployDesign == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deployDesign_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeployDesign(childComplexity, args["input"].(model.DesignDeployInput)), true
+
+	case "Mutation.undeployDesign":
+		if e.complexity.Mutation.UndeployDesign == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_undeployDesign_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UndeployDesign(childComplexity, args["input"].(model.DesignDeployInput)), true
+
+	case "Mutation.updateDesign":
+		if e.complexity.Mutation.UpdateDesign == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateDesign_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateDesign(childComplexity, args["id"].(string), args["input"].(model.DesignInput)), true
+
 	case "NameSpace.namespace":
 		if e.complexity.NameSpace.Namespace == nil {
 			break
@@ -2132,6 +2330,83 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.ResyncCluster(childComplexity, args["selector"].(*model.ReSyncActions), args["k8scontextID"].(string)), true
 
+	case "RegistryChange.action":
+		if e.complexity.RegistryChange.Action == nil {
+			break
+		}
+
+		return e.complexity.RegistryChange.Action(childComplexity), true
+
+	case "RegistryChange.entityType":
+		if e.complexity.RegistryChange.EntityType == nil {
+			break
+		}
+
+		return e.complexity.RegistryChange.EntityType(childComplexity), true
+
+	case "RegistryChange.kind":
+		if e.complexity.RegistryChange.Kind == nil {
+			break
+		}
+
+		return e.complexity.RegistryChange.Kind(childComplexity), true
+
+	case "RegistryChange.model":
+		if e.complexity.RegistryChange.Model == nil {
+			break
+		}
+
+		return e.complexity.RegistryChange.Model(childComplexity), true
+
+	case "RegistryChange.modelVersion":
+		if e.complexity.RegistryChange.ModelVersion == nil {
+			break
+		}
+
+		return e.complexity.RegistryChange.ModelVersion(childComplexity), true
+
+	case "RegistryChange.subType":
+		if e.complexity.RegistryChange.SubType == nil {
+			break
+		}
+
+		return e.complexity.RegistryChange.SubType(childComplexity), true
+
+	case "RegistryChange.timestamp":
+		if e.complexity.RegistryChange.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.RegistryChange.Timestamp(childComplexity), true
+
+	case "RegistryUpdate.changes":
+		if e.complexity.RegistryUpdate.Changes == nil {
+			break
+		}
+
+		return e.complexity.RegistryUpdate.Changes(childComplexity), true
+
+	case "RegistryUpdate.components":
+		if e.complexity.RegistryUpdate.Components == nil {
+			break
+		}
+
+		return e.complexity.RegistryUpdate.Components(childComplexity), true
+
+	case "RegistryUpdate.models":
+		if e.complexity.RegistryUpdate.Models == nil {
+			break
+		}
+
+		return e.complexity.RegistryUpdate.Models(childComplexity), true
+
+	case "RegistryUpdate.relationships":
+		if e.complexity.RegistryUpdate.Relationships == nil {
+			break
+		}
+
+		return e.complexity.RegistryUpdate.Relationships(childComplexity), true
+
 	case "Resource.count":
 		if e.complexity.Resource.Count == nil {
 			break
@@ -2158,6 +2433,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Subscription.ListenToOperatorState(childComplexity, args["k8scontextIDs"].([]string)), true
 
+	case "Subscription.registryUpdated":
+		if e.complexity.Subscription.RegistryUpdated == nil {
+			break
+		}
+
+		return e.complexity.Subscription.RegistryUpdated(childComplexity), true
+
 	case "Subscription.subscribeClusterResources":
 		if e.complexity.Subscription.SubscribeClusterResources == nil {
 			break
@@ -2182,6 +2464,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Subscription.SubscribeConfiguration(childComplexity, args["applicationSelector"].(model.PageFilter), args["patternSelector"].(model.PageFilter), args["filterSelector"].(model.PageFilter)), true
 
+	case "Subscription.subscribeDesignDeployment":
+		if e.complexity.Subscription.SubscribeDesignDeployment == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_subscribeDesignDeployment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.SubscribeDesignDeployment(childComplexity, args["designID"].(string)), true
+
 	case "Subscription.subscribeEvents":
 		if e.complexity.Subscription.SubscribeEvents == nil {
 			break
@@ -2293,6 +2587,8 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputAdapterStatusInput,
 		ec.unmarshalInputAddonStatusInput,
 		ec.unmarshalInputCatalogSelector,
+		ec.unmarshalInputDesignDeployInput,
+		ec.unmarshalInputDesignInput,
 		ec.unmarshalInputMeshModelSummarySelector,
 		ec.unmarshalInputOperatorStatusInput,
 		ec.unmarshalInputPageFilter,
@@ -2967,6 +3263,27 @@ type MeshModelSummary {
   relationships: [MeshModelRelationship!]
 }
 
+# A component, relationship or model registered, updated or deleted in the registry
+type RegistryChange {
+  action: String!
+  # component, relationship or model
+  entityType: String!
+  # kind of the component or relationship, empty for models
+  kind: String!
+  subType: String!
+  model: String!
+  modelVersion: String!
+  timestamp: String!
+}
+
+# Type RegistryUpdate defines the number of entities of the registry along with the changes made to it since the previous update
+type RegistryUpdate {
+  models: Int!
+  components: Int!
+  relationships: Int!
+  changes: [RegistryChange!]!
+}
+
 type MeshModelComponent {
   name: String!
   count: Int!
@@ -3073,12 +3390,81 @@ input AdapterStatusInput {
   adapter: String!
 }
 
+# ============== Design =============================
+
+input DesignInput {
+  # Name of the design, the name in the design file when empty
+  name: String
+
+  # The design file, in YAML or JSON
+  designFile: String!
+}
+
+input DesignDeployInput {
+  designID: ID!
+
+  # IDs of the Kubernetes contexts to deploy to, "all" for every context, the first context when empty
+  k8scontextIDs: [String!]
+
+  # Values of the variables of the design, by name
+  variables: Map
+
+  dryRun: Boolean
+  skipCRD: Boolean
+
+  # Delete the components deployed so far when the deployment fails
+  rollback: Boolean
+}
+
+type Design {
+  id: ID!
+  name: String!
+  designFile: String!
+  updatedAt: Time
+}
+
+# Type DesignDeployment defines the outcome of the deployment or the undeployment of a design
+type DesignDeployment {
+  designID: ID!
+
+  # Summary of the deployment, as returned by /api/pattern/deploy
+  summary: Map
+}
+
+# Type DesignDeploymentProgress defines the progress of a stage, or of a component of a stage, of the deployment of a design
+type DesignDeploymentProgress {
+  designID: ID!
+
+  # ID of the deployment, empty for the dry runs
+  deploymentID: ID
+  stage: Int!
+  stageName: String!
+
+  # Empty for the progress of the stage itself
+  component: String!
+  status: String!
+  error: String!
+  time: Time!
+}
+
 type Mutation {
   # Change the Operator Status
   changeOperatorStatus(input: OperatorStatusInput): Status! @KubernetesMiddleware
 
   # Change the Adapter Status
   changeAdapterStatus(input: AdapterStatusInput): Status! @KubernetesMiddleware
+
+  # Create a design
+  createDesign(input: DesignInput!): Design!
+
+  # Update the design
+  updateDesign(id: ID!, input: DesignInput!): Design!
+
+  # Deploy the design, subscribe to subscribeDesignDeployment beforehand to follow the progress of the deployment
+  deployDesign(input: DesignDeployInput!): DesignDeployment!
+
+  # Undeploy the design
+  undeployDesign(input: DesignDeployInput!): DesignDeployment!
 }
 
 type Subscription {
@@ -3116,6 +3502,12 @@ type Subscription {
 
   subscribeMeshModelSummary(selector: MeshModelSummarySelector!) : MeshModelSummary!
 
+  # Listen to the changes of the registry, batched, along with the number of its entities
+  registryUpdated : RegistryUpdate!
+
+  # Listen to the progress of the deployments and undeployments of the design
+  subscribeDesignDeployment(designID: ID!) : DesignDeploymentProgress!
+
   # Publish events to user
   subscribeEvents : Event!
 }
@@ -3171,6 +3563,75 @@ func (ec *executionContext) field_Mutation_changeOperatorStatus_args(ctx context
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_createDesign_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.DesignInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNDesignInput2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDesignInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_deployDesign_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.DesignDeployInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNDesignDeployInput2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDesignDeployInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_undeployDesign_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.DesignDeployInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg0, err = ec.unmarshalNDesignDeployInput2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDesignDeployInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_updateDesign_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	var arg1 model.DesignInput
+	if tmp, ok := rawArgs["input"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("input"))
+		arg1, err = ec.unmarshalNDesignInput2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDesignInput(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["input"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -3564,22 +4025,37 @@ func (ec *executionContext) field_Subscription_subscribeConfiguration_args(ctx c
 	return args, nil
 }
 
-func (ec *executionContext) field_Subscription_subscribeK8sContext_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Subscription_subscribeDesignDeployment_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 model.PageFilter
-	if tmp, ok := rawArgs["selector"]; ok {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
-		arg0, err = ec.unmarshalNPageFilter2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPageFilter(ctx, tmp)
+	var arg0 string
+	if tmp, ok := rawArgs["designID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("designID"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["selector"] = arg0
+	args["designID"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Subscription_subscribeMeshModelSummary_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Subscription_subscribeK8sContext_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.PageFilter
+	if tmp, ok := rawArgs["selector"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
+		arg0, err = ec.unmarshalNPageFilter2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPageFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["selector"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_subscribeMeshModelSummary_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 model.MeshModelSummarySelector
@@ -6664,8 +7140,8 @@ func (ec *executionContext) fieldContext_DataPlane_proxies(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Error_code(ctx context.Context, field graphql.CollectedField, obj *model.Error) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Error_code(ctx, field)
+func (ec *executionContext) _Design_id(ctx context.Context, field graphql.CollectedField, obj *model.Design) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Design_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6678,7 +7154,7 @@ func (ec *executionContext) _Error_code(ctx context.Context, field graphql.Colle
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Code, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6692,24 +7168,24 @@ func (ec *executionContext) _Error_code(ctx context.Context, field graphql.Colle
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Error_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Design_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Error",
+		Object:     "Design",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Error_description(ctx context.Context, field graphql.CollectedField, obj *model.Error) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Error_description(ctx, field)
+func (ec *executionContext) _Design_name(ctx context.Context, field graphql.CollectedField, obj *model.Design) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Design_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6722,7 +7198,7 @@ func (ec *executionContext) _Error_description(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6739,9 +7215,9 @@ func (ec *executionContext) _Error_description(ctx context.Context, field graphq
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Error_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Design_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Error",
+		Object:     "Design",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6752,8 +7228,8 @@ func (ec *executionContext) fieldContext_Error_description(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_id(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_id(ctx, field)
+func (ec *executionContext) _Design_designFile(ctx context.Context, field graphql.CollectedField, obj *model.Design) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Design_designFile(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6766,7 +7242,7 @@ func (ec *executionContext) _Event_id(ctx context.Context, field graphql.Collect
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.DesignFile, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6780,24 +7256,24 @@ func (ec *executionContext) _Event_id(ctx context.Context, field graphql.Collect
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Design_designFile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "Design",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_userID(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_userID(ctx, field)
+func (ec *executionContext) _Design_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Design) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Design_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6810,38 +7286,35 @@ func (ec *executionContext) _Event_userID(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UserID, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_userID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Design_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "Design",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_actedUpon(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_actedUpon(ctx, field)
+func (ec *executionContext) _DesignDeployment_designID(ctx context.Context, field graphql.CollectedField, obj *model.DesignDeployment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DesignDeployment_designID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6854,7 +7327,7 @@ func (ec *executionContext) _Event_actedUpon(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ActedUpon, nil
+		return obj.DesignID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6871,9 +7344,9 @@ func (ec *executionContext) _Event_actedUpon(ctx context.Context, field graphql.
 	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_actedUpon(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DesignDeployment_designID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DesignDeployment",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6884,8 +7357,8 @@ func (ec *executionContext) fieldContext_Event_actedUpon(ctx context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_operationID(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_operationID(ctx, field)
+func (ec *executionContext) _DesignDeployment_summary(ctx context.Context, field graphql.CollectedField, obj *model.DesignDeployment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DesignDeployment_summary(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6898,38 +7371,35 @@ func (ec *executionContext) _Event_operationID(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OperationID, nil
+		return obj.Summary, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(map[string]interface{})
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalOMap2map(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_operationID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DesignDeployment_summary(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DesignDeployment",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Map does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_systemID(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_systemID(ctx, field)
+func (ec *executionContext) _DesignDeploymentProgress_designID(ctx context.Context, field graphql.CollectedField, obj *model.DesignDeploymentProgress) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DesignDeploymentProgress_designID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6942,7 +7412,7 @@ func (ec *executionContext) _Event_systemID(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SystemID, nil
+		return obj.DesignID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6959,9 +7429,9 @@ func (ec *executionContext) _Event_systemID(ctx context.Context, field graphql.C
 	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_systemID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DesignDeploymentProgress_designID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DesignDeploymentProgress",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -6972,8 +7442,8 @@ func (ec *executionContext) fieldContext_Event_systemID(ctx context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_severity(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_severity(ctx, field)
+func (ec *executionContext) _DesignDeploymentProgress_deploymentID(ctx context.Context, field graphql.CollectedField, obj *model.DesignDeploymentProgress) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DesignDeploymentProgress_deploymentID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6986,38 +7456,35 @@ func (ec *executionContext) _Event_severity(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Severity, nil
+		return obj.DeploymentID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Severity)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNSeverity2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐSeverity(ctx, field.Selections, res)
+	return ec.marshalOID2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_severity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DesignDeploymentProgress_deploymentID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DesignDeploymentProgress",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Severity does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_action(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_action(ctx, field)
+func (ec *executionContext) _DesignDeploymentProgress_stage(ctx context.Context, field graphql.CollectedField, obj *model.DesignDeploymentProgress) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DesignDeploymentProgress_stage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7030,7 +7497,7 @@ func (ec *executionContext) _Event_action(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Action, nil
+		return obj.Stage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7042,26 +7509,26 @@ func (ec *executionContext) _Event_action(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_action(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DesignDeploymentProgress_stage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DesignDeploymentProgress",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_status(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_status(ctx, field)
+func (ec *executionContext) _DesignDeploymentProgress_stageName(ctx context.Context, field graphql.CollectedField, obj *model.DesignDeploymentProgress) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DesignDeploymentProgress_stageName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7074,7 +7541,7 @@ func (ec *executionContext) _Event_status(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.StageName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7091,9 +7558,9 @@ func (ec *executionContext) _Event_status(ctx context.Context, field graphql.Col
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DesignDeploymentProgress_stageName(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DesignDeploymentProgress",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7104,8 +7571,8 @@ func (ec *executionContext) fieldContext_Event_status(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_category(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_category(ctx, field)
+func (ec *executionContext) _DesignDeploymentProgress_component(ctx context.Context, field graphql.CollectedField, obj *model.DesignDeploymentProgress) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DesignDeploymentProgress_component(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7118,7 +7585,7 @@ func (ec *executionContext) _Event_category(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Category, nil
+		return obj.Component, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7135,9 +7602,9 @@ func (ec *executionContext) _Event_category(ctx context.Context, field graphql.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_category(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DesignDeploymentProgress_component(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DesignDeploymentProgress",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7148,8 +7615,8 @@ func (ec *executionContext) fieldContext_Event_category(ctx context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_description(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_description(ctx, field)
+func (ec *executionContext) _DesignDeploymentProgress_status(ctx context.Context, field graphql.CollectedField, obj *model.DesignDeploymentProgress) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DesignDeploymentProgress_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7162,7 +7629,7 @@ func (ec *executionContext) _Event_description(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7179,9 +7646,9 @@ func (ec *executionContext) _Event_description(ctx context.Context, field graphq
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DesignDeploymentProgress_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DesignDeploymentProgress",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7192,8 +7659,8 @@ func (ec *executionContext) fieldContext_Event_description(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_metadata(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_metadata(ctx, field)
+func (ec *executionContext) _DesignDeploymentProgress_error(ctx context.Context, field graphql.CollectedField, obj *model.DesignDeploymentProgress) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DesignDeploymentProgress_error(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7206,35 +7673,38 @@ func (ec *executionContext) _Event_metadata(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Metadata, nil
+		return obj.Error, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(map[string]interface{})
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOMap2map(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DesignDeploymentProgress_error(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DesignDeploymentProgress",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Map does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_createdAt(ctx, field)
+func (ec *executionContext) _DesignDeploymentProgress_time(ctx context.Context, field graphql.CollectedField, obj *model.DesignDeploymentProgress) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DesignDeploymentProgress_time(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7247,7 +7717,7 @@ func (ec *executionContext) _Event_createdAt(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Time, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7264,9 +7734,9 @@ func (ec *executionContext) _Event_createdAt(ctx context.Context, field graphql.
 	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DesignDeploymentProgress_time(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DesignDeploymentProgress",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7277,8 +7747,8 @@ func (ec *executionContext) fieldContext_Event_createdAt(ctx context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_updatedAt(ctx, field)
+func (ec *executionContext) _Error_code(ctx context.Context, field graphql.CollectedField, obj *model.Error) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Error_code(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7291,7 +7761,7 @@ func (ec *executionContext) _Event_updatedAt(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.Code, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7303,26 +7773,26 @@ func (ec *executionContext) _Event_updatedAt(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Error_code(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "Error",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Event_deletedAt(ctx, field)
+func (ec *executionContext) _Error_description(ctx context.Context, field graphql.CollectedField, obj *model.Error) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Error_description(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7335,35 +7805,38 @@ func (ec *executionContext) _Event_deletedAt(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeletedAt, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*time.Time)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Event_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Error_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "Error",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FilterPage_page(ctx context.Context, field graphql.CollectedField, obj *model.FilterPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FilterPage_page(ctx, field)
+func (ec *executionContext) _Event_id(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7376,7 +7849,7 @@ func (ec *executionContext) _FilterPage_page(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Page, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7388,26 +7861,26 @@ func (ec *executionContext) _FilterPage_page(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FilterPage_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FilterPage",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FilterPage_page_size(ctx context.Context, field graphql.CollectedField, obj *model.FilterPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FilterPage_page_size(ctx, field)
+func (ec *executionContext) _Event_userID(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_userID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7420,7 +7893,7 @@ func (ec *executionContext) _FilterPage_page_size(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageSize, nil
+		return obj.UserID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7432,26 +7905,26 @@ func (ec *executionContext) _FilterPage_page_size(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FilterPage_page_size(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_userID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FilterPage",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FilterPage_total_count(ctx context.Context, field graphql.CollectedField, obj *model.FilterPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FilterPage_total_count(ctx, field)
+func (ec *executionContext) _Event_actedUpon(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_actedUpon(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7464,7 +7937,7 @@ func (ec *executionContext) _FilterPage_total_count(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.ActedUpon, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7476,26 +7949,26 @@ func (ec *executionContext) _FilterPage_total_count(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FilterPage_total_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_actedUpon(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FilterPage",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FilterPage_filters(ctx context.Context, field graphql.CollectedField, obj *model.FilterPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FilterPage_filters(ctx, field)
+func (ec *executionContext) _Event_operationID(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_operationID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7508,57 +7981,38 @@ func (ec *executionContext) _FilterPage_filters(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Filters, nil
+		return obj.OperationID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.FilterResult)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOFilterResult2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐFilterResult(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FilterPage_filters(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_operationID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FilterPage",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_FilterResult_id(ctx, field)
-			case "name":
-				return ec.fieldContext_FilterResult_name(ctx, field)
-			case "filter_file":
-				return ec.fieldContext_FilterResult_filter_file(ctx, field)
-			case "filter_resource":
-				return ec.fieldContext_FilterResult_filter_resource(ctx, field)
-			case "user_id":
-				return ec.fieldContext_FilterResult_user_id(ctx, field)
-			case "location":
-				return ec.fieldContext_FilterResult_location(ctx, field)
-			case "visibility":
-				return ec.fieldContext_FilterResult_visibility(ctx, field)
-			case "catalog_data":
-				return ec.fieldContext_FilterResult_catalog_data(ctx, field)
-			case "created_at":
-				return ec.fieldContext_FilterResult_created_at(ctx, field)
-			case "updated_at":
-				return ec.fieldContext_FilterResult_updated_at(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type FilterResult", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FilterResult_id(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FilterResult_id(ctx, field)
+func (ec *executionContext) _Event_systemID(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_systemID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7571,7 +8025,7 @@ func (ec *executionContext) _FilterResult_id(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.SystemID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7588,9 +8042,9 @@ func (ec *executionContext) _FilterResult_id(ctx context.Context, field graphql.
 	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FilterResult_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_systemID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FilterResult",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7601,8 +8055,8 @@ func (ec *executionContext) fieldContext_FilterResult_id(ctx context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _FilterResult_name(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FilterResult_name(ctx, field)
+func (ec *executionContext) _Event_severity(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_severity(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7615,7 +8069,7 @@ func (ec *executionContext) _FilterResult_name(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Severity, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7627,26 +8081,26 @@ func (ec *executionContext) _FilterResult_name(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.Severity)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNSeverity2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐSeverity(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FilterResult_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_severity(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FilterResult",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Severity does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FilterResult_filter_file(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FilterResult_filter_file(ctx, field)
+func (ec *executionContext) _Event_action(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_action(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7659,7 +8113,7 @@ func (ec *executionContext) _FilterResult_filter_file(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FilterFile, nil
+		return obj.Action, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7676,9 +8130,9 @@ func (ec *executionContext) _FilterResult_filter_file(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FilterResult_filter_file(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_action(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FilterResult",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7689,8 +8143,8 @@ func (ec *executionContext) fieldContext_FilterResult_filter_file(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _FilterResult_filter_resource(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FilterResult_filter_resource(ctx, field)
+func (ec *executionContext) _Event_status(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7703,7 +8157,7 @@ func (ec *executionContext) _FilterResult_filter_resource(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FilterResource, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7720,9 +8174,9 @@ func (ec *executionContext) _FilterResult_filter_resource(ctx context.Context, f
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FilterResult_filter_resource(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FilterResult",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7733,8 +8187,8 @@ func (ec *executionContext) fieldContext_FilterResult_filter_resource(ctx contex
 	return fc, nil
 }
 
-func (ec *executionContext) _FilterResult_user_id(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FilterResult_user_id(ctx, field)
+func (ec *executionContext) _Event_category(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_category(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7747,7 +8201,7 @@ func (ec *executionContext) _FilterResult_user_id(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UserID, nil
+		return obj.Category, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7764,9 +8218,9 @@ func (ec *executionContext) _FilterResult_user_id(ctx context.Context, field gra
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FilterResult_user_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_category(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FilterResult",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7777,8 +8231,8 @@ func (ec *executionContext) fieldContext_FilterResult_user_id(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _FilterResult_location(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FilterResult_location(ctx, field)
+func (ec *executionContext) _Event_description(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_description(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7791,7 +8245,7 @@ func (ec *executionContext) _FilterResult_location(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Location, nil
+		return obj.Description, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7803,36 +8257,26 @@ func (ec *executionContext) _FilterResult_location(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Location)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLocation2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐLocation(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FilterResult_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_description(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FilterResult",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "branch":
-				return ec.fieldContext_Location_branch(ctx, field)
-			case "host":
-				return ec.fieldContext_Location_host(ctx, field)
-			case "path":
-				return ec.fieldContext_Location_path(ctx, field)
-			case "type":
-				return ec.fieldContext_Location_type(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FilterResult_visibility(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FilterResult_visibility(ctx, field)
+func (ec *executionContext) _Event_metadata(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_metadata(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7845,38 +8289,35 @@ func (ec *executionContext) _FilterResult_visibility(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Visibility, nil
+		return obj.Metadata, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(map[string]interface{})
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOMap2map(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FilterResult_visibility(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FilterResult",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Map does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FilterResult_catalog_data(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FilterResult_catalog_data(ctx, field)
+func (ec *executionContext) _Event_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_createdAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7889,35 +8330,38 @@ func (ec *executionContext) _FilterResult_catalog_data(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CatalogData, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(map[string]interface{})
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOMap2map(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FilterResult_catalog_data(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_createdAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FilterResult",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Map does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FilterResult_created_at(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FilterResult_created_at(ctx, field)
+func (ec *executionContext) _Event_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_updatedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7930,35 +8374,38 @@ func (ec *executionContext) _FilterResult_created_at(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTime2timeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FilterResult_created_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_updatedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FilterResult",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _FilterResult_updated_at(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_FilterResult_updated_at(ctx, field)
+func (ec *executionContext) _Event_deletedAt(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Event_deletedAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7971,7 +8418,7 @@ func (ec *executionContext) _FilterResult_updated_at(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.DeletedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7980,26 +8427,26 @@ func (ec *executionContext) _FilterResult_updated_at(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_FilterResult_updated_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Event_deletedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "FilterResult",
+		Object:     "Event",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _K8sContext_id(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_K8sContext_id(ctx, field)
+func (ec *executionContext) _FilterPage_page(ctx context.Context, field graphql.CollectedField, obj *model.FilterPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FilterPage_page(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8012,7 +8459,7 @@ func (ec *executionContext) _K8sContext_id(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Page, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8024,26 +8471,26 @@ func (ec *executionContext) _K8sContext_id(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_K8sContext_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FilterPage_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "K8sContext",
+		Object:     "FilterPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _K8sContext_name(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_K8sContext_name(ctx, field)
+func (ec *executionContext) _FilterPage_page_size(ctx context.Context, field graphql.CollectedField, obj *model.FilterPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FilterPage_page_size(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8056,7 +8503,7 @@ func (ec *executionContext) _K8sContext_name(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.PageSize, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8068,26 +8515,26 @@ func (ec *executionContext) _K8sContext_name(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_K8sContext_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FilterPage_page_size(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "K8sContext",
+		Object:     "FilterPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _K8sContext_server(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_K8sContext_server(ctx, field)
+func (ec *executionContext) _FilterPage_total_count(ctx context.Context, field graphql.CollectedField, obj *model.FilterPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FilterPage_total_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8100,7 +8547,7 @@ func (ec *executionContext) _K8sContext_server(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Server, nil
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8112,26 +8559,26 @@ func (ec *executionContext) _K8sContext_server(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_K8sContext_server(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FilterPage_total_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "K8sContext",
+		Object:     "FilterPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _K8sContext_owner(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_K8sContext_owner(ctx, field)
+func (ec *executionContext) _FilterPage_filters(ctx context.Context, field graphql.CollectedField, obj *model.FilterPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FilterPage_filters(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8144,38 +8591,57 @@ func (ec *executionContext) _K8sContext_owner(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Owner, nil
+		return obj.Filters, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.FilterResult)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalOFilterResult2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐFilterResult(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_K8sContext_owner(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FilterPage_filters(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "K8sContext",
+		Object:     "FilterPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FilterResult_id(ctx, field)
+			case "name":
+				return ec.fieldContext_FilterResult_name(ctx, field)
+			case "filter_file":
+				return ec.fieldContext_FilterResult_filter_file(ctx, field)
+			case "filter_resource":
+				return ec.fieldContext_FilterResult_filter_resource(ctx, field)
+			case "user_id":
+				return ec.fieldContext_FilterResult_user_id(ctx, field)
+			case "location":
+				return ec.fieldContext_FilterResult_location(ctx, field)
+			case "visibility":
+				return ec.fieldContext_FilterResult_visibility(ctx, field)
+			case "catalog_data":
+				return ec.fieldContext_FilterResult_catalog_data(ctx, field)
+			case "created_at":
+				return ec.fieldContext_FilterResult_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_FilterResult_updated_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FilterResult", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _K8sContext_created_by(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_K8sContext_created_by(ctx, field)
+func (ec *executionContext) _FilterResult_id(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FilterResult_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8188,7 +8654,7 @@ func (ec *executionContext) _K8sContext_created_by(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedBy, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8205,9 +8671,9 @@ func (ec *executionContext) _K8sContext_created_by(ctx context.Context, field gr
 	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_K8sContext_created_by(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FilterResult_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "K8sContext",
+		Object:     "FilterResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8218,8 +8684,8 @@ func (ec *executionContext) fieldContext_K8sContext_created_by(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _K8sContext_meshery_instance_id(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_K8sContext_meshery_instance_id(ctx, field)
+func (ec *executionContext) _FilterResult_name(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FilterResult_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8232,7 +8698,7 @@ func (ec *executionContext) _K8sContext_meshery_instance_id(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MesheryInstanceID, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8246,24 +8712,24 @@ func (ec *executionContext) _K8sContext_meshery_instance_id(ctx context.Context,
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_K8sContext_meshery_instance_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FilterResult_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "K8sContext",
+		Object:     "FilterResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _K8sContext_kubernetes_server_id(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_K8sContext_kubernetes_server_id(ctx, field)
+func (ec *executionContext) _FilterResult_filter_file(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FilterResult_filter_file(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8276,7 +8742,7 @@ func (ec *executionContext) _K8sContext_kubernetes_server_id(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.KubernetesServerID, nil
+		return obj.FilterFile, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8290,24 +8756,24 @@ func (ec *executionContext) _K8sContext_kubernetes_server_id(ctx context.Context
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_K8sContext_kubernetes_server_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FilterResult_filter_file(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "K8sContext",
+		Object:     "FilterResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _K8sContext_deployment_type(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_K8sContext_deployment_type(ctx, field)
+func (ec *executionContext) _FilterResult_filter_resource(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FilterResult_filter_resource(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8320,7 +8786,7 @@ func (ec *executionContext) _K8sContext_deployment_type(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeploymentType, nil
+		return obj.FilterResource, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8337,9 +8803,9 @@ func (ec *executionContext) _K8sContext_deployment_type(ctx context.Context, fie
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_K8sContext_deployment_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FilterResult_filter_resource(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "K8sContext",
+		Object:     "FilterResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8350,8 +8816,8 @@ func (ec *executionContext) fieldContext_K8sContext_deployment_type(ctx context.
 	return fc, nil
 }
 
-func (ec *executionContext) _K8sContext_version(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_K8sContext_version(ctx, field)
+func (ec *executionContext) _FilterResult_user_id(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FilterResult_user_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8364,7 +8830,7 @@ func (ec *executionContext) _K8sContext_version(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Version, nil
+		return obj.UserID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8381,9 +8847,9 @@ func (ec *executionContext) _K8sContext_version(ctx context.Context, field graph
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_K8sContext_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FilterResult_user_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "K8sContext",
+		Object:     "FilterResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8394,8 +8860,8 @@ func (ec *executionContext) fieldContext_K8sContext_version(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _K8sContext_updated_at(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_K8sContext_updated_at(ctx, field)
+func (ec *executionContext) _FilterResult_location(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FilterResult_location(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8408,7 +8874,7 @@ func (ec *executionContext) _K8sContext_updated_at(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.Location, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8420,26 +8886,36 @@ func (ec *executionContext) _K8sContext_updated_at(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Location)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLocation2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐLocation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_K8sContext_updated_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FilterResult_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "K8sContext",
+		Object:     "FilterResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "branch":
+				return ec.fieldContext_Location_branch(ctx, field)
+			case "host":
+				return ec.fieldContext_Location_host(ctx, field)
+			case "path":
+				return ec.fieldContext_Location_path(ctx, field)
+			case "type":
+				return ec.fieldContext_Location_type(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _K8sContext_created_at(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_K8sContext_created_at(ctx, field)
+func (ec *executionContext) _FilterResult_visibility(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FilterResult_visibility(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8452,7 +8928,7 @@ func (ec *executionContext) _K8sContext_created_at(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Visibility, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8469,9 +8945,9 @@ func (ec *executionContext) _K8sContext_created_at(ctx context.Context, field gr
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_K8sContext_created_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FilterResult_visibility(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "K8sContext",
+		Object:     "FilterResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8482,8 +8958,8 @@ func (ec *executionContext) fieldContext_K8sContext_created_at(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _K8sContext_connection_id(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_K8sContext_connection_id(ctx, field)
+func (ec *executionContext) _FilterResult_catalog_data(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FilterResult_catalog_data(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8496,38 +8972,35 @@ func (ec *executionContext) _K8sContext_connection_id(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ConnectionID, nil
+		return obj.CatalogData, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(map[string]interface{})
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOMap2map(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_K8sContext_connection_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FilterResult_catalog_data(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "K8sContext",
+		Object:     "FilterResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Map does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _K8sContextsPage_total_count(ctx context.Context, field graphql.CollectedField, obj *model.K8sContextsPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_K8sContextsPage_total_count(ctx, field)
+func (ec *executionContext) _FilterResult_created_at(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FilterResult_created_at(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8540,38 +9013,35 @@ func (ec *executionContext) _K8sContextsPage_total_count(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_K8sContextsPage_total_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FilterResult_created_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "K8sContextsPage",
+		Object:     "FilterResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _K8sContextsPage_contexts(ctx context.Context, field graphql.CollectedField, obj *model.K8sContextsPage) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_K8sContextsPage_contexts(ctx, field)
+func (ec *executionContext) _FilterResult_updated_at(ctx context.Context, field graphql.CollectedField, obj *model.FilterResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_FilterResult_updated_at(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8584,64 +9054,35 @@ func (ec *executionContext) _K8sContextsPage_contexts(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Contexts, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.K8sContext)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNK8sContext2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐK8sContext(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_K8sContextsPage_contexts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_FilterResult_updated_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "K8sContextsPage",
+		Object:     "FilterResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_K8sContext_id(ctx, field)
-			case "name":
-				return ec.fieldContext_K8sContext_name(ctx, field)
-			case "server":
-				return ec.fieldContext_K8sContext_server(ctx, field)
-			case "owner":
-				return ec.fieldContext_K8sContext_owner(ctx, field)
-			case "created_by":
-				return ec.fieldContext_K8sContext_created_by(ctx, field)
-			case "meshery_instance_id":
-				return ec.fieldContext_K8sContext_meshery_instance_id(ctx, field)
-			case "kubernetes_server_id":
-				return ec.fieldContext_K8sContext_kubernetes_server_id(ctx, field)
-			case "deployment_type":
-				return ec.fieldContext_K8sContext_deployment_type(ctx, field)
-			case "version":
-				return ec.fieldContext_K8sContext_version(ctx, field)
-			case "updated_at":
-				return ec.fieldContext_K8sContext_updated_at(ctx, field)
-			case "created_at":
-				return ec.fieldContext_K8sContext_created_at(ctx, field)
-			case "connection_id":
-				return ec.fieldContext_K8sContext_connection_id(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type K8sContext", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _KctlDescribeDetails_describe(ctx context.Context, field graphql.CollectedField, obj *model.KctlDescribeDetails) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_KctlDescribeDetails_describe(ctx, field)
+func (ec *executionContext) _K8sContext_id(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_K8sContext_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8654,23 +9095,26 @@ func (ec *executionContext) _KctlDescribeDetails_describe(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Describe, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_KctlDescribeDetails_describe(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_K8sContext_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "KctlDescribeDetails",
+		Object:     "K8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8681,8 +9125,8 @@ func (ec *executionContext) fieldContext_KctlDescribeDetails_describe(ctx contex
 	return fc, nil
 }
 
-func (ec *executionContext) _KctlDescribeDetails_ctxid(ctx context.Context, field graphql.CollectedField, obj *model.KctlDescribeDetails) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_KctlDescribeDetails_ctxid(ctx, field)
+func (ec *executionContext) _K8sContext_name(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_K8sContext_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8695,23 +9139,26 @@ func (ec *executionContext) _KctlDescribeDetails_ctxid(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Ctxid, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_KctlDescribeDetails_ctxid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_K8sContext_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "KctlDescribeDetails",
+		Object:     "K8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8722,8 +9169,8 @@ func (ec *executionContext) fieldContext_KctlDescribeDetails_ctxid(ctx context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _Location_branch(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Location_branch(ctx, field)
+func (ec *executionContext) _K8sContext_server(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_K8sContext_server(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8736,23 +9183,26 @@ func (ec *executionContext) _Location_branch(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Branch, nil
+		return obj.Server, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Location_branch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_K8sContext_server(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "K8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8763,8 +9213,8 @@ func (ec *executionContext) fieldContext_Location_branch(ctx context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _Location_host(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Location_host(ctx, field)
+func (ec *executionContext) _K8sContext_owner(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_K8sContext_owner(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8777,35 +9227,38 @@ func (ec *executionContext) _Location_host(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Host, nil
+		return obj.Owner, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Location_host(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_K8sContext_owner(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "K8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Location_path(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Location_path(ctx, field)
+func (ec *executionContext) _K8sContext_created_by(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_K8sContext_created_by(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8818,35 +9271,38 @@ func (ec *executionContext) _Location_path(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Path, nil
+		return obj.CreatedBy, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Location_path(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_K8sContext_created_by(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "K8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Location_type(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Location_type(ctx, field)
+func (ec *executionContext) _K8sContext_meshery_instance_id(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_K8sContext_meshery_instance_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8859,35 +9315,38 @@ func (ec *executionContext) _Location_type(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.MesheryInstanceID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Location_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_K8sContext_meshery_instance_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "K8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MeshModelComponent_name(ctx context.Context, field graphql.CollectedField, obj *model.MeshModelComponent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MeshModelComponent_name(ctx, field)
+func (ec *executionContext) _K8sContext_kubernetes_server_id(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_K8sContext_kubernetes_server_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8900,7 +9359,7 @@ func (ec *executionContext) _MeshModelComponent_name(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.KubernetesServerID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8914,24 +9373,24 @@ func (ec *executionContext) _MeshModelComponent_name(ctx context.Context, field
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MeshModelComponent_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_K8sContext_kubernetes_server_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MeshModelComponent",
+		Object:     "K8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MeshModelComponent_count(ctx context.Context, field graphql.CollectedField, obj *model.MeshModelComponent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MeshModelComponent_count(ctx, field)
+func (ec *executionContext) _K8sContext_deployment_type(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_K8sContext_deployment_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8944,7 +9403,7 @@ func (ec *executionContext) _MeshModelComponent_count(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Count, nil
+		return obj.DeploymentType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8956,26 +9415,26 @@ func (ec *executionContext) _MeshModelComponent_count(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MeshModelComponent_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_K8sContext_deployment_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MeshModelComponent",
+		Object:     "K8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MeshModelRelationship_name(ctx context.Context, field graphql.CollectedField, obj *model.MeshModelRelationship) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MeshModelRelationship_name(ctx, field)
+func (ec *executionContext) _K8sContext_version(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_K8sContext_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8988,7 +9447,7 @@ func (ec *executionContext) _MeshModelRelationship_name(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9005,9 +9464,9 @@ func (ec *executionContext) _MeshModelRelationship_name(ctx context.Context, fie
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MeshModelRelationship_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_K8sContext_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MeshModelRelationship",
+		Object:     "K8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9018,8 +9477,8 @@ func (ec *executionContext) fieldContext_MeshModelRelationship_name(ctx context.
 	return fc, nil
 }
 
-func (ec *executionContext) _MeshModelRelationship_count(ctx context.Context, field graphql.CollectedField, obj *model.MeshModelRelationship) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MeshModelRelationship_count(ctx, field)
+func (ec *executionContext) _K8sContext_updated_at(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_K8sContext_updated_at(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9032,7 +9491,7 @@ func (ec *executionContext) _MeshModelRelationship_count(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Count, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9044,26 +9503,26 @@ func (ec *executionContext) _MeshModelRelationship_count(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MeshModelRelationship_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_K8sContext_updated_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MeshModelRelationship",
+		Object:     "K8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MeshModelSummary_components(ctx context.Context, field graphql.CollectedField, obj *model.MeshModelSummary) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MeshModelSummary_components(ctx, field)
+func (ec *executionContext) _K8sContext_created_at(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_K8sContext_created_at(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9076,41 +9535,38 @@ func (ec *executionContext) _MeshModelSummary_components(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Components, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.MeshModelComponent)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOMeshModelComponent2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMeshModelComponentᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MeshModelSummary_components(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_K8sContext_created_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MeshModelSummary",
+		Object:     "K8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_MeshModelComponent_name(ctx, field)
-			case "count":
-				return ec.fieldContext_MeshModelComponent_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type MeshModelComponent", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MeshModelSummary_relationships(ctx context.Context, field graphql.CollectedField, obj *model.MeshModelSummary) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MeshModelSummary_relationships(ctx, field)
+func (ec *executionContext) _K8sContext_connection_id(ctx context.Context, field graphql.CollectedField, obj *model.K8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_K8sContext_connection_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9123,41 +9579,38 @@ func (ec *executionContext) _MeshModelSummary_relationships(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Relationships, nil
+		return obj.ConnectionID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.MeshModelRelationship)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOMeshModelRelationship2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMeshModelRelationshipᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MeshModelSummary_relationships(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_K8sContext_connection_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MeshModelSummary",
+		Object:     "K8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_MeshModelRelationship_name(ctx, field)
-			case "count":
-				return ec.fieldContext_MeshModelRelationship_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type MeshModelRelationship", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MeshSyncEvent_type(ctx context.Context, field graphql.CollectedField, obj *model.MeshSyncEvent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MeshSyncEvent_type(ctx, field)
+func (ec *executionContext) _K8sContextsPage_total_count(ctx context.Context, field graphql.CollectedField, obj *model.K8sContextsPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_K8sContextsPage_total_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9170,7 +9623,7 @@ func (ec *executionContext) _MeshSyncEvent_type(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9182,26 +9635,26 @@ func (ec *executionContext) _MeshSyncEvent_type(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MeshSyncEvent_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_K8sContextsPage_total_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MeshSyncEvent",
+		Object:     "K8sContextsPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MeshSyncEvent_object(ctx context.Context, field graphql.CollectedField, obj *model.MeshSyncEvent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MeshSyncEvent_object(ctx, field)
+func (ec *executionContext) _K8sContextsPage_contexts(ctx context.Context, field graphql.CollectedField, obj *model.K8sContextsPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_K8sContextsPage_contexts(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9214,7 +9667,7 @@ func (ec *executionContext) _MeshSyncEvent_object(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Object, nil
+		return obj.Contexts, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9226,26 +9679,52 @@ func (ec *executionContext) _MeshSyncEvent_object(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(interface{})
+	res := resTmp.([]*model.K8sContext)
 	fc.Result = res
-	return ec.marshalNAny2interface(ctx, field.Selections, res)
+	return ec.marshalNK8sContext2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐK8sContext(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MeshSyncEvent_object(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_K8sContextsPage_contexts(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MeshSyncEvent",
+		Object:     "K8sContextsPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Any does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_K8sContext_id(ctx, field)
+			case "name":
+				return ec.fieldContext_K8sContext_name(ctx, field)
+			case "server":
+				return ec.fieldContext_K8sContext_server(ctx, field)
+			case "owner":
+				return ec.fieldContext_K8sContext_owner(ctx, field)
+			case "created_by":
+				return ec.fieldContext_K8sContext_created_by(ctx, field)
+			case "meshery_instance_id":
+				return ec.fieldContext_K8sContext_meshery_instance_id(ctx, field)
+			case "kubernetes_server_id":
+				return ec.fieldContext_K8sContext_kubernetes_server_id(ctx, field)
+			case "deployment_type":
+				return ec.fieldContext_K8sContext_deployment_type(ctx, field)
+			case "version":
+				return ec.fieldContext_K8sContext_version(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_K8sContext_updated_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_K8sContext_created_at(ctx, field)
+			case "connection_id":
+				return ec.fieldContext_K8sContext_connection_id(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type K8sContext", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MeshSyncEvent_contextId(ctx context.Context, field graphql.CollectedField, obj *model.MeshSyncEvent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MeshSyncEvent_contextId(ctx, field)
+func (ec *executionContext) _KctlDescribeDetails_describe(ctx context.Context, field graphql.CollectedField, obj *model.KctlDescribeDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_KctlDescribeDetails_describe(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9258,26 +9737,23 @@ func (ec *executionContext) _MeshSyncEvent_contextId(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContextID, nil
+		return obj.Describe, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MeshSyncEvent_contextId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_KctlDescribeDetails_describe(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MeshSyncEvent",
+		Object:     "KctlDescribeDetails",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9288,8 +9764,8 @@ func (ec *executionContext) fieldContext_MeshSyncEvent_contextId(ctx context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryControllersStatusListItem_contextId(ctx context.Context, field graphql.CollectedField, obj *model.MesheryControllersStatusListItem) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryControllersStatusListItem_contextId(ctx, field)
+func (ec *executionContext) _KctlDescribeDetails_ctxid(ctx context.Context, field graphql.CollectedField, obj *model.KctlDescribeDetails) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_KctlDescribeDetails_ctxid(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9302,26 +9778,23 @@ func (ec *executionContext) _MesheryControllersStatusListItem_contextId(ctx cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContextID, nil
+		return obj.Ctxid, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryControllersStatusListItem_contextId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_KctlDescribeDetails_ctxid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryControllersStatusListItem",
+		Object:     "KctlDescribeDetails",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9332,8 +9805,8 @@ func (ec *executionContext) fieldContext_MesheryControllersStatusListItem_contex
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryControllersStatusListItem_controller(ctx context.Context, field graphql.CollectedField, obj *model.MesheryControllersStatusListItem) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryControllersStatusListItem_controller(ctx, field)
+func (ec *executionContext) _Location_branch(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_branch(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9346,38 +9819,35 @@ func (ec *executionContext) _MesheryControllersStatusListItem_controller(ctx con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Controller, nil
+		return obj.Branch, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.MesheryController)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNMesheryController2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryController(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryControllersStatusListItem_controller(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_branch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryControllersStatusListItem",
+		Object:     "Location",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type MesheryController does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryControllersStatusListItem_status(ctx context.Context, field graphql.CollectedField, obj *model.MesheryControllersStatusListItem) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryControllersStatusListItem_status(ctx, field)
+func (ec *executionContext) _Location_host(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_host(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9390,38 +9860,35 @@ func (ec *executionContext) _MesheryControllersStatusListItem_status(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.Host, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.MesheryControllerStatus)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNMesheryControllerStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryControllerStatus(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryControllersStatusListItem_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_host(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryControllersStatusListItem",
+		Object:     "Location",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type MesheryControllerStatus does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_meshery_id(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_meshery_id(ctx, field)
+func (ec *executionContext) _Location_path(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_path(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9434,7 +9901,7 @@ func (ec *executionContext) _MesheryResult_meshery_id(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MesheryID, nil
+		return obj.Path, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9448,9 +9915,9 @@ func (ec *executionContext) _MesheryResult_meshery_id(ctx context.Context, field
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_meshery_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_path(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "Location",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9461,8 +9928,8 @@ func (ec *executionContext) fieldContext_MesheryResult_meshery_id(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_name(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_name(ctx, field)
+func (ec *executionContext) _Location_type(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9475,7 +9942,7 @@ func (ec *executionContext) _MesheryResult_name(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9489,9 +9956,9 @@ func (ec *executionContext) _MesheryResult_name(ctx context.Context, field graph
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "Location",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9502,8 +9969,8 @@ func (ec *executionContext) fieldContext_MesheryResult_name(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_mesh(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_mesh(ctx, field)
+func (ec *executionContext) _MeshModelComponent_name(ctx context.Context, field graphql.CollectedField, obj *model.MeshModelComponent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MeshModelComponent_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9516,23 +9983,26 @@ func (ec *executionContext) _MesheryResult_mesh(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Mesh, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_mesh(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MeshModelComponent_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "MeshModelComponent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9543,8 +10013,8 @@ func (ec *executionContext) fieldContext_MesheryResult_mesh(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_performance_profile(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_performance_profile(ctx, field)
+func (ec *executionContext) _MeshModelComponent_count(ctx context.Context, field graphql.CollectedField, obj *model.MeshModelComponent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MeshModelComponent_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9557,35 +10027,38 @@ func (ec *executionContext) _MesheryResult_performance_profile(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PerformanceProfile, nil
+		return obj.Count, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_performance_profile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MeshModelComponent_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "MeshModelComponent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_test_id(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_test_id(ctx, field)
+func (ec *executionContext) _MeshModelRelationship_name(ctx context.Context, field graphql.CollectedField, obj *model.MeshModelRelationship) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MeshModelRelationship_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9598,23 +10071,26 @@ func (ec *executionContext) _MesheryResult_test_id(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TestID, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_test_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MeshModelRelationship_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "MeshModelRelationship",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9625,8 +10101,8 @@ func (ec *executionContext) fieldContext_MesheryResult_test_id(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_runner_results(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_runner_results(ctx, field)
+func (ec *executionContext) _MeshModelRelationship_count(ctx context.Context, field graphql.CollectedField, obj *model.MeshModelRelationship) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MeshModelRelationship_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9639,35 +10115,38 @@ func (ec *executionContext) _MesheryResult_runner_results(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RunnerResults, nil
+		return obj.Count, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(map[string]interface{})
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOMap2map(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_runner_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MeshModelRelationship_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "MeshModelRelationship",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Map does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_server_metrics(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_server_metrics(ctx, field)
+func (ec *executionContext) _MeshModelSummary_components(ctx context.Context, field graphql.CollectedField, obj *model.MeshModelSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MeshModelSummary_components(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9680,7 +10159,7 @@ func (ec *executionContext) _MesheryResult_server_metrics(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ServerMetrics, nil
+		return obj.Components, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9689,26 +10168,32 @@ func (ec *executionContext) _MesheryResult_server_metrics(ctx context.Context, f
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.MeshModelComponent)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOMeshModelComponent2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMeshModelComponentᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_server_metrics(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MeshModelSummary_components(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "MeshModelSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_MeshModelComponent_name(ctx, field)
+			case "count":
+				return ec.fieldContext_MeshModelComponent_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MeshModelComponent", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_server_board_config(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_server_board_config(ctx, field)
+func (ec *executionContext) _MeshModelSummary_relationships(ctx context.Context, field graphql.CollectedField, obj *model.MeshModelSummary) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MeshModelSummary_relationships(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9721,7 +10206,7 @@ func (ec *executionContext) _MesheryResult_server_board_config(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ServerBoardConfig, nil
+		return obj.Relationships, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9730,26 +10215,32 @@ func (ec *executionContext) _MesheryResult_server_board_config(ctx context.Conte
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.MeshModelRelationship)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOMeshModelRelationship2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMeshModelRelationshipᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_server_board_config(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MeshModelSummary_relationships(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "MeshModelSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_MeshModelRelationship_name(ctx, field)
+			case "count":
+				return ec.fieldContext_MeshModelRelationship_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MeshModelRelationship", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_test_start_time(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_test_start_time(ctx, field)
+func (ec *executionContext) _MeshSyncEvent_type(ctx context.Context, field graphql.CollectedField, obj *model.MeshSyncEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MeshSyncEvent_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9762,23 +10253,26 @@ func (ec *executionContext) _MesheryResult_test_start_time(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TestStartTime, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_test_start_time(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MeshSyncEvent_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "MeshSyncEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9789,8 +10283,8 @@ func (ec *executionContext) fieldContext_MesheryResult_test_start_time(ctx conte
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_user_id(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_user_id(ctx, field)
+func (ec *executionContext) _MeshSyncEvent_object(ctx context.Context, field graphql.CollectedField, obj *model.MeshSyncEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MeshSyncEvent_object(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9803,35 +10297,38 @@ func (ec *executionContext) _MesheryResult_user_id(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UserID, nil
+		return obj.Object, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(interface{})
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNAny2interface(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_user_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MeshSyncEvent_object(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "MeshSyncEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Any does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_updated_at(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_updated_at(ctx, field)
+func (ec *executionContext) _MeshSyncEvent_contextId(ctx context.Context, field graphql.CollectedField, obj *model.MeshSyncEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MeshSyncEvent_contextId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9844,23 +10341,26 @@ func (ec *executionContext) _MesheryResult_updated_at(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.ContextID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_updated_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MeshSyncEvent_contextId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "MeshSyncEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9871,8 +10371,8 @@ func (ec *executionContext) fieldContext_MesheryResult_updated_at(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_created_at(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_created_at(ctx, field)
+func (ec *executionContext) _MesheryControllersStatusListItem_contextId(ctx context.Context, field graphql.CollectedField, obj *model.MesheryControllersStatusListItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryControllersStatusListItem_contextId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9885,23 +10385,26 @@ func (ec *executionContext) _MesheryResult_created_at(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.ContextID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_created_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryControllersStatusListItem_contextId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "MesheryControllersStatusListItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9912,8 +10415,8 @@ func (ec *executionContext) fieldContext_MesheryResult_created_at(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_changeOperatorStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_changeOperatorStatus(ctx, field)
+func (ec *executionContext) _MesheryControllersStatusListItem_controller(ctx context.Context, field graphql.CollectedField, obj *model.MesheryControllersStatusListItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryControllersStatusListItem_controller(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9925,28 +10428,8 @@ func (ec *executionContext) _Mutation_changeOperatorStatus(ctx context.Context,
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		directive0 := func(rctx context.Context) (interface{}, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().ChangeOperatorStatus(rctx, fc.Args["input"].(*model.OperatorStatusInput))
-		}
-		directive1 := func(ctx context.Context) (interface{}, error) {
-			if ec.directives.KubernetesMiddleware == nil {
-				return nil, errors.New("directive KubernetesMiddleware is not implemented")
-			}
-			return ec.directives.KubernetesMiddleware(ctx, nil, directive0)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(model.Status); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be github.com/layer5io/meshery/server/internal/graphql/model.Status`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return obj.Controller, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9958,37 +10441,26 @@ func (ec *executionContext) _Mutation_changeOperatorStatus(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Status)
+	res := resTmp.(model.MesheryController)
 	fc.Result = res
-	return ec.marshalNStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐStatus(ctx, field.Selections, res)
+	return ec.marshalNMesheryController2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryController(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_changeOperatorStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryControllersStatusListItem_controller(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MesheryControllersStatusListItem",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Status does not have child fields")
+			return nil, errors.New("field of type MesheryController does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_changeOperatorStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_changeAdapterStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_changeAdapterStatus(ctx, field)
+func (ec *executionContext) _MesheryControllersStatusListItem_status(ctx context.Context, field graphql.CollectedField, obj *model.MesheryControllersStatusListItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryControllersStatusListItem_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10000,28 +10472,8 @@ func (ec *executionContext) _Mutation_changeAdapterStatus(ctx context.Context, f
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		directive0 := func(rctx context.Context) (interface{}, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Mutation().ChangeAdapterStatus(rctx, fc.Args["input"].(*model.AdapterStatusInput))
-		}
-		directive1 := func(ctx context.Context) (interface{}, error) {
-			if ec.directives.KubernetesMiddleware == nil {
-				return nil, errors.New("directive KubernetesMiddleware is not implemented")
-			}
-			return ec.directives.KubernetesMiddleware(ctx, nil, directive0)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(model.Status); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be github.com/layer5io/meshery/server/internal/graphql/model.Status`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10033,37 +10485,26 @@ func (ec *executionContext) _Mutation_changeAdapterStatus(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Status)
+	res := resTmp.(model.MesheryControllerStatus)
 	fc.Result = res
-	return ec.marshalNStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐStatus(ctx, field.Selections, res)
+	return ec.marshalNMesheryControllerStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryControllerStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_changeAdapterStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryControllersStatusListItem_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MesheryControllersStatusListItem",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Status does not have child fields")
+			return nil, errors.New("field of type MesheryControllerStatus does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_changeAdapterStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _NameSpace_namespace(ctx context.Context, field graphql.CollectedField, obj *model.NameSpace) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_NameSpace_namespace(ctx, field)
+func (ec *executionContext) _MesheryResult_meshery_id(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_meshery_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10076,26 +10517,23 @@ func (ec *executionContext) _NameSpace_namespace(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Namespace, nil
+		return obj.MesheryID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_NameSpace_namespace(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_meshery_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "NameSpace",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10106,8 +10544,8 @@ func (ec *executionContext) fieldContext_NameSpace_namespace(ctx context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _NullString_String(ctx context.Context, field graphql.CollectedField, obj *model.NullString) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_NullString_String(ctx, field)
+func (ec *executionContext) _MesheryResult_name(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10120,26 +10558,23 @@ func (ec *executionContext) _NullString_String(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.String, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_NullString_String(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "NullString",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10150,8 +10585,8 @@ func (ec *executionContext) fieldContext_NullString_String(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _NullString_Valid(ctx context.Context, field graphql.CollectedField, obj *model.NullString) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_NullString_Valid(ctx, field)
+func (ec *executionContext) _MesheryResult_mesh(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_mesh(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10164,38 +10599,35 @@ func (ec *executionContext) _NullString_Valid(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Valid, nil
+		return obj.Mesh, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_NullString_Valid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_mesh(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "NullString",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OAMCapability_oam_definition(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OAMCapability_oam_definition(ctx, field)
+func (ec *executionContext) _MesheryResult_performance_profile(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_performance_profile(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10208,7 +10640,7 @@ func (ec *executionContext) _OAMCapability_oam_definition(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OamDefinition, nil
+		return obj.PerformanceProfile, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10217,26 +10649,26 @@ func (ec *executionContext) _OAMCapability_oam_definition(ctx context.Context, f
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(interface{})
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOAny2interface(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OAMCapability_oam_definition(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_performance_profile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OAMCapability",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Any does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OAMCapability_id(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OAMCapability_id(ctx, field)
+func (ec *executionContext) _MesheryResult_test_id(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_test_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10249,7 +10681,7 @@ func (ec *executionContext) _OAMCapability_id(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.TestID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10263,9 +10695,9 @@ func (ec *executionContext) _OAMCapability_id(ctx context.Context, field graphql
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OAMCapability_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_test_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OAMCapability",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10276,8 +10708,8 @@ func (ec *executionContext) fieldContext_OAMCapability_id(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _OAMCapability_oam_ref_schema(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OAMCapability_oam_ref_schema(ctx, field)
+func (ec *executionContext) _MesheryResult_runner_results(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_runner_results(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10290,7 +10722,7 @@ func (ec *executionContext) _OAMCapability_oam_ref_schema(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OamRefSchema, nil
+		return obj.RunnerResults, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10299,26 +10731,26 @@ func (ec *executionContext) _OAMCapability_oam_ref_schema(ctx context.Context, f
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(map[string]interface{})
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOMap2map(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OAMCapability_oam_ref_schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_runner_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OAMCapability",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Map does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OAMCapability_host(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OAMCapability_host(ctx, field)
+func (ec *executionContext) _MesheryResult_server_metrics(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_server_metrics(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10331,7 +10763,7 @@ func (ec *executionContext) _OAMCapability_host(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Host, nil
+		return obj.ServerMetrics, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10345,9 +10777,9 @@ func (ec *executionContext) _OAMCapability_host(ctx context.Context, field graph
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OAMCapability_host(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_server_metrics(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OAMCapability",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10358,8 +10790,8 @@ func (ec *executionContext) fieldContext_OAMCapability_host(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _OAMCapability_restricted(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OAMCapability_restricted(ctx, field)
+func (ec *executionContext) _MesheryResult_server_board_config(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_server_board_config(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10372,7 +10804,7 @@ func (ec *executionContext) _OAMCapability_restricted(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Restricted, nil
+		return obj.ServerBoardConfig, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10381,26 +10813,26 @@ func (ec *executionContext) _OAMCapability_restricted(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OAMCapability_restricted(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_server_board_config(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OAMCapability",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OAMCapability_metadata(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OAMCapability_metadata(ctx, field)
+func (ec *executionContext) _MesheryResult_test_start_time(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_test_start_time(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10413,7 +10845,7 @@ func (ec *executionContext) _OAMCapability_metadata(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Metadata, nil
+		return obj.TestStartTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10422,26 +10854,26 @@ func (ec *executionContext) _OAMCapability_metadata(ctx context.Context, field g
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(interface{})
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOAny2interface(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OAMCapability_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_test_start_time(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OAMCapability",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Any does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorControllerStatus_name(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorControllerStatus_name(ctx, field)
+func (ec *executionContext) _MesheryResult_user_id(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_user_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10454,26 +10886,23 @@ func (ec *executionContext) _OperatorControllerStatus_name(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.UserID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorControllerStatus_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_user_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorControllerStatus",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10484,8 +10913,8 @@ func (ec *executionContext) fieldContext_OperatorControllerStatus_name(ctx conte
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorControllerStatus_version(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorControllerStatus_version(ctx, field)
+func (ec *executionContext) _MesheryResult_updated_at(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_updated_at(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10498,26 +10927,23 @@ func (ec *executionContext) _OperatorControllerStatus_version(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Version, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorControllerStatus_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_updated_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorControllerStatus",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10528,8 +10954,8 @@ func (ec *executionContext) fieldContext_OperatorControllerStatus_version(ctx co
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorControllerStatus_status(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorControllerStatus_status(ctx, field)
+func (ec *executionContext) _MesheryResult_created_at(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_created_at(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10542,38 +10968,35 @@ func (ec *executionContext) _OperatorControllerStatus_status(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Status)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐStatus(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorControllerStatus_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_created_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorControllerStatus",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Status does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorControllerStatus_error(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorControllerStatus_error(ctx, field)
+func (ec *executionContext) _Mutation_changeOperatorStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_changeOperatorStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10585,42 +11008,70 @@ func (ec *executionContext) _OperatorControllerStatus_error(ctx context.Context,
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Error, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().ChangeOperatorStatus(rctx, fc.Args["input"].(*model.OperatorStatusInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.KubernetesMiddleware == nil {
+				return nil, errors.New("directive KubernetesMiddleware is not implemented")
+			}
+			return ec.directives.KubernetesMiddleware(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(model.Status); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be github.com/layer5io/meshery/server/internal/graphql/model.Status`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Error)
+	res := resTmp.(model.Status)
 	fc.Result = res
-	return ec.marshalOError2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐError(ctx, field.Selections, res)
+	return ec.marshalNStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorControllerStatus_error(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_changeOperatorStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorControllerStatus",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "code":
-				return ec.fieldContext_Error_code(ctx, field)
-			case "description":
-				return ec.fieldContext_Error_description(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
+			return nil, errors.New("field of type Status does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_changeOperatorStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorControllerStatus_contextID(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorControllerStatus_contextID(ctx, field)
+func (ec *executionContext) _Mutation_changeAdapterStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_changeAdapterStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10632,8 +11083,28 @@ func (ec *executionContext) _OperatorControllerStatus_contextID(ctx context.Cont
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ContextID, nil
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Mutation().ChangeAdapterStatus(rctx, fc.Args["input"].(*model.AdapterStatusInput))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.KubernetesMiddleware == nil {
+				return nil, errors.New("directive KubernetesMiddleware is not implemented")
+			}
+			return ec.directives.KubernetesMiddleware(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(model.Status); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be github.com/layer5io/meshery/server/internal/graphql/model.Status`, tmp)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10645,26 +11116,37 @@ func (ec *executionContext) _OperatorControllerStatus_contextID(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.Status)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorControllerStatus_contextID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_changeAdapterStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorControllerStatus",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Status does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_changeAdapterStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorControllerStatusPerK8sContext_contextID(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatusPerK8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorControllerStatusPerK8sContext_contextID(ctx, field)
+func (ec *executionContext) _Mutation_createDesign(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createDesign(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10677,7 +11159,7 @@ func (ec *executionContext) _OperatorControllerStatusPerK8sContext_contextID(ctx
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContextID, nil
+		return ec.resolvers.Mutation().CreateDesign(rctx, fc.Args["input"].(model.DesignInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10689,26 +11171,47 @@ func (ec *executionContext) _OperatorControllerStatusPerK8sContext_contextID(ctx
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Design)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNDesign2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDesign(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorControllerStatusPerK8sContext_contextID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createDesign(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorControllerStatusPerK8sContext",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Design_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Design_name(ctx, field)
+			case "designFile":
+				return ec.fieldContext_Design_designFile(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Design_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Design", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createDesign_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorControllerStatusPerK8sContext_OperatorControllerStatus(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatusPerK8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorControllerStatusPerK8sContext_OperatorControllerStatus(ctx, field)
+func (ec *executionContext) _Mutation_updateDesign(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_updateDesign(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10721,7 +11224,7 @@ func (ec *executionContext) _OperatorControllerStatusPerK8sContext_OperatorContr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OperatorControllerStatus, nil
+		return ec.resolvers.Mutation().UpdateDesign(rctx, fc.Args["id"].(string), fc.Args["input"].(model.DesignInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10733,38 +11236,47 @@ func (ec *executionContext) _OperatorControllerStatusPerK8sContext_OperatorContr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.OperatorControllerStatus)
+	res := resTmp.(*model.Design)
 	fc.Result = res
-	return ec.marshalNOperatorControllerStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatus(ctx, field.Selections, res)
+	return ec.marshalNDesign2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDesign(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorControllerStatusPerK8sContext_OperatorControllerStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updateDesign(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorControllerStatusPerK8sContext",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "id":
+				return ec.fieldContext_Design_id(ctx, field)
 			case "name":
-				return ec.fieldContext_OperatorControllerStatus_name(ctx, field)
-			case "version":
-				return ec.fieldContext_OperatorControllerStatus_version(ctx, field)
-			case "status":
-				return ec.fieldContext_OperatorControllerStatus_status(ctx, field)
-			case "error":
-				return ec.fieldContext_OperatorControllerStatus_error(ctx, field)
-			case "contextID":
-				return ec.fieldContext_OperatorControllerStatus_contextID(ctx, field)
+				return ec.fieldContext_Design_name(ctx, field)
+			case "designFile":
+				return ec.fieldContext_Design_designFile(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Design_updatedAt(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type OperatorControllerStatus", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Design", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updateDesign_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorStatus_status(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorStatus_status(ctx, field)
+func (ec *executionContext) _Mutation_deployDesign(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deployDesign(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10777,7 +11289,7 @@ func (ec *executionContext) _OperatorStatus_status(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return ec.resolvers.Mutation().DeployDesign(rctx, fc.Args["input"].(model.DesignDeployInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10789,26 +11301,43 @@ func (ec *executionContext) _OperatorStatus_status(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Status)
+	res := resTmp.(*model.DesignDeployment)
 	fc.Result = res
-	return ec.marshalNStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐStatus(ctx, field.Selections, res)
+	return ec.marshalNDesignDeployment2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDesignDeployment(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorStatus_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deployDesign(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorStatus",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Status does not have child fields")
+			switch field.Name {
+			case "designID":
+				return ec.fieldContext_DesignDeployment_designID(ctx, field)
+			case "summary":
+				return ec.fieldContext_DesignDeployment_summary(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DesignDeployment", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deployDesign_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorStatus_version(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorStatus_version(ctx, field)
+func (ec *executionContext) _Mutation_undeployDesign(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_undeployDesign(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10821,7 +11350,7 @@ func (ec *executionContext) _OperatorStatus_version(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Version, nil
+		return ec.resolvers.Mutation().UndeployDesign(rctx, fc.Args["input"].(model.DesignDeployInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10833,26 +11362,43 @@ func (ec *executionContext) _OperatorStatus_version(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.DesignDeployment)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNDesignDeployment2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDesignDeployment(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorStatus_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_undeployDesign(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorStatus",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "designID":
+				return ec.fieldContext_DesignDeployment_designID(ctx, field)
+			case "summary":
+				return ec.fieldContext_DesignDeployment_summary(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DesignDeployment", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_undeployDesign_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorStatus_controllers(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorStatus_controllers(ctx, field)
+func (ec *executionContext) _NameSpace_namespace(ctx context.Context, field graphql.CollectedField, obj *model.NameSpace) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NameSpace_namespace(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10865,7 +11411,7 @@ func (ec *executionContext) _OperatorStatus_controllers(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Controllers, nil
+		return obj.Namespace, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10877,38 +11423,26 @@ func (ec *executionContext) _OperatorStatus_controllers(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.OperatorControllerStatus)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNOperatorControllerStatus2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatusᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorStatus_controllers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NameSpace_namespace(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorStatus",
+		Object:     "NameSpace",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_OperatorControllerStatus_name(ctx, field)
-			case "version":
-				return ec.fieldContext_OperatorControllerStatus_version(ctx, field)
-			case "status":
-				return ec.fieldContext_OperatorControllerStatus_status(ctx, field)
-			case "error":
-				return ec.fieldContext_OperatorControllerStatus_error(ctx, field)
-			case "contextID":
-				return ec.fieldContext_OperatorControllerStatus_contextID(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type OperatorControllerStatus", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorStatus_error(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorStatus_error(ctx, field)
+func (ec *executionContext) _NullString_String(ctx context.Context, field graphql.CollectedField, obj *model.NullString) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NullString_String(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10921,41 +11455,38 @@ func (ec *executionContext) _OperatorStatus_error(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Error, nil
+		return obj.String, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Error)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOError2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐError(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorStatus_error(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NullString_String(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorStatus",
+		Object:     "NullString",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "code":
-				return ec.fieldContext_Error_code(ctx, field)
-			case "description":
-				return ec.fieldContext_Error_description(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorStatus_contextID(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorStatus_contextID(ctx, field)
+func (ec *executionContext) _NullString_Valid(ctx context.Context, field graphql.CollectedField, obj *model.NullString) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NullString_Valid(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10968,7 +11499,7 @@ func (ec *executionContext) _OperatorStatus_contextID(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContextID, nil
+		return obj.Valid, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10980,26 +11511,26 @@ func (ec *executionContext) _OperatorStatus_contextID(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorStatus_contextID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NullString_Valid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorStatus",
+		Object:     "NullString",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorStatusPerK8sContext_contextID(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatusPerK8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorStatusPerK8sContext_contextID(ctx, field)
+func (ec *executionContext) _OAMCapability_oam_definition(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OAMCapability_oam_definition(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11012,38 +11543,35 @@ func (ec *executionContext) _OperatorStatusPerK8sContext_contextID(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContextID, nil
+		return obj.OamDefinition, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(interface{})
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOAny2interface(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorStatusPerK8sContext_contextID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAMCapability_oam_definition(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorStatusPerK8sContext",
+		Object:     "OAMCapability",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Any does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorStatusPerK8sContext_operatorStatus(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatusPerK8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorStatusPerK8sContext_operatorStatus(ctx, field)
+func (ec *executionContext) _OAMCapability_id(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OAMCapability_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11056,50 +11584,35 @@ func (ec *executionContext) _OperatorStatusPerK8sContext_operatorStatus(ctx cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OperatorStatus, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.OperatorStatus)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNOperatorStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorStatus(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorStatusPerK8sContext_operatorStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAMCapability_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorStatusPerK8sContext",
+		Object:     "OAMCapability",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "status":
-				return ec.fieldContext_OperatorStatus_status(ctx, field)
-			case "version":
-				return ec.fieldContext_OperatorStatus_version(ctx, field)
-			case "controllers":
-				return ec.fieldContext_OperatorStatus_controllers(ctx, field)
-			case "error":
-				return ec.fieldContext_OperatorStatus_error(ctx, field)
-			case "contextID":
-				return ec.fieldContext_OperatorStatus_contextID(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type OperatorStatus", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternPageResult_page(ctx context.Context, field graphql.CollectedField, obj *model.PatternPageResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternPageResult_page(ctx, field)
+func (ec *executionContext) _OAMCapability_oam_ref_schema(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OAMCapability_oam_ref_schema(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11112,38 +11625,35 @@ func (ec *executionContext) _PatternPageResult_page(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Page, nil
+		return obj.OamRefSchema, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternPageResult_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAMCapability_oam_ref_schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternPageResult",
+		Object:     "OAMCapability",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternPageResult_page_size(ctx context.Context, field graphql.CollectedField, obj *model.PatternPageResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternPageResult_page_size(ctx, field)
+func (ec *executionContext) _OAMCapability_host(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OAMCapability_host(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11156,38 +11666,35 @@ func (ec *executionContext) _PatternPageResult_page_size(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageSize, nil
+		return obj.Host, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternPageResult_page_size(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAMCapability_host(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternPageResult",
+		Object:     "OAMCapability",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternPageResult_total_count(ctx context.Context, field graphql.CollectedField, obj *model.PatternPageResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternPageResult_total_count(ctx, field)
+func (ec *executionContext) _OAMCapability_restricted(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OAMCapability_restricted(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11200,38 +11707,35 @@ func (ec *executionContext) _PatternPageResult_total_count(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.Restricted, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*bool)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternPageResult_total_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAMCapability_restricted(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternPageResult",
+		Object:     "OAMCapability",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternPageResult_patterns(ctx context.Context, field graphql.CollectedField, obj *model.PatternPageResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternPageResult_patterns(ctx, field)
+func (ec *executionContext) _OAMCapability_metadata(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OAMCapability_metadata(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11244,7 +11748,7 @@ func (ec *executionContext) _PatternPageResult_patterns(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Patterns, nil
+		return obj.Metadata, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11253,50 +11757,26 @@ func (ec *executionContext) _PatternPageResult_patterns(ctx context.Context, fie
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.PatternResult)
+	res := resTmp.(interface{})
 	fc.Result = res
-	return ec.marshalOPatternResult2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternResult(ctx, field.Selections, res)
+	return ec.marshalOAny2interface(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternPageResult_patterns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAMCapability_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternPageResult",
+		Object:     "OAMCapability",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_PatternResult_id(ctx, field)
-			case "name":
-				return ec.fieldContext_PatternResult_name(ctx, field)
-			case "user_id":
-				return ec.fieldContext_PatternResult_user_id(ctx, field)
-			case "location":
-				return ec.fieldContext_PatternResult_location(ctx, field)
-			case "pattern_file":
-				return ec.fieldContext_PatternResult_pattern_file(ctx, field)
-			case "visibility":
-				return ec.fieldContext_PatternResult_visibility(ctx, field)
-			case "catalog_data":
-				return ec.fieldContext_PatternResult_catalog_data(ctx, field)
-			case "canSupport":
-				return ec.fieldContext_PatternResult_canSupport(ctx, field)
-			case "errmsg":
-				return ec.fieldContext_PatternResult_errmsg(ctx, field)
-			case "created_at":
-				return ec.fieldContext_PatternResult_created_at(ctx, field)
-			case "updated_at":
-				return ec.fieldContext_PatternResult_updated_at(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PatternResult", field.Name)
+			return nil, errors.New("field of type Any does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_id(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_id(ctx, field)
+func (ec *executionContext) _OperatorControllerStatus_name(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorControllerStatus_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11309,7 +11789,7 @@ func (ec *executionContext) _PatternResult_id(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11323,24 +11803,24 @@ func (ec *executionContext) _PatternResult_id(ctx context.Context, field graphql
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorControllerStatus_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "OperatorControllerStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_name(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_name(ctx, field)
+func (ec *executionContext) _OperatorControllerStatus_version(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorControllerStatus_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11353,7 +11833,7 @@ func (ec *executionContext) _PatternResult_name(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11370,9 +11850,9 @@ func (ec *executionContext) _PatternResult_name(ctx context.Context, field graph
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorControllerStatus_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "OperatorControllerStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -11383,8 +11863,8 @@ func (ec *executionContext) fieldContext_PatternResult_name(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_user_id(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_user_id(ctx, field)
+func (ec *executionContext) _OperatorControllerStatus_status(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorControllerStatus_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11397,7 +11877,7 @@ func (ec *executionContext) _PatternResult_user_id(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UserID, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11409,26 +11889,26 @@ func (ec *executionContext) _PatternResult_user_id(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.Status)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_user_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorControllerStatus_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "OperatorControllerStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Status does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_location(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_location(ctx, field)
+func (ec *executionContext) _OperatorControllerStatus_error(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorControllerStatus_error(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11441,49 +11921,42 @@ func (ec *executionContext) _PatternResult_location(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Location, nil
+		return obj.Error, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Location)
+	res := resTmp.(*model.Error)
 	fc.Result = res
-	return ec.marshalNLocation2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐLocation(ctx, field.Selections, res)
+	return ec.marshalOError2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐError(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorControllerStatus_error(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "OperatorControllerStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "branch":
-				return ec.fieldContext_Location_branch(ctx, field)
-			case "host":
-				return ec.fieldContext_Location_host(ctx, field)
-			case "path":
-				return ec.fieldContext_Location_path(ctx, field)
-			case "type":
-				return ec.fieldContext_Location_type(ctx, field)
+			case "code":
+				return ec.fieldContext_Error_code(ctx, field)
+			case "description":
+				return ec.fieldContext_Error_description(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_pattern_file(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_pattern_file(ctx, field)
-	if err != nil {
+func (ec *executionContext) _OperatorControllerStatus_contextID(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorControllerStatus_contextID(ctx, field)
+	if err != nil {
 		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
@@ -11495,7 +11968,7 @@ func (ec *executionContext) _PatternResult_pattern_file(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PatternFile, nil
+		return obj.ContextID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11512,9 +11985,9 @@ func (ec *executionContext) _PatternResult_pattern_file(ctx context.Context, fie
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_pattern_file(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorControllerStatus_contextID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "OperatorControllerStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -11525,8 +11998,8 @@ func (ec *executionContext) fieldContext_PatternResult_pattern_file(ctx context.
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_visibility(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_visibility(ctx, field)
+func (ec *executionContext) _OperatorControllerStatusPerK8sContext_contextID(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatusPerK8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorControllerStatusPerK8sContext_contextID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11539,7 +12012,7 @@ func (ec *executionContext) _PatternResult_visibility(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Visibility, nil
+		return obj.ContextID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11556,9 +12029,9 @@ func (ec *executionContext) _PatternResult_visibility(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_visibility(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorControllerStatusPerK8sContext_contextID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "OperatorControllerStatusPerK8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -11569,8 +12042,8 @@ func (ec *executionContext) fieldContext_PatternResult_visibility(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_catalog_data(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_catalog_data(ctx, field)
+func (ec *executionContext) _OperatorControllerStatusPerK8sContext_OperatorControllerStatus(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatusPerK8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorControllerStatusPerK8sContext_OperatorControllerStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11583,35 +12056,50 @@ func (ec *executionContext) _PatternResult_catalog_data(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CatalogData, nil
+		return obj.OperatorControllerStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(map[string]interface{})
+	res := resTmp.(*model.OperatorControllerStatus)
 	fc.Result = res
-	return ec.marshalOMap2map(ctx, field.Selections, res)
+	return ec.marshalNOperatorControllerStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_catalog_data(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorControllerStatusPerK8sContext_OperatorControllerStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "OperatorControllerStatusPerK8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Map does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_OperatorControllerStatus_name(ctx, field)
+			case "version":
+				return ec.fieldContext_OperatorControllerStatus_version(ctx, field)
+			case "status":
+				return ec.fieldContext_OperatorControllerStatus_status(ctx, field)
+			case "error":
+				return ec.fieldContext_OperatorControllerStatus_error(ctx, field)
+			case "contextID":
+				return ec.fieldContext_OperatorControllerStatus_contextID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OperatorControllerStatus", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_canSupport(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_canSupport(ctx, field)
+func (ec *executionContext) _OperatorStatus_status(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorStatus_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11624,7 +12112,7 @@ func (ec *executionContext) _PatternResult_canSupport(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CanSupport, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11636,26 +12124,26 @@ func (ec *executionContext) _PatternResult_canSupport(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(model.Status)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_canSupport(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorStatus_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "OperatorStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Status does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_errmsg(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_errmsg(ctx, field)
+func (ec *executionContext) _OperatorStatus_version(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorStatus_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11668,23 +12156,26 @@ func (ec *executionContext) _PatternResult_errmsg(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Errmsg, nil
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_errmsg(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorStatus_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "OperatorStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -11695,8 +12186,8 @@ func (ec *executionContext) fieldContext_PatternResult_errmsg(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_created_at(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_created_at(ctx, field)
+func (ec *executionContext) _OperatorStatus_controllers(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorStatus_controllers(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11709,35 +12200,50 @@ func (ec *executionContext) _PatternResult_created_at(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Controllers, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.OperatorControllerStatus)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNOperatorControllerStatus2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatusᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_created_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorStatus_controllers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "OperatorStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_OperatorControllerStatus_name(ctx, field)
+			case "version":
+				return ec.fieldContext_OperatorControllerStatus_version(ctx, field)
+			case "status":
+				return ec.fieldContext_OperatorControllerStatus_status(ctx, field)
+			case "error":
+				return ec.fieldContext_OperatorControllerStatus_error(ctx, field)
+			case "contextID":
+				return ec.fieldContext_OperatorControllerStatus_contextID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OperatorControllerStatus", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_updated_at(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_updated_at(ctx, field)
+func (ec *executionContext) _OperatorStatus_error(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorStatus_error(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11750,7 +12256,7 @@ func (ec *executionContext) _PatternResult_updated_at(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.Error, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11759,26 +12265,32 @@ func (ec *executionContext) _PatternResult_updated_at(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.Error)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOError2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐError(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_updated_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorStatus_error(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "OperatorStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_Error_code(ctx, field)
+			case "description":
+				return ec.fieldContext_Error_description(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfPageProfiles_page(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageProfiles) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfPageProfiles_page(ctx, field)
+func (ec *executionContext) _OperatorStatus_contextID(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorStatus_contextID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11791,7 +12303,7 @@ func (ec *executionContext) _PerfPageProfiles_page(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Page, nil
+		return obj.ContextID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11803,26 +12315,26 @@ func (ec *executionContext) _PerfPageProfiles_page(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfPageProfiles_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorStatus_contextID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfPageProfiles",
+		Object:     "OperatorStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfPageProfiles_page_size(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageProfiles) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfPageProfiles_page_size(ctx, field)
+func (ec *executionContext) _OperatorStatusPerK8sContext_contextID(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatusPerK8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorStatusPerK8sContext_contextID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11835,7 +12347,7 @@ func (ec *executionContext) _PerfPageProfiles_page_size(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageSize, nil
+		return obj.ContextID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11847,26 +12359,26 @@ func (ec *executionContext) _PerfPageProfiles_page_size(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfPageProfiles_page_size(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorStatusPerK8sContext_contextID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfPageProfiles",
+		Object:     "OperatorStatusPerK8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfPageProfiles_total_count(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageProfiles) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfPageProfiles_total_count(ctx, field)
+func (ec *executionContext) _OperatorStatusPerK8sContext_operatorStatus(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatusPerK8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorStatusPerK8sContext_operatorStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11879,7 +12391,7 @@ func (ec *executionContext) _PerfPageProfiles_total_count(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.OperatorStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11891,26 +12403,38 @@ func (ec *executionContext) _PerfPageProfiles_total_count(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.OperatorStatus)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNOperatorStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfPageProfiles_total_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorStatusPerK8sContext_operatorStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfPageProfiles",
+		Object:     "OperatorStatusPerK8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "status":
+				return ec.fieldContext_OperatorStatus_status(ctx, field)
+			case "version":
+				return ec.fieldContext_OperatorStatus_version(ctx, field)
+			case "controllers":
+				return ec.fieldContext_OperatorStatus_controllers(ctx, field)
+			case "error":
+				return ec.fieldContext_OperatorStatus_error(ctx, field)
+			case "contextID":
+				return ec.fieldContext_OperatorStatus_contextID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OperatorStatus", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfPageProfiles_profiles(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageProfiles) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfPageProfiles_profiles(ctx, field)
+func (ec *executionContext) _PatternPageResult_page(ctx context.Context, field graphql.CollectedField, obj *model.PatternPageResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternPageResult_page(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11923,74 +12447,39 @@ func (ec *executionContext) _PerfPageProfiles_profiles(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Profiles, nil
+		return obj.Page, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.PerfProfile)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOPerfProfile2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPerfProfile(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfPageProfiles_profiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternPageResult_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfPageProfiles",
+		Object:     "PatternPageResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "concurrent_request":
-				return ec.fieldContext_PerfProfile_concurrent_request(ctx, field)
-			case "created_at":
-				return ec.fieldContext_PerfProfile_created_at(ctx, field)
-			case "duration":
-				return ec.fieldContext_PerfProfile_duration(ctx, field)
-			case "endpoints":
-				return ec.fieldContext_PerfProfile_endpoints(ctx, field)
-			case "id":
-				return ec.fieldContext_PerfProfile_id(ctx, field)
-			case "last_run":
-				return ec.fieldContext_PerfProfile_last_run(ctx, field)
-			case "load_generators":
-				return ec.fieldContext_PerfProfile_load_generators(ctx, field)
-			case "name":
-				return ec.fieldContext_PerfProfile_name(ctx, field)
-			case "qps":
-				return ec.fieldContext_PerfProfile_qps(ctx, field)
-			case "total_results":
-				return ec.fieldContext_PerfProfile_total_results(ctx, field)
-			case "updated_at":
-				return ec.fieldContext_PerfProfile_updated_at(ctx, field)
-			case "user_id":
-				return ec.fieldContext_PerfProfile_user_id(ctx, field)
-			case "request_headers":
-				return ec.fieldContext_PerfProfile_request_headers(ctx, field)
-			case "request_cookies":
-				return ec.fieldContext_PerfProfile_request_cookies(ctx, field)
-			case "request_body":
-				return ec.fieldContext_PerfProfile_request_body(ctx, field)
-			case "content_type":
-				return ec.fieldContext_PerfProfile_content_type(ctx, field)
-			case "service_mesh":
-				return ec.fieldContext_PerfProfile_service_mesh(ctx, field)
-			case "metadata":
-				return ec.fieldContext_PerfProfile_metadata(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PerfProfile", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfPageResult_page(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfPageResult_page(ctx, field)
-	if err != nil {
+func (ec *executionContext) _PatternPageResult_page_size(ctx context.Context, field graphql.CollectedField, obj *model.PatternPageResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternPageResult_page_size(ctx, field)
+	if err != nil {
 		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
@@ -12002,7 +12491,7 @@ func (ec *executionContext) _PerfPageResult_page(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Page, nil
+		return obj.PageSize, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12019,9 +12508,9 @@ func (ec *executionContext) _PerfPageResult_page(ctx context.Context, field grap
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfPageResult_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternPageResult_page_size(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfPageResult",
+		Object:     "PatternPageResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -12032,8 +12521,8 @@ func (ec *executionContext) fieldContext_PerfPageResult_page(ctx context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfPageResult_page_size(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfPageResult_page_size(ctx, field)
+func (ec *executionContext) _PatternPageResult_total_count(ctx context.Context, field graphql.CollectedField, obj *model.PatternPageResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternPageResult_total_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12046,7 +12535,7 @@ func (ec *executionContext) _PerfPageResult_page_size(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageSize, nil
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12063,9 +12552,9 @@ func (ec *executionContext) _PerfPageResult_page_size(ctx context.Context, field
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfPageResult_page_size(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternPageResult_total_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfPageResult",
+		Object:     "PatternPageResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -12076,8 +12565,8 @@ func (ec *executionContext) fieldContext_PerfPageResult_page_size(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfPageResult_total_count(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfPageResult_total_count(ctx, field)
+func (ec *executionContext) _PatternPageResult_patterns(ctx context.Context, field graphql.CollectedField, obj *model.PatternPageResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternPageResult_patterns(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12090,38 +12579,59 @@ func (ec *executionContext) _PerfPageResult_total_count(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.Patterns, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]*model.PatternResult)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOPatternResult2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternResult(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfPageResult_total_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternPageResult_patterns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfPageResult",
+		Object:     "PatternPageResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PatternResult_id(ctx, field)
+			case "name":
+				return ec.fieldContext_PatternResult_name(ctx, field)
+			case "user_id":
+				return ec.fieldContext_PatternResult_user_id(ctx, field)
+			case "location":
+				return ec.fieldContext_PatternResult_location(ctx, field)
+			case "pattern_file":
+				return ec.fieldContext_PatternResult_pattern_file(ctx, field)
+			case "visibility":
+				return ec.fieldContext_PatternResult_visibility(ctx, field)
+			case "catalog_data":
+				return ec.fieldContext_PatternResult_catalog_data(ctx, field)
+			case "canSupport":
+				return ec.fieldContext_PatternResult_canSupport(ctx, field)
+			case "errmsg":
+				return ec.fieldContext_PatternResult_errmsg(ctx, field)
+			case "created_at":
+				return ec.fieldContext_PatternResult_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_PatternResult_updated_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PatternResult", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfPageResult_results(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfPageResult_results(ctx, field)
+func (ec *executionContext) _PatternResult_id(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12134,61 +12644,38 @@ func (ec *executionContext) _PerfPageResult_results(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Results, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.MesheryResult)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOMesheryResult2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryResult(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfPageResult_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternResult_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfPageResult",
+		Object:     "PatternResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "meshery_id":
-				return ec.fieldContext_MesheryResult_meshery_id(ctx, field)
-			case "name":
-				return ec.fieldContext_MesheryResult_name(ctx, field)
-			case "mesh":
-				return ec.fieldContext_MesheryResult_mesh(ctx, field)
-			case "performance_profile":
-				return ec.fieldContext_MesheryResult_performance_profile(ctx, field)
-			case "test_id":
-				return ec.fieldContext_MesheryResult_test_id(ctx, field)
-			case "runner_results":
-				return ec.fieldContext_MesheryResult_runner_results(ctx, field)
-			case "server_metrics":
-				return ec.fieldContext_MesheryResult_server_metrics(ctx, field)
-			case "server_board_config":
-				return ec.fieldContext_MesheryResult_server_board_config(ctx, field)
-			case "test_start_time":
-				return ec.fieldContext_MesheryResult_test_start_time(ctx, field)
-			case "user_id":
-				return ec.fieldContext_MesheryResult_user_id(ctx, field)
-			case "updated_at":
-				return ec.fieldContext_MesheryResult_updated_at(ctx, field)
-			case "created_at":
-				return ec.fieldContext_MesheryResult_created_at(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type MesheryResult", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_concurrent_request(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_concurrent_request(ctx, field)
+func (ec *executionContext) _PatternResult_name(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12201,7 +12688,7 @@ func (ec *executionContext) _PerfProfile_concurrent_request(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ConcurrentRequest, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12213,26 +12700,26 @@ func (ec *executionContext) _PerfProfile_concurrent_request(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_concurrent_request(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternResult_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_created_at(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_created_at(ctx, field)
+func (ec *executionContext) _PatternResult_user_id(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_user_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12245,23 +12732,26 @@ func (ec *executionContext) _PerfProfile_created_at(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.UserID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_created_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternResult_user_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -12272,8 +12762,8 @@ func (ec *executionContext) fieldContext_PerfProfile_created_at(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_duration(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_duration(ctx, field)
+func (ec *executionContext) _PatternResult_location(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_location(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12286,7 +12776,7 @@ func (ec *executionContext) _PerfProfile_duration(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Duration, nil
+		return obj.Location, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12298,26 +12788,36 @@ func (ec *executionContext) _PerfProfile_duration(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Location)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNLocation2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐLocation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_duration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternResult_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "branch":
+				return ec.fieldContext_Location_branch(ctx, field)
+			case "host":
+				return ec.fieldContext_Location_host(ctx, field)
+			case "path":
+				return ec.fieldContext_Location_path(ctx, field)
+			case "type":
+				return ec.fieldContext_Location_type(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_endpoints(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_endpoints(ctx, field)
+func (ec *executionContext) _PatternResult_pattern_file(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_pattern_file(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12330,23 +12830,26 @@ func (ec *executionContext) _PerfProfile_endpoints(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Endpoints, nil
+		return obj.PatternFile, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚕᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_endpoints(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternResult_pattern_file(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -12357,8 +12860,8 @@ func (ec *executionContext) fieldContext_PerfProfile_endpoints(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_id(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_id(ctx, field)
+func (ec *executionContext) _PatternResult_visibility(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_visibility(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12371,7 +12874,7 @@ func (ec *executionContext) _PerfProfile_id(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Visibility, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12388,9 +12891,9 @@ func (ec *executionContext) _PerfProfile_id(ctx context.Context, field graphql.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternResult_visibility(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -12401,8 +12904,8 @@ func (ec *executionContext) fieldContext_PerfProfile_id(ctx context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_last_run(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_last_run(ctx, field)
+func (ec *executionContext) _PatternResult_catalog_data(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_catalog_data(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12415,7 +12918,7 @@ func (ec *executionContext) _PerfProfile_last_run(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastRun, nil
+		return obj.CatalogData, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12424,26 +12927,26 @@ func (ec *executionContext) _PerfProfile_last_run(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(map[string]interface{})
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOMap2map(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_last_run(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternResult_catalog_data(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Map does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_load_generators(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_load_generators(ctx, field)
+func (ec *executionContext) _PatternResult_canSupport(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_canSupport(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12456,35 +12959,38 @@ func (ec *executionContext) _PerfProfile_load_generators(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LoadGenerators, nil
+		return obj.CanSupport, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2ᚕᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_load_generators(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternResult_canSupport(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_name(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_name(ctx, field)
+func (ec *executionContext) _PatternResult_errmsg(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_errmsg(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12497,7 +13003,7 @@ func (ec *executionContext) _PerfProfile_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Errmsg, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12511,9 +13017,9 @@ func (ec *executionContext) _PerfProfile_name(ctx context.Context, field graphql
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternResult_errmsg(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -12524,8 +13030,8 @@ func (ec *executionContext) fieldContext_PerfProfile_name(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_qps(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_qps(ctx, field)
+func (ec *executionContext) _PatternResult_created_at(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_created_at(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12538,7 +13044,7 @@ func (ec *executionContext) _PerfProfile_qps(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QPS, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12547,26 +13053,26 @@ func (ec *executionContext) _PerfProfile_qps(ctx context.Context, field graphql.
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_qps(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternResult_created_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_total_results(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_total_results(ctx, field)
+func (ec *executionContext) _PatternResult_updated_at(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_updated_at(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12579,7 +13085,7 @@ func (ec *executionContext) _PerfProfile_total_results(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalResults, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12588,26 +13094,26 @@ func (ec *executionContext) _PerfProfile_total_results(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_total_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternResult_updated_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_updated_at(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_updated_at(ctx, field)
+func (ec *executionContext) _PerfPageProfiles_page(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageProfiles) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfPageProfiles_page(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12620,35 +13126,38 @@ func (ec *executionContext) _PerfProfile_updated_at(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.Page, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_updated_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PerfPageProfiles_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PerfPageProfiles",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_user_id(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_user_id(ctx, field)
+func (ec *executionContext) _PerfPageProfiles_page_size(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageProfiles) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfPageProfiles_page_size(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12661,7 +13170,7 @@ func (ec *executionContext) _PerfProfile_user_id(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UserID, nil
+		return obj.PageSize, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12673,26 +13182,26 @@ func (ec *executionContext) _PerfProfile_user_id(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_user_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PerfPageProfiles_page_size(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PerfPageProfiles",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_request_headers(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_request_headers(ctx, field)
+func (ec *executionContext) _PerfPageProfiles_total_count(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageProfiles) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfPageProfiles_total_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12705,35 +13214,38 @@ func (ec *executionContext) _PerfProfile_request_headers(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RequestHeaders, nil
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_request_headers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PerfPageProfiles_total_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PerfPageProfiles",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_request_cookies(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_request_cookies(ctx, field)
+func (ec *executionContext) _PerfPageProfiles_profiles(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageProfiles) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfPageProfiles_profiles(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12746,7 +13258,7 @@ func (ec *executionContext) _PerfProfile_request_cookies(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RequestCookies, nil
+		return obj.Profiles, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12755,26 +13267,64 @@ func (ec *executionContext) _PerfProfile_request_cookies(ctx context.Context, fi
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.PerfProfile)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOPerfProfile2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPerfProfile(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_request_cookies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PerfPageProfiles_profiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PerfPageProfiles",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "concurrent_request":
+				return ec.fieldContext_PerfProfile_concurrent_request(ctx, field)
+			case "created_at":
+				return ec.fieldContext_PerfProfile_created_at(ctx, field)
+			case "duration":
+				return ec.fieldContext_PerfProfile_duration(ctx, field)
+			case "endpoints":
+				return ec.fieldContext_PerfProfile_endpoints(ctx, field)
+			case "id":
+				return ec.fieldContext_PerfProfile_id(ctx, field)
+			case "last_run":
+				return ec.fieldContext_PerfProfile_last_run(ctx, field)
+			case "load_generators":
+				return ec.fieldContext_PerfProfile_load_generators(ctx, field)
+			case "name":
+				return ec.fieldContext_PerfProfile_name(ctx, field)
+			case "qps":
+				return ec.fieldContext_PerfProfile_qps(ctx, field)
+			case "total_results":
+				return ec.fieldContext_PerfProfile_total_results(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_PerfProfile_updated_at(ctx, field)
+			case "user_id":
+				return ec.fieldContext_PerfProfile_user_id(ctx, field)
+			case "request_headers":
+				return ec.fieldContext_PerfProfile_request_headers(ctx, field)
+			case "request_cookies":
+				return ec.fieldContext_PerfProfile_request_cookies(ctx, field)
+			case "request_body":
+				return ec.fieldContext_PerfProfile_request_body(ctx, field)
+			case "content_type":
+				return ec.fieldContext_PerfProfile_content_type(ctx, field)
+			case "service_mesh":
+				return ec.fieldContext_PerfProfile_service_mesh(ctx, field)
+			case "metadata":
+				return ec.fieldContext_PerfProfile_metadata(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PerfProfile", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_request_body(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_request_body(ctx, field)
+func (ec *executionContext) _PerfPageResult_page(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfPageResult_page(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12787,35 +13337,38 @@ func (ec *executionContext) _PerfProfile_request_body(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RequestBody, nil
+		return obj.Page, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_request_body(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PerfPageResult_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PerfPageResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_content_type(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_content_type(ctx, field)
+func (ec *executionContext) _PerfPageResult_page_size(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfPageResult_page_size(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12828,35 +13381,38 @@ func (ec *executionContext) _PerfProfile_content_type(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContentType, nil
+		return obj.PageSize, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_content_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PerfPageResult_page_size(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PerfPageResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_service_mesh(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_service_mesh(ctx, field)
+func (ec *executionContext) _PerfPageResult_total_count(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfPageResult_total_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12869,35 +13425,38 @@ func (ec *executionContext) _PerfProfile_service_mesh(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ServiceMesh, nil
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_service_mesh(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PerfPageResult_total_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PerfPageResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_metadata(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_metadata(ctx, field)
+func (ec *executionContext) _PerfPageResult_results(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfPageResult_results(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12910,7 +13469,7 @@ func (ec *executionContext) _PerfProfile_metadata(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Metadata, nil
+		return obj.Results, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12919,26 +13478,52 @@ func (ec *executionContext) _PerfProfile_metadata(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(map[string]interface{})
+	res := resTmp.([]*model.MesheryResult)
 	fc.Result = res
-	return ec.marshalOMap2map(ctx, field.Selections, res)
+	return ec.marshalOMesheryResult2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryResult(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PerfPageResult_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PerfPageResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Map does not have child fields")
+			switch field.Name {
+			case "meshery_id":
+				return ec.fieldContext_MesheryResult_meshery_id(ctx, field)
+			case "name":
+				return ec.fieldContext_MesheryResult_name(ctx, field)
+			case "mesh":
+				return ec.fieldContext_MesheryResult_mesh(ctx, field)
+			case "performance_profile":
+				return ec.fieldContext_MesheryResult_performance_profile(ctx, field)
+			case "test_id":
+				return ec.fieldContext_MesheryResult_test_id(ctx, field)
+			case "runner_results":
+				return ec.fieldContext_MesheryResult_runner_results(ctx, field)
+			case "server_metrics":
+				return ec.fieldContext_MesheryResult_server_metrics(ctx, field)
+			case "server_board_config":
+				return ec.fieldContext_MesheryResult_server_board_config(ctx, field)
+			case "test_start_time":
+				return ec.fieldContext_MesheryResult_test_start_time(ctx, field)
+			case "user_id":
+				return ec.fieldContext_MesheryResult_user_id(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_MesheryResult_updated_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_MesheryResult_created_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MesheryResult", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getAvailableAddons(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getAvailableAddons(ctx, field)
+func (ec *executionContext) _PerfProfile_concurrent_request(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_concurrent_request(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12951,7 +13536,7 @@ func (ec *executionContext) _Query_getAvailableAddons(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetAvailableAddons(rctx, fc.Args["filter"].(*model.ServiceMeshFilter))
+		return obj.ConcurrentRequest, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12963,25 +13548,1392 @@ func (ec *executionContext) _Query_getAvailableAddons(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.AddonList)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNAddonList2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐAddonListᚄ(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getAvailableAddons(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PerfProfile_concurrent_request(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "PerfProfile",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_AddonList_name(ctx, field)
-			case "owner":
-				return ec.fieldContext_AddonList_owner(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type AddonList", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_created_at(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_created_at(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_created_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_duration(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_duration(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Duration, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_duration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_endpoints(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_endpoints(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Endpoints, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*string)
+	fc.Result = res
+	return ec.marshalOString2ᚕᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_endpoints(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_id(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_last_run(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_last_run(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastRun, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_last_run(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_load_generators(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_load_generators(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LoadGenerators, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*string)
+	fc.Result = res
+	return ec.marshalOString2ᚕᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_load_generators(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_name(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_qps(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_qps(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QPS, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_qps(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_total_results(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_total_results(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalResults, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_total_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_updated_at(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_updated_at(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_updated_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_user_id(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_user_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_user_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_request_headers(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_request_headers(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RequestHeaders, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_request_headers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_request_cookies(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_request_cookies(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RequestCookies, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_request_cookies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_request_body(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_request_body(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RequestBody, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_request_body(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_content_type(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_content_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ContentType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_content_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_service_mesh(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_service_mesh(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ServiceMesh, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_service_mesh(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_metadata(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_metadata(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Metadata, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(map[string]interface{})
+	fc.Result = res
+	return ec.marshalOMap2map(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Map does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getAvailableAddons(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getAvailableAddons(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetAvailableAddons(rctx, fc.Args["filter"].(*model.ServiceMeshFilter))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.AddonList)
+	fc.Result = res
+	return ec.marshalNAddonList2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐAddonListᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getAvailableAddons(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_AddonList_name(ctx, field)
+			case "owner":
+				return ec.fieldContext_AddonList_owner(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type AddonList", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getAvailableAddons_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getControlPlanes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getControlPlanes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetControlPlanes(rctx, fc.Args["filter"].(*model.ServiceMeshFilter))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ControlPlane)
+	fc.Result = res
+	return ec.marshalNControlPlane2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐControlPlaneᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getControlPlanes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_ControlPlane_name(ctx, field)
+			case "members":
+				return ec.fieldContext_ControlPlane_members(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ControlPlane", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getControlPlanes_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getDataPlanes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getDataPlanes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetDataPlanes(rctx, fc.Args["filter"].(*model.ServiceMeshFilter))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.DataPlane)
+	fc.Result = res
+	return ec.marshalNDataPlane2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDataPlaneᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getDataPlanes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_DataPlane_name(ctx, field)
+			case "proxies":
+				return ec.fieldContext_DataPlane_proxies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DataPlane", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDataPlanes_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getOperatorStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getOperatorStatus(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().GetOperatorStatus(rctx, fc.Args["k8scontextID"].(string))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.KubernetesMiddleware == nil {
+				return nil, errors.New("directive KubernetesMiddleware is not implemented")
+			}
+			return ec.directives.KubernetesMiddleware(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(*model.OperatorStatus); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/layer5io/meshery/server/internal/graphql/model.OperatorStatus`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.OperatorStatus)
+	fc.Result = res
+	return ec.marshalOOperatorStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getOperatorStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "status":
+				return ec.fieldContext_OperatorStatus_status(ctx, field)
+			case "version":
+				return ec.fieldContext_OperatorStatus_version(ctx, field)
+			case "controllers":
+				return ec.fieldContext_OperatorStatus_controllers(ctx, field)
+			case "error":
+				return ec.fieldContext_OperatorStatus_error(ctx, field)
+			case "contextID":
+				return ec.fieldContext_OperatorStatus_contextID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OperatorStatus", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getOperatorStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_resyncCluster(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_resyncCluster(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		directive0 := func(rctx context.Context) (interface{}, error) {
+			ctx = rctx // use context from middleware stack in children
+			return ec.resolvers.Query().ResyncCluster(rctx, fc.Args["selector"].(*model.ReSyncActions), fc.Args["k8scontextID"].(string))
+		}
+		directive1 := func(ctx context.Context) (interface{}, error) {
+			if ec.directives.KubernetesMiddleware == nil {
+				return nil, errors.New("directive KubernetesMiddleware is not implemented")
+			}
+			return ec.directives.KubernetesMiddleware(ctx, nil, directive0)
+		}
+
+		tmp, err := directive1(rctx)
+		if err != nil {
+			return nil, graphql.ErrorOnPath(ctx, err)
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		if data, ok := tmp.(model.Status); ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf(`unexpected type %T from directive, should be github.com/layer5io/meshery/server/internal/graphql/model.Status`, tmp)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.Status)
+	fc.Result = res
+	return ec.marshalNStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_resyncCluster(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Status does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_resyncCluster_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getMeshsyncStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getMeshsyncStatus(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetMeshsyncStatus(rctx, fc.Args["connectionID"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.OperatorControllerStatus)
+	fc.Result = res
+	return ec.marshalNOperatorControllerStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getMeshsyncStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_OperatorControllerStatus_name(ctx, field)
+			case "version":
+				return ec.fieldContext_OperatorControllerStatus_version(ctx, field)
+			case "status":
+				return ec.fieldContext_OperatorControllerStatus_status(ctx, field)
+			case "error":
+				return ec.fieldContext_OperatorControllerStatus_error(ctx, field)
+			case "contextID":
+				return ec.fieldContext_OperatorControllerStatus_contextID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OperatorControllerStatus", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getMeshsyncStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getNatsStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getNatsStatus(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetNatsStatus(rctx, fc.Args["connectionID"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.OperatorControllerStatus)
+	fc.Result = res
+	return ec.marshalNOperatorControllerStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getNatsStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_OperatorControllerStatus_name(ctx, field)
+			case "version":
+				return ec.fieldContext_OperatorControllerStatus_version(ctx, field)
+			case "status":
+				return ec.fieldContext_OperatorControllerStatus_status(ctx, field)
+			case "error":
+				return ec.fieldContext_OperatorControllerStatus_error(ctx, field)
+			case "contextID":
+				return ec.fieldContext_OperatorControllerStatus_contextID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OperatorControllerStatus", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getNatsStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getAvailableNamespaces(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getAvailableNamespaces(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetAvailableNamespaces(rctx, fc.Args["k8sClusterIDs"].([]string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.NameSpace)
+	fc.Result = res
+	return ec.marshalNNameSpace2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐNameSpaceᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getAvailableNamespaces(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "namespace":
+				return ec.fieldContext_NameSpace_namespace(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type NameSpace", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getAvailableNamespaces_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getPerfResult(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getPerfResult(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetPerfResult(rctx, fc.Args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.MesheryResult)
+	fc.Result = res
+	return ec.marshalOMesheryResult2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getPerfResult(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "meshery_id":
+				return ec.fieldContext_MesheryResult_meshery_id(ctx, field)
+			case "name":
+				return ec.fieldContext_MesheryResult_name(ctx, field)
+			case "mesh":
+				return ec.fieldContext_MesheryResult_mesh(ctx, field)
+			case "performance_profile":
+				return ec.fieldContext_MesheryResult_performance_profile(ctx, field)
+			case "test_id":
+				return ec.fieldContext_MesheryResult_test_id(ctx, field)
+			case "runner_results":
+				return ec.fieldContext_MesheryResult_runner_results(ctx, field)
+			case "server_metrics":
+				return ec.fieldContext_MesheryResult_server_metrics(ctx, field)
+			case "server_board_config":
+				return ec.fieldContext_MesheryResult_server_board_config(ctx, field)
+			case "test_start_time":
+				return ec.fieldContext_MesheryResult_test_start_time(ctx, field)
+			case "user_id":
+				return ec.fieldContext_MesheryResult_user_id(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_MesheryResult_updated_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_MesheryResult_created_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MesheryResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getPerfResult_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_fetchResults(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_fetchResults(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().FetchResults(rctx, fc.Args["selector"].(model.PageFilter), fc.Args["profileID"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PerfPageResult)
+	fc.Result = res
+	return ec.marshalNPerfPageResult2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPerfPageResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_fetchResults(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "page":
+				return ec.fieldContext_PerfPageResult_page(ctx, field)
+			case "page_size":
+				return ec.fieldContext_PerfPageResult_page_size(ctx, field)
+			case "total_count":
+				return ec.fieldContext_PerfPageResult_total_count(ctx, field)
+			case "results":
+				return ec.fieldContext_PerfPageResult_results(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PerfPageResult", field.Name)
 		},
 	}
 	defer func() {
@@ -12991,15 +14943,15 @@ func (ec *executionContext) fieldContext_Query_getAvailableAddons(ctx context.Co
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getAvailableAddons_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_fetchResults_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getControlPlanes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getControlPlanes(ctx, field)
+func (ec *executionContext) _Query_getPerformanceProfiles(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getPerformanceProfiles(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13012,7 +14964,7 @@ func (ec *executionContext) _Query_getControlPlanes(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetControlPlanes(rctx, fc.Args["filter"].(*model.ServiceMeshFilter))
+		return ec.resolvers.Query().GetPerformanceProfiles(rctx, fc.Args["selector"].(model.PageFilter))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13024,12 +14976,12 @@ func (ec *executionContext) _Query_getControlPlanes(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ControlPlane)
+	res := resTmp.(*model.PerfPageProfiles)
 	fc.Result = res
-	return ec.marshalNControlPlane2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐControlPlaneᚄ(ctx, field.Selections, res)
+	return ec.marshalNPerfPageProfiles2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPerfPageProfiles(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getControlPlanes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getPerformanceProfiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -13037,12 +14989,16 @@ func (ec *executionContext) fieldContext_Query_getControlPlanes(ctx context.Cont
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext_ControlPlane_name(ctx, field)
-			case "members":
-				return ec.fieldContext_ControlPlane_members(ctx, field)
+			case "page":
+				return ec.fieldContext_PerfPageProfiles_page(ctx, field)
+			case "page_size":
+				return ec.fieldContext_PerfPageProfiles_page_size(ctx, field)
+			case "total_count":
+				return ec.fieldContext_PerfPageProfiles_total_count(ctx, field)
+			case "profiles":
+				return ec.fieldContext_PerfPageProfiles_profiles(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ControlPlane", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PerfPageProfiles", field.Name)
 		},
 	}
 	defer func() {
@@ -13052,15 +15008,15 @@ func (ec *executionContext) fieldContext_Query_getControlPlanes(ctx context.Cont
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getControlPlanes_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_getPerformanceProfiles_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDataPlanes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getDataPlanes(ctx, field)
+func (ec *executionContext) _Query_fetchAllResults(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_fetchAllResults(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13073,7 +15029,7 @@ func (ec *executionContext) _Query_getDataPlanes(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetDataPlanes(rctx, fc.Args["filter"].(*model.ServiceMeshFilter))
+		return ec.resolvers.Query().FetchAllResults(rctx, fc.Args["selector"].(model.PageFilter))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13085,12 +15041,12 @@ func (ec *executionContext) _Query_getDataPlanes(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.DataPlane)
+	res := resTmp.(*model.PerfPageResult)
 	fc.Result = res
-	return ec.marshalNDataPlane2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDataPlaneᚄ(ctx, field.Selections, res)
+	return ec.marshalNPerfPageResult2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPerfPageResult(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getDataPlanes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_fetchAllResults(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -13098,12 +15054,16 @@ func (ec *executionContext) fieldContext_Query_getDataPlanes(ctx context.Context
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext_DataPlane_name(ctx, field)
-			case "proxies":
-				return ec.fieldContext_DataPlane_proxies(ctx, field)
+			case "page":
+				return ec.fieldContext_PerfPageResult_page(ctx, field)
+			case "page_size":
+				return ec.fieldContext_PerfPageResult_page_size(ctx, field)
+			case "total_count":
+				return ec.fieldContext_PerfPageResult_total_count(ctx, field)
+			case "results":
+				return ec.fieldContext_PerfPageResult_results(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type DataPlane", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PerfPageResult", field.Name)
 		},
 	}
 	defer func() {
@@ -13113,15 +15073,15 @@ func (ec *executionContext) fieldContext_Query_getDataPlanes(ctx context.Context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getDataPlanes_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_fetchAllResults_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getOperatorStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getOperatorStatus(ctx, field)
+func (ec *executionContext) _Query_fetchPatterns(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_fetchPatterns(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13133,42 +15093,25 @@ func (ec *executionContext) _Query_getOperatorStatus(ctx context.Context, field
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		directive0 := func(rctx context.Context) (interface{}, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Query().GetOperatorStatus(rctx, fc.Args["k8scontextID"].(string))
-		}
-		directive1 := func(ctx context.Context) (interface{}, error) {
-			if ec.directives.KubernetesMiddleware == nil {
-				return nil, errors.New("directive KubernetesMiddleware is not implemented")
-			}
-			return ec.directives.KubernetesMiddleware(ctx, nil, directive0)
-		}
-
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(*model.OperatorStatus); ok {
-			return data, nil
-		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be *github.com/layer5io/meshery/server/internal/graphql/model.OperatorStatus`, tmp)
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().FetchPatterns(rctx, fc.Args["selector"].(model.PageFilter))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.OperatorStatus)
+	res := resTmp.(*model.PatternPageResult)
 	fc.Result = res
-	return ec.marshalOOperatorStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorStatus(ctx, field.Selections, res)
+	return ec.marshalNPatternPageResult2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternPageResult(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getOperatorStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_fetchPatterns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -13176,18 +15119,16 @@ func (ec *executionContext) fieldContext_Query_getOperatorStatus(ctx context.Con
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "status":
-				return ec.fieldContext_OperatorStatus_status(ctx, field)
-			case "version":
-				return ec.fieldContext_OperatorStatus_version(ctx, field)
-			case "controllers":
-				return ec.fieldContext_OperatorStatus_controllers(ctx, field)
-			case "error":
-				return ec.fieldContext_OperatorStatus_error(ctx, field)
-			case "contextID":
-				return ec.fieldContext_OperatorStatus_contextID(ctx, field)
+			case "page":
+				return ec.fieldContext_PatternPageResult_page(ctx, field)
+			case "page_size":
+				return ec.fieldContext_PatternPageResult_page_size(ctx, field)
+			case "total_count":
+				return ec.fieldContext_PatternPageResult_total_count(ctx, field)
+			case "patterns":
+				return ec.fieldContext_PatternPageResult_patterns(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type OperatorStatus", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PatternPageResult", field.Name)
 		},
 	}
 	defer func() {
@@ -13197,15 +15138,15 @@ func (ec *executionContext) fieldContext_Query_getOperatorStatus(ctx context.Con
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getOperatorStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_fetchPatterns_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_resyncCluster(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_resyncCluster(ctx, field)
+func (ec *executionContext) _Query_getKubectlDescribe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getKubectlDescribe(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13217,28 +15158,69 @@ func (ec *executionContext) _Query_resyncCluster(ctx context.Context, field grap
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		directive0 := func(rctx context.Context) (interface{}, error) {
-			ctx = rctx // use context from middleware stack in children
-			return ec.resolvers.Query().ResyncCluster(rctx, fc.Args["selector"].(*model.ReSyncActions), fc.Args["k8scontextID"].(string))
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetKubectlDescribe(rctx, fc.Args["name"].(string), fc.Args["kind"].(string), fc.Args["namespace"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
 		}
-		directive1 := func(ctx context.Context) (interface{}, error) {
-			if ec.directives.KubernetesMiddleware == nil {
-				return nil, errors.New("directive KubernetesMiddleware is not implemented")
+		return graphql.Null
+	}
+	res := resTmp.(*model.KctlDescribeDetails)
+	fc.Result = res
+	return ec.marshalNKctlDescribeDetails2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐKctlDescribeDetails(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getKubectlDescribe(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "describe":
+				return ec.fieldContext_KctlDescribeDetails_describe(ctx, field)
+			case "ctxid":
+				return ec.fieldContext_KctlDescribeDetails_ctxid(ctx, field)
 			}
-			return ec.directives.KubernetesMiddleware(ctx, nil, directive0)
+			return nil, fmt.Errorf("no field named %q was found under type KctlDescribeDetails", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
 		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getKubectlDescribe_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
 
-		tmp, err := directive1(rctx)
-		if err != nil {
-			return nil, graphql.ErrorOnPath(ctx, err)
-		}
-		if tmp == nil {
-			return nil, nil
-		}
-		if data, ok := tmp.(model.Status); ok {
-			return data, nil
+func (ec *executionContext) _Query_fetchPatternCatalogContent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_fetchPatternCatalogContent(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
-		return nil, fmt.Errorf(`unexpected type %T from directive, should be github.com/layer5io/meshery/server/internal/graphql/model.Status`, tmp)
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().FetchPatternCatalogContent(rctx, fc.Args["selector"].(*model.CatalogSelector))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13250,19 +15232,39 @@ func (ec *executionContext) _Query_resyncCluster(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Status)
+	res := resTmp.([]*model.CatalogPattern)
 	fc.Result = res
-	return ec.marshalNStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐStatus(ctx, field.Selections, res)
+	return ec.marshalNCatalogPattern2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐCatalogPatternᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_resyncCluster(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_fetchPatternCatalogContent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Status does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_CatalogPattern_id(ctx, field)
+			case "name":
+				return ec.fieldContext_CatalogPattern_name(ctx, field)
+			case "user_id":
+				return ec.fieldContext_CatalogPattern_user_id(ctx, field)
+			case "pattern_file":
+				return ec.fieldContext_CatalogPattern_pattern_file(ctx, field)
+			case "location":
+				return ec.fieldContext_CatalogPattern_location(ctx, field)
+			case "visibility":
+				return ec.fieldContext_CatalogPattern_visibility(ctx, field)
+			case "catalog_data":
+				return ec.fieldContext_CatalogPattern_catalog_data(ctx, field)
+			case "created_at":
+				return ec.fieldContext_CatalogPattern_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_CatalogPattern_updated_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CatalogPattern", field.Name)
 		},
 	}
 	defer func() {
@@ -13272,15 +15274,15 @@ func (ec *executionContext) fieldContext_Query_resyncCluster(ctx context.Context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_resyncCluster_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_fetchPatternCatalogContent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getMeshsyncStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getMeshsyncStatus(ctx, field)
+func (ec *executionContext) _Query_fetchFilterCatalogContent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_fetchFilterCatalogContent(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13293,7 +15295,7 @@ func (ec *executionContext) _Query_getMeshsyncStatus(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetMeshsyncStatus(rctx, fc.Args["connectionID"].(string))
+		return ec.resolvers.Query().FetchFilterCatalogContent(rctx, fc.Args["selector"].(*model.CatalogSelector))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13305,12 +15307,12 @@ func (ec *executionContext) _Query_getMeshsyncStatus(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.OperatorControllerStatus)
+	res := resTmp.([]*model.CatalogFilter)
 	fc.Result = res
-	return ec.marshalNOperatorControllerStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatus(ctx, field.Selections, res)
+	return ec.marshalNCatalogFilter2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐCatalogFilterᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getMeshsyncStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_fetchFilterCatalogContent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -13318,18 +15320,28 @@ func (ec *executionContext) fieldContext_Query_getMeshsyncStatus(ctx context.Con
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "id":
+				return ec.fieldContext_CatalogFilter_id(ctx, field)
 			case "name":
-				return ec.fieldContext_OperatorControllerStatus_name(ctx, field)
-			case "version":
-				return ec.fieldContext_OperatorControllerStatus_version(ctx, field)
-			case "status":
-				return ec.fieldContext_OperatorControllerStatus_status(ctx, field)
-			case "error":
-				return ec.fieldContext_OperatorControllerStatus_error(ctx, field)
-			case "contextID":
-				return ec.fieldContext_OperatorControllerStatus_contextID(ctx, field)
+				return ec.fieldContext_CatalogFilter_name(ctx, field)
+			case "filter_file":
+				return ec.fieldContext_CatalogFilter_filter_file(ctx, field)
+			case "user_id":
+				return ec.fieldContext_CatalogFilter_user_id(ctx, field)
+			case "location":
+				return ec.fieldContext_CatalogFilter_location(ctx, field)
+			case "filter_resource":
+				return ec.fieldContext_CatalogFilter_filter_resource(ctx, field)
+			case "visibility":
+				return ec.fieldContext_CatalogFilter_visibility(ctx, field)
+			case "catalog_data":
+				return ec.fieldContext_CatalogFilter_catalog_data(ctx, field)
+			case "created_at":
+				return ec.fieldContext_CatalogFilter_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_CatalogFilter_updated_at(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type OperatorControllerStatus", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type CatalogFilter", field.Name)
 		},
 	}
 	defer func() {
@@ -13339,15 +15351,15 @@ func (ec *executionContext) fieldContext_Query_getMeshsyncStatus(ctx context.Con
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getMeshsyncStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_fetchFilterCatalogContent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getNatsStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getNatsStatus(ctx, field)
+func (ec *executionContext) _Query_getMeshModelSummary(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getMeshModelSummary(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13360,7 +15372,7 @@ func (ec *executionContext) _Query_getNatsStatus(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetNatsStatus(rctx, fc.Args["connectionID"].(string))
+		return ec.resolvers.Query().GetMeshModelSummary(rctx, fc.Args["selector"].(model.MeshModelSummarySelector))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13372,12 +15384,12 @@ func (ec *executionContext) _Query_getNatsStatus(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.OperatorControllerStatus)
+	res := resTmp.(*model.MeshModelSummary)
 	fc.Result = res
-	return ec.marshalNOperatorControllerStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatus(ctx, field.Selections, res)
+	return ec.marshalNMeshModelSummary2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMeshModelSummary(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getNatsStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getMeshModelSummary(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -13385,18 +15397,12 @@ func (ec *executionContext) fieldContext_Query_getNatsStatus(ctx context.Context
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext_OperatorControllerStatus_name(ctx, field)
-			case "version":
-				return ec.fieldContext_OperatorControllerStatus_version(ctx, field)
-			case "status":
-				return ec.fieldContext_OperatorControllerStatus_status(ctx, field)
-			case "error":
-				return ec.fieldContext_OperatorControllerStatus_error(ctx, field)
-			case "contextID":
-				return ec.fieldContext_OperatorControllerStatus_contextID(ctx, field)
+			case "components":
+				return ec.fieldContext_MeshModelSummary_components(ctx, field)
+			case "relationships":
+				return ec.fieldContext_MeshModelSummary_relationships(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type OperatorControllerStatus", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type MeshModelSummary", field.Name)
 		},
 	}
 	defer func() {
@@ -13406,15 +15412,15 @@ func (ec *executionContext) fieldContext_Query_getNatsStatus(ctx context.Context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getNatsStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_getMeshModelSummary_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getAvailableNamespaces(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getAvailableNamespaces(ctx, field)
+func (ec *executionContext) _Query_fetchTelemetryComponents(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_fetchTelemetryComponents(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13427,7 +15433,7 @@ func (ec *executionContext) _Query_getAvailableNamespaces(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetAvailableNamespaces(rctx, fc.Args["k8sClusterIDs"].([]string))
+		return ec.resolvers.Query().FetchTelemetryComponents(rctx, fc.Args["contexts"].([]string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13439,12 +15445,12 @@ func (ec *executionContext) _Query_getAvailableNamespaces(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.NameSpace)
+	res := resTmp.([]*model.TelemetryComp)
 	fc.Result = res
-	return ec.marshalNNameSpace2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐNameSpaceᚄ(ctx, field.Selections, res)
+	return ec.marshalNTelemetryComp2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐTelemetryComp(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getAvailableNamespaces(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_fetchTelemetryComponents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -13452,10 +15458,14 @@ func (ec *executionContext) fieldContext_Query_getAvailableNamespaces(ctx contex
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "namespace":
-				return ec.fieldContext_NameSpace_namespace(ctx, field)
+			case "name":
+				return ec.fieldContext_TelemetryComp_name(ctx, field)
+			case "spec":
+				return ec.fieldContext_TelemetryComp_spec(ctx, field)
+			case "status":
+				return ec.fieldContext_TelemetryComp_status(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type NameSpace", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TelemetryComp", field.Name)
 		},
 	}
 	defer func() {
@@ -13465,15 +15475,15 @@ func (ec *executionContext) fieldContext_Query_getAvailableNamespaces(ctx contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getAvailableNamespaces_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_fetchTelemetryComponents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getPerfResult(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getPerfResult(ctx, field)
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13486,7 +15496,7 @@ func (ec *executionContext) _Query_getPerfResult(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetPerfResult(rctx, fc.Args["id"].(string))
+		return ec.introspectType(fc.Args["name"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13495,45 +15505,41 @@ func (ec *executionContext) _Query_getPerfResult(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.MesheryResult)
+	res := resTmp.(*introspection.Type)
 	fc.Result = res
-	return ec.marshalOMesheryResult2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryResult(ctx, field.Selections, res)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getPerfResult(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "meshery_id":
-				return ec.fieldContext_MesheryResult_meshery_id(ctx, field)
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
 			case "name":
-				return ec.fieldContext_MesheryResult_name(ctx, field)
-			case "mesh":
-				return ec.fieldContext_MesheryResult_mesh(ctx, field)
-			case "performance_profile":
-				return ec.fieldContext_MesheryResult_performance_profile(ctx, field)
-			case "test_id":
-				return ec.fieldContext_MesheryResult_test_id(ctx, field)
-			case "runner_results":
-				return ec.fieldContext_MesheryResult_runner_results(ctx, field)
-			case "server_metrics":
-				return ec.fieldContext_MesheryResult_server_metrics(ctx, field)
-			case "server_board_config":
-				return ec.fieldContext_MesheryResult_server_board_config(ctx, field)
-			case "test_start_time":
-				return ec.fieldContext_MesheryResult_test_start_time(ctx, field)
-			case "user_id":
-				return ec.fieldContext_MesheryResult_user_id(ctx, field)
-			case "updated_at":
-				return ec.fieldContext_MesheryResult_updated_at(ctx, field)
-			case "created_at":
-				return ec.fieldContext_MesheryResult_created_at(ctx, field)
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type MesheryResult", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
 		},
 	}
 	defer func() {
@@ -13543,15 +15549,15 @@ func (ec *executionContext) fieldContext_Query_getPerfResult(ctx context.Context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getPerfResult_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_fetchResults(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_fetchResults(ctx, field)
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___schema(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13564,59 +15570,93 @@ func (ec *executionContext) _Query_fetchResults(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().FetchResults(rctx, fc.Args["selector"].(model.PageFilter), fc.Args["profileID"].(string))
+		return ec.introspectSchema()
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PerfPageResult)
+	res := resTmp.(*introspection.Schema)
 	fc.Result = res
-	return ec.marshalNPerfPageResult2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPerfPageResult(ctx, field.Selections, res)
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_fetchResults(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: true,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "page":
-				return ec.fieldContext_PerfPageResult_page(ctx, field)
-			case "page_size":
-				return ec.fieldContext_PerfPageResult_page_size(ctx, field)
-			case "total_count":
-				return ec.fieldContext_PerfPageResult_total_count(ctx, field)
-			case "results":
-				return ec.fieldContext_PerfPageResult_results(ctx, field)
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type PerfPageResult", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RegistryChange_action(ctx context.Context, field graphql.CollectedField, obj *model.RegistryChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegistryChange_action(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_fetchResults_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Action, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RegistryChange_action(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RegistryChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getPerformanceProfiles(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getPerformanceProfiles(ctx, field)
+func (ec *executionContext) _RegistryChange_entityType(ctx context.Context, field graphql.CollectedField, obj *model.RegistryChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegistryChange_entityType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13629,7 +15669,7 @@ func (ec *executionContext) _Query_getPerformanceProfiles(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetPerformanceProfiles(rctx, fc.Args["selector"].(model.PageFilter))
+		return obj.EntityType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13641,47 +15681,26 @@ func (ec *executionContext) _Query_getPerformanceProfiles(ctx context.Context, f
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PerfPageProfiles)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPerfPageProfiles2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPerfPageProfiles(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getPerformanceProfiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RegistryChange_entityType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "RegistryChange",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "page":
-				return ec.fieldContext_PerfPageProfiles_page(ctx, field)
-			case "page_size":
-				return ec.fieldContext_PerfPageProfiles_page_size(ctx, field)
-			case "total_count":
-				return ec.fieldContext_PerfPageProfiles_total_count(ctx, field)
-			case "profiles":
-				return ec.fieldContext_PerfPageProfiles_profiles(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PerfPageProfiles", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getPerformanceProfiles_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_fetchAllResults(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_fetchAllResults(ctx, field)
+func (ec *executionContext) _RegistryChange_kind(ctx context.Context, field graphql.CollectedField, obj *model.RegistryChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegistryChange_kind(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13694,7 +15713,7 @@ func (ec *executionContext) _Query_fetchAllResults(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().FetchAllResults(rctx, fc.Args["selector"].(model.PageFilter))
+		return obj.Kind, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13706,47 +15725,26 @@ func (ec *executionContext) _Query_fetchAllResults(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PerfPageResult)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPerfPageResult2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPerfPageResult(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_fetchAllResults(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RegistryChange_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "RegistryChange",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "page":
-				return ec.fieldContext_PerfPageResult_page(ctx, field)
-			case "page_size":
-				return ec.fieldContext_PerfPageResult_page_size(ctx, field)
-			case "total_count":
-				return ec.fieldContext_PerfPageResult_total_count(ctx, field)
-			case "results":
-				return ec.fieldContext_PerfPageResult_results(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PerfPageResult", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_fetchAllResults_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_fetchPatterns(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_fetchPatterns(ctx, field)
+func (ec *executionContext) _RegistryChange_subType(ctx context.Context, field graphql.CollectedField, obj *model.RegistryChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegistryChange_subType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13759,7 +15757,7 @@ func (ec *executionContext) _Query_fetchPatterns(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().FetchPatterns(rctx, fc.Args["selector"].(model.PageFilter))
+		return obj.SubType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13771,47 +15769,26 @@ func (ec *executionContext) _Query_fetchPatterns(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PatternPageResult)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPatternPageResult2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternPageResult(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_fetchPatterns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RegistryChange_subType(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "RegistryChange",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "page":
-				return ec.fieldContext_PatternPageResult_page(ctx, field)
-			case "page_size":
-				return ec.fieldContext_PatternPageResult_page_size(ctx, field)
-			case "total_count":
-				return ec.fieldContext_PatternPageResult_total_count(ctx, field)
-			case "patterns":
-				return ec.fieldContext_PatternPageResult_patterns(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PatternPageResult", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_fetchPatterns_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getKubectlDescribe(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getKubectlDescribe(ctx, field)
+func (ec *executionContext) _RegistryChange_model(ctx context.Context, field graphql.CollectedField, obj *model.RegistryChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegistryChange_model(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13824,7 +15801,7 @@ func (ec *executionContext) _Query_getKubectlDescribe(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetKubectlDescribe(rctx, fc.Args["name"].(string), fc.Args["kind"].(string), fc.Args["namespace"].(string))
+		return obj.Model, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13836,43 +15813,26 @@ func (ec *executionContext) _Query_getKubectlDescribe(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.KctlDescribeDetails)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNKctlDescribeDetails2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐKctlDescribeDetails(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getKubectlDescribe(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RegistryChange_model(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "RegistryChange",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "describe":
-				return ec.fieldContext_KctlDescribeDetails_describe(ctx, field)
-			case "ctxid":
-				return ec.fieldContext_KctlDescribeDetails_ctxid(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type KctlDescribeDetails", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getKubectlDescribe_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_fetchPatternCatalogContent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_fetchPatternCatalogContent(ctx, field)
+func (ec *executionContext) _RegistryChange_modelVersion(ctx context.Context, field graphql.CollectedField, obj *model.RegistryChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegistryChange_modelVersion(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13885,7 +15845,7 @@ func (ec *executionContext) _Query_fetchPatternCatalogContent(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().FetchPatternCatalogContent(rctx, fc.Args["selector"].(*model.CatalogSelector))
+		return obj.ModelVersion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13897,57 +15857,26 @@ func (ec *executionContext) _Query_fetchPatternCatalogContent(ctx context.Contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.CatalogPattern)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNCatalogPattern2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐCatalogPatternᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_fetchPatternCatalogContent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RegistryChange_modelVersion(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "RegistryChange",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_CatalogPattern_id(ctx, field)
-			case "name":
-				return ec.fieldContext_CatalogPattern_name(ctx, field)
-			case "user_id":
-				return ec.fieldContext_CatalogPattern_user_id(ctx, field)
-			case "pattern_file":
-				return ec.fieldContext_CatalogPattern_pattern_file(ctx, field)
-			case "location":
-				return ec.fieldContext_CatalogPattern_location(ctx, field)
-			case "visibility":
-				return ec.fieldContext_CatalogPattern_visibility(ctx, field)
-			case "catalog_data":
-				return ec.fieldContext_CatalogPattern_catalog_data(ctx, field)
-			case "created_at":
-				return ec.fieldContext_CatalogPattern_created_at(ctx, field)
-			case "updated_at":
-				return ec.fieldContext_CatalogPattern_updated_at(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CatalogPattern", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_fetchPatternCatalogContent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_fetchFilterCatalogContent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_fetchFilterCatalogContent(ctx, field)
+func (ec *executionContext) _RegistryChange_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.RegistryChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegistryChange_timestamp(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -13960,7 +15889,7 @@ func (ec *executionContext) _Query_fetchFilterCatalogContent(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().FetchFilterCatalogContent(rctx, fc.Args["selector"].(*model.CatalogSelector))
+		return obj.Timestamp, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -13972,59 +15901,26 @@ func (ec *executionContext) _Query_fetchFilterCatalogContent(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.CatalogFilter)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNCatalogFilter2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐCatalogFilterᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_fetchFilterCatalogContent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RegistryChange_timestamp(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "RegistryChange",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_CatalogFilter_id(ctx, field)
-			case "name":
-				return ec.fieldContext_CatalogFilter_name(ctx, field)
-			case "filter_file":
-				return ec.fieldContext_CatalogFilter_filter_file(ctx, field)
-			case "user_id":
-				return ec.fieldContext_CatalogFilter_user_id(ctx, field)
-			case "location":
-				return ec.fieldContext_CatalogFilter_location(ctx, field)
-			case "filter_resource":
-				return ec.fieldContext_CatalogFilter_filter_resource(ctx, field)
-			case "visibility":
-				return ec.fieldContext_CatalogFilter_visibility(ctx, field)
-			case "catalog_data":
-				return ec.fieldContext_CatalogFilter_catalog_data(ctx, field)
-			case "created_at":
-				return ec.fieldContext_CatalogFilter_created_at(ctx, field)
-			case "updated_at":
-				return ec.fieldContext_CatalogFilter_updated_at(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CatalogFilter", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_fetchFilterCatalogContent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getMeshModelSummary(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getMeshModelSummary(ctx, field)
+func (ec *executionContext) _RegistryUpdate_models(ctx context.Context, field graphql.CollectedField, obj *model.RegistryUpdate) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegistryUpdate_models(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14037,7 +15933,7 @@ func (ec *executionContext) _Query_getMeshModelSummary(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetMeshModelSummary(rctx, fc.Args["selector"].(model.MeshModelSummarySelector))
+		return obj.Models, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14049,43 +15945,26 @@ func (ec *executionContext) _Query_getMeshModelSummary(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.MeshModelSummary)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNMeshModelSummary2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMeshModelSummary(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getMeshModelSummary(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RegistryUpdate_models(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "RegistryUpdate",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "components":
-				return ec.fieldContext_MeshModelSummary_components(ctx, field)
-			case "relationships":
-				return ec.fieldContext_MeshModelSummary_relationships(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type MeshModelSummary", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getMeshModelSummary_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_fetchTelemetryComponents(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_fetchTelemetryComponents(ctx, field)
+func (ec *executionContext) _RegistryUpdate_components(ctx context.Context, field graphql.CollectedField, obj *model.RegistryUpdate) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegistryUpdate_components(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14098,7 +15977,7 @@ func (ec *executionContext) _Query_fetchTelemetryComponents(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().FetchTelemetryComponents(rctx, fc.Args["contexts"].([]string))
+		return obj.Components, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14110,45 +15989,26 @@ func (ec *executionContext) _Query_fetchTelemetryComponents(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.TelemetryComp)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNTelemetryComp2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐTelemetryComp(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_fetchTelemetryComponents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RegistryUpdate_components(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "RegistryUpdate",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_TelemetryComp_name(ctx, field)
-			case "spec":
-				return ec.fieldContext_TelemetryComp_spec(ctx, field)
-			case "status":
-				return ec.fieldContext_TelemetryComp_status(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TelemetryComp", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_fetchTelemetryComponents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___type(ctx, field)
+func (ec *executionContext) _RegistryUpdate_relationships(ctx context.Context, field graphql.CollectedField, obj *model.RegistryUpdate) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegistryUpdate_relationships(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14161,68 +16021,38 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(fc.Args["name"].(string))
+		return obj.Relationships, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RegistryUpdate_relationships(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "RegistryUpdate",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___schema(ctx, field)
+func (ec *executionContext) _RegistryUpdate_changes(ctx context.Context, field graphql.CollectedField, obj *model.RegistryUpdate) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RegistryUpdate_changes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -14235,42 +16065,47 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.Changes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.([]*model.RegistryChange)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalNRegistryChange2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐRegistryChangeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RegistryUpdate_changes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "RegistryUpdate",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
+			case "action":
+				return ec.fieldContext_RegistryChange_action(ctx, field)
+			case "entityType":
+				return ec.fieldContext_RegistryChange_entityType(ctx, field)
+			case "kind":
+				return ec.fieldContext_RegistryChange_kind(ctx, field)
+			case "subType":
+				return ec.fieldContext_RegistryChange_subType(ctx, field)
+			case "model":
+				return ec.fieldContext_RegistryChange_model(ctx, field)
+			case "modelVersion":
+				return ec.fieldContext_RegistryChange_modelVersion(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_RegistryChange_timestamp(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RegistryChange", field.Name)
 		},
 	}
 	return fc, nil
@@ -14899,7 +16734,155 @@ func (ec *executionContext) _Subscription_subscribeClusterResources(ctx context.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().SubscribeClusterResources(rctx, fc.Args["k8scontextIDs"].([]string), fc.Args["namespace"].(string))
+		return ec.resolvers.Subscription().SubscribeClusterResources(rctx, fc.Args["k8scontextIDs"].([]string), fc.Args["namespace"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.ClusterResources):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNClusterResources2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐClusterResources(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_subscribeClusterResources(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "resources":
+				return ec.fieldContext_ClusterResources_resources(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ClusterResources", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_subscribeClusterResources_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_subscribeK8sContext(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_subscribeK8sContext(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().SubscribeK8sContext(rctx, fc.Args["selector"].(model.PageFilter))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.K8sContextsPage):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNK8sContextsPage2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐK8sContextsPage(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_subscribeK8sContext(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "total_count":
+				return ec.fieldContext_K8sContextsPage_total_count(ctx, field)
+			case "contexts":
+				return ec.fieldContext_K8sContextsPage_contexts(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type K8sContextsPage", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_subscribeK8sContext_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_subscribeMeshModelSummary(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_subscribeMeshModelSummary(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().SubscribeMeshModelSummary(rctx, fc.Args["selector"].(model.MeshModelSummarySelector))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14913,7 +16896,7 @@ func (ec *executionContext) _Subscription_subscribeClusterResources(ctx context.
 	}
 	return func(ctx context.Context) graphql.Marshaler {
 		select {
-		case res, ok := <-resTmp.(<-chan *model.ClusterResources):
+		case res, ok := <-resTmp.(<-chan *model.MeshModelSummary):
 			if !ok {
 				return nil
 			}
@@ -14921,7 +16904,7 @@ func (ec *executionContext) _Subscription_subscribeClusterResources(ctx context.
 				w.Write([]byte{'{'})
 				graphql.MarshalString(field.Alias).MarshalGQL(w)
 				w.Write([]byte{':'})
-				ec.marshalNClusterResources2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐClusterResources(ctx, field.Selections, res).MarshalGQL(w)
+				ec.marshalNMeshModelSummary2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMeshModelSummary(ctx, field.Selections, res).MarshalGQL(w)
 				w.Write([]byte{'}'})
 			})
 		case <-ctx.Done():
@@ -14930,7 +16913,7 @@ func (ec *executionContext) _Subscription_subscribeClusterResources(ctx context.
 	}
 }
 
-func (ec *executionContext) fieldContext_Subscription_subscribeClusterResources(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Subscription_subscribeMeshModelSummary(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Subscription",
 		Field:      field,
@@ -14938,10 +16921,12 @@ func (ec *executionContext) fieldContext_Subscription_subscribeClusterResources(
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "resources":
-				return ec.fieldContext_ClusterResources_resources(ctx, field)
+			case "components":
+				return ec.fieldContext_MeshModelSummary_components(ctx, field)
+			case "relationships":
+				return ec.fieldContext_MeshModelSummary_relationships(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ClusterResources", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type MeshModelSummary", field.Name)
 		},
 	}
 	defer func() {
@@ -14951,15 +16936,15 @@ func (ec *executionContext) fieldContext_Subscription_subscribeClusterResources(
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_subscribeClusterResources_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Subscription_subscribeMeshModelSummary_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_subscribeK8sContext(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_subscribeK8sContext(ctx, field)
+func (ec *executionContext) _Subscription_registryUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_registryUpdated(ctx, field)
 	if err != nil {
 		return nil
 	}
@@ -14972,7 +16957,7 @@ func (ec *executionContext) _Subscription_subscribeK8sContext(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().SubscribeK8sContext(rctx, fc.Args["selector"].(model.PageFilter))
+		return ec.resolvers.Subscription().RegistryUpdated(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -14986,7 +16971,7 @@ func (ec *executionContext) _Subscription_subscribeK8sContext(ctx context.Contex
 	}
 	return func(ctx context.Context) graphql.Marshaler {
 		select {
-		case res, ok := <-resTmp.(<-chan *model.K8sContextsPage):
+		case res, ok := <-resTmp.(<-chan *model.RegistryUpdate):
 			if !ok {
 				return nil
 			}
@@ -14994,7 +16979,7 @@ func (ec *executionContext) _Subscription_subscribeK8sContext(ctx context.Contex
 				w.Write([]byte{'{'})
 				graphql.MarshalString(field.Alias).MarshalGQL(w)
 				w.Write([]byte{':'})
-				ec.marshalNK8sContextsPage2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐK8sContextsPage(ctx, field.Selections, res).MarshalGQL(w)
+				ec.marshalNRegistryUpdate2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐRegistryUpdate(ctx, field.Selections, res).MarshalGQL(w)
 				w.Write([]byte{'}'})
 			})
 		case <-ctx.Done():
@@ -15003,7 +16988,7 @@ func (ec *executionContext) _Subscription_subscribeK8sContext(ctx context.Contex
 	}
 }
 
-func (ec *executionContext) fieldContext_Subscription_subscribeK8sContext(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Subscription_registryUpdated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Subscription",
 		Field:      field,
@@ -15011,30 +16996,23 @@ func (ec *executionContext) fieldContext_Subscription_subscribeK8sContext(ctx co
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "total_count":
-				return ec.fieldContext_K8sContextsPage_total_count(ctx, field)
-			case "contexts":
-				return ec.fieldContext_K8sContextsPage_contexts(ctx, field)
+			case "models":
+				return ec.fieldContext_RegistryUpdate_models(ctx, field)
+			case "components":
+				return ec.fieldContext_RegistryUpdate_components(ctx, field)
+			case "relationships":
+				return ec.fieldContext_RegistryUpdate_relationships(ctx, field)
+			case "changes":
+				return ec.fieldContext_RegistryUpdate_changes(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type K8sContextsPage", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RegistryUpdate", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_subscribeK8sContext_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_subscribeMeshModelSummary(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_subscribeMeshModelSummary(ctx, field)
+func (ec *executionContext) _Subscription_subscribeDesignDeployment(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_subscribeDesignDeployment(ctx, field)
 	if err != nil {
 		return nil
 	}
@@ -15047,7 +17025,7 @@ func (ec *executionContext) _Subscription_subscribeMeshModelSummary(ctx context.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().SubscribeMeshModelSummary(rctx, fc.Args["selector"].(model.MeshModelSummarySelector))
+		return ec.resolvers.Subscription().SubscribeDesignDeployment(rctx, fc.Args["designID"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -15061,7 +17039,7 @@ func (ec *executionContext) _Subscription_subscribeMeshModelSummary(ctx context.
 	}
 	return func(ctx context.Context) graphql.Marshaler {
 		select {
-		case res, ok := <-resTmp.(<-chan *model.MeshModelSummary):
+		case res, ok := <-resTmp.(<-chan *model.DesignDeploymentProgress):
 			if !ok {
 				return nil
 			}
@@ -15069,7 +17047,7 @@ func (ec *executionContext) _Subscription_subscribeMeshModelSummary(ctx context.
 				w.Write([]byte{'{'})
 				graphql.MarshalString(field.Alias).MarshalGQL(w)
 				w.Write([]byte{':'})
-				ec.marshalNMeshModelSummary2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMeshModelSummary(ctx, field.Selections, res).MarshalGQL(w)
+				ec.marshalNDesignDeploymentProgress2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDesignDeploymentProgress(ctx, field.Selections, res).MarshalGQL(w)
 				w.Write([]byte{'}'})
 			})
 		case <-ctx.Done():
@@ -15078,7 +17056,7 @@ func (ec *executionContext) _Subscription_subscribeMeshModelSummary(ctx context.
 	}
 }
 
-func (ec *executionContext) fieldContext_Subscription_subscribeMeshModelSummary(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Subscription_subscribeDesignDeployment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Subscription",
 		Field:      field,
@@ -15086,12 +17064,24 @@ func (ec *executionContext) fieldContext_Subscription_subscribeMeshModelSummary(
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "components":
-				return ec.fieldContext_MeshModelSummary_components(ctx, field)
-			case "relationships":
-				return ec.fieldContext_MeshModelSummary_relationships(ctx, field)
+			case "designID":
+				return ec.fieldContext_DesignDeploymentProgress_designID(ctx, field)
+			case "deploymentID":
+				return ec.fieldContext_DesignDeploymentProgress_deploymentID(ctx, field)
+			case "stage":
+				return ec.fieldContext_DesignDeploymentProgress_stage(ctx, field)
+			case "stageName":
+				return ec.fieldContext_DesignDeploymentProgress_stageName(ctx, field)
+			case "component":
+				return ec.fieldContext_DesignDeploymentProgress_component(ctx, field)
+			case "status":
+				return ec.fieldContext_DesignDeploymentProgress_status(ctx, field)
+			case "error":
+				return ec.fieldContext_DesignDeploymentProgress_error(ctx, field)
+			case "time":
+				return ec.fieldContext_DesignDeploymentProgress_time(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type MeshModelSummary", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type DesignDeploymentProgress", field.Name)
 		},
 	}
 	defer func() {
@@ -15101,7 +17091,7 @@ func (ec *executionContext) fieldContext_Subscription_subscribeMeshModelSummary(
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Subscription_subscribeMeshModelSummary_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Subscription_subscribeDesignDeployment_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
@@ -17251,6 +19241,118 @@ func (ec *executionContext) unmarshalInputCatalogSelector(ctx context.Context, o
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputDesignDeployInput(ctx context.Context, obj interface{}) (model.DesignDeployInput, error) {
+	var it model.DesignDeployInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"designID", "k8scontextIDs", "variables", "dryRun", "skipCRD", "rollback"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "designID":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("designID"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DesignID = data
+		case "k8scontextIDs":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("k8scontextIDs"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.K8scontextIDs = data
+		case "variables":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("variables"))
+			data, err := ec.unmarshalOMap2map(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Variables = data
+		case "dryRun":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dryRun"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DryRun = data
+		case "skipCRD":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("skipCRD"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SkipCrd = data
+		case "rollback":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rollback"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Rollback = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputDesignInput(ctx context.Context, obj interface{}) (model.DesignInput, error) {
+	var it model.DesignInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "designFile"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "designFile":
+			var err error
+
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("designFile"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DesignFile = data
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputMeshModelSummarySelector(ctx context.Context, obj interface{}) (model.MeshModelSummarySelector, error) {
 	var it model.MeshModelSummarySelector
 	asMap := map[string]interface{}{}
@@ -18126,8 +20228,144 @@ func (ec *executionContext) _ControlPlaneMember(ctx context.Context, sel ast.Sel
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "data_planes":
-			out.Values[i] = ec._ControlPlaneMember_data_planes(ctx, field, obj)
+		case "data_planes":
+			out.Values[i] = ec._ControlPlaneMember_data_planes(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var dataPlaneImplementors = []string{"DataPlane"}
+
+func (ec *executionContext) _DataPlane(ctx context.Context, sel ast.SelectionSet, obj *model.DataPlane) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dataPlaneImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DataPlane")
+		case "name":
+			out.Values[i] = ec._DataPlane_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "proxies":
+			out.Values[i] = ec._DataPlane_proxies(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var designImplementors = []string{"Design"}
+
+func (ec *executionContext) _Design(ctx context.Context, sel ast.SelectionSet, obj *model.Design) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, designImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Design")
+		case "id":
+			out.Values[i] = ec._Design_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._Design_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "designFile":
+			out.Values[i] = ec._Design_designFile(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._Design_updatedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var designDeploymentImplementors = []string{"DesignDeployment"}
+
+func (ec *executionContext) _DesignDeployment(ctx context.Context, sel ast.SelectionSet, obj *model.DesignDeployment) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, designDeploymentImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DesignDeployment")
+		case "designID":
+			out.Values[i] = ec._DesignDeployment_designID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "summary":
+			out.Values[i] = ec._DesignDeployment_summary(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -18151,24 +20389,51 @@ func (ec *executionContext) _ControlPlaneMember(ctx context.Context, sel ast.Sel
 	return out
 }
 
-var dataPlaneImplementors = []string{"DataPlane"}
+var designDeploymentProgressImplementors = []string{"DesignDeploymentProgress"}
 
-func (ec *executionContext) _DataPlane(ctx context.Context, sel ast.SelectionSet, obj *model.DataPlane) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, dataPlaneImplementors)
+func (ec *executionContext) _DesignDeploymentProgress(ctx context.Context, sel ast.SelectionSet, obj *model.DesignDeploymentProgress) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, designDeploymentProgressImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("DataPlane")
-		case "name":
-			out.Values[i] = ec._DataPlane_name(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("DesignDeploymentProgress")
+		case "designID":
+			out.Values[i] = ec._DesignDeploymentProgress_designID(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "proxies":
-			out.Values[i] = ec._DataPlane_proxies(ctx, field, obj)
+		case "deploymentID":
+			out.Values[i] = ec._DesignDeploymentProgress_deploymentID(ctx, field, obj)
+		case "stage":
+			out.Values[i] = ec._DesignDeploymentProgress_stage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "stageName":
+			out.Values[i] = ec._DesignDeploymentProgress_stageName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "component":
+			out.Values[i] = ec._DesignDeploymentProgress_component(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._DesignDeploymentProgress_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "error":
+			out.Values[i] = ec._DesignDeploymentProgress_error(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "time":
+			out.Values[i] = ec._DesignDeploymentProgress_time(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -18996,6 +21261,34 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "createDesign":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createDesign(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updateDesign":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updateDesign(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deployDesign":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deployDesign(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "undeployDesign":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_undeployDesign(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -20100,6 +22393,129 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
+var registryChangeImplementors = []string{"RegistryChange"}
+
+func (ec *executionContext) _RegistryChange(ctx context.Context, sel ast.SelectionSet, obj *model.RegistryChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, registryChangeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RegistryChange")
+		case "action":
+			out.Values[i] = ec._RegistryChange_action(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "entityType":
+			out.Values[i] = ec._RegistryChange_entityType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "kind":
+			out.Values[i] = ec._RegistryChange_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "subType":
+			out.Values[i] = ec._RegistryChange_subType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "model":
+			out.Values[i] = ec._RegistryChange_model(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "modelVersion":
+			out.Values[i] = ec._RegistryChange_modelVersion(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._RegistryChange_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var registryUpdateImplementors = []string{"RegistryUpdate"}
+
+func (ec *executionContext) _RegistryUpdate(ctx context.Context, sel ast.SelectionSet, obj *model.RegistryUpdate) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, registryUpdateImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RegistryUpdate")
+		case "models":
+			out.Values[i] = ec._RegistryUpdate_models(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "components":
+			out.Values[i] = ec._RegistryUpdate_components(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "relationships":
+			out.Values[i] = ec._RegistryUpdate_relationships(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "changes":
+			out.Values[i] = ec._RegistryUpdate_changes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var resourceImplementors = []string{"Resource"}
 
 func (ec *executionContext) _Resource(ctx context.Context, sel ast.SelectionSet, obj *model.Resource) graphql.Marshaler {
@@ -20175,6 +22591,10 @@ func (ec *executionContext) _Subscription(ctx context.Context, sel ast.Selection
 		return ec._Subscription_subscribeK8sContext(ctx, fields[0])
 	case "subscribeMeshModelSummary":
 		return ec._Subscription_subscribeMeshModelSummary(ctx, fields[0])
+	case "registryUpdated":
+		return ec._Subscription_registryUpdated(ctx, fields[0])
+	case "subscribeDesignDeployment":
+		return ec._Subscription_subscribeDesignDeployment(ctx, fields[0])
 	case "subscribeEvents":
 		return ec._Subscription_subscribeEvents(ctx, fields[0])
 	default:
@@ -20999,6 +23419,58 @@ func (ec *executionContext) marshalNDataPlane2ᚖgithubᚗcomᚋlayer5ioᚋmeshe
 	return ec._DataPlane(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNDesign2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDesign(ctx context.Context, sel ast.SelectionSet, v model.Design) graphql.Marshaler {
+	return ec._Design(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNDesign2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDesign(ctx context.Context, sel ast.SelectionSet, v *model.Design) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Design(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNDesignDeployInput2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDesignDeployInput(ctx context.Context, v interface{}) (model.DesignDeployInput, error) {
+	res, err := ec.unmarshalInputDesignDeployInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDesignDeployment2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDesignDeployment(ctx context.Context, sel ast.SelectionSet, v model.DesignDeployment) graphql.Marshaler {
+	return ec._DesignDeployment(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNDesignDeployment2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDesignDeployment(ctx context.Context, sel ast.SelectionSet, v *model.DesignDeployment) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DesignDeployment(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNDesignDeploymentProgress2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDesignDeploymentProgress(ctx context.Context, sel ast.SelectionSet, v model.DesignDeploymentProgress) graphql.Marshaler {
+	return ec._DesignDeploymentProgress(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNDesignDeploymentProgress2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDesignDeploymentProgress(ctx context.Context, sel ast.SelectionSet, v *model.DesignDeploymentProgress) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DesignDeploymentProgress(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNDesignInput2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐDesignInput(ctx context.Context, v interface{}) (model.DesignInput, error) {
+	res, err := ec.unmarshalInputDesignInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalNEvent2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐEvent(ctx context.Context, sel ast.SelectionSet, v model.Event) graphql.Marshaler {
 	return ec._Event(ctx, sel, &v)
 }
@@ -21435,6 +23907,74 @@ func (ec *executionContext) marshalNPerfPageResult2ᚖgithubᚗcomᚋlayer5ioᚋ
 	return ec._PerfPageResult(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNRegistryChange2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐRegistryChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RegistryChange) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRegistryChange2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐRegistryChange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNRegistryChange2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐRegistryChange(ctx context.Context, sel ast.SelectionSet, v *model.RegistryChange) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RegistryChange(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRegistryUpdate2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐRegistryUpdate(ctx context.Context, sel ast.SelectionSet, v model.RegistryUpdate) graphql.Marshaler {
+	return ec._RegistryUpdate(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRegistryUpdate2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐRegistryUpdate(ctx context.Context, sel ast.SelectionSet, v *model.RegistryUpdate) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RegistryUpdate(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNResource2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐResourceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Resource) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -22107,6 +24647,22 @@ func (ec *executionContext) marshalOFilterResult2ᚖgithubᚗcomᚋlayer5ioᚋme
 	return ec._FilterResult(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOID2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalID(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOID2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	res := graphql.MarshalID(*v)
+	return res
+}
+
 func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
 	if v == nil {
 		return nil, nil
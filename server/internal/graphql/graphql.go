@@ -7,6 +7,8 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/gorilla/websocket"
@@ -17,6 +19,8 @@ import (
 	"github.com/layer5io/meshkit/broker"
 	"github.com/layer5io/meshkit/logger"
 	"github.com/layer5io/meshkit/utils/broadcast"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
 )
 
 type Options struct {
@@ -25,6 +29,12 @@ type Options struct {
 	Config      *models.HandlerConfig
 	URL         string
 	Broadcaster broadcast.Broadcaster
+	// MaxDepth and MaxComplexity limit the nesting and the complexity of the operations, 0 disables the limit
+	MaxDepth      int
+	MaxComplexity int
+	// PersistedQueryCacheSize is the number of persisted queries kept, the clients send the sha256 hash of the
+	// queries cached in place of their text, 0 disables the persisted queries
+	PersistedQueryCacheSize int
 }
 
 // New returns a graphql handler instance
@@ -72,9 +82,50 @@ func New(opts Options) http.Handler {
 		},
 	})
 
+	srv.Use(Authorization{})
+	if opts.MaxDepth > 0 {
+		srv.Use(DepthLimit{MaxDepth: opts.MaxDepth})
+	}
+	if opts.MaxComplexity > 0 {
+		srv.Use(extension.FixedComplexityLimit(opts.MaxComplexity))
+	}
+	if opts.PersistedQueryCacheSize > 0 {
+		srv.Use(extension.AutomaticPersistedQuery{
+			Cache: queryCache{lru.New(opts.PersistedQueryCacheSize)},
+		})
+	}
+
 	return srv
 }
 
+// queryCache keeps the persisted queries but not the documents holding a mutation, so that a hash alone never replays a
+// mutation, nor the documents which do not parse
+type queryCache struct {
+	graphql.Cache
+}
+
+func (c queryCache) Add(ctx context.Context, key string, value interface{}) {
+	if query, ok := value.(string); !ok || hasMutation(query) {
+		return
+	}
+	c.Cache.Add(ctx, key, value)
+}
+
+// hasMutation reports whether the document holds a mutation among its operations, the documents which do not parse
+// are told to hold one
+func hasMutation(query string) bool {
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return true
+	}
+	for _, op := range doc.Operations {
+		if op.Operation == ast.Mutation {
+			return true
+		}
+	}
+	return false
+}
+
 // NewPlayground returns a graphql playground instance
 func NewPlayground(opts Options) http.Handler {
 	return playground.Handler("GraphQL playground", opts.URL)
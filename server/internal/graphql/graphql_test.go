@@ -0,0 +1,78 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestQueryCache(t *testing.T) {
+	ctx := context.Background()
+	cache := queryCache{lru.New(10)}
+	tests := []struct {
+		query  string
+		cached bool
+	}{
+		{`query { getAvailableNamespaces { namespace } }`, true},
+		{`{ getAvailableNamespaces { namespace } }`, true},
+		{`mutation { changeOperatorStatus(input: {}) }`, false},
+		{"# x\nmutation { changeOperatorStatus(input: {}) }", false},
+		{`query A { getAvailableNamespaces { namespace } } mutation B { changeOperatorStatus(input: {}) }`, false},
+		{`query {`, false},
+	}
+	for i, tt := range tests {
+		key := string(rune('a' + i))
+		cache.Add(ctx, key, tt.query)
+		if _, cached := cache.Get(ctx, key); cached != tt.cached {
+			t.Errorf("the query %q was cached = %t, want %t", tt.query, cached, tt.cached)
+		}
+	}
+}
+
+const depthTestSchema = `
+type Query { namespaces: [Namespace!]! }
+type Namespace { name: String!, pods: [Pod!]! }
+type Pod { name: String!, containers: [Container!]! }
+type Container { name: String! }
+`
+
+func TestDepthLimit(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: depthTestSchema})
+	tests := []struct {
+		name  string
+		query string
+		depth int
+	}{
+		{"Nested fields", `{ namespaces { name pods { name } } }`, 3},
+		{"Deepest branch", `{ namespaces { name pods { containers { name } } } }`, 4},
+		{"Fragments count at the depth they are spread at", `{ namespaces { ...pods } } fragment pods on Namespace { pods { containers { name } } }`, 4},
+		{"Inline fragments", `{ namespaces { ... on Namespace { pods { name } } } }`, 3},
+		{"Introspection fields are left out", `{ __schema { types { fields { type { ofType { name } } } } } namespaces { name } }`, 2},
+	}
+	limit := DepthLimit{MaxDepth: 3}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, errs := gqlparser.LoadQuery(schema, tt.query)
+			if errs != nil {
+				t.Fatal(errs)
+			}
+			if depth := selectionSetDepth(doc.Operations[0].SelectionSet, map[string]bool{}); depth != tt.depth {
+				t.Errorf("the depth of the operation is %d, want %d", depth, tt.depth)
+			}
+			err := limit.MutateOperationContext(context.Background(), &graphql.OperationContext{Operation: doc.Operations[0]})
+			if rejected := err != nil; rejected != (tt.depth > limit.MaxDepth) {
+				t.Errorf("the operation of depth %d was rejected = %t with the limit of %d", tt.depth, rejected, limit.MaxDepth)
+			}
+			if err != nil && err.Extensions["code"] != errDepthLimitExceeded {
+				t.Errorf("the operation was rejected with %v", err.Extensions)
+			}
+		})
+	}
+	if err := (DepthLimit{}).Validate(nil); err == nil {
+		t.Error("a depth limit of 0 is accepted")
+	}
+}
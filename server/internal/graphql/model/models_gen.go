@@ -132,6 +132,43 @@ type DataPlane struct {
 	Proxies []*Container `json:"proxies"`
 }
 
+type Design struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	DesignFile string     `json:"designFile"`
+	UpdatedAt  *time.Time `json:"updatedAt,omitempty"`
+}
+
+type DesignDeployInput struct {
+	DesignID      string                 `json:"designID"`
+	K8scontextIDs []string               `json:"k8scontextIDs,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+	DryRun        *bool                  `json:"dryRun,omitempty"`
+	SkipCrd       *bool                  `json:"skipCRD,omitempty"`
+	Rollback      *bool                  `json:"rollback,omitempty"`
+}
+
+type DesignDeployment struct {
+	DesignID string                 `json:"designID"`
+	Summary  map[string]interface{} `json:"summary,omitempty"`
+}
+
+type DesignDeploymentProgress struct {
+	DesignID     string    `json:"designID"`
+	DeploymentID *string   `json:"deploymentID,omitempty"`
+	Stage        int       `json:"stage"`
+	StageName    string    `json:"stageName"`
+	Component    string    `json:"component"`
+	Status       string    `json:"status"`
+	Error        string    `json:"error"`
+	Time         time.Time `json:"time"`
+}
+
+type DesignInput struct {
+	Name       *string `json:"name,omitempty"`
+	DesignFile string  `json:"designFile"`
+}
+
 type Error struct {
 	Code        string `json:"code"`
 	Description string `json:"description"`
@@ -373,6 +410,23 @@ type ReSyncActions struct {
 	HardReset string `json:"hardReset"`
 }
 
+type RegistryChange struct {
+	Action       string `json:"action"`
+	EntityType   string `json:"entityType"`
+	Kind         string `json:"kind"`
+	SubType      string `json:"subType"`
+	Model        string `json:"model"`
+	ModelVersion string `json:"modelVersion"`
+	Timestamp    string `json:"timestamp"`
+}
+
+type RegistryUpdate struct {
+	Models        int               `json:"models"`
+	Components    int               `json:"components"`
+	Relationships int               `json:"relationships"`
+	Changes       []*RegistryChange `json:"changes"`
+}
+
 type Resource struct {
 	Kind  string `json:"kind"`
 	Count int    `json:"count"`
@@ -21,6 +21,9 @@ func getAdapterInformationByName(adapterName string) *models.Adapter {
 }
 
 func (r *Resolver) changeAdapterStatus(ctx context.Context, _ models.Provider, targetStatus model.Status, adapterName, targetPort string) (model.Status, error) {
+	if err := models.Authorize(ctx, models.DeployPermission); err != nil {
+		return model.StatusUnknown, err
+	}
 	// not able to perform any operation when the name is not there
 	if adapterName == "" && targetPort == "" {
 		return model.StatusUnknown, ErrAdapterInsufficientInformation(fmt.Errorf("adapter name or targetport or both are missing"))
@@ -0,0 +1,209 @@
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/handlers"
+	"github.com/layer5io/meshery/server/internal/graphql/model"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/pattern/stages"
+	"github.com/layer5io/meshkit/models/events"
+)
+
+func getHandler(ctx context.Context) (*handlers.Handler, error) {
+	h, ok := ctx.Value(models.HandlerKey).(*handlers.Handler)
+	if !ok {
+		return nil, ErrGettingHandler(errors.New("unable to get handler from context"))
+	}
+	return h, nil
+}
+
+func parseDesignID(id string) (uuid.UUID, error) {
+	designID, err := uuid.FromString(id)
+	if err != nil {
+		return uuid.Nil, ErrInvalidDesignID(err)
+	}
+	return designID, nil
+}
+
+func (r *Resolver) saveDesign(ctx context.Context, provider models.Provider, id *uuid.UUID, input model.DesignInput) (*model.Design, error) {
+	if err := models.Authorize(ctx, models.EditPermission); err != nil {
+		return nil, err
+	}
+	h, err := getHandler(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user := ctx.Value(models.UserCtxKey).(*models.User)
+	design := &models.MesheryPattern{
+		ID:          id,
+		PatternFile: input.DesignFile,
+	}
+	if input.Name != nil {
+		design.Name = *input.Name
+	}
+	if id != nil {
+		// the location and the catalog data of the design are kept
+		saved, err := h.GetDesign(ctx, provider, *id)
+		if err != nil {
+			return nil, err
+		}
+		design.Location = saved.Location
+		design.CatalogData = saved.CatalogData
+		design.Visibility = saved.Visibility
+	}
+
+	design, err = h.SaveDesign(ctx, provider, user, design)
+	if err != nil {
+		r.Log.Error(err)
+		return nil, err
+	}
+	return &model.Design{
+		ID:         design.ID.String(),
+		Name:       design.Name,
+		DesignFile: design.PatternFile,
+		UpdatedAt:  design.UpdatedAt,
+	}, nil
+}
+
+func (r *Resolver) createDesign(ctx context.Context, provider models.Provider, input model.DesignInput) (*model.Design, error) {
+	return r.saveDesign(ctx, provider, nil, input)
+}
+
+func (r *Resolver) updateDesign(ctx context.Context, provider models.Provider, id string, input model.DesignInput) (*model.Design, error) {
+	designID, err := parseDesignID(id)
+	if err != nil {
+		return nil, err
+	}
+	return r.saveDesign(ctx, provider, &designID, input)
+}
+
+func (r *Resolver) deployDesign(ctx context.Context, provider models.Provider, input model.DesignDeployInput, isDelete bool) (*model.DesignDeployment, error) {
+	if err := models.Authorize(ctx, models.DeployPermission); err != nil {
+		return nil, err
+	}
+	designID, err := parseDesignID(input.DesignID)
+	if err != nil {
+		return nil, err
+	}
+	h, err := getHandler(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user := ctx.Value(models.UserCtxKey).(*models.User)
+	prefObj, _ := ctx.Value(models.PerfObjCtxKey).(*models.Preference)
+	if prefObj == nil {
+		prefObj = &models.Preference{}
+	}
+
+	opts := handlers.DesignDeployOptions{
+		K8sContextIDs: input.K8scontextIDs,
+		Variables:     input.Variables,
+	}
+	if input.DryRun != nil {
+		opts.DryRun = *input.DryRun
+	}
+	if input.SkipCrd != nil {
+		opts.SkipCRD = *input.SkipCrd
+	}
+	if input.Rollback != nil {
+		opts.Rollback = *input.Rollback
+	}
+	summary, err := h.DeployDesign(ctx, provider, user, prefObj, designID, isDelete, opts)
+	if err != nil {
+		r.Log.Error(err)
+		return nil, err
+	}
+	return &model.DesignDeployment{
+		DesignID: input.DesignID,
+		Summary:  summary,
+	}, nil
+}
+
+// subscribeDesignDeployment sends the progress of the deployments of the design of the user, clients subscribe before
+// deploying the design so that they do not miss its first stages
+func (r *Resolver) subscribeDesignDeployment(ctx context.Context, designID string) (<-chan *model.DesignDeploymentProgress, error) {
+	id, err := parseDesignID(designID)
+	if err != nil {
+		return nil, err
+	}
+	user := ctx.Value(models.UserCtxKey).(*models.User)
+	userID, _ := uuid.FromString(user.ID)
+	ch, unsubscribe := r.Config.EventBroadcaster.Subscribe(userID)
+
+	progressChan := make(chan *model.DesignDeploymentProgress)
+	go func() {
+		r.Log.Info(fmt.Sprintf("Design deployment subscription started for %s", designID))
+		defer func() {
+			unsubscribe()
+			close(progressChan)
+			r.Log.Info(fmt.Sprintf("Design deployment subscription stopped for %s", designID))
+		}()
+		forwardDesignDeploymentProgress(ctx, id, ch, progressChan)
+	}()
+	return progressChan, nil
+}
+
+// forwardDesignDeploymentProgress sends to progressChan the progress of the deployments of the design published to ch,
+// until ch is closed or ctx is done
+func forwardDesignDeploymentProgress(ctx context.Context, id uuid.UUID, ch <-chan interface{}, progressChan chan<- *model.DesignDeploymentProgress) {
+	for {
+		select {
+		case data, ok := <-ch:
+			if !ok {
+				return
+			}
+			update := designDeploymentProgress(data, id)
+			if update == nil {
+				continue
+			}
+			select {
+			case progressChan <- update:
+			case <-ctx.Done():
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// designDeploymentProgress returns the progress of the deployment of the design the data published to the user is,
+// nil if it is not a progress event of the design
+func designDeploymentProgress(data interface{}, id uuid.UUID) *model.DesignDeploymentProgress {
+	event, ok := data.(*events.Event)
+	if !ok || event.Action != "progress" || event.ActedUpon != id {
+		return nil
+	}
+	var progress stages.Progress
+	switch p := event.Metadata["progress"].(type) {
+	case stages.Progress:
+		progress = p
+	case map[string]interface{}:
+		// the progress is published as its JSON when its error held secrets, see redact.Event
+		b, err := json.Marshal(p)
+		if err != nil || json.Unmarshal(b, &progress) != nil {
+			return nil
+		}
+	default:
+		return nil
+	}
+	update := &model.DesignDeploymentProgress{
+		DesignID:  id.String(),
+		Stage:     progress.Stage,
+		StageName: progress.StageName,
+		Component: progress.Component,
+		Status:    string(progress.Status),
+		Error:     progress.Error,
+		Time:      progress.Time,
+	}
+	if deploymentID, ok := event.Metadata["deploymentID"].(uuid.UUID); ok {
+		deployment := deploymentID.String()
+		update.DeploymentID = &deployment
+	}
+	return update
+}
@@ -0,0 +1,218 @@
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/internal/graphql/model"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/pattern/stages"
+	"github.com/layer5io/meshkit/errors"
+	"github.com/layer5io/meshkit/logger"
+	"github.com/layer5io/meshkit/models/events"
+)
+
+// roleContext returns the context of the requests of a user with the role
+func roleContext(role models.Role) context.Context {
+	ctx := context.WithValue(context.Background(), models.UserCtxKey, &models.User{ID: uuid.Must(uuid.NewV4()).String()})
+	return context.WithValue(ctx, models.AuthorizerCtxKey, models.Authorizer(func(permission models.Permission) error {
+		if !role.Can(permission) {
+			return models.ErrInvalidAPITokenScope(permission)
+		}
+		return nil
+	}))
+}
+
+func testResolver(t *testing.T) *Resolver {
+	t.Helper()
+	log, err := logger.New("meshery-test", logger.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Resolver{Log: log, Config: &models.HandlerConfig{EventBroadcaster: models.NewBroadcaster()}}
+}
+
+func TestDesignMutations(t *testing.T) {
+	r := testResolver(t)
+	designID := uuid.Must(uuid.NewV4()).String()
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		mutate func(ctx context.Context) error
+		code   string
+	}{
+		{
+			name: "A viewer cannot create designs",
+			ctx:  roleContext(models.ViewerRole),
+			mutate: func(ctx context.Context) error {
+				_, err := r.createDesign(ctx, nil, model.DesignInput{})
+				return err
+			},
+			code: models.ErrInvalidAPITokenScopeCode,
+		},
+		{
+			name: "Requests without a session cannot update designs",
+			ctx:  context.Background(),
+			mutate: func(ctx context.Context) error {
+				_, err := r.updateDesign(ctx, nil, designID, model.DesignInput{})
+				return err
+			},
+			code: models.ErrUnauthorizedContextCode,
+		},
+		{
+			name: "A viewer cannot deploy designs",
+			ctx:  roleContext(models.ViewerRole),
+			mutate: func(ctx context.Context) error {
+				_, err := r.deployDesign(ctx, nil, model.DesignDeployInput{DesignID: designID}, false)
+				return err
+			},
+			code: models.ErrInvalidAPITokenScopeCode,
+		},
+		{
+			name: "A viewer cannot undeploy designs",
+			ctx:  roleContext(models.ViewerRole),
+			mutate: func(ctx context.Context) error {
+				_, err := r.deployDesign(ctx, nil, model.DesignDeployInput{DesignID: designID}, true)
+				return err
+			},
+			code: models.ErrInvalidAPITokenScopeCode,
+		},
+		{
+			name: "The ID of the updated design is validated",
+			ctx:  roleContext(models.OperatorRole),
+			mutate: func(ctx context.Context) error {
+				_, err := r.updateDesign(ctx, nil, "not-a-uuid", model.DesignInput{})
+				return err
+			},
+			code: ErrInvalidDesignIDCode,
+		},
+		{
+			name: "The ID of the deployed design is validated",
+			ctx:  roleContext(models.OperatorRole),
+			mutate: func(ctx context.Context) error {
+				_, err := r.deployDesign(ctx, nil, model.DesignDeployInput{DesignID: "not-a-uuid"}, false)
+				return err
+			},
+			code: ErrInvalidDesignIDCode,
+		},
+		{
+			name: "Mutations are only served through the endpoint of Meshery Server",
+			ctx:  roleContext(models.OperatorRole),
+			mutate: func(ctx context.Context) error {
+				_, err := r.createDesign(ctx, nil, model.DesignInput{})
+				return err
+			},
+			code: ErrGettingHandlerCode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.mutate(tt.ctx)
+			if code := errors.GetCode(err); code != tt.code {
+				t.Errorf("the mutation returned %v, want an error with the code %s", err, tt.code)
+			}
+		})
+	}
+}
+
+func progressEvent(designID uuid.UUID, action string, progress interface{}) *events.Event {
+	return events.NewEvent().ActedUpon(designID).WithCategory("pattern").WithAction(action).
+		WithMetadata(map[string]interface{}{"progress": progress}).Build()
+}
+
+func TestDesignDeploymentProgress(t *testing.T) {
+	designID := uuid.Must(uuid.NewV4())
+	progress := stages.Progress{Stage: 2, StageName: "provision", Component: "web", Status: stages.ProgressFailed, Error: "token=[redacted]"}
+	var redacted map[string]interface{}
+	b, _ := json.Marshal(progress)
+	if err := json.Unmarshal(b, &redacted); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		data interface{}
+		want bool
+	}{
+		{"progress of the design", progressEvent(designID, "progress", progress), true},
+		{"progress redacted as its JSON", progressEvent(designID, "progress", redacted), true},
+		{"progress of another design", progressEvent(uuid.Must(uuid.NewV4()), "progress", progress), false},
+		{"other action on the design", progressEvent(designID, "deploy", progress), false},
+		{"event without progress", progressEvent(designID, "progress", nil), false},
+		{"data which is not an event", "progress", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			update := designDeploymentProgress(tt.data, designID)
+			if (update != nil) != tt.want {
+				t.Fatalf("designDeploymentProgress() = %+v, want an update = %t", update, tt.want)
+			}
+			if update != nil && (update.DesignID != designID.String() || update.StageName != "provision" || update.Component != "web" || update.Status != "failed") {
+				t.Errorf("designDeploymentProgress() = %+v, want the failed provisioning of web", update)
+			}
+		})
+	}
+}
+
+func TestForwardDesignDeploymentProgress(t *testing.T) {
+	designID := uuid.Must(uuid.NewV4())
+	ch := make(chan interface{})
+	progressChan := make(chan *model.DesignDeploymentProgress)
+	done := make(chan struct{})
+	go func() {
+		forwardDesignDeploymentProgress(context.Background(), designID, ch, progressChan)
+		close(done)
+	}()
+
+	ch <- progressEvent(uuid.Must(uuid.NewV4()), "progress", stages.Progress{StageName: "other"})
+	ch <- progressEvent(designID, "progress", stages.Progress{StageName: "validate"})
+	if update := <-progressChan; update.StageName != "validate" {
+		t.Errorf("the update sent is the one of stage %s, want validate", update.StageName)
+	}
+	// the subscription ends when the broadcaster closes the channel of the subscriber
+	close(ch)
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the progress is still forwarded after the channel of the subscriber was closed")
+	}
+}
+
+func TestSubscribeDesignDeployment(t *testing.T) {
+	r := testResolver(t)
+	designID := uuid.Must(uuid.NewV4())
+	ctx, cancel := context.WithCancel(roleContext(models.ViewerRole))
+	defer cancel()
+
+	if _, err := r.subscribeDesignDeployment(ctx, "not-a-uuid"); errors.GetCode(err) != ErrInvalidDesignIDCode {
+		t.Errorf("the subscription to an invalid design returned %v", err)
+	}
+	progressChan, err := r.subscribeDesignDeployment(ctx, designID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userID := uuid.FromStringOrNil(ctx.Value(models.UserCtxKey).(*models.User).ID)
+	r.Config.EventBroadcaster.Publish(userID, progressEvent(designID, "progress", stages.Progress{StageName: "provision", Status: stages.ProgressStarted}))
+	select {
+	case update := <-progressChan:
+		if update.StageName != "provision" || update.Status != "started" {
+			t.Errorf("the update sent is %+v, want the start of provision", update)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the progress of the deployment was not sent")
+	}
+
+	cancel()
+	select {
+	case _, ok := <-progressChan:
+		if ok {
+			t.Error("an update was sent after the subscription ended")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the channel of the subscription was not closed when its context was done")
+	}
+}
@@ -37,6 +37,9 @@ const (
 	ErrAdapterInsufficientInformationCode   = "1377"
 	ErrPerformanceProfilesSubscriptionCode  = "1378"
 	ErrPerformanceResultSubscriptionCode    = "1379"
+	ErrRegistryUpdatedSubscriptionCode      = "1625"
+	ErrInvalidDesignIDCode                  = "1626"
+	ErrGettingHandlerCode                   = "1627"
 )
 
 var (
@@ -150,6 +153,17 @@ func ErrMeshModelSummarySubscription(err error) error {
 		[]string{"Confirm that Meshery Server is reachable from your browser."})
 }
 
+func ErrRegistryUpdatedSubscription(err error) error {
+	return errors.New(
+		ErrRegistryUpdatedSubscriptionCode,
+		errors.Alert,
+		[]string{"Unable to count the entities of the registry for the registryUpdated subscription"},
+		[]string{err.Error()},
+		[]string{"Table in the database might not exists"},
+		[]string{"Restart Meshery Server to import the models again"},
+	)
+}
+
 func ErrGettingMeshModelSummary(err error) error {
 	return errors.New(
 		ErrGettingMeshModelSummaryCode,
@@ -183,3 +197,11 @@ func ErrGettingTelemetryComponents(err error) error {
 func ErrAdapterInsufficientInformation(err error) error {
 	return errors.New(ErrAdapterInsufficientInformationCode, errors.Critical, []string{"Unable to process adapter request, incomplete request"}, []string{err.Error()}, []string{}, []string{})
 }
+
+func ErrInvalidDesignID(err error) error {
+	return errors.New(ErrInvalidDesignIDCode, errors.Alert, []string{"Invalid design ID"}, []string{err.Error()}, []string{"The ID of the design is not a valid UUID"}, []string{"Pass the ID of a saved design"})
+}
+
+func ErrGettingHandler(err error) error {
+	return errors.New(ErrGettingHandlerCode, errors.Alert, []string{"Unable to retrieve the handler of Meshery Server"}, []string{err.Error()}, []string{"The GraphQL request was not served through the GraphQL endpoint of Meshery Server"}, []string{"Send the request to /api/system/graphql/query"})
+}
@@ -3,9 +3,12 @@ package resolver
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/layer5io/meshery/server/internal/graphql/model"
 	"github.com/layer5io/meshery/server/models"
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshkit/database"
 	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
 	meshmodel "github.com/layer5io/meshkit/models/meshmodel/registry"
 	"github.com/sirupsen/logrus"
@@ -40,20 +43,129 @@ func (r *Resolver) subscribeMeshModelSummary(ctx context.Context, provider model
 	return respChan, nil
 }
 
-func (r *Resolver) getMeshModelSummary(ctx context.Context, _ models.Provider, selector model.MeshModelSummarySelector) (*model.MeshModelSummary, error) {
+// Models are registered with hundreds of components at once, the changes of the registry are sent at most this often
+const registryUpdateInterval = time.Second
+
+// subscribeRegistryUpdated sends the number of entities of the registry right away, then again along with the changes
+// whenever the registry changes. Changes the subscription was too slow to receive are missing from the deltas, the
+// counts are always up to date. The relationships of the organizations other than the one of the user are neither
+// counted nor reported.
+func (r *Resolver) subscribeRegistryUpdated(ctx context.Context, provider models.Provider) (<-chan *model.RegistryUpdate, error) {
+	orgID, _ := ctx.Value(models.OrgIDCtxKey).(string)
+	events, unsubscribe := r.Config.MeshModelEventsChannel.Subscribe()
+	respChan := make(chan *model.RegistryUpdate)
+
+	go func() {
+		r.Log.Info("Initializing registryUpdated subscription")
+		defer unsubscribe()
+		defer close(respChan)
+		ticker := time.NewTicker(registryUpdateInterval)
+		defer ticker.Stop()
+
+		send := func(changes []*model.RegistryChange) bool {
+			update, err := getRegistryUpdate(provider.GetGenericPersister(), orgID)
+			if err != nil {
+				r.Log.Error(ErrRegistryUpdatedSubscription(err))
+				return true
+			}
+			update.Changes = changes
+			select {
+			case respChan <- update:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		if !send([]*model.RegistryChange{}) {
+			return
+		}
+		changes := []*model.RegistryChange{}
+		for {
+			select {
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				if !event.VisibleTo(orgID) {
+					continue
+				}
+				changes = append(changes, &model.RegistryChange{
+					Action:       event.Action,
+					EntityType:   event.EntityType,
+					Kind:         event.Kind,
+					SubType:      event.SubType,
+					Model:        event.Model,
+					ModelVersion: event.ModelVersion,
+					Timestamp:    event.Timestamp.Format(time.RFC3339),
+				})
+			case <-ticker.C:
+				if len(changes) == 0 {
+					continue
+				}
+				if !send(changes) {
+					return
+				}
+				changes = []*model.RegistryChange{}
+			case <-ctx.Done():
+				r.Log.Info("Closing registryUpdated subscription")
+				return
+			}
+		}
+	}()
+
+	return respChan, nil
+}
+
+// getRegistryUpdate counts the entities of the registry, the relationships counted are those visible to the organization
+func getRegistryUpdate(db *database.Handler, orgID string) (*model.RegistryUpdate, error) {
+	if db == nil {
+		return nil, errors.New("the database of the registry is not available")
+	}
+	update := &model.RegistryUpdate{}
+	for _, entity := range []struct {
+		table  interface{}
+		count  *int
+		scoped bool
+	}{
+		{&v1alpha1.ModelDB{}, &update.Models, false},
+		{&v1alpha1.ComponentDefinitionDB{}, &update.Components, false},
+		{&v1alpha1.RelationshipDefinitionDB{}, &update.Relationships, true},
+	} {
+		var count int64
+		finder := db.Model(entity.table)
+		if entity.scoped {
+			finder = finder.Scopes(mesherymeshmodel.VisibleToOrg(orgID))
+		}
+		if err := finder.Count(&count).Error; err != nil {
+			return nil, err
+		}
+		*entity.count = int(count)
+	}
+	return update, nil
+}
+
+// getMeshModelSummary summarizes the components or the relationships of the registry, the relationships summarized are
+// those visible to the organization of the user
+func (r *Resolver) getMeshModelSummary(ctx context.Context, provider models.Provider, selector model.MeshModelSummarySelector) (*model.MeshModelSummary, error) {
 	regManager, ok := ctx.Value(models.RegistryManagerKey).(*meshmodel.RegistryManager)
 	summary := &model.MeshModelSummary{}
 	if !ok {
 		err := errors.New("unable to get registry manager from context")
 		return nil, ErrGettingRegistryManager(err)
 	}
+	orgID, _ := ctx.Value(models.OrgIDCtxKey).(string)
 	switch selector.Type {
 	case "components":
 		components := getMeshModelComponents(regManager)
 		summary.Components = components
 		summary.Relationships = []*model.MeshModelRelationship{}
 	case "relationships":
-		relationships := getMeshModelRelationships(regManager)
+		db := provider.GetGenericPersister()
+		if db == nil {
+			return nil, ErrGettingRegistryManager(errors.New("the database of the registry is not available"))
+		}
+		relationships := getMeshModelRelationships(mesherymeshmodel.OrgScopedRegistry{EntitiesGetter: regManager, DB: db, Log: r.Log}, orgID)
 		summary.Relationships = relationships
 		summary.Components = []*model.MeshModelComponent{}
 	}
@@ -70,8 +182,8 @@ type MeshModelRelationshipResponse struct {
 	Subtype []string `json:"subType"`
 }
 
-func getMeshModelRelationships(regManager *meshmodel.RegistryManager) []*model.MeshModelRelationship {
-	res, _, _ := regManager.GetEntities(&v1alpha1.RelationshipFilter{})
+func getMeshModelRelationships(registry mesherymeshmodel.EntitiesGetter, orgID string) []*model.MeshModelRelationship {
+	res, _, _ := registry.GetEntities(&mesherymeshmodel.OrgRelationshipFilter{Org: orgID})
 	relationships := make([]*model.MeshModelRelationship, 0)
 	var relmap = make(map[string]*MeshModelRelationshipResponse)
 	for _, r := range res {
@@ -0,0 +1,99 @@
+package resolver
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/layer5io/meshery/server/internal/graphql/model"
+	"github.com/layer5io/meshery/server/models"
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	meshmodel "github.com/layer5io/meshkit/models/meshmodel/registry"
+)
+
+// persisterProvider is a provider whose generic persister is the database
+type persisterProvider struct {
+	models.Provider
+	db *database.Handler
+}
+
+func (p persisterProvider) GetGenericPersister() *database.Handler {
+	return p.db
+}
+
+// registryProvider returns a provider over a SQLite registry holding a global relationship and a relationship of org-b
+func registryProvider(t *testing.T) persisterProvider {
+	t.Helper()
+	db, err := database.New(database.Options{Filename: filepath.Join(t.TempDir(), "mesherydb.sql"), Engine: database.SQLITE})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rm, err := meshmodel.NewRegistryManager(&db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, orgID := range []string{"", "org-b"} {
+		rel := v1alpha1.RelationshipDefinition{
+			TypeMeta: v1alpha1.TypeMeta{Kind: "Edge"},
+			Model:    v1alpha1.Model{Name: "kubernetes", Version: "v1.25.2", Category: v1alpha1.Category{Name: "Orchestration & Management"}},
+			SubType:  "Network",
+			Metadata: map[string]interface{}{},
+		}
+		mesherymeshmodel.SetRelationshipOrg(&rel, orgID)
+		if err := rm.RegisterEntity(meshmodel.Host{Hostname: "kubernetes"}, rel); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return persisterProvider{db: &db}
+}
+
+func receiveRegistryUpdate(t *testing.T, updates <-chan *model.RegistryUpdate) *model.RegistryUpdate {
+	t.Helper()
+	select {
+	case update := <-updates:
+		return update
+	case <-time.After(5 * time.Second):
+		t.Fatal("the update of the registry was not sent")
+		return nil
+	}
+}
+
+func TestSubscribeRegistryUpdated(t *testing.T) {
+	r := testResolver(t)
+	r.Config.MeshModelEventsChannel = mesherymeshmodel.NewRegistryEventsChannel()
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), models.OrgIDCtxKey, "org-a"))
+	defer cancel()
+
+	updates, err := r.subscribeRegistryUpdated(ctx, registryProvider(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	update := receiveRegistryUpdate(t, updates)
+	if update.Models != 1 || update.Relationships != 1 || len(update.Changes) != 0 {
+		t.Errorf("the first update is %+v, want the model and the global relationship counted without changes", update)
+	}
+
+	r.Config.MeshModelEventsChannel.Publish(mesherymeshmodel.RegistryEvent{
+		Action: mesherymeshmodel.RegistryEventRegistered, EntityType: mesherymeshmodel.RegistryEntityRelationship, Kind: "Edge", SubType: "Mount", Model: "kubernetes", Org: "org-b",
+	})
+	r.Config.MeshModelEventsChannel.Publish(mesherymeshmodel.RegistryEvent{
+		Action: mesherymeshmodel.RegistryEventRegistered, EntityType: mesherymeshmodel.RegistryEntityRelationship, Kind: "Edge", SubType: "Network", Model: "kubernetes", Org: "org-a",
+	})
+	update = receiveRegistryUpdate(t, updates)
+	if len(update.Changes) != 1 || update.Changes[0].SubType != "Network" {
+		t.Errorf("the changes sent to org-a are %+v, want the relationship registered in org-a only", update.Changes)
+	}
+
+	cancel()
+	select {
+	case _, ok := <-updates:
+		if ok {
+			t.Error("an update was sent after the subscription ended")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the channel of the subscription was not closed when its context was done")
+	}
+}
@@ -31,42 +31,45 @@ func (r *Resolver) resyncCluster(ctx context.Context, provider models.Provider,
 		// copies the contents .meshery/config/mesherydb.sql to .meshery/config/.archive/mesherydb.sql
 		// then drops all the DB table and then migrate/create tables, missing foreign keys, constraints, columns and indexes.
 		if actions.HardReset == "true" {
-			mesherydbPath := path.Join(utils.GetHome(), ".meshery/config")
-			err := os.Mkdir(path.Join(mesherydbPath, ".archive"), os.ModePerm)
-			if err != nil && os.IsNotExist(err) {
-				return "", err
+			dbHandler := provider.GetGenericPersister()
+			if dbHandler == nil {
+				return "", model.ErrEmptyHandler
 			}
 
-			src := path.Join(mesherydbPath, "mesherydb.sql")
-			dst := path.Join(mesherydbPath, ".archive/mesherydb.sql")
+			// the SQLite database is archived before it is dropped, a PostgreSQL database is backed up by its operators
+			if !models.IsPostgres(dbHandler.DB) {
+				mesherydbPath := path.Join(utils.GetHome(), ".meshery/config")
+				err := os.Mkdir(path.Join(mesherydbPath, ".archive"), os.ModePerm)
+				if err != nil && os.IsNotExist(err) {
+					return "", err
+				}
 
-			fin, err := os.Open(src)
-			if err != nil {
-				return "", err
-			}
-			defer fin.Close()
+				src := path.Join(mesherydbPath, "mesherydb.sql")
+				dst := path.Join(mesherydbPath, ".archive/mesherydb.sql")
 
-			fout, err := os.Create(dst)
-			if err != nil {
-				return "", err
-			}
-			defer fout.Close()
+				fin, err := os.Open(src)
+				if err != nil {
+					return "", err
+				}
+				defer fin.Close()
 
-			_, err = io.Copy(fout, fin)
-			if err != nil {
-				return "", err
-			}
+				fout, err := os.Create(dst)
+				if err != nil {
+					return "", err
+				}
+				defer fout.Close()
 
-			dbHandler := provider.GetGenericPersister()
-			if dbHandler == nil {
-				return "", model.ErrEmptyHandler
+				_, err = io.Copy(fout, fin)
+				if err != nil {
+					return "", err
+				}
 			}
 
 			dbHandler.Lock()
 			defer dbHandler.Unlock()
 
 			r.Log.Info("Dropping Meshery Database")
-			err = dbHandler.Migrator().DropTable(
+			err := dbHandler.Migrator().DropTable(
 				&meshsyncmodel.KeyValue{},
 				&meshsyncmodel.Object{},
 				&meshsyncmodel.ResourceSpec{},
@@ -19,6 +19,9 @@ type operatorStatusK8sContext struct {
 }
 
 func (r *Resolver) changeOperatorStatus(ctx context.Context, provider models.Provider, status model.Status, ctxID string) (model.Status, error) {
+	if err := models.Authorize(ctx, models.DeployPermission); err != nil {
+		return model.StatusUnknown, err
+	}
 	deleteOperator := true
 
 	// Tell operator status subscription that operation is starting
@@ -29,6 +29,30 @@ func (r *mutationResolver) ChangeAdapterStatus(ctx context.Context, input *model
 	return r.changeAdapterStatus(ctx, provider, input.TargetStatus, input.Adapter, input.TargetPort)
 }
 
+// CreateDesign is the resolver for the createDesign field.
+func (r *mutationResolver) CreateDesign(ctx context.Context, input model.DesignInput) (*model.Design, error) {
+	provider := ctx.Value(models.ProviderCtxKey).(models.Provider)
+	return r.createDesign(ctx, provider, input)
+}
+
+// UpdateDesign is the resolver for the updateDesign field.
+func (r *mutationResolver) UpdateDesign(ctx context.Context, id string, input model.DesignInput) (*model.Design, error) {
+	provider := ctx.Value(models.ProviderCtxKey).(models.Provider)
+	return r.updateDesign(ctx, provider, id, input)
+}
+
+// DeployDesign is the resolver for the deployDesign field.
+func (r *mutationResolver) DeployDesign(ctx context.Context, input model.DesignDeployInput) (*model.DesignDeployment, error) {
+	provider := ctx.Value(models.ProviderCtxKey).(models.Provider)
+	return r.deployDesign(ctx, provider, input, false)
+}
+
+// UndeployDesign is the resolver for the undeployDesign field.
+func (r *mutationResolver) UndeployDesign(ctx context.Context, input model.DesignDeployInput) (*model.DesignDeployment, error) {
+	provider := ctx.Value(models.ProviderCtxKey).(models.Provider)
+	return r.deployDesign(ctx, provider, input, true)
+}
+
 // GetAvailableAddons is the resolver for the getAvailableAddons field.
 func (r *queryResolver) GetAvailableAddons(ctx context.Context, filter *model.ServiceMeshFilter) ([]*model.AddonList, error) {
 	provider := ctx.Value(models.ProviderCtxKey).(models.Provider)
@@ -311,6 +335,17 @@ func (r *subscriptionResolver) SubscribeMeshModelSummary(ctx context.Context, se
 	return r.subscribeMeshModelSummary(ctx, provider, selector)
 }
 
+// RegistryUpdated is the resolver for the registryUpdated field.
+func (r *subscriptionResolver) RegistryUpdated(ctx context.Context) (<-chan *model.RegistryUpdate, error) {
+	provider := ctx.Value(models.ProviderCtxKey).(models.Provider)
+	return r.subscribeRegistryUpdated(ctx, provider)
+}
+
+// SubscribeDesignDeployment is the resolver for the subscribeDesignDeployment field.
+func (r *subscriptionResolver) SubscribeDesignDeployment(ctx context.Context, designID string) (<-chan *model.DesignDeploymentProgress, error) {
+	return r.subscribeDesignDeployment(ctx, designID)
+}
+
 // SubscribeEvents is the resolver for the subscribeEvents field.
 func (r *subscriptionResolver) SubscribeEvents(ctx context.Context) (<-chan *model.Event, error) {
 	provider := ctx.Value(models.ProviderCtxKey).(models.Provider)
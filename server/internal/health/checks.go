@@ -0,0 +1,129 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/models/controllers"
+)
+
+// Database checks that the database of Meshery Server accepts connections
+func Database(db *database.Handler) Checker {
+	return func(ctx context.Context) []Check {
+		return []Check{Timed(ctx, "database", SubsystemDatabase, func(ctx context.Context) (Status, string) {
+			if db == nil || db.DB == nil {
+				return Fail, "the database is not initialized"
+			}
+			sqlDB, err := db.DB.DB()
+			if err != nil {
+				return Fail, err.Error()
+			}
+			if err := sqlDB.PingContext(ctx); err != nil {
+				return Fail, err.Error()
+			}
+			return Pass, ""
+		})}
+	}
+}
+
+// Providers checks that the remote providers are reachable. An unreachable provider fails the check when it is the
+// provider Meshery Server is restricted to, users of the other providers are still able to use Meshery.
+func Providers(providers map[string]models.Provider, designated string, client *http.Client) Checker {
+	return func(ctx context.Context) []Check {
+		checks := make([]Check, 0, len(providers))
+		for name, provider := range providers {
+			if provider.GetProviderType() != models.RemoteProviderType {
+				checks = append(checks, Check{Name: name, Subsystem: SubsystemProvider, Status: Pass})
+				continue
+			}
+			providerURL := provider.GetProviderProperties().ProviderURL
+			unreachable := Warn
+			if designated == name {
+				unreachable = Fail
+			}
+			checks = append(checks, Timed(ctx, name, SubsystemProvider, func(ctx context.Context) (Status, string) {
+				req, err := http.NewRequestWithContext(ctx, http.MethodGet, providerURL, nil)
+				if err != nil {
+					return unreachable, err.Error()
+				}
+				resp, err := client.Do(req)
+				if err != nil {
+					return unreachable, err.Error()
+				}
+				_ = resp.Body.Close()
+				if resp.StatusCode >= http.StatusInternalServerError {
+					return unreachable, fmt.Sprintf("%s responded with status code %d", providerURL, resp.StatusCode)
+				}
+				return Pass, ""
+			}))
+		}
+		return checks
+	}
+}
+
+// Broker checks that Meshery Server receives the data of MeshSync through Meshery Broker, for every Kubernetes context
+// Meshery Operator is deployed to. Disconnected brokers leave the data of their context stale, without failing the check.
+func Broker(helper *models.MesheryControllersHelper) Checker {
+	return func(ctx context.Context) []Check {
+		handlers := helper.GetControllerHandlersForEachContext()
+		connected := helper.GetMeshSyncDataHandlersForEachContext()
+		if len(handlers) == 0 {
+			return []Check{{Name: "meshery-broker", Subsystem: SubsystemBroker, Status: Pass, Output: "no Kubernetes context is connected"}}
+		}
+		checks := make([]Check, 0, len(handlers))
+		for ctxID, controllerHandlers := range handlers {
+			broker, ok := controllerHandlers[models.MesheryBroker]
+			if !ok {
+				continue
+			}
+			_, isConnected := connected[ctxID]
+			checks = append(checks, Timed(ctx, ctxID, SubsystemBroker, func(context.Context) (Status, string) {
+				status := broker.GetStatus()
+				if isConnected {
+					return Pass, status.String()
+				}
+				switch status {
+				case controllers.Undeployed, controllers.NotDeployed:
+					return Warn, fmt.Sprintf("Meshery Broker is %s", status)
+				}
+				return Warn, fmt.Sprintf("Meshery Broker is %s, MeshSync data is not received", status)
+			}))
+		}
+		return checks
+	}
+}
+
+// Kubernetes checks that the API servers of the Kubernetes contexts registered with Meshery Server are reachable.
+// Unreachable clusters do not make Meshery Server unable to serve the other clusters, they only warn.
+func Kubernetes(contexts func(ctx context.Context) ([]models.K8sContext, error)) Checker {
+	return func(ctx context.Context) []Check {
+		k8sContexts, err := contexts(ctx)
+		if err != nil {
+			return []Check{{Name: "kubernetes", Subsystem: SubsystemKubernetes, Status: Warn, Output: err.Error()}}
+		}
+		if len(k8sContexts) == 0 {
+			return []Check{{Name: "kubernetes", Subsystem: SubsystemKubernetes, Status: Warn, Output: "no Kubernetes context is registered"}}
+		}
+
+		checks := make([]Check, len(k8sContexts))
+		var wg sync.WaitGroup
+		for i, k8sContext := range k8sContexts {
+			wg.Add(1)
+			go func(i int, k8sContext models.K8sContext) {
+				defer wg.Done()
+				checks[i] = Timed(ctx, k8sContext.Name, SubsystemKubernetes, func(context.Context) (Status, string) {
+					if err := k8sContext.PingTest(); err != nil {
+						return Warn, err.Error()
+					}
+					return Pass, k8sContext.Server
+				})
+			}(i, k8sContext)
+		}
+		wg.Wait()
+		return checks
+	}
+}
@@ -0,0 +1,109 @@
+// Package health checks the subsystems Meshery Server depends on, for the liveness and the readiness endpoints
+// load balancers probe and mesheryctl system status reports.
+package health
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Status is the outcome of a check, and of the checks of a report as a whole
+type Status string
+
+const (
+	// Pass is the status of a subsystem working as expected
+	Pass Status = "pass"
+	// Warn is the status of a subsystem which is degraded, Meshery Server is still able to serve requests
+	Warn Status = "warn"
+	// Fail is the status of a subsystem Meshery Server cannot serve requests without
+	Fail Status = "fail"
+)
+
+// Subsystems of Meshery Server
+const (
+	SubsystemDatabase   = "database"
+	SubsystemProvider   = "provider"
+	SubsystemBroker     = "broker"
+	SubsystemKubernetes = "kubernetes"
+)
+
+// Check is the outcome of checking a subsystem, or one instance of a subsystem, eg: a Kubernetes context
+type Check struct {
+	Name      string `json:"name"`
+	Subsystem string `json:"subsystem"`
+	Status    Status `json:"status"`
+	LatencyMs int64  `json:"latencyMs"`
+	// Output describes the status, eg: the error of a failed check
+	Output string `json:"output,omitempty"`
+}
+
+// Report is the outcome of the checks of Meshery Server, its status is the worst status of the checks
+type Report struct {
+	Status Status  `json:"status"`
+	Checks []Check `json:"checks"`
+}
+
+// Checker checks a subsystem, returning a check for each instance of the subsystem it checked
+type Checker func(ctx context.Context) []Check
+
+// Timed runs the probe of the instance of the subsystem, recording its latency. Probes are expected to give up once
+// the context is done.
+func Timed(ctx context.Context, name, subsystem string, probe func(ctx context.Context) (Status, string)) Check {
+	start := time.Now()
+	status, output := probe(ctx)
+	return Check{
+		Name:      name,
+		Subsystem: subsystem,
+		Status:    status,
+		LatencyMs: time.Since(start).Milliseconds(),
+		Output:    output,
+	}
+}
+
+// Run runs the checkers concurrently, each for at most timeout, and reports their checks ordered by subsystem and name
+func Run(ctx context.Context, timeout time.Duration, checkers ...Checker) Report {
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		checks = make([]Check, 0, len(checkers))
+	)
+	for _, checker := range checkers {
+		wg.Add(1)
+		go func(checker Checker) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			result := checker(ctx)
+			mu.Lock()
+			checks = append(checks, result...)
+			mu.Unlock()
+		}(checker)
+	}
+	wg.Wait()
+
+	sort.Slice(checks, func(i, j int) bool {
+		if checks[i].Subsystem != checks[j].Subsystem {
+			return checks[i].Subsystem < checks[j].Subsystem
+		}
+		return checks[i].Name < checks[j].Name
+	})
+	report := Report{Status: Pass, Checks: checks}
+	for _, check := range checks {
+		if severity(check.Status) > severity(report.Status) {
+			report.Status = check.Status
+		}
+	}
+	return report
+}
+
+func severity(status Status) int {
+	switch status {
+	case Pass:
+		return 0
+	case Warn:
+		return 1
+	}
+	return 2
+}
@@ -0,0 +1,72 @@
+package health
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	check := func(name, subsystem string, status Status) Checker {
+		return func(ctx context.Context) []Check {
+			return []Check{Timed(ctx, name, subsystem, func(context.Context) (Status, string) {
+				return status, ""
+			})}
+		}
+	}
+
+	tests := []struct {
+		name     string
+		checkers []Checker
+		want     Status
+	}{
+		{"no checks", nil, Pass},
+		{"passing checks", []Checker{check("database", SubsystemDatabase, Pass), check("local", SubsystemProvider, Pass)}, Pass},
+		{"degraded subsystem", []Checker{check("database", SubsystemDatabase, Pass), check("kind", SubsystemKubernetes, Warn)}, Warn},
+		{"failed subsystem", []Checker{check("database", SubsystemDatabase, Fail), check("kind", SubsystemKubernetes, Warn)}, Fail},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := Run(context.Background(), time.Second, tt.checkers...)
+			if report.Status != tt.want {
+				t.Errorf("Run() status = %s, want %s", report.Status, tt.want)
+			}
+			if len(report.Checks) != len(tt.checkers) {
+				t.Errorf("Run() checks = %+v, want %d checks", report.Checks, len(tt.checkers))
+			}
+		})
+	}
+}
+
+func TestRunOrdersChecks(t *testing.T) {
+	checkers := []Checker{
+		func(context.Context) []Check {
+			return []Check{{Name: "prod", Subsystem: SubsystemKubernetes, Status: Pass}, {Name: "dev", Subsystem: SubsystemKubernetes, Status: Pass}}
+		},
+		func(context.Context) []Check {
+			return []Check{{Name: "database", Subsystem: SubsystemDatabase, Status: Pass}}
+		},
+	}
+	report := Run(context.Background(), time.Second, checkers...)
+	got := []string{}
+	for _, c := range report.Checks {
+		got = append(got, c.Name)
+	}
+	if want := []string{"database", "dev", "prod"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Run() checks = %v, want %v", got, want)
+	}
+}
+
+func TestRunTimeout(t *testing.T) {
+	slow := func(ctx context.Context) []Check {
+		return []Check{Timed(ctx, "database", SubsystemDatabase, func(ctx context.Context) (Status, string) {
+			<-ctx.Done()
+			return Fail, ctx.Err().Error()
+		})}
+	}
+	report := Run(context.Background(), 10*time.Millisecond, slow)
+	if report.Status != Fail || report.Checks[0].Output != context.DeadlineExceeded.Error() {
+		t.Errorf("Run() = %+v, want the check to fail once timed out", report)
+	}
+}
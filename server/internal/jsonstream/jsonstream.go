@@ -0,0 +1,174 @@
+// Package jsonstream encodes the responses of the list endpoints of Meshery Server without serializing them fully
+// into memory: the elements of the lists of a response are encoded and written one at a time.
+package jsonstream
+
+import (
+	"bufio"
+	"encoding"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// flushEvery is the number of elements of a list after which the encoded elements are written out
+const flushEvery = 64
+
+var (
+	marshalerType     = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// Encode writes the JSON encoding of v to w, followed by a newline, like json.Encoder.Encode does.
+// The slices and arrays among the fields of v, a struct or a pointer to a struct, are encoded element by element.
+// Values of other kinds, and the structs embedding other structs or implementing json.Marshaler, are encoded at once.
+func Encode(w io.Writer, v interface{}) error {
+	bw := bufio.NewWriter(w)
+	if err := encode(bw, v); err != nil {
+		return err
+	}
+	if err := bw.WriteByte('\n'); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func encode(w *bufio.Writer, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() && !implementsMarshaler(rv.Type()) {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct || implementsMarshaler(rv.Type()) || implementsMarshaler(reflect.PointerTo(rv.Type())) {
+		return writeValue(w, v)
+	}
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		// the fields of embedded structs are promoted and the fields with the string option are quoted
+		if t.Field(i).Anonymous || strings.Contains(t.Field(i).Tag.Get("json"), ",string") {
+			return writeValue(w, v)
+		}
+	}
+
+	if err := w.WriteByte('{'); err != nil {
+		return err
+	}
+	first := true
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, omitEmpty, ok := fieldName(field)
+		if !ok {
+			continue
+		}
+		fv := rv.Field(i)
+		if omitEmpty && isEmpty(fv) {
+			continue
+		}
+		if !first {
+			if err := w.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		first = false
+		if err := writeValue(w, name); err != nil {
+			return err
+		}
+		if err := w.WriteByte(':'); err != nil {
+			return err
+		}
+		if err := writeField(w, fv); err != nil {
+			return err
+		}
+	}
+	return w.WriteByte('}')
+}
+
+// writeField writes the slices and arrays element by element and the other values at once
+func writeField(w *bufio.Writer, fv reflect.Value) error {
+	kind := fv.Kind()
+	streamed := (kind == reflect.Slice && !fv.IsNil() && fv.Type().Elem().Kind() != reflect.Uint8) || kind == reflect.Array
+	if !streamed || implementsMarshaler(fv.Type()) {
+		return writeValue(w, valueOf(fv))
+	}
+	if err := w.WriteByte('['); err != nil {
+		return err
+	}
+	for i := 0; i < fv.Len(); i++ {
+		if i > 0 {
+			if err := w.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		if err := writeValue(w, valueOf(fv.Index(i))); err != nil {
+			return err
+		}
+		if (i+1)%flushEvery == 0 {
+			if err := w.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return w.WriteByte(']')
+}
+
+// valueOf returns the value to encode, a pointer to the value when it is addressable and its methods with pointer
+// receivers encode it, since encoding/json calls these methods on addressable values
+func valueOf(v reflect.Value) interface{} {
+	if v.CanAddr() && v.Kind() != reflect.Pointer && !implementsMarshaler(v.Type()) && implementsMarshaler(reflect.PointerTo(v.Type())) {
+		return v.Addr().Interface()
+	}
+	return v.Interface()
+}
+
+func writeValue(w *bufio.Writer, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// fieldName returns the name of the field in the JSON encoding and whether it is omitted when empty,
+// ok is false for the fields encoding/json does not encode
+func fieldName(field reflect.StructField) (name string, omitEmpty, ok bool) {
+	if !field.IsExported() {
+		return "", false, false
+	}
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, false
+	}
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return name, omitEmpty, true
+}
+
+// isEmpty reports whether the value is empty as defined by the omitempty option of encoding/json
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	}
+	return false
+}
+
+func implementsMarshaler(t reflect.Type) bool {
+	return t.Implements(marshalerType) || t.Implements(textMarshalerType)
+}
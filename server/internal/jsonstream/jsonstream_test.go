@@ -0,0 +1,85 @@
+package jsonstream
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+type component struct {
+	Kind     string                 `json:"kind"`
+	Schema   string                 `json:"schema,omitempty"`
+	Metadata map[string]interface{} `json:"metadata"`
+	secret   string
+}
+
+type ptrMarshaler struct{ v int }
+
+func (p *ptrMarshaler) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]int{"v": p.v})
+}
+
+type page struct {
+	Page       int            `json:"page"`
+	Count      int64          `json:"total_count"`
+	Components []component    `json:"components"`
+	Revisions  []component    `json:"revisions,omitempty"`
+	Hosts      []string       `json:"hosts"`
+	Digest     []byte         `json:"digest"`
+	Created    time.Time      `json:"created_at"`
+	Counts     [3]int         `json:"counts"`
+	Marshalers []ptrMarshaler `json:"marshalers"`
+	Ignored    string         `json:"-"`
+	NoTag      bool
+}
+
+type embedding struct {
+	page
+	Extra string `json:"extra"`
+}
+
+func TestEncode(t *testing.T) {
+	components := make([]component, 0, 150)
+	for i := 0; i < 150; i++ {
+		components = append(components, component{Kind: "Deployment<" + string(rune('a'+i%26)) + ">", Metadata: map[string]interface{}{"i": i}, secret: "s"})
+	}
+	full := page{
+		Page:       2,
+		Count:      150,
+		Components: components,
+		Digest:     []byte("digest"),
+		Created:    time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC),
+		Counts:     [3]int{1, 2, 3},
+		Marshalers: []ptrMarshaler{{1}, {2}},
+		Ignored:    "ignored",
+		NoTag:      true,
+	}
+
+	tests := []struct {
+		name string
+		v    interface{}
+	}{
+		{"struct", full},
+		{"pointer to struct", &full},
+		{"empty lists", page{Components: []component{}}},
+		{"nil lists", page{}},
+		{"embedded struct", embedding{page: full, Extra: "extra"}},
+		{"slice", components[:3]},
+		{"nil pointer", (*page)(nil)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var want, got bytes.Buffer
+			if err := json.NewEncoder(&want).Encode(tt.v); err != nil {
+				t.Fatal(err)
+			}
+			if err := Encode(&got, tt.v); err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != want.String() {
+				t.Errorf("Encode() =\n%s\nwant\n%s", got.String(), want.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Package metrics exposes the metrics of Meshery Server in the Prometheus exposition format, so that operators can
+// monitor Meshery with their existing Prometheus stack.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "meshery"
+
+// Registry holds the metrics of Meshery Server, along with the ones of the Go runtime and of the process
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of the HTTP requests served by Meshery Server, by route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"handler", "method", "code"})
+
+	providerRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "provider",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of the requests of Meshery Server to the remote providers.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"provider", "method", "code"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestDuration,
+		providerRequestDuration,
+	)
+}
+
+// Handler serves the metrics of the Registry
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// Middleware records the duration of the requests of the routes of the router it is used by. Requests are labeled
+// with the path template of their route, eg: /api/pattern/{id}, so that the IDs in paths do not make up new series.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler := "unmatched"
+		if route := mux.CurrentRoute(r); route != nil {
+			if tmpl, err := route.GetPathTemplate(); err == nil {
+				handler = tmpl
+			}
+		}
+		// the wrapped writer keeps implementing http.Flusher and http.Hijacker, for the event streams and the websockets
+		promhttp.InstrumentHandlerDuration(httpRequestDuration.MustCurryWith(prometheus.Labels{"handler": handler}), next).ServeHTTP(w, r)
+	})
+}
+
+// ObserveProviderRequest records the duration of a request to the remote provider, code is 0 when no response was received
+func ObserveProviderRequest(provider, method string, code int, duration time.Duration) {
+	status := "error"
+	if code != 0 {
+		status = strconv.Itoa(code)
+	}
+	providerRequestDuration.WithLabelValues(provider, method, status).Observe(duration.Seconds())
+}
+
+// RegisterGauge registers the gauge named meshery_<name>, whose value is returned by value when the metrics are scraped
+func RegisterGauge(name, help string, value func() float64) {
+	Registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      name,
+		Help:      help,
+	}, value))
+}
+
+// RegisterGaugeVec registers the gauges named meshery_<name>, whose values by value of the label are returned by
+// values when the metrics are scraped
+func RegisterGaugeVec(name, help, label string, values func() map[string]float64) {
+	Registry.MustRegister(&gaugeVecCollector{
+		desc:   prometheus.NewDesc(prometheus.BuildFQName(namespace, "", name), help, []string{label}, nil),
+		values: values,
+	})
+}
+
+type gaugeVecCollector struct {
+	desc   *prometheus.Desc
+	values func() map[string]float64
+}
+
+func (c *gaugeVecCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *gaugeVecCollector) Collect(ch chan<- prometheus.Metric) {
+	for label, value := range c.values() {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, value, label)
+	}
+}
@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMiddleware(t *testing.T) {
+	router := mux.NewRouter()
+	router.HandleFunc("/api/pattern/{id}", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}).Methods("GET")
+	router.Use(Middleware)
+
+	for _, id := range []string{"1", "2"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/pattern/"+id, nil))
+	}
+
+	// the requests of a route are recorded in a single series, whatever the IDs in their path
+	families, err := Registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, family := range families {
+		if family.GetName() != "meshery_http_request_duration_seconds" {
+			continue
+		}
+		if len(family.GetMetric()) != 1 {
+			t.Fatalf("series = %d, want 1", len(family.GetMetric()))
+		}
+		metric := family.GetMetric()[0]
+		labels := map[string]string{}
+		for _, label := range metric.GetLabel() {
+			labels[label.GetName()] = label.GetValue()
+		}
+		if labels["handler"] != "/api/pattern/{id}" || labels["method"] != "get" || labels["code"] != "404" {
+			t.Errorf("labels = %v, want the route template, the method and the status code", labels)
+		}
+		if count := metric.GetHistogram().GetSampleCount(); count != 2 {
+			t.Errorf("requests = %d, want 2", count)
+		}
+		return
+	}
+	t.Error("no request duration recorded")
+}
+
+func TestRegisterGaugeVec(t *testing.T) {
+	RegisterGaugeVec("test_entities", "Number of test entities.", "kind", func() map[string]float64 {
+		return map[string]float64{"model": 2, "component": 10}
+	})
+	if n, err := testutil.GatherAndCount(Registry, "meshery_test_entities"); err != nil || n != 2 {
+		t.Errorf("GatherAndCount() = %d, %v, want 2 gauges", n, err)
+	}
+}
@@ -0,0 +1,241 @@
+// Package mtls authenticates the adapters and the registrants of Meshery Server with mutual TLS, so that the writes to
+// the registry can be restricted to trusted workloads.
+//
+// The certificate, the key and the CA bundle are read from files, eg: the ones written by cert-manager or by the SPIFFE
+// helper, and are read again as soon as they change, so that rotated certificates are used without restarting Meshery
+// Server. The peers are identified by the SPIFFE ID of their certificate, ie: its spiffe:// URI SAN, and can be restricted
+// to a trust domain or to a list of IDs.
+package mtls
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultRefreshInterval is how often the files are checked for rotated certificates
+const DefaultRefreshInterval = time.Minute
+
+// Config configures the certificates of Meshery Server and the peers it trusts
+type Config struct {
+	// CertFile and KeyFile are the PEM certificate, with its intermediates, and the key of Meshery Server
+	CertFile string
+	KeyFile  string
+	// CAFile is the PEM bundle of the CAs the certificates of the peers are verified with, eg: the SPIFFE trust bundle
+	CAFile string
+	// TrustDomain restricts the peers to the SPIFFE IDs of the trust domain, eg: meshery.example.com
+	TrustDomain string
+	// AllowedIDs restricts the peers to the SPIFFE IDs, an ID ending with /* allows the IDs under its path,
+	// eg: spiffe://meshery.example.com/ns/meshery/sa/*
+	AllowedIDs []string
+	// RefreshInterval is how often the files are checked for rotated certificates, DefaultRefreshInterval by default
+	RefreshInterval time.Duration
+}
+
+// Source holds the certificates of the configuration, reading them again when their files change
+type Source struct {
+	config Config
+	now    func() time.Time
+
+	mu          sync.RWMutex
+	certificate *tls.Certificate
+	pool        *x509.CertPool
+	contents    [3][]byte
+	checkedAt   time.Time
+}
+
+// NewSource reads the certificates of the configuration
+func NewSource(config Config) (*Source, error) {
+	if config.CertFile == "" || config.KeyFile == "" || config.CAFile == "" {
+		return nil, errors.New("the certificate, the key and the CA bundle are required")
+	}
+	if config.RefreshInterval <= 0 {
+		config.RefreshInterval = DefaultRefreshInterval
+	}
+	for i, id := range config.AllowedIDs {
+		config.AllowedIDs[i] = strings.TrimSpace(id)
+		if !strings.HasPrefix(config.AllowedIDs[i], "spiffe://") {
+			return nil, fmt.Errorf("%q is not a SPIFFE ID", id)
+		}
+	}
+	s := &Source{config: config, now: time.Now}
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// refresh reads the certificates again when the refresh interval has elapsed and their files changed. The certificates
+// read last are kept when the files cannot be read, eg: while they are being rotated.
+func (s *Source) refresh() {
+	s.mu.RLock()
+	due := s.now().Sub(s.checkedAt) >= s.config.RefreshInterval
+	s.mu.RUnlock()
+	if due {
+		_ = s.reload()
+	}
+}
+
+func (s *Source) reload() error {
+	var contents [3][]byte
+	for i, file := range []string{s.config.CertFile, s.config.KeyFile, s.config.CAFile} {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		contents[i] = b
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkedAt = s.now()
+	if bytes.Equal(contents[0], s.contents[0]) && bytes.Equal(contents[1], s.contents[1]) && bytes.Equal(contents[2], s.contents[2]) {
+		return nil
+	}
+	certificate, err := tls.X509KeyPair(contents[0], contents[1])
+	if err != nil {
+		return err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(contents[2]) {
+		return fmt.Errorf("%s holds no PEM certificate", s.config.CAFile)
+	}
+	s.certificate, s.pool, s.contents = &certificate, pool, contents
+	return nil
+}
+
+func (s *Source) current() (*tls.Certificate, *x509.CertPool) {
+	s.refresh()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.certificate, s.pool
+}
+
+// ServerConfig returns the configuration of the servers requiring the certificates of their clients, which are verified
+// with the CA bundle and authorized by their SPIFFE ID
+func (s *Source) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			certificate, _ := s.current()
+			return certificate, nil
+		},
+		// the CA bundle read last is used by the configuration of every connection
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			certificate, pool := s.current()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				NextProtos:   []string{"h2", "http/1.1"},
+				Certificates: []tls.Certificate{*certificate},
+				ClientCAs:    pool,
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+				VerifyPeerCertificate: func(_ [][]byte, chains [][]*x509.Certificate) error {
+					return s.Authorize(chains[0][0])
+				},
+			}, nil
+		},
+	}
+}
+
+// ClientConfig returns the configuration of the clients presenting the certificate of Meshery Server. The certificates of
+// the servers are verified with the CA bundle, and authorized by their SPIFFE ID, or by their host name when they have no
+// SPIFFE ID and no trust domain nor ID is required.
+func (s *Source) ClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			certificate, _ := s.current()
+			return certificate, nil
+		},
+		// the chains are verified by VerifyConnection, against the CA bundle read last
+		InsecureSkipVerify: true, // #nosec G402
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			_, pool := s.current()
+			if len(cs.PeerCertificates) == 0 {
+				return errors.New("the server presented no certificate")
+			}
+			leaf := cs.PeerCertificates[0]
+			opts := x509.VerifyOptions{Roots: pool, Intermediates: x509.NewCertPool()}
+			for _, c := range cs.PeerCertificates[1:] {
+				opts.Intermediates.AddCert(c)
+			}
+			if SPIFFEID(leaf) == "" && !s.restricted() {
+				opts.DNSName = cs.ServerName
+			}
+			if _, err := leaf.Verify(opts); err != nil {
+				return err
+			}
+			return s.Authorize(leaf)
+		},
+	}
+}
+
+// Authorize returns an error when the SPIFFE ID of the certificate is not the one of a trusted workload
+func (s *Source) Authorize(certificate *x509.Certificate) error {
+	if !s.restricted() {
+		return nil
+	}
+	id := SPIFFEID(certificate)
+	if id == "" {
+		return fmt.Errorf("the certificate of %s has no SPIFFE ID", certificate.Subject)
+	}
+	if s.config.TrustDomain != "" && !strings.HasPrefix(id, "spiffe://"+s.config.TrustDomain+"/") {
+		return fmt.Errorf("%s is not in the trust domain %s", id, s.config.TrustDomain)
+	}
+	if len(s.config.AllowedIDs) == 0 {
+		return nil
+	}
+	for _, allowed := range s.config.AllowedIDs {
+		if id == allowed || (strings.HasSuffix(allowed, "/*") && strings.HasPrefix(id, strings.TrimSuffix(allowed, "*"))) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not allowed", id)
+}
+
+// restricted tells whether the peers are restricted to SPIFFE IDs
+func (s *Source) restricted() bool {
+	return s.config.TrustDomain != "" || len(s.config.AllowedIDs) > 0
+}
+
+// SPIFFEID returns the SPIFFE ID of the certificate, empty when it has none
+func SPIFFEID(certificate *x509.Certificate) string {
+	for _, uri := range certificate.URIs {
+		if uri.Scheme == "spiffe" {
+			return (&url.URL{Scheme: uri.Scheme, Host: uri.Host, Path: uri.Path}).String()
+		}
+	}
+	return ""
+}
+
+// PeerID returns the SPIFFE ID of the verified certificate of the client of the request, or the subject of the certificate
+// when it has none. ok is false when the request was not made over mutual TLS.
+func PeerID(r *http.Request) (id string, ok bool) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	leaf := r.TLS.VerifiedChains[0][0]
+	if id := SPIFFEID(leaf); id != "" {
+		return id, true
+	}
+	return leaf.Subject.String(), true
+}
+
+// RequireClientCertificate rejects the requests not made over mutual TLS, the clients being authorized during the handshake
+func RequireClientCertificate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := PeerID(r); !ok {
+			http.Error(w, "this endpoint requires a client certificate, call it on the mutual TLS port of Meshery Server", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,210 @@
+package mtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue writes the certificate of the SPIFFE ID signed by the CA, its key, and the CA bundle to the directory
+func (ca *testCA) issue(t *testing.T, dir, id string, bundle ...*testCA) Config {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uri, _ := url.Parse(id)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		URIs:         []*url.URL{uri},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var caPEM []byte
+	for _, c := range append([]*testCA{ca}, bundle...) {
+		caPEM = append(caPEM, c.pem...)
+	}
+	config := Config{
+		CertFile: filepath.Join(dir, "tls.crt"),
+		KeyFile:  filepath.Join(dir, "tls.key"),
+		CAFile:   filepath.Join(dir, "ca.crt"),
+	}
+	for file, b := range map[string][]byte{
+		config.CertFile: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		config.KeyFile:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		config.CAFile:   caPEM,
+	} {
+		if err := os.WriteFile(file, b, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return config
+}
+
+// serve serves the peer IDs of the requests over mutual TLS with the server source
+func serve(t *testing.T, server *Source) string {
+	ts := httptest.NewUnstartedServer(RequireClientCertificate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, _ := PeerID(r)
+		_, _ = io.WriteString(w, id)
+	})))
+	ts.TLS = server.ServerConfig()
+	ts.StartTLS()
+	t.Cleanup(ts.Close)
+	return ts.URL
+}
+
+// get returns the response of the server to the client source, or the error of the request
+func get(serverURL string, client *Source) (string, error) {
+	c := &http.Client{Transport: &http.Transport{TLSClientConfig: client.ClientConfig()}}
+	resp, err := c.Get(serverURL)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	return string(b), err
+}
+
+func TestMutualTLS(t *testing.T) {
+	ca := newTestCA(t)
+	serverConfig := ca.issue(t, t.TempDir(), "spiffe://example.org/ns/meshery/sa/meshery-server")
+	serverConfig.AllowedIDs = []string{"spiffe://example.org/ns/meshery/sa/*"}
+	server, err := NewSource(serverConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverURL := serve(t, server)
+
+	clientConfig := ca.issue(t, t.TempDir(), "spiffe://example.org/ns/meshery/sa/meshery-istio")
+	clientConfig.TrustDomain = "example.org"
+	client, err := NewSource(clientConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := get(serverURL, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "spiffe://example.org/ns/meshery/sa/meshery-istio" {
+		t.Errorf("the peer of the server is %q", id)
+	}
+
+	untrusted, err := NewSource(ca.issue(t, t.TempDir(), "spiffe://example.org/ns/default/sa/default"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := get(serverURL, untrusted); err == nil {
+		t.Error("the server accepted a workload which is not allowed")
+	}
+
+	otherDomain := clientConfig
+	otherDomain.TrustDomain = "meshery.example.com"
+	other, err := NewSource(otherDomain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := get(serverURL, other); err == nil {
+		t.Error("the client accepted a server of another trust domain")
+	}
+}
+
+func TestRotation(t *testing.T) {
+	ca, rotated := newTestCA(t), newTestCA(t)
+	dir := t.TempDir()
+	server, err := NewSource(ca.issue(t, dir, "spiffe://example.org/meshery-server"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	server.now = func() time.Time { return now }
+	serverURL := serve(t, server)
+
+	client, err := NewSource(rotated.issue(t, t.TempDir(), "spiffe://example.org/registrant", ca))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := get(serverURL, client); err == nil {
+		t.Fatal("the server accepted a certificate of a CA it does not trust yet")
+	}
+
+	// the server trusts both CAs from the rotation on, the files being checked after the refresh interval only
+	rotated.issue(t, dir, "spiffe://example.org/meshery-server", ca)
+	if _, err := get(serverURL, client); err == nil {
+		t.Fatal("the files were read again before the refresh interval")
+	}
+	now = now.Add(DefaultRefreshInterval)
+	if _, err := get(serverURL, client); err != nil {
+		t.Errorf("the rotated certificates are not used: %v", err)
+	}
+}
+
+func TestNewSource(t *testing.T) {
+	config := newTestCA(t).issue(t, t.TempDir(), "spiffe://example.org/meshery-server")
+	config.AllowedIDs = []string{"meshery-istio"}
+	if _, err := NewSource(config); err == nil {
+		t.Error("NewSource() accepted an ID which is not a SPIFFE ID")
+	}
+	config.AllowedIDs = nil
+	config.CAFile = filepath.Join(t.TempDir(), "missing.crt")
+	if _, err := NewSource(config); err == nil {
+		t.Error("NewSource() succeeded without CA bundle")
+	}
+}
+
+func TestRequireClientCertificate(t *testing.T) {
+	h := RequireClientCertificate(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/meshmodels/components", nil))
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("the request without client certificate was answered %d", rec.Code)
+	}
+}
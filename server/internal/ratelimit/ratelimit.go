@@ -0,0 +1,244 @@
+// Package ratelimit limits the rate of the calls of the API of Meshery Server with token buckets, one per provider
+// token and one per client IP, so that runaway automation cannot exhaust the registry and the deployment endpoints.
+package ratelimit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// TokenCookie is the cookie holding the provider token of the clients of the API, the UI and mesheryctl
+const TokenCookie = "token"
+
+// buckets idle for longer are forgotten, a forgotten bucket is full again
+const idleTimeout = 10 * time.Minute
+
+// Quota is the sustained rate, in requests per second, and the burst of a token bucket. A zero rate disables the quota.
+type Quota struct {
+	Rate  float64
+	Burst int
+}
+
+// Limiter holds a token bucket for every key, eg: every client IP
+type Limiter struct {
+	quota Quota
+	now   func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewLimiter returns a limiter allowing every key the quota
+func NewLimiter(quota Quota) *Limiter {
+	return &Limiter{quota: quota, now: time.Now, buckets: make(map[string]*bucket)}
+}
+
+// Reserve takes a token from the bucket of the key. When the bucket is empty no token is taken and the returned
+// duration is the time until a token is available, it is 0 otherwise.
+func (l *Limiter) Reserve(key string) time.Duration {
+	_, wait := l.reserve(key)
+	return wait
+}
+
+// reserve takes a token from the bucket of the key, the returned function gives it back
+func (l *Limiter) reserve(key string) (func(), time.Duration) {
+	if l.quota.Rate <= 0 {
+		return func() {}, 0
+	}
+	now := l.now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sweep(now)
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(l.quota.Rate), l.quota.Burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+
+	r := b.limiter.ReserveN(now, 1)
+	if !r.OK() {
+		// the burst is 0, no request is ever allowed
+		return func() {}, time.Duration(math.MaxInt64)
+	}
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return func() {}, delay
+	}
+	return func() { r.CancelAt(now) }, 0
+}
+
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < idleTimeout {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) > idleTimeout {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// Config holds the quotas of the API
+type Config struct {
+	// Token is the quota of every provider token, shared by the UI and the mesheryctl sessions of a user
+	Token Quota
+	// IP is the quota of every client IP, whether the requests carry a token or not
+	IP Quota
+	// PathPrefix selects the routes the quotas apply to, eg: /api/, so that the assets of the UI are not limited
+	PathPrefix string
+	// TrustedProxies are the IPs and CIDRs of the reverse proxies in front of Meshery Server. The client IP of the requests
+	// they forward is taken from the X-Forwarded-For header, which is ignored for the requests of the other addresses.
+	// The entries which are not valid, see ParseTrustedProxies, are ignored.
+	TrustedProxies []string
+}
+
+// ParseTrustedProxies parses the IPs and CIDRs of trusted proxies, eg: 10.0.0.0/8
+func ParseTrustedProxies(entries []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("trusted proxy %q is not an IP or a CIDR", entry)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q is not an IP or a CIDR", entry)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}
+
+// Middleware rejects with 429 Too Many Requests the requests exceeding the quota of their provider token or of their
+// client IP. The Retry-After header of the response is the number of seconds until the request would be allowed.
+func Middleware(cfg Config) func(http.Handler) http.Handler {
+	tokens := NewLimiter(cfg.Token)
+	ips := NewLimiter(cfg.IP)
+	var proxies []*net.IPNet
+	for _, entry := range cfg.TrustedProxies {
+		if parsed, err := ParseTrustedProxies([]string{entry}); err == nil {
+			proxies = append(proxies, parsed...)
+		}
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasPrefix(r.URL.Path, cfg.PathPrefix) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			cancel, wait := ips.reserve(clientIP(r, proxies))
+			if wait > 0 {
+				tooManyRequests(w, wait)
+				return
+			}
+			if token := tokenKey(r); token != "" {
+				if wait := tokens.Reserve(token); wait > 0 {
+					// the request is not served, it does not count against the quota of the IP
+					cancel()
+					tooManyRequests(w, wait)
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func tooManyRequests(w http.ResponseWriter, wait time.Duration) {
+	seconds := int64(math.Ceil(wait.Seconds()))
+	if wait == time.Duration(math.MaxInt64) {
+		seconds = int64(idleTimeout.Seconds())
+	}
+	w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+	http.Error(w, "rate limit exceeded, retry in "+strconv.FormatInt(seconds, 10)+"s", http.StatusTooManyRequests)
+}
+
+// tokenKey returns the key of the bucket of the provider token of the request, the digest of the token
+// so that the tokens are not kept in memory, or an empty string if the request carries no token
+func tokenKey(r *http.Request) string {
+	token := ""
+	if ck, err := r.Cookie(TokenCookie); err == nil {
+		token = ck.Value
+	} else if auth := r.Header.Get("Authorization"); len(auth) > len("bearer ") && strings.EqualFold(auth[:len("bearer ")], "bearer ") {
+		token = auth[len("bearer "):]
+	}
+	if token == "" {
+		return ""
+	}
+	digest := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(digest[:])
+}
+
+// clientIP returns the IP of the client of the request. When the request comes from a trusted proxy the client is the
+// last address of the X-Forwarded-For header which is not a trusted proxy, the addresses before it are set by the client
+// and cannot be trusted.
+func clientIP(r *http.Request, proxies []*net.IPNet) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	if !trusted(host, proxies) {
+		return host
+	}
+	var forwarded []string
+	for _, header := range r.Header.Values("X-Forwarded-For") {
+		forwarded = append(forwarded, strings.Split(header, ",")...)
+	}
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		addr := strings.TrimSpace(forwarded[i])
+		if net.ParseIP(addr) == nil {
+			// the chain of addresses is broken, the proxy is the client
+			return host
+		}
+		host = addr
+		if !trusted(addr, proxies) {
+			break
+		}
+	}
+	return host
+}
+
+func trusted(addr string, proxies []*net.IPNet) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, proxy := range proxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
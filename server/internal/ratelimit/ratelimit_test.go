@@ -0,0 +1,121 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestLimiterReserve(t *testing.T) {
+	now := time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC)
+	l := NewLimiter(Quota{Rate: 2, Burst: 2})
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if wait := l.Reserve("a"); wait != 0 {
+			t.Fatalf("request %d within the burst waits %v", i, wait)
+		}
+	}
+	if wait := l.Reserve("a"); wait != 500*time.Millisecond {
+		t.Errorf("request over the burst waits %v, want 500ms", wait)
+	}
+	// the denied request does not take a token
+	now = now.Add(500 * time.Millisecond)
+	if wait := l.Reserve("a"); wait != 0 {
+		t.Errorf("request after the refill waits %v", wait)
+	}
+	// buckets are independent
+	if wait := l.Reserve("b"); wait != 0 {
+		t.Errorf("request of another key waits %v", wait)
+	}
+
+	if wait := NewLimiter(Quota{}).Reserve("a"); wait != 0 {
+		t.Errorf("disabled quota waits %v", wait)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	handler := Middleware(Config{
+		Token:      Quota{Rate: 1, Burst: 1},
+		IP:         Quota{Rate: 1, Burst: 3},
+		PathPrefix: "/api/",
+	})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	serve := func(path, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+		req.RemoteAddr = "10.0.0.1:51234"
+		if token != "" {
+			req.AddCookie(&http.Cookie{Name: TokenCookie, Value: token})
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := serve("/api/pattern/deploy", "alice"); rec.Code != http.StatusOK {
+		t.Fatalf("first request: %d", rec.Code)
+	}
+	rec := serve("/api/pattern/deploy", "alice")
+	if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") != "1" {
+		t.Errorf("request over the quota of the token: %d, Retry-After %q, want 429 and 1", rec.Code, rec.Header().Get("Retry-After"))
+	}
+	// the rejected request did not count against the quota of the IP
+	if rec := serve("/api/pattern/deploy", "bob"); rec.Code != http.StatusOK {
+		t.Errorf("request of another token: %d", rec.Code)
+	}
+	if rec := serve("/api/pattern/deploy", ""); rec.Code != http.StatusOK {
+		t.Errorf("request without token: %d", rec.Code)
+	}
+	if rec := serve("/api/pattern/deploy", ""); rec.Code != http.StatusTooManyRequests {
+		t.Errorf("request over the quota of the IP: %d, want 429", rec.Code)
+	}
+	if rec := serve("/provider", ""); rec.Code != http.StatusOK {
+		t.Errorf("request outside of the API: %d", rec.Code)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.10"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor []string
+		want         string
+	}{
+		{"direct client", "203.0.113.7:51234", nil, "203.0.113.7"},
+		{"untrusted client forging the header", "203.0.113.7:51234", []string{"198.51.100.1"}, "203.0.113.7"},
+		{"trusted proxy", "10.0.0.1:51234", []string{"198.51.100.1"}, "198.51.100.1"},
+		{"chain of trusted proxies", "192.168.1.10:51234", []string{"198.51.100.1, 10.1.2.3"}, "198.51.100.1"},
+		{"client forging the start of the chain", "10.0.0.1:51234", []string{"1.2.3.4, 198.51.100.1"}, "198.51.100.1"},
+		{"header split across lines", "10.0.0.1:51234", []string{"1.2.3.4", "198.51.100.1"}, "198.51.100.1"},
+		{"invalid address in the chain", "10.0.0.1:51234", []string{"198.51.100.1, unknown"}, "10.0.0.1"},
+		{"trusted proxy without header", "10.0.0.1:51234", nil, "10.0.0.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/pattern", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for _, header := range tt.forwardedFor {
+				req.Header.Add("X-Forwarded-For", header)
+			}
+			if got := clientIP(req, proxies); got != tt.want {
+				t.Errorf("clientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	if _, err := ParseTrustedProxies([]string{"10.0.0.0/8", " ::1 ", ""}); err != nil {
+		t.Errorf("ParseTrustedProxies() = %v", err)
+	}
+	for _, entry := range []string{"proxy.example.com", "10.0.0.0/33"} {
+		if _, err := ParseTrustedProxies([]string{entry}); err == nil {
+			t.Errorf("ParseTrustedProxies(%q) accepted an invalid entry", entry)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/layer5io/meshkit/models/events"
+)
+
+// Event returns a copy of the event with the secrets of its description and of its metadata masked
+func Event(event *events.Event) *events.Event {
+	if event == nil {
+		return nil
+	}
+	redacted := *event
+	redacted.Description = String(event.Description)
+	if event.Metadata != nil {
+		redacted.Metadata = make(map[string]interface{}, len(event.Metadata))
+		for k, v := range event.Metadata {
+			if sensitiveKey(k) && !empty(v) {
+				redacted.Metadata[k] = mask(v)
+				continue
+			}
+			redacted.Metadata[k] = metadataValue(v)
+		}
+	}
+	return &redacted
+}
+
+// metadataValue returns the value with its secrets masked, the values of other types than the ones decoded from JSON, eg:
+// the designs and the components attached to the events, are redacted as their JSON
+func metadataValue(v interface{}) interface{} {
+	switch v.(type) {
+	case nil, bool, float64, int, string, []interface{}, map[string]interface{}:
+		return Value(v)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	redacted := JSON(b)
+	if bytes.Equal(redacted, b) {
+		return v
+	}
+	var out interface{}
+	if err := json.Unmarshal(redacted, &out); err != nil {
+		return Mask
+	}
+	return out
+}
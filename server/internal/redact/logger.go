@@ -0,0 +1,63 @@
+package redact
+
+import (
+	stderrors "errors"
+	"fmt"
+
+	"github.com/layer5io/meshkit/errors"
+	"github.com/layer5io/meshkit/logger"
+)
+
+// Logger returns the logger masking the secrets of the lines it logs
+func Logger(l logger.Handler) logger.Handler {
+	return &redactingLogger{Handler: l}
+}
+
+type redactingLogger struct {
+	logger.Handler
+}
+
+func (l *redactingLogger) Info(description ...interface{}) {
+	l.Handler.Info(String(fmt.Sprint(description...)))
+}
+
+func (l *redactingLogger) Debug(description ...interface{}) {
+	l.Handler.Debug(String(fmt.Sprint(description...)))
+}
+
+func (l *redactingLogger) Warn(err error) {
+	l.Handler.Warn(Error(err))
+}
+
+func (l *redactingLogger) Error(err error) {
+	l.Handler.Error(Error(err))
+}
+
+// Error returns the error with the secrets of its descriptions masked, the meshkit errors keep their code and severity
+func Error(err error) error {
+	if err == nil {
+		return nil
+	}
+	if e, ok := errors.Is(err); ok && e != nil {
+		return &errors.Error{
+			Code:                 e.Code,
+			Severity:             e.Severity,
+			ShortDescription:     redactStrings(e.ShortDescription),
+			LongDescription:      redactStrings(e.LongDescription),
+			ProbableCause:        redactStrings(e.ProbableCause),
+			SuggestedRemediation: redactStrings(e.SuggestedRemediation),
+		}
+	}
+	if s := String(err.Error()); s != err.Error() {
+		return stderrors.New(s)
+	}
+	return err
+}
+
+func redactStrings(ss []string) []string {
+	out := make([]string, len(ss))
+	for i, s := range ss {
+		out[i] = String(s)
+	}
+	return out
+}
@@ -0,0 +1,140 @@
+package redact
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Middleware masks the secrets of the JSON responses of the API, and of the text and event stream ones, except under the
+// exempt path prefixes, eg: the ones of the APIs returning credentials or tokens to their owners on purpose.
+// The JSON responses are buffered to be redacted as a whole, the streamed responses are redacted as they are written.
+func Middleware(exempt ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Upgrade") != "" || isExempt(r.URL.Path, exempt) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			rw := &responseWriter{ResponseWriter: w}
+			defer rw.close()
+			next.ServeHTTP(rw, r)
+		})
+	}
+}
+
+func isExempt(path string, exempt []string) bool {
+	for _, prefix := range exempt {
+		if prefix != "" && strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// responseWriter buffers the JSON responses until they are complete, and redacts the text ones as they are written
+type responseWriter struct {
+	http.ResponseWriter
+
+	status      int
+	wroteHeader bool
+	// buffering is set while the response is a JSON document being buffered, passthrough once the response is binary
+	buffering   bool
+	passthrough bool
+	buf         []byte
+}
+
+func (w *responseWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.status = status
+	ct := w.Header().Get("Content-Type")
+	switch {
+	case status < http.StatusOK || status == http.StatusNoContent || status == http.StatusNotModified:
+		w.passthrough = true
+	case ct == "" || strings.Contains(ct, "json"):
+		// most of the handlers encode their JSON responses without setting their content type
+		w.buffering = true
+		return
+	case !strings.HasPrefix(ct, "text/"):
+		w.passthrough = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	switch {
+	case w.passthrough:
+		return w.ResponseWriter.Write(b)
+	case w.buffering:
+		w.buf = append(w.buf, b...)
+		return len(b), nil
+	}
+	if _, err := w.ResponseWriter.Write([]byte(String(string(b)))); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+// Flush writes the buffered response, which is then redacted as it is written, and flushes the response
+func (w *responseWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.buffering {
+		w.stopBuffering(false)
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// stopBuffering writes the header of the response and the buffered bytes, redacted as a whole JSON document if the
+// response is complete
+func (w *responseWriter) stopBuffering(complete bool) {
+	w.buffering = false
+	body := w.buf
+	w.buf = nil
+	if complete {
+		body = JSON(body)
+	} else {
+		body = []byte(String(string(body)))
+	}
+	h := w.Header()
+	if h.Get("Content-Length") != "" {
+		h.Set("Content-Length", strconv.Itoa(len(body)))
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+	if len(body) > 0 {
+		_, _ = w.ResponseWriter.Write(body)
+	}
+}
+
+// Hijack lets the handlers take over the connection, what they write to it is not redacted
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	w.passthrough = true
+	w.buffering = false
+	return http.NewResponseController(w.ResponseWriter).Hijack()
+}
+
+// Unwrap returns the wrapped writer, for http.ResponseController
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *responseWriter) close() {
+	if !w.wroteHeader {
+		// the handler wrote nothing, the default status is written by net/http
+		return
+	}
+	if w.buffering {
+		w.stopBuffering(true)
+	}
+}
@@ -0,0 +1,191 @@
+// Package redact scrubs the credentials and the tokens from the responses of the API, the events and the logs of Meshery
+// Server, since the configurations of designs and components may embed secrets.
+// The values of the fields named like secrets, eg: password or apiKey, of the data and stringData of Kubernetes Secrets
+// and of the environment variables named like secrets are masked, and so are the strings looking like secrets, eg: JWTs,
+// bearer tokens, private keys or the passwords of URLs.
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// Mask replaces the redacted values
+const Mask = "[REDACTED]"
+
+// sensitiveSuffixes are the suffixes of the normalized names of the fields holding secrets, see sensitiveKey
+var sensitiveSuffixes = []string{
+	"password", "passwd", "passphrase", "secret", "token", "apikey", "accesskey", "secretkey", "privatekey",
+	"clientkeydata", "authorization", "cookie",
+}
+
+// insensitiveSuffixes are the suffixes of the names of the fields ending with a sensitive name which hold no secret,
+// eg: tokenType or secretName
+var insensitiveSuffixes = []string{"name", "type", "id", "ref", "expiry", "expiresat", "count", "ttl"}
+
+// patterns match the secrets in free text, which are replaced by their replacement
+var patterns = []struct {
+	re          *regexp.Regexp
+	replacement string
+}{
+	// private keys in PEM
+	{regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`), Mask},
+	// JWTs
+	{regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`), Mask},
+	// the credentials of the Authorization headers
+	{regexp.MustCompile(`(?i)(\b(?:bearer|basic)\s+)[A-Za-z0-9._~+/=-]{8,}`), "${1}" + Mask},
+	// the passwords of the URLs
+	{regexp.MustCompile(`(://[^:/@\s]+:)[^@/\s]+@`), "${1}" + Mask + "@"},
+	// the assignments of secrets, eg: password=... or "client-key-data": ...
+	{regexp.MustCompile(`(?i)(\b(?:password|passwd|secret|token|api[_-]?key|access[_-]?key|secret[_-]?key|private[_-]?key|client[_-]?secret|client[_-]?key[_-]?data)["']?\s*[=:]\s*)(?:"[^"]*"|'[^']*'|[^\s,;&"'}]+)`), "${1}" + Mask},
+	// API tokens of Meshery Server, AWS access key ids, GitHub tokens and Slack tokens
+	{regexp.MustCompile(`\bmshy_[A-Za-z0-9_-]{20,}`), Mask},
+	{regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`), Mask},
+	{regexp.MustCompile(`\bgh[pousr]_[A-Za-z0-9]{36,}\b`), Mask},
+	{regexp.MustCompile(`\bxox[abeoprs]-[A-Za-z0-9-]{10,}`), Mask},
+}
+
+// String returns the text with the secrets it holds masked
+func String(s string) string {
+	for _, p := range patterns {
+		s = p.re.ReplaceAllString(s, p.replacement)
+	}
+	return s
+}
+
+// Value returns the decoded JSON value with the secrets it holds masked, the value is not modified
+func Value(v interface{}) interface{} {
+	redacted, _ := value(v)
+	return redacted
+}
+
+// JSON returns the JSON document with the secrets it holds masked. The document is returned as is when it holds none,
+// and is redacted as text when it is not valid JSON.
+func JSON(b []byte) []byte {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil || dec.More() {
+		return []byte(String(string(b)))
+	}
+	redacted, changed := value(v)
+	if !changed {
+		return b
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(redacted); err != nil {
+		return []byte(String(string(b)))
+	}
+	out := buf.Bytes()
+	// the encoder ends the document with a newline, which is kept only if the document had one
+	if !bytes.HasSuffix(bytes.TrimRight(b, " \t\r"), []byte("\n")) {
+		out = bytes.TrimSuffix(out, []byte("\n"))
+	}
+	return out
+}
+
+// value returns the value with the secrets it holds masked, and whether it held any
+func value(v interface{}) (interface{}, bool) {
+	switch v := v.(type) {
+	case string:
+		s := String(v)
+		return s, s != v
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		changed := false
+		for i, e := range v {
+			var c bool
+			out[i], c = value(e)
+			changed = changed || c
+		}
+		return out, changed
+	case map[string]interface{}:
+		return object(v)
+	}
+	return v, false
+}
+
+// object returns the object with the values of its sensitive fields masked
+func object(m map[string]interface{}) (map[string]interface{}, bool) {
+	out := make(map[string]interface{}, len(m))
+	changed := false
+	kind, _ := m["kind"].(string)
+	envName, _ := m["name"].(string)
+	_, isEnvVar := m["value"]
+	for k, v := range m {
+		switch {
+		case kind == "Secret" && (k == "data" || k == "stringData"):
+			out[k], changed = maskFields(v), true
+		case isEnvVar && k == "value" && sensitiveKey(envName):
+			out[k], changed = mask(v), true
+		case sensitiveKey(k) && !empty(v):
+			out[k], changed = mask(v), true
+		default:
+			var c bool
+			out[k], c = value(v)
+			changed = changed || c
+		}
+	}
+	return out, changed
+}
+
+// maskFields masks the values of the fields of the object, the data of a Kubernetes Secret
+func maskFields(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return mask(v)
+	}
+	out := make(map[string]interface{}, len(m))
+	for k := range m {
+		out[k] = Mask
+	}
+	return out
+}
+
+func mask(v interface{}) interface{} {
+	if empty(v) {
+		return v
+	}
+	return Mask
+}
+
+// empty reports whether the value holds nothing to mask, the booleans telling whether secrets are set
+func empty(v interface{}) bool {
+	switch v := v.(type) {
+	case nil, bool:
+		return true
+	case string:
+		return v == ""
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// sensitiveKey reports whether the field holds a secret given its name, whatever its case and separators
+func sensitiveKey(key string) bool {
+	normalized := strings.Map(func(r rune) rune {
+		switch r {
+		case '-', '_', '.', ' ':
+			return -1
+		}
+		return r
+	}, strings.ToLower(key))
+	for _, suffix := range insensitiveSuffixes {
+		if strings.HasSuffix(normalized, suffix) {
+			return false
+		}
+	}
+	for _, suffix := range sensitiveSuffixes {
+		if strings.HasSuffix(normalized, suffix) {
+			return true
+		}
+	}
+	return false
+}
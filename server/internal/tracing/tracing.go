@@ -0,0 +1,102 @@
+// Package tracing instruments Meshery Server with OpenTelemetry, so that slow requests can be traced end to end: from
+// the HTTP handlers, through the registry and the remote provider, to the Kubernetes API server.
+//
+// Traces are exported over OTLP/gRPC to the collector of OTEL_EXPORTER_OTLP_ENDPOINT, tracing is disabled when the
+// endpoint is not set. The exporter and the sampler are configured with the standard OpenTelemetry environment
+// variables, eg: OTEL_EXPORTER_OTLP_INSECURE, OTEL_EXPORTER_OTLP_HEADERS, OTEL_TRACES_SAMPLER and OTEL_TRACES_SAMPLER_ARG.
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// EndpointEnv is the environment variable of the OTLP endpoint traces are exported to
+const EndpointEnv = "OTEL_EXPORTER_OTLP_ENDPOINT"
+
+const instrumentationName = "github.com/layer5io/meshery/server"
+
+// Init installs the global tracer provider and the W3C trace context propagator. The returned function flushes the
+// pending spans and stops the exporter, it has to be called before Meshery Server exits.
+func Init(ctx context.Context, serviceName, version string) (func(context.Context) error, error) {
+	// the propagator is installed even without an exporter, so that the trace context of the requests keeps being propagated
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if os.Getenv(EndpointEnv) == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(version),
+	))
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of Meshery Server
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Start starts a span named name, child of the span of ctx if any
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records err on the span, if not nil, and ends the span
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Middleware starts a span for every request of the routes of the router it is used by, continuing the trace of the
+// caller when the request carries a trace context. Spans are named after the method and the path template of the route,
+// eg: GET /api/pattern/{id}.
+func Middleware(next http.Handler) http.Handler {
+	return otelhttp.NewHandler(next, "http.server", otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+		if route := mux.CurrentRoute(r); route != nil {
+			if tmpl, err := route.GetPathTemplate(); err == nil {
+				return r.Method + " " + tmpl
+			}
+		}
+		return r.Method
+	}))
+}
+
+// Transport returns a round tripper starting a client span for every request sent through base and injecting the trace
+// context in the request headers. A nil base uses http.DefaultTransport.
+func Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return otelhttp.NewTransport(base)
+}
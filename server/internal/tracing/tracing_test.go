@@ -0,0 +1,48 @@
+package tracing
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMiddleware(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	var handlerSpan trace.SpanContext
+	r := mux.NewRouter()
+	r.Use(Middleware)
+	r.HandleFunc("/api/pattern/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_, span := Start(r.Context(), "registry.GetEntities")
+		handlerSpan = span.SpanContext()
+		End(span, nil)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/pattern/0e3fa1c2", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("got %d spans, want the span of the request and the one of the handler", len(spans))
+	}
+	server := spans[1]
+	if server.Name() != "GET /api/pattern/{id}" {
+		t.Errorf("span name = %q, want the method and the route template", server.Name())
+	}
+	if got := server.SpanContext().TraceID().String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("trace ID = %s, want the one of the caller", got)
+	}
+	if handlerSpan.TraceID() != server.SpanContext().TraceID() || spans[0].Parent().SpanID() != server.SpanContext().SpanID() {
+		t.Errorf("the span of the handler is not a child of the span of the request")
+	}
+}
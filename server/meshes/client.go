@@ -2,11 +2,22 @@ package meshes
 
 import (
 	context "context"
+	"crypto/tls"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// transportCredentials secure the connections to the adapters, which are not encrypted unless UseTLS is called
+var transportCredentials = insecure.NewCredentials()
+
+// UseTLS makes the clients created afterwards connect to the adapters over TLS, eg: mutual TLS with the client
+// configuration of mtls.Source
+func UseTLS(config *tls.Config) {
+	transportCredentials = credentials.NewTLS(config)
+}
+
 // MeshClient represents a gRPC adapter client
 type MeshClient struct {
 	MClient MeshServiceClient
@@ -16,14 +27,7 @@ type MeshClient struct {
 // CreateClient creates a MeshClient for the given params
 func CreateClient(_ context.Context, meshLocationURL string) (*MeshClient, error) {
 	var opts []grpc.DialOption
-	// creds, err := credentials.NewClientTLSFromFile(*caFile, *serverHostOverride)
-	// 	if err != nil {
-	// 		logrus.Errorf("Failed to create TLS credentials %v", err)
-	// 	}
-	// 	opts = append(opts, grpc.WithTransportCredentials(creds))
-	// } else {
-	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	// }
+	opts = append(opts, grpc.WithTransportCredentials(transportCredentials))
 	conn, err := grpc.Dial(meshLocationURL, opts...)
 	if err != nil {
 		return nil, err
@@ -7,9 +7,16 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/ghodss/yaml"
 	"github.com/layer5io/meshery/server/helpers/utils"
 	"github.com/layer5io/meshery/server/models"
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
 	"github.com/layer5io/meshkit/logger"
 	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
 	meshmodel "github.com/layer5io/meshkit/models/meshmodel/registry"
@@ -21,22 +28,20 @@ var ModelsPath = "../meshmodel"
 var RelativeRelationshipsPath = "relationships"
 
 type EntityRegistrationHelper struct {
-	handlerConfig    *models.HandlerConfig
-	regManager       *meshmodel.RegistryManager
-	componentChan    chan v1alpha1.ComponentDefinition
-	relationshipChan chan v1alpha1.RelationshipDefinition
-	errorChan        chan error
-	log              logger.Handler
+	handlerConfig *models.HandlerConfig
+	regManager    *meshmodel.RegistryManager
+	componentChan chan v1alpha1.ComponentDefinition
+	errorChan     chan error
+	log           logger.Handler
 }
 
 func NewEntityRegistrationHelper(hc *models.HandlerConfig, rm *meshmodel.RegistryManager, log logger.Handler) *EntityRegistrationHelper {
 	return &EntityRegistrationHelper{
-		handlerConfig:    hc,
-		regManager:       rm,
-		componentChan:    make(chan v1alpha1.ComponentDefinition, 1),
-		relationshipChan: make(chan v1alpha1.RelationshipDefinition, 1),
-		errorChan:        make(chan error),
-		log:              log,
+		handlerConfig: hc,
+		regManager:    rm,
+		componentChan: make(chan v1alpha1.ComponentDefinition, 1),
+		errorChan:     make(chan error),
+		log:           log,
 	}
 }
 
@@ -81,8 +86,18 @@ func (erh *EntityRegistrationHelper) SeedComponents() {
 		}
 	}
 
+	// A malformed definition must not prevent the remaining relationships from being registered,
+	// failures are collected across all the models and reported once seeding completes.
+	parseErrs := &RelationshipParseErrors{}
+	files := make([]string, 0)
 	for _, relationship := range relationships {
-		erh.generateRelationships(relationship)
+		files = append(files, findRelationshipFiles(relationship, parseErrs)...)
+	}
+	start := time.Now()
+	registered := erh.registerRelationshipFiles(files, parseErrs)
+	erh.log.Info(fmt.Sprintf("registered %d of %d static relationships in %s", registered, len(files), time.Since(start)))
+	if parseErrs.Len() > 0 {
+		erh.log.Warn(errors.Wrapf(parseErrs, "%d static relationship definitions could not be registered", parseErrs.Len()))
 	}
 }
 
@@ -104,12 +119,12 @@ func (erh *EntityRegistrationHelper) generateComponents(pathToComponents string)
 			var comp v1alpha1.ComponentDefinition
 			byt, err := os.ReadFile(path)
 			if err != nil {
-				erh.errorChan <- errors.Wrapf(err, fmt.Sprintf("unable to read file at %s", path))
+				erh.errorChan <- errors.Wrapf(err, "unable to read file at %s", path)
 				return nil
 			}
 			err = json.Unmarshal(byt, &comp)
 			if err != nil {
-				erh.errorChan <- errors.Wrapf(err, fmt.Sprintf("unmarshal json failed for %s", path))
+				erh.errorChan <- errors.Wrapf(err, "unmarshal json failed for %s", path)
 				return nil
 			}
 			// Only register components that have been marked as published
@@ -126,37 +141,106 @@ func (erh *EntityRegistrationHelper) generateComponents(pathToComponents string)
 	}
 }
 
-// reads relationship definitions from files and sends them to the relationship channel
-func (erh *EntityRegistrationHelper) generateRelationships(pathToComponents string) {
+// returns the relationship definition files under pathToComponents, errors walking the directory are recorded in parseErrs
+func findRelationshipFiles(pathToComponents string, parseErrs *RelationshipParseErrors) []string {
 	path, err := filepath.Abs(pathToComponents)
 	if err != nil {
-		erh.errorChan <- errors.Wrapf(err, "error while getting absolute path for generating relationships")
-		return
+		parseErrs.Add(pathToComponents, errors.Wrapf(err, "error while getting absolute path for generating relationships"))
+		return nil
 	}
 
+	files := make([]string, 0)
 	err = filepath.Walk(path, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			parseErrs.Add(path, err)
+			return nil
+		}
 		if info == nil {
 			return nil
 		}
-		if !info.IsDir() {
-			var rel v1alpha1.RelationshipDefinition
-			byt, err := os.ReadFile(path)
-			if err != nil {
-				erh.errorChan <- errors.Wrapf(err, fmt.Sprintf("unable to read file at %s", path))
-				return nil
-			}
-			err = json.Unmarshal(byt, &rel)
-			if err != nil {
-				erh.errorChan <- errors.Wrapf(err, fmt.Sprintf("unmarshal json failed for %s", path))
-				return nil
-			}
-			erh.relationshipChan <- rel
+		if !info.IsDir() && isRelationshipFile(path) {
+			files = append(files, path)
 		}
 		return nil
 	})
 	if err != nil {
-		erh.errorChan <- errors.Wrapf(err, "error while generating relationships")
+		parseErrs.Add(path, errors.Wrapf(err, "error while generating relationships"))
 	}
+	return files
+}
+
+// parses and registers the relationship definition files using a bounded pool of workers, as model repositories may hold thousands of files.
+// Files which cannot be parsed or registered are recorded in parseErrs and skipped, returns the number of definitions registered.
+func (erh *EntityRegistrationHelper) registerRelationshipFiles(files []string, parseErrs *RelationshipParseErrors) int {
+	workers := runtime.NumCPU()
+	if workers > len(files) {
+		workers = len(files)
+	}
+
+	var registered int64
+	paths := make(chan string)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for path := range paths {
+				rel, err := parseRelationshipFile(path)
+				if err != nil {
+					parseErrs.Add(path, err)
+					continue
+				}
+				err = erh.regManager.RegisterEntity(meshmodel.Host{
+					Hostname: ArtifactHubComponentsHandler.String(),
+				}, rel)
+				if err != nil {
+					parseErrs.Add(path, errors.Wrapf(err, "unable to register relationship from %s", path))
+					continue
+				}
+				atomic.AddInt64(&registered, 1)
+			}
+		}()
+	}
+	for _, path := range files {
+		paths <- path
+	}
+	close(paths)
+	wg.Wait()
+	return int(registered)
+}
+
+// reports whether the file at path holds a relationship definition, definitions can be authored in JSON or YAML
+func isRelationshipFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json", ".yaml", ".yml":
+		return true
+	}
+	return false
+}
+
+// reads the relationship definition from the file at path, the format is detected from the file extension
+func parseRelationshipFile(path string) (v1alpha1.RelationshipDefinition, error) {
+	var rel v1alpha1.RelationshipDefinition
+	byt, err := os.ReadFile(path)
+	if err != nil {
+		return rel, errors.Wrapf(err, "unable to read file at %s", path)
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(byt, &rel)
+		if err != nil {
+			return rel, errors.Wrapf(err, "unmarshal yaml failed for %s", path)
+		}
+	default:
+		err = json.Unmarshal(byt, &rel)
+		if err != nil {
+			return rel, errors.Wrapf(err, "unmarshal json failed for %s", path)
+		}
+	}
+	// the static relationships are global, whatever organization their files name
+	mesherymeshmodel.SetRelationshipOrg(&rel, "")
+	mesherymeshmodel.SetRelationshipSource(&rel, mesherymeshmodel.RelationshipSourceStatic)
+	return rel, nil
 }
 
 // watches the component and relationship channels for incoming definitions and registers them with the registry manager
@@ -169,23 +253,63 @@ func (erh *EntityRegistrationHelper) watchComponents(ctx context.Context) {
 			err = erh.regManager.RegisterEntity(meshmodel.Host{
 				Hostname: ArtifactHubComponentsHandler.String(),
 			}, comp)
-		case rel := <-erh.relationshipChan:
-			err = erh.regManager.RegisterEntity(meshmodel.Host{
-				Hostname: ArtifactHubComponentsHandler.String(),
-			}, rel)
-
 		//Watching and logging errors from error channel
 		case mhErr := <-erh.errorChan:
-			if err != nil {
-				erh.log.Error(mhErr)
-			}
+			erh.log.Error(mhErr)
 
 		case <-ctx.Done():
 			return
 		}
 
+		// this goroutine is the only receiver on the error channel, so registration errors are logged directly
 		if err != nil {
-			erh.errorChan <- err
+			erh.log.Error(err)
+			err = nil
 		}
 	}
 }
+
+// RelationshipParseErrors collects the relationship definition files which could not be parsed or registered, keyed by their path.
+// It is safe for concurrent use.
+type RelationshipParseErrors struct {
+	mx    sync.Mutex
+	paths []string
+	errs  map[string]error
+}
+
+// Add records the error encountered while parsing the file at path
+func (e *RelationshipParseErrors) Add(path string, err error) {
+	e.mx.Lock()
+	defer e.mx.Unlock()
+	if e.errs == nil {
+		e.errs = make(map[string]error)
+	}
+	if _, ok := e.errs[path]; !ok {
+		e.paths = append(e.paths, path)
+	}
+	e.errs[path] = err
+}
+
+// Len returns the number of files which could not be parsed
+func (e *RelationshipParseErrors) Len() int {
+	e.mx.Lock()
+	defer e.mx.Unlock()
+	return len(e.paths)
+}
+
+// Files returns the paths of the files which could not be parsed, in the order they were encountered
+func (e *RelationshipParseErrors) Files() []string {
+	e.mx.Lock()
+	defer e.mx.Unlock()
+	return append([]string(nil), e.paths...)
+}
+
+func (e *RelationshipParseErrors) Error() string {
+	e.mx.Lock()
+	defer e.mx.Unlock()
+	msgs := make([]string, 0, len(e.paths))
+	for _, path := range e.paths {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", path, e.errs[path]))
+	}
+	return strings.Join(msgs, "; ")
+}
@@ -0,0 +1,107 @@
+package meshmodel
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/layer5io/meshery/server/models"
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+	meshmodel "github.com/layer5io/meshkit/models/meshmodel/registry"
+)
+
+const (
+	edgeRelationshipJSON = `{"kind": "Edge", "subType": "Network", "model": {"name": "kubernetes", "version": "v1.25.2"},
+	"selectors": {"allow": {"from": [{"kind": "Service", "model": "kubernetes"}], "to": [{"kind": "Pod", "model": "kubernetes"}]}}}`
+	hierarchicalRelationshipYAML = `kind: Hierarchical
+subType: Parent
+model:
+  name: kubernetes
+  version: v1.25.2
+selectors:
+  allow:
+    from:
+      - kind: Pod
+        model: kubernetes
+    to:
+      - kind: Namespace
+        model: kubernetes
+`
+)
+
+// testRegistrationHelper returns a registration helper over a SQLite registry
+func testRegistrationHelper(t *testing.T) (*EntityRegistrationHelper, *database.Handler) {
+	t.Helper()
+	db, err := database.New(database.Options{Filename: filepath.Join(t.TempDir(), "mesherydb.sql"), Engine: database.SQLITE})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&mesherymeshmodel.RelationshipRevision{}); err != nil {
+		t.Fatal(err)
+	}
+	regManager, err := meshmodel.NewRegistryManager(&db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log, err := logger.New("meshery-test", logger.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hc := &models.HandlerConfig{MeshModelEventsChannel: mesherymeshmodel.NewRegistryEventsChannel()}
+	return NewEntityRegistrationHelper(hc, regManager, log), &db
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestParseRelationshipFile(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"edge.json": edgeRelationshipJSON, "hierarchical.yaml": hierarchicalRelationshipYAML, "hierarchical.YML": hierarchicalRelationshipYAML})
+
+	for name, kind := range map[string]string{"edge.json": "Edge", "hierarchical.yaml": "Hierarchical", "hierarchical.YML": "Hierarchical"} {
+		rel, err := parseRelationshipFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Errorf("parseRelationshipFile(%s) = %v", name, err)
+			continue
+		}
+		if rel.Kind != kind || rel.Model.Name != "kubernetes" || mesherymeshmodel.RelationshipSource(rel.Metadata) != mesherymeshmodel.RelationshipSourceStatic {
+			t.Errorf("parseRelationshipFile(%s) = %+v, want a static %s relationship of kubernetes", name, rel, kind)
+		}
+	}
+}
+
+func TestRegisterRelationshipFiles(t *testing.T) {
+	erh, db := testRegistrationHelper(t)
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"edge.json":         edgeRelationshipJSON,
+		"hierarchical.yaml": hierarchicalRelationshipYAML,
+		"broken.json":       `{"kind": `,
+		"broken.yaml":       "kind: [",
+		"README.md":         "not a relationship",
+	})
+
+	parseErrs := &RelationshipParseErrors{}
+	files := findRelationshipFiles(dir, parseErrs)
+	if len(files) != 4 {
+		t.Fatalf("the relationship files are %v, want the json and yaml files", files)
+	}
+	if registered := erh.registerRelationshipFiles(files, parseErrs); registered != 2 {
+		t.Errorf("%d relationships were registered, want the 2 valid ones", registered)
+	}
+	if parseErrs.Len() != 2 {
+		t.Errorf("the broken files are %v, want broken.json and broken.yaml", parseErrs.Files())
+	}
+	var count int64
+	if err := db.Table("relationship_definition_dbs").Count(&count).Error; err != nil || count != 2 {
+		t.Errorf("%d relationships are stored, want 2: %v", count, err)
+	}
+}
@@ -0,0 +1,15 @@
+// Package kubernetes embeds the relationship evaluation policies and the relationship definitions of the Kubernetes
+// model, so that designs can be evaluated without the files of Meshery Server, eg: by mesheryctl.
+package kubernetes
+
+import "embed"
+
+// Policies holds the Rego modules of the relationship evaluation policies, under policies/
+//
+//go:embed policies/*.rego
+var Policies embed.FS
+
+// Relationships holds the relationship definitions the policies are evaluated with, under relationships/
+//
+//go:embed relationships/*.json
+var Relationships embed.FS
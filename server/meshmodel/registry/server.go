@@ -0,0 +1,290 @@
+package registry
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	meshmodel "github.com/layer5io/meshkit/models/meshmodel/registry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Server exposes the registry manager over gRPC, so that adapters and external registrants can push
+// components and relationships without going through the JSON API.
+// Relationships are validated and evaluated against the relationship policies exactly like the ones registered over HTTP.
+type Server struct {
+	UnimplementedRegistryServiceServer
+
+	regManager *meshmodel.RegistryManager
+	dbHandler  *database.Handler
+	events     *mesherymeshmodel.RegistryEventsChannel
+	log        logger.Handler
+	tlsConfig  *tls.Config
+}
+
+func NewServer(rm *meshmodel.RegistryManager, db *database.Handler, events *mesherymeshmodel.RegistryEventsChannel, log logger.Handler) *Server {
+	return &Server{
+		regManager: rm,
+		dbHandler:  db,
+		events:     events,
+		log:        log,
+	}
+}
+
+// UseTLS makes the registry served over TLS, eg: mutual TLS with the server configuration of mtls.Source, so that only
+// the trusted registrants can register entities
+func (s *Server) UseTLS(config *tls.Config) *Server {
+	s.tlsConfig = config
+	return s
+}
+
+// Start serves the registry on the given address until ctx is cancelled
+func (s *Server) Start(ctx context.Context, addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	var opts []grpc.ServerOption
+	if s.tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(s.tlsConfig)))
+	}
+	srv := grpc.NewServer(opts...)
+	RegisterRegistryServiceServer(srv, s)
+	go func() {
+		<-ctx.Done()
+		srv.GracefulStop()
+	}()
+	s.log.Info("Meshery registry gRPC server listening on: ", addr)
+	return srv.Serve(lis)
+}
+
+// RegisterEntity registers the component or relationship definition on behalf of the host.
+// A relationship identical to a registered one is not registered again, so registrants can push their definitions on every start.
+func (s *Server) RegisterEntity(ctx context.Context, req *RegisterEntityRequest) (*RegisterEntityResponse, error) {
+	host := meshmodel.Host{
+		Hostname: req.GetHost().GetHostname(),
+		Port:     int(req.GetHost().GetPort()),
+		Metadata: req.GetHost().GetMetadata(),
+	}
+	if host.Hostname == "" {
+		return nil, status.Error(codes.InvalidArgument, "hostname of the registrant cannot be empty")
+	}
+
+	switch def := req.GetEntity().GetDefinition().(type) {
+	case *Entity_Component:
+		comp := toComponentDefinition(def.Component)
+		if err := s.regManager.RegisterEntity(host, comp); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		s.events.Publish(mesherymeshmodel.RegistryEvent{
+			Action:       mesherymeshmodel.RegistryEventRegistered,
+			EntityType:   mesherymeshmodel.RegistryEntityComponent,
+			Kind:         comp.Kind,
+			Model:        comp.Model.Name,
+			ModelVersion: comp.Model.Version,
+		})
+	case *Entity_Relationship:
+		rel := toRelationshipDefinition(def.Relationship)
+		if err := s.registerRelationship(ctx, host, rel); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "entity must be a component or a relationship definition")
+	}
+	return &RegisterEntityResponse{}, nil
+}
+
+func (s *Server) registerRelationship(ctx context.Context, host meshmodel.Host, rel v1alpha1.RelationshipDefinition) error {
+	// registrants are not members of an organization, the relationships they register are global
+	mesherymeshmodel.SetRelationshipOrg(&rel, "")
+	if err := mesherymeshmodel.ValidateRelationshipDefinition(rel); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	evaluator, err := mesherymeshmodel.NewRelationshipPolicyEvaluator(ctx, s.dbHandler)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err := evaluator.Evaluate(ctx, rel); err != nil {
+		if _, ok := err.(*mesherymeshmodel.RelationshipPolicyError); ok {
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+	existing, err := mesherymeshmodel.FindDuplicateRelationship(s.dbHandler, rel)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	if existing != nil {
+		s.log.Debug("relationship ", rel.Kind, " of model ", rel.Model.Name, " is already registered")
+		return nil
+	}
+
+	mesherymeshmodel.SetRelationshipSource(&rel, mesherymeshmodel.RelationshipSourceAdapter)
+	if err := s.regManager.RegisterEntity(host, rel); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	s.events.Publish(mesherymeshmodel.RegistryEvent{
+		Action:       mesherymeshmodel.RegistryEventRegistered,
+		EntityType:   mesherymeshmodel.RegistryEntityRelationship,
+		Kind:         rel.Kind,
+		SubType:      rel.SubType,
+		Model:        rel.Model.Name,
+		ModelVersion: rel.Model.Version,
+	})
+	return nil
+}
+
+// GetEntities streams the registered components or relationships matching the request along with their registrant.
+// Only the global relationships are streamed, the relationships of the organizations are not visible to registrants.
+func (s *Server) GetEntities(req *GetEntitiesRequest, stream RegistryService_GetEntitiesServer) error {
+	var entities []meshmodel.Entity
+	switch req.GetType() {
+	case EntityType_COMPONENT:
+		entities, _, _ = s.regManager.GetEntities(&v1alpha1.ComponentFilter{
+			Name:      req.GetKind(),
+			ModelName: req.GetModel(),
+			Version:   req.GetVersion(),
+		})
+	case EntityType_RELATIONSHIP:
+		scoped := mesherymeshmodel.OrgScopedRegistry{EntitiesGetter: s.regManager, DB: s.dbHandler, Log: s.log}
+		entities, _, _ = scoped.GetEntities(&mesherymeshmodel.OrgRelationshipFilter{
+			RelationshipFilter: v1alpha1.RelationshipFilter{
+				Kind:      req.GetKind(),
+				SubType:   req.GetSubType(),
+				ModelName: req.GetModel(),
+				Version:   req.GetVersion(),
+			},
+		})
+	default:
+		return status.Errorf(codes.InvalidArgument, "unsupported entity type %s", req.GetType())
+	}
+
+	for _, en := range entities {
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		msg := &Entity{Registrant: s.regManager.GetRegistrant(en).Hostname}
+		switch def := en.(type) {
+		case v1alpha1.ComponentDefinition:
+			comp, err := fromComponentDefinition(def)
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			msg.Definition = &Entity_Component{Component: comp}
+		case v1alpha1.RelationshipDefinition:
+			rel, err := fromRelationshipDefinition(def)
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			msg.Definition = &Entity_Relationship{Relationship: rel}
+		default:
+			continue
+		}
+		if err := stream.Send(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toModel(m *Model) v1alpha1.Model {
+	return v1alpha1.Model{
+		Name:        m.GetName(),
+		Version:     m.GetVersion(),
+		DisplayName: m.GetDisplayName(),
+		Category:    v1alpha1.Category{Name: m.GetCategory()},
+		Metadata:    m.GetMetadata().AsMap(),
+	}
+}
+
+func toComponentDefinition(c *ComponentDefinition) v1alpha1.ComponentDefinition {
+	return v1alpha1.ComponentDefinition{
+		TypeMeta: v1alpha1.TypeMeta{
+			Kind:       c.GetKind(),
+			APIVersion: c.GetApiVersion(),
+		},
+		DisplayName: c.GetDisplayName(),
+		Format:      v1alpha1.ComponentFormat(c.GetFormat()),
+		Model:       toModel(c.GetModel()),
+		Metadata:    c.GetMetadata().AsMap(),
+		Schema:      c.GetSchema(),
+	}
+}
+
+func toRelationshipDefinition(r *RelationshipDefinition) v1alpha1.RelationshipDefinition {
+	return v1alpha1.RelationshipDefinition{
+		TypeMeta: v1alpha1.TypeMeta{
+			Kind:       r.GetKind(),
+			APIVersion: r.GetApiVersion(),
+		},
+		Model:     toModel(r.GetModel()),
+		SubType:   r.GetSubType(),
+		Metadata:  r.GetMetadata().AsMap(),
+		Selectors: r.GetSelectors().AsMap(),
+	}
+}
+
+func fromModel(m v1alpha1.Model) (*Model, error) {
+	metadata, err := structpb.NewStruct(m.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	return &Model{
+		Name:        m.Name,
+		Version:     m.Version,
+		DisplayName: m.DisplayName,
+		Category:    m.Category.Name,
+		Metadata:    metadata,
+	}, nil
+}
+
+func fromComponentDefinition(c v1alpha1.ComponentDefinition) (*ComponentDefinition, error) {
+	model, err := fromModel(c.Model)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := structpb.NewStruct(c.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	return &ComponentDefinition{
+		Kind:        c.Kind,
+		ApiVersion:  c.APIVersion,
+		DisplayName: c.DisplayName,
+		Format:      string(c.Format),
+		Model:       model,
+		Metadata:    metadata,
+		Schema:      c.Schema,
+	}, nil
+}
+
+func fromRelationshipDefinition(r v1alpha1.RelationshipDefinition) (*RelationshipDefinition, error) {
+	model, err := fromModel(r.Model)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := structpb.NewStruct(r.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	selectors, err := structpb.NewStruct(r.Selectors)
+	if err != nil {
+		return nil, err
+	}
+	return &RelationshipDefinition{
+		Kind:       r.Kind,
+		ApiVersion: r.APIVersion,
+		SubType:    r.SubType,
+		Model:      model,
+		Metadata:   metadata,
+		Selectors:  selectors,
+	}, nil
+}
@@ -0,0 +1,159 @@
+package registry
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	meshmodel "github.com/layer5io/meshkit/models/meshmodel/registry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// testServer returns a registry server over a SQLite registry
+func testServer(t *testing.T) *Server {
+	t.Helper()
+	db, err := database.New(database.Options{Filename: filepath.Join(t.TempDir(), "mesherydb.sql"), Engine: database.SQLITE})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&mesherymeshmodel.RelationshipPolicy{}); err != nil {
+		t.Fatal(err)
+	}
+	rm, err := meshmodel.NewRegistryManager(&db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log, err := logger.New("meshery-test", logger.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewServer(rm, &db, mesherymeshmodel.NewRegistryEventsChannel(), log)
+}
+
+func relationshipRequest(t *testing.T, hostname, subType string, metadata map[string]interface{}) *RegisterEntityRequest {
+	t.Helper()
+	selector := func(kind string) interface{} {
+		return map[string]interface{}{"kind": kind, "model": "kubernetes"}
+	}
+	selectors, err := structpb.NewStruct(map[string]interface{}{
+		"allow": map[string]interface{}{"from": []interface{}{selector("Service")}, "to": []interface{}{selector("Pod")}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	md, err := structpb.NewStruct(metadata)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &RegisterEntityRequest{
+		Host: &Host{Hostname: hostname},
+		Entity: &Entity{Definition: &Entity_Relationship{Relationship: &RelationshipDefinition{
+			Kind:      "Edge",
+			SubType:   subType,
+			Model:     &Model{Name: "kubernetes", Version: "v1.25.2", Category: "Orchestration & Management"},
+			Metadata:  md,
+			Selectors: selectors,
+		}}},
+	}
+}
+
+// entityStream collects the entities streamed by GetEntities
+type entityStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	entities []*Entity
+}
+
+func (s *entityStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *entityStream) Send(e *Entity) error {
+	s.entities = append(s.entities, e)
+	return nil
+}
+
+func TestRegisterEntity(t *testing.T) {
+	s := testServer(t)
+	ctx := context.Background()
+
+	if _, err := s.RegisterEntity(ctx, relationshipRequest(t, "", "Network", nil)); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("the registration without a registrant returned %v, want InvalidArgument", err)
+	}
+	invalid := relationshipRequest(t, "meshery-istio", "Network", nil)
+	invalid.Entity.GetRelationship().Selectors, _ = structpb.NewStruct(map[string]interface{}{"allow": map[string]interface{}{"from": []interface{}{"Service"}}})
+	if _, err := s.RegisterEntity(ctx, invalid); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("the registration of an invalid relationship returned %v, want InvalidArgument", err)
+	}
+
+	// registrants are not members of an organization, the organization their definitions name is dropped
+	for i := 0; i < 2; i++ {
+		if _, err := s.RegisterEntity(ctx, relationshipRequest(t, "meshery-istio", "Network", map[string]interface{}{"orgID": "org-a"})); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rels, count, err := mesherymeshmodel.GetOrgRelationships(s.dbHandler, mesherymeshmodel.OrgRelationshipFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Fatalf("%d global relationships are registered, want the relationship registered once", count)
+	}
+	if source := mesherymeshmodel.RelationshipSource(rels[0].Metadata); source != mesherymeshmodel.RelationshipSourceAdapter {
+		t.Errorf("the relationship is registered from %q, want %q", source, mesherymeshmodel.RelationshipSourceAdapter)
+	}
+
+	policy := mesherymeshmodel.RelationshipPolicy{Name: "no-mount", Module: `package meshery.relationships
+
+deny[msg] {
+	input.subType == "Mount"
+	msg := "relationships of subType Mount are not allowed"
+}`}
+	if err := mesherymeshmodel.SaveRelationshipPolicy(s.dbHandler, &policy); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.RegisterEntity(ctx, relationshipRequest(t, "meshery-istio", "Mount", nil)); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("the registration of a relationship violating a policy returned %v, want PermissionDenied", err)
+	}
+}
+
+func TestGetEntities(t *testing.T) {
+	s := testServer(t)
+	if _, err := s.RegisterEntity(context.Background(), relationshipRequest(t, "meshery-istio", "Network", nil)); err != nil {
+		t.Fatal(err)
+	}
+	rel := v1alpha1.RelationshipDefinition{
+		TypeMeta: v1alpha1.TypeMeta{Kind: "Edge"},
+		Model:    v1alpha1.Model{Name: "kubernetes", Version: "v1.25.2", Category: v1alpha1.Category{Name: "Orchestration & Management"}},
+		SubType:  "Mount",
+		Metadata: map[string]interface{}{},
+	}
+	mesherymeshmodel.SetRelationshipOrg(&rel, "org-a")
+	if err := s.regManager.RegisterEntity(meshmodel.Host{Hostname: "kubernetes"}, rel); err != nil {
+		t.Fatal(err)
+	}
+
+	stream := &entityStream{ctx: context.Background()}
+	if err := s.GetEntities(&GetEntitiesRequest{Type: EntityType_RELATIONSHIP, Model: "kubernetes"}, stream); err != nil {
+		t.Fatal(err)
+	}
+	if len(stream.entities) != 1 {
+		t.Fatalf("%d relationships were streamed, want the global one only", len(stream.entities))
+	}
+	if got := stream.entities[0]; got.GetRelationship().GetSubType() != "Network" || got.GetRegistrant() != "meshery-istio" {
+		t.Errorf("the relationship streamed is %s registered by %s, want the Network relationship of meshery-istio", got.GetRelationship().GetSubType(), got.GetRegistrant())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := s.GetEntities(&GetEntitiesRequest{Type: EntityType_RELATIONSHIP}, &entityStream{ctx: ctx}); status.Code(err) != codes.Canceled {
+		t.Errorf("the stream of a cancelled request returned %v, want Canceled", err)
+	}
+}
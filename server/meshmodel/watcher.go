@@ -0,0 +1,222 @@
+package meshmodel
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	meshmodel "github.com/layer5io/meshkit/models/meshmodel/registry"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// Editors and copy operations emit several events for a single file, registration waits for the file to settle.
+const relationshipWatchDebounce = 500 * time.Millisecond
+
+// WatchRelationships watches the static relationship directories of every model for new or modified definitions and
+// registers them without requiring a server restart. The models added after startup, and the relationship directories
+// added to the existing models, are watched as soon as they are created.
+// A definition already registered for the same model, kind and subType is replaced by the one on the file system.
+// Watching stops when ctx is cancelled.
+func (erh *EntityRegistrationHelper) WatchRelationships(ctx context.Context, dbHandler *database.Handler) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return errors.Wrap(err, "unable to create watcher for static relationships")
+	}
+
+	modelsDir, err := filepath.Abs(ModelsPath)
+	if err != nil {
+		_ = watcher.Close()
+		return errors.Wrapf(err, "error while getting absolute path for watching relationships")
+	}
+	models, err := os.ReadDir(modelsDir)
+	if err != nil {
+		_ = watcher.Close()
+		return errors.Wrapf(err, "error while reading directory for watching relationships")
+	}
+	// the models directory itself is watched for the models added after startup
+	erh.watchDir(watcher, modelsDir)
+	for _, model := range models {
+		if model.IsDir() {
+			erh.watchModelDir(watcher, filepath.Join(modelsDir, model.Name()))
+		}
+	}
+
+	go func() {
+		defer watcher.Close()
+
+		var mx sync.Mutex
+		pending := make(map[string]*time.Timer)
+		register := func(path string) {
+			mx.Lock()
+			defer mx.Unlock()
+			if t, ok := pending[path]; ok {
+				t.Stop()
+			}
+			pending[path] = time.AfterFunc(relationshipWatchDebounce, func() {
+				mx.Lock()
+				delete(pending, path)
+				mx.Unlock()
+				if err := erh.registerRelationshipFile(dbHandler, path); err != nil {
+					erh.log.Error(err)
+					return
+				}
+				go erh.handlerConfig.MeshModelSummaryChannel.Publish()
+			})
+		}
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Write) {
+					continue
+				}
+				path := event.Name
+				if event.Has(fsnotify.Create) {
+					if info, err := os.Stat(path); err == nil && info.IsDir() {
+						// the files of a directory moved or copied in are there before it is watched
+						for _, file := range erh.watchNewDir(watcher, modelsDir, path) {
+							register(file)
+						}
+						continue
+					}
+				}
+				if isWatchedRelationshipFile(modelsDir, path) {
+					register(path)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				erh.log.Error(errors.Wrap(err, "error while watching static relationships"))
+			case <-ctx.Done():
+				mx.Lock()
+				for _, t := range pending {
+					t.Stop()
+				}
+				mx.Unlock()
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (erh *EntityRegistrationHelper) watchDir(watcher *fsnotify.Watcher, dir string) bool {
+	if err := watcher.Add(dir); err != nil {
+		erh.log.Error(errors.Wrapf(err, "unable to watch %s for static relationships", dir))
+		return false
+	}
+	erh.log.Debug("watching for static relationships at ", dir)
+	return true
+}
+
+// watches the directory of a model for the creation of its relationship directory, and the relationship directory when it
+// exists. The relationship files already in it are returned.
+func (erh *EntityRegistrationHelper) watchModelDir(watcher *fsnotify.Watcher, modelDir string) []string {
+	erh.watchDir(watcher, modelDir)
+	dir := filepath.Join(modelDir, RelativeRelationshipsPath)
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return nil
+	}
+	if !erh.watchDir(watcher, dir) {
+		return nil
+	}
+	return relationshipFilesIn(dir)
+}
+
+// watches the directory created at path when it is a model or a relationship directory, and returns the relationship
+// files already in it
+func (erh *EntityRegistrationHelper) watchNewDir(watcher *fsnotify.Watcher, modelsDir, path string) []string {
+	switch {
+	case filepath.Dir(path) == modelsDir:
+		return erh.watchModelDir(watcher, path)
+	case filepath.Dir(filepath.Dir(path)) == modelsDir && filepath.Base(path) == RelativeRelationshipsPath:
+		if erh.watchDir(watcher, path) {
+			return relationshipFilesIn(path)
+		}
+	}
+	return nil
+}
+
+// reports whether path is a relationship file of the relationship directory of one of the models of modelsDir, the
+// other files of the watched model directories are not relationships
+func isWatchedRelationshipFile(modelsDir, path string) bool {
+	dir := filepath.Dir(path)
+	return isRelationshipFile(path) && filepath.Base(dir) == RelativeRelationshipsPath && filepath.Dir(filepath.Dir(dir)) == modelsDir
+}
+
+// returns the relationship files directly in dir
+func relationshipFilesIn(dir string) []string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() && isRelationshipFile(entry.Name()) {
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	return files
+}
+
+// registers the relationship definition at path, replacing the definition previously registered from it
+func (erh *EntityRegistrationHelper) registerRelationshipFile(dbHandler *database.Handler, path string) error {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		// The file was removed or renamed before it settled
+		return nil
+	}
+	rel, err := parseRelationshipFile(path)
+	if err != nil {
+		return err
+	}
+
+	// the relationships of the watched directories are global
+	existing, err := mesherymeshmodel.FindRelationship(dbHandler, rel.Model.Name, rel.Kind, rel.Model.Version, rel.SubType, "")
+	switch {
+	case err == nil:
+		byt, err := json.Marshal(rel)
+		if err != nil {
+			return errors.Wrapf(err, "unable to update relationship from %s", path)
+		}
+		if _, err := mesherymeshmodel.UpdateRelationship(dbHandler, existing, byt, false); err != nil {
+			return errors.Wrapf(err, "unable to update relationship from %s", path)
+		}
+		erh.log.Info("updated relationship ", rel.Kind, " of model ", rel.Model.Name, " from ", path)
+		erh.publishRelationshipEvent(mesherymeshmodel.RegistryEventUpdated, rel)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		err = erh.regManager.RegisterEntity(meshmodel.Host{
+			Hostname: ArtifactHubComponentsHandler.String(),
+		}, rel)
+		if err != nil {
+			return errors.Wrapf(err, "unable to register relationship from %s", path)
+		}
+		erh.log.Info("registered relationship ", rel.Kind, " of model ", rel.Model.Name, " from ", path)
+		erh.publishRelationshipEvent(mesherymeshmodel.RegistryEventRegistered, rel)
+	default:
+		return errors.Wrapf(err, "unable to register relationship from %s", path)
+	}
+	return nil
+}
+
+func (erh *EntityRegistrationHelper) publishRelationshipEvent(action string, rel v1alpha1.RelationshipDefinition) {
+	erh.handlerConfig.MeshModelEventsChannel.Publish(mesherymeshmodel.RegistryEvent{
+		Action:       action,
+		EntityType:   mesherymeshmodel.RegistryEntityRelationship,
+		Kind:         rel.Kind,
+		SubType:      rel.SubType,
+		Model:        rel.Model.Name,
+		ModelVersion: rel.Model.Version,
+	})
+}
@@ -0,0 +1,99 @@
+package meshmodel
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	mesherymeshmodel "github.com/layer5io/meshery/server/models/meshmodel"
+)
+
+func TestIsWatchedRelationshipFile(t *testing.T) {
+	modelsDir := filepath.Join("/", "meshmodel")
+	tests := []struct {
+		path string
+		want bool
+	}{
+		{filepath.Join(modelsDir, "kubernetes", RelativeRelationshipsPath, "edge.json"), true},
+		{filepath.Join(modelsDir, "kubernetes", RelativeRelationshipsPath, "edge.yaml"), true},
+		{filepath.Join(modelsDir, "kubernetes", RelativeRelationshipsPath, "README.md"), false},
+		{filepath.Join(modelsDir, "kubernetes", "model_template.json"), false},
+		{filepath.Join(modelsDir, "kubernetes", "v1.25.2", "Pod.json"), false},
+		{filepath.Join("/", "other", "kubernetes", RelativeRelationshipsPath, "edge.json"), false},
+	}
+	for _, tt := range tests {
+		if got := isWatchedRelationshipFile(modelsDir, tt.path); got != tt.want {
+			t.Errorf("isWatchedRelationshipFile(%s) = %t, want %t", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestWatchRelationshipsNewModels(t *testing.T) {
+	erh, db := testRegistrationHelper(t)
+	erh.handlerConfig.MeshModelSummaryChannel = &mesherymeshmodel.SummaryChannel{}
+	events, unsubscribe := erh.handlerConfig.MeshModelEventsChannel.Subscribe()
+	defer unsubscribe()
+
+	modelsPath := ModelsPath
+	defer func() { ModelsPath = modelsPath }()
+	ModelsPath = t.TempDir()
+	if err := os.Mkdir(filepath.Join(ModelsPath, "istio-base"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := erh.WatchRelationships(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		create func(t *testing.T)
+		kind   string
+	}{
+		{
+			name: "The relationship directory of a model existing at startup is created",
+			create: func(t *testing.T) {
+				dir := filepath.Join(ModelsPath, "istio-base", RelativeRelationshipsPath)
+				if err := os.Mkdir(dir, 0o755); err != nil {
+					t.Fatal(err)
+				}
+				writeFiles(t, dir, map[string]string{"edge.json": edgeRelationshipJSON})
+			},
+			kind: "Edge",
+		},
+		{
+			name: "A model is moved in along with its relationships",
+			create: func(t *testing.T) {
+				model := filepath.Join(t.TempDir(), "kubernetes")
+				if err := os.MkdirAll(filepath.Join(model, RelativeRelationshipsPath), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				writeFiles(t, filepath.Join(model, RelativeRelationshipsPath), map[string]string{"hierarchical.yaml": hierarchicalRelationshipYAML})
+				if err := os.Rename(model, filepath.Join(ModelsPath, "kubernetes")); err != nil {
+					t.Fatal(err)
+				}
+			},
+			kind: "Hierarchical",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.create(t)
+			timeout := time.After(10 * time.Second)
+			for {
+				select {
+				case event := <-events:
+					if event.Action == mesherymeshmodel.RegistryEventRegistered && event.Kind == tt.kind {
+						return
+					}
+				case <-timeout:
+					t.Fatalf("the %s relationship was not registered", tt.kind)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package models
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// APITokenPrefix starts the API tokens, telling them from the tokens of the providers in the Authorization headers
+const APITokenPrefix = "mshy_"
+
+// APIToken is a personal access token of a user, used by the CI systems and mesheryctl to call the API of Meshery Server
+// without a session of the provider. Only the digest of the token is stored, the token is returned once, when created.
+// The token of the session of the provider the token was created with is stored encrypted with a key derived from the
+// token, so that neither of them can be recovered from the database alone.
+type APIToken struct {
+	ID     uuid.UUID `json:"id" gorm:"primaryKey"`
+	UserID string    `json:"user_id" gorm:"index"`
+	Name   string    `json:"name"`
+	// Prefix is the beginning of the token, telling the tokens of a user apart
+	Prefix string `json:"prefix"`
+	// Digest is the hex encoded SHA-256 digest of the token
+	Digest string `json:"-" gorm:"uniqueIndex"`
+	// Scopes is the comma separated list of the permissions the token is restricted to, see Permission
+	Scopes string `json:"-"`
+
+	// The user and the provider of the session the token was created with, the calls made with the token use them
+	UserName     string `json:"-"`
+	ProviderName string `json:"provider"`
+	// EncryptedToken is the token of the session of the provider, see SealProviderToken
+	EncryptedToken []byte `json:"-"`
+
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// APITokenRequestBody is the body of the requests creating an API token
+type APITokenRequestBody struct {
+	Name string `json:"name"`
+	// Scopes are the permissions the token is restricted to, the view permission when empty
+	Scopes []Permission `json:"scopes"`
+	// ExpiresAt is when the token expires, API_TOKEN_TTL after its creation when empty
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// CreatedAPIToken is an API token along with its value, which is only returned when the token is created
+type CreatedAPIToken struct {
+	APIToken
+	Value string `json:"token"`
+}
+
+// NewAPITokenValue returns a random API token and its digest
+func NewAPITokenValue() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	value := APITokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return value, APITokenDigest(value), nil
+}
+
+// APITokenDigest returns the digest the API token is stored with
+func APITokenDigest(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// apiTokenKey returns the AES-256 key the token of the provider is encrypted with for the API token, it is unrelated
+// to the digest of the API token
+func apiTokenKey(value string) []byte {
+	mac := hmac.New(sha256.New, []byte(value))
+	mac.Write([]byte("meshery provider token"))
+	return mac.Sum(nil)
+}
+
+func apiTokenAEAD(value string) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(apiTokenKey(value))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// SealProviderToken stores the token of the session of the provider encrypted with a key derived from value, the API token
+func (t *APIToken) SealProviderToken(value, providerToken string) error {
+	aead, err := apiTokenAEAD(value)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	t.EncryptedToken = aead.Seal(nonce, nonce, []byte(providerToken), []byte(t.Digest))
+	return nil
+}
+
+// OpenProviderToken returns the token of the session of the provider, decrypted with the key derived from value, the API token
+func (t *APIToken) OpenProviderToken(value string) (string, error) {
+	aead, err := apiTokenAEAD(value)
+	if err != nil {
+		return "", err
+	}
+	if len(t.EncryptedToken) < aead.NonceSize() {
+		return "", errors.New("the token of the provider is missing")
+	}
+	nonce, sealed := t.EncryptedToken[:aead.NonceSize()], t.EncryptedToken[aead.NonceSize():]
+	token, err := aead.Open(nil, nonce, sealed, []byte(t.Digest))
+	if err != nil {
+		return "", err
+	}
+	return string(token), nil
+}
+
+// ScopeList returns the permissions the token is restricted to
+func (t *APIToken) ScopeList() []Permission {
+	scopes := []Permission{}
+	for _, s := range strings.Split(t.Scopes, ",") {
+		if s != "" {
+			scopes = append(scopes, Permission(s))
+		}
+	}
+	return scopes
+}
+
+// SetScopes restricts the token to the permissions, which must be granted by some role
+func (t *APIToken) SetScopes(scopes []Permission) error {
+	names := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !AdminRole.Can(scope) {
+			return ErrInvalidAPITokenScope(scope)
+		}
+		names = append(names, string(scope))
+	}
+	t.Scopes = strings.Join(names, ",")
+	return nil
+}
+
+// Grants reports whether the token is allowed the permission. The view permission, granted by every role, is implied by
+// every scope. The other scopes only grant their own permission, unlike the roles they do not imply one another, eg: a
+// token with the deploy scope cannot edit the designs it deploys.
+func (t *APIToken) Grants(permission Permission) bool {
+	scopes := t.ScopeList()
+	if permission == ViewPermission && len(scopes) > 0 {
+		return true
+	}
+	for _, scope := range scopes {
+		if scope == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an error if the token is revoked or expired at the time
+func (t *APIToken) Validate(now time.Time) error {
+	switch {
+	case t.RevokedAt != nil:
+		return ErrInvalidAPIToken("the token was revoked")
+	case t.ExpiresAt != nil && !now.Before(*t.ExpiresAt):
+		return ErrInvalidAPIToken("the token expired")
+	}
+	return nil
+}
+
+// MarshalJSON includes the scopes in the JSON of the token
+func (t APIToken) MarshalJSON() ([]byte, error) {
+	type apiToken APIToken
+	return json.Marshal(struct {
+		apiToken
+		Scopes []Permission `json:"scopes"`
+	}{apiToken(t), t.ScopeList()})
+}
+
+// MarshalJSON includes the scopes and the value in the JSON of the token
+func (t CreatedAPIToken) MarshalJSON() ([]byte, error) {
+	type apiToken APIToken
+	return json.Marshal(struct {
+		apiToken
+		Scopes []Permission `json:"scopes"`
+		Value  string       `json:"token"`
+	}{apiToken(t.APIToken), t.ScopeList(), t.Value})
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// APITokenPersister is the persister for the API tokens
+type APITokenPersister struct {
+	DB *database.Handler
+}
+
+// SaveAPIToken stores the token, generating its ID if it has none
+func (atp *APITokenPersister) SaveAPIToken(token *APIToken) error {
+	if token.ID == uuid.Nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+		token.ID = id
+	}
+	return atp.DB.Save(token).Error
+}
+
+// GetAPITokenByDigest returns the token with the digest, or nil if there is none
+func (atp *APITokenPersister) GetAPITokenByDigest(digest string) (*APIToken, error) {
+	var tokens []APIToken
+	if err := atp.DB.Where("digest = ?", digest).Limit(1).Find(&tokens).Error; err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+	return &tokens[0], nil
+}
+
+// GetAPITokens returns the tokens of the user, most recent first, along with the revoked ones if includeRevoked is set
+func (atp *APITokenPersister) GetAPITokens(userID string, includeRevoked bool) ([]APIToken, error) {
+	query := atp.DB.Where("user_id = ?", userID)
+	if !includeRevoked {
+		query = query.Where("revoked_at IS NULL")
+	}
+	tokens := []APIToken{}
+	if err := query.Order("created_at desc").Find(&tokens).Error; err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// RevokeAPIToken revokes the token of the user, and returns whether the user had such a token not revoked yet
+func (atp *APITokenPersister) RevokeAPIToken(id uuid.UUID, userID string) (bool, error) {
+	result := atp.DB.Model(&APIToken{}).Where("id = ? AND user_id = ? AND revoked_at IS NULL", id, userID).Update("revoked_at", time.Now())
+	return result.RowsAffected > 0, result.Error
+}
+
+// TouchAPIToken records that the token was used at the time
+func (atp *APITokenPersister) TouchAPIToken(id uuid.UUID, t time.Time) error {
+	return atp.DB.Model(&APIToken{}).Where("id = ?", id).Update("last_used_at", t).Error
+}
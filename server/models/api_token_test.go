@@ -0,0 +1,114 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewAPITokenValue(t *testing.T) {
+	value, digest, err := NewAPITokenValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(value, APITokenPrefix) || len(value) != len(APITokenPrefix)+43 {
+		t.Errorf("NewAPITokenValue() = %q", value)
+	}
+	if digest != APITokenDigest(value) || strings.Contains(digest, value) {
+		t.Errorf("the digest of %q is %q", value, digest)
+	}
+	if other, _, _ := NewAPITokenValue(); other == value {
+		t.Error("NewAPITokenValue() returned the same token twice")
+	}
+}
+
+func TestAPITokenScopes(t *testing.T) {
+	var token APIToken
+	if err := token.SetScopes([]Permission{ViewPermission, DeployPermission}); err != nil {
+		t.Fatal(err)
+	}
+	if !token.Grants(DeployPermission) || token.Grants(EditPermission) {
+		t.Errorf("the token with the scopes %q grants the wrong permissions", token.Scopes)
+	}
+	if err := token.SetScopes([]Permission{DeployPermission}); err != nil {
+		t.Fatal(err)
+	}
+	if !token.Grants(ViewPermission) || token.Grants(EditPermission) {
+		t.Errorf("the token with the scopes %q does not grant the view permission only along with its own", token.Scopes)
+	}
+	if (&APIToken{}).Grants(ViewPermission) {
+		t.Error("the token without scopes grants the view permission")
+	}
+	if err := token.SetScopes([]Permission{"delete_everything"}); err == nil {
+		t.Error("SetScopes() accepted an unknown scope")
+	}
+}
+
+func TestAPITokenValidate(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+	tests := []struct {
+		name    string
+		token   APIToken
+		wantErr bool
+	}{
+		{"no expiry", APIToken{}, false},
+		{"not expired", APIToken{ExpiresAt: &future}, false},
+		{"expired", APIToken{ExpiresAt: &past}, true},
+		{"revoked", APIToken{ExpiresAt: &future, RevokedAt: &past}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.token.Validate(now); (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestAPITokenProviderToken(t *testing.T) {
+	value, digest, err := NewAPITokenValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := APIToken{Digest: digest}
+	if err := token.SealProviderToken(value, "provider-token"); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(token.EncryptedToken), "provider-token") {
+		t.Errorf("the token of the provider is stored in plain text: %q", token.EncryptedToken)
+	}
+	if got, err := token.OpenProviderToken(value); err != nil || got != "provider-token" {
+		t.Errorf("OpenProviderToken() = %q, %v, want provider-token", got, err)
+	}
+
+	other, _, _ := NewAPITokenValue()
+	if _, err := token.OpenProviderToken(other); err == nil {
+		t.Error("the token of the provider was decrypted with another API token")
+	}
+	// the encrypted token is bound to the API token it was stored with
+	moved := APIToken{Digest: APITokenDigest(other), EncryptedToken: token.EncryptedToken}
+	if _, err := moved.OpenProviderToken(value); err == nil {
+		t.Error("the token of the provider was decrypted for another API token")
+	}
+	if _, err := (&APIToken{}).OpenProviderToken(value); err == nil {
+		t.Error("OpenProviderToken() succeeded without a token of the provider")
+	}
+}
+
+func TestAPITokenJSON(t *testing.T) {
+	token := APIToken{Name: "ci", Digest: "d1g3st", EncryptedToken: []byte("provider-token"), Scopes: "view,deploy"}
+	b, err := json.Marshal(CreatedAPIToken{APIToken: token, Value: "mshy_value"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got["token"] != "mshy_value" || got["name"] != "ci" || len(got["scopes"].([]interface{})) != 2 {
+		t.Errorf("JSON of the created token = %s", b)
+	}
+	if strings.Contains(string(b), "d1g3st") || strings.Contains(string(b), "provider-token") {
+		t.Errorf("JSON of the created token holds its digest or the token of the provider: %s", b)
+	}
+}
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// Results of the API calls recorded in the audit log
+const (
+	AuditResultSuccess = "success"
+	AuditResultFailure = "failure"
+)
+
+// AuditRecord is an entry of the audit log, recorded for every call of the API of Meshery Server modifying its state.
+// The payload of the call is not stored, only its digest, so that the audit log does not hold credentials or secrets.
+type AuditRecord struct {
+	ID     uuid.UUID `json:"id" gorm:"primaryKey"`
+	UserID string    `json:"user_id" gorm:"index"`
+	Method string    `json:"method"`
+	// Endpoint is the path template of the route of the call, eg: /api/pattern/{id}
+	Endpoint string `json:"endpoint" gorm:"index"`
+	Path     string `json:"path"`
+	// EntityID is the ID of the entity the call concerns, taken from the path of the call, empty if it has none
+	EntityID string `json:"entity_id,omitempty" gorm:"index"`
+	// PayloadDigest is the hex encoded SHA-256 digest of the body of the call, empty if it has none or if it is a multipart
+	// body or one announced larger than the limit of the audit log
+	PayloadDigest string `json:"payload_digest,omitempty"`
+	// PayloadTruncated is true when the body is larger than the limit of the audit log, the digest is then the one of its
+	// first bytes only
+	PayloadTruncated bool      `json:"payload_truncated,omitempty"`
+	StatusCode       int       `json:"status_code"`
+	Result           string    `json:"result"`
+	RemoteAddr       string    `json:"remote_addr"`
+	CreatedAt        time.Time `json:"created_at" gorm:"index"`
+}
+
+// AuditFilter selects the entries of the audit log, the zero value of a field matches all the entries
+type AuditFilter struct {
+	UserID   string
+	Method   string
+	Endpoint string
+	EntityID string
+	Result   string
+	Since    time.Time
+	Until    time.Time
+
+	Offset int
+	Limit  int
+	// Order is either "asc" or "desc", by creation time. Entries are listed most recent first by default.
+	Order string
+}
+
+// AuditRecordsPage is a page of the entries of the audit log
+type AuditRecordsPage struct {
+	Page       int           `json:"page"`
+	PageSize   int           `json:"page_size"`
+	TotalCount int64         `json:"total_count"`
+	Records    []AuditRecord `json:"records"`
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// AuditRecordPersister is the persister for the audit log
+type AuditRecordPersister struct {
+	DB *database.Handler
+}
+
+// SaveAuditRecord stores the entry, generating its ID if it has none
+func (arp *AuditRecordPersister) SaveAuditRecord(record *AuditRecord) error {
+	if record.ID == uuid.Nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+		record.ID = id
+	}
+	return arp.DB.Create(record).Error
+}
+
+// GetAuditRecords returns the entries matching the filter, along with the total number of matching entries
+func (arp *AuditRecordPersister) GetAuditRecords(filter AuditFilter) ([]AuditRecord, int64, error) {
+	query := arp.DB.Model(&AuditRecord{})
+	if filter.UserID != "" {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Method != "" {
+		query = query.Where("method = ?", filter.Method)
+	}
+	if filter.Endpoint != "" {
+		query = query.Where("endpoint LIKE ?", "%"+filter.Endpoint+"%")
+	}
+	if filter.EntityID != "" {
+		query = query.Where("entity_id = ?", filter.EntityID)
+	}
+	if filter.Result != "" {
+		query = query.Where("result = ?", filter.Result)
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where("created_at >= ?", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		query = query.Where("created_at < ?", filter.Until)
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order := "created_at desc"
+	if filter.Order == "asc" {
+		order = "created_at asc"
+	}
+	query = query.Order(order).Offset(filter.Offset)
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	records := []AuditRecord{}
+	if err := query.Find(&records).Error; err != nil {
+		return nil, 0, err
+	}
+	return records, count, nil
+}
+
+// DeleteAuditRecordsBefore deletes the entries recorded before t and returns the number of entries deleted
+func (arp *AuditRecordPersister) DeleteAuditRecordsBefore(t time.Time) (int64, error) {
+	result := arp.DB.Where("created_at < ?", t).Delete(&AuditRecord{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,151 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/layer5io/meshery/server/internal/sql"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+const (
+	// maxConcurrentClusterProbes is the number of clusters probed at the same time
+	maxConcurrentClusterProbes = 8
+	// clusterProbeTimeout bounds the probe of a cluster, so that the unreachable clusters do not hold the discovery
+	clusterProbeTimeout = 10 * time.Second
+	// clusterProbeNodes is the number of nodes the labels of a cluster are discovered from
+	clusterProbeNodes = 10
+)
+
+// the clouds of the schemes of the provider IDs of the nodes
+var providerIDClouds = map[string]string{
+	"aws":          "aws",
+	"gce":          "gcp",
+	"azure":        "azure",
+	"digitalocean": "digitalocean",
+	"linode":       "linode",
+	"ibm":          "ibm",
+	"alicloud":     "alibaba",
+	"oci":          "oracle",
+	"openstack":    "openstack",
+	"vsphere":      "vsphere",
+}
+
+// DiscoverClusters probes the clusters of the contexts concurrently, and returns their metadata: whether they are reachable,
+// their version and the labels discovered from their version and their nodes, see ClusterCloudLabel, ClusterRegionLabel
+// and ClusterDistroLabel
+func DiscoverClusters(ctx context.Context, contexts []*K8sContext) []ClusterMetadata {
+	metadata := make([]ClusterMetadata, len(contexts))
+	probes := make(chan struct{}, maxConcurrentClusterProbes)
+	var wg sync.WaitGroup
+	for i, kc := range contexts {
+		wg.Add(1)
+		go func(i int, kc *K8sContext) {
+			defer wg.Done()
+			probes <- struct{}{}
+			defer func() { <-probes }()
+			metadata[i] = probeCluster(ctx, kc)
+		}(i, kc)
+	}
+	wg.Wait()
+	return metadata
+}
+
+// probeCluster returns the metadata of the cluster of the context
+func probeCluster(ctx context.Context, kc *K8sContext) ClusterMetadata {
+	now := time.Now()
+	metadata := ClusterMetadata{
+		ContextID:        kc.ID,
+		Name:             kc.Name,
+		Server:           kc.Server,
+		LastProbedAt:     &now,
+		DiscoveredLabels: sql.Map{},
+	}
+	ctx, cancel := context.WithTimeout(ctx, clusterProbeTimeout)
+	defer cancel()
+
+	handler, err := kc.GenerateKubeHandler()
+	if err != nil {
+		metadata.ProbeError = ErrDiscoverCluster(err, kc.Name).Error()
+		return metadata
+	}
+	raw, err := handler.KubeClient.DiscoveryClient.RESTClient().Get().AbsPath("/version").Do(ctx).Raw()
+	if err != nil {
+		metadata.ProbeError = ErrUnreachableKubeAPI(err, kc.Server).Error()
+		return metadata
+	}
+	var info version.Info
+	if err := json.Unmarshal(raw, &info); err != nil {
+		metadata.ProbeError = ErrDiscoverCluster(err, kc.Name).Error()
+		return metadata
+	}
+	metadata.Reachable = true
+	metadata.Version = info.GitVersion
+
+	// the labels are discovered from the version alone when the nodes cannot be listed
+	var nodes []corev1.Node
+	list, err := handler.KubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{Limit: clusterProbeNodes})
+	if err != nil {
+		metadata.ProbeError = ErrDiscoverCluster(err, kc.Name).Error()
+	} else {
+		nodes = list.Items
+	}
+	for k, v := range clusterLabels(info.GitVersion, nodes) {
+		metadata.DiscoveredLabels[k] = v
+	}
+	return metadata
+}
+
+// clusterLabels returns the cloud, the region and the distribution of Kubernetes of the cluster with the version and the nodes,
+// the labels which cannot be told are not returned
+func clusterLabels(gitVersion string, nodes []corev1.Node) map[string]string {
+	labels := map[string]string{}
+	switch {
+	case strings.Contains(gitVersion, "-eks-"):
+		labels[ClusterDistroLabel] = "eks"
+	case strings.Contains(gitVersion, "-gke."):
+		labels[ClusterDistroLabel] = "gke"
+	case strings.Contains(gitVersion, "+k3s"):
+		labels[ClusterDistroLabel] = "k3s"
+	case strings.Contains(gitVersion, "+rke2"):
+		labels[ClusterDistroLabel] = "rke2"
+	}
+
+	for _, node := range nodes {
+		if scheme, _, ok := strings.Cut(node.Spec.ProviderID, "://"); ok {
+			if cloud, ok := providerIDClouds[scheme]; ok && labels[ClusterCloudLabel] == "" {
+				labels[ClusterCloudLabel] = cloud
+			}
+			if scheme == "kind" && labels[ClusterDistroLabel] == "" {
+				labels[ClusterDistroLabel] = "kind"
+			}
+		}
+		if labels[ClusterRegionLabel] == "" {
+			region := node.Labels[corev1.LabelTopologyRegion]
+			if region == "" {
+				region = node.Labels[corev1.LabelFailureDomainBetaRegion]
+			}
+			if region != "" {
+				labels[ClusterRegionLabel] = region
+			}
+		}
+		if labels[ClusterDistroLabel] == "" {
+			switch {
+			case node.Labels["kubernetes.azure.com/cluster"] != "":
+				labels[ClusterDistroLabel] = "aks"
+			case node.Labels["node.openshift.io/os_id"] != "":
+				labels[ClusterDistroLabel] = "openshift"
+			case node.Labels["minikube.k8s.io/name"] != "":
+				labels[ClusterDistroLabel] = "minikube"
+			case node.Name == "docker-desktop":
+				labels[ClusterDistroLabel] = "docker-desktop"
+			}
+		}
+	}
+	return labels
+}
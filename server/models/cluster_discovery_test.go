@@ -0,0 +1,75 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func node(name, providerID string, labels map[string]string) corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Spec:       corev1.NodeSpec{ProviderID: providerID},
+	}
+}
+
+func TestClusterLabels(t *testing.T) {
+	tests := []struct {
+		name       string
+		gitVersion string
+		nodes      []corev1.Node
+		want       map[string]string
+	}{
+		{
+			name:       "eks",
+			gitVersion: "v1.27.4-eks-2d98532",
+			nodes: []corev1.Node{node("ip-10-0-1-12", "aws:///us-east-1a/i-0abc", map[string]string{
+				corev1.LabelTopologyRegion: "us-east-1",
+			})},
+			want: map[string]string{ClusterCloudLabel: "aws", ClusterRegionLabel: "us-east-1", ClusterDistroLabel: "eks"},
+		},
+		{
+			name:       "gke with deprecated region label",
+			gitVersion: "v1.26.5-gke.1200",
+			nodes: []corev1.Node{node("gke-pool-1", "gce://project/europe-west1-b/gke-pool-1", map[string]string{
+				corev1.LabelFailureDomainBetaRegion: "europe-west1",
+			})},
+			want: map[string]string{ClusterCloudLabel: "gcp", ClusterRegionLabel: "europe-west1", ClusterDistroLabel: "gke"},
+		},
+		{
+			name:       "aks",
+			gitVersion: "v1.27.3",
+			nodes: []corev1.Node{node("aks-nodepool1-0", "azure:///subscriptions/s/resourceGroups/g/providers/Microsoft.Compute/virtualMachineScaleSets/aks/virtualMachines/0", map[string]string{
+				corev1.LabelTopologyRegion:     "westeurope",
+				"kubernetes.azure.com/cluster": "MC_group_cluster_westeurope",
+			})},
+			want: map[string]string{ClusterCloudLabel: "azure", ClusterRegionLabel: "westeurope", ClusterDistroLabel: "aks"},
+		},
+		{
+			name:       "kind",
+			gitVersion: "v1.27.3",
+			nodes:      []corev1.Node{node("kind-control-plane", "kind://docker/kind/kind-control-plane", nil)},
+			want:       map[string]string{ClusterDistroLabel: "kind"},
+		},
+		{
+			name:       "k3s without nodes",
+			gitVersion: "v1.27.4+k3s1",
+			want:       map[string]string{ClusterDistroLabel: "k3s"},
+		},
+		{
+			name:       "unknown",
+			gitVersion: "v1.27.3",
+			nodes:      []corev1.Node{node("node-1", "", nil)},
+			want:       map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := clusterLabels(tt.gitVersion, tt.nodes); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("clusterLabels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/layer5io/meshery/server/internal/sql"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Labels discovered on the clusters of the Kubernetes contexts
+const (
+	// ClusterCloudLabel is the cloud the cluster runs in, eg: aws, gcp or azure
+	ClusterCloudLabel = "cloud"
+	// ClusterRegionLabel is the region of the cloud the nodes of the cluster run in
+	ClusterRegionLabel = "region"
+	// ClusterDistroLabel is the distribution of Kubernetes of the cluster, eg: eks, gke, aks, openshift, k3s or kind
+	ClusterDistroLabel = "distro"
+)
+
+// ClusterMetadata is the metadata of the cluster of a Kubernetes context: whether it is reachable, its version and its labels,
+// which the contexts designs are deployed to are chosen by
+type ClusterMetadata struct {
+	ContextID string `json:"context_id" gorm:"primaryKey"`
+	Name      string `json:"name"`
+	Server    string `json:"server"`
+
+	Reachable    bool       `json:"reachable"`
+	Version      string     `json:"version,omitempty"`
+	ProbeError   string     `json:"probe_error,omitempty"`
+	LastProbedAt *time.Time `json:"last_probed_at,omitempty"`
+
+	// DiscoveredLabels are the labels found by probing the cluster, see ClusterCloudLabel, ClusterRegionLabel and ClusterDistroLabel
+	DiscoveredLabels sql.Map `json:"discovered_labels"`
+	// Labels are the labels set by the users, they override the discovered labels with the same keys
+	Labels sql.Map `json:"labels"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// EffectiveLabels returns the labels of the cluster: the discovered labels, overridden by the ones set by the users
+func (cm *ClusterMetadata) EffectiveLabels() labels.Set {
+	set := labels.Set{}
+	for _, m := range []sql.Map{cm.DiscoveredLabels, cm.Labels} {
+		for k, v := range m {
+			if s, ok := v.(string); ok {
+				set[k] = s
+			}
+		}
+	}
+	return set
+}
+
+// MarshalJSON includes the effective labels in the JSON of the metadata
+func (cm ClusterMetadata) MarshalJSON() ([]byte, error) {
+	type clusterMetadata ClusterMetadata
+	return json.Marshal(struct {
+		clusterMetadata
+		EffectiveLabels labels.Set `json:"effective_labels"`
+	}{clusterMetadata(cm), cm.EffectiveLabels()})
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"github.com/layer5io/meshkit/database"
+	"gorm.io/gorm/clause"
+)
+
+// ClusterMetadataPersister is the persister for the metadata of the clusters of the Kubernetes contexts
+type ClusterMetadataPersister struct {
+	DB *database.Handler
+}
+
+// SaveClusterMetadata stores the metadata of the cluster
+func (cmp *ClusterMetadataPersister) SaveClusterMetadata(cm *ClusterMetadata) error {
+	return cmp.DB.Save(cm).Error
+}
+
+// SaveDiscoveredClusterMetadata stores the result of the discovery of the cluster, keeping the labels set by the users
+func (cmp *ClusterMetadataPersister) SaveDiscoveredClusterMetadata(cm *ClusterMetadata) error {
+	return cmp.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "context_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "server", "reachable", "version", "probe_error", "last_probed_at", "discovered_labels", "updated_at"}),
+	}).Create(cm).Error
+}
+
+// GetClusterMetadata returns the metadata of the cluster of the context, or nil if there is none
+func (cmp *ClusterMetadataPersister) GetClusterMetadata(contextID string) (*ClusterMetadata, error) {
+	var metadata []ClusterMetadata
+	if err := cmp.DB.Where("context_id = ?", contextID).Limit(1).Find(&metadata).Error; err != nil {
+		return nil, err
+	}
+	if len(metadata) == 0 {
+		return nil, nil
+	}
+	return &metadata[0], nil
+}
+
+// GetClustersMetadata returns the metadata of the clusters of the contexts, ordered by the names of the contexts
+func (cmp *ClusterMetadataPersister) GetClustersMetadata(contextIDs []string) ([]ClusterMetadata, error) {
+	metadata := []ClusterMetadata{}
+	if len(contextIDs) == 0 {
+		return metadata, nil
+	}
+	err := cmp.DB.Where("context_id IN ?", contextIDs).Order("name").Find(&metadata).Error
+	return metadata, err
+}
+
+// DeleteClusterMetadata deletes the metadata of the cluster of the context
+func (cmp *ClusterMetadataPersister) DeleteClusterMetadata(contextID string) error {
+	return cmp.DB.Where("context_id = ?", contextID).Delete(&ClusterMetadata{}).Error
+}
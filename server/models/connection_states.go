@@ -0,0 +1,70 @@
+package models
+
+// connectionTransitions are the statuses a connection of each status can transition to.
+// A connection is discovered, registered by the users, then connected once its credentials are verified. Connected
+// connections can be put under maintenance, get disconnected, or not be found anymore. Ignored connections are not
+// discovered again until they are registered. Deleted connections cannot transition anymore.
+var connectionTransitions = map[ConnectionStatus][]ConnectionStatus{
+	DISCOVERED:   {REGISTERED, IGNORED, DELETED},
+	REGISTERED:   {CONNECTED, IGNORED, DELETED},
+	CONNECTED:    {MAINTENANCE, DISCONNECTED, NOTFOUND, IGNORED, DELETED},
+	MAINTENANCE:  {CONNECTED, DISCONNECTED, DELETED},
+	DISCONNECTED: {CONNECTED, NOTFOUND, IGNORED, DELETED},
+	NOTFOUND:     {CONNECTED, DELETED},
+	IGNORED:      {REGISTERED, DELETED},
+	DELETED:      {},
+}
+
+// ConnectionTransition is a change of the status of a connection
+type ConnectionTransition struct {
+	From ConnectionStatus `json:"from"`
+	To   ConnectionStatus `json:"to"`
+}
+
+// ConnectionTransitionRequestBody is the body of the requests transitioning a connection to a status
+type ConnectionTransitionRequestBody struct {
+	Status ConnectionStatus `json:"status"`
+}
+
+// ConnectionTransitions are the statuses a connection can transition to from its current status
+// swagger:response ConnectionTransitions
+type ConnectionTransitions struct {
+	Status      ConnectionStatus   `json:"status"`
+	Transitions []ConnectionStatus `json:"transitions"`
+}
+
+// IsValid reports whether the status is one of the statuses of the lifecycle of the connections
+func (s ConnectionStatus) IsValid() bool {
+	_, ok := connectionTransitions[s]
+	return ok
+}
+
+// Transitions returns the statuses a connection of the status can transition to
+func (s ConnectionStatus) Transitions() []ConnectionStatus {
+	return append([]ConnectionStatus{}, connectionTransitions[s]...)
+}
+
+// CanTransitionTo reports whether a connection of the status can transition to the other one
+func (s ConnectionStatus) CanTransitionTo(to ConnectionStatus) bool {
+	for _, t := range connectionTransitions[s] {
+		if t == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateConnectionTransition returns an error if a connection cannot transition between the statuses.
+// Transitioning a connection to its current status is allowed, and changes nothing.
+func ValidateConnectionTransition(from, to ConnectionStatus) error {
+	if !to.IsValid() {
+		return ErrInvalidConnectionTransition(from, to)
+	}
+	if from == to {
+		return nil
+	}
+	if !from.CanTransitionTo(to) {
+		return ErrInvalidConnectionTransition(from, to)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package models
+
+import "testing"
+
+func TestValidateConnectionTransition(t *testing.T) {
+	tests := []struct {
+		from, to ConnectionStatus
+		wantErr  bool
+	}{
+		{DISCOVERED, REGISTERED, false},
+		{REGISTERED, CONNECTED, false},
+		{CONNECTED, IGNORED, false},
+		{IGNORED, REGISTERED, false},
+		{CONNECTED, DELETED, false},
+		{CONNECTED, CONNECTED, false},
+		{DISCOVERED, CONNECTED, true},
+		{IGNORED, CONNECTED, true},
+		{DELETED, DISCOVERED, true},
+		{CONNECTED, "unknown", true},
+		{"unknown", "unknown", true},
+	}
+	for _, tt := range tests {
+		err := ValidateConnectionTransition(tt.from, tt.to)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ValidateConnectionTransition(%q, %q) error = %v, wantErr %v", tt.from, tt.to, err, tt.wantErr)
+		}
+	}
+}
+
+func TestConnectionTransitionsAreValid(t *testing.T) {
+	for from, transitions := range connectionTransitions {
+		for _, to := range transitions {
+			if !to.IsValid() {
+				t.Errorf("%q transitions to %q, which is not a status of the lifecycle of the connections", from, to)
+			}
+			if to == from {
+				t.Errorf("%q transitions to itself", from)
+			}
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Package credstore stores the secrets of the credentials of the users, eg: the ones of Prometheus, Grafana, registries or
+// clouds, in HashiCorp Vault or in Kubernetes Secrets instead of the Meshery database. The credentials saved in the
+// database then hold a reference to their secret, which is resolved when the credentials are read.
+package credstore
+
+import (
+	"context"
+	"strings"
+)
+
+// Backends the secrets of the credentials are stored in
+const (
+	// BackendDatabase stores the secrets in the Meshery database, along with the credentials
+	BackendDatabase = "database"
+	BackendVault    = "vault"
+	// BackendKubernetes stores the secrets in Kubernetes Secrets
+	BackendKubernetes = "kubernetes"
+)
+
+// RefKey is the only key of the secrets of the credentials stored in a Store, its value references the secret in the
+// store as <backend>:<key>
+const RefKey = "meshery.io/secret-ref"
+
+// Store stores the secrets of credentials by key
+type Store interface {
+	// Name returns the backend of the store
+	Name() string
+	Put(ctx context.Context, key string, secret map[string]interface{}) error
+	// Get returns ErrSecretNotFound when there is no secret with the key
+	Get(ctx context.Context, key string) (map[string]interface{}, error)
+	// Delete deletes the secret with the key, if there is one
+	Delete(ctx context.Context, key string) error
+}
+
+// Config is the configuration of the backends
+type Config struct {
+	Vault      VaultConfig
+	Kubernetes KubernetesConfig
+}
+
+// NewStore returns the store of the backend, nil for the database
+func NewStore(backend string, cfg Config) (Store, error) {
+	switch strings.ToLower(strings.TrimSpace(backend)) {
+	case "", BackendDatabase:
+		return nil, nil
+	case BackendVault:
+		vault, err := NewVault(cfg.Vault)
+		if err != nil {
+			return nil, err
+		}
+		return vault, nil
+	case BackendKubernetes:
+		k8s, err := NewKubernetes(cfg.Kubernetes)
+		if err != nil {
+			return nil, err
+		}
+		return k8s, nil
+	}
+	return nil, ErrUnsupportedBackend(backend)
+}
+
+// Externalize puts the secret in the store under the key and returns the reference to it, which is saved along with the
+// credential instead of the secret. Without store, the secret is returned as is.
+func Externalize(ctx context.Context, store Store, key string, secret map[string]interface{}) (map[string]interface{}, error) {
+	if store == nil || IsRef(secret) {
+		return secret, nil
+	}
+	if err := store.Put(ctx, key, secret); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{RefKey: store.Name() + ":" + key}, nil
+}
+
+// Resolve returns the secret the reference points to, or the secret as is if it is not a reference
+func Resolve(ctx context.Context, store Store, secret map[string]interface{}) (map[string]interface{}, error) {
+	if !IsRef(secret) {
+		return secret, nil
+	}
+	backend, key := parseRef(secret[RefKey].(string))
+	if store == nil || store.Name() != backend {
+		return nil, ErrBackendUnavailable(backend)
+	}
+	return store.Get(ctx, key)
+}
+
+// Remove deletes the secret under the key from the store, if there is one
+func Remove(ctx context.Context, store Store, key string) error {
+	if store == nil {
+		return nil
+	}
+	return store.Delete(ctx, key)
+}
+
+// IsRef reports whether the secret is a reference to a secret of a store
+func IsRef(secret map[string]interface{}) bool {
+	if len(secret) != 1 {
+		return false
+	}
+	ref, ok := secret[RefKey].(string)
+	return ok && strings.Contains(ref, ":")
+}
+
+func parseRef(ref string) (backend, key string) {
+	backend, key, _ = strings.Cut(ref, ":")
+	return backend, key
+}
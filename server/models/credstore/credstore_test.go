@@ -0,0 +1,91 @@
+package credstore
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+type memoryStore map[string]map[string]interface{}
+
+func (m memoryStore) Name() string {
+	return "memory"
+}
+
+func (m memoryStore) Put(_ context.Context, key string, secret map[string]interface{}) error {
+	m[key] = secret
+	return nil
+}
+
+func (m memoryStore) Get(_ context.Context, key string) (map[string]interface{}, error) {
+	secret, ok := m[key]
+	if !ok {
+		return nil, ErrSecretNotFound(key)
+	}
+	return secret, nil
+}
+
+func (m memoryStore) Delete(_ context.Context, key string) error {
+	delete(m, key)
+	return nil
+}
+
+func TestExternalizeResolve(t *testing.T) {
+	ctx := context.Background()
+	secret := map[string]interface{}{"username": "admin", "password": "s3cr3t"}
+
+	t.Run("Secrets are stored in the store and referenced", func(t *testing.T) {
+		store := memoryStore{}
+		ref, err := Externalize(ctx, store, "0b5b7b1e", secret)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := map[string]interface{}{RefKey: "memory:0b5b7b1e"}; !reflect.DeepEqual(ref, want) {
+			t.Errorf("Externalize() = %v, want %v", ref, want)
+		}
+		if !reflect.DeepEqual(store["0b5b7b1e"], secret) {
+			t.Errorf("stored secret = %v, want %v", store["0b5b7b1e"], secret)
+		}
+		resolved, err := Resolve(ctx, store, ref)
+		if err != nil || !reflect.DeepEqual(resolved, secret) {
+			t.Errorf("Resolve() = %v, %v, want %v", resolved, err, secret)
+		}
+		if err := Remove(ctx, store, "0b5b7b1e"); err != nil || len(store) != 0 {
+			t.Errorf("Remove() = %v, store = %v, want the secret deleted", err, store)
+		}
+	})
+
+	t.Run("Secrets stay as they are without store", func(t *testing.T) {
+		ref, err := Externalize(ctx, nil, "0b5b7b1e", secret)
+		if err != nil || !reflect.DeepEqual(ref, secret) {
+			t.Errorf("Externalize() = %v, %v, want %v", ref, err, secret)
+		}
+		resolved, err := Resolve(ctx, nil, secret)
+		if err != nil || !reflect.DeepEqual(resolved, secret) {
+			t.Errorf("Resolve() = %v, %v, want %v", resolved, err, secret)
+		}
+	})
+
+	t.Run("References to the secrets of another backend cannot be resolved", func(t *testing.T) {
+		if _, err := Resolve(ctx, memoryStore{}, map[string]interface{}{RefKey: "vault:0b5b7b1e"}); err == nil {
+			t.Error("Resolve() succeeded, want an error")
+		}
+		if _, err := Resolve(ctx, nil, map[string]interface{}{RefKey: "vault:0b5b7b1e"}); err == nil {
+			t.Error("Resolve() without store succeeded, want an error")
+		}
+	})
+}
+
+func TestNewStore(t *testing.T) {
+	for _, backend := range []string{"", "database", "Database"} {
+		if store, err := NewStore(backend, Config{}); store != nil || err != nil {
+			t.Errorf("NewStore(%q) = %v, %v, want no store", backend, store, err)
+		}
+	}
+	if _, err := NewStore("consul", Config{}); err == nil {
+		t.Error("NewStore(consul) succeeded, want an error")
+	}
+	if _, err := NewStore("vault", Config{}); err == nil {
+		t.Error("NewStore(vault) without address succeeded, want an error")
+	}
+}
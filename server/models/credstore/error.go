@@ -0,0 +1,45 @@
+package credstore
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshkit/errors"
+)
+
+const (
+	ErrUnsupportedBackendCode = "1599"
+	ErrInvalidConfigCode      = "1600"
+	ErrStoreSecretCode        = "1601"
+	ErrReadSecretCode         = "1602"
+	ErrDeleteSecretCode       = "1603"
+	ErrSecretNotFoundCode     = "1604"
+	ErrBackendUnavailableCode = "1605"
+)
+
+func ErrUnsupportedBackend(backend string) error {
+	return errors.New(ErrUnsupportedBackendCode, errors.Alert, []string{fmt.Sprintf("Unsupported credentials backend %s", backend)}, []string{fmt.Sprintf("The credentials backend %s is neither database, vault nor kubernetes", backend)}, []string{"CREDENTIALS_BACKEND is set to a backend Meshery does not support"}, []string{"Set CREDENTIALS_BACKEND to database, vault or kubernetes"})
+}
+
+func ErrInvalidConfig(backend string, err error) error {
+	return errors.New(ErrInvalidConfigCode, errors.Alert, []string{fmt.Sprintf("Invalid configuration of the %s credentials backend", backend)}, []string{err.Error()}, []string{"The address or the token of Vault is not set", "Meshery Server does not run in a Kubernetes cluster and no kubeconfig is set"}, []string{"Set VAULT_ADDR and VAULT_TOKEN to store the secrets of the credentials in Vault", "Set CREDENTIALS_KUBECONFIG to the kubeconfig of the cluster to store the secrets of the credentials in"})
+}
+
+func ErrStoreSecret(err error, backend string) error {
+	return errors.New(ErrStoreSecretCode, errors.Alert, []string{fmt.Sprintf("Could not store the secret of the credential in %s", backend)}, []string{err.Error()}, []string{"The credentials backend is unreachable", "The token of Vault, or the service account of Meshery Server, is not allowed to write the secrets"}, []string{"Make sure the credentials backend is reachable from Meshery Server", "Allow Meshery Server to write the secrets under the configured path or namespace"})
+}
+
+func ErrReadSecret(err error, backend string) error {
+	return errors.New(ErrReadSecretCode, errors.Alert, []string{fmt.Sprintf("Could not read the secret of the credential from %s", backend)}, []string{err.Error()}, []string{"The credentials backend is unreachable", "The token of Vault, or the service account of Meshery Server, is not allowed to read the secrets"}, []string{"Make sure the credentials backend is reachable from Meshery Server", "Allow Meshery Server to read the secrets under the configured path or namespace"})
+}
+
+func ErrDeleteSecret(err error, backend string) error {
+	return errors.New(ErrDeleteSecretCode, errors.Alert, []string{fmt.Sprintf("Could not delete the secret of the credential from %s", backend)}, []string{err.Error()}, []string{"The credentials backend is unreachable", "The token of Vault, or the service account of Meshery Server, is not allowed to delete the secrets"}, []string{"Make sure the credentials backend is reachable from Meshery Server", "Delete the secret of the credential from the credentials backend"})
+}
+
+func ErrSecretNotFound(key string) error {
+	return errors.New(ErrSecretNotFoundCode, errors.Alert, []string{fmt.Sprintf("The secret of the credential %s was not found", key)}, []string{fmt.Sprintf("The credentials backend holds no secret for the credential %s", key)}, []string{"The secret was deleted from the credentials backend", "The credentials backend, or its path or namespace, changed since the credential was saved"}, []string{"Save the credential again", "Configure the credentials backend the credential was saved in"})
+}
+
+func ErrBackendUnavailable(backend string) error {
+	return errors.New(ErrBackendUnavailableCode, errors.Alert, []string{fmt.Sprintf("The secret of the credential is stored in the %s credentials backend, which is not configured", backend)}, []string{fmt.Sprintf("The credential was saved while CREDENTIALS_BACKEND was %s", backend)}, []string{"CREDENTIALS_BACKEND changed since the credential was saved"}, []string{fmt.Sprintf("Set CREDENTIALS_BACKEND to %s, or save the credential again", backend)})
+}
@@ -0,0 +1,109 @@
+package credstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// secretDataKey is the key of the data of the Kubernetes Secrets holding the secrets of the credentials, as JSON
+const secretDataKey = "secret"
+
+// KubernetesConfig is the configuration of the cluster the Kubernetes Secrets are stored in
+type KubernetesConfig struct {
+	// Kubeconfig is the path of the kubeconfig of the cluster, the cluster Meshery Server runs in is used without it
+	Kubeconfig string
+	Namespace  string
+}
+
+// Kubernetes stores the secrets in Kubernetes Secrets, one per credential
+type Kubernetes struct {
+	Client    kubernetes.Interface
+	Namespace string
+}
+
+// NewKubernetes returns the store of the namespace of the cluster
+func NewKubernetes(cfg KubernetesConfig) (*Kubernetes, error) {
+	var restConfig *rest.Config
+	var err error
+	if cfg.Kubeconfig != "" {
+		restConfig, err = clientcmd.BuildConfigFromFlags("", cfg.Kubeconfig)
+	} else {
+		restConfig, err = rest.InClusterConfig()
+	}
+	if err != nil {
+		return nil, ErrInvalidConfig(BackendKubernetes, err)
+	}
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, ErrInvalidConfig(BackendKubernetes, err)
+	}
+	if cfg.Namespace == "" {
+		cfg.Namespace = "meshery"
+	}
+	return &Kubernetes{Client: client, Namespace: cfg.Namespace}, nil
+}
+
+func (k *Kubernetes) Name() string {
+	return BackendKubernetes
+}
+
+func (k *Kubernetes) Put(ctx context.Context, key string, secret map[string]interface{}) error {
+	data, err := json.Marshal(secret)
+	if err != nil {
+		return ErrStoreSecret(err, k.Name())
+	}
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   secretName(key),
+			Labels: map[string]string{"app.kubernetes.io/managed-by": "meshery"},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{secretDataKey: data},
+	}
+	secrets := k.Client.CoreV1().Secrets(k.Namespace)
+	_, err = secrets.Update(ctx, s, metav1.UpdateOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = secrets.Create(ctx, s, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return ErrStoreSecret(err, k.Name())
+	}
+	return nil
+}
+
+func (k *Kubernetes) Get(ctx context.Context, key string) (map[string]interface{}, error) {
+	s, err := k.Client.CoreV1().Secrets(k.Namespace).Get(ctx, secretName(key), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, ErrSecretNotFound(key)
+	}
+	if err != nil {
+		return nil, ErrReadSecret(err, k.Name())
+	}
+	secret := map[string]interface{}{}
+	if err := json.Unmarshal(s.Data[secretDataKey], &secret); err != nil {
+		return nil, ErrReadSecret(fmt.Errorf("the Secret %s/%s does not hold a secret of a credential: %w", k.Namespace, s.Name, err), k.Name())
+	}
+	return secret, nil
+}
+
+func (k *Kubernetes) Delete(ctx context.Context, key string) error {
+	err := k.Client.CoreV1().Secrets(k.Namespace).Delete(ctx, secretName(key), metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ErrDeleteSecret(err, k.Name())
+	}
+	return nil
+}
+
+// secretName returns the name of the Kubernetes Secret of the key, the keys of the credentials being their ids
+func secretName(key string) string {
+	return "meshery-credential-" + strings.ToLower(strings.ReplaceAll(key, "/", "-"))
+}
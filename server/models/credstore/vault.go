@@ -0,0 +1,137 @@
+package credstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+// VaultConfig is the configuration of the KV version 2 secrets engine of HashiCorp Vault the secrets are stored in
+type VaultConfig struct {
+	Address string
+	Token   string
+	// Namespace is the Vault Enterprise namespace of the secrets engine, if any
+	Namespace string
+	// Mount is the path the secrets engine is mounted at, eg: secret
+	Mount string
+	// Prefix is the path of the secrets in the secrets engine, eg: meshery/credentials
+	Prefix string
+}
+
+// Vault stores the secrets in the KV version 2 secrets engine of HashiCorp Vault
+type Vault struct {
+	VaultConfig
+	Client *http.Client
+}
+
+// NewVault returns the store of the secrets engine
+func NewVault(cfg VaultConfig) (*Vault, error) {
+	if cfg.Address == "" || cfg.Token == "" {
+		return nil, ErrInvalidConfig(BackendVault, fmt.Errorf("the address and the token of Vault are required"))
+	}
+	if _, err := url.Parse(cfg.Address); err != nil {
+		return nil, ErrInvalidConfig(BackendVault, err)
+	}
+	if cfg.Mount == "" {
+		cfg.Mount = "secret"
+	}
+	return &Vault{VaultConfig: cfg, Client: &http.Client{Timeout: 10 * time.Second}}, nil
+}
+
+func (v *Vault) Name() string {
+	return BackendVault
+}
+
+func (v *Vault) Put(ctx context.Context, key string, secret map[string]interface{}) error {
+	body, err := json.Marshal(map[string]interface{}{"data": secret})
+	if err != nil {
+		return ErrStoreSecret(err, v.Name())
+	}
+	raw, status, err := v.do(ctx, http.MethodPost, "data", key, body)
+	if err == nil && status >= 300 {
+		err = statusError(status, raw)
+	}
+	if err != nil {
+		return ErrStoreSecret(err, v.Name())
+	}
+	return nil
+}
+
+func (v *Vault) Get(ctx context.Context, key string) (map[string]interface{}, error) {
+	raw, status, err := v.do(ctx, http.MethodGet, "data", key, nil)
+	switch {
+	case err != nil:
+		return nil, ErrReadSecret(err, v.Name())
+	case status == http.StatusNotFound:
+		return nil, ErrSecretNotFound(key)
+	case status >= 300:
+		return nil, ErrReadSecret(statusError(status, raw), v.Name())
+	}
+	var resp struct {
+		Data struct {
+			Data map[string]interface{} `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return nil, ErrReadSecret(err, v.Name())
+	}
+	// a secret whose latest version was deleted, but not its metadata, has no data
+	if resp.Data.Data == nil {
+		return nil, ErrSecretNotFound(key)
+	}
+	return resp.Data.Data, nil
+}
+
+// Delete deletes all the versions of the secret
+func (v *Vault) Delete(ctx context.Context, key string) error {
+	raw, status, err := v.do(ctx, http.MethodDelete, "metadata", key, nil)
+	if err == nil && status >= 300 && status != http.StatusNotFound {
+		err = statusError(status, raw)
+	}
+	if err != nil {
+		return ErrDeleteSecret(err, v.Name())
+	}
+	return nil
+}
+
+// do sends the request to the endpoint of the secret of the key under the API of the secrets engine, eg: data or metadata,
+// and returns the body and the status of the response
+func (v *Vault) do(ctx context.Context, method, api, key string, body []byte) ([]byte, int, error) {
+	u := strings.TrimSuffix(v.Address, "/") + "/v1/" + path.Join(v.Mount, api, v.Prefix, key)
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("X-Vault-Token", v.Token)
+	if v.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.Namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := v.Client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, err
+	}
+	return raw, resp.StatusCode, nil
+}
+
+func statusError(status int, raw []byte) error {
+	return fmt.Errorf("vault responded with status %d: %s", status, strings.TrimSpace(string(raw)))
+}
@@ -0,0 +1,81 @@
+package credstore
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// fakeVault serves the API of a KV version 2 secrets engine mounted at secret, keeping the latest version of the secrets
+func fakeVault(t *testing.T) *httptest.Server {
+	var mu sync.Mutex
+	secrets := map[string]map[string]interface{}{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "root" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		const data, metadata = "/v1/secret/data/", "/v1/secret/metadata/"
+		switch {
+		case r.Method == http.MethodPost && len(r.URL.Path) > len(data) && r.URL.Path[:len(data)] == data:
+			var body struct {
+				Data map[string]interface{} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("invalid body: %v", err)
+			}
+			secrets[r.URL.Path[len(data):]] = body.Data
+		case r.Method == http.MethodGet && len(r.URL.Path) > len(data) && r.URL.Path[:len(data)] == data:
+			secret, ok := secrets[r.URL.Path[len(data):]]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"data": secret}})
+		case r.Method == http.MethodDelete && len(r.URL.Path) > len(metadata) && r.URL.Path[:len(metadata)] == metadata:
+			delete(secrets, r.URL.Path[len(metadata):])
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestVault(t *testing.T) {
+	server := fakeVault(t)
+	defer server.Close()
+	ctx := context.Background()
+
+	vault, err := NewVault(VaultConfig{Address: server.URL, Token: "root", Prefix: "meshery/credentials"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret := map[string]interface{}{"token": "glsa_123"}
+	if err := vault.Put(ctx, "0b5b7b1e", secret); err != nil {
+		t.Fatalf("Put() = %v", err)
+	}
+	got, err := vault.Get(ctx, "0b5b7b1e")
+	if err != nil || !reflect.DeepEqual(got, secret) {
+		t.Errorf("Get() = %v, %v, want %v", got, err, secret)
+	}
+	if err := vault.Delete(ctx, "0b5b7b1e"); err != nil {
+		t.Fatalf("Delete() = %v", err)
+	}
+	if _, err := vault.Get(ctx, "0b5b7b1e"); err == nil {
+		t.Error("Get() of a deleted secret succeeded, want an error")
+	}
+	if err := vault.Delete(ctx, "0b5b7b1e"); err != nil {
+		t.Errorf("Delete() of a deleted secret = %v, want no error", err)
+	}
+
+	vault.Token = "invalid"
+	if err := vault.Put(ctx, "0b5b7b1e", secret); err == nil {
+		t.Error("Put() with an invalid token succeeded, want an error")
+	}
+}
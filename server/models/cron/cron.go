@@ -0,0 +1,175 @@
+// Package cron parses the cron expressions of schedules and computes their next runs.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron expression
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+	// a wildcard day of the month or of the week makes the days match the other field only,
+	// otherwise the days matching either field match
+	domWildcard, dowWildcard bool
+}
+
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6, "jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// 7 is Sunday as well
+	dowField = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+
+	descriptors = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// the number of years Next looks ahead before deciding the schedule never runs, eg: for the 30th of February
+const maxLookAheadYears = 5
+
+// Parse parses a standard cron expression of five fields: minute, hour, day of month, month and day of week.
+// Fields are lists of values, ranges and steps, eg: 0,30 or 9-17 or */15 or 1-31/2, months and days of week can
+// be named, eg: JAN or MON-FRI. The descriptors @yearly, @monthly, @weekly, @daily and @hourly are supported as well.
+func Parse(expr string) (*Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if d, ok := descriptors[strings.ToLower(expr)]; ok {
+		expr = d
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q must have 5 fields, found %d", expr, len(fields))
+	}
+
+	s := &Schedule{}
+	var err error
+	if s.minute, _, err = minuteField.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if s.hour, _, err = hourField.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if s.dom, s.domWildcard, err = domField.parse(fields[2]); err != nil {
+		return nil, err
+	}
+	if s.month, _, err = monthField.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if s.dow, s.dowWildcard, err = dowField.parse(fields[4]); err != nil {
+		return nil, err
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	return s, nil
+}
+
+// parse returns the bitset of the values of the field, and whether the field is a wildcard
+func (f field) parse(expr string) (uint64, bool, error) {
+	var set uint64
+	for _, part := range strings.Split(expr, ",") {
+		rng, step := part, 1
+		if i := strings.Index(part, "/"); i != -1 {
+			s, err := strconv.Atoi(part[i+1:])
+			if err != nil || s <= 0 {
+				return 0, false, fmt.Errorf("invalid step %q of the %s field", part[i+1:], f.name)
+			}
+			rng, step = part[:i], s
+		}
+
+		var start, end int
+		switch {
+		case rng == "*" || rng == "?":
+			start, end = f.min, f.max
+		case strings.Contains(rng, "-"):
+			i := strings.Index(rng, "-")
+			var err error
+			if start, err = f.value(rng[:i]); err != nil {
+				return 0, false, err
+			}
+			if end, err = f.value(rng[i+1:]); err != nil {
+				return 0, false, err
+			}
+		default:
+			var err error
+			if start, err = f.value(rng); err != nil {
+				return 0, false, err
+			}
+			end = start
+			if step != 1 {
+				end = f.max
+			}
+		}
+		if start > end {
+			return 0, false, fmt.Errorf("invalid range %q of the %s field", rng, f.name)
+		}
+		for v := start; v <= end; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+	return set, expr == "*" || expr == "?", nil
+}
+
+func (f field) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid value %q of the %s field, expected %d-%d", s, f.name, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Next returns the first time after t the schedule runs at, in the location of t.
+// It returns the zero time if the schedule never runs.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, 0, loc)
+	limit := t.Year() + maxLookAheadYears
+
+	for t.Year() <= limit {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+	if s.domWildcard || s.dowWildcard {
+		return dom && dow
+	}
+	return dom || dow
+}
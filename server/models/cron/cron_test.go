@@ -0,0 +1,70 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNext(t *testing.T) {
+	// Wednesday
+	from := time.Date(2024, time.January, 10, 10, 30, 15, 0, time.UTC)
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{expr: "* * * * *", want: time.Date(2024, time.January, 10, 10, 31, 0, 0, time.UTC)},
+		{expr: "*/15 * * * *", want: time.Date(2024, time.January, 10, 10, 45, 0, 0, time.UTC)},
+		{expr: "0 2 * * *", want: time.Date(2024, time.January, 11, 2, 0, 0, 0, time.UTC)},
+		{expr: "@daily", want: time.Date(2024, time.January, 11, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 9-17 * * MON-FRI", want: time.Date(2024, time.January, 10, 11, 0, 0, 0, time.UTC)},
+		{expr: "30 22 * * sat,sun", want: time.Date(2024, time.January, 13, 22, 30, 0, 0, time.UTC)},
+		{expr: "0 0 * * 7", want: time.Date(2024, time.January, 14, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 0 1 feb *", want: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		// the day of the month or the day of the week
+		{expr: "0 0 15 * MON", want: time.Date(2024, time.January, 15, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 0 29 2 *", want: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 0 30 2 *", want: time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			s, err := Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := s.Next(from); !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextInLocation(t *testing.T) {
+	loc := time.FixedZone("IST", 5*3600+1800)
+	s, err := Parse("0 9 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := s.Next(time.Date(2024, time.January, 10, 10, 0, 0, 0, loc))
+	if want := time.Date(2024, time.January, 11, 9, 0, 0, 0, loc); !got.Equal(want) {
+		t.Errorf("Next() = %v, want %v", got, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"* * * foo *",
+	} {
+		if _, err := Parse(expr); err == nil {
+			t.Errorf("Parse(%q) error = nil, want an error", expr)
+		}
+	}
+}
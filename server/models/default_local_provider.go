@@ -48,6 +48,8 @@ type DefaultLocalProvider struct {
 	MesheryK8sContextPersister      *MesheryK8sContextPersister
 	GenericPersister                *database.Handler
 	KubeClient                      *mesherykube.Client
+	// OIDC signs the users in with an OpenID Connect issuer when set, the users are otherwise not authenticated
+	OIDC *OIDCAuthenticator
 }
 
 // Initialize will initialize the local provider
@@ -59,6 +61,9 @@ func (l *DefaultLocalProvider) Initialize() {
 		"No performance or conformance test result history",
 		"Free Use",
 	}
+	if l.OIDC != nil {
+		l.ProviderDescription = append(l.ProviderDescription, "Single sign-on with OpenID Connect")
+	}
 	l.ProviderType = LocalProviderType
 	l.PackageVersion = viper.GetString("BUILD")
 	l.PackageURL = ""
@@ -106,8 +111,26 @@ func (l *DefaultLocalProvider) GetProviderCapabilities(w http.ResponseWriter, _
 }
 
 // InitiateLogin - initiates login flow and returns a true to indicate the handler to "return" or false to continue
-func (l *DefaultLocalProvider) InitiateLogin(_ http.ResponseWriter, _ *http.Request, _ bool) {
-	// l.issueSession(w, r, fromMiddleWare)
+//
+// The users are redirected to the OpenID Connect issuer when OIDC is set, and then to the path of the ref query parameter
+func (l *DefaultLocalProvider) InitiateLogin(w http.ResponseWriter, r *http.Request, _ bool) {
+	if l.OIDC == nil {
+		return
+	}
+	if _, err := l.OIDC.Session(r); err == nil {
+		http.Redirect(w, r, "/", http.StatusFound)
+		return
+	}
+	if err := l.OIDC.StartLogin(w, r, l.oidcCallbackURL(r), r.URL.Query().Get("ref")); err != nil {
+		logrus.Error(err)
+		http.Error(w, err.Error(), http.StatusBadGateway)
+	}
+}
+
+// oidcCallbackURL returns the URL the OpenID Connect issuer redirects the users to once signed in
+func (l *DefaultLocalProvider) oidcCallbackURL(r *http.Request) string {
+	callbackURL, _ := r.Context().Value(MesheryServerCallbackURL).(string)
+	return callbackURL
 }
 
 func (l *DefaultLocalProvider) fetchUserDetails() *User {
@@ -119,8 +142,11 @@ func (l *DefaultLocalProvider) fetchUserDetails() *User {
 	}
 }
 
-// GetUserDetails - returns the user details
-func (l *DefaultLocalProvider) GetUserDetails(_ *http.Request) (*User, error) {
+// GetUserDetails - returns the user details, the ones of the session when the users sign in with OpenID Connect
+func (l *DefaultLocalProvider) GetUserDetails(req *http.Request) (*User, error) {
+	if l.OIDC != nil {
+		return l.OIDC.Session(req)
+	}
 	return l.fetchUserDetails(), nil
 }
 
@@ -165,22 +191,38 @@ func (l *DefaultLocalProvider) GetUsersKeys(_, _, _, _, _, _ string) ([]byte, er
 }
 
 // GetSession - returns the session
-func (l *DefaultLocalProvider) GetSession(_ *http.Request) error {
+func (l *DefaultLocalProvider) GetSession(req *http.Request) error {
+	if l.OIDC != nil {
+		_, err := l.OIDC.Session(req)
+		return err
+	}
 	return nil
 }
 
 // GetProviderToken - returns provider token
-func (l *DefaultLocalProvider) GetProviderToken(_ *http.Request) (string, error) {
+func (l *DefaultLocalProvider) GetProviderToken(req *http.Request) (string, error) {
+	if l.OIDC != nil {
+		return SessionToken(req)
+	}
 	return "", nil
 }
 
 // Logout - logout from provider backend
-func (l *DefaultLocalProvider) Logout(_ http.ResponseWriter, _ *http.Request) error {
+func (l *DefaultLocalProvider) Logout(w http.ResponseWriter, _ *http.Request) error {
+	if l.OIDC != nil {
+		l.OIDC.EndSession(w)
+	}
 	return nil
 }
 
 // HandleUnAuthenticated - logout from provider backend
+//
+// The API requests are rejected rather than redirected to the OpenID Connect issuer, which the clients cannot follow
 func (l *DefaultLocalProvider) HandleUnAuthenticated(w http.ResponseWriter, req *http.Request) {
+	if l.OIDC != nil && strings.HasPrefix(req.URL.Path, "/api/") {
+		http.Error(w, "the session is missing or has expired, sign in at /user/login", http.StatusUnauthorized)
+		return
+	}
 	http.Redirect(w, req, "/user/login", http.StatusFound)
 }
 
@@ -455,20 +497,38 @@ func (l *DefaultLocalProvider) RecordPreferences(_ *http.Request, userID string,
 	return l.MapPreferencePersister.WriteToPersister(userID, data)
 }
 
-// UpdateToken - specific to remote auth
-func (l *DefaultLocalProvider) UpdateToken(http.ResponseWriter, *http.Request) string {
-	return ""
+// UpdateToken - returns the token of the session when the users sign in with OpenID Connect
+func (l *DefaultLocalProvider) UpdateToken(_ http.ResponseWriter, req *http.Request) string {
+	if l.OIDC == nil {
+		return ""
+	}
+	token, _ := SessionToken(req)
+	return token
 }
 
-// TokenHandler - specific to remote auth
-func (l *DefaultLocalProvider) TokenHandler(_ http.ResponseWriter, _ *http.Request, _ bool) {
+// TokenHandler - handles the callback of the OpenID Connect issuer, which redirects the users to it once signed in
+func (l *DefaultLocalProvider) TokenHandler(w http.ResponseWriter, r *http.Request, _ bool) {
+	if l.OIDC == nil {
+		return
+	}
+	returnTo, err := l.OIDC.FinishLogin(w, r, l.oidcCallbackURL(r))
+	if err != nil {
+		logrus.Error(err)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	http.Redirect(w, r, returnTo, http.StatusFound)
 }
 
 // ExtractToken - Returns the auth token and the provider type
-func (l *DefaultLocalProvider) ExtractToken(w http.ResponseWriter, _ *http.Request) {
+func (l *DefaultLocalProvider) ExtractToken(w http.ResponseWriter, req *http.Request) {
+	token := ""
+	if l.OIDC != nil {
+		token, _ = SessionToken(req)
+	}
 	resp := map[string]interface{}{
 		"meshery-provider": l.Name(),
-		tokenName:          "",
+		tokenName:          token,
 	}
 	logrus.Debugf("token sent for meshery-provider %v", l.Name())
 	if err := json.NewEncoder(w).Encode(resp); err != nil {
@@ -616,6 +676,18 @@ func (l *DefaultLocalProvider) GetMesheryPattern(_ *http.Request, patternID stri
 	return l.MesheryPatternPersister.GetMesheryPattern(id)
 }
 
+// GetMesheryPatternRevisions gets the revisions of the pattern for the given patternID
+func (l *DefaultLocalProvider) GetMesheryPatternRevisions(_ *http.Request, patternID string) ([]byte, error) {
+	id := uuid.FromStringOrNil(patternID)
+	return l.MesheryPatternPersister.GetMesheryPatternRevisions(id)
+}
+
+// RestoreMesheryPatternRevision restores the pattern for the given patternID to the given revision
+func (l *DefaultLocalProvider) RestoreMesheryPatternRevision(_ *http.Request, patternID string, revision int) ([]byte, error) {
+	id := uuid.FromStringOrNil(patternID)
+	return l.MesheryPatternPersister.RestoreMesheryPatternRevision(id, revision)
+}
+
 // DeleteMesheryPattern deletes a meshery pattern with the given id
 func (l *DefaultLocalProvider) DeleteMesheryPattern(_ *http.Request, patternID string) ([]byte, error) {
 	id := uuid.FromStringOrNil(patternID)
@@ -986,6 +1058,10 @@ func (l *DefaultLocalProvider) GetConnectionsStatus(_ *http.Request, _ string) (
 	return nil, ErrLocalProviderSupport
 }
 
+func (l *DefaultLocalProvider) GetConnectionByID(_ *http.Request, _ uuid.UUID) (*Connection, error) {
+	return nil, ErrLocalProviderSupport
+}
+
 func (l *DefaultLocalProvider) UpdateConnection(_ *http.Request, _ *Connection) (*Connection, error) {
 	return nil, ErrLocalProviderSupport
 }
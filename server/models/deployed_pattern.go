@@ -0,0 +1,53 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+// DeployedPattern is a design deployed to a Kubernetes context, whose resources are compared to the components of the
+// design in the background to detect the changes made to them outside of Meshery
+type DeployedPattern struct {
+	ID     uuid.UUID `json:"id" gorm:"primaryKey"`
+	UserID string    `json:"user_id" gorm:"index"`
+	// PatternID is the id of the saved design, empty for designs deployed without being saved
+	PatternID string `json:"pattern_id"`
+	Name      string `json:"name"`
+	ContextID string `json:"context_id" gorm:"index"`
+	// PatternFile is the design as it was deployed, with the values of its variables
+	PatternFile string `json:"-"`
+
+	// The user and the provider of the session of the deployment, the checks use them as they run without a session
+	UserName     string `json:"-"`
+	ProviderName string `json:"-"`
+	Token        string `json:"-"`
+
+	// Drift is the JSON list of the changes deploying the design again would make to the resources of the context
+	Drift            string     `json:"-"`
+	Drifted          bool       `json:"drifted"`
+	LastCheckedAt    *time.Time `json:"last_checked_at,omitempty"`
+	LastCheckError   string     `json:"last_check_error,omitempty"`
+	LastReconciledAt *time.Time `json:"last_reconciled_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// DriftedResources returns the resources of the context which drifted from the components of the design
+func (dp *DeployedPattern) DriftedResources() []core.ResourceChange {
+	changes := []core.ResourceChange{}
+	_ = json.Unmarshal([]byte(dp.Drift), &changes)
+	return changes
+}
+
+// MarshalJSON includes the drifted resources in the JSON of the deployed design
+func (dp DeployedPattern) MarshalJSON() ([]byte, error) {
+	type deployedPattern DeployedPattern
+	return json.Marshal(struct {
+		deployedPattern
+		DriftedResources []core.ResourceChange `json:"drifted_resources"`
+	}{deployedPattern(dp), dp.DriftedResources()})
+}
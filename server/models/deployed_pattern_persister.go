@@ -0,0 +1,75 @@
+package models
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// DeployedPatternPersister is the persister for the designs deployed to Kubernetes contexts
+type DeployedPatternPersister struct {
+	DB *database.Handler
+}
+
+// SaveDeployedPattern stores the deployed design, generating its ID if it has none
+func (dpp *DeployedPatternPersister) SaveDeployedPattern(dp *DeployedPattern) error {
+	if dp.ID == uuid.Nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+		dp.ID = id
+	}
+	return dpp.DB.Save(dp).Error
+}
+
+// GetDeployedPattern returns the deployed design with the ID, or nil if there is none
+func (dpp *DeployedPatternPersister) GetDeployedPattern(id uuid.UUID) (*DeployedPattern, error) {
+	var deployed []DeployedPattern
+	if err := dpp.DB.Where("id = ?", id).Limit(1).Find(&deployed).Error; err != nil {
+		return nil, err
+	}
+	if len(deployed) == 0 {
+		return nil, nil
+	}
+	return &deployed[0], nil
+}
+
+// FindDeployedPattern returns the design of the user deployed to the context, or nil if there is none.
+// Designs are identified by their id, or by their name if they were deployed without being saved.
+func (dpp *DeployedPatternPersister) FindDeployedPattern(userID, contextID, patternID, name string) (*DeployedPattern, error) {
+	query := dpp.DB.Where("user_id = ? AND context_id = ? AND pattern_id = ?", userID, contextID, patternID)
+	if patternID == "" {
+		query = query.Where("name = ?", name)
+	}
+	var deployed []DeployedPattern
+	if err := query.Limit(1).Find(&deployed).Error; err != nil {
+		return nil, err
+	}
+	if len(deployed) == 0 {
+		return nil, nil
+	}
+	return &deployed[0], nil
+}
+
+// GetDeployedPatterns returns the deployed designs of the user, only the drifted ones if drifted is true
+func (dpp *DeployedPatternPersister) GetDeployedPatterns(userID string, drifted bool) ([]DeployedPattern, error) {
+	query := dpp.DB.Where("user_id = ?", userID)
+	if drifted {
+		query = query.Where("drifted = ?", true)
+	}
+	deployed := []DeployedPattern{}
+	err := query.Order("created_at").Find(&deployed).Error
+	return deployed, err
+}
+
+// GetAllDeployedPatterns returns the deployed designs of all the users
+func (dpp *DeployedPatternPersister) GetAllDeployedPatterns() ([]DeployedPattern, error) {
+	deployed := []DeployedPattern{}
+	err := dpp.DB.Order("created_at").Find(&deployed).Error
+	return deployed, err
+}
+
+// DeleteDeployedPattern deletes the deployed design
+func (dpp *DeployedPatternPersister) DeleteDeployedPattern(id uuid.UUID) error {
+	return dpp.DB.Where("id = ?", id).Delete(&DeployedPattern{}).Error
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestDeployedPatternPersister(t *testing.T) {
+	for engine, db := range testDatabases(t) {
+		t.Run(engine, func(t *testing.T) {
+			if err := db.AutoMigrate(&DeployedPattern{}); err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() {
+				_ = db.Migrator().DropTable(&DeployedPattern{})
+			})
+
+			persister := &DeployedPatternPersister{DB: db}
+			saved := &DeployedPattern{UserID: "alice", PatternID: "design", Name: "web", ContextID: "c1", Token: "secret"}
+			unsaved := &DeployedPattern{UserID: "alice", Name: "db", ContextID: "c1", Drifted: true, Drift: `[{"kind":"Deployment","name":"db"}]`}
+			other := &DeployedPattern{UserID: "bob", PatternID: "design", Name: "web", ContextID: "c1", Drifted: true}
+			for _, dp := range []*DeployedPattern{saved, unsaved, other} {
+				if err := persister.SaveDeployedPattern(dp); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if deployed, err := persister.GetDeployedPatterns("alice", false); err != nil || len(deployed) != 2 {
+				t.Errorf("the deployed designs of alice are %+v, %v, want her 2 designs", deployed, err)
+			}
+			deployed, err := persister.GetDeployedPatterns("alice", true)
+			if err != nil || len(deployed) != 1 || deployed[0].ID != unsaved.ID {
+				t.Errorf("the drifted designs of alice are %+v, %v, want the drifted one only", deployed, err)
+			}
+
+			found, err := persister.FindDeployedPattern("bob", "c1", "design", "")
+			if err != nil || found == nil || found.ID != other.ID {
+				t.Errorf("the design of bob found is %+v, %v", found, err)
+			}
+			found, err = persister.FindDeployedPattern("bob", "c1", "", "db")
+			if err != nil || found != nil {
+				t.Errorf("the unsaved design of alice was found for bob: %+v, %v", found, err)
+			}
+			found, err = persister.FindDeployedPattern("alice", "c1", "", "db")
+			if err != nil || found == nil || found.ID != unsaved.ID {
+				t.Errorf("the unsaved design of alice found by its name is %+v, %v", found, err)
+			}
+
+			if err := persister.DeleteDeployedPattern(saved.ID); err != nil {
+				t.Fatal(err)
+			}
+			if got, err := persister.GetDeployedPattern(saved.ID); err != nil || got != nil {
+				t.Errorf("the deleted design is %+v, %v", got, err)
+			}
+		})
+	}
+}
+
+func TestDeployedPatternJSON(t *testing.T) {
+	dp := DeployedPattern{UserID: "alice", Name: "web", Token: "secret", UserName: "alice@example.com", Drift: `[{"kind":"Deployment","name":"web"}]`}
+	byt, err := json.Marshal(dp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(byt), "secret") || strings.Contains(string(byt), "alice@example.com") {
+		t.Errorf("the session of the deployment is exposed in %s", byt)
+	}
+	var decoded struct {
+		DriftedResources []map[string]interface{} `json:"drifted_resources"`
+	}
+	if err := json.Unmarshal(byt, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.DriftedResources) != 1 {
+		t.Errorf("the drifted resources of %s are not included", byt)
+	}
+}
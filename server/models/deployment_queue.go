@@ -0,0 +1,205 @@
+package models
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// States of the deployments in the DeploymentQueue
+const (
+	DeploymentQueued  = "queued"
+	DeploymentRunning = "running"
+)
+
+// QueuedDeployment is a design deployment admitted to or waiting in the DeploymentQueue
+type QueuedDeployment struct {
+	ID     uuid.UUID `json:"id"`
+	UserID string    `json:"user_id"`
+	Name   string    `json:"name"`
+	// Clusters the deployment deploys to
+	Clusters []string `json:"clusters"`
+	State    string   `json:"state"`
+	// Position of the deployment in the queue starting at 1, 0 once it is running
+	Position  int        `json:"position"`
+	QueuedAt  time.Time  `json:"queued_at"`
+	StartedAt *time.Time `json:"started_at,omitempty"`
+
+	ready chan struct{}
+}
+
+// DeploymentQueue caps the number of design deployments running concurrently on every Kubernetes cluster,
+// so that many users deploying at once do not get throttled by the API server.
+// Deployments exceeding the limit of any of their clusters wait in first come, first served order.
+type DeploymentQueue struct {
+	// maximum number of deployments running on a cluster, 0 for no limit
+	limit int
+
+	mx          sync.Mutex
+	running     map[string]int
+	waiting     []*QueuedDeployment
+	deployments map[uuid.UUID]*QueuedDeployment
+}
+
+// NewDeploymentQueue returns a queue running at most limit deployments on every cluster, or any number of them if limit is not positive
+func NewDeploymentQueue(limit int) *DeploymentQueue {
+	if limit < 0 {
+		limit = 0
+	}
+	return &DeploymentQueue{
+		limit:       limit,
+		running:     make(map[string]int),
+		deployments: make(map[uuid.UUID]*QueuedDeployment),
+	}
+}
+
+// Acquire waits until the deployment can run on all of its clusters and returns the function releasing its slots once it is done.
+// onQueued is invoked with the status of the deployment if it has to wait.
+// The deployment leaves the queue if ctx is done before it is admitted, in which case the error of ctx is returned.
+func (dq *DeploymentQueue) Acquire(ctx context.Context, id uuid.UUID, userID, name string, clusters []string, onQueued func(QueuedDeployment)) (func(), error) {
+	d := &QueuedDeployment{
+		ID:       id,
+		UserID:   userID,
+		Name:     name,
+		Clusters: dedupeClusters(clusters),
+		State:    DeploymentQueued,
+		QueuedAt: time.Now(),
+		ready:    make(chan struct{}),
+	}
+
+	dq.mx.Lock()
+	dq.deployments[id] = d
+	dq.waiting = append(dq.waiting, d)
+	dq.dispatch()
+	queued := d.State == DeploymentQueued
+	status := dq.status(d)
+	dq.mx.Unlock()
+
+	if queued && onQueued != nil {
+		onQueued(status)
+	}
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() { dq.release(d) })
+	}
+	select {
+	case <-d.ready:
+		return release, nil
+	case <-ctx.Done():
+	}
+
+	dq.mx.Lock()
+	if d.State == DeploymentRunning {
+		// admitted while ctx was done
+		dq.mx.Unlock()
+		release()
+		return nil, ctx.Err()
+	}
+	for i, w := range dq.waiting {
+		if w == d {
+			dq.waiting = append(dq.waiting[:i], dq.waiting[i+1:]...)
+			break
+		}
+	}
+	delete(dq.deployments, id)
+	// the deployment no longer holds back the ones queued after it
+	dq.dispatch()
+	dq.mx.Unlock()
+	return nil, ctx.Err()
+}
+
+// List returns the deployments of the user which are running or queued, or of every user if userID is empty
+func (dq *DeploymentQueue) List(userID string) []QueuedDeployment {
+	dq.mx.Lock()
+	defer dq.mx.Unlock()
+
+	list := make([]QueuedDeployment, 0)
+	for _, d := range dq.deployments {
+		if userID == "" || d.UserID == userID {
+			list = append(list, dq.status(d))
+		}
+	}
+	return list
+}
+
+// Limit returns the maximum number of deployments running concurrently on a cluster, 0 for no limit
+func (dq *DeploymentQueue) Limit() int {
+	return dq.limit
+}
+
+func (dq *DeploymentQueue) release(d *QueuedDeployment) {
+	dq.mx.Lock()
+	defer dq.mx.Unlock()
+
+	for _, c := range d.Clusters {
+		dq.running[c]--
+		if dq.running[c] <= 0 {
+			delete(dq.running, c)
+		}
+	}
+	delete(dq.deployments, d.ID)
+	dq.dispatch()
+}
+
+// dispatch admits the waiting deployments in order. The clusters of a deployment which cannot be admitted are
+// reserved for it, so that deployments queued after it cannot starve it. Must be called with dq.mx held.
+func (dq *DeploymentQueue) dispatch() {
+	reserved := make(map[string]bool)
+	waiting := dq.waiting[:0]
+	for _, d := range dq.waiting {
+		admissible := true
+		for _, c := range d.Clusters {
+			if reserved[c] || (dq.limit > 0 && dq.running[c] >= dq.limit) {
+				admissible = false
+				break
+			}
+		}
+		if !admissible {
+			for _, c := range d.Clusters {
+				reserved[c] = true
+			}
+			waiting = append(waiting, d)
+			continue
+		}
+		for _, c := range d.Clusters {
+			dq.running[c]++
+		}
+		now := time.Now()
+		d.State = DeploymentRunning
+		d.StartedAt = &now
+		close(d.ready)
+	}
+	for i := len(waiting); i < len(dq.waiting); i++ {
+		dq.waiting[i] = nil
+	}
+	dq.waiting = waiting
+}
+
+// status returns a copy of the deployment along with its position. Must be called with dq.mx held.
+func (dq *DeploymentQueue) status(d *QueuedDeployment) QueuedDeployment {
+	status := *d
+	status.ready = nil
+	status.Clusters = append([]string{}, d.Clusters...)
+	for i, w := range dq.waiting {
+		if w == d {
+			status.Position = i + 1
+			break
+		}
+	}
+	return status
+}
+
+func dedupeClusters(clusters []string) []string {
+	seen := make(map[string]bool, len(clusters))
+	deduped := make([]string, 0, len(clusters))
+	for _, c := range clusters {
+		if !seen[c] {
+			seen[c] = true
+			deduped = append(deduped, c)
+		}
+	}
+	return deduped
+}
@@ -0,0 +1,132 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// acquired is the result of an Acquire waiting in the queue
+type acquired struct {
+	release func()
+	err     error
+}
+
+// acquireQueued starts an Acquire which is expected to wait in the queue, it returns once the deployment is queued
+func acquireQueued(t *testing.T, ctx context.Context, dq *DeploymentQueue, userID string, clusters ...string) (uuid.UUID, <-chan acquired) {
+	t.Helper()
+	id := uuid.Must(uuid.NewV4())
+	queued := make(chan QueuedDeployment, 1)
+	result := make(chan acquired, 1)
+	go func() {
+		release, err := dq.Acquire(ctx, id, userID, "design", clusters, func(d QueuedDeployment) { queued <- d })
+		result <- acquired{release, err}
+	}()
+	select {
+	case d := <-queued:
+		if d.State != DeploymentQueued || d.Position == 0 {
+			t.Errorf("the deployment waiting in the queue has the state %s at position %d", d.State, d.Position)
+		}
+	case r := <-result:
+		t.Fatalf("the deployment was admitted to %v over the limit: %v", clusters, r.err)
+	}
+	return id, result
+}
+
+func TestDeploymentQueue(t *testing.T) {
+	ctx := context.Background()
+	dq := NewDeploymentQueue(1)
+
+	releaseA, err := dq.Acquire(ctx, uuid.Must(uuid.NewV4()), "alice", "design", []string{"c1", "c1"}, func(QueuedDeployment) {
+		t.Error("the first deployment of the cluster was queued")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, resultB := acquireQueued(t, ctx, dq, "bob", "c1")
+	// the clusters of a queued deployment are reserved for it, even when their limit is not reached
+	_, resultC := acquireQueued(t, ctx, dq, "alice", "c2", "c1")
+	releaseD, err := dq.Acquire(ctx, uuid.Must(uuid.NewV4()), "carol", "design", []string{"c3"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	releaseD()
+
+	if list := dq.List("bob"); len(list) != 1 || list[0].UserID != "bob" || list[0].Position != 1 {
+		t.Errorf("the deployments of bob are %+v, want his queued deployment only", list)
+	}
+	if list := dq.List(""); len(list) != 3 {
+		t.Errorf("%d deployments are listed for every user, want 3", len(list))
+	}
+
+	releaseA()
+	releaseA()
+	b := <-resultB
+	if b.err != nil {
+		t.Fatal(b.err)
+	}
+	select {
+	case <-resultC:
+		t.Fatal("the deployment queued behind another one on c1 was admitted first")
+	default:
+	}
+	b.release()
+	if c := <-resultC; c.err != nil {
+		t.Fatal(c.err)
+	} else {
+		c.release()
+	}
+	if list := dq.List(""); len(list) != 0 {
+		t.Errorf("the queue still holds %+v once every deployment is released", list)
+	}
+}
+
+func TestDeploymentQueueCancel(t *testing.T) {
+	dq := NewDeploymentQueue(1)
+	release, err := dq.Acquire(context.Background(), uuid.Must(uuid.NewV4()), "alice", "design", []string{"c1"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	id, cancelled := acquireQueued(t, ctx, dq, "bob", "c1")
+	_, next := acquireQueued(t, context.Background(), dq, "carol", "c1")
+	cancel()
+	select {
+	case r := <-cancelled:
+		if !errors.Is(r.err, context.Canceled) || r.release != nil {
+			t.Errorf("the cancelled deployment returned %v", r.err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the cancelled deployment is still waiting")
+	}
+	for _, d := range dq.List("") {
+		if d.ID == id {
+			t.Error("the cancelled deployment is still in the queue")
+		}
+	}
+
+	release()
+	if r := <-next; r.err != nil {
+		t.Fatal(r.err)
+	} else {
+		r.release()
+	}
+}
+
+func TestDeploymentQueueUnlimited(t *testing.T) {
+	dq := NewDeploymentQueue(-1)
+	for i := 0; i < 3; i++ {
+		if _, err := dq.Acquire(context.Background(), uuid.Must(uuid.NewV4()), "alice", "design", []string{"c1"}, func(QueuedDeployment) {
+			t.Error("a deployment was queued without limit")
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if dq.Limit() != 0 {
+		t.Errorf("the limit of the unlimited queue is %d", dq.Limit())
+	}
+}
@@ -3,6 +3,7 @@ package models
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/layer5io/meshkit/errors"
@@ -98,6 +99,28 @@ const (
 	ErrPersistEventCode                   = "1533"
 	ErrUnreachableKubeAPICode             = "1534"
 	ErrFlushMeshSyncDataCode              = "1535"
+	ErrIndexRelationshipUsageCode         = "1544"
+	ErrServerShuttingDownCode             = "1581"
+	ErrUnknownJobTypeCode                 = "1584"
+	ErrRunJobCode                         = "1585"
+	ErrJobPanicCode                       = "1586"
+	ErrDiscoverClusterCode                = "1594"
+	ErrInvalidConnectionTransitionCode    = "1598"
+	ErrInvalidRoleCode                    = "1608"
+	ErrInvalidAPITokenCode                = "1610"
+	ErrInvalidAPITokenScopeCode           = "1611"
+	ErrOIDCConfigCode                     = "1613"
+	ErrOIDCDiscoveryCode                  = "1614"
+	ErrOIDCLoginCode                      = "1615"
+	ErrInvalidOIDCSessionCode             = "1616"
+	ErrInvalidWebhookCode                 = "1619"
+	ErrWebhookDeliveryCode                = "1620"
+	ErrInvalidNotificationCode            = "1622"
+	ErrNotificationDeliveryCode           = "1623"
+	ErrInvalidPerfProfileTemplateCode     = "1628"
+	ErrInvalidPerfSLOCode                 = "1631"
+	ErrUnauthorizedContextCode            = "1632"
+	ErrWebhookPrivateAddressCode          = "1634"
 )
 
 var (
@@ -371,3 +394,98 @@ func ErrUnreachableKubeAPI(err error, server string) error {
 func ErrFlushMeshSyncData(err error, contextName, server string) error {
 	return errors.New(ErrFlushMeshSyncDataCode, errors.Alert, []string{"Unable to flush MeshSync data for context %s at %s "}, []string{err.Error()}, []string{"Meshery Database handler is not accessible to perform operations"}, []string{"Restart Meshery Server or Perform Hard Reset"})
 }
+
+func ErrIndexRelationshipUsage(err error) error {
+	return errors.New(ErrIndexRelationshipUsageCode, errors.Alert, []string{"Unable to index the usage of relationships in saved designs"}, []string{err.Error()}, []string{"Meshery Database handler is not accessible to perform operations"}, []string{"Restart Meshery Server or Perform Hard Reset"})
+}
+
+func ErrServerShuttingDown() error {
+	return errors.New(ErrServerShuttingDownCode, errors.Alert, []string{"Meshery Server is shutting down"}, []string{"The deployment was not started or was interrupted because Meshery Server is shutting down"}, []string{"Meshery Server received a termination signal and is draining the running deployments"}, []string{"Retry once Meshery Server is restarted", "Resume the interrupted deployment once Meshery Server is restarted"})
+}
+
+func ErrUnknownJobType(jobType string) error {
+	return errors.New(ErrUnknownJobTypeCode, errors.Alert, []string{fmt.Sprintf("Unknown type of job %s", jobType)}, []string{fmt.Sprintf("No function runs the jobs of type %s", jobType)}, []string{"The job was enqueued before the function running its type was registered."}, []string{"Register the function running the jobs of the type with the job runner before enqueueing them."})
+}
+
+func ErrRunJob(err error, jobType string) error {
+	return errors.New(ErrRunJobCode, errors.Alert, []string{fmt.Sprintf("Could not run the background job of type %s", jobType)}, []string{err.Error()}, []string{"The job failed, it is attempted again until it used up its attempts.", "Meshery Database is not reachable or corrupt."}, []string{"Check the error of the job with the jobs API.", "Visit Settings and reset the Meshery database."})
+}
+
+func ErrJobPanic(r interface{}) error {
+	return errors.New(ErrJobPanicCode, errors.Alert, []string{"The background job panicked"}, []string{fmt.Sprint(r)}, []string{"The function running the job has a bug."}, []string{"Report the error along with the logs of Meshery Server."})
+}
+
+func ErrDiscoverCluster(err error, contextName string) error {
+	return errors.New(ErrDiscoverClusterCode, errors.Alert, []string{"Could not discover the cluster of the Kubernetes context " + contextName}, []string{err.Error()}, []string{"The credentials of the Kubernetes context are invalid or do not allow listing the nodes of the cluster."}, []string{"Ensure the kubeconfig of the context is valid and its credentials allow listing the nodes of the cluster."})
+}
+
+func ErrInvalidConnectionTransition(from, to ConnectionStatus) error {
+	allowed := []string{}
+	for _, s := range from.Transitions() {
+		allowed = append(allowed, string(s))
+	}
+	if len(allowed) == 0 {
+		allowed = append(allowed, "none")
+	}
+	return errors.New(ErrInvalidConnectionTransitionCode, errors.Alert, []string{fmt.Sprintf("A connection cannot transition from %q to %q", from, to)}, []string{fmt.Sprintf("A %q connection can transition to: %s", from, strings.Join(allowed, ", "))}, []string{"The status is not one of the statuses of the lifecycle of the connections.", "The connection changed status since it was last read."}, []string{"Transition the connection to one of the statuses listed by its transitions.", "Reload the connection and retry."})
+}
+
+func ErrInvalidRole(name string) error {
+	return errors.New(ErrInvalidRoleCode, errors.Alert, []string{fmt.Sprintf("%q is not a role", name)}, []string{"The roles of the users are admin, operator and viewer."}, []string{"The role is misspelled."}, []string{"Bind one of the roles listed by the roles API."})
+}
+
+func ErrInvalidAPIToken(reason string) error {
+	return errors.New(ErrInvalidAPITokenCode, errors.Alert, []string{"Invalid API token"}, []string{reason}, []string{"The token is unknown, was revoked or expired."}, []string{"Create a new API token and use it instead."})
+}
+
+func ErrInvalidAPITokenScope(scope Permission) error {
+	return errors.New(ErrInvalidAPITokenScopeCode, errors.Alert, []string{fmt.Sprintf("%q is not a scope of the API tokens", scope)}, []string{"The scopes of the API tokens are the permissions of the roles: view, edit, deploy, manage_system and manage_roles."}, []string{"The scope is misspelled."}, []string{"Restrict the token to the permissions listed by the roles API."})
+}
+
+func ErrOIDCConfig(err error) error {
+	return errors.New(ErrOIDCConfigCode, errors.Alert, []string{"Invalid OpenID Connect configuration of the local provider"}, []string{err.Error()}, []string{"OIDC_ISSUER is set without OIDC_CLIENT_ID.", "OIDC_GROUP_ROLES is not a list of group=role pairs, or lists unknown roles."}, []string{"Set OIDC_CLIENT_ID to the ID of the client registered with the issuer.", "Map the groups to the roles viewer, operator or admin, eg: platform-admins=admin,developers=operator."})
+}
+
+func ErrOIDCDiscovery(err error, issuer string) error {
+	return errors.New(ErrOIDCDiscoveryCode, errors.Alert, []string{fmt.Sprintf("Unable to discover the OpenID Connect issuer %s", issuer)}, []string{err.Error()}, []string{"The issuer is unreachable from Meshery Server.", "OIDC_ISSUER is not the URL of the issuer, which is the iss claim of its tokens."}, []string{"Verify that Meshery Server reaches <issuer>/.well-known/openid-configuration.", "Set OIDC_ISSUER to the issuer advertised by this configuration, eg: the URL of the realm of Keycloak."})
+}
+
+func ErrOIDCLogin(err error) error {
+	return errors.New(ErrOIDCLoginCode, errors.Alert, []string{"Unable to sign in with OpenID Connect"}, []string{err.Error()}, []string{"The issuer denied the sign in.", "The sign in took too long, or was not started by this Meshery Server.", "The client secret or the redirect URL registered with the issuer do not match the ones of Meshery Server."}, []string{"Sign in again at /user/login.", "Verify OIDC_CLIENT_SECRET and OIDC_REDIRECT_URL against the client registered with the issuer."})
+}
+
+func ErrInvalidOIDCSession(err error) error {
+	return errors.New(ErrInvalidOIDCSessionCode, errors.Alert, []string{"Invalid session"}, []string{err.Error()}, []string{"The session has expired.", "Meshery Server restarted without OIDC_SESSION_SECRET, which signs the sessions."}, []string{"Sign in again at /user/login.", "Set OIDC_SESSION_SECRET for the sessions to outlive the restarts of Meshery Server."})
+}
+
+func ErrInvalidWebhook(reason string) error {
+	return errors.New(ErrInvalidWebhookCode, errors.Alert, []string{"Invalid webhook"}, []string{reason}, []string{"The name, the URL or the event types of the webhook are missing or invalid."}, []string{"Register an http or https URL subscribing to the event types deployment.finished, drift.detected or relationship.registered."})
+}
+
+func ErrWebhookDelivery(err error) error {
+	return errors.New(ErrWebhookDeliveryCode, errors.Alert, []string{"Unable to deliver the events to the webhooks"}, []string{err.Error()}, []string{"Meshery Database handler is not accessible to perform operations."}, []string{"Restart Meshery Server, the pending deliveries are attempted again once it is restarted."})
+}
+
+func ErrWebhookPrivateAddress(address string) error {
+	return errors.New(ErrWebhookPrivateAddressCode, errors.Alert, []string{"The webhook is not allowed to reach the address"}, []string{fmt.Sprintf("%s is a loopback, link-local, private or otherwise internal address", address)}, []string{"The URL of the webhook is, or resolves to, an address of the network of Meshery Server, which the webhooks are not allowed to reach."}, []string{"Register a URL reachable from the internet, or set WEBHOOK_ALLOW_PRIVATE_NETWORKS to true if the receivers of the webhooks are in the network of Meshery Server."})
+}
+
+func ErrInvalidNotification(reason string) error {
+	return errors.New(ErrInvalidNotificationCode, errors.Alert, []string{"Invalid notification channel or rule"}, []string{reason}, []string{"The type, the target or the template of the notification channel, or the severity or the template of the rule, are missing or invalid."}, []string{"Use a slack or teams channel with the URL of an incoming webhook, or an email channel with the addresses of its recipients, and a severity among debug, informational, success, warning, error, critical, alert and emergency."})
+}
+
+func ErrNotificationDelivery(err error) error {
+	return errors.New(ErrNotificationDeliveryCode, errors.Alert, []string{"Unable to send the notification"}, []string{err.Error()}, []string{"The incoming webhook of the channel is unreachable or revoked.", "The SMTP server of NOTIFICATION_SMTP_HOST is unreachable or rejected the credentials."}, []string{"Check the URL of the incoming webhook of the channel and send it a test notification.", "Check the NOTIFICATION_SMTP_* settings of Meshery Server."})
+}
+
+func ErrInvalidPerfProfileTemplate(reason string) error {
+	return errors.New(ErrInvalidPerfProfileTemplateCode, errors.Alert, []string{"Invalid performance profile template"}, []string{reason}, []string{"The name, the load options or the SLOs of the template are missing or invalid.", "The endpoints the template is instantiated against are not http or https URLs."}, []string{"Set the load generator to fortio, wrk2 or nighthawk, a positive number of concurrent requests and duration, a positive qps for a constant load or 0 for a max load, and SLOs on p50, p90, p99, p99.9, max, error_rate or qps."})
+}
+
+func ErrInvalidPerfSLO(reason string) error {
+	return errors.New(ErrInvalidPerfSLOCode, errors.Alert, []string{"Invalid SLOs"}, []string{reason}, []string{"The SLOs of the performance profile or of the template are not a list of metrics and positive thresholds, or set several SLOs on a metric."}, []string{"Set the SLOs as a list of {\"metric\": ..., \"threshold\": ...}, on p50, p90, p99, p99.9 or max latencies in milliseconds, on error_rate in percent or on qps."})
+}
+
+func ErrUnauthorizedContext(permission Permission) error {
+	return errors.New(ErrUnauthorizedContextCode, errors.Alert, []string{fmt.Sprintf("The %s permission cannot be checked", permission)}, []string{"The action requires a permission, but it is not taken on behalf of a user whose session was checked."}, []string{"The action is called outside of the requests authenticated with the session of a user."}, []string{"Take the action through the API of Meshery Server with the session or an API token of a user."})
+}
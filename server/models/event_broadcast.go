@@ -4,6 +4,8 @@ import (
 	"sync"
 
 	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/internal/redact"
+	"github.com/layer5io/meshkit/models/events"
 )
 
 type clients struct {
@@ -13,6 +15,9 @@ type clients struct {
 
 type Broadcast struct {
 	clients *sync.Map
+
+	hooksMu sync.RWMutex
+	hooks   []func(uuid.UUID, interface{})
 }
 
 func (c *Broadcast) Subscribe(id uuid.UUID) (chan interface{}, func()) {
@@ -37,7 +42,25 @@ func (c *Broadcast) Subscribe(id uuid.UUID) (chan interface{}, func()) {
 	return ch, unsubscribe
 }
 
+// OnPublish registers a function invoked with the data published to every id, eg: to deliver the events to the webhooks.
+// Unlike subscribers, hooks receive the data published to the ids nobody subscribes to. Hooks must not block.
+func (c *Broadcast) OnPublish(hook func(uuid.UUID, interface{})) {
+	c.hooksMu.Lock()
+	defer c.hooksMu.Unlock()
+	c.hooks = append(c.hooks, hook)
+}
+
+// Publish sends the data to the subscribers of the id, the events are sent with their secrets masked
 func (c *Broadcast) Publish(id uuid.UUID, data interface{}) {
+	if event, ok := data.(*events.Event); ok {
+		data = redact.Event(event)
+	}
+	c.hooksMu.RLock()
+	for _, hook := range c.hooks {
+		hook(id, data)
+	}
+	c.hooksMu.RUnlock()
+
 	clientMap, ok := c.clients.Load(id)
 	if !ok {
 		return
@@ -49,6 +72,21 @@ func (c *Broadcast) Publish(id uuid.UUID, data interface{}) {
 	}
 }
 
+// QueueDepth returns the number of messages published and not yet received by the subscribers
+func (c *Broadcast) QueueDepth() int {
+	depth := 0
+	c.clients.Range(func(_, value interface{}) bool {
+		connectedClient := value.(*clients)
+		connectedClient.mu.Lock()
+		for _, listener := range connectedClient.listeners {
+			depth += len(listener)
+		}
+		connectedClient.mu.Unlock()
+		return true
+	})
+	return depth
+}
+
 func NewBroadcaster() *Broadcast {
 	return &Broadcast{
 		clients: new(sync.Map),
@@ -1,16 +1,57 @@
 package models
 
 import (
+	"time"
+
 	"github.com/gofrs/uuid"
 	"github.com/layer5io/meshkit/models/events"
 )
 
+// EventAcknowledged is the status of the events a user handled, on top of the read and unread statuses of meshkit.
+// The acknowledged events are left out of the counts of the notification center.
+const EventAcknowledged events.EventStatus = "acknowledged"
+
+// EventStatuses are the statuses of the events
+var EventStatuses = []events.EventStatus{events.Unread, events.Read, EventAcknowledged}
+
+// ValidEventStatus tells whether the status is one of EventStatuses
+func ValidEventStatus(status string) bool {
+	for _, s := range EventStatuses {
+		if string(s) == status {
+			return true
+		}
+	}
+	return false
+}
+
+// EventsFilter filters the events of a user, on top of the category, the action, the severity, the status and the
+// description the events of meshkit are filtered on
+type EventsFilter struct {
+	events.EventsFilter
+	// IDs are the IDs of the events, every event when empty
+	IDs []uuid.UUID
+	// ActedUpon are the IDs of the entities the events are linked to, eg: designs or connections, every entity when empty
+	ActedUpon []uuid.UUID
+	// Since and Until bound the time the events were created at, when set
+	Since *time.Time
+	Until *time.Time
+}
+
+// Selective tells whether the filter selects some of the events of the user rather than every one, the bulk
+// operations require one so that they are not applied to every event of the user by mistake
+func (f *EventsFilter) Selective() bool {
+	return len(f.IDs) != 0 || len(f.ActedUpon) != 0 || f.Since != nil || f.Until != nil || len(f.Category) != 0 ||
+		len(f.Action) != 0 || len(f.Severity) != 0 || f.Search != "" || f.Status != ""
+}
+
 type MesheryEvents interface {
-	GetAllEvents(eventFilter *events.EventsFilter, userID uuid.UUID) (*EventsResponse, error)
+	GetAllEvents(eventFilter *EventsFilter, userID uuid.UUID) (*EventsResponse, error)
 	GetEventTypes(userID uuid.UUID) (map[string]interface{}, error)
 	PersistEvent(data *events.Event) error
-	DeleteEvent(eventID uuid.UUID) error
-	UpdateEventStatus(eventID uuid.UUID, status string) (*events.Event, error)
-	BulkUpdateEventStatus(eventID []*uuid.UUID, status string) ([]*events.Event, error)
-	BulkDeleteEvent(eventID []*uuid.UUID) (error)
+	DeleteEvent(eventID uuid.UUID, userID uuid.UUID) error
+	UpdateEventStatus(eventID uuid.UUID, userID uuid.UUID, status string) (*events.Event, error)
+	BulkUpdateEventStatus(eventID []*uuid.UUID, userID uuid.UUID, status string) ([]*events.Event, error)
+	BulkDeleteEvent(eventID []*uuid.UUID, userID uuid.UUID) error
+	UpdateFilteredEventsStatus(eventFilter *EventsFilter, userID uuid.UUID, status string) (int64, error)
+	DeleteFilteredEvents(eventFilter *EventsFilter, userID uuid.UUID) (int64, error)
 }
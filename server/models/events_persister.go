@@ -1,13 +1,18 @@
 package models
 
 import (
+	"strings"
+	"time"
+
 	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/internal/redact"
 	"github.com/layer5io/meshkit/database"
 	"github.com/layer5io/meshkit/models/events"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
-// EventsPersister assists with persisting events in local SQLite DB
+// EventsPersister assists with persisting events in the Meshery database
 type EventsPersister struct {
 	DB *database.Handler
 }
@@ -44,29 +49,9 @@ func (e *EventsPersister) GetEventTypes(userID uuid.UUID) (map[string]interface{
 	return eventTypes, err
 }
 
-func (e *EventsPersister) GetAllEvents(eventsFilter *events.EventsFilter, userID uuid.UUID) (*EventsResponse, error) {
+func (e *EventsPersister) GetAllEvents(eventsFilter *EventsFilter, userID uuid.UUID) (*EventsResponse, error) {
 	eventsDB := []*events.Event{}
-	finder := e.DB.Model(&events.Event{}).Where("user_id = ?", userID)
-
-	if len(eventsFilter.Category) != 0 {
-		finder = finder.Where("category IN ?", eventsFilter.Category)
-	}
-
-	if len(eventsFilter.Action) != 0 {
-		finder = finder.Where("action IN ?", eventsFilter.Action)
-	}
-
-	if len(eventsFilter.Severity) != 0 {
-		finder = finder.Where("severity IN ?", eventsFilter.Severity)
-	}
-
-	if eventsFilter.Search != "" {
-		finder = finder.Where("description LIKE ?", "%"+eventsFilter.Search+"%")
-	}
-
-	if eventsFilter.Status != "" {
-		finder = finder.Where("status = ?", eventsFilter.Status)
-	}
+	finder := filterEvents(e.DB.Model(&events.Event{}), eventsFilter, userID)
 
 	if eventsFilter.Order == "asc" {
 		finder = finder.Order(eventsFilter.SortOn)
@@ -104,29 +89,34 @@ func (e *EventsPersister) GetAllEvents(eventsFilter *events.EventsFilter, userID
 	}, nil
 }
 
-func (e *EventsPersister) UpdateEventStatus(eventID uuid.UUID, status string) (*events.Event, error) {
-	err := e.DB.Model(&events.Event{ID: eventID, Status: events.EventStatus(status)}).Update("status", status).Error
-	if err != nil {
-		return nil, err
+// UpdateEventStatus sets the status of the event of the user. The hooks of the events of meshkit only accept the read and
+// unread statuses, the status column is updated without them.
+func (e *EventsPersister) UpdateEventStatus(eventID uuid.UUID, userID uuid.UUID, status string) (*events.Event, error) {
+	result := e.DB.Model(&events.Event{}).Where("id = ? AND user_id = ?", eventID, userID).UpdateColumns(eventStatusColumns(status))
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
 	}
 
 	updatedEvent := &events.Event{}
-	err = e.DB.Find(updatedEvent, "id = ?", eventID).Error
+	err := e.DB.Find(updatedEvent, "id = ?", eventID).Error
 	if err != nil {
 		return nil, err
 	}
 	return updatedEvent, nil
 }
 
-func (e *EventsPersister) BulkUpdateEventStatus(eventIDs []*uuid.UUID, status string) ([]*events.Event, error) {
+func (e *EventsPersister) BulkUpdateEventStatus(eventIDs []*uuid.UUID, userID uuid.UUID, status string) ([]*events.Event, error) {
 
-	err := e.DB.Model(&events.Event{Status: events.EventStatus(status)}).Where("id IN ?", eventIDs).Update("status", status).Error
+	err := e.DB.Model(&events.Event{}).Where("id IN ? AND user_id = ?", eventIDs, userID).UpdateColumns(eventStatusColumns(status)).Error
 	if err != nil {
 		return nil, err
 	}
-	
+
 	updatedEvent := &[]*events.Event{}
-	err = e.DB.Find(updatedEvent, "id IN ?", eventIDs).Error
+	err = e.DB.Find(updatedEvent, "id IN ? AND user_id = ?", eventIDs, userID).Error
 	if err != nil {
 		return nil, err
 	}
@@ -134,27 +124,46 @@ func (e *EventsPersister) BulkUpdateEventStatus(eventIDs []*uuid.UUID, status st
 	return *updatedEvent, nil
 }
 
-func (e *EventsPersister) DeleteEvent(eventID uuid.UUID) error {
-	err := e.DB.Delete(&events.Event{ID: eventID}).Error
-	if err != nil {
-		return err
+// UpdateFilteredEventsStatus sets the status of the events of the user matching the filter, and returns their count
+func (e *EventsPersister) UpdateFilteredEventsStatus(eventsFilter *EventsFilter, userID uuid.UUID, status string) (int64, error) {
+	result := filterEvents(e.DB.Model(&events.Event{}), eventsFilter, userID).UpdateColumns(eventStatusColumns(status))
+	return result.RowsAffected, result.Error
+}
+
+func (e *EventsPersister) DeleteEvent(eventID uuid.UUID, userID uuid.UUID) error {
+	result := e.DB.Where("id = ? AND user_id = ?", eventID, userID).Delete(&events.Event{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
 	}
 	return nil
 }
 
-func (e *EventsPersister) BulkDeleteEvent(eventIDs []*uuid.UUID) error {
-	err := e.DB.Where("id IN ?", eventIDs).Delete(&events.Event{}).Error
+func (e *EventsPersister) BulkDeleteEvent(eventIDs []*uuid.UUID, userID uuid.UUID) error {
+	err := e.DB.Where("id IN ? AND user_id = ?", eventIDs, userID).Delete(&events.Event{}).Error
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// DeleteFilteredEvents deletes the events of the user matching the filter, and returns their count
+func (e *EventsPersister) DeleteFilteredEvents(eventsFilter *EventsFilter, userID uuid.UUID) (int64, error) {
+	result := filterEvents(e.DB.Model(&events.Event{}), eventsFilter, userID).Delete(&events.Event{})
+	return result.RowsAffected, result.Error
+}
+
+// PersistEvent saves the event with the secrets of its description and of its metadata masked. The event gets the ID
+// it is saved with, so that it is published with the ID it is updated and deleted by.
 func (e *EventsPersister) PersistEvent(event *events.Event) error {
-	err := e.DB.Save(event).Error
+	redacted := redact.Event(event)
+	err := e.DB.Save(redacted).Error
 	if err != nil {
 		return ErrPersistEvent(err)
 	}
+	event.ID = redacted.ID
 	return nil
 }
 
@@ -171,3 +180,50 @@ func (e *EventsPersister) getCountBySeverity(userID uuid.UUID, eventStatus event
 
 	return eventsBySeverity, nil
 }
+
+// eventStatusColumns are the columns updated to set the status of events
+func eventStatusColumns(status string) map[string]interface{} {
+	return map[string]interface{}{"status": status, "updated_at": time.Now()}
+}
+
+// filterEvents restricts the query to the events of the user matching the filter
+func filterEvents(finder *gorm.DB, eventsFilter *EventsFilter, userID uuid.UUID) *gorm.DB {
+	finder = finder.Where("user_id = ?", userID)
+
+	if len(eventsFilter.IDs) != 0 {
+		finder = finder.Where("id IN ?", eventsFilter.IDs)
+	}
+
+	if len(eventsFilter.Category) != 0 {
+		finder = finder.Where("category IN ?", eventsFilter.Category)
+	}
+
+	if len(eventsFilter.Action) != 0 {
+		finder = finder.Where("action IN ?", eventsFilter.Action)
+	}
+
+	if len(eventsFilter.Severity) != 0 {
+		finder = finder.Where("severity IN ?", eventsFilter.Severity)
+	}
+
+	if len(eventsFilter.ActedUpon) != 0 {
+		finder = finder.Where("acted_upon IN ?", eventsFilter.ActedUpon)
+	}
+
+	if eventsFilter.Since != nil {
+		finder = finder.Where("created_at >= ?", *eventsFilter.Since)
+	}
+
+	if eventsFilter.Until != nil {
+		finder = finder.Where("created_at < ?", *eventsFilter.Until)
+	}
+
+	if eventsFilter.Search != "" {
+		finder = finder.Where("lower(description) LIKE ?", "%"+strings.ToLower(eventsFilter.Search)+"%")
+	}
+
+	if eventsFilter.Status != "" {
+		finder = finder.Where("status = ?", eventsFilter.Status)
+	}
+	return finder
+}
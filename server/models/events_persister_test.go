@@ -0,0 +1,100 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/models/events"
+	"gorm.io/gorm"
+)
+
+func TestEventsPersisterFilters(t *testing.T) {
+	for engine, db := range testDatabases(t) {
+		t.Run(engine, func(t *testing.T) {
+			if err := db.AutoMigrate(&events.Event{}); err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() {
+				_ = db.Migrator().DropTable(&events.Event{})
+			})
+
+			persister := &EventsPersister{DB: db}
+			user, other := uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4())
+			design := uuid.Must(uuid.NewV4())
+			now := time.Now().UTC().Truncate(time.Second)
+			event := func(userID uuid.UUID, severity events.EventSeverity, actedUpon uuid.UUID, age time.Duration) *events.Event {
+				e := events.NewEvent().FromUser(userID).FromSystem(uuid.Must(uuid.NewV4())).ActedUpon(actedUpon).
+					WithCategory("pattern").WithAction("deploy").WithSeverity(severity).WithDescription("deploy of design").Build()
+				e.CreatedAt = now.Add(-age)
+				e.UpdatedAt = e.CreatedAt
+				return e
+			}
+			recentError := event(user, events.Error, design, time.Hour)
+			oldError := event(user, events.Error, design, 48*time.Hour)
+			otherDesign := event(user, events.Informational, uuid.Must(uuid.NewV4()), time.Hour)
+			otherUser := event(other, events.Error, design, time.Hour)
+			for _, e := range []*events.Event{recentError, oldError, otherDesign, otherUser} {
+				if err := persister.PersistEvent(e); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			since := now.Add(-24 * time.Hour)
+			filter := &EventsFilter{ActedUpon: []uuid.UUID{design}, Since: &since}
+			filter.SortOn = "created_at"
+			result, err := persister.GetAllEvents(filter, user)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.TotalCount != 1 || result.Events[0].ID != recentError.ID {
+				t.Errorf("the events of the design of the last day are %+v, want the recent error only", result.Events)
+			}
+
+			count, err := persister.UpdateFilteredEventsStatus(&EventsFilter{EventsFilter: events.EventsFilter{Severity: []string{"error"}}}, user, string(EventAcknowledged))
+			if err != nil || count != 2 {
+				t.Fatalf("UpdateFilteredEventsStatus() = %d, %v, want the 2 errors of the user acknowledged", count, err)
+			}
+			filter = &EventsFilter{}
+			filter.Status = EventAcknowledged
+			filter.SortOn = "created_at"
+			if result, err := persister.GetAllEvents(filter, other); err != nil || result.TotalCount != 0 {
+				t.Errorf("the events of another user were acknowledged: %+v, %v", result, err)
+			}
+
+			if err := persister.DeleteEvent(otherUser.ID, user); err != gorm.ErrRecordNotFound {
+				t.Errorf("DeleteEvent() of the event of another user = %v, want %v", err, gorm.ErrRecordNotFound)
+			}
+			if _, err := persister.UpdateEventStatus(otherUser.ID, user, string(events.Read)); err != gorm.ErrRecordNotFound {
+				t.Errorf("UpdateEventStatus() of the event of another user = %v, want %v", err, gorm.ErrRecordNotFound)
+			}
+
+			filter = &EventsFilter{}
+			filter.Status = EventAcknowledged
+			if count, err := persister.DeleteFilteredEvents(filter, user); err != nil || count != 2 {
+				t.Errorf("DeleteFilteredEvents() = %d, %v, want the 2 acknowledged events deleted", count, err)
+			}
+			filter = &EventsFilter{}
+			filter.SortOn = "created_at"
+			if result, err := persister.GetAllEvents(filter, user); err != nil || result.TotalCount != 1 || result.Events[0].ID != otherDesign.ID {
+				t.Errorf("the events left are %+v, %v, want the informational event only", result, err)
+			}
+		})
+	}
+}
+
+func TestEventsFilterSelective(t *testing.T) {
+	if (&EventsFilter{}).Selective() {
+		t.Error("an empty filter is selective")
+	}
+	until := time.Now()
+	for _, filter := range []*EventsFilter{
+		{IDs: []uuid.UUID{uuid.Must(uuid.NewV4())}},
+		{Until: &until},
+		{EventsFilter: events.EventsFilter{Severity: []string{"error"}}},
+	} {
+		if !filter.Selective() {
+			t.Errorf("%+v is not selective", filter)
+		}
+	}
+}
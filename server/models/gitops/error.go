@@ -0,0 +1,23 @@
+package gitops
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshkit/errors"
+)
+
+// Please reference the following before contributing an error code:
+// https://docs.meshery.io/project/contributing/contributing-error
+// https://github.com/meshery/meshkit/blob/master/errors/errors.go
+const (
+	ErrGitSyncCode = "1560"
+	ErrWebhookCode = "1561"
+)
+
+func ErrGitSync(err error, url, branch string) error {
+	return errors.New(ErrGitSyncCode, errors.Alert, []string{fmt.Sprintf("Could not sync designs with branch %s of %s", branch, url)}, []string{err.Error()}, []string{"The repository or the branch does not exist, or is not reachable from Meshery Server", "The token does not grant access to the repository", "The path of the designs does not exist in the branch"}, []string{"Make sure the repository URL and the branch are correct", "Use a token allowed to read and push to the repository", "Make sure the designs are committed at the linked path"})
+}
+
+func ErrWebhook(err error) error {
+	return errors.New(ErrWebhookCode, errors.Alert, []string{"Invalid Git webhook request"}, []string{err.Error()}, []string{"The request is not signed with the secret of the link", "The payload is not a push event of GitHub, GitLab or Gitea"}, []string{"Configure the webhook of the repository with the secret given when the design was linked", "Configure the webhook to send push events with the application/json content type"})
+}
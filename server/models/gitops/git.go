@@ -0,0 +1,175 @@
+package gitops
+
+import (
+	"context"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+// Remote is a branch of a Git repository
+type Remote struct {
+	URL    string
+	Branch string
+	// Token authenticates to the repository over HTTPS, eg: a personal access token of GitHub, optional for public repositories
+	Token string
+}
+
+// Author of the commits pushed to a repository
+type Author struct {
+	Name  string
+	Email string
+}
+
+// Snapshot is the content of the designs of a commit
+type Snapshot struct {
+	SHA string
+	// Files are the contents of the designs by their path in the repository
+	Files map[string][]byte
+}
+
+func (r Remote) auth() transport.AuthMethod {
+	if r.Token == "" {
+		return nil
+	}
+	// the username is ignored by GitHub, GitLab and Gitea when authenticating with a token, but cannot be empty
+	return &http.BasicAuth{Username: "meshery", Password: r.Token}
+}
+
+func (r Remote) clone(ctx context.Context, depth int) (*git.Repository, billy.Filesystem, error) {
+	fs := memfs.New()
+	repo, err := git.CloneContext(ctx, memory.NewStorage(), fs, &git.CloneOptions{
+		URL:           r.URL,
+		Auth:          r.auth(),
+		ReferenceName: plumbing.NewBranchReferenceName(r.Branch),
+		SingleBranch:  true,
+		Depth:         depth,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return repo, fs, nil
+}
+
+// Pull returns the designs of the last commit of the branch at the path, which is either a design file
+// or a directory whose YAML files are designs
+func Pull(ctx context.Context, remote Remote, designsPath string) (Snapshot, error) {
+	repo, fs, err := remote.clone(ctx, 1)
+	if err != nil {
+		return Snapshot{}, ErrGitSync(err, remote.URL, remote.Branch)
+	}
+	head, err := repo.Head()
+	if err != nil {
+		return Snapshot{}, ErrGitSync(err, remote.URL, remote.Branch)
+	}
+
+	designsPath = CleanPath(designsPath)
+	info, err := fs.Stat(designsPath)
+	if err != nil {
+		return Snapshot{}, ErrGitSync(err, remote.URL, remote.Branch)
+	}
+	paths := []string{designsPath}
+	if info.IsDir() {
+		entries, err := fs.ReadDir(designsPath)
+		if err != nil {
+			return Snapshot{}, ErrGitSync(err, remote.URL, remote.Branch)
+		}
+		paths = paths[:0]
+		for _, entry := range entries {
+			if !entry.IsDir() && IsDesignFile(entry.Name()) {
+				paths = append(paths, path.Join(designsPath, entry.Name()))
+			}
+		}
+	}
+
+	snapshot := Snapshot{SHA: head.Hash().String(), Files: make(map[string][]byte, len(paths))}
+	for _, p := range paths {
+		content, err := util.ReadFile(fs, p)
+		if err != nil {
+			return Snapshot{}, ErrGitSync(err, remote.URL, remote.Branch)
+		}
+		snapshot.Files[p] = content
+	}
+	return snapshot, nil
+}
+
+// Push commits the designs to the branch by their path in the repository and pushes the commit.
+// It returns the SHA of the last commit of the branch, which is the one it was at if the designs did not change.
+func Push(ctx context.Context, remote Remote, files map[string][]byte, message string, author Author) (string, error) {
+	repo, fs, err := remote.clone(ctx, 0)
+	if err != nil {
+		return "", ErrGitSync(err, remote.URL, remote.Branch)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		return "", ErrGitSync(err, remote.URL, remote.Branch)
+	}
+
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	for _, name := range paths {
+		p := CleanPath(name)
+		if err := fs.MkdirAll(path.Dir(p), 0755); err != nil {
+			return "", ErrGitSync(err, remote.URL, remote.Branch)
+		}
+		if err := util.WriteFile(fs, p, files[name], os.FileMode(0644)); err != nil {
+			return "", ErrGitSync(err, remote.URL, remote.Branch)
+		}
+		if _, err := wt.Add(p); err != nil {
+			return "", ErrGitSync(err, remote.URL, remote.Branch)
+		}
+	}
+
+	status, err := wt.Status()
+	if err != nil {
+		return "", ErrGitSync(err, remote.URL, remote.Branch)
+	}
+	if status.IsClean() {
+		head, err := repo.Head()
+		if err != nil {
+			return "", ErrGitSync(err, remote.URL, remote.Branch)
+		}
+		return head.Hash().String(), nil
+	}
+
+	hash, err := wt.Commit(message, &git.CommitOptions{
+		Author: &object.Signature{Name: author.Name, Email: author.Email, When: time.Now()},
+	})
+	if err != nil {
+		return "", ErrGitSync(err, remote.URL, remote.Branch)
+	}
+	if err := repo.PushContext(ctx, &git.PushOptions{Auth: remote.auth()}); err != nil && err != git.NoErrAlreadyUpToDate {
+		return "", ErrGitSync(err, remote.URL, remote.Branch)
+	}
+	return hash.String(), nil
+}
+
+// IsDesignFile reports whether the file is a design, by its extension
+func IsDesignFile(name string) bool {
+	ext := strings.ToLower(path.Ext(name))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// CleanPath returns the path relative to the root of the repository
+func CleanPath(p string) string {
+	p = strings.TrimPrefix(path.Clean("/"+p), "/")
+	if p == "" {
+		return "."
+	}
+	return p
+}
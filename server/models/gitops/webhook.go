@@ -0,0 +1,87 @@
+package gitops
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// the largest webhook payload read, GitHub caps the payloads at 25MB
+const maxWebhookPayload = 25 << 20
+
+// PushEvent is a push to a branch of a repository, notified by a webhook
+type PushEvent struct {
+	Branch string
+	SHA    string
+}
+
+type pushPayload struct {
+	Ref   string `json:"ref"`
+	After string `json:"after"`
+}
+
+// ParseWebhook verifies the webhook request of GitHub, GitLab or Gitea was sent with the secret and returns the push it
+// notifies. ok is false for the other events, eg: the ping sent by GitHub when the webhook is created, and for the
+// pushes deleting a branch.
+func ParseWebhook(r *http.Request, secret string) (event PushEvent, ok bool, err error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookPayload))
+	if err != nil {
+		return PushEvent{}, false, ErrWebhook(err)
+	}
+
+	var name string
+	switch {
+	case r.Header.Get("X-GitHub-Event") != "":
+		name = r.Header.Get("X-GitHub-Event")
+		err = verifySignature(body, secret, strings.TrimPrefix(r.Header.Get("X-Hub-Signature-256"), "sha256="))
+	case r.Header.Get("X-Gitea-Event") != "":
+		name = r.Header.Get("X-Gitea-Event")
+		err = verifySignature(body, secret, r.Header.Get("X-Gitea-Signature"))
+	case r.Header.Get("X-Gitlab-Event") != "":
+		name = r.Header.Get("X-Gitlab-Event")
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("X-Gitlab-Token")), []byte(secret)) != 1 {
+			err = fmt.Errorf("the token of the request does not match the secret")
+		}
+	default:
+		return PushEvent{}, false, ErrWebhook(fmt.Errorf("the request is not a webhook of GitHub, GitLab or Gitea"))
+	}
+	if err != nil {
+		return PushEvent{}, false, ErrWebhook(err)
+	}
+	if name != "push" && name != "Push Hook" {
+		return PushEvent{}, false, nil
+	}
+
+	var payload pushPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return PushEvent{}, false, ErrWebhook(err)
+	}
+	branch := strings.TrimPrefix(payload.Ref, "refs/heads/")
+	if branch == payload.Ref || strings.Trim(payload.After, "0") == "" {
+		return PushEvent{}, false, nil
+	}
+	return PushEvent{Branch: branch, SHA: payload.After}, true, nil
+}
+
+// verifySignature checks the hex encoded HMAC-SHA256 signature of the body with the secret
+func verifySignature(body []byte, secret, signature string) error {
+	if signature == "" {
+		return fmt.Errorf("the request is not signed")
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return fmt.Errorf("the signature of the request does not match the secret")
+	}
+	return nil
+}
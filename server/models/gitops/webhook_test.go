@@ -0,0 +1,106 @@
+package gitops
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func sign(body, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestParseWebhook(t *testing.T) {
+	push := `{"ref": "refs/heads/main", "after": "0a1b2c"}`
+	tests := []struct {
+		name    string
+		body    string
+		headers map[string]string
+		want    PushEvent
+		wantOK  bool
+		wantErr bool
+	}{
+		{
+			name:    "GitHub pushes signed with the secret are accepted",
+			body:    push,
+			headers: map[string]string{"X-GitHub-Event": "push", "X-Hub-Signature-256": "sha256=" + sign(push, "s3cret")},
+			want:    PushEvent{Branch: "main", SHA: "0a1b2c"},
+			wantOK:  true,
+		},
+		{
+			name:    "Gitea pushes signed with the secret are accepted",
+			body:    push,
+			headers: map[string]string{"X-Gitea-Event": "push", "X-Gitea-Signature": sign(push, "s3cret")},
+			want:    PushEvent{Branch: "main", SHA: "0a1b2c"},
+			wantOK:  true,
+		},
+		{
+			name:    "GitLab pushes with the secret token are accepted",
+			body:    push,
+			headers: map[string]string{"X-Gitlab-Event": "Push Hook", "X-Gitlab-Token": "s3cret"},
+			want:    PushEvent{Branch: "main", SHA: "0a1b2c"},
+			wantOK:  true,
+		},
+		{
+			name:    "Requests signed with another secret are rejected",
+			body:    push,
+			headers: map[string]string{"X-GitHub-Event": "push", "X-Hub-Signature-256": "sha256=" + sign(push, "other")},
+			wantErr: true,
+		},
+		{
+			name:    "Unsigned requests are rejected",
+			body:    push,
+			headers: map[string]string{"X-GitHub-Event": "push"},
+			wantErr: true,
+		},
+		{
+			name:    "Other events are ignored",
+			body:    `{"zen": "Keep it logically awesome."}`,
+			headers: map[string]string{"X-GitHub-Event": "ping", "X-Hub-Signature-256": "sha256=" + sign(`{"zen": "Keep it logically awesome."}`, "s3cret")},
+		},
+		{
+			name:    "Pushes deleting a branch are ignored",
+			body:    `{"ref": "refs/heads/main", "after": "0000000000000000000000000000000000000000"}`,
+			headers: map[string]string{"X-Gitlab-Event": "Push Hook", "X-Gitlab-Token": "s3cret"},
+		},
+		{
+			name:    "Pushes of tags are ignored",
+			body:    `{"ref": "refs/tags/v1.0.0", "after": "0a1b2c"}`,
+			headers: map[string]string{"X-Gitlab-Event": "Push Hook", "X-Gitlab-Token": "s3cret"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/api/pattern/gitops/link/webhook", strings.NewReader(tt.body))
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			got, ok, err := ParseWebhook(req, "s3cret")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseWebhook() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("ParseWebhook() = %+v, %v, want %+v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCleanPath(t *testing.T) {
+	for p, want := range map[string]string{
+		"designs/web.yaml":    "designs/web.yaml",
+		"/designs/":           "designs",
+		"../../etc/passwd":    "etc/passwd",
+		"":                    ".",
+		"designs/../web.yaml": "web.yaml",
+	} {
+		if got := CleanPath(p); got != want {
+			t.Errorf("CleanPath(%q) = %q, want %q", p, got, want)
+		}
+	}
+}
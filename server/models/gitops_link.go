@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/internal/sql"
+)
+
+// Directions of the sync of the designs linked to a Git repository
+const (
+	GitOpsPull = "pull"
+	GitOpsPush = "push"
+)
+
+// GitOpsLink links designs to a path of a branch of a Git repository, which is the source of truth of the designs.
+// The path is either a design file, linked to a single design, or a directory whose YAML files are a workspace of designs.
+type GitOpsLink struct {
+	ID     uuid.UUID `json:"id" gorm:"primaryKey"`
+	UserID string    `json:"user_id" gorm:"index"`
+
+	RepoURL string `json:"repo_url"`
+	Branch  string `json:"branch"`
+	Path    string `json:"path"`
+	// Token authenticates to the repository, it is never returned by the API
+	Token string `json:"-"`
+	// WebhookSecret verifies the webhooks notifying the pushes to the branch, it is never returned by the API
+	WebhookSecret string `json:"-"`
+	// PushOnSave pushes the designs to the repository every time one of them is saved
+	PushOnSave bool `json:"push_on_save"`
+
+	// Designs maps the paths of the design files in the repository to the ids of the designs
+	Designs sql.Map `json:"designs"`
+
+	LastCommitSHA string     `json:"last_commit_sha"`
+	LastSyncedAt  *time.Time `json:"last_synced_at,omitempty"`
+	LastSyncError string     `json:"last_sync_error,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// PatternID returns the id of the design of the file, or nil if the file is not linked to a design
+func (l *GitOpsLink) PatternID(path string) *uuid.UUID {
+	s, _ := l.Designs[path].(string)
+	id, err := uuid.FromString(s)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
+
+// GitOpsLinkRequestBody refers to the type of request body that CreateGitOpsLinkHandler would receive
+type GitOpsLinkRequestBody struct {
+	// PatternID of the design linked to the design file at Path, empty to link the workspace of designs in the directory at Path
+	PatternID     string `json:"pattern_id,omitempty"`
+	RepoURL       string `json:"repo_url"`
+	Branch        string `json:"branch"`
+	Path          string `json:"path"`
+	Token         string `json:"token,omitempty"`
+	WebhookSecret string `json:"webhook_secret,omitempty"`
+	PushOnSave    bool   `json:"push_on_save"`
+	// Sync is the direction of the first sync of the link, it defaults to push for the links of a design and pull otherwise
+	Sync string `json:"sync,omitempty"`
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// GitOpsLinkPersister is the persister for the links of designs to Git repositories
+type GitOpsLinkPersister struct {
+	DB *database.Handler
+}
+
+// SaveGitOpsLink stores the link, generating its ID if it has none
+func (glp *GitOpsLinkPersister) SaveGitOpsLink(link *GitOpsLink) error {
+	if link.ID == uuid.Nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+		link.ID = id
+	}
+	return glp.DB.Save(link).Error
+}
+
+// GetGitOpsLink returns the link with the ID, or nil if there is none
+func (glp *GitOpsLinkPersister) GetGitOpsLink(id uuid.UUID) (*GitOpsLink, error) {
+	var links []GitOpsLink
+	if err := glp.DB.Where("id = ?", id).Limit(1).Find(&links).Error; err != nil {
+		return nil, err
+	}
+	if len(links) == 0 {
+		return nil, nil
+	}
+	return &links[0], nil
+}
+
+// GetGitOpsLinks returns the links of the user
+func (glp *GitOpsLinkPersister) GetGitOpsLinks(userID string) ([]GitOpsLink, error) {
+	links := []GitOpsLink{}
+	err := glp.DB.Where("user_id = ?", userID).Order("created_at").Find(&links).Error
+	return links, err
+}
+
+// GetGitOpsLinksOfPattern returns the links of the user pushing the design when it is saved
+func (glp *GitOpsLinkPersister) GetGitOpsLinksOfPattern(userID string, patternID uuid.UUID) ([]GitOpsLink, error) {
+	links, err := glp.GetGitOpsLinks(userID)
+	if err != nil {
+		return nil, err
+	}
+	linked := []GitOpsLink{}
+	for _, link := range links {
+		for path := range link.Designs {
+			if id := link.PatternID(path); link.PushOnSave && id != nil && *id == patternID {
+				linked = append(linked, link)
+				break
+			}
+		}
+	}
+	return linked, nil
+}
+
+// DeleteGitOpsLink deletes the link, the linked designs are kept
+func (glp *GitOpsLinkPersister) DeleteGitOpsLink(id uuid.UUID) error {
+	return glp.DB.Where("id = ?", id).Delete(&GitOpsLink{}).Error
+}
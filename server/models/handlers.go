@@ -1,11 +1,15 @@
 package models
 
 import (
+	"context"
 	"net/http"
 
 	"time"
 
+	"github.com/layer5io/meshery/server/models/credstore"
+	"github.com/layer5io/meshery/server/models/imagescan"
 	"github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshery/server/models/pattern/core"
 	"github.com/layer5io/meshkit/utils/events"
 	"github.com/vmihailenco/taskq/v3"
 )
@@ -13,6 +17,35 @@ import (
 // HandlerInterface defines the methods a Handler should define
 type HandlerInterface interface {
 	ServerVersionHandler(w http.ResponseWriter, r *http.Request)
+	HealthzHandler(w http.ResponseWriter, r *http.Request)
+	GetAuditRecordsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetRolesHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetRoleBindingsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	SaveRoleBindingHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DeleteRoleBindingHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetUserPermissionsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetAPITokensHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	CreateAPITokenHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	RevokeAPITokenHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetWebhooksHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	CreateWebhookHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	UpdateWebhookHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DeleteWebhookHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetWebhookDeliveriesHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	RedeliverWebhookDeliveryHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetNotificationChannelsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	CreateNotificationChannelHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	UpdateNotificationChannelHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DeleteNotificationChannelHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	TestNotificationChannelHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetNotificationRulesHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	CreateNotificationRuleHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	UpdateNotificationRuleHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DeleteNotificationRuleHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetJobsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetJobHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	CancelJobHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ReadyzHandler(w http.ResponseWriter, r *http.Request)
 
 	ProviderMiddleware(http.Handler) http.Handler
 
@@ -26,6 +59,8 @@ type HandlerInterface interface {
 	MesheryControllersMiddleware(func(http.ResponseWriter, *http.Request, *Preference, *User, Provider)) func(http.ResponseWriter, *http.Request, *Preference, *User, Provider)
 	SessionInjectorMiddleware(func(http.ResponseWriter, *http.Request, *Preference, *User, Provider)) http.Handler
 	GraphqlMiddleware(http.Handler) func(http.ResponseWriter, *http.Request, *Preference, *User, Provider)
+	ETagMiddleware(http.Handler) http.Handler
+	AuditMiddleware(http.Handler) http.Handler
 
 	ProviderHandler(w http.ResponseWriter, r *http.Request)
 	ProvidersHandler(w http.ResponseWriter, r *http.Request)
@@ -57,6 +92,7 @@ type HandlerInterface interface {
 	FetchResultsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	FetchAllResultsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetResultHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetResultSLOHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetSMPServiceMeshes(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetSystemDatabase(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	ResetSystemDatabase(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
@@ -77,6 +113,7 @@ type HandlerInterface interface {
 	BulkUpdateEventStatus(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	DeleteEvent(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	BulkDeleteEvent(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	AcknowledgeEvents(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 
 	GrafanaConfigHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	GrafanaBoardsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
@@ -107,10 +144,31 @@ type HandlerInterface interface {
 	GetPerformanceProfilesHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetPerformanceProfileHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	DeletePerformanceProfileHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetPerformanceProfileTemplatesHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetPerformanceProfileTemplateHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	CreatePerformanceProfileTemplateHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	UpdatePerformanceProfileTemplateHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DeletePerformanceProfileTemplateHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	InstantiatePerformanceProfileTemplateHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 
 	SessionSyncHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 
 	PatternFileHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ResumePatternDeploymentHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	PatternDiffHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	PatternRolloutHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	PatternUndeployPreviewHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	PatternCostHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	PatternSecurityHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	PatternCapabilitiesHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ScanPatternImagesHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetPatternImageScansHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetPatternImageSBOMHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	PatternEvaluateHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	PatternLintHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ImportComposePatternHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ImportTerraformPatternHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DeploymentQueueHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetMeshmodelCategories(rw http.ResponseWriter, r *http.Request)
 	GetMeshmodelCategoriesByName(rw http.ResponseWriter, r *http.Request)
 	GetMeshmodelModelsByName(rw http.ResponseWriter, r *http.Request)
@@ -118,6 +176,7 @@ type HandlerInterface interface {
 	GetMeshmodelModelsByCategoriesByModel(rw http.ResponseWriter, r *http.Request)
 	ValidationHandler(rw http.ResponseWriter, r *http.Request)
 	MeshModelGenerationHandler(rw http.ResponseWriter, r *http.Request)
+	CRDComponentGenerationHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetMeshmodelModels(rw http.ResponseWriter, r *http.Request)
 	RegisterMeshmodelComponents(rw http.ResponseWriter, r *http.Request)
 
@@ -136,16 +195,58 @@ type HandlerInterface interface {
 	GetAllMeshmodelPolicies(rw http.ResponseWriter, r *http.Request)
 	GetAllMeshmodelPoliciesByName(rw http.ResponseWriter, r *http.Request)
 	RegisterMeshmodelRelationships(rw http.ResponseWriter, r *http.Request)
+	RegisterMeshmodelRelationshipsBulk(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DeleteMeshmodelRelationship(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ExportMeshmodelRelationships(rw http.ResponseWriter, r *http.Request)
+	GetMeshmodelRelationshipsGraph(rw http.ResponseWriter, r *http.Request)
+	GetMeshmodelRelationshipsUsage(rw http.ResponseWriter, r *http.Request)
+	EvaluateMeshmodelRelationship(rw http.ResponseWriter, r *http.Request)
+	LintMeshmodelRelationships(rw http.ResponseWriter, r *http.Request)
+	GetMeshmodelRelationshipsProvenance(rw http.ResponseWriter, r *http.Request)
+	ExportRegistryBundle(rw http.ResponseWriter, r *http.Request)
+	ImportRegistryBundle(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetMeshmodelEvents(rw http.ResponseWriter, r *http.Request)
+	GetMeshmodelRelationshipHistory(rw http.ResponseWriter, r *http.Request)
+	GetMeshmodelRelationshipPolicies(rw http.ResponseWriter, r *http.Request)
+	SaveMeshmodelRelationshipPolicy(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DeleteMeshmodelRelationshipPolicy(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	UpdateMeshmodelRelationship(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 
 	PatternFileRequestHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	DeleteMesheryPatternHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	CloneMesheryPatternHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	DownloadMesheryPatternHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ExportMesheryPatternHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	DeleteMultiMesheryPatternsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetCatalogMesheryPatternsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	PublishCatalogPatternHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	UnPublishCatalogPatternHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetMesheryPatternHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetMesheryPatternRevisionsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetMesheryPatternRevisionsDiffHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	RestoreMesheryPatternRevisionHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	MergeMesheryPatternsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	MigrateMesheryPatternsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetPatternDriftHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	CheckPatternDriftHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ReconcilePatternDriftHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ExecDeployedPatternHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetComponentLogsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetClustersMetadataHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DiscoverClustersHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetClusterMetadataHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	UpdateClusterMetadataHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DeleteClusterMetadataHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	CreateGitOpsLinkHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetGitOpsLinksHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DeleteGitOpsLinkHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	SyncGitOpsLinkHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GitOpsWebhookHandler(rw http.ResponseWriter, r *http.Request)
+	CreatePatternScheduleHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetPatternSchedulesHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DeletePatternScheduleHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	PausePatternScheduleHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ResumePatternScheduleHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 
 	FilterFileHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetMesheryFilterFileHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
@@ -190,6 +291,8 @@ type HandlerInterface interface {
 	UpdateConnection(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	UpdateConnectionById(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	DeleteConnection(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetConnectionTransitions(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	TransitionConnection(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 
 	GetRegoPolicyForDesignFile(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 
@@ -200,6 +303,15 @@ type HandlerInterface interface {
 	UpdateEnvironmentHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	AddConnectionToEnvironmentHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	RemoveConnectionFromEnvironmentHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+
+	// RunPatternSchedules runs the scheduled design deployments which are due, until the context is done
+	RunPatternSchedules(ctx context.Context, interval time.Duration)
+	// RunPatternDriftDetection checks the drift of the deployed designs periodically, until the context is done
+	RunPatternDriftDetection(ctx context.Context, interval time.Duration)
+	// RunAuditRetention deletes the entries of the audit log older than the retention periodically, until the context is done
+	RunAuditRetention(ctx context.Context, interval time.Duration)
+	// RunKubernetesEventsCorrelation publishes the warning events of the resources of the deployed designs periodically, until the context is done
+	RunKubernetesEventsCorrelation(ctx context.Context, interval time.Duration)
 }
 
 // HandlerConfig holds all the config pieces needed by handler methods
@@ -241,6 +353,25 @@ type HandlerConfig struct {
 	EventBroadcaster          *Broadcast
 	DashboardK8sResourcesChan *DashboardK8sResourcesChan
 	MeshModelSummaryChannel   *meshmodel.SummaryChannel
+	MeshModelEventsChannel    *meshmodel.RegistryEventsChannel
+	// RegistryCache caches the lookups of the entities of the registry, it is invalidated by the registry events
+	RegistryCache            *meshmodel.RegistryCache
+	RelationshipUsageIndexer *RelationshipUsageIndexer
+
+	// DeploymentQueue caps the number of design deployments running concurrently on a cluster
+	DeploymentQueue *DeploymentQueue
+	// ShutdownManager drains the design deployments when Meshery Server shuts down
+	ShutdownManager *ShutdownManager
+	// JobRunner runs the long-running operations in the background, eg: the checks of the drift of the deployed designs
+	JobRunner *JobRunner
+	// Pricing provides the prices the cost of designs is estimated with
+	Pricing core.Pricing
+	// ImageScanPolicy is how the container images of designs are scanned before they are deployed, nil if they are not
+	ImageScanPolicy *imagescan.Policy
+	// CredentialStore stores the secrets of the credentials outside of the database, nil if they are stored in it
+	CredentialStore credstore.Store
+	// Notifier sends the events to the notification channels of the users
+	Notifier *Notifier
 
 	K8scontextChannel *K8scontextChan
 	EventsBuffer      *events.EventStreamer
@@ -0,0 +1,35 @@
+package imagescan
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshkit/errors"
+)
+
+const (
+	ErrUnsupportedScannerCode = "1569"
+	ErrInvalidSeverityCode    = "1570"
+	ErrScanImageCode          = "1571"
+	ErrGenerateSBOMCode       = "1572"
+	ErrImagesFailedPolicyCode = "1573"
+)
+
+func ErrUnsupportedScanner(name string) error {
+	return errors.New(ErrUnsupportedScannerCode, errors.Alert, []string{fmt.Sprintf("Unsupported image scanner %s", name)}, []string{fmt.Sprintf("The image scanner %s is neither trivy nor grype", name)}, []string{"IMAGE_SCANNER is set to an image scanner Meshery does not support"}, []string{"Set IMAGE_SCANNER to trivy or grype, or unset it to deploy designs without scanning their images"})
+}
+
+func ErrInvalidSeverity(severity string) error {
+	return errors.New(ErrInvalidSeverityCode, errors.Alert, []string{fmt.Sprintf("Invalid vulnerability severity %s", severity)}, []string{fmt.Sprintf("%s is not a severity of vulnerabilities", severity)}, []string{"IMAGE_SCAN_FAIL_ON is set to an unknown severity"}, []string{"Set IMAGE_SCAN_FAIL_ON to one of UNKNOWN, NEGLIGIBLE, LOW, MEDIUM, HIGH or CRITICAL"})
+}
+
+func ErrScanImage(err error, image string) error {
+	return errors.New(ErrScanImageCode, errors.Alert, []string{fmt.Sprintf("Could not scan the image %s for vulnerabilities", image)}, []string{err.Error()}, []string{"The image scanner is not installed on the host of Meshery Server", "The image could not be pulled from its registry"}, []string{"Install the image scanner IMAGE_SCANNER names and make sure it is in the PATH", "Make sure the image exists and its registry is reachable"})
+}
+
+func ErrGenerateSBOM(err error, image string) error {
+	return errors.New(ErrGenerateSBOMCode, errors.Alert, []string{fmt.Sprintf("Could not generate the SBOM of the image %s", image)}, []string{err.Error()}, []string{"trivy, or syft along with grype, is not installed on the host of Meshery Server", "The image could not be pulled from its registry"}, []string{"Install the SBOM generator and make sure it is in the PATH", "Unset IMAGE_SCAN_SBOM to scan the images without generating their SBOMs"})
+}
+
+func ErrImagesFailedPolicy(violations []string) error {
+	return errors.New(ErrImagesFailedPolicyCode, errors.Alert, []string{"The images of the design failed the vulnerability scan"}, violations, []string{"The images of the design have vulnerabilities of the severity IMAGE_SCAN_FAIL_ON sets or higher"}, []string{"Update the images to versions fixing the vulnerabilities", "See the reports of the scan of the images of the design under /api/pattern/{id}/image-scans"})
+}
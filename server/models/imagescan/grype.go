@@ -0,0 +1,79 @@
+package imagescan
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// Grype scans images with the Grype CLI, and generates their SBOMs with the Syft CLI
+type Grype struct {
+	// Paths of the grype and syft executables
+	Path     string
+	SyftPath string
+}
+
+func (g *Grype) Name() string {
+	return "grype"
+}
+
+func (g *Grype) Scan(ctx context.Context, image string) ([]Vulnerability, error) {
+	out, err := run(ctx, g.Path, image, "--quiet", "--output", "json")
+	if err != nil {
+		return nil, ErrScanImage(err, image)
+	}
+	vulnerabilities, err := parseGrypeReport(out)
+	if err != nil {
+		return nil, ErrScanImage(err, image)
+	}
+	return vulnerabilities, nil
+}
+
+func (g *Grype) SBOM(ctx context.Context, image string) ([]byte, error) {
+	out, err := run(ctx, g.SyftPath, image, "--quiet", "--output", "cyclonedx-json")
+	if err != nil {
+		return nil, ErrGenerateSBOM(err, image)
+	}
+	return out, nil
+}
+
+type grypeReport struct {
+	Matches []struct {
+		Vulnerability struct {
+			ID          string `json:"id"`
+			Severity    string `json:"severity"`
+			Description string `json:"description"`
+			Fix         struct {
+				Versions []string `json:"versions"`
+			} `json:"fix"`
+		} `json:"vulnerability"`
+		Artifact struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"artifact"`
+	} `json:"matches"`
+}
+
+// parseGrypeReport returns the vulnerabilities of the JSON report of grype
+func parseGrypeReport(out []byte) ([]Vulnerability, error) {
+	var report grypeReport
+	if err := json.Unmarshal(out, &report); err != nil {
+		return nil, err
+	}
+	vulnerabilities := []Vulnerability{}
+	for _, match := range report.Matches {
+		severity, err := ParseSeverity(match.Vulnerability.Severity)
+		if err != nil {
+			severity = SeverityUnknown
+		}
+		vulnerabilities = append(vulnerabilities, Vulnerability{
+			ID:               match.Vulnerability.ID,
+			Package:          match.Artifact.Name,
+			InstalledVersion: match.Artifact.Version,
+			FixedVersion:     strings.Join(match.Vulnerability.Fix.Versions, ", "),
+			Severity:         severity,
+			Title:            match.Vulnerability.Description,
+		})
+	}
+	return vulnerabilities, nil
+}
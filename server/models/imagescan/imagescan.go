@@ -0,0 +1,208 @@
+// Package imagescan generates the SBOMs of the container images of designs and scans them for vulnerabilities with
+// the Trivy or Grype CLI.
+package imagescan
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Severity of a vulnerability, as reported by the scanners
+type Severity string
+
+const (
+	SeverityUnknown    Severity = "UNKNOWN"
+	SeverityNegligible Severity = "NEGLIGIBLE"
+	SeverityLow        Severity = "LOW"
+	SeverityMedium     Severity = "MEDIUM"
+	SeverityHigh       Severity = "HIGH"
+	SeverityCritical   Severity = "CRITICAL"
+)
+
+var severityRanks = map[Severity]int{
+	SeverityUnknown:    0,
+	SeverityNegligible: 1,
+	SeverityLow:        2,
+	SeverityMedium:     3,
+	SeverityHigh:       4,
+	SeverityCritical:   5,
+}
+
+// ParseSeverity parses the severity regardless of its case
+func ParseSeverity(s string) (Severity, error) {
+	severity := Severity(strings.ToUpper(strings.TrimSpace(s)))
+	if _, ok := severityRanks[severity]; !ok {
+		return "", ErrInvalidSeverity(s)
+	}
+	return severity, nil
+}
+
+// AtLeast tells if the severity is as high as the other one
+func (s Severity) AtLeast(other Severity) bool {
+	return severityRanks[s] >= severityRanks[other]
+}
+
+// Vulnerability is a vulnerability of a package of an image
+type Vulnerability struct {
+	ID               string   `json:"id"`
+	Package          string   `json:"package"`
+	InstalledVersion string   `json:"installedVersion"`
+	FixedVersion     string   `json:"fixedVersion,omitempty"`
+	Severity         Severity `json:"severity"`
+	Title            string   `json:"title,omitempty"`
+}
+
+// Report is the result of the scan of an image
+type Report struct {
+	Image string `json:"image"`
+	// Components of the design using the image
+	Components      []string         `json:"components"`
+	Scanner         string           `json:"scanner"`
+	Vulnerabilities []Vulnerability  `json:"vulnerabilities"`
+	Summary         map[Severity]int `json:"summary"`
+	// SBOM is the CycloneDX SBOM of the image, when SBOMs are generated
+	SBOM      json.RawMessage `json:"sbom,omitempty"`
+	SBOMError string          `json:"sbomError,omitempty"`
+	// Error is the reason the image could not be scanned
+	Error     string    `json:"error,omitempty"`
+	ScannedAt time.Time `json:"scannedAt"`
+}
+
+// Scanner scans container images for vulnerabilities and generates their SBOMs
+type Scanner interface {
+	Name() string
+	Scan(ctx context.Context, image string) ([]Vulnerability, error)
+	// SBOM returns the CycloneDX JSON SBOM of the image
+	SBOM(ctx context.Context, image string) ([]byte, error)
+}
+
+// NewScanner returns the scanner with the name, trivy or grype
+func NewScanner(name string) (Scanner, error) {
+	switch strings.ToLower(name) {
+	case "trivy":
+		return &Trivy{Path: "trivy"}, nil
+	case "grype":
+		return &Grype{Path: "grype", SyftPath: "syft"}, nil
+	}
+	return nil, ErrUnsupportedScanner(name)
+}
+
+// Policy is how the images of designs are scanned before they are deployed
+type Policy struct {
+	Scanner Scanner
+	// FailOn is the lowest severity of the vulnerabilities failing the deployment of a design, the deployment never
+	// fails when it is empty. Images which could not be scanned fail the deployment as well when it is set.
+	FailOn Severity
+	// GenerateSBOM tells if the SBOMs of the images are generated along with their scans
+	GenerateSBOM bool
+}
+
+// NewPolicy returns the policy scanning the images with the named scanner, or nil if no scanner is named
+func NewPolicy(scanner, failOn string, generateSBOM bool) (*Policy, error) {
+	if scanner == "" {
+		return nil, nil
+	}
+	s, err := NewScanner(scanner)
+	if err != nil {
+		return nil, err
+	}
+	policy := &Policy{Scanner: s, GenerateSBOM: generateSBOM}
+	if failOn != "" {
+		policy.FailOn, err = ParseSeverity(failOn)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return policy, nil
+}
+
+// Result is the scan of the images of a design
+type Result struct {
+	Scanner string   `json:"scanner"`
+	FailOn  Severity `json:"failOn,omitempty"`
+	Reports []Report `json:"reports"`
+	// Violations are the reasons the images fail the policy
+	Violations []string `json:"violations,omitempty"`
+}
+
+// Passed tells if the images of the design pass the policy
+func (r Result) Passed() bool {
+	return len(r.Violations) == 0
+}
+
+// ScanImages scans the images used by the components of a design, given by component, ordered by image
+func (p *Policy) ScanImages(ctx context.Context, images map[string][]string) Result {
+	components := make(map[string][]string)
+	for comp, imgs := range images {
+		for _, image := range imgs {
+			components[image] = append(components[image], comp)
+		}
+	}
+	names := make([]string, 0, len(components))
+	for image := range components {
+		names = append(names, image)
+	}
+	sort.Strings(names)
+
+	result := Result{Scanner: p.Scanner.Name(), FailOn: p.FailOn, Reports: []Report{}}
+	for _, image := range names {
+		sort.Strings(components[image])
+		report := p.scanImage(ctx, image)
+		report.Components = components[image]
+		result.Reports = append(result.Reports, report)
+		result.Violations = append(result.Violations, p.violations(report)...)
+	}
+	return result
+}
+
+func (p *Policy) scanImage(ctx context.Context, image string) Report {
+	report := Report{
+		Image:           image,
+		Scanner:         p.Scanner.Name(),
+		Vulnerabilities: []Vulnerability{},
+		Summary:         map[Severity]int{},
+		ScannedAt:       time.Now(),
+	}
+	vulnerabilities, err := p.Scanner.Scan(ctx, image)
+	if err != nil {
+		report.Error = err.Error()
+		return report
+	}
+	report.Vulnerabilities = vulnerabilities
+	for _, v := range vulnerabilities {
+		report.Summary[v.Severity]++
+	}
+	if p.GenerateSBOM {
+		sbom, err := p.Scanner.SBOM(ctx, image)
+		if err != nil {
+			report.SBOMError = err.Error()
+		} else {
+			report.SBOM = sbom
+		}
+	}
+	return report
+}
+
+// violations returns the reasons the image fails the policy
+func (p *Policy) violations(report Report) []string {
+	if p.FailOn == "" {
+		return nil
+	}
+	if report.Error != "" {
+		return []string{fmt.Sprintf("image %s could not be scanned: %s", report.Image, report.Error)}
+	}
+	count := 0
+	for severity, n := range report.Summary {
+		if severity.AtLeast(p.FailOn) {
+			count += n
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	return []string{fmt.Sprintf("image %s has %d vulnerabilities of severity %s or higher", report.Image, count, p.FailOn)}
+}
@@ -0,0 +1,116 @@
+package imagescan
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type fakeScanner map[string][]Vulnerability
+
+func (f fakeScanner) Name() string {
+	return "fake"
+}
+
+func (f fakeScanner) Scan(_ context.Context, image string) ([]Vulnerability, error) {
+	vulnerabilities, ok := f[image]
+	if !ok {
+		return nil, fmt.Errorf("image not found")
+	}
+	return vulnerabilities, nil
+}
+
+func (f fakeScanner) SBOM(_ context.Context, image string) ([]byte, error) {
+	return []byte(`{"bomFormat":"CycloneDX"}`), nil
+}
+
+func TestPolicyScanImages(t *testing.T) {
+	scanner := fakeScanner{
+		"nginx:1.25": {
+			{ID: "CVE-1", Severity: SeverityCritical},
+			{ID: "CVE-2", Severity: SeverityHigh},
+			{ID: "CVE-3", Severity: SeverityLow},
+		},
+		"busybox:1.36": {
+			{ID: "CVE-4", Severity: SeverityMedium},
+		},
+	}
+	images := map[string][]string{
+		"web":     {"nginx:1.25", "busybox:1.36"},
+		"proxy":   {"nginx:1.25"},
+		"sidecar": {"missing:latest"},
+	}
+
+	t.Run("Images with vulnerabilities above the threshold fail the policy", func(t *testing.T) {
+		policy := &Policy{Scanner: scanner, FailOn: SeverityHigh, GenerateSBOM: true}
+		result := policy.ScanImages(context.Background(), images)
+		want := []string{
+			"image missing:latest could not be scanned: image not found",
+			"image nginx:1.25 has 2 vulnerabilities of severity HIGH or higher",
+		}
+		if !reflect.DeepEqual(result.Violations, want) {
+			t.Errorf("violations = %v, want %v", result.Violations, want)
+		}
+		if len(result.Reports) != 3 || result.Reports[2].Image != "nginx:1.25" {
+			t.Fatalf("reports = %+v, want the reports of the 3 images ordered by image", result.Reports)
+		}
+		nginx := result.Reports[2]
+		if !reflect.DeepEqual(nginx.Components, []string{"proxy", "web"}) {
+			t.Errorf("components = %v, want [proxy web]", nginx.Components)
+		}
+		if nginx.Summary[SeverityCritical] != 1 || nginx.Summary[SeverityLow] != 1 || nginx.SBOM == nil {
+			t.Errorf("report = %+v, want its summary and SBOM", nginx)
+		}
+	})
+
+	t.Run("Images never fail the policy without threshold", func(t *testing.T) {
+		policy := &Policy{Scanner: scanner}
+		if result := policy.ScanImages(context.Background(), images); !result.Passed() {
+			t.Errorf("violations = %v, want none", result.Violations)
+		}
+	})
+}
+
+func TestNewPolicy(t *testing.T) {
+	if policy, err := NewPolicy("", "high", true); policy != nil || err != nil {
+		t.Errorf("NewPolicy() = %v, %v, want no policy without scanner", policy, err)
+	}
+	policy, err := NewPolicy("Grype", "high", false)
+	if err != nil || policy.Scanner.Name() != "grype" || policy.FailOn != SeverityHigh {
+		t.Errorf("NewPolicy() = %+v, %v, want grype failing on HIGH", policy, err)
+	}
+	if _, err := NewPolicy("clair", "", false); err == nil {
+		t.Error("NewPolicy() error = nil, want unsupported scanner")
+	}
+	if _, err := NewPolicy("trivy", "severe", false); err == nil {
+		t.Error("NewPolicy() error = nil, want invalid severity")
+	}
+}
+
+func TestParseReports(t *testing.T) {
+	want := []Vulnerability{{
+		ID:               "CVE-2023-0001",
+		Package:          "openssl",
+		InstalledVersion: "3.0.1",
+		FixedVersion:     "3.0.8",
+		Severity:         SeverityCritical,
+		Title:            "openssl: buffer overflow",
+	}}
+
+	t.Run("Trivy", func(t *testing.T) {
+		out := []byte(`{"Results":[{"Target":"nginx:1.25 (debian 12)","Vulnerabilities":[{"VulnerabilityID":"CVE-2023-0001","PkgName":"openssl","InstalledVersion":"3.0.1","FixedVersion":"3.0.8","Severity":"CRITICAL","Title":"openssl: buffer overflow"}]},{"Target":"app"}]}`)
+		got, err := parseTrivyReport(out)
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("parseTrivyReport() = %+v, %v, want %+v", got, err, want)
+		}
+	})
+
+	t.Run("Grype", func(t *testing.T) {
+		out := []byte(`{"matches":[{"vulnerability":{"id":"CVE-2023-0001","severity":"Critical","description":"openssl: buffer overflow","fix":{"versions":["3.0.8"]}},"artifact":{"name":"openssl","version":"3.0.1"}}]}`)
+		got, err := parseGrypeReport(out)
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("parseGrypeReport() = %+v, %v, want %+v", got, err, want)
+		}
+	})
+}
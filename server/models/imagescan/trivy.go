@@ -0,0 +1,95 @@
+package imagescan
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Trivy scans images and generates their SBOMs with the Trivy CLI
+type Trivy struct {
+	// Path of the trivy executable
+	Path string
+}
+
+func (t *Trivy) Name() string {
+	return "trivy"
+}
+
+func (t *Trivy) Scan(ctx context.Context, image string) ([]Vulnerability, error) {
+	out, err := run(ctx, t.Path, "image", "--quiet", "--format", "json", image)
+	if err != nil {
+		return nil, ErrScanImage(err, image)
+	}
+	vulnerabilities, err := parseTrivyReport(out)
+	if err != nil {
+		return nil, ErrScanImage(err, image)
+	}
+	return vulnerabilities, nil
+}
+
+func (t *Trivy) SBOM(ctx context.Context, image string) ([]byte, error) {
+	out, err := run(ctx, t.Path, "image", "--quiet", "--format", "cyclonedx", image)
+	if err != nil {
+		return nil, ErrGenerateSBOM(err, image)
+	}
+	return out, nil
+}
+
+type trivyReport struct {
+	Results []struct {
+		Target          string `json:"Target"`
+		Vulnerabilities []struct {
+			VulnerabilityID  string `json:"VulnerabilityID"`
+			PkgName          string `json:"PkgName"`
+			InstalledVersion string `json:"InstalledVersion"`
+			FixedVersion     string `json:"FixedVersion"`
+			Severity         string `json:"Severity"`
+			Title            string `json:"Title"`
+		} `json:"Vulnerabilities"`
+	} `json:"Results"`
+}
+
+// parseTrivyReport returns the vulnerabilities of the JSON report of trivy image
+func parseTrivyReport(out []byte) ([]Vulnerability, error) {
+	var report trivyReport
+	if err := json.Unmarshal(out, &report); err != nil {
+		return nil, err
+	}
+	vulnerabilities := []Vulnerability{}
+	for _, result := range report.Results {
+		for _, v := range result.Vulnerabilities {
+			severity, err := ParseSeverity(v.Severity)
+			if err != nil {
+				severity = SeverityUnknown
+			}
+			vulnerabilities = append(vulnerabilities, Vulnerability{
+				ID:               v.VulnerabilityID,
+				Package:          v.PkgName,
+				InstalledVersion: v.InstalledVersion,
+				FixedVersion:     v.FixedVersion,
+				Severity:         severity,
+				Title:            v.Title,
+			})
+		}
+	}
+	return vulnerabilities, nil
+}
+
+// run runs the command and returns its output, the errors it reports on stderr are part of the error returned
+func run(ctx context.Context, path string, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, path, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%s: %s", err, msg)
+		}
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// States of the background jobs
+const (
+	JobQueued    = "queued"
+	JobRunning   = "running"
+	JobSucceeded = "succeeded"
+	JobFailed    = "failed"
+	JobCancelled = "cancelled"
+)
+
+// Types of the background jobs run by Meshery Server
+const (
+	JobTypePatternDrift = "pattern-drift"
+	JobTypeGitOpsPush   = "gitops-push"
+)
+
+// Job is a long-running operation run in the background by the JobRunner, eg: the check of the drift of the deployed
+// designs or the push of designs to a Git repository. Jobs are stored in Meshery Database, so that their status
+// outlives the request which enqueued them and the jobs interrupted by a restart of Meshery Server are run again.
+type Job struct {
+	ID   uuid.UUID `json:"id" gorm:"primaryKey"`
+	Type string    `json:"type" gorm:"index"`
+	// UserID is the user the job runs for, empty for the jobs of Meshery Server itself
+	UserID string `json:"user_id" gorm:"index"`
+	// Payload is the JSON encoded input of the job, decoded by the function running the jobs of its type
+	Payload string `json:"payload,omitempty"`
+	// Result is the JSON encoded output of the job once it succeeded
+	Result string `json:"result,omitempty"`
+	Status string `json:"status" gorm:"index"`
+	// Attempts is the number of times the job was started, the job fails once MaxAttempts attempts failed
+	Attempts    int `json:"attempts"`
+	MaxAttempts int `json:"max_attempts"`
+	// Error is the error of the last failed attempt
+	Error string `json:"error,omitempty"`
+	// RunAt is the time the next attempt of the job is due
+	RunAt time.Time `json:"run_at" gorm:"index"`
+	// HeartbeatAt is updated periodically while the job runs, a running job without a recent heartbeat was abandoned
+	// by a crash of the Meshery Server running it
+	HeartbeatAt *time.Time `json:"heartbeat_at,omitempty"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	FinishedAt  *time.Time `json:"finished_at,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Done reports whether the job reached a final state
+func (j *Job) Done() bool {
+	return j.Status == JobSucceeded || j.Status == JobFailed || j.Status == JobCancelled
+}
+
+// JobFilter selects the background jobs, the zero value of a field matches all the jobs
+type JobFilter struct {
+	// UserIDs are the users whose jobs are selected
+	UserIDs []string
+	Type    string
+	Status  string
+
+	Offset int
+	Limit  int
+}
+
+// JobsPage is a page of the background jobs
+type JobsPage struct {
+	Page       int   `json:"page"`
+	PageSize   int   `json:"page_size"`
+	TotalCount int64 `json:"total_count"`
+	Jobs       []Job `json:"jobs"`
+}
@@ -0,0 +1,158 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+	"gorm.io/gorm"
+)
+
+// JobPersister is the persister for the background jobs
+type JobPersister struct {
+	DB *database.Handler
+}
+
+// SaveJob stores the job, generating its ID if it has none
+func (jp *JobPersister) SaveJob(job *Job) error {
+	if job.ID == uuid.Nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+		job.ID = id
+	}
+	return jp.DB.Save(job).Error
+}
+
+// GetJob returns the job with the ID
+func (jp *JobPersister) GetJob(id uuid.UUID) (*Job, error) {
+	job := &Job{}
+	if err := jp.DB.First(job, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// GetJobs returns the jobs matching the filter, most recent first, along with the total number of matching jobs
+func (jp *JobPersister) GetJobs(filter JobFilter) ([]Job, int64, error) {
+	query := jp.DB.Model(&Job{})
+	if filter.UserIDs != nil {
+		query = query.Where("user_id IN ?", filter.UserIDs)
+	}
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	query = query.Order("created_at desc").Offset(filter.Offset)
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	jobs := []Job{}
+	if err := query.Find(&jobs).Error; err != nil {
+		return nil, 0, err
+	}
+	return jobs, count, nil
+}
+
+// GetActiveJob returns a job of the type and user which is queued or running, or nil if there is none
+func (jp *JobPersister) GetActiveJob(jobType, userID string) (*Job, error) {
+	jobs := []Job{}
+	err := jp.DB.Where("type = ? AND user_id = ? AND status IN ?", jobType, userID, []string{JobQueued, JobRunning}).
+		Order("created_at").Limit(1).Find(&jobs).Error
+	if err != nil || len(jobs) == 0 {
+		return nil, err
+	}
+	return &jobs[0], nil
+}
+
+// GetDueJobs returns at most limit queued jobs of the types whose next attempt is due at t, the longest overdue first
+func (jp *JobPersister) GetDueJobs(types []string, t time.Time, limit int) ([]Job, error) {
+	jobs := []Job{}
+	err := jp.DB.Where("status = ? AND type IN ? AND run_at <= ?", JobQueued, types, t).
+		Order("run_at").Limit(limit).Find(&jobs).Error
+	return jobs, err
+}
+
+// ClaimJob marks the queued job as running and counts its attempt. It reports whether the job was claimed,
+// which it is not when it was claimed by another Meshery Server or cancelled in the meantime.
+func (jp *JobPersister) ClaimJob(id uuid.UUID, t time.Time) (bool, error) {
+	result := jp.DB.Model(&Job{}).Where("id = ? AND status = ?", id, JobQueued).Updates(map[string]interface{}{
+		"status":       JobRunning,
+		"attempts":     gorm.Expr("attempts + 1"),
+		"started_at":   t,
+		"heartbeat_at": t,
+		"error":        "",
+	})
+	return result.RowsAffected == 1, result.Error
+}
+
+// FinishJob stores the status, result, error, number of attempts and next attempt of the running job.
+// It reports whether the job was stored, which it is not when the job was cancelled while it was running.
+func (jp *JobPersister) FinishJob(job *Job) (bool, error) {
+	result := jp.DB.Model(&Job{}).Where("id = ? AND status = ?", job.ID, JobRunning).Updates(map[string]interface{}{
+		"status":       job.Status,
+		"result":       job.Result,
+		"error":        job.Error,
+		"attempts":     job.Attempts,
+		"run_at":       job.RunAt,
+		"finished_at":  job.FinishedAt,
+		"heartbeat_at": nil,
+	})
+	return result.RowsAffected == 1, result.Error
+}
+
+// CancelJob cancels the job if it is queued or running, and reports whether it was
+func (jp *JobPersister) CancelJob(id uuid.UUID) (bool, error) {
+	result := jp.DB.Model(&Job{}).Where("id = ? AND status IN ?", id, []string{JobQueued, JobRunning}).Updates(map[string]interface{}{
+		"status":       JobCancelled,
+		"finished_at":  time.Now(),
+		"heartbeat_at": nil,
+	})
+	return result.RowsAffected == 1, result.Error
+}
+
+// HeartbeatJobs records that the running jobs with the IDs are alive at t, and returns the IDs of the ones
+// which are no longer running, because they were cancelled
+func (jp *JobPersister) HeartbeatJobs(ids []uuid.UUID, t time.Time) ([]uuid.UUID, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	if err := jp.DB.Model(&Job{}).Where("id IN ? AND status = ?", ids, JobRunning).Update("heartbeat_at", t).Error; err != nil {
+		return nil, err
+	}
+	stopped := []uuid.UUID{}
+	err := jp.DB.Model(&Job{}).Where("id IN ? AND status <> ?", ids, JobRunning).Pluck("id", &stopped).Error
+	return stopped, err
+}
+
+// RequeueAbandonedJobs queues again the running jobs whose last heartbeat is older than t, which were abandoned
+// by a crash of the Meshery Server running them. The abandoned jobs which used up their attempts fail instead.
+// It returns the number of jobs queued again.
+func (jp *JobPersister) RequeueAbandonedJobs(t time.Time) (int64, error) {
+	abandoned := func() *gorm.DB {
+		return jp.DB.Model(&Job{}).Where("status = ? AND (heartbeat_at IS NULL OR heartbeat_at < ?)", JobRunning, t)
+	}
+	if err := abandoned().Where("attempts >= max_attempts").Updates(map[string]interface{}{
+		"status":       JobFailed,
+		"error":        "the job was abandoned by a restart of Meshery Server",
+		"finished_at":  time.Now(),
+		"heartbeat_at": nil,
+	}).Error; err != nil {
+		return 0, err
+	}
+	result := abandoned().Updates(map[string]interface{}{
+		"status":       JobQueued,
+		"run_at":       time.Now(),
+		"heartbeat_at": nil,
+	})
+	return result.RowsAffected, result.Error
+}
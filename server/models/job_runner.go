@@ -0,0 +1,310 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+)
+
+// JobFunc runs a job of the type it is registered for and returns its result, which is stored JSON encoded.
+// The job must stop when ctx is done, which happens when the job is cancelled or Meshery Server shuts down.
+type JobFunc func(ctx context.Context, job *Job) (interface{}, error)
+
+// JobOptions are the options of an enqueued job
+type JobOptions struct {
+	// MaxAttempts is the number of times the job is attempted before it fails, the default of the runner if not positive
+	MaxAttempts int
+	// RunAt delays the first attempt of the job, which is run as soon as possible if it is zero
+	RunAt time.Time
+	// Unique does not enqueue the job if a job of the same type and user is already queued or running,
+	// the job queued or running is returned instead
+	Unique bool
+}
+
+// JobRunner runs the long-running operations of Meshery Server in the background. The jobs are stored in
+// Meshery Database before they are run, so that their status can be looked up and the jobs are not lost when
+// Meshery Server restarts. Failed jobs are attempted again with an exponential backoff, and jobs can be cancelled.
+type JobRunner struct {
+	persister *JobPersister
+	log       logger.Handler
+	// maximum number of jobs running at once
+	workers     int
+	maxAttempts int
+	// backoff is the delay before the second attempt of a failed job, doubled for every further attempt up to maxBackoff
+	backoff    time.Duration
+	maxBackoff time.Duration
+
+	mx      sync.Mutex
+	funcs   map[string]JobFunc
+	running map[uuid.UUID]context.CancelFunc
+	wg      sync.WaitGroup
+	// wake is signalled by Enqueue so that new jobs do not wait for the next poll
+	wake chan struct{}
+	// finished is called with every job whose attempt returned, after its outcome is stored
+	finished func(*Job)
+}
+
+// NewJobRunner returns a runner running at most workers jobs at once, attempting the jobs maxAttempts times by default
+func NewJobRunner(db *database.Handler, log logger.Handler, workers, maxAttempts int) *JobRunner {
+	if workers < 1 {
+		workers = 1
+	}
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	return &JobRunner{
+		persister:   &JobPersister{DB: db},
+		log:         log,
+		workers:     workers,
+		maxAttempts: maxAttempts,
+		backoff:     10 * time.Second,
+		maxBackoff:  30 * time.Minute,
+		funcs:       make(map[string]JobFunc),
+		running:     make(map[uuid.UUID]context.CancelFunc),
+		wake:        make(chan struct{}, 1),
+	}
+}
+
+// Register sets the function running the jobs of the type. Only the jobs of the registered types are run.
+func (jr *JobRunner) Register(jobType string, fn JobFunc) {
+	jr.mx.Lock()
+	defer jr.mx.Unlock()
+	jr.funcs[jobType] = fn
+}
+
+// Enqueue stores a job of the type for the user, with the JSON encoded payload, and returns it.
+// The job is run as soon as a worker is free and its RunAt is due.
+func (jr *JobRunner) Enqueue(jobType, userID string, payload interface{}, opts JobOptions) (*Job, error) {
+	jr.mx.Lock()
+	_, ok := jr.funcs[jobType]
+	jr.mx.Unlock()
+	if !ok {
+		return nil, ErrUnknownJobType(jobType)
+	}
+	if opts.Unique {
+		active, err := jr.persister.GetActiveJob(jobType, userID)
+		if err != nil || active != nil {
+			return active, err
+		}
+	}
+
+	job := &Job{
+		Type:        jobType,
+		UserID:      userID,
+		Status:      JobQueued,
+		MaxAttempts: opts.MaxAttempts,
+		RunAt:       opts.RunAt,
+	}
+	if job.MaxAttempts < 1 {
+		job.MaxAttempts = jr.maxAttempts
+	}
+	if job.RunAt.IsZero() {
+		job.RunAt = time.Now()
+	}
+	if payload != nil {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return nil, ErrMarshal(err, "job payload")
+		}
+		job.Payload = string(b)
+	}
+	if err := jr.persister.SaveJob(job); err != nil {
+		return nil, err
+	}
+	select {
+	case jr.wake <- struct{}{}:
+	default:
+	}
+	return job, nil
+}
+
+// Cancel cancels the job if it is queued or running, and reports whether it was. A running job is stopped
+// through its context if it runs on this Meshery Server, or else at the next heartbeat of the one running it.
+func (jr *JobRunner) Cancel(id uuid.UUID) (bool, error) {
+	cancelled, err := jr.persister.CancelJob(id)
+	if err != nil || !cancelled {
+		return false, err
+	}
+	jr.mx.Lock()
+	if cancel, ok := jr.running[id]; ok {
+		cancel()
+	}
+	jr.mx.Unlock()
+	return true, nil
+}
+
+// Run runs the due jobs, polling Meshery Database for them at every interval, until ctx is done.
+// The running jobs are then stopped and queued again, Run returns once they returned.
+// The running jobs whose heartbeat is older than three intervals were abandoned by a crash and are queued again.
+func (jr *JobRunner) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	defer jr.wg.Wait()
+	for {
+		jr.heartbeat()
+		if n, err := jr.persister.RequeueAbandonedJobs(time.Now().Add(-3 * interval)); err != nil {
+			jr.log.Error(ErrRunJob(err, "abandoned"))
+		} else if n > 0 {
+			jr.log.Info("queued again ", n, " jobs abandoned by a restart of Meshery Server")
+		}
+		jr.startDueJobs(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-jr.wake:
+		}
+	}
+}
+
+// heartbeat records that the jobs running on this Meshery Server are alive, and stops the ones cancelled
+// through another Meshery Server
+func (jr *JobRunner) heartbeat() {
+	jr.mx.Lock()
+	ids := make([]uuid.UUID, 0, len(jr.running))
+	for id := range jr.running {
+		ids = append(ids, id)
+	}
+	jr.mx.Unlock()
+
+	stopped, err := jr.persister.HeartbeatJobs(ids, time.Now())
+	if err != nil {
+		jr.log.Error(ErrRunJob(err, "heartbeat"))
+		return
+	}
+	jr.mx.Lock()
+	defer jr.mx.Unlock()
+	for _, id := range stopped {
+		if cancel, ok := jr.running[id]; ok {
+			cancel()
+		}
+	}
+}
+
+// startDueJobs claims the due jobs fitting in the free workers and runs them
+func (jr *JobRunner) startDueJobs(ctx context.Context) {
+	jr.mx.Lock()
+	free := jr.workers - len(jr.running)
+	types := make([]string, 0, len(jr.funcs))
+	for jobType := range jr.funcs {
+		types = append(types, jobType)
+	}
+	jr.mx.Unlock()
+	if free <= 0 || len(types) == 0 || ctx.Err() != nil {
+		return
+	}
+
+	jobs, err := jr.persister.GetDueJobs(types, time.Now(), free)
+	if err != nil {
+		jr.log.Error(ErrRunJob(err, "due"))
+		return
+	}
+	for i := range jobs {
+		job := &jobs[i]
+		now := time.Now()
+		claimed, err := jr.persister.ClaimJob(job.ID, now)
+		if err != nil {
+			jr.log.Error(ErrRunJob(err, job.Type))
+			continue
+		}
+		if !claimed {
+			continue
+		}
+		job.Status = JobRunning
+		job.Attempts++
+		job.StartedAt = &now
+		job.Error = ""
+
+		jobCtx, cancel := context.WithCancel(ctx)
+		jr.mx.Lock()
+		jr.running[job.ID] = cancel
+		fn := jr.funcs[job.Type]
+		jr.mx.Unlock()
+		jr.wg.Add(1)
+		go func() {
+			defer jr.wg.Done()
+			jr.run(ctx, jobCtx, fn, job)
+			cancel()
+			jr.mx.Lock()
+			delete(jr.running, job.ID)
+			jr.mx.Unlock()
+			if jr.finished != nil {
+				jr.finished(job)
+			}
+			// a worker is free
+			select {
+			case jr.wake <- struct{}{}:
+			default:
+			}
+		}()
+	}
+}
+
+// run runs the claimed job and stores its outcome: its result if it succeeded, or its error and next attempt if it failed.
+// The job is queued again without counting its attempt if it was stopped because Meshery Server shuts down.
+func (jr *JobRunner) run(runnerCtx, ctx context.Context, fn JobFunc, job *Job) {
+	result, err := runJobFunc(ctx, fn, job)
+	now := time.Now()
+	switch {
+	case err == nil:
+		job.Status = JobSucceeded
+		job.FinishedAt = &now
+		if result != nil {
+			b, merr := json.Marshal(result)
+			if merr != nil {
+				jr.log.Error(ErrMarshal(merr, "job result"))
+			}
+			job.Result = string(b)
+		}
+	case runnerCtx.Err() != nil:
+		job.Status = JobQueued
+		job.Attempts--
+		job.RunAt = now
+		job.Error = err.Error()
+	case ctx.Err() != nil:
+		// the job was cancelled, it is already stored as such
+		return
+	case job.Attempts < job.MaxAttempts:
+		job.Status = JobQueued
+		job.RunAt = now.Add(jr.backoffOf(job.Attempts))
+		job.Error = err.Error()
+		jr.log.Warn(ErrRunJob(err, job.Type))
+	default:
+		job.Status = JobFailed
+		job.FinishedAt = &now
+		job.Error = err.Error()
+		jr.log.Error(ErrRunJob(err, job.Type))
+	}
+	// the job is not stored if it was cancelled while running, it stays cancelled
+	if _, err := jr.persister.FinishJob(job); err != nil {
+		jr.log.Error(ErrRunJob(err, job.Type))
+	}
+}
+
+// backoffOf returns the delay before the attempt following the failed attempt
+func (jr *JobRunner) backoffOf(attempt int) time.Duration {
+	delay := jr.backoff
+	for i := 1; i < attempt && delay < jr.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > jr.maxBackoff {
+		delay = jr.maxBackoff
+	}
+	return delay
+}
+
+// runJobFunc runs the job, turning a panic of the job into its error so that it does not crash Meshery Server
+func runJobFunc(ctx context.Context, fn JobFunc, job *Job) (result interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrJobPanic(r)
+		}
+	}()
+	return fn(ctx, job)
+}
@@ -0,0 +1,213 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+)
+
+// newTestJobRunner returns a runner and the channel the IDs of its jobs are sent to when their attempts returned
+func newTestJobRunner(t *testing.T, db *database.Handler) (*JobRunner, <-chan uuid.UUID) {
+	t.Helper()
+	if err := db.AutoMigrate(&Job{}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = db.Migrator().DropTable(&Job{})
+	})
+	log, err := logger.New("meshery-test", logger.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	jr := NewJobRunner(db, log, 2, 3)
+	jr.backoff = time.Millisecond
+	finished := make(chan uuid.UUID, 64)
+	jr.finished = func(job *Job) {
+		finished <- job.ID
+	}
+	return jr, finished
+}
+
+// waitJob waits for the job to reach the status, looking it up again whenever an attempt of a job returned
+func waitJob(t *testing.T, jr *JobRunner, finished <-chan uuid.UUID, id uuid.UUID, status string) *Job {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		job, err := jr.persister.GetJob(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if job.Status == status {
+			return job
+		}
+		select {
+		case <-finished:
+		case <-timeout:
+			t.Fatalf("job %s is %s, want %s", job.Type, job.Status, status)
+		}
+	}
+}
+
+func TestJobRunner(t *testing.T) {
+	for engine, db := range testDatabases(t) {
+		t.Run(engine, func(t *testing.T) {
+			jr, finished := newTestJobRunner(t, db)
+			flaky := 0
+			jr.Register("succeed", func(_ context.Context, job *Job) (interface{}, error) {
+				return map[string]string{"payload": job.Payload}, nil
+			})
+			jr.Register("flaky", func(context.Context, *Job) (interface{}, error) {
+				flaky++
+				if flaky < 2 {
+					return nil, errors.New("flaky")
+				}
+				return nil, nil
+			})
+			jr.Register("fail", func(context.Context, *Job) (interface{}, error) {
+				return nil, errors.New("failure")
+			})
+			jr.Register("panic", func(context.Context, *Job) (interface{}, error) {
+				panic("panic")
+			})
+			started := make(chan struct{})
+			jr.Register("block", func(ctx context.Context, _ *Job) (interface{}, error) {
+				close(started)
+				<-ctx.Done()
+				return nil, ctx.Err()
+			})
+
+			if _, err := jr.Enqueue("unknown", "", nil, JobOptions{}); err == nil {
+				t.Error("Enqueue() of a job of an unknown type succeeded")
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			stopped := make(chan struct{})
+			go func() {
+				jr.Run(ctx, 10*time.Millisecond)
+				close(stopped)
+			}()
+			defer func() {
+				cancel()
+				<-stopped
+			}()
+
+			succeed, err := jr.Enqueue("succeed", "user", "design", JobOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if job := waitJob(t, jr, finished, succeed.ID, JobSucceeded); job.Result != `{"payload":"\"design\""}` || job.Attempts != 1 {
+				t.Errorf("succeeded job has result %s after %d attempts", job.Result, job.Attempts)
+			}
+
+			flakyJob, err := jr.Enqueue("flaky", "user", nil, JobOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if job := waitJob(t, jr, finished, flakyJob.ID, JobSucceeded); job.Attempts != 2 {
+				t.Errorf("flaky job succeeded after %d attempts, want 2", job.Attempts)
+			}
+
+			for _, jobType := range []string{"fail", "panic"} {
+				failed, err := jr.Enqueue(jobType, "user", nil, JobOptions{MaxAttempts: 2})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if job := waitJob(t, jr, finished, failed.ID, JobFailed); job.Attempts != 2 || job.Error == "" || job.FinishedAt == nil {
+					t.Errorf("%s job failed after %d attempts with error %q", jobType, job.Attempts, job.Error)
+				}
+			}
+
+			block, err := jr.Enqueue("block", "", nil, JobOptions{Unique: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if again, err := jr.Enqueue("block", "", nil, JobOptions{Unique: true}); err != nil || again.ID != block.ID {
+				t.Errorf("Enqueue() of a unique job queued = %v, %v, want the queued job", again, err)
+			}
+			<-started
+			if cancelled, err := jr.Cancel(block.ID); err != nil || !cancelled {
+				t.Fatalf("Cancel() = %t, %v, want true", cancelled, err)
+			}
+			waitJob(t, jr, finished, block.ID, JobCancelled)
+			if cancelled, err := jr.Cancel(block.ID); err != nil || cancelled {
+				t.Errorf("Cancel() of a cancelled job = %t, %v, want false", cancelled, err)
+			}
+		})
+	}
+}
+
+func TestJobRunnerShutdown(t *testing.T) {
+	for engine, db := range testDatabases(t) {
+		t.Run(engine, func(t *testing.T) {
+			jr, _ := newTestJobRunner(t, db)
+			started := make(chan struct{})
+			jr.Register("block", func(ctx context.Context, _ *Job) (interface{}, error) {
+				close(started)
+				<-ctx.Done()
+				return nil, ctx.Err()
+			})
+			job, err := jr.Enqueue("block", "", nil, JobOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			stopped := make(chan struct{})
+			go func() {
+				jr.Run(ctx, 10*time.Millisecond)
+				close(stopped)
+			}()
+			<-started
+			cancel()
+			<-stopped
+
+			// the job stopped by the shutdown is queued again, its attempt is not counted
+			got, err := jr.persister.GetJob(job.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Status != JobQueued || got.Attempts != 0 {
+				t.Errorf("job stopped by the shutdown is %s after %d attempts, want queued after 0", got.Status, got.Attempts)
+			}
+		})
+	}
+}
+
+func TestRequeueAbandonedJobs(t *testing.T) {
+	for engine, db := range testDatabases(t) {
+		t.Run(engine, func(t *testing.T) {
+			jr, _ := newTestJobRunner(t, db)
+			stale := time.Now().Add(-time.Hour)
+			fresh := time.Now()
+			jobs := map[string]*Job{
+				"abandoned": {Type: "sync", Status: JobRunning, Attempts: 1, MaxAttempts: 3, HeartbeatAt: &stale},
+				"exhausted": {Type: "sync", Status: JobRunning, Attempts: 3, MaxAttempts: 3, HeartbeatAt: &stale},
+				"alive":     {Type: "sync", Status: JobRunning, Attempts: 1, MaxAttempts: 3, HeartbeatAt: &fresh},
+			}
+			for _, job := range jobs {
+				if err := jr.persister.SaveJob(job); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			n, err := jr.persister.RequeueAbandonedJobs(time.Now().Add(-time.Minute))
+			if err != nil || n != 1 {
+				t.Fatalf("RequeueAbandonedJobs() = %d, %v, want 1", n, err)
+			}
+			for name, want := range map[string]string{"abandoned": JobQueued, "exhausted": JobFailed, "alive": JobRunning} {
+				got, err := jr.persister.GetJob(jobs[name].ID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got.Status != want {
+					t.Errorf("%s job is %s, want %s", name, got.Status, want)
+				}
+			}
+		})
+	}
+}
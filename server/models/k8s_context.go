@@ -20,6 +20,8 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"net"
 	"os"
+	"sort"
+	"sync"
 	"time"
 )
 
@@ -137,7 +139,8 @@ func NewK8sContextWithServerID(
 }
 
 // K8sContextsFromKubeconfig takes in a kubeconfig and meshery instance ID and generates
-// kubernetes contexts from it
+// kubernetes contexts from it. The clusters of the contexts are probed concurrently, the contexts
+// whose clusters cannot be reached are skipped.
 func K8sContextsFromKubeconfig(provider Provider, userID string, eventChan *Broadcast, kubeconfig []byte, instanceID *uuid.UUID) []*K8sContext {
 	kcs := []*K8sContext{}
 	parsed, err := clientcmd.Load(kubeconfig)
@@ -152,72 +155,93 @@ func K8sContextsFromKubeconfig(provider Provider, userID string, eventChan *Broa
 		return kcs
 	}
 
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		probes = make(chan struct{}, maxConcurrentClusterProbes)
+	)
 	for name := range parsed.Contexts {
-		var msg string
 		kc, _ := kcfg.K8sContext(name, instanceID)
-		eventBuilder := events.NewEvent().ActedUpon(uuid.FromStringOrNil(kc.ConnectionID)).WithCategory("connection").WithAction("register").FromSystem(*instanceID).FromUser(userUUID)
+		wg.Add(1)
+		go func(kc K8sContext) {
+			defer wg.Done()
+			probes <- struct{}{}
+			defer func() { <-probes }()
+			if probeK8sContext(provider, eventChan, userUUID, instanceID, &kc) {
+				mu.Lock()
+				kcs = append(kcs, &kc)
+				mu.Unlock()
+			}
+		}(kc)
+	}
+	wg.Wait()
 
-		handler, err := kc.GenerateKubeHandler()
-		if err != nil {
-			msg = fmt.Sprintf("error generating kubernetes handler, skipping context %s: %v", err, kc.Name)
+	sort.Slice(kcs, func(i, j int) bool {
+		return kcs[i].Name < kcs[j].Name
+	})
+	return kcs
+}
 
-			event := eventBuilder.WithSeverity(events.Error).WithDescription(fmt.Sprintf("Error connecting with kubernetes context at %s, skipping %s", kc.Server, kc.Name)).WithMetadata(map[string]interface{}{
-				"error": err,
-			}).Build()
+// probeK8sContext pings the cluster of the context and assigns its server ID and version, and reports whether the context can be used
+func probeK8sContext(provider Provider, eventChan *Broadcast, userUUID uuid.UUID, instanceID *uuid.UUID, kc *K8sContext) bool {
+	var msg string
+	eventBuilder := events.NewEvent().ActedUpon(uuid.FromStringOrNil(kc.ConnectionID)).WithCategory("connection").WithAction("register").FromSystem(*instanceID).FromUser(userUUID)
 
-			_ = provider.PersistEvent(event)
-			eventChan.Publish(userUUID, event)
+	handler, err := kc.GenerateKubeHandler()
+	if err != nil {
+		msg = fmt.Sprintf("error generating kubernetes handler, skipping context %s: %v", err, kc.Name)
 
-			logrus.Warnf(msg)
-			continue
-		}
+		event := eventBuilder.WithSeverity(events.Error).WithDescription(fmt.Sprintf("Error connecting with kubernetes context at %s, skipping %s", kc.Server, kc.Name)).WithMetadata(map[string]interface{}{
+			"error": err,
+		}).Build()
 
-		// Perform Ping test on the cluster
-		if err := kc.PingTest(); err != nil {
-			msg = fmt.Sprintf("unable to ping kubernetes context at %s, skipping context %s %v \n", kc.Server, kc.Name, err)
-			event := eventBuilder.WithSeverity(events.Error).WithDescription(fmt.Sprintf("Unable to ping kubernetes context at %s, skipping %s", kc.Server, kc.Name)).WithMetadata(map[string]interface{}{
-				"error": err,
-			}).Build()
+		_ = provider.PersistEvent(event)
+		eventChan.Publish(userUUID, event)
 
-			_ = provider.PersistEvent(event)
-			eventChan.Publish(userUUID, event)
+		logrus.Warnf(msg)
+		return false
+	}
 
-			logrus.Warn(msg)
-			continue
-		}
+	// Perform Ping test on the cluster
+	if err := kc.PingTest(); err != nil {
+		msg = fmt.Sprintf("unable to ping kubernetes context at %s, skipping context %s %v \n", kc.Server, kc.Name, err)
+		event := eventBuilder.WithSeverity(events.Error).WithDescription(fmt.Sprintf("Unable to ping kubernetes context at %s, skipping %s", kc.Server, kc.Name)).WithMetadata(map[string]interface{}{
+			"error": err,
+		}).Build()
 
-		if err := kc.AssignServerID(handler); err != nil {
-			msg = fmt.Sprintf("could not retrieve kubernetes cluster ID, skipping context %s: %v", kc.Name, err)
+		_ = provider.PersistEvent(event)
+		eventChan.Publish(userUUID, event)
 
-			event := eventBuilder.WithSeverity(events.Error).WithDescription(fmt.Sprintf("Could not assign server id, skipping context %s", kc.Name)).WithMetadata(map[string]interface{}{
-				"error": err,
-			}).Build()
+		logrus.Warn(msg)
+		return false
+	}
 
-			_ = provider.PersistEvent(event)
-			eventChan.Publish(userUUID, event)
-			logrus.Warn(msg)
-			continue
-		}
+	if err := kc.AssignServerID(handler); err != nil {
+		msg = fmt.Sprintf("could not retrieve kubernetes cluster ID, skipping context %s: %v", kc.Name, err)
 
-		err = kc.AssignVersion(handler)
-		if err != nil {
-			msg = fmt.Sprintf("could not retrieve kubernetes version for context %s: %v ", kc.Name, err)
-			event := eventBuilder.WithSeverity(events.Warning).WithDescription(fmt.Sprintf("Could not retrieve Kubernetes version for %s", kc.Name)).WithMetadata(map[string]interface{}{
-				"error": err,
-			}).Build()
+		event := eventBuilder.WithSeverity(events.Error).WithDescription(fmt.Sprintf("Could not assign server id, skipping context %s", kc.Name)).WithMetadata(map[string]interface{}{
+			"error": err,
+		}).Build()
 
-			_ = provider.PersistEvent(event)
-			eventChan.Publish(userUUID, event)
+		_ = provider.PersistEvent(event)
+		eventChan.Publish(userUUID, event)
+		logrus.Warn(msg)
+		return false
+	}
 
-			logrus.Warnf(msg)
-			kcs = append(kcs, &kc)
-			continue
-		}
+	err = kc.AssignVersion(handler)
+	if err != nil {
+		msg = fmt.Sprintf("could not retrieve kubernetes version for context %s: %v ", kc.Name, err)
+		event := eventBuilder.WithSeverity(events.Warning).WithDescription(fmt.Sprintf("Could not retrieve Kubernetes version for %s", kc.Name)).WithMetadata(map[string]interface{}{
+			"error": err,
+		}).Build()
 
-		kcs = append(kcs, &kc)
-	}
+		_ = provider.PersistEvent(event)
+		eventChan.Publish(userUUID, event)
 
-	return kcs
+		logrus.Warnf(msg)
+	}
+	return true
 }
 
 func NewK8sContextFromInClusterConfig(contextName string, instanceID *uuid.UUID) (*K8sContext, error) {
@@ -72,6 +72,9 @@ type LoadTestOptions struct {
 
 	Options string
 
+	// SLOs are the SLOs of the performance profile of the test, evaluated against its result
+	SLOs []PerformanceSLO
+
 	// Values required for fortio gRPC health & ping test
 	GRPCStreamsCount int
 	GRPCDoHealth     bool
@@ -1,6 +1,10 @@
 package models
 
-import "github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+import (
+	"github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	"github.com/layer5io/meshkit/models/meshmodel/registry"
+)
 
 // API response model for meshmodel models API
 type MeshmodelsAPIResponse struct {
@@ -40,6 +44,52 @@ type MeshmodelRelationshipsAPIResponse struct {
 	PageSize      int                               `json:"page_size"`
 	Count         int64                             `json:"total_count"`
 	Relationships []v1alpha1.RelationshipDefinition `json:"relationships"`
+	// Prior revisions of the relationships, only populated when every version is requested
+	Revisions []meshmodel.RelationshipRevision `json:"revisions,omitempty"`
+}
+
+// API response model for meshmodel relationship history API
+type MeshmodelRelationshipHistoryAPIResponse struct {
+	Page      int                              `json:"page"`
+	PageSize  int                              `json:"page_size"`
+	Count     int64                            `json:"total_count"`
+	Revisions []meshmodel.RelationshipRevision `json:"revisions"`
+}
+
+// Request body for registering multiple meshmodel relationships at once
+type MeshmodelRelationshipsBulkRegistrationRequest struct {
+	Host          registry.Host                     `json:"host"`
+	Relationships []v1alpha1.RelationshipDefinition `json:"relationships"`
+}
+
+// API response model for bulk registration of meshmodel relationships
+type MeshmodelRelationshipsBulkRegistrationResponse struct {
+	Registered int                                 `json:"registered"`
+	Failed     int                                 `json:"failed"`
+	Results    []MeshmodelEntityRegistrationResult `json:"results"`
+}
+
+// Registration outcome of a single entity in a bulk registration request
+type MeshmodelEntityRegistrationResult struct {
+	Index      int    `json:"index"`
+	Kind       string `json:"kind"`
+	Model      string `json:"model"`
+	Registered bool   `json:"registered"`
+	Error      string `json:"error,omitempty"`
+	// Fields of the entity which failed schema validation
+	ValidationErrors []meshmodel.RelationshipSchemaError `json:"validationErrors,omitempty"`
+	// Registered relationship which the entity duplicates
+	Duplicate *v1alpha1.RelationshipDefinition `json:"duplicate,omitempty"`
+	// Violations of the relationship policies by the entity
+	Violations []meshmodel.RelationshipPolicyViolation `json:"violations,omitempty"`
+}
+
+// API response model for meshmodel relationship policies API
+type MeshmodelRelationshipPoliciesAPIResponse struct {
+	Page     int                            `json:"page"`
+	PageSize int                            `json:"page_size"`
+	Count    int64                          `json:"total_count"`
+	Policies []meshmodel.RelationshipPolicy `json:"policies"`
 }
 
 // API response model for meshmodel categories API
@@ -111,3 +161,35 @@ func FindDuplicateModels(models []v1alpha1.Model) []DuplicateResponseModels {
 
 	return mods
 }
+
+// Request body of the relationship evaluation API
+type MeshmodelRelationshipEvaluationRequest struct {
+	Relationship v1alpha1.RelationshipDefinition `json:"relationship"`
+	// Design to evaluate the relationship against, as YAML
+	Design string `json:"design"`
+}
+
+// API response model for the relationship evaluation API
+type MeshmodelRelationshipEvaluationResponse struct {
+	Matches []meshmodel.RelationshipMatch `json:"matches"`
+}
+
+// Request body for linting relationship definitions
+type MeshmodelRelationshipsLintRequest struct {
+	Relationships []v1alpha1.RelationshipDefinition `json:"relationships"`
+}
+
+// API response model for the relationship lint API
+type MeshmodelRelationshipsLintResponse struct {
+	Errors      int                                    `json:"errors"`
+	Warnings    int                                    `json:"warnings"`
+	Diagnostics []meshmodel.RelationshipLintDiagnostic `json:"diagnostics"`
+}
+
+// API response model for the relationship provenance API
+type MeshmodelRelationshipsProvenanceAPIResponse struct {
+	Page       int                                `json:"page"`
+	PageSize   int                                `json:"page_size"`
+	Count      int64                              `json:"total_count"`
+	Provenance []meshmodel.RelationshipProvenance `json:"provenance"`
+}
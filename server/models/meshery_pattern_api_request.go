@@ -1,5 +1,7 @@
 package models
 
+import "github.com/layer5io/meshery/server/models/pattern/core"
+
 // MesheryPatternDeleteRequestBody refers to the type of request body
 // that DeleteMultiMesheryPatternsHandler would receive
 type MesheryPatternDeleteRequestBody struct {
@@ -11,3 +13,27 @@ type deletePatternModel struct {
 	ID   string `json:"id,omitempty"`
 	Name string `json:"name,omitempty"`
 }
+
+// MesheryPatternExportRequestBody refers to the type of request body
+// that ExportMesheryPatternHandler would receive
+type MesheryPatternExportRequestBody struct {
+	// Environments are the values of the variables of the design in every environment it is exported for
+	Environments map[string]map[string]interface{} `json:"environments,omitempty"`
+}
+
+// MesheryPatternMergeRequestBody refers to the type of request body
+// that MergeMesheryPatternsHandler would receive
+type MesheryPatternMergeRequestBody struct {
+	// PatternID of a saved design, whose current version is merged when Ours is empty
+	// and whose revisions can be used as the base or their design
+	PatternID string `json:"pattern_id,omitempty"`
+	// Base is the design both merged designs were derived from, or the revision of the saved design given by BaseRevision
+	Base         string `json:"base,omitempty"`
+	BaseRevision int    `json:"base_revision,omitempty"`
+	Ours         string `json:"ours,omitempty"`
+	// Theirs is the design merged into ours, or the revision of the saved design given by TheirsRevision
+	Theirs         string `json:"theirs,omitempty"`
+	TheirsRevision int    `json:"theirs_revision,omitempty"`
+	// Resolutions of the conflicts reported by a previous merge of the designs
+	Resolutions []core.MergeResolution `json:"resolutions,omitempty"`
+}
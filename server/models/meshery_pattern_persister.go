@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/layer5io/meshkit/database"
+	"gorm.io/gorm"
 )
 
 // MesheryPatternPersister is the persister for persisting
@@ -146,6 +148,7 @@ func (mpp *MesheryPatternPersister) CloneMesheryPattern(patternID string, cloneP
 func (mpp *MesheryPatternPersister) DeleteMesheryPattern(id uuid.UUID) ([]byte, error) {
 	pattern := MesheryPattern{ID: &id}
 	mpp.DB.Delete(&pattern)
+	mpp.DB.Where("pattern_id = ?", id).Delete(&MesheryPatternRevision{})
 
 	return marshalMesheryPattern(&pattern), nil
 }
@@ -157,12 +160,14 @@ func (mpp *MesheryPatternPersister) DeleteMesheryPatterns(patterns MesheryPatter
 		id := uuid.FromStringOrNil(pObj.ID)
 		pattern := MesheryPattern{ID: &id}
 		mpp.DB.Delete(&pattern)
+		mpp.DB.Where("pattern_id = ?", id).Delete(&MesheryPatternRevision{})
 		deletedMaptterns = append(deletedMaptterns, pattern)
 	}
 
 	return marshalMesheryPatterns(deletedMaptterns), nil
 }
 
+// SaveMesheryPattern saves the pattern and records a revision of it, if it changed since its last revision
 func (mpp *MesheryPatternPersister) SaveMesheryPattern(pattern *MesheryPattern) ([]byte, error) {
 	if pattern.Visibility == "" {
 		pattern.Visibility = Private
@@ -176,7 +181,13 @@ func (mpp *MesheryPatternPersister) SaveMesheryPattern(pattern *MesheryPattern)
 		pattern.ID = &id
 	}
 
-	return marshalMesheryPatterns([]MesheryPattern{*pattern}), mpp.DB.Save(pattern).Error
+	err := mpp.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(pattern).Error; err != nil {
+			return err
+		}
+		return recordPatternRevision(tx, pattern)
+	})
+	return marshalMesheryPatterns([]MesheryPattern{*pattern}), err
 }
 
 // SaveMesheryPatterns batch inserts the given patterns
@@ -200,7 +211,18 @@ func (mpp *MesheryPatternPersister) SaveMesheryPatterns(patterns []MesheryPatter
 		finalPatterns = append(finalPatterns, pattern)
 	}
 
-	return marshalMesheryPatterns(finalPatterns), mpp.DB.Create(finalPatterns).Error
+	err := mpp.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(finalPatterns).Error; err != nil {
+			return err
+		}
+		for i := range finalPatterns {
+			if err := recordPatternRevision(tx, &finalPatterns[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return marshalMesheryPatterns(finalPatterns), err
 }
 
 func (mpp *MesheryPatternPersister) GetMesheryPattern(id uuid.UUID) ([]byte, error) {
@@ -210,6 +232,60 @@ func (mpp *MesheryPatternPersister) GetMesheryPattern(id uuid.UUID) ([]byte, err
 	return marshalMesheryPattern(&mesheryPattern), err
 }
 
+// GetMesheryPatternRevisions returns the revisions of the pattern, most recent first
+func (mpp *MesheryPatternPersister) GetMesheryPatternRevisions(id uuid.UUID) ([]byte, error) {
+	revisions := []MesheryPatternRevision{}
+	if err := mpp.DB.Where("pattern_id = ?", id).Order("revision desc").Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+	return json.Marshal(revisions)
+}
+
+// RestoreMesheryPatternRevision saves the revision of the pattern as its current version,
+// which records a new revision so that the restore can be undone as well
+func (mpp *MesheryPatternPersister) RestoreMesheryPatternRevision(id uuid.UUID, revision int) ([]byte, error) {
+	var mesheryPattern MesheryPattern
+	if err := mpp.DB.First(&mesheryPattern, id).Error; err != nil {
+		return nil, fmt.Errorf("unable to get design: %w", err)
+	}
+	var patternRevision MesheryPatternRevision
+	if err := mpp.DB.Where("pattern_id = ? AND revision = ?", id, revision).First(&patternRevision).Error; err != nil {
+		return nil, fmt.Errorf("unable to get revision %d of design: %w", revision, err)
+	}
+
+	mesheryPattern.Name = patternRevision.Name
+	mesheryPattern.PatternFile = patternRevision.PatternFile
+	return mpp.SaveMesheryPattern(&mesheryPattern)
+}
+
+// recordPatternRevision records the saved pattern as its next revision, unless it is identical to the last one
+func recordPatternRevision(tx *gorm.DB, pattern *MesheryPattern) error {
+	var last []MesheryPatternRevision
+	if err := tx.Where("pattern_id = ?", pattern.ID).Order("revision desc").Limit(1).Find(&last).Error; err != nil {
+		return err
+	}
+	revision := 1
+	if len(last) != 0 {
+		if last[0].Name == pattern.Name && last[0].PatternFile == pattern.PatternFile {
+			return nil
+		}
+		revision = last[0].Revision + 1
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return ErrGenerateUUID(err)
+	}
+	return tx.Create(&MesheryPatternRevision{
+		ID:          id,
+		PatternID:   *pattern.ID,
+		Revision:    revision,
+		Name:        pattern.Name,
+		PatternFile: pattern.PatternFile,
+		CreatedAt:   time.Now(),
+	}).Error
+}
+
 func marshalMesheryPatternPage(mpp *MesheryPatternPage) []byte {
 	res, _ := json.Marshal(mpp)
 
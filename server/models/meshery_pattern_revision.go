@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+// MesheryPatternRevision is an immutable snapshot of a design, recorded every time the design is saved with changes
+type MesheryPatternRevision struct {
+	ID        uuid.UUID `json:"id" gorm:"primaryKey"`
+	PatternID uuid.UUID `json:"pattern_id" gorm:"index"`
+	// Revision numbers the snapshots of a design, starting from 1
+	Revision    int       `json:"revision"`
+	Name        string    `json:"name"`
+	PatternFile string    `json:"pattern_file"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// MesheryPatternRevisionWithDiff is a revision of a design and the changes it made to the previous revision
+type MesheryPatternRevisionWithDiff struct {
+	MesheryPatternRevision
+	Diff core.PatternDiff `json:"diff"`
+}
+
+// MesheryPatternRevisionsAPIResponse is the history of a design, most recent revision first
+type MesheryPatternRevisionsAPIResponse struct {
+	PatternID string                           `json:"pattern_id"`
+	Revisions []MesheryPatternRevisionWithDiff `json:"revisions"`
+}
+
+// MesheryPatternRevisionsDiffAPIResponse is the difference between two revisions of a design
+type MesheryPatternRevisionsDiffAPIResponse struct {
+	PatternID string           `json:"pattern_id"`
+	From      int              `json:"from"`
+	To        int              `json:"to"`
+	Diff      core.PatternDiff `json:"diff"`
+}
@@ -1,5 +1,10 @@
 package models
 
+import (
+	"github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
 // PatternsAPIResponse response retruned by patternfile endpoint on meshery server
 type PatternsAPIResponse struct {
 	Page       uint             `json:"page"`
@@ -7,3 +12,43 @@ type PatternsAPIResponse struct {
 	TotalCount uint             `json:"total_count"`
 	Patterns   []MesheryPattern `json:"patterns"`
 }
+
+// PatternImportResponse is the design converted from another format by the pattern import endpoints
+type PatternImportResponse struct {
+	Name        string `json:"name"`
+	PatternFile string `json:"pattern_file"`
+	// Warnings report the parts of the imported file which could not be converted
+	Warnings []string `json:"warnings"`
+}
+
+// PatternMergeResponse is the merged design and the conflicts left unresolved, whose fields are taken from our design
+type PatternMergeResponse struct {
+	PatternFile string               `json:"pattern_file"`
+	Conflicts   []core.MergeConflict `json:"conflicts"`
+}
+
+// PatternMigrationResult is the migration of a saved design to the current version of the format of pattern files
+type PatternMigrationResult struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	core.MigrationReport
+	// Saved tells if the migrated design was saved
+	Saved bool   `json:"saved"`
+	Error string `json:"error,omitempty"`
+}
+
+// PatternMigrationResponse is the migration of the saved designs of the user
+type PatternMigrationResponse struct {
+	SchemaVersion string                   `json:"schema_version"`
+	Migrated      int                      `json:"migrated"`
+	Incompatible  int                      `json:"incompatible"`
+	Failed        int                      `json:"failed"`
+	Designs       []PatternMigrationResult `json:"designs"`
+}
+
+// PatternLintResponse is the mistakes found in a design by the pattern lint endpoint
+type PatternLintResponse struct {
+	Errors      int                              `json:"errors"`
+	Warnings    int                              `json:"warnings"`
+	Diagnostics []meshmodel.DesignLintDiagnostic `json:"diagnostics"`
+}
@@ -87,6 +87,8 @@ type RunnerResults struct {
 		Average     float64 `json:"Avg,omitempty"`
 		Max         float64 `json:"Max,omitempty"`
 		Min         float64 `json:"Min,omitempty"`
+		StdDev      float64 `json:"StdDev,omitempty"`
+		Count       int64   `json:"Count,omitempty"`
 		Percentiles []struct {
 			Percentile float64 `json:"Percentile,omitempty"`
 			Value      float64 `json:"Value,omitempty"`
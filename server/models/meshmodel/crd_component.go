@@ -0,0 +1,183 @@
+package meshmodel
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/layer5io/meshery/server/models/pattern/utils"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	"github.com/layer5io/meshkit/utils/manifests"
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultCRDCategory is the category of the models of the components generated from CRDs, when none is given
+const DefaultCRDCategory = "Uncategorized"
+
+// the fields of the resources which are set by the system, and are left out of the schemas of the components
+var systemFields = []string{"apiVersion", "kind", "metadata", "status"}
+
+type crdSchema struct {
+	OpenAPIV3Schema map[string]interface{} `json:"openAPIV3Schema"`
+}
+
+type customResourceDefinition struct {
+	Kind     string `json:"kind"`
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Spec struct {
+		Group string `json:"group"`
+		Names struct {
+			Kind string `json:"kind"`
+		} `json:"names"`
+		Scope    string `json:"scope"`
+		Versions []struct {
+			Name    string     `json:"name"`
+			Served  bool       `json:"served"`
+			Storage bool       `json:"storage"`
+			Schema  *crdSchema `json:"schema"`
+		} `json:"versions"`
+		// the version and the schema of apiextensions.k8s.io/v1beta1 CRDs
+		Version    string     `json:"version"`
+		Validation *crdSchema `json:"validation"`
+	} `json:"spec"`
+}
+
+// GenerateCRDComponents generates a component for every CustomResourceDefinition of the YAML or JSON documents.
+// The component is generated for the storage version of the CRD, or its first served version, and its schema is the
+// openAPIV3Schema of the version without the fields set by the system. The components belong to the given model, whose
+// name defaults to the group of the CRD, version to the version of the component and category to DefaultCRDCategory.
+func GenerateCRDComponents(data []byte, model v1alpha1.Model) ([]v1alpha1.ComponentDefinition, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	comps := make([]v1alpha1.ComponentDefinition, 0)
+	for i := 0; ; i++ {
+		var doc interface{}
+		err := dec.Decode(&doc)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+		if doc == nil {
+			continue
+		}
+		byt, err := json.Marshal(utils.ConvertMapInterfaceMapString(doc))
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+		var crd customResourceDefinition
+		if err := json.Unmarshal(byt, &crd); err != nil {
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+		comp, err := crdComponent(crd, model)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+		comps = append(comps, comp)
+	}
+	if len(comps) == 0 {
+		return nil, fmt.Errorf("no CustomResourceDefinition found")
+	}
+	return comps, nil
+}
+
+func crdComponent(crd customResourceDefinition, model v1alpha1.Model) (v1alpha1.ComponentDefinition, error) {
+	if crd.Kind != "CustomResourceDefinition" {
+		return v1alpha1.ComponentDefinition{}, fmt.Errorf("%s %s is not a CustomResourceDefinition", crd.Kind, crd.Metadata.Name)
+	}
+	kind := crd.Spec.Names.Kind
+	if kind == "" || crd.Spec.Group == "" {
+		return v1alpha1.ComponentDefinition{}, fmt.Errorf("CustomResourceDefinition %s has no group or kind", crd.Metadata.Name)
+	}
+
+	version, schema := crd.Spec.Version, crd.Spec.Validation
+	found := false
+	for _, v := range crd.Spec.Versions {
+		if v.Storage || (!found && v.Served) {
+			version, found = v.Name, true
+			if v.Schema != nil {
+				schema = v.Schema
+			}
+		}
+	}
+	if version == "" {
+		return v1alpha1.ComponentDefinition{}, fmt.Errorf("CustomResourceDefinition %s has no served version", crd.Metadata.Name)
+	}
+	if schema == nil || schema.OpenAPIV3Schema == nil {
+		return v1alpha1.ComponentDefinition{}, fmt.Errorf("CustomResourceDefinition %s has no openAPIV3Schema for version %s", crd.Metadata.Name, version)
+	}
+
+	properties := schema.OpenAPIV3Schema
+	if props, ok := properties["properties"].(map[string]interface{}); ok {
+		for _, f := range systemFields {
+			delete(props, f)
+		}
+	}
+	preserveUnknownFields(properties)
+	properties["title"] = manifests.FormatToReadableString(kind)
+	byt, err := json.MarshalIndent(properties, "", " ")
+	if err != nil {
+		return v1alpha1.ComponentDefinition{}, err
+	}
+
+	if model.Name == "" {
+		model.Name = crd.Spec.Group
+	}
+	if model.Version == "" {
+		model.Version = version
+	}
+	if model.DisplayName == "" {
+		model.DisplayName = manifests.FormatToReadableString(model.Name)
+	}
+	if model.Category.Name == "" {
+		model.Category.Name = DefaultCRDCategory
+	}
+	return v1alpha1.ComponentDefinition{
+		TypeMeta: v1alpha1.TypeMeta{
+			Kind:       kind,
+			APIVersion: fmt.Sprintf("%s/%s", crd.Spec.Group, version),
+		},
+		DisplayName: manifests.FormatToReadableString(kind),
+		Format:      v1alpha1.JSON,
+		Metadata: map[string]interface{}{
+			"isCustomResource": true,
+			"isNamespaced":     crd.Spec.Scope != "Cluster",
+		},
+		Model:  model,
+		Schema: string(byt),
+	}, nil
+}
+
+// preserveUnknownFields turns the properties of the schema preserving unknown fields, which cannot be described by
+// the schema, into free form text fields
+func preserveUnknownFields(schema map[string]interface{}) {
+	var children []map[string]interface{}
+	for _, key := range []string{"properties", "patternProperties"} {
+		if props, ok := schema[key].(map[string]interface{}); ok {
+			for _, p := range props {
+				if m, ok := p.(map[string]interface{}); ok {
+					children = append(children, m)
+				}
+			}
+		}
+	}
+	for _, key := range []string{"items", "additionalProperties"} {
+		if m, ok := schema[key].(map[string]interface{}); ok {
+			children = append(children, m)
+		}
+	}
+
+	for _, child := range children {
+		if preserve, _ := child["x-kubernetes-preserve-unknown-fields"].(bool); preserve {
+			delete(child, "x-kubernetes-preserve-unknown-fields")
+			delete(child, "properties")
+			child["type"] = "string"
+			child["format"] = "textarea"
+			continue
+		}
+		preserveUnknownFields(child)
+	}
+}
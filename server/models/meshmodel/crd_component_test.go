@@ -0,0 +1,135 @@
+package meshmodel
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+const certificateCRD = `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: certificates.cert-manager.io
+spec:
+  group: cert-manager.io
+  names:
+    kind: Certificate
+  scope: Namespaced
+  versions:
+    - name: v1alpha2
+      served: true
+      storage: false
+      schema:
+        openAPIV3Schema:
+          type: object
+    - name: v1
+      served: true
+      storage: true
+      schema:
+        openAPIV3Schema:
+          type: object
+          properties:
+            apiVersion:
+              type: string
+            kind:
+              type: string
+            metadata:
+              type: object
+            spec:
+              type: object
+              properties:
+                secretName:
+                  type: string
+                config:
+                  type: object
+                  x-kubernetes-preserve-unknown-fields: true
+            status:
+              type: object
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: clusterissuers.cert-manager.io
+spec:
+  group: cert-manager.io
+  names:
+    kind: ClusterIssuer
+  scope: Cluster
+  versions:
+    - name: v1
+      served: true
+      storage: true
+      schema:
+        openAPIV3Schema:
+          type: object
+`
+
+func TestGenerateCRDComponents(t *testing.T) {
+	comps, err := GenerateCRDComponents([]byte(certificateCRD), v1alpha1.Model{})
+	if err != nil {
+		t.Fatalf("GenerateCRDComponents() error = %v", err)
+	}
+	if len(comps) != 2 {
+		t.Fatalf("components = %d, want 2", len(comps))
+	}
+
+	t.Run("Components are generated for the storage version", func(t *testing.T) {
+		cert := comps[0]
+		if cert.Kind != "Certificate" || cert.APIVersion != "cert-manager.io/v1" {
+			t.Errorf("component = %s %s, want Certificate cert-manager.io/v1", cert.APIVersion, cert.Kind)
+		}
+		if cert.Model.Name != "cert-manager.io" || cert.Model.Version != "v1" || cert.Model.Category.Name != DefaultCRDCategory {
+			t.Errorf("model = %+v, want the defaults derived from the CRD", cert.Model)
+		}
+		if cert.Metadata["isNamespaced"] != true || comps[1].Metadata["isNamespaced"] != false {
+			t.Errorf("isNamespaced = %v, %v, want the scope of the CRDs", cert.Metadata["isNamespaced"], comps[1].Metadata["isNamespaced"])
+		}
+	})
+
+	t.Run("Schemas leave out the fields set by the system", func(t *testing.T) {
+		var schema map[string]interface{}
+		if err := json.Unmarshal([]byte(comps[0].Schema), &schema); err != nil {
+			t.Fatal(err)
+		}
+		props := schema["properties"].(map[string]interface{})
+		if len(props) != 1 || props["spec"] == nil {
+			t.Errorf("properties = %v, want only spec", props)
+		}
+		config := props["spec"].(map[string]interface{})["properties"].(map[string]interface{})["config"]
+		want := map[string]interface{}{"type": "string", "format": "textarea"}
+		if !reflect.DeepEqual(config, want) {
+			t.Errorf("config = %v, want a free form text field", config)
+		}
+	})
+
+	t.Run("The model given overrides the defaults", func(t *testing.T) {
+		comps, err := GenerateCRDComponents([]byte(certificateCRD), v1alpha1.Model{Name: "cert-manager", Version: "1.13.0"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if comps[0].Model.Name != "cert-manager" || comps[0].Model.Version != "1.13.0" {
+			t.Errorf("model = %+v", comps[0].Model)
+		}
+	})
+}
+
+func TestGenerateCRDComponentsErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		crd  string
+	}{
+		{name: "Other resources are rejected", crd: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: web\n"},
+		{name: "CRDs without schema are rejected", crd: "kind: CustomResourceDefinition\nspec:\n  group: example.com\n  names:\n    kind: Web\n  versions:\n    - name: v1\n      served: true\n"},
+		{name: "Empty documents are rejected", crd: "---\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := GenerateCRDComponents([]byte(tt.crd), v1alpha1.Model{}); err == nil {
+				t.Errorf("GenerateCRDComponents() error = nil, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package meshmodel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshery/server/models/pattern/jsonschema"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+// Severities of the diagnostics reported by the design linter
+const (
+	DesignLintError   = "error"
+	DesignLintWarning = "warning"
+)
+
+// Rules checked by the design linter
+const (
+	// the design file cannot be parsed
+	DesignLintRuleSyntax = "syntax"
+	// no component of the type, model and version of a component of the design is registered
+	DesignLintRuleUnknownComponent = "unknown-component"
+	// the settings of a component do not conform to the schema of its definition
+	DesignLintRuleComponentSchema = "component-schema"
+	// a relationship declared by the design is denied or not allowed by the registered relationships
+	DesignLintRuleRelationship = "relationship"
+	// the design violates a validation policy
+	DesignLintRulePolicy = "policy"
+	// the relationship policies suggest patching a component or removing a relationship of the design
+	DesignLintRulePolicySuggestion = "policy-suggestion"
+)
+
+// DesignLintDiagnostic is a mistake found in a design
+type DesignLintDiagnostic struct {
+	// Component is the name of the offending component, empty when the diagnostic concerns the whole design
+	Component string `json:"component,omitempty"`
+	Rule      string `json:"rule"`
+	Severity  string `json:"severity"`
+	Message   string `json:"message"`
+}
+
+// DesignLinter runs against a design the validations Meshery Server runs before deploying it
+type DesignLinter struct {
+	// Component returns the registered definition of the component of the type, version, model and API version
+	Component func(kind, version, model, apiVersion string) (v1alpha1.ComponentDefinition, error)
+	// Relationships are the registered relationships the relationships declared by the design are checked against
+	Relationships []v1alpha1.RelationshipDefinition
+	// Policies evaluates the design against the relationship and the validation policies, nil skips the policies
+	Policies *DesignPolicyEngine
+}
+
+// Lint returns the diagnostics of the design, ordered by component. The design is not modified.
+func (l DesignLinter) Lint(ctx context.Context, pattern core.Pattern) ([]DesignLintDiagnostic, error) {
+	diagnostics := make([]DesignLintDiagnostic, 0)
+
+	for name, svc := range pattern.Services {
+		if svc == nil {
+			continue
+		}
+		definition, err := l.Component(svc.Type, svc.Version, svc.Model, svc.APIVersion)
+		if err != nil {
+			diagnostics = append(diagnostics, DesignLintDiagnostic{
+				Component: name,
+				Rule:      DesignLintRuleUnknownComponent,
+				Severity:  DesignLintError,
+				Message:   err.Error(),
+			})
+			continue
+		}
+		settings := svc.Settings
+		if core.Format {
+			settings = core.Format.DePrettify(settings, false)
+		}
+		if err := ValidateComponentSettings(settings, definition.Schema); err != nil {
+			diagnostics = append(diagnostics, DesignLintDiagnostic{
+				Component: name,
+				Rule:      DesignLintRuleComponentSchema,
+				Severity:  DesignLintError,
+				Message:   fmt.Sprintf("invalid component configuration for %s: %s", name, err.Error()),
+			})
+		}
+	}
+
+	for _, violation := range ValidateDesignRelationships(DeclaredRelationships(pattern), l.Relationships) {
+		diagnostics = append(diagnostics, DesignLintDiagnostic{
+			Component: violation.From.Name,
+			Rule:      DesignLintRuleRelationship,
+			Severity:  DesignLintError,
+			Message:   violation.Message,
+		})
+	}
+
+	if l.Policies != nil {
+		report, err := l.Policies.Evaluate(ctx, pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, violation := range report.Violations {
+			diagnostics = append(diagnostics, DesignLintDiagnostic{
+				Rule:     DesignLintRulePolicy,
+				Severity: DesignLintError,
+				Message:  violation,
+			})
+		}
+		for _, rel := range report.Relationships {
+			if rel.Action != RelationshipActionRemove {
+				continue
+			}
+			diagnostics = append(diagnostics, DesignLintDiagnostic{
+				Component: rel.From.Name,
+				Rule:      DesignLintRulePolicySuggestion,
+				Severity:  DesignLintWarning,
+				Message:   fmt.Sprintf("the %s relationship from %q to %q is no longer satisfied, remove it (policy %s)", rel.Kind, rel.From.Name, rel.To.Name, rel.Policy),
+			})
+		}
+		for _, patch := range report.Patches {
+			paths := make([]string, 0, len(patch.Operations))
+			for _, op := range patch.Operations {
+				paths = append(paths, op.Op+" "+op.Path)
+			}
+			diagnostics = append(diagnostics, DesignLintDiagnostic{
+				Component: patch.Component.Name,
+				Rule:      DesignLintRulePolicySuggestion,
+				Severity:  DesignLintWarning,
+				Message:   fmt.Sprintf("the relationship policies suggest patching %q: %s", patch.Component.Name, strings.Join(paths, ", ")),
+			})
+		}
+	}
+
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		if diagnostics[i].Component != diagnostics[j].Component {
+			return diagnostics[i].Component < diagnostics[j].Component
+		}
+		return diagnostics[i].Rule < diagnostics[j].Rule
+	})
+	return diagnostics, nil
+}
+
+// ValidateComponentSettings validates the settings of a component against the JSON schema of its definition
+func ValidateComponentSettings(settings map[string]interface{}, schema string) error {
+	// Create schema validator from the schema
+	rs := jsonschema.GlobalJSONSchema()
+	if err := json.Unmarshal([]byte(schema), rs); err != nil {
+		return fmt.Errorf("failed to create schema: %s", err)
+	}
+
+	// Create json settings
+	jsonSettings, err := json.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("failed to generate schema from the PatternFile settings: %s", err)
+	}
+
+	// Validate the json against the schema
+	errs, err := rs.ValidateBytes(context.TODO(), jsonSettings)
+	if err != nil {
+		return fmt.Errorf("error occurred during schema validation: %s", err)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid settings: %s", errs)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package meshmodel
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+func TestDesignLinter(t *testing.T) {
+	definitions := map[string]v1alpha1.ComponentDefinition{
+		"Deployment": {Schema: `{"type": "object", "properties": {"spec": {"type": "object", "properties": {"replicas": {"type": "integer"}}}}}`},
+		"Pod":        {Schema: `{"type": "object"}`},
+	}
+	policies := fstest.MapFS{
+		"bare-pods.rego": {Data: []byte(`package meshery.designs
+
+deny[msg] {
+	svc := input.services[_]
+	svc.type == "Pod"
+	msg := sprintf("%s: pods should be managed by a Deployment", [svc.name])
+}
+`)},
+	}
+	engine, err := NewDesignPolicyEngine(policies, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	linter := DesignLinter{
+		Component: func(kind, _, _, _ string) (v1alpha1.ComponentDefinition, error) {
+			definition, ok := definitions[kind]
+			if !ok {
+				return definition, fmt.Errorf("no registered component of kind %s", kind)
+			}
+			return definition, nil
+		},
+		Policies: engine,
+	}
+
+	service := func(name, kind string, settings map[string]interface{}) *core.Service {
+		return &core.Service{Name: name, Type: kind, Model: "kubernetes", Settings: settings}
+	}
+	pattern := core.Pattern{
+		Name: "web",
+		Services: map[string]*core.Service{
+			"api":  service("api", "Deployment", map[string]interface{}{"spec": map[string]interface{}{"replicas": 2}}),
+			"web":  service("web", "Deployment", map[string]interface{}{"spec": map[string]interface{}{"replicas": "two"}}),
+			"bare": service("bare", "Pod", map[string]interface{}{}),
+			"mesh": service("mesh", "VirtualService", map[string]interface{}{}),
+		},
+	}
+
+	diagnostics, err := linter.Lint(context.Background(), pattern)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type diagnostic struct{ component, rule, severity string }
+	got := make([]diagnostic, 0, len(diagnostics))
+	for _, d := range diagnostics {
+		got = append(got, diagnostic{d.Component, d.Rule, d.Severity})
+	}
+	want := []diagnostic{
+		{"", DesignLintRulePolicy, DesignLintError},
+		{"mesh", DesignLintRuleUnknownComponent, DesignLintError},
+		{"web", DesignLintRuleComponentSchema, DesignLintError},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Lint() = %+v, want %+v", diagnostics, want)
+	}
+
+	// valid designs have no diagnostics
+	delete(pattern.Services, "web")
+	delete(pattern.Services, "bare")
+	delete(pattern.Services, "mesh")
+	if diagnostics, err := linter.Lint(context.Background(), pattern); err != nil || len(diagnostics) != 0 {
+		t.Errorf("Lint() = %+v, %v, want no diagnostics", diagnostics, err)
+	}
+}
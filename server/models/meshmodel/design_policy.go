@@ -0,0 +1,162 @@
+package meshmodel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/open-policy-agent/opa/rego"
+	"github.com/open-policy-agent/opa/storage/inmem"
+)
+
+// DesignPolicyQuery is the rule evaluated by the validation policies of designs.
+// Policies declare the rule in the "meshery.designs" package, every value of the set is reported as a violation:
+//
+//	package meshery.designs
+//
+//	deny[msg] {
+//		svc := input.services[_]
+//		svc.type == "Pod"
+//		msg := sprintf("%s: pods should be managed by a Deployment", [svc.name])
+//	}
+const DesignPolicyQuery = "data.meshery.designs.deny"
+
+// relationshipEvaluationQuery is the package of the relationship evaluation policies, see server/meshmodel/kubernetes/policies
+const relationshipEvaluationQuery = "data.meshmodel_policy"
+
+// DesignPolicyReport is the outcome of evaluating the relationship and the validation policies against a design
+type DesignPolicyReport struct {
+	PolicyEvaluation
+	// Violations are the messages of the validation policies the design violates
+	Violations []string `json:"violations"`
+}
+
+// Passed tells if the design violates none of the validation policies. The relationships and the patches suggested
+// by the relationship policies are not violations, designs are not expected to declare them.
+func (r DesignPolicyReport) Passed() bool {
+	return len(r.Violations) == 0
+}
+
+// DesignPolicyEngine evaluates designs against a set of Rego modules, without a Meshery Server
+type DesignPolicyEngine struct {
+	modules map[string]string
+	// the relationship definitions the policies are evaluated with, by lowercase subType
+	data map[string]interface{}
+}
+
+// NewDesignPolicyEngine loads the Rego modules of the .rego files of policies and the relationship definitions of
+// the .json files of relationships, eg: the ones embedded by server/meshmodel/kubernetes. relationships can be nil.
+func NewDesignPolicyEngine(policies, relationships fs.FS) (*DesignPolicyEngine, error) {
+	engine := &DesignPolicyEngine{modules: map[string]string{}, data: map[string]interface{}{}}
+	if err := fs.WalkDir(policies, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || path.Ext(name) != ".rego" {
+			return err
+		}
+		byt, err := fs.ReadFile(policies, name)
+		if err != nil {
+			return err
+		}
+		engine.AddModule(name, string(byt))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if relationships == nil {
+		return engine, nil
+	}
+	if err := fs.WalkDir(relationships, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || path.Ext(name) != ".json" {
+			return err
+		}
+		byt, err := fs.ReadFile(relationships, name)
+		if err != nil {
+			return err
+		}
+		var relationship map[string]interface{}
+		if err := json.Unmarshal(byt, &relationship); err != nil {
+			return fmt.Errorf("invalid relationship definition %s: %w", name, err)
+		}
+		subType, _ := relationship["subType"].(string)
+		engine.data[strings.ToLower(subType)] = relationship
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return engine, nil
+}
+
+// AddModule adds the Rego module to the policies, replacing the module previously added with the same name
+func (e *DesignPolicyEngine) AddModule(name, module string) {
+	e.modules[name] = module
+}
+
+// Evaluate runs the policies against the design. The design is not modified.
+func (e *DesignPolicyEngine) Evaluate(ctx context.Context, pattern core.Pattern) (DesignPolicyReport, error) {
+	report := DesignPolicyReport{Violations: make([]string, 0)}
+
+	// the policies are written against the settings of the components as they are deployed
+	services := make(map[string]*core.Service, len(pattern.Services))
+	for name, svc := range pattern.Services {
+		if svc == nil {
+			continue
+		}
+		copied := *svc
+		copied.Settings = core.Format.DePrettify(svc.Settings, false)
+		services[name] = &copied
+	}
+	pattern.Services = services
+
+	byt, err := json.Marshal(pattern)
+	if err != nil {
+		return report, err
+	}
+	var input map[string]interface{}
+	if err := json.Unmarshal(byt, &input); err != nil {
+		return report, err
+	}
+
+	results, err := e.eval(ctx, relationshipEvaluationQuery, input)
+	if err != nil {
+		return report, err
+	}
+	evaluation, _ := results.(map[string]interface{})
+	report.PolicyEvaluation = InterpretPolicyEvaluation(pattern, evaluation)
+
+	denials, err := e.eval(ctx, DesignPolicyQuery, input)
+	if err != nil {
+		return report, err
+	}
+	for _, denial := range asSlice(denials) {
+		report.Violations = append(report.Violations, policyMessage(denial))
+	}
+	sort.Strings(report.Violations)
+	return report, nil
+}
+
+// eval returns the value of the query, nil when none of the modules defines it
+func (e *DesignPolicyEngine) eval(ctx context.Context, query string, input map[string]interface{}) (interface{}, error) {
+	options := []func(*rego.Rego){
+		rego.Query(query),
+		rego.Store(inmem.NewFromObject(e.data)),
+	}
+	for name, module := range e.modules {
+		options = append(options, rego.Module(name, module))
+	}
+	prepared, err := rego.New(options...).PrepareForEval(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compile the policies: %w", err)
+	}
+	rs, err := prepared.Eval(ctx, rego.EvalInput(input))
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate %s: %w", query, err)
+	}
+	if len(rs) == 0 || len(rs[0].Expressions) == 0 {
+		return nil, nil
+	}
+	return rs[0].Expressions[0].Value, nil
+}
@@ -0,0 +1,81 @@
+package meshmodel
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+func TestDesignPolicyEngine(t *testing.T) {
+	policies := fstest.MapFS{
+		"service-pod.rego": {Data: []byte(`package meshmodel_policy
+
+service_pod_relationships[{"source_id": service.traits.meshmap.id, "destination_id": pod.traits.meshmap.id}] {
+	service := input.services[_]
+	service.type == "Service"
+	pod := input.services[_]
+	pod.type == "Pod"
+	data.network.subType == "Network"
+}
+`)},
+		"bare-pods.rego": {Data: []byte(`package meshery.designs
+
+deny[msg] {
+	svc := input.services[_]
+	svc.type == "Pod"
+	msg := sprintf("%s: pods should be managed by a Deployment", [svc.name])
+}
+`)},
+		"README.md": {Data: []byte("not a policy")},
+	}
+	relationships := fstest.MapFS{
+		"network_edge.json": {Data: []byte(`{"kind": "Edge", "subType": "Network"}`)},
+	}
+	engine, err := NewDesignPolicyEngine(policies, relationships)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := func(name, kind, id string) *core.Service {
+		return &core.Service{Name: name, Type: kind, Model: "kubernetes", Traits: map[string]interface{}{"meshmap": map[string]interface{}{"id": id}}}
+	}
+	pattern := core.Pattern{
+		Name: "web",
+		Services: map[string]*core.Service{
+			"svc": service("svc", "Service", "1"),
+			"web": service("web", "Pod", "2"),
+		},
+	}
+	report, err := engine.Evaluate(context.Background(), pattern)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Passed() {
+		t.Error("Passed() = true, want the violation of the validation policy")
+	}
+	if want := []string{"web: pods should be managed by a Deployment"}; !reflect.DeepEqual(report.Violations, want) {
+		t.Errorf("violations = %v, want %v", report.Violations, want)
+	}
+	want := []SuggestedRelationship{{
+		Kind:    RelationshipKindEdge,
+		SubType: "Network",
+		Action:  RelationshipActionAdd,
+		From:    ComponentRef{Name: "svc", Kind: "Service", Model: "kubernetes"},
+		To:      ComponentRef{Name: "web", Kind: "Pod", Model: "kubernetes"},
+		Policy:  servicePodPolicy,
+	}}
+	if !reflect.DeepEqual(report.Relationships, want) {
+		t.Errorf("relationships = %+v, want %+v", report.Relationships, want)
+	}
+
+	// designs without validation policies pass
+	delete(engine.modules, "bare-pods.rego")
+	report, err = engine.Evaluate(context.Background(), pattern)
+	if err != nil || !report.Passed() {
+		t.Errorf("Evaluate() = %+v, %v, want no violations", report, err)
+	}
+}
@@ -0,0 +1,244 @@
+package meshmodel
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+// Actions suggested for the relationships found by the evaluation policies
+const (
+	RelationshipActionAdd    = "add"
+	RelationshipActionRemove = "remove"
+)
+
+// Results of the relationship evaluation policies, see server/meshmodel/kubernetes/policies
+const (
+	bindingPolicy           = "binding_relationship"
+	hierarchyPolicy         = "parent_child_relationship"
+	namespacePolicy         = "namespaces"
+	servicePodPolicy        = "service_pod_relationships"
+	serviceDeploymentPolicy = "service_deployment_relationships"
+)
+
+// SuggestedRelationship is a relationship between two components of a design suggested by the evaluation policies
+type SuggestedRelationship struct {
+	Kind    string `json:"kind"`
+	SubType string `json:"subType,omitempty"`
+	// Action is either add, for relationships missing from the design, or remove, for relationships no longer satisfied
+	Action string       `json:"action"`
+	From   ComponentRef `json:"from"`
+	To     ComponentRef `json:"to"`
+	// Via is the component binding the pair, if any, eg: the RoleBinding binding a Role to a ServiceAccount
+	Via *ComponentRef `json:"via,omitempty"`
+	// Policy is the name of the policy suggesting the relationship
+	Policy string `json:"policy"`
+}
+
+// PatchOperation is a JSON patch (RFC 6902) operation on a component of a design
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// ComponentPatch is the configuration the evaluation policies suggest applying to a component of a design
+type ComponentPatch struct {
+	Component  ComponentRef     `json:"component"`
+	Operations []PatchOperation `json:"operations"`
+}
+
+// PolicyEvaluation is the outcome of evaluating the relationship policies against a design
+type PolicyEvaluation struct {
+	Relationships []SuggestedRelationship `json:"relationships"`
+	Patches       []ComponentPatch        `json:"patches"`
+}
+
+// InterpretPolicyEvaluation turns the results of the relationship evaluation policies run against the design into
+// the relationships and configuration patches they suggest. Components are referred to by their meshmap id in the
+// results, the ones not part of the design are ignored.
+func InterpretPolicyEvaluation(pattern core.Pattern, results map[string]interface{}) PolicyEvaluation {
+	comps := make(map[string]ComponentRef, len(pattern.Services))
+	settings := make(map[string]map[string]interface{}, len(pattern.Services))
+	namespaces := make(map[string]ComponentRef)
+	for _, svc := range pattern.Services {
+		if svc == nil {
+			continue
+		}
+		ref := ComponentRef{Name: svc.Name, Kind: svc.Type, Model: svc.Model}
+		if svc.Type == "Namespace" {
+			namespaces[svc.Name] = ref
+		}
+		id := componentID(svc.Traits)
+		if id == "" {
+			continue
+		}
+		comps[id] = ref
+		settings[id] = svc.Settings
+	}
+
+	eval := PolicyEvaluation{
+		Relationships: make([]SuggestedRelationship, 0),
+		Patches:       make([]ComponentPatch, 0),
+	}
+	suggest := func(kind, subType, action, policy, from, to, via string) {
+		src, ok := comps[from]
+		if !ok {
+			return
+		}
+		dst, ok := comps[to]
+		if !ok || from == to {
+			return
+		}
+		rel := SuggestedRelationship{Kind: kind, SubType: subType, Action: action, From: src, To: dst, Policy: policy}
+		if via != "" {
+			binder, ok := comps[via]
+			if !ok {
+				return
+			}
+			rel.Via = &binder
+		}
+		eval.Relationships = append(eval.Relationships, rel)
+	}
+
+	// the binding policy yields, for every binding type, the edges to add and to remove
+	for _, result := range asSlice(results[bindingPolicy]) {
+		bindings, _ := result.(map[string]interface{})
+		for bindingType, edges := range bindings {
+			edges, _ := edges.(map[string]interface{})
+			for action, key := range map[string]string{RelationshipActionAdd: "edges_to_add", RelationshipActionRemove: "edges_to_remove"} {
+				for _, edge := range asSlice(edges[key]) {
+					edge, _ := edge.(map[string]interface{})
+					suggest(RelationshipKindEdge, bindingType, action, bindingPolicy,
+						nestedID(edge, "from"), nestedID(edge, "to"), nestedID(edge, "binded_by"))
+				}
+			}
+		}
+	}
+
+	for _, policy := range []string{servicePodPolicy, serviceDeploymentPolicy} {
+		for _, result := range asSlice(results[policy]) {
+			edge, _ := result.(map[string]interface{})
+			source, _ := edge["source_id"].(string)
+			destination, _ := edge["destination_id"].(string)
+			suggest(RelationshipKindEdge, "Network", RelationshipActionAdd, policy, source, destination, "")
+		}
+	}
+
+	// the namespace policy groups the ids of the components by namespace
+	byNamespace, _ := results[namespacePolicy].(map[string]interface{})
+	for ns, members := range byNamespace {
+		parent, ok := namespaces[ns]
+		if !ok {
+			continue
+		}
+		members, _ := members.(map[string]interface{})
+		for _, id := range members {
+			id, _ := id.(string)
+			if comp, ok := comps[id]; ok && comp != parent {
+				eval.Relationships = append(eval.Relationships, SuggestedRelationship{
+					Kind:   RelationshipKindHierarchical,
+					Action: RelationshipActionAdd,
+					From:   comp,
+					To:     parent,
+					Policy: namespacePolicy,
+				})
+			}
+		}
+	}
+
+	// the hierarchy policy yields the design with the configuration of the parents patched by their children
+	updated, _ := results[hierarchyPolicy].(map[string]interface{})
+	for id, svc := range updated {
+		comp, ok := comps[id]
+		if !ok {
+			continue
+		}
+		svc, _ := svc.(map[string]interface{})
+		desired, _ := normalize(svc["settings"]).(map[string]interface{})
+		current, _ := normalize(settings[id]).(map[string]interface{})
+		if ops := settingsPatch(current, desired); len(ops) > 0 {
+			eval.Patches = append(eval.Patches, ComponentPatch{Component: comp, Operations: ops})
+		}
+	}
+
+	sort.SliceStable(eval.Relationships, func(i, j int) bool {
+		a, b := eval.Relationships[i], eval.Relationships[j]
+		if a.From.Name != b.From.Name {
+			return a.From.Name < b.From.Name
+		}
+		if a.To.Name != b.To.Name {
+			return a.To.Name < b.To.Name
+		}
+		if a.Policy != b.Policy {
+			return a.Policy < b.Policy
+		}
+		return a.SubType+a.Action < b.SubType+b.Action
+	})
+	sort.Slice(eval.Patches, func(i, j int) bool {
+		return eval.Patches[i].Component.Name < eval.Patches[j].Component.Name
+	})
+	return eval
+}
+
+// settingsPatch returns the operations turning the current settings of a component into the desired ones, ordered by path
+func settingsPatch(current, desired map[string]interface{}) []PatchOperation {
+	ops := make([]PatchOperation, 0)
+	for key, value := range desired {
+		if cur, ok := current[key]; !ok || !reflect.DeepEqual(cur, value) {
+			op := "replace"
+			if !ok {
+				op = "add"
+			}
+			ops = append(ops, PatchOperation{Op: op, Path: "/settings/" + escapePointer(key), Value: value})
+		}
+	}
+	for key := range current {
+		if _, ok := desired[key]; !ok {
+			ops = append(ops, PatchOperation{Op: "remove", Path: "/settings/" + escapePointer(key)})
+		}
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].Path < ops[j].Path
+	})
+	return ops
+}
+
+// escapePointer escapes a key to be used as a JSON pointer reference token
+func escapePointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+// normalize round trips the value through JSON, so that values decoded from YAML and from the policy results compare equal
+func normalize(v interface{}) interface{} {
+	byt, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var out interface{}
+	if err := json.Unmarshal(byt, &out); err != nil {
+		return nil
+	}
+	return out
+}
+
+func componentID(traits map[string]interface{}) string {
+	meshmap, _ := traits["meshmap"].(map[string]interface{})
+	id, _ := meshmap["id"].(string)
+	return id
+}
+
+func nestedID(m map[string]interface{}, key string) string {
+	ref, _ := m[key].(map[string]interface{})
+	id, _ := ref["id"].(string)
+	return id
+}
+
+// asSlice returns the elements of a set returned by a policy
+func asSlice(v interface{}) []interface{} {
+	s, _ := v.([]interface{})
+	return s
+}
@@ -0,0 +1,101 @@
+package meshmodel
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+func TestInterpretPolicyEvaluation(t *testing.T) {
+	service := func(name, kind, id string, settings map[string]interface{}) *core.Service {
+		return &core.Service{
+			Name:     name,
+			Type:     kind,
+			Model:    "kubernetes",
+			Settings: settings,
+			Traits:   map[string]interface{}{"meshmap": map[string]interface{}{"id": id}},
+		}
+	}
+	pattern := core.Pattern{
+		Services: map[string]*core.Service{
+			"svc":     service("svc", "Service", "1", nil),
+			"deploy":  service("deploy", "Deployment", "2", map[string]interface{}{"replicas": 1, "paused": false}),
+			"role":    service("role", "Role", "3", nil),
+			"binding": service("binding", "RoleBinding", "4", nil),
+			"sa":      service("sa", "ServiceAccount", "5", nil),
+			"default": service("default", "Namespace", "6", nil),
+		},
+	}
+	ref := func(name, kind string) ComponentRef {
+		return ComponentRef{Name: name, Kind: kind, Model: "kubernetes"}
+	}
+	binding := ref("binding", "RoleBinding")
+
+	tests := []struct {
+		name    string
+		results map[string]interface{}
+		want    PolicyEvaluation
+	}{
+		{
+			name:    "Nothing is suggested when the policies yield no results",
+			results: map[string]interface{}{},
+			want:    PolicyEvaluation{Relationships: []SuggestedRelationship{}, Patches: []ComponentPatch{}},
+		},
+		{
+			name: "Relationships are suggested between the components of the design",
+			results: map[string]interface{}{
+				"binding_relationship": []interface{}{
+					map[string]interface{}{"permission": map[string]interface{}{
+						"edges_to_add": []interface{}{map[string]interface{}{
+							"from": map[string]interface{}{"id": "3"}, "to": map[string]interface{}{"id": "5"}, "binded_by": map[string]interface{}{"id": "4"},
+						}},
+						"edges_to_remove": []interface{}{map[string]interface{}{
+							"from": map[string]interface{}{"id": "3"}, "to": map[string]interface{}{"id": "missing"}, "binded_by": map[string]interface{}{"id": "4"},
+						}},
+					}},
+				},
+				"service_deployment_relationships": []interface{}{
+					map[string]interface{}{"source_id": "1", "destination_id": "2"},
+				},
+				"namespaces": map[string]interface{}{
+					"default": map[string]interface{}{"svc": "1"},
+					"other":   map[string]interface{}{"deploy": "2"},
+				},
+			},
+			want: PolicyEvaluation{
+				Relationships: []SuggestedRelationship{
+					{Kind: RelationshipKindEdge, SubType: "permission", Action: RelationshipActionAdd, From: ref("role", "Role"), To: ref("sa", "ServiceAccount"), Via: &binding, Policy: "binding_relationship"},
+					{Kind: RelationshipKindHierarchical, Action: RelationshipActionAdd, From: ref("svc", "Service"), To: ref("default", "Namespace"), Policy: "namespaces"},
+					{Kind: RelationshipKindEdge, SubType: "Network", Action: RelationshipActionAdd, From: ref("svc", "Service"), To: ref("deploy", "Deployment"), Policy: "service_deployment_relationships"},
+				},
+				Patches: []ComponentPatch{},
+			},
+		},
+		{
+			name: "Patches are suggested for the components whose settings are updated",
+			results: map[string]interface{}{
+				"parent_child_relationship": map[string]interface{}{
+					"1": map[string]interface{}{"settings": nil},
+					"2": map[string]interface{}{"settings": map[string]interface{}{"replicas": 1.0, "spec/path": "v"}},
+				},
+			},
+			want: PolicyEvaluation{
+				Relationships: []SuggestedRelationship{},
+				Patches: []ComponentPatch{
+					{Component: ref("deploy", "Deployment"), Operations: []PatchOperation{
+						{Op: "remove", Path: "/settings/paused"},
+						{Op: "add", Path: "/settings/spec~1path", Value: "v"},
+					}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InterpretPolicyEvaluation(pattern, tt.results); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InterpretPolicyEvaluation() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,343 @@
+package meshmodel
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	"github.com/layer5io/meshkit/models/meshmodel/registry"
+	"gorm.io/gorm"
+)
+
+// Outcomes of the import of an entity of a registry bundle
+const (
+	BundleEntityAdded   = "added"
+	BundleEntityUpdated = "updated"
+	BundleEntitySkipped = "skipped"
+	BundleEntityFailed  = "failed"
+)
+
+// RegistryBundle holds every model, component and relationship of a registry, so that they can be moved to Meshery
+// deployments without access to the internet
+type RegistryBundle struct {
+	Models        []v1alpha1.Model
+	Components    []v1alpha1.ComponentDefinition
+	Relationships []v1alpha1.RelationshipDefinition
+}
+
+// RegistryImportResult is the outcome of the import of an entity of a registry bundle
+type RegistryImportResult struct {
+	// One of RegistryEntityModel, RegistryEntityComponent or RegistryEntityRelationship
+	EntityType string `json:"entityType"`
+	Model      string `json:"model"`
+	Version    string `json:"version"`
+	// Kind of the component or relationship, empty for models
+	Kind   string `json:"kind,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// RegistryImportReport is the outcome of the import of a registry bundle
+type RegistryImportReport struct {
+	Added   int                    `json:"added"`
+	Updated int                    `json:"updated"`
+	Skipped int                    `json:"skipped"`
+	Failed  int                    `json:"failed"`
+	Results []RegistryImportResult `json:"results"`
+}
+
+func (r *RegistryImportReport) add(result RegistryImportResult) {
+	switch result.Status {
+	case BundleEntityAdded:
+		r.Added++
+	case BundleEntityUpdated:
+		r.Updated++
+	case BundleEntitySkipped:
+		r.Skipped++
+	case BundleEntityFailed:
+		r.Failed++
+	}
+	r.Results = append(r.Results, result)
+}
+
+// ExportRegistryBundle writes the models, components and relationships of the bundle as a gzip compressed tarball to w.
+// The entities are laid out as <model>/<version>/model.json, <model>/<version>/components/<kind>.json and
+// <model>/<version>/relationships/<kind>-<subType>.json. Registrant details and the organizations of the relationships
+// are stripped as they are specific to the deployment the entities are exported from.
+func ExportRegistryBundle(w io.Writer, bundle RegistryBundle) error {
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+	now := time.Now()
+	names := make(map[string]int)
+
+	write := func(dir, name string, entity interface{}) error {
+		byt, err := json.MarshalIndent(entity, "", "  ")
+		if err != nil {
+			return err
+		}
+		file := path.Join(dir, name)
+		if n := names[file]; n > 0 {
+			names[file]++
+			file = path.Join(dir, fmt.Sprintf("%s-%d", name, n))
+		} else {
+			names[file] = 1
+		}
+		err = tw.WriteHeader(&tar.Header{
+			Name:    file + ".json",
+			Mode:    0644,
+			Size:    int64(len(byt)),
+			ModTime: now,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = tw.Write(byt)
+		return err
+	}
+
+	for _, model := range bundle.Models {
+		model = bundleModel(model)
+		if err := write(path.Join(model.Name, model.Version), "model", model); err != nil {
+			return err
+		}
+	}
+	for _, comp := range bundle.Components {
+		comp.Model = bundleModel(comp.Model)
+		comp.HostID = uuid.Nil
+		comp.HostName = ""
+		comp.DisplayHostName = ""
+		if err := write(path.Join(comp.Model.Name, comp.Model.Version, "components"), strings.ToLower(comp.Kind), comp); err != nil {
+			return err
+		}
+	}
+	for _, rel := range bundle.Relationships {
+		rel.Model = bundleModel(rel.Model)
+		rel.HostID = uuid.Nil
+		rel.HostName = ""
+		rel.DisplayHostName = ""
+		if orgID := RelationshipOrg(rel.Metadata); orgID != "" {
+			metadata := make(map[string]interface{}, len(rel.Metadata))
+			for k, v := range rel.Metadata {
+				metadata[k] = v
+			}
+			delete(metadata, RelationshipOrgKey)
+			rel.Metadata = metadata
+		}
+		name := strings.ToLower(rel.Kind)
+		if rel.SubType != "" {
+			name = fmt.Sprintf("%s-%s", name, strings.ToLower(rel.SubType))
+		}
+		if err := write(path.Join(rel.Model.Name, rel.Model.Version, "relationships"), name, rel); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gzw.Close()
+}
+
+// bundleModel strips the registrant details of the model
+func bundleModel(model v1alpha1.Model) v1alpha1.Model {
+	model.HostID = uuid.Nil
+	model.HostName = ""
+	model.DisplayHostName = ""
+	return model
+}
+
+// ReadRegistryBundle reads a registry bundle written by ExportRegistryBundle.
+// The type of every entity is told by the file it is read from, files which are not entities are ignored.
+func ReadRegistryBundle(r io.Reader) (RegistryBundle, error) {
+	var bundle RegistryBundle
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return bundle, err
+	}
+	defer gzr.Close()
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return bundle, err
+		}
+		if header.Typeflag != tar.TypeReg || path.Ext(header.Name) != ".json" {
+			continue
+		}
+		byt, err := io.ReadAll(tr)
+		if err != nil {
+			return bundle, err
+		}
+
+		switch {
+		case path.Base(header.Name) == "model.json":
+			var model v1alpha1.Model
+			if err := json.Unmarshal(byt, &model); err != nil {
+				return bundle, fmt.Errorf("invalid model %s: %w", header.Name, err)
+			}
+			bundle.Models = append(bundle.Models, model)
+		case path.Base(path.Dir(header.Name)) == "components":
+			var comp v1alpha1.ComponentDefinition
+			if err := json.Unmarshal(byt, &comp); err != nil {
+				return bundle, fmt.Errorf("invalid component %s: %w", header.Name, err)
+			}
+			bundle.Components = append(bundle.Components, comp)
+		case path.Base(path.Dir(header.Name)) == "relationships":
+			var rel v1alpha1.RelationshipDefinition
+			if err := json.Unmarshal(byt, &rel); err != nil {
+				return bundle, fmt.Errorf("invalid relationship %s: %w", header.Name, err)
+			}
+			bundle.Relationships = append(bundle.Relationships, rel)
+		}
+	}
+	return bundle, nil
+}
+
+// ImportRegistryBundle registers the entities of the bundle which are not registered yet, updates the registered components
+// and relationships which differ from those of the bundle and skips the others. Components are identified by their model,
+// model version, kind and apiVersion, relationships by their model, model version, kind, subType and selectors.
+// Entities failing to import are reported without stopping the import of the others.
+func ImportRegistryBundle(db *database.Handler, rm *registry.RegistryManager, host registry.Host, bundle RegistryBundle) RegistryImportReport {
+	report := RegistryImportReport{Results: []RegistryImportResult{}}
+
+	for _, model := range bundle.Models {
+		result := RegistryImportResult{EntityType: RegistryEntityModel, Model: model.Name, Version: model.Version, Status: BundleEntitySkipped}
+		var count int64
+		err := db.Model(&v1alpha1.ModelDB{}).Where("name = ? AND version = ?", model.Name, model.Version).Count(&count).Error
+		if err == nil && count == 0 {
+			result.Status = BundleEntityAdded
+			_, err = v1alpha1.CreateModel(db, model)
+		}
+		if err != nil {
+			result.Status, result.Error = BundleEntityFailed, err.Error()
+		}
+		report.add(result)
+	}
+
+	for _, comp := range bundle.Components {
+		result := RegistryImportResult{EntityType: RegistryEntityComponent, Model: comp.Model.Name, Version: comp.Model.Version, Kind: comp.Kind}
+		status, err := importComponent(db, rm, host, comp)
+		result.Status = status
+		if err != nil {
+			result.Status, result.Error = BundleEntityFailed, err.Error()
+		}
+		report.add(result)
+	}
+
+	for _, rel := range bundle.Relationships {
+		result := RegistryImportResult{EntityType: RegistryEntityRelationship, Model: rel.Model.Name, Version: rel.Model.Version, Kind: rel.Kind}
+		status, err := importRelationship(db, rm, host, rel)
+		result.Status = status
+		if err != nil {
+			result.Status, result.Error = BundleEntityFailed, err.Error()
+		}
+		report.add(result)
+	}
+
+	sort.SliceStable(report.Results, func(i, j int) bool {
+		a, b := report.Results[i], report.Results[j]
+		if a.Model != b.Model {
+			return a.Model < b.Model
+		}
+		return a.Version < b.Version
+	})
+	return report
+}
+
+func importComponent(db *database.Handler, rm *registry.RegistryManager, host registry.Host, comp v1alpha1.ComponentDefinition) (string, error) {
+	var existing v1alpha1.ComponentDefinitionDB
+	err := db.Model(&v1alpha1.ComponentDefinitionDB{}).
+		Select("component_definition_dbs.*").
+		Joins("JOIN model_dbs ON component_definition_dbs.model_id = model_dbs.id").
+		Where("model_dbs.name = ? AND model_dbs.version = ? AND component_definition_dbs.kind = ? AND component_definition_dbs.api_version = ?",
+			comp.Model.Name, comp.Model.Version, comp.Kind, comp.APIVersion).
+		First(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return BundleEntityAdded, rm.RegisterEntity(host, comp)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var metadata map[string]interface{}
+	_ = json.Unmarshal(existing.Metadata, &metadata)
+	if existing.Schema == comp.Schema && existing.DisplayName == comp.DisplayName && existing.Format == comp.Format && equalMetadata(metadata, comp.Metadata) {
+		return BundleEntitySkipped, nil
+	}
+	byt, err := json.Marshal(comp.Metadata)
+	if err != nil {
+		return "", err
+	}
+	err = db.Model(&v1alpha1.ComponentDefinitionDB{}).Where("id = ?", existing.ID).Updates(map[string]interface{}{
+		"display_name": comp.DisplayName,
+		"format":       comp.Format,
+		"metadata":     byt,
+		"schema":       comp.Schema,
+		"updated_at":   time.Now(),
+	}).Error
+	return BundleEntityUpdated, err
+}
+
+func importRelationship(db *database.Handler, rm *registry.RegistryManager, host registry.Host, rel v1alpha1.RelationshipDefinition) (string, error) {
+	if err := ValidateRelationshipDefinition(rel); err != nil {
+		return "", err
+	}
+	existing, err := FindDuplicateRelationship(db, rel)
+	if err != nil {
+		return "", err
+	}
+	if existing == nil {
+		return BundleEntityAdded, rm.RegisterEntity(host, rel)
+	}
+
+	// the version, provenance and organization of a relationship are specific to the registry it is registered with
+	bookkeeping := func(metadata map[string]interface{}) map[string]interface{} {
+		stripped := make(map[string]interface{}, len(metadata))
+		for k, v := range metadata {
+			if k != RelationshipVersionKey && k != RelationshipSourceKey && k != RelationshipOrgKey {
+				stripped[k] = v
+			}
+		}
+		return stripped
+	}
+	if equalMetadata(bookkeeping(existing.Metadata), bookkeeping(rel.Metadata)) {
+		return BundleEntitySkipped, nil
+	}
+	var rdb v1alpha1.RelationshipDefinitionDB
+	if err := db.First(&rdb, "id = ?", existing.ID).Error; err != nil {
+		return "", err
+	}
+	byt, err := json.Marshal(rel)
+	if err != nil {
+		return "", err
+	}
+	_, err = UpdateRelationship(db, rdb, byt, false)
+	return BundleEntityUpdated, err
+}
+
+// equalMetadata compares the metadata as JSON, so that numbers decoded from JSON equal the numbers they were encoded from
+func equalMetadata(a, b map[string]interface{}) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	var x, y interface{}
+	byt, _ := json.Marshal(a)
+	_ = json.Unmarshal(byt, &x)
+	byt, _ = json.Marshal(b)
+	_ = json.Unmarshal(byt, &y)
+	return reflect.DeepEqual(x, y)
+}
@@ -0,0 +1,68 @@
+package meshmodel
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+func TestRegistryBundleRoundTrip(t *testing.T) {
+	model := v1alpha1.Model{Name: "kubernetes", Version: "v1.25.2", HostName: "localhost"}
+	component := func(apiVersion string) v1alpha1.ComponentDefinition {
+		return v1alpha1.ComponentDefinition{
+			TypeMeta: v1alpha1.TypeMeta{Kind: "Ingress", APIVersion: apiVersion},
+			Model:    model,
+			Schema:   `{"type":"object"}`,
+			Metadata: map[string]interface{}{},
+		}
+	}
+	bundle := RegistryBundle{
+		Models:     []v1alpha1.Model{model},
+		Components: []v1alpha1.ComponentDefinition{component("networking.k8s.io/v1"), component("extensions/v1beta1")},
+		Relationships: []v1alpha1.RelationshipDefinition{{
+			TypeMeta: v1alpha1.TypeMeta{Kind: "Edge"},
+			Model:    model,
+			SubType:  "Network",
+			HostName: "localhost",
+			Metadata: map[string]interface{}{RelationshipOrgKey: "org"},
+		}},
+	}
+
+	var buf bytes.Buffer
+	if err := ExportRegistryBundle(&buf, bundle); err != nil {
+		t.Fatal(err)
+	}
+	read, err := ReadRegistryBundle(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(read.Models) != 1 || len(read.Components) != 2 || len(read.Relationships) != 1 {
+		t.Fatalf("expected 1 model, 2 components and 1 relationship, got %d, %d and %d", len(read.Models), len(read.Components), len(read.Relationships))
+	}
+	if read.Models[0].HostName != "" || read.Components[0].Model.HostName != "" || read.Components[0].HostName != "" || read.Relationships[0].HostName != "" {
+		t.Error("expected the registrant details to be stripped")
+	}
+	if read.Components[1].APIVersion != "extensions/v1beta1" {
+		t.Errorf("expected components of the same kind to be kept apart, got %s", read.Components[1].APIVersion)
+	}
+	if _, ok := read.Relationships[0].Metadata[RelationshipOrgKey]; ok {
+		t.Error("expected the organization of the relationship to be stripped")
+	}
+	if _, ok := bundle.Relationships[0].Metadata[RelationshipOrgKey]; !ok {
+		t.Error("expected the exported relationship to be left untouched")
+	}
+}
+
+func TestEqualMetadata(t *testing.T) {
+	if !equalMetadata(nil, map[string]interface{}{}) {
+		t.Error("expected empty metadata to be equal")
+	}
+	if !equalMetadata(map[string]interface{}{"n": 1}, map[string]interface{}{"n": float64(1)}) {
+		t.Error("expected numbers to be compared as JSON")
+	}
+	if equalMetadata(map[string]interface{}{"n": 1}, map[string]interface{}{"n": 2}) {
+		t.Error("expected different metadata not to be equal")
+	}
+}
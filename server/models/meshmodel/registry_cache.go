@@ -0,0 +1,189 @@
+package meshmodel
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+	"github.com/layer5io/meshkit/models/meshmodel/core/types"
+	meshmodel "github.com/layer5io/meshkit/models/meshmodel/registry"
+)
+
+// EntitiesGetter looks up the entities of the registry, eg: the registry manager
+type EntitiesGetter interface {
+	GetEntities(f types.Filter) ([]meshmodel.Entity, *int64, *int)
+}
+
+// OrgScopedRegistry looks up the relationships of an OrgRelationshipFilter in the database, so that the filter on the
+// organization is applied before paginating. The lookups of the other filters are delegated to the registry.
+type OrgScopedRegistry struct {
+	EntitiesGetter
+	DB  *database.Handler
+	Log logger.Handler
+}
+
+// GetEntities returns the entities matching the filter along with the total number of matching relationships
+func (osr OrgScopedRegistry) GetEntities(f types.Filter) ([]meshmodel.Entity, *int64, *int) {
+	filter, ok := f.(*OrgRelationshipFilter)
+	if !ok {
+		return osr.EntitiesGetter.GetEntities(f)
+	}
+	rels, count, err := GetOrgRelationships(osr.DB, *filter)
+	if err != nil {
+		osr.Log.Error(err)
+	}
+	en := make([]meshmodel.Entity, 0, len(rels))
+	for _, rel := range rels {
+		en = append(en, rel)
+	}
+	return en, &count, nil
+}
+
+// RegistryCache caches the entities of the registry by filter, since the UI requests the lists of components and
+// relationships repeatedly with identical filters. The cache is invalidated as a whole when the registry changes,
+// and its entries expire after a TTL so that the changes made without invalidating it are eventually seen.
+type RegistryCache struct {
+	registry   EntitiesGetter
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	mx      sync.Mutex
+	entries map[string]*registryCacheEntry
+	// generation is incremented by Invalidate, so that the lookups started before are not cached
+	generation uint64
+	// distinguishes the generations of the caches of successive runs of Meshery Server
+	started int64
+	hits    uint64
+	misses  uint64
+}
+
+type registryCacheEntry struct {
+	entities []meshmodel.Entity
+	count    int64
+	unique   int
+	expires  time.Time
+}
+
+// RegistryCacheStats are the counters of a RegistryCache
+type RegistryCacheStats struct {
+	Entries int    `json:"entries"`
+	Hits    uint64 `json:"hits"`
+	Misses  uint64 `json:"misses"`
+}
+
+// NewRegistryCache returns a cache of the entities of the registry keeping at most maxEntries lookups for ttl.
+// A ttl which is not positive disables the cache.
+func NewRegistryCache(registry EntitiesGetter, ttl time.Duration, maxEntries int) *RegistryCache {
+	return &RegistryCache{
+		registry:   registry,
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		entries:    make(map[string]*registryCacheEntry),
+		started:    time.Now().UnixNano(),
+	}
+}
+
+// GetEntities returns the entities matching the filter, from the cache if the same filter was looked up recently.
+// The returned entities are shared by the callers and must not be modified.
+func (c *RegistryCache) GetEntities(f types.Filter) ([]meshmodel.Entity, *int64, *int) {
+	if c.ttl <= 0 {
+		return c.registry.GetEntities(f)
+	}
+	key, err := filterKey(f)
+	if err != nil {
+		return c.registry.GetEntities(f)
+	}
+
+	c.mx.Lock()
+	now := c.now()
+	if e, ok := c.entries[key]; ok && now.Before(e.expires) {
+		c.hits++
+		c.mx.Unlock()
+		count, unique := e.count, e.unique
+		return append([]meshmodel.Entity(nil), e.entities...), &count, &unique
+	}
+	c.misses++
+	generation := c.generation
+	c.mx.Unlock()
+
+	entities, count, unique := c.registry.GetEntities(f)
+	e := &registryCacheEntry{entities: entities, expires: now.Add(c.ttl)}
+	if count != nil {
+		e.count = *count
+	}
+	if unique != nil {
+		e.unique = *unique
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	// the registry changed during the lookup, its result may already be stale
+	if generation != c.generation {
+		return entities, count, unique
+	}
+	if len(c.entries) >= c.maxEntries {
+		c.evict(now)
+	}
+	c.entries[key] = e
+	return append([]meshmodel.Entity(nil), entities...), count, unique
+}
+
+// Invalidate drops the cached entities, it is called whenever entities are registered, updated or deleted
+func (c *RegistryCache) Invalidate() {
+	if c == nil {
+		return
+	}
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.generation++
+	c.entries = make(map[string]*registryCacheEntry)
+}
+
+// Version identifies the state of the registry, it changes whenever the cache is invalidated and with every run of
+// Meshery Server, even when the cache is disabled
+func (c *RegistryCache) Version() string {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	return fmt.Sprintf("%d.%d", c.started, c.generation)
+}
+
+// Stats returns the counters of the cache
+func (c *RegistryCache) Stats() RegistryCacheStats {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	return RegistryCacheStats{Entries: len(c.entries), Hits: c.hits, Misses: c.misses}
+}
+
+// evict drops the expired entries, or the entry expiring first if none is expired
+func (c *RegistryCache) evict(now time.Time) {
+	var oldest string
+	for key, e := range c.entries {
+		if !now.Before(e.expires) {
+			delete(c.entries, key)
+			continue
+		}
+		if oldest == "" || e.expires.Before(c.entries[oldest].expires) {
+			oldest = key
+		}
+	}
+	if len(c.entries) >= c.maxEntries && oldest != "" {
+		delete(c.entries, oldest)
+	}
+}
+
+// filterKey is the digest of the type and the fields of the filter
+func filterKey(f types.Filter) (string, error) {
+	b, err := json.Marshal(f)
+	if err != nil {
+		return "", err
+	}
+	digest := sha256.Sum256(append([]byte(fmt.Sprintf("%T", f)), b...))
+	return hex.EncodeToString(digest[:]), nil
+}
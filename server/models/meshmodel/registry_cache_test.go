@@ -0,0 +1,87 @@
+package meshmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/layer5io/meshkit/models/meshmodel/core/types"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	meshmodel "github.com/layer5io/meshkit/models/meshmodel/registry"
+)
+
+type countingRegistry struct {
+	lookups  int
+	entities []meshmodel.Entity
+}
+
+func (r *countingRegistry) GetEntities(types.Filter) ([]meshmodel.Entity, *int64, *int) {
+	r.lookups++
+	count, unique := int64(len(r.entities)), len(r.entities)
+	return r.entities, &count, &unique
+}
+
+func TestRegistryCache(t *testing.T) {
+	now := time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC)
+	registry := &countingRegistry{entities: []meshmodel.Entity{v1alpha1.ComponentDefinition{TypeMeta: v1alpha1.TypeMeta{Kind: "Pod"}}}}
+	cache := NewRegistryCache(registry, time.Minute, 2)
+	cache.now = func() time.Time { return now }
+
+	pods := &v1alpha1.ComponentFilter{Name: "Pod", ModelName: "kubernetes"}
+	for i := 0; i < 3; i++ {
+		entities, count, _ := cache.GetEntities(&v1alpha1.ComponentFilter{Name: "Pod", ModelName: "kubernetes"})
+		if len(entities) != 1 || *count != 1 {
+			t.Fatalf("GetEntities() = %d entities, count %d, want 1", len(entities), *count)
+		}
+	}
+	if registry.lookups != 1 {
+		t.Errorf("identical filters looked the registry up %d times, want 1", registry.lookups)
+	}
+
+	// filters of different types or fields are cached apart
+	cache.GetEntities(&v1alpha1.RelationshipFilter{ModelName: "kubernetes"})
+	cache.GetEntities(&v1alpha1.ComponentFilter{Name: "Deployment", ModelName: "kubernetes"})
+	if registry.lookups != 3 {
+		t.Errorf("distinct filters looked the registry up %d times, want 3", registry.lookups)
+	}
+	if stats := cache.Stats(); stats.Entries != 2 || stats.Hits != 2 || stats.Misses != 3 {
+		t.Errorf("Stats() = %+v, want 2 entries, 2 hits and 3 misses", stats)
+	}
+
+	version := cache.Version()
+	cache.Invalidate()
+	if cache.Version() == version {
+		t.Errorf("Version() = %q after Invalidate, want another version", version)
+	}
+	cache.GetEntities(pods)
+	if registry.lookups != 4 {
+		t.Errorf("lookup after Invalidate was served from the cache")
+	}
+
+	now = now.Add(2 * time.Minute)
+	cache.GetEntities(pods)
+	if registry.lookups != 5 {
+		t.Errorf("expired lookup was served from the cache")
+	}
+
+	disabled := NewRegistryCache(registry, 0, 2)
+	disabled.GetEntities(pods)
+	disabled.GetEntities(pods)
+	if registry.lookups != 7 {
+		t.Errorf("disabled cache served a lookup")
+	}
+}
+
+func TestRegistryEventsInvalidateCache(t *testing.T) {
+	registry := &countingRegistry{}
+	cache := NewRegistryCache(registry, time.Minute, 10)
+	events := NewRegistryEventsChannel()
+	events.OnPublish(func(RegistryEvent) { cache.Invalidate() })
+
+	filter := &v1alpha1.RelationshipFilter{Kind: "Edge"}
+	cache.GetEntities(filter)
+	events.Publish(RegistryEvent{Action: RegistryEventUpdated, EntityType: RegistryEntityRelationship, Kind: "Edge"})
+	cache.GetEntities(filter)
+	if registry.lookups != 2 {
+		t.Errorf("registry looked up %d times, want 2 as the event invalidated the cache", registry.lookups)
+	}
+}
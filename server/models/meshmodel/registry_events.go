@@ -0,0 +1,100 @@
+package meshmodel
+
+import (
+	"sync"
+	"time"
+)
+
+// Actions performed on the entities of the registry
+const (
+	RegistryEventRegistered = "registered"
+	RegistryEventUpdated    = "updated"
+	RegistryEventDeleted    = "deleted"
+)
+
+// Types of the entities of the registry
+const (
+	RegistryEntityComponent    = "component"
+	RegistryEntityModel        = "model"
+	RegistryEntityRelationship = "relationship"
+)
+
+// RegistryEvent notifies that an entity of the registry was registered, updated or deleted
+type RegistryEvent struct {
+	Action     string `json:"action"`
+	EntityType string `json:"entityType"`
+	// Kind of the component or relationship, empty for models
+	Kind         string    `json:"kind,omitempty"`
+	SubType      string    `json:"subType,omitempty"`
+	Model        string    `json:"model"`
+	ModelVersion string    `json:"modelVersion,omitempty"`
+	Timestamp    time.Time `json:"timestamp"`
+	// Org is the organization of the relationship, events of the relationships of an organization are only delivered to its members
+	Org string `json:"-"`
+}
+
+// VisibleTo reports whether the event can be delivered to the members of the organization
+func (e RegistryEvent) VisibleTo(orgID string) bool {
+	return RelationshipVisibleTo(e.Org, orgID)
+}
+
+// RegistryEventsChannel fans out the changes made to the registry to every subscriber
+type RegistryEventsChannel struct {
+	subscribers map[chan RegistryEvent]struct{}
+	hooks       []func(RegistryEvent)
+	mx          sync.Mutex
+}
+
+func NewRegistryEventsChannel() *RegistryEventsChannel {
+	return &RegistryEventsChannel{
+		subscribers: make(map[chan RegistryEvent]struct{}),
+	}
+}
+
+// Subscribe returns a channel receiving the events published from now on and a function to stop receiving them
+func (c *RegistryEventsChannel) Subscribe() (<-chan RegistryEvent, func()) {
+	ch := make(chan RegistryEvent, 10)
+	c.mx.Lock()
+	c.subscribers[ch] = struct{}{}
+	c.mx.Unlock()
+
+	unsubscribe := func() {
+		c.mx.Lock()
+		defer c.mx.Unlock()
+		if _, ok := c.subscribers[ch]; ok {
+			delete(c.subscribers, ch)
+			close(ch)
+		}
+	}
+	return ch, unsubscribe
+}
+
+// OnPublish registers a function invoked with every event before it is sent to the subscribers, eg: to invalidate the
+// caches of the registry before the subscribers refetch the entities. Unlike subscribers, hooks never miss an event.
+func (c *RegistryEventsChannel) OnPublish(hook func(RegistryEvent)) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.hooks = append(c.hooks, hook)
+}
+
+// Publish sends the event to every subscriber.
+// Subscribers which are not keeping up miss the event rather than blocking the registration.
+func (c *RegistryEventsChannel) Publish(event RegistryEvent) {
+	if c == nil {
+		return
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	for _, hook := range c.hooks {
+		hook(event)
+	}
+	for ch := range c.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
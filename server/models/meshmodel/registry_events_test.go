@@ -0,0 +1,36 @@
+package meshmodel
+
+import (
+	"testing"
+)
+
+func TestRegistryEventsChannel(t *testing.T) {
+	c := NewRegistryEventsChannel()
+	first, unsubscribeFirst := c.Subscribe()
+	second, unsubscribeSecond := c.Subscribe()
+	defer unsubscribeSecond()
+
+	event := RegistryEvent{Action: RegistryEventRegistered, EntityType: RegistryEntityRelationship, Kind: "Edge", Model: "kubernetes"}
+	c.Publish(event)
+	for _, ch := range []<-chan RegistryEvent{first, second} {
+		got := <-ch
+		if got.Kind != event.Kind || got.Action != event.Action || got.Timestamp.IsZero() {
+			t.Errorf("received %+v, want %+v with a timestamp", got, event)
+		}
+	}
+
+	unsubscribeFirst()
+	if _, ok := <-first; ok {
+		t.Errorf("channel of an unsubscribed subscriber is not closed")
+	}
+	// unsubscribing twice must not panic
+	unsubscribeFirst()
+
+	// slow subscribers miss events instead of blocking the publisher
+	for i := 0; i < cap(second)+5; i++ {
+		c.Publish(event)
+	}
+	if len(second) != cap(second) {
+		t.Errorf("subscriber received %d events, want %d", len(second), cap(second))
+	}
+}
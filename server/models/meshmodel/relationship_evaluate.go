@@ -0,0 +1,40 @@
+package meshmodel
+
+import (
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+// RelationshipMatch is a pair of components of a design matched by the selectors of a relationship
+type RelationshipMatch struct {
+	From ComponentRef `json:"from"`
+	To   ComponentRef `json:"to"`
+}
+
+// MatchRelationship returns every pair of distinct components in which the first component matches a "from" selector and
+// the second one matches a "to" selector of the "allow" selectors of the relationship.
+// Pairs matched by the "deny" selectors are excluded.
+func MatchRelationship(rel v1alpha1.RelationshipDefinition, comps []ComponentRef) []RelationshipMatch {
+	allow, _ := rel.Selectors["allow"].(map[string]interface{})
+	deny, _ := rel.Selectors["deny"].(map[string]interface{})
+	allowFrom := parseSelectors(allow["from"], rel.Model.Name)
+	allowTo := parseSelectors(allow["to"], rel.Model.Name)
+	denyFrom := parseSelectors(deny["from"], rel.Model.Name)
+	denyTo := parseSelectors(deny["to"], rel.Model.Name)
+
+	matches := make([]RelationshipMatch, 0)
+	for i, src := range comps {
+		if !matchesAnySelector(allowFrom, src) {
+			continue
+		}
+		for j, dst := range comps {
+			if i == j || !matchesAnySelector(allowTo, dst) {
+				continue
+			}
+			if matchesAnySelector(denyFrom, src) && matchesAnySelector(denyTo, dst) {
+				continue
+			}
+			matches = append(matches, RelationshipMatch{From: src, To: dst})
+		}
+	}
+	return matches
+}
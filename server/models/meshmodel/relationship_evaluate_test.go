@@ -0,0 +1,61 @@
+package meshmodel
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+func TestMatchRelationship(t *testing.T) {
+	rel := v1alpha1.RelationshipDefinition{
+		TypeMeta: v1alpha1.TypeMeta{Kind: "Edge"},
+		Model:    v1alpha1.Model{Name: "kubernetes"},
+		SubType:  "Network",
+		Selectors: map[string]interface{}{
+			"allow": map[string]interface{}{
+				"from": []interface{}{map[string]interface{}{"kind": "Service"}},
+				"to":   []interface{}{map[string]interface{}{"model": "kubernetes"}},
+			},
+			"deny": map[string]interface{}{
+				"from": []interface{}{map[string]interface{}{"kind": "Service"}},
+				"to":   []interface{}{map[string]interface{}{"kind": "Secret"}},
+			},
+		},
+	}
+	svc := ComponentRef{Name: "svc", Kind: "Service", Model: "kubernetes"}
+	deploy := ComponentRef{Name: "deploy", Kind: "Deployment", Model: "kubernetes"}
+	secret := ComponentRef{Name: "secret", Kind: "Secret", Model: "kubernetes"}
+	envoy := ComponentRef{Name: "filter", Kind: "EnvoyFilter", Model: "istio-base"}
+
+	tests := []struct {
+		name  string
+		comps []ComponentRef
+		want  []RelationshipMatch
+	}{
+		{
+			name:  "No pairs are matched when the design has no components",
+			comps: nil,
+			want:  []RelationshipMatch{},
+		},
+		{
+			name:  "A component is not matched with itself",
+			comps: []ComponentRef{svc},
+			want:  []RelationshipMatch{},
+		},
+		{
+			name:  "Pairs matched by deny selectors and components of other models are excluded",
+			comps: []ComponentRef{svc, deploy, secret, envoy},
+			want: []RelationshipMatch{
+				{From: svc, To: deploy},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchRelationship(rel, tt.comps); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MatchRelationship() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
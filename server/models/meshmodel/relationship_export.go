@@ -0,0 +1,65 @@
+package meshmodel
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+// ExportRelationships writes the given relationship definitions of the model as a gzip compressed tarball to w.
+// The definitions are laid out as <model>/relationships/<kind>-<subType>.json, mirroring the static relationships directory,
+// so that the archive can be extracted into the models directory of another Meshery deployment.
+// Registrant details are stripped as they are specific to the deployment the definitions are exported from.
+func ExportRelationships(w io.Writer, model string, rels []v1alpha1.RelationshipDefinition) error {
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+
+	now := time.Now()
+	names := make(map[string]int)
+	for _, rel := range rels {
+		rel.HostID = uuid.Nil
+		rel.HostName = ""
+		rel.DisplayHostName = ""
+		byt, err := json.MarshalIndent(rel, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		name := strings.ToLower(rel.Kind)
+		if rel.SubType != "" {
+			name = fmt.Sprintf("%s-%s", name, strings.ToLower(rel.SubType))
+		}
+		if n := names[name]; n > 0 {
+			names[name]++
+			name = fmt.Sprintf("%s-%d", name, n)
+		} else {
+			names[name] = 1
+		}
+
+		err = tw.WriteHeader(&tar.Header{
+			Name:    path.Join(model, "relationships", name+".json"),
+			Mode:    0644,
+			Size:    int64(len(byt)),
+			ModTime: now,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := tw.Write(byt); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gzw.Close()
+}
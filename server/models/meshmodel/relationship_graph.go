@@ -0,0 +1,104 @@
+package meshmodel
+
+import (
+	"sort"
+
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+// AnyComponentKind identifies the node standing for every component of a model, used when a selector does not specify a kind
+const AnyComponentKind = "*"
+
+// RelationshipGraphNode is a component kind taking part in at least one relationship
+type RelationshipGraphNode struct {
+	ID    string `json:"id"`
+	Kind  string `json:"kind"`
+	Model string `json:"model"`
+}
+
+// RelationshipGraphEdge connects two component kinds through a registered relationship
+type RelationshipGraphEdge struct {
+	To      string `json:"to"`
+	Kind    string `json:"kind"`
+	SubType string `json:"subType"`
+}
+
+// RelationshipGraph is the adjacency list of component kinds connected by the relationships allowed between them.
+// Adjacency is keyed by the ID of the source node.
+type RelationshipGraph struct {
+	Nodes     []RelationshipGraphNode            `json:"nodes"`
+	Adjacency map[string][]RelationshipGraphEdge `json:"adjacency"`
+}
+
+type relationshipSelector struct {
+	kind  string
+	model string
+}
+
+// BuildRelationshipGraph builds the graph of component kinds connected by the "allow" selectors of the given relationships.
+// Every component matching a "from" selector is connected to every component matching a "to" selector of the same relationship.
+func BuildRelationshipGraph(rels []v1alpha1.RelationshipDefinition) RelationshipGraph {
+	graph := RelationshipGraph{
+		Nodes:     make([]RelationshipGraphNode, 0),
+		Adjacency: make(map[string][]RelationshipGraphEdge),
+	}
+	nodes := make(map[string]RelationshipGraphNode)
+	seen := make(map[string]map[RelationshipGraphEdge]bool)
+
+	addNode := func(sel relationshipSelector) string {
+		id := sel.model + "/" + sel.kind
+		if _, ok := nodes[id]; !ok {
+			nodes[id] = RelationshipGraphNode{ID: id, Kind: sel.kind, Model: sel.model}
+		}
+		return id
+	}
+
+	for _, rel := range rels {
+		allow, _ := rel.Selectors["allow"].(map[string]interface{})
+		from := parseSelectors(allow["from"], rel.Model.Name)
+		to := parseSelectors(allow["to"], rel.Model.Name)
+		for _, f := range from {
+			src := addNode(f)
+			for _, t := range to {
+				edge := RelationshipGraphEdge{To: addNode(t), Kind: rel.Kind, SubType: rel.SubType}
+				if seen[src] == nil {
+					seen[src] = make(map[RelationshipGraphEdge]bool)
+				}
+				if seen[src][edge] {
+					continue
+				}
+				seen[src][edge] = true
+				graph.Adjacency[src] = append(graph.Adjacency[src], edge)
+			}
+		}
+	}
+
+	for _, node := range nodes {
+		graph.Nodes = append(graph.Nodes, node)
+	}
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		return graph.Nodes[i].ID < graph.Nodes[j].ID
+	})
+	return graph
+}
+
+// parses the list of selectors, selectors not specifying a model default to the model of the relationship
+func parseSelectors(in interface{}, defaultModel string) []relationshipSelector {
+	items, _ := in.([]interface{})
+	sels := make([]relationshipSelector, 0, len(items))
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		sel := relationshipSelector{kind: AnyComponentKind, model: defaultModel}
+		if kind, _ := m["kind"].(string); kind != "" {
+			sel.kind = kind
+		}
+		if model, _ := m["model"].(string); model != "" {
+			sel.model = model
+		}
+		sels = append(sels, sel)
+	}
+	return sels
+}
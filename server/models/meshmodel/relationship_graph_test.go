@@ -0,0 +1,122 @@
+package meshmodel
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+func selectors(from, to []interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"allow": map[string]interface{}{
+			"from": from,
+			"to":   to,
+		},
+	}
+}
+
+func TestBuildRelationshipGraph(t *testing.T) {
+	k8s := v1alpha1.Model{Name: "kubernetes"}
+	tests := []struct {
+		name      string
+		rels      []v1alpha1.RelationshipDefinition
+		nodes     []string
+		adjacency map[string][]RelationshipGraphEdge
+	}{
+		{
+			name:      "No relationships result in an empty graph",
+			rels:      nil,
+			nodes:     []string{},
+			adjacency: map[string][]RelationshipGraphEdge{},
+		},
+		{
+			name: "Every from selector is connected to every to selector",
+			rels: []v1alpha1.RelationshipDefinition{
+				{
+					TypeMeta: v1alpha1.TypeMeta{Kind: "Edge"},
+					Model:    k8s,
+					SubType:  "Network",
+					Selectors: selectors(
+						[]interface{}{map[string]interface{}{"kind": "Service", "model": "kubernetes"}},
+						[]interface{}{
+							map[string]interface{}{"kind": "Deployment", "model": "kubernetes"},
+							map[string]interface{}{"kind": "Pod", "model": "kubernetes"},
+						},
+					),
+				},
+			},
+			nodes: []string{"kubernetes/Deployment", "kubernetes/Pod", "kubernetes/Service"},
+			adjacency: map[string][]RelationshipGraphEdge{
+				"kubernetes/Service": {
+					{To: "kubernetes/Deployment", Kind: "Edge", SubType: "Network"},
+					{To: "kubernetes/Pod", Kind: "Edge", SubType: "Network"},
+				},
+			},
+		},
+		{
+			name: "Selectors without kind or model match any component of the relationship's model",
+			rels: []v1alpha1.RelationshipDefinition{
+				{
+					TypeMeta: v1alpha1.TypeMeta{Kind: "Hierarchical"},
+					Model:    k8s,
+					SubType:  "Parent",
+					Selectors: selectors(
+						[]interface{}{map[string]interface{}{"kind": "Namespace"}},
+						[]interface{}{map[string]interface{}{"model": "kubernetes"}},
+					),
+				},
+			},
+			nodes: []string{"kubernetes/*", "kubernetes/Namespace"},
+			adjacency: map[string][]RelationshipGraphEdge{
+				"kubernetes/Namespace": {
+					{To: "kubernetes/*", Kind: "Hierarchical", SubType: "Parent"},
+				},
+			},
+		},
+		{
+			name: "Duplicate edges are collapsed and deny selectors are ignored",
+			rels: []v1alpha1.RelationshipDefinition{
+				{
+					TypeMeta: v1alpha1.TypeMeta{Kind: "Edge"},
+					Model:    k8s,
+					SubType:  "Mount",
+					Selectors: map[string]interface{}{
+						"allow": map[string]interface{}{
+							"from": []interface{}{map[string]interface{}{"kind": "Pod"}},
+							"to": []interface{}{
+								map[string]interface{}{"kind": "PersistentVolume"},
+								map[string]interface{}{"kind": "PersistentVolume"},
+							},
+						},
+						"deny": map[string]interface{}{
+							"from": []interface{}{map[string]interface{}{"kind": "Pod"}},
+							"to":   []interface{}{map[string]interface{}{"kind": "Secret"}},
+						},
+					},
+				},
+			},
+			nodes: []string{"kubernetes/PersistentVolume", "kubernetes/Pod"},
+			adjacency: map[string][]RelationshipGraphEdge{
+				"kubernetes/Pod": {
+					{To: "kubernetes/PersistentVolume", Kind: "Edge", SubType: "Mount"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph := BuildRelationshipGraph(tt.rels)
+			nodes := make([]string, 0, len(graph.Nodes))
+			for _, node := range graph.Nodes {
+				nodes = append(nodes, node.ID)
+			}
+			if !reflect.DeepEqual(nodes, tt.nodes) {
+				t.Errorf("BuildRelationshipGraph() nodes = %v, want %v", nodes, tt.nodes)
+			}
+			if !reflect.DeepEqual(graph.Adjacency, tt.adjacency) {
+				t.Errorf("BuildRelationshipGraph() adjacency = %v, want %v", graph.Adjacency, tt.adjacency)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package meshmodel
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	"gorm.io/gorm"
+)
+
+// RelationshipRevision is a prior version of a relationship definition.
+// A revision is recorded every time a relationship is updated, so that evaluation can be pinned to a specific version of the definition.
+type RelationshipRevision struct {
+	ID             uuid.UUID `json:"id" gorm:"primaryKey"`
+	RelationshipID uuid.UUID `json:"relationshipId" gorm:"index"`
+	Model          string    `json:"model" gorm:"index"`
+	ModelVersion   string    `json:"modelVersion"`
+	Kind           string    `json:"kind"`
+	SubType        string    `json:"subType"`
+	// organization the relationship is scoped to, empty for the global relationships
+	Org string `json:"org,omitempty" gorm:"index"`
+	// Version of the relationship definition which was replaced
+	Version      int                             `json:"version"`
+	Definition   []byte                          `json:"-"`
+	Relationship v1alpha1.RelationshipDefinition `json:"relationship" gorm:"-"`
+	CreatedAt    time.Time                       `json:"createdAt"`
+}
+
+// RelationshipHistoryFilter narrows down the revisions of the relationships of a model
+type RelationshipHistoryFilter struct {
+	Model        string
+	Kind         string
+	ModelVersion string
+	SubType      string
+	// returns only the revisions at the given version of the relationship definition when non zero
+	Version int
+	// organization of the caller, the revisions of the relationships of the other organizations are not returned
+	Org    string
+	Limit  int // If 0 then all records are returned
+	Offset int
+}
+
+// records the relationship definition as it is before being replaced
+func recordRelationshipRevision(tx *gorm.DB, rel v1alpha1.RelationshipDefinition) error {
+	byt, err := json.Marshal(rel)
+	if err != nil {
+		return err
+	}
+	return tx.Create(&RelationshipRevision{
+		ID:             uuid.New(),
+		RelationshipID: rel.ID,
+		Model:          rel.Model.Name,
+		ModelVersion:   rel.Model.Version,
+		Kind:           rel.Kind,
+		SubType:        rel.SubType,
+		Org:            RelationshipOrg(rel.Metadata),
+		Version:        RelationshipVersion(rel),
+		Definition:     byt,
+		CreatedAt:      time.Now(),
+	}).Error
+}
+
+// GetRelationshipHistory returns the prior revisions of the relationships matching the filter, most recent first.
+// Only the revisions of the relationships visible to the organization of the filter, the global ones and those of the
+// organization, are returned. Returns the total number of matching revisions along with the requested page.
+func GetRelationshipHistory(db *database.Handler, filter RelationshipHistoryFilter) ([]RelationshipRevision, int64, error) {
+	finder := db.Model(&RelationshipRevision{}).Where("model = ? AND kind = ?", filter.Model, filter.Kind)
+	if filter.ModelVersion != "" {
+		finder = finder.Where("model_version = ?", filter.ModelVersion)
+	}
+	if filter.SubType != "" {
+		finder = finder.Where("sub_type = ?", filter.SubType)
+	}
+	if filter.Version != 0 {
+		finder = finder.Where("version = ?", filter.Version)
+	}
+	finder = finder.Where("org IN ?", []string{"", filter.Org})
+
+	var count int64
+	if err := finder.Session(&gorm.Session{}).Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+	finder = finder.Order("created_at desc").Offset(filter.Offset)
+	if filter.Limit != 0 {
+		finder = finder.Limit(filter.Limit)
+	}
+	var revisions []RelationshipRevision
+	if err := finder.Find(&revisions).Error; err != nil {
+		return nil, 0, err
+	}
+	for i := range revisions {
+		if err := json.Unmarshal(revisions[i].Definition, &revisions[i].Relationship); err != nil {
+			return nil, 0, err
+		}
+	}
+	return revisions, count, nil
+}
+
+// ScopeRelationshipRevisions records the organization of the revisions recorded before revisions had one, taken from
+// their definition, so that the revisions of the relationships of an organization are not returned to the others.
+func ScopeRelationshipRevisions(db *database.Handler) error {
+	var revisions []RelationshipRevision
+	if err := db.Model(&RelationshipRevision{}).Where("org = ?", "").Find(&revisions).Error; err != nil {
+		return err
+	}
+	for _, revision := range revisions {
+		var rel v1alpha1.RelationshipDefinition
+		if err := json.Unmarshal(revision.Definition, &rel); err != nil {
+			return err
+		}
+		org := RelationshipOrg(rel.Metadata)
+		if org == "" {
+			continue
+		}
+		if err := db.Model(&RelationshipRevision{}).Where("id = ?", revision.ID).Update("org", org).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package meshmodel
+
+import (
+	"testing"
+
+	"github.com/layer5io/meshkit/models/meshmodel/registry"
+)
+
+func TestGetRelationshipHistory(t *testing.T) {
+	db, rm := registryDB(t)
+	registerRelationships(t, rm, registry.Host{Hostname: "kubernetes"}, testRelationship("Network", "org-a", nil), testRelationship("Network", "", nil))
+	// the revisions are returned most recent first
+	for _, orgID := range []string{"", "org-a", "org-a"} {
+		rel, err := FindRelationship(db, "kubernetes", "Edge", "", "", orgID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := UpdateRelationship(db, rel, []byte(`{"metadata": {"description": "updated"}}`), true); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		filter   RelationshipHistoryFilter
+		versions []int
+	}{
+		{"revisions of the organization and the global ones", RelationshipHistoryFilter{Model: "kubernetes", Kind: "Edge", Org: "org-a"}, []int{2, 1, 1}},
+		{"revisions of another organization", RelationshipHistoryFilter{Model: "kubernetes", Kind: "Edge", Org: "org-b"}, []int{1}},
+		{"revisions without organization", RelationshipHistoryFilter{Model: "kubernetes", Kind: "Edge"}, []int{1}},
+		{"revisions at a version", RelationshipHistoryFilter{Model: "kubernetes", Kind: "Edge", Version: 2, Org: "org-a"}, []int{2}},
+		{"revisions of another kind", RelationshipHistoryFilter{Model: "kubernetes", Kind: "Hierarchical", Org: "org-a"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revisions, count, err := GetRelationshipHistory(db, tt.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(revisions) != len(tt.versions) || count != int64(len(tt.versions)) {
+				t.Fatalf("%d revisions out of %d were returned, want %d", len(revisions), count, len(tt.versions))
+			}
+			for i, revision := range revisions {
+				if revision.Version != tt.versions[i] {
+					t.Errorf("revision %d is at version %d, want %d", i, revision.Version, tt.versions[i])
+				}
+				if orgID := RelationshipOrg(revision.Relationship.Metadata); !RelationshipVisibleTo(orgID, tt.filter.Org) {
+					t.Errorf("the revision of a relationship of %q was returned to %q", orgID, tt.filter.Org)
+				}
+			}
+		})
+	}
+
+	// the pages are taken from the revisions visible to the organization, the count is the one of all of them
+	revisions, count, err := GetRelationshipHistory(db, RelationshipHistoryFilter{Model: "kubernetes", Kind: "Edge", Org: "org-a", Limit: 2, Offset: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 3 || len(revisions) != 2 || revisions[0].Version != 1 || revisions[1].Version != 1 {
+		t.Errorf("the second page of the revisions = %d revisions out of %d, want the 2 revisions at version 1 out of 3", len(revisions), count)
+	}
+}
+
+func TestScopeRelationshipRevisions(t *testing.T) {
+	db, rm := registryDB(t)
+	registerRelationships(t, rm, registry.Host{Hostname: "kubernetes"}, testRelationship("Network", "org-a", nil))
+	rel, err := FindRelationship(db, "kubernetes", "Edge", "", "", "org-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := UpdateRelationship(db, rel, []byte(`{"metadata": {"description": "updated"}}`), true); err != nil {
+		t.Fatal(err)
+	}
+	// the revisions recorded before revisions had an organization
+	if err := db.Model(&RelationshipRevision{}).Where("1 = 1").Update("org", "").Error; err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ScopeRelationshipRevisions(db); err != nil {
+		t.Fatal(err)
+	}
+	revisions, _, err := GetRelationshipHistory(db, RelationshipHistoryFilter{Model: "kubernetes", Kind: "Edge", Org: "org-b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revisions) != 0 {
+		t.Errorf("the revisions of the relationship of org-a are returned to org-b: %+v", revisions)
+	}
+	revisions, _, err = GetRelationshipHistory(db, RelationshipHistoryFilter{Model: "kubernetes", Kind: "Edge", Org: "org-a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revisions) != 1 || revisions[0].Org != "org-a" {
+		t.Errorf("the revisions of the relationship of org-a are %+v, want the revision scoped to org-a", revisions)
+	}
+}
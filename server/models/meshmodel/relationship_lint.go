@@ -0,0 +1,218 @@
+package meshmodel
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+// Severities of the diagnostics reported by the relationship linter
+const (
+	RelationshipLintError   = "error"
+	RelationshipLintWarning = "warning"
+)
+
+// Rules checked by the relationship linter
+const (
+	// the definition does not conform to the relationship schema
+	RelationshipLintRuleSchema = "schema"
+	// a selector references a model with no registered components
+	RelationshipLintRuleUnknownModel = "unknown-model"
+	// a selector references a component kind not registered for its model
+	RelationshipLintRuleUnknownKind = "unknown-component-kind"
+	// a selector can never match because of a missing counterpart or because the deny selectors exclude it
+	RelationshipLintRuleUnreachableSelector = "unreachable-selector"
+	// the selectors have no deny list
+	RelationshipLintRuleMissingDeny = "missing-deny"
+)
+
+// selectors referencing this model match the components of every model
+const anyModel = "*"
+
+// RelationshipLintDiagnostic is a mistake found in a relationship definition
+type RelationshipLintDiagnostic struct {
+	// Index of the definition in the linted list
+	Index    int    `json:"index"`
+	Kind     string `json:"kind"`
+	Model    string `json:"model"`
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	// JSON pointer to the offending field of the definition
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// RegisteredComponents is the set of component kinds registered for every model, the linter resolves selectors against it
+type RegisteredComponents map[string]map[string]bool
+
+// Add records that a component of the kind is registered for the model
+func (rc RegisteredComponents) Add(model, kind string) {
+	if rc[model] == nil {
+		rc[model] = make(map[string]bool)
+	}
+	rc[model][kind] = true
+}
+
+// RelationshipSelectorModels returns the models referenced by the selectors of the relationships, in order to load their components before linting
+func RelationshipSelectorModels(rels []v1alpha1.RelationshipDefinition) []string {
+	seen := make(map[string]bool)
+	for _, rel := range rels {
+		for _, set := range []string{"allow", "deny"} {
+			sels, _ := rel.Selectors[set].(map[string]interface{})
+			for _, dir := range []string{"from", "to"} {
+				for _, sel := range parseSelectors(sels[dir], rel.Model.Name) {
+					if sel.model != anyModel && sel.model != "" {
+						seen[sel.model] = true
+					}
+				}
+			}
+		}
+	}
+	models := make([]string, 0, len(seen))
+	for model := range seen {
+		models = append(models, model)
+	}
+	sort.Strings(models)
+	return models
+}
+
+// LintRelationships checks the relationship definitions for mistakes which do not prevent their registration
+// but keep their selectors from ever matching the intended components.
+// Diagnostics are ordered by definition and then by the path of the offending field.
+func LintRelationships(rels []v1alpha1.RelationshipDefinition, registered RegisteredComponents) []RelationshipLintDiagnostic {
+	diagnostics := make([]RelationshipLintDiagnostic, 0)
+	for i, rel := range rels {
+		diagnostics = append(diagnostics, lintRelationship(i, rel, registered)...)
+	}
+	return diagnostics
+}
+
+func lintRelationship(index int, rel v1alpha1.RelationshipDefinition, registered RegisteredComponents) []RelationshipLintDiagnostic {
+	var diagnostics []RelationshipLintDiagnostic
+	report := func(rule, severity, path, msg string) {
+		diagnostics = append(diagnostics, RelationshipLintDiagnostic{
+			Index:    index,
+			Kind:     rel.Kind,
+			Model:    rel.Model.Name,
+			Rule:     rule,
+			Severity: severity,
+			Path:     path,
+			Message:  msg,
+		})
+	}
+
+	schemaErrs, err := validateRelationshipSchema(rel)
+	if err != nil {
+		report(RelationshipLintRuleSchema, RelationshipLintError, "/", err.Error())
+		return diagnostics
+	}
+	if len(schemaErrs) > 0 {
+		// selectors which are not well formed cannot be resolved
+		for _, se := range schemaErrs {
+			report(RelationshipLintRuleSchema, RelationshipLintError, se.Path, se.Message)
+		}
+		return diagnostics
+	}
+
+	allow, _ := rel.Selectors["allow"].(map[string]interface{})
+	deny, hasDeny := rel.Selectors["deny"].(map[string]interface{})
+	selectors := map[string]map[string][]relationshipSelector{
+		"allow": {
+			"from": parseSelectors(allow["from"], rel.Model.Name),
+			"to":   parseSelectors(allow["to"], rel.Model.Name),
+		},
+		"deny": {
+			"from": parseSelectors(deny["from"], rel.Model.Name),
+			"to":   parseSelectors(deny["to"], rel.Model.Name),
+		},
+	}
+
+	for _, set := range []string{"allow", "deny"} {
+		for _, dir := range []string{"from", "to"} {
+			for i, sel := range selectors[set][dir] {
+				path := fmt.Sprintf("/selectors/%s/%s/%d", set, dir, i)
+				if sel.model == anyModel {
+					continue
+				}
+				kinds, ok := registered[sel.model]
+				if !ok {
+					report(RelationshipLintRuleUnknownModel, RelationshipLintError, path+"/model",
+						fmt.Sprintf("no components are registered for model %s", sel.model))
+					continue
+				}
+				if sel.kind != AnyComponentKind && !kinds[sel.kind] {
+					report(RelationshipLintRuleUnknownKind, RelationshipLintError, path+"/kind",
+						fmt.Sprintf("component %s is not registered for model %s", sel.kind, sel.model))
+				}
+			}
+		}
+	}
+
+	from, to := selectors["allow"]["from"], selectors["allow"]["to"]
+	denyFrom, denyTo := selectors["deny"]["from"], selectors["deny"]["to"]
+	switch {
+	case len(from) == 0 && len(to) == 0:
+		report(RelationshipLintRuleUnreachableSelector, RelationshipLintError, "/selectors/allow",
+			"the relationship has no allow selectors and never matches any component")
+	case len(from) == 0:
+		report(RelationshipLintRuleUnreachableSelector, RelationshipLintError, "/selectors/allow/from",
+			"the relationship has no allow from selectors, its to selectors are never matched")
+	case len(to) == 0:
+		report(RelationshipLintRuleUnreachableSelector, RelationshipLintError, "/selectors/allow/to",
+			"the relationship has no allow to selectors, its from selectors are never matched")
+	default:
+		// a pair of components is denied when the deny selectors match both its source and its target
+		for i, f := range from {
+			if coveredBy(f, denyFrom) && allCoveredBy(to, denyTo) {
+				report(RelationshipLintRuleUnreachableSelector, RelationshipLintWarning, fmt.Sprintf("/selectors/allow/from/%d", i),
+					fmt.Sprintf("every component %s is allowed to relate to is denied", describeSelector(f)))
+			}
+		}
+		for i, t := range to {
+			if coveredBy(t, denyTo) && allCoveredBy(from, denyFrom) {
+				report(RelationshipLintRuleUnreachableSelector, RelationshipLintWarning, fmt.Sprintf("/selectors/allow/to/%d", i),
+					fmt.Sprintf("every component allowed to relate to %s is denied", describeSelector(t)))
+			}
+		}
+	}
+
+	if !hasDeny {
+		report(RelationshipLintRuleMissingDeny, RelationshipLintWarning, "/selectors",
+			"the selectors have no deny list, add an empty one if no pair of components needs to be excluded")
+	}
+
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		return diagnostics[i].Path < diagnostics[j].Path
+	})
+	return diagnostics
+}
+
+// reports whether every component matched by sel is also matched by one of the deny selectors
+func coveredBy(sel relationshipSelector, deny []relationshipSelector) bool {
+	for _, d := range deny {
+		if d.model != anyModel && d.model != sel.model {
+			continue
+		}
+		if d.kind == AnyComponentKind || d.kind == sel.kind {
+			return true
+		}
+	}
+	return false
+}
+
+func allCoveredBy(sels, deny []relationshipSelector) bool {
+	for _, sel := range sels {
+		if !coveredBy(sel, deny) {
+			return false
+		}
+	}
+	return true
+}
+
+func describeSelector(sel relationshipSelector) string {
+	if sel.kind == AnyComponentKind {
+		return "any component of model " + sel.model
+	}
+	return sel.model + "/" + sel.kind
+}
@@ -0,0 +1,125 @@
+package meshmodel
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+func TestLintRelationships(t *testing.T) {
+	k8s := v1alpha1.Model{Name: "kubernetes"}
+	registered := RegisteredComponents{}
+	for _, kind := range []string{"Service", "Pod", "Deployment", "Ingress"} {
+		registered.Add("kubernetes", kind)
+	}
+	emptyDeny := map[string]interface{}{"from": []interface{}{}, "to": []interface{}{}}
+	sel := func(kind, model string) interface{} {
+		return map[string]interface{}{"kind": kind, "model": model}
+	}
+
+	tests := []struct {
+		name  string
+		rel   v1alpha1.RelationshipDefinition
+		rules []string
+		paths []string
+	}{
+		{
+			name: "Selectors resolving to registered components are not reported",
+			rel: v1alpha1.RelationshipDefinition{
+				TypeMeta: v1alpha1.TypeMeta{Kind: "Edge"},
+				Model:    k8s,
+				Selectors: map[string]interface{}{
+					"allow": map[string]interface{}{
+						"from": []interface{}{sel("Service", "kubernetes")},
+						"to":   []interface{}{sel("Pod", "kubernetes"), sel("", "*")},
+					},
+					"deny": emptyDeny,
+				},
+			},
+		},
+		{
+			name: "Unknown models and component kinds are reported",
+			rel: v1alpha1.RelationshipDefinition{
+				TypeMeta: v1alpha1.TypeMeta{Kind: "Edge"},
+				Model:    k8s,
+				Selectors: map[string]interface{}{
+					"allow": map[string]interface{}{
+						"from": []interface{}{sel("Servce", "kubernetes")},
+						"to":   []interface{}{sel("VirtualService", "istio-base")},
+					},
+					"deny": emptyDeny,
+				},
+			},
+			rules: []string{RelationshipLintRuleUnknownKind, RelationshipLintRuleUnknownModel},
+			paths: []string{"/selectors/allow/from/0/kind", "/selectors/allow/to/0/model"},
+		},
+		{
+			name: "Allow selectors excluded by the deny selectors are unreachable",
+			rel: v1alpha1.RelationshipDefinition{
+				TypeMeta: v1alpha1.TypeMeta{Kind: "Edge"},
+				Model:    k8s,
+				Selectors: map[string]interface{}{
+					"allow": map[string]interface{}{
+						"from": []interface{}{sel("Ingress", "kubernetes"), sel("Service", "kubernetes")},
+						"to":   []interface{}{sel("Pod", "kubernetes"), sel("Deployment", "kubernetes")},
+					},
+					"deny": map[string]interface{}{
+						"from": []interface{}{sel("Ingress", "kubernetes")},
+						"to":   []interface{}{sel("Pod", "kubernetes"), sel("Deployment", "kubernetes")},
+					},
+				},
+			},
+			rules: []string{RelationshipLintRuleUnreachableSelector},
+			paths: []string{"/selectors/allow/from/0"},
+		},
+		{
+			name: "Missing to selectors and deny list are reported",
+			rel: v1alpha1.RelationshipDefinition{
+				TypeMeta: v1alpha1.TypeMeta{Kind: "Edge"},
+				Model:    k8s,
+				Selectors: map[string]interface{}{
+					"allow": map[string]interface{}{
+						"from": []interface{}{sel("Service", "kubernetes")},
+					},
+				},
+			},
+			rules: []string{RelationshipLintRuleMissingDeny, RelationshipLintRuleUnreachableSelector},
+			paths: []string{"/selectors", "/selectors/allow/to"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rules, paths []string
+			for _, d := range LintRelationships([]v1alpha1.RelationshipDefinition{tt.rel}, registered) {
+				rules = append(rules, d.Rule)
+				paths = append(paths, d.Path)
+			}
+			if !reflect.DeepEqual(rules, tt.rules) {
+				t.Errorf("expected rules %v, got %v", tt.rules, rules)
+			}
+			if !reflect.DeepEqual(paths, tt.paths) {
+				t.Errorf("expected paths %v, got %v", tt.paths, paths)
+			}
+		})
+	}
+}
+
+func TestRelationshipSelectorModels(t *testing.T) {
+	rels := []v1alpha1.RelationshipDefinition{
+		{
+			Model: v1alpha1.Model{Name: "kubernetes"},
+			Selectors: map[string]interface{}{
+				"allow": map[string]interface{}{
+					"from": []interface{}{map[string]interface{}{"kind": "WASMFilter", "model": "istio-base"}},
+					"to":   []interface{}{map[string]interface{}{"model": "*"}, map[string]interface{}{"kind": "Pod"}},
+				},
+			},
+		},
+	}
+	expected := []string{"istio-base", "kubernetes"}
+	if got := RelationshipSelectorModels(rels); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected models %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,181 @@
+package meshmodel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	"github.com/open-policy-agent/opa/rego"
+)
+
+// RelationshipPolicyQuery is the rule evaluated by the relationship validation policies.
+// Policies declare the rule in the "meshery.relationships" package, every value of the set is reported as a violation:
+//
+//	package meshery.relationships
+//
+//	deny[msg] {
+//		input.subType == "Mount"
+//		msg := "relationships of subType Mount are not allowed"
+//	}
+const RelationshipPolicyQuery = "data.meshery.relationships.deny"
+
+// RelationshipPolicy is a Rego module registered by an administrator, relationship definitions violating it are not registered
+type RelationshipPolicy struct {
+	ID        uuid.UUID `json:"id" gorm:"primaryKey"`
+	Name      string    `json:"name" gorm:"uniqueIndex"`
+	Module    string    `json:"module"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// RelationshipPolicyViolation is a reason a policy gives for rejecting a relationship definition
+type RelationshipPolicyViolation struct {
+	Policy  string `json:"policy"`
+	Message string `json:"message"`
+}
+
+// RelationshipPolicyError is returned when a relationship definition violates the registered policies
+type RelationshipPolicyError struct {
+	Kind       string                        `json:"kind"`
+	Violations []RelationshipPolicyViolation `json:"violations"`
+}
+
+func (e *RelationshipPolicyError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", v.Policy, v.Message))
+	}
+	return fmt.Sprintf("relationship %s violates the registered policies: %s", e.Kind, strings.Join(msgs, "; "))
+}
+
+// ListRelationshipPolicies returns the registered relationship policies ordered by name
+func ListRelationshipPolicies(db *database.Handler) ([]RelationshipPolicy, error) {
+	policies := []RelationshipPolicy{}
+	if err := db.Order("name").Find(&policies).Error; err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// SaveRelationshipPolicy compiles the policy and stores it, replacing the policy previously registered with the same name
+func SaveRelationshipPolicy(db *database.Handler, policy *RelationshipPolicy) error {
+	if policy.Name == "" {
+		return fmt.Errorf("relationship policy name cannot be empty")
+	}
+	if _, err := prepareRelationshipPolicy(context.Background(), *policy); err != nil {
+		return err
+	}
+
+	var existing RelationshipPolicy
+	err := db.Where("name = ?", policy.Name).Limit(1).Find(&existing).Error
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if existing.ID != uuid.Nil {
+		policy.ID = existing.ID
+		policy.CreatedAt = existing.CreatedAt
+	} else {
+		policy.ID = uuid.New()
+		policy.CreatedAt = now
+	}
+	policy.UpdatedAt = now
+	return db.Save(policy).Error
+}
+
+// DeleteRelationshipPolicy removes the policy with the given name, returns false when there is none
+func DeleteRelationshipPolicy(db *database.Handler, name string) (bool, error) {
+	result := db.Where("name = ?", name).Delete(&RelationshipPolicy{})
+	return result.RowsAffected > 0, result.Error
+}
+
+type preparedRelationshipPolicy struct {
+	name  string
+	query rego.PreparedEvalQuery
+}
+
+// RelationshipPolicyEvaluator evaluates relationship definitions against a set of compiled policies
+type RelationshipPolicyEvaluator struct {
+	policies []preparedRelationshipPolicy
+}
+
+// NewRelationshipPolicyEvaluator compiles the registered relationship policies
+func NewRelationshipPolicyEvaluator(ctx context.Context, db *database.Handler) (*RelationshipPolicyEvaluator, error) {
+	policies, err := ListRelationshipPolicies(db)
+	if err != nil {
+		return nil, err
+	}
+	evaluator := &RelationshipPolicyEvaluator{}
+	for _, policy := range policies {
+		prepared, err := prepareRelationshipPolicy(ctx, policy)
+		if err != nil {
+			return nil, err
+		}
+		evaluator.policies = append(evaluator.policies, prepared)
+	}
+	return evaluator, nil
+}
+
+func prepareRelationshipPolicy(ctx context.Context, policy RelationshipPolicy) (preparedRelationshipPolicy, error) {
+	query, err := rego.New(
+		rego.Query(RelationshipPolicyQuery),
+		rego.Module(policy.Name+".rego", policy.Module),
+	).PrepareForEval(ctx)
+	if err != nil {
+		return preparedRelationshipPolicy{}, fmt.Errorf("unable to compile relationship policy %s: %w", policy.Name, err)
+	}
+	return preparedRelationshipPolicy{name: policy.Name, query: query}, nil
+}
+
+// Evaluate returns a *RelationshipPolicyError listing every violation when the relationship violates any of the policies
+func (e *RelationshipPolicyEvaluator) Evaluate(ctx context.Context, rel v1alpha1.RelationshipDefinition) error {
+	if len(e.policies) == 0 {
+		return nil
+	}
+	byt, err := json.Marshal(rel)
+	if err != nil {
+		return err
+	}
+	var input map[string]interface{}
+	if err := json.Unmarshal(byt, &input); err != nil {
+		return err
+	}
+
+	var violations []RelationshipPolicyViolation
+	for _, policy := range e.policies {
+		rs, err := policy.query.Eval(ctx, rego.EvalInput(input))
+		if err != nil {
+			return fmt.Errorf("unable to evaluate relationship policy %s: %w", policy.name, err)
+		}
+		for _, result := range rs {
+			for _, expr := range result.Expressions {
+				denials, _ := expr.Value.([]interface{})
+				for _, denial := range denials {
+					violations = append(violations, RelationshipPolicyViolation{Policy: policy.name, Message: policyMessage(denial)})
+				}
+			}
+		}
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Policy < violations[j].Policy
+	})
+	return &RelationshipPolicyError{Kind: rel.Kind, Violations: violations}
+}
+
+// policies usually deny with a message, other values are reported as JSON
+func policyMessage(denial interface{}) string {
+	if msg, ok := denial.(string); ok {
+		return msg
+	}
+	byt, _ := json.Marshal(denial)
+	return string(byt)
+}
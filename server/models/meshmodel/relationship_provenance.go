@@ -0,0 +1,121 @@
+package meshmodel
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	"gorm.io/gorm"
+)
+
+// RelationshipSourceKey is the metadata key recording how a relationship definition was registered
+const RelationshipSourceKey = "source"
+
+const (
+	// Relationship seeded from the static relationships directory of a model
+	RelationshipSourceStatic = "static"
+	// Relationship registered by a Meshery Adapter
+	RelationshipSourceAdapter = "adapter"
+	// Relationship registered through the REST API
+	RelationshipSourceAPI = "api"
+	// Relationship imported from a registry bundle
+	RelationshipSourceBundle = "bundle"
+)
+
+// SetRelationshipSource records how the relationship was registered in its metadata
+func SetRelationshipSource(rel *v1alpha1.RelationshipDefinition, source string) {
+	if rel.Metadata == nil {
+		rel.Metadata = map[string]interface{}{}
+	}
+	rel.Metadata[RelationshipSourceKey] = source
+}
+
+// RelationshipSource returns how the relationship was registered, relationships registered before the source was recorded have none
+func RelationshipSource(metadata map[string]interface{}) string {
+	source, _ := metadata[RelationshipSourceKey].(string)
+	return source
+}
+
+// RelationshipProvenance describes who registered a relationship, when and how
+type RelationshipProvenance struct {
+	Kind         string    `json:"kind"`
+	SubType      string    `json:"subType"`
+	Model        string    `json:"model"`
+	ModelVersion string    `json:"modelVersion"`
+	Registrant   string    `json:"registrant"`
+	Source       string    `json:"source"`
+	RegisteredAt time.Time `json:"registeredAt"`
+}
+
+// RelationshipProvenanceFilter narrows down the relationships listed by ListRelationshipProvenance
+type RelationshipProvenanceFilter struct {
+	Model      string
+	Registrant string
+	Source     string
+	// organization of the caller, only the relationships visible to its members are listed
+	Org    string
+	Limit  int // If 0 then all records are returned
+	Offset int
+}
+
+// ListRelationshipProvenance lists the provenance of the registered relationships matching the filter, most recently registered first.
+// Returns the total number of matching relationships along with the requested page.
+func ListRelationshipProvenance(db *database.Handler, filter RelationshipProvenanceFilter) ([]RelationshipProvenance, int64, error) {
+	type provenanceRow struct {
+		Kind         string
+		SubType      string
+		Metadata     []byte
+		ModelName    string
+		ModelVersion string
+		Hostname     string
+		RegisteredAt time.Time
+	}
+	finder := db.Model(&v1alpha1.RelationshipDefinitionDB{}).
+		Joins("JOIN model_dbs ON relationship_definition_dbs.model_id = model_dbs.id").
+		Joins("JOIN registries ON registries.entity = relationship_definition_dbs.id").
+		Joins("JOIN hosts ON hosts.id = registries.registrant_id").
+		Scopes(VisibleToOrg(filter.Org))
+	if filter.Model != "" {
+		finder = finder.Where("model_dbs.name = ?", filter.Model)
+	}
+	if filter.Registrant != "" {
+		finder = finder.Where("LOWER(hosts.hostname) = ?", strings.ToLower(filter.Registrant))
+	}
+	if filter.Source != "" {
+		finder = finder.Where("LOWER("+relationshipMetadataColumn(finder, RelationshipSourceKey)+") = ?", strings.ToLower(filter.Source))
+	}
+
+	var count int64
+	if err := finder.Session(&gorm.Session{}).Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+	finder = finder.Select("relationship_definition_dbs.kind, relationship_definition_dbs.sub_type, relationship_definition_dbs.metadata, " +
+		"model_dbs.name AS model_name, model_dbs.version AS model_version, hosts.hostname, registries.created_at AS registered_at").
+		Order("registries.created_at DESC").
+		Offset(filter.Offset)
+	if filter.Limit != 0 {
+		finder = finder.Limit(filter.Limit)
+	}
+	var rows []provenanceRow
+	if err := finder.Scan(&rows).Error; err != nil {
+		return nil, 0, err
+	}
+
+	provenance := make([]RelationshipProvenance, 0, len(rows))
+	for _, row := range rows {
+		var metadata map[string]interface{}
+		_ = json.Unmarshal(row.Metadata, &metadata)
+		provenance = append(provenance, RelationshipProvenance{
+			Kind:         row.Kind,
+			SubType:      row.SubType,
+			Model:        row.ModelName,
+			ModelVersion: row.ModelVersion,
+			Registrant:   row.Hostname,
+			Source:       RelationshipSource(metadata),
+			RegisteredAt: row.RegisteredAt,
+		})
+	}
+	return provenance, count, nil
+}
@@ -0,0 +1,59 @@
+package meshmodel
+
+import (
+	"testing"
+
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	"github.com/layer5io/meshkit/models/meshmodel/registry"
+)
+
+func TestListRelationshipProvenance(t *testing.T) {
+	db, rm := registryDB(t)
+	static := testRelationship("Network", "", nil)
+	SetRelationshipSource(&static, RelationshipSourceStatic)
+	registerRelationships(t, rm, registry.Host{Hostname: "kubernetes"}, static)
+	rels := []v1alpha1.RelationshipDefinition{testRelationship("Mount", "org-a", nil), testRelationship("Mount", "org-b", nil)}
+	for i := range rels {
+		SetRelationshipSource(&rels[i], RelationshipSourceAdapter)
+	}
+	registerRelationships(t, rm, registry.Host{Hostname: "meshery-istio"}, rels...)
+
+	tests := []struct {
+		name   string
+		filter RelationshipProvenanceFilter
+		count  int64
+	}{
+		{"relationships of the organization and the global ones", RelationshipProvenanceFilter{Org: "org-a"}, 2},
+		{"relationships without organization", RelationshipProvenanceFilter{}, 1},
+		{"relationships of a registrant", RelationshipProvenanceFilter{Registrant: "Meshery-Istio", Org: "org-a"}, 1},
+		{"relationships of a source", RelationshipProvenanceFilter{Source: "STATIC", Org: "org-b"}, 1},
+		{"relationships of another model", RelationshipProvenanceFilter{Model: "istio", Org: "org-a"}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provenance, count, err := ListRelationshipProvenance(db, tt.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count != tt.count || int64(len(provenance)) != tt.count {
+				t.Fatalf("%d of %d relationships were listed, want %d", len(provenance), count, tt.count)
+			}
+			for _, p := range provenance {
+				if tt.filter.Registrant != "" && p.Registrant != "meshery-istio" {
+					t.Errorf("a relationship registered by %s was listed for %s", p.Registrant, tt.filter.Registrant)
+				}
+				if tt.filter.Source != "" && p.Source != RelationshipSourceStatic {
+					t.Errorf("a relationship of source %q was listed for %s", p.Source, tt.filter.Source)
+				}
+			}
+		})
+	}
+
+	provenance, count, err := ListRelationshipProvenance(db, RelationshipProvenanceFilter{Org: "org-a", Limit: 1, Offset: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 || len(provenance) != 1 {
+		t.Errorf("the second page lists %d of %d relationships, want 1 of 2", len(provenance), count)
+	}
+}
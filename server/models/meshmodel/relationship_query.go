@@ -0,0 +1,124 @@
+package meshmodel
+
+import (
+	"strings"
+
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+// RelationshipQuery holds the filters on relationship definitions which the registry does not support,
+// they are applied on the definitions returned by the registry.
+type RelationshipQuery struct {
+	// "true" or "false", matches relationships whose metadata marks them as annotations
+	Annotation string
+	// hostname of the registrant the relationship was registered by
+	Registrant string
+	// kind of a component referenced by any of the selectors of the relationship
+	SelectorKind string
+	// case insensitive term searched for in the fields of the relationship
+	Search string
+	// restricts the search to one of the RelationshipSearchFields, all of them are searched when empty
+	SearchField string
+}
+
+// Fields of a relationship definition which can be searched
+const (
+	RelationshipSearchFieldKind            = "kind"
+	RelationshipSearchFieldDescription     = "description"
+	RelationshipSearchFieldSubType         = "subType"
+	RelationshipSearchFieldEvaluationQuery = "evaluationQuery"
+	RelationshipSearchFieldSelectorKind    = "selectorKind"
+)
+
+// RelationshipSearchFields lists the fields of a relationship definition which can be searched
+var RelationshipSearchFields = []string{
+	RelationshipSearchFieldKind,
+	RelationshipSearchFieldDescription,
+	RelationshipSearchFieldSubType,
+	RelationshipSearchFieldEvaluationQuery,
+	RelationshipSearchFieldSelectorKind,
+}
+
+// IsEmpty reports whether the query filters nothing
+func (q RelationshipQuery) IsEmpty() bool {
+	return q.Annotation == "" && q.Registrant == "" && q.SelectorKind == "" && q.Search == ""
+}
+
+// Matches reports whether the relationship satisfies every filter of the query.
+// The registrant details of the relationship are expected to be populated.
+func (q RelationshipQuery) Matches(rel v1alpha1.RelationshipDefinition) bool {
+	if q.Annotation != "" {
+		isAnnotation, _ := rel.Metadata["isAnnotation"].(bool)
+		if strings.EqualFold(q.Annotation, "true") != isAnnotation {
+			return false
+		}
+	}
+	if q.Registrant != "" && !strings.EqualFold(q.Registrant, rel.HostName) {
+		return false
+	}
+	if q.SelectorKind != "" && !selectsKind(rel, q.SelectorKind) {
+		return false
+	}
+	if q.Search != "" && !searchRelationship(rel, q.Search, q.SearchField) {
+		return false
+	}
+	return true
+}
+
+// reports whether the term is contained in the given field of the relationship, or in any of its searchable fields when field is empty
+func searchRelationship(rel v1alpha1.RelationshipDefinition, term, field string) bool {
+	term = strings.ToLower(term)
+	contains := func(value string) bool {
+		return strings.Contains(strings.ToLower(value), term)
+	}
+	for _, f := range RelationshipSearchFields {
+		if field != "" && !strings.EqualFold(field, f) {
+			continue
+		}
+		switch f {
+		case RelationshipSearchFieldKind:
+			if contains(rel.Kind) {
+				return true
+			}
+		case RelationshipSearchFieldDescription:
+			if description, _ := rel.Metadata["description"].(string); contains(description) {
+				return true
+			}
+		case RelationshipSearchFieldSubType:
+			if contains(rel.SubType) {
+				return true
+			}
+		case RelationshipSearchFieldEvaluationQuery:
+			if query, _ := rel.Metadata["evaluationQuery"].(string); contains(query) {
+				return true
+			}
+		case RelationshipSearchFieldSelectorKind:
+			for _, set := range []string{"allow", "deny"} {
+				sels, _ := rel.Selectors[set].(map[string]interface{})
+				for _, dir := range []string{"from", "to"} {
+					for _, sel := range parseSelectors(sels[dir], rel.Model.Name) {
+						if sel.kind != AnyComponentKind && contains(sel.kind) {
+							return true
+						}
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// reports whether any of the allow or deny selectors of the relationship references the component kind
+func selectsKind(rel v1alpha1.RelationshipDefinition, kind string) bool {
+	for _, set := range []string{"allow", "deny"} {
+		sels, _ := rel.Selectors[set].(map[string]interface{})
+		for _, dir := range []string{"from", "to"} {
+			for _, sel := range parseSelectors(sels[dir], rel.Model.Name) {
+				if strings.EqualFold(sel.kind, kind) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package meshmodel
+
+import (
+	"testing"
+
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+func TestRelationshipQuerySearch(t *testing.T) {
+	rel := v1alpha1.RelationshipDefinition{
+		TypeMeta: v1alpha1.TypeMeta{Kind: "Edge"},
+		Model:    v1alpha1.Model{Name: "kubernetes"},
+		SubType:  "Network",
+		Metadata: map[string]interface{}{
+			"description":     "A Service routes traffic to the Pods of a Deployment",
+			"evaluationQuery": "edge_network_policy",
+		},
+		Selectors: selectors(
+			[]interface{}{map[string]interface{}{"kind": "Service", "model": "kubernetes"}},
+			[]interface{}{map[string]interface{}{"model": "kubernetes"}},
+		),
+	}
+
+	tests := []struct {
+		name  string
+		query RelationshipQuery
+		want  bool
+	}{
+		{name: "Kind is searched", query: RelationshipQuery{Search: "edge"}, want: true},
+		{name: "Description is searched", query: RelationshipQuery{Search: "traffic"}, want: true},
+		{name: "SubType is searched", query: RelationshipQuery{Search: "netw"}, want: true},
+		{name: "Evaluation query is searched", query: RelationshipQuery{Search: "policy"}, want: true},
+		{name: "Selector component kinds are searched", query: RelationshipQuery{Search: "service"}, want: true},
+		{name: "Terms found in no field do not match", query: RelationshipQuery{Search: "ingress"}, want: false},
+		{name: "Search can target a single field", query: RelationshipQuery{Search: "service", SearchField: "selectorKind"}, want: true},
+		{name: "Terms found outside of the targeted field do not match", query: RelationshipQuery{Search: "traffic", SearchField: "kind"}, want: false},
+		{name: "Wildcard selectors do not match the search", query: RelationshipQuery{Search: "*", SearchField: "selectorKind"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.Matches(rel); got != tt.want {
+				t.Errorf("RelationshipQuery.Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
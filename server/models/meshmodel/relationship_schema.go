@@ -0,0 +1,142 @@
+package meshmodel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/layer5io/meshery/server/models/pattern/jsonschema"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+// relationshipSchema describes the shape of the user provided fields of a relationship definition.
+// Selectors are matched against the components of a design, so a malformed selector silently never matches;
+// validating them during registration surfaces the mistake to the registrant instead.
+const relationshipSchema = `{
+	"$schema": "https://json-schema.org/draft/2019-09/schema",
+	"type": "object",
+	"properties": {
+		"subType": { "type": "string" },
+		"metadata": {
+			"type": ["object", "null"],
+			"properties": {
+				"description": { "type": "string" },
+				"version": { "type": "integer", "minimum": 1 }
+			}
+		},
+		"selectors": {
+			"type": ["object", "null"],
+			"properties": {
+				"allow": { "$ref": "#/$defs/selectorSet" },
+				"deny": { "$ref": "#/$defs/selectorSet" }
+			},
+			"additionalProperties": false
+		}
+	},
+	"$defs": {
+		"selectorSet": {
+			"type": "object",
+			"properties": {
+				"from": { "type": "array", "items": { "$ref": "#/$defs/selector" } },
+				"to": { "type": "array", "items": { "$ref": "#/$defs/selector" } }
+			},
+			"additionalProperties": false
+		},
+		"selector": {
+			"type": "object",
+			"required": ["model"],
+			"properties": {
+				"kind": { "type": "string" },
+				"model": { "type": "string", "minLength": 1 },
+				"match": {
+					"type": "object",
+					"additionalProperties": { "$ref": "#/$defs/paths" }
+				},
+				"patch": {
+					"type": "object",
+					"properties": {
+						"patchStrategy": { "type": "string", "minLength": 1 },
+						"mutatorRef": { "$ref": "#/$defs/paths" },
+						"mutatedRef": { "$ref": "#/$defs/paths" },
+						"description": { "type": "string" }
+					}
+				}
+			}
+		},
+		"paths": {
+			"type": "array",
+			"items": { "type": "array", "items": { "type": "string" } }
+		}
+	}
+}`
+
+var (
+	relationshipSchemaValidator     *jsonschema.Schema
+	relationshipSchemaValidatorErr  error
+	relationshipSchemaValidatorOnce sync.Once
+)
+
+// RelationshipSchemaError describes a single field of a relationship definition that failed validation
+type RelationshipSchemaError struct {
+	Path    string      `json:"path"`
+	Value   interface{} `json:"value,omitempty"`
+	Message string      `json:"message"`
+}
+
+// RelationshipValidationError is returned when a relationship definition does not conform to the relationship schema
+type RelationshipValidationError struct {
+	Kind   string                    `json:"kind"`
+	Errors []RelationshipSchemaError `json:"errors"`
+}
+
+func (e *RelationshipValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, se := range e.Errors {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", se.Path, se.Message))
+	}
+	return fmt.Sprintf("invalid relationship %s: %s", e.Kind, strings.Join(msgs, "; "))
+}
+
+func getRelationshipSchemaValidator() (*jsonschema.Schema, error) {
+	relationshipSchemaValidatorOnce.Do(func() {
+		rs := &jsonschema.Schema{}
+		if err := json.Unmarshal([]byte(relationshipSchema), rs); err != nil {
+			relationshipSchemaValidatorErr = fmt.Errorf("failed to create relationship schema: %s", err)
+			return
+		}
+		relationshipSchemaValidator = rs
+	})
+	return relationshipSchemaValidator, relationshipSchemaValidatorErr
+}
+
+// validateRelationshipSchema validates the selectors and metadata of the relationship against the relationship schema.
+// The returned slice holds one entry per offending field, identified by its JSON pointer path.
+func validateRelationshipSchema(rel v1alpha1.RelationshipDefinition) ([]RelationshipSchemaError, error) {
+	rs, err := getRelationshipSchemaValidator()
+	if err != nil {
+		return nil, err
+	}
+	byt, err := json.Marshal(rel)
+	if err != nil {
+		return nil, err
+	}
+	keyErrs, err := rs.ValidateBytes(context.TODO(), byt)
+	if err != nil {
+		return nil, fmt.Errorf("error occurred during schema validation: %s", err)
+	}
+	schemaErrs := make([]RelationshipSchemaError, 0, len(keyErrs))
+	for _, ke := range keyErrs {
+		path := ke.PropertyPath
+		if path == "" {
+			path = "/"
+		}
+		schemaErrs = append(schemaErrs, RelationshipSchemaError{
+			Path:    path,
+			Value:   ke.InvalidValue,
+			Message: ke.Message,
+		})
+	}
+	return schemaErrs, nil
+}
@@ -0,0 +1,130 @@
+package meshmodel
+
+import (
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RelationshipOrgKey is the metadata key recording the organization a relationship definition is scoped to.
+// Relationships without an organization are visible to everyone.
+const RelationshipOrgKey = "orgID"
+
+// SetRelationshipOrg scopes the relationship to the organization, the relationship is made global when orgID is empty.
+// The organization the definition itself names is always replaced, so that callers cannot register into another organization.
+func SetRelationshipOrg(rel *v1alpha1.RelationshipDefinition, orgID string) {
+	if orgID == "" {
+		delete(rel.Metadata, RelationshipOrgKey)
+		return
+	}
+	if rel.Metadata == nil {
+		rel.Metadata = map[string]interface{}{}
+	}
+	rel.Metadata[RelationshipOrgKey] = orgID
+}
+
+// RelationshipOrg returns the organization the relationship is scoped to, empty for global relationships
+func RelationshipOrg(metadata map[string]interface{}) string {
+	orgID, _ := metadata[RelationshipOrgKey].(string)
+	return orgID
+}
+
+// RelationshipVisibleTo reports whether a relationship scoped to relOrg is visible to the members of orgID,
+// global relationships are visible to everyone
+func RelationshipVisibleTo(relOrg, orgID string) bool {
+	return relOrg == "" || relOrg == orgID
+}
+
+// relationshipMetadataColumn is the SQL expression of the string at key in the JSON metadata of the rows of
+// relationship_definition_dbs, empty when the metadata has none
+func relationshipMetadataColumn(db *gorm.DB, key string) string {
+	if db.Dialector.Name() == database.POSTGRES {
+		return "COALESCE(convert_from(NULLIF(relationship_definition_dbs.metadata, ''::bytea), 'UTF8')::jsonb ->> '" + key + "', '')"
+	}
+	return "COALESCE(json_extract(NULLIF(CAST(relationship_definition_dbs.metadata AS TEXT), ''), '$." + key + "'), '')"
+}
+
+// relationshipOrgColumn is the SQL expression of the organization of the rows of relationship_definition_dbs, empty for
+// the global relationships
+func relationshipOrgColumn(db *gorm.DB) string {
+	return relationshipMetadataColumn(db, RelationshipOrgKey)
+}
+
+// OwnedByOrg scopes a query of the relationship definitions to those of the organization, to the global ones when orgID
+// is empty. The relationships a caller changes or deletes are those of its organization only.
+func OwnedByOrg(orgID string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(relationshipOrgColumn(db)+" = ?", orgID)
+	}
+}
+
+// VisibleToOrg scopes a query of the relationship definitions to those visible to the members of the organization, the
+// global ones and those of the organization. Only the global relationships are visible when orgID is empty.
+func VisibleToOrg(orgID string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(relationshipOrgColumn(db)+" IN ?", []string{"", orgID})
+	}
+}
+
+// OrgRelationshipFilter is a RelationshipFilter restricted to the relationships visible to the members of Org.
+// The registry manager does not support it, lookups go through an OrgScopedRegistry.
+type OrgRelationshipFilter struct {
+	v1alpha1.RelationshipFilter
+	// organization of the caller, only the global relationships are returned when empty
+	Org string
+}
+
+// GetOrgRelationships returns the relationship definitions matching the filter along with their total number,
+// it performs the lookup of v1alpha1.GetMeshModelRelationship with the relationships restricted by VisibleToOrg.
+func GetOrgRelationships(db *database.Handler, f OrgRelationshipFilter) ([]v1alpha1.RelationshipDefinition, int64, error) {
+	type relationshipDefinitionWithModel struct {
+		v1alpha1.RelationshipDefinitionDB
+		v1alpha1.ModelDB
+		v1alpha1.CategoryDB
+	}
+	var rows []relationshipDefinitionWithModel
+	finder := db.Model(&v1alpha1.RelationshipDefinitionDB{}).
+		Joins("JOIN model_dbs ON relationship_definition_dbs.model_id = model_dbs.id").
+		Joins("JOIN category_dbs ON model_dbs.category_id = category_dbs.id").
+		Scopes(VisibleToOrg(f.Org))
+	if f.Kind != "" {
+		if f.Greedy {
+			finder = finder.Where("relationship_definition_dbs.kind LIKE ?", "%"+f.Kind+"%")
+		} else {
+			finder = finder.Where("relationship_definition_dbs.kind = ?", f.Kind)
+		}
+	}
+	if f.SubType != "" {
+		finder = finder.Where("relationship_definition_dbs.sub_type = ?", f.SubType)
+	}
+	if f.ModelName != "" {
+		finder = finder.Where("model_dbs.name = ?", f.ModelName)
+	}
+	if f.Version != "" {
+		finder = finder.Where("model_dbs.version = ?", f.Version)
+	}
+	var count int64
+	if err := finder.Session(&gorm.Session{}).Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+	if f.OrderOn != "" {
+		if f.Sort == "desc" {
+			finder = finder.Order(clause.OrderByColumn{Column: clause.Column{Name: f.OrderOn}, Desc: true})
+		} else {
+			finder = finder.Order(f.OrderOn)
+		}
+	}
+	finder = finder.Select("relationship_definition_dbs.*, model_dbs.*").Offset(f.Offset)
+	if f.Limit != 0 {
+		finder = finder.Limit(f.Limit)
+	}
+	if err := finder.Scan(&rows).Error; err != nil {
+		return nil, 0, err
+	}
+	rels := make([]v1alpha1.RelationshipDefinition, 0, len(rows))
+	for _, row := range rows {
+		rels = append(rels, row.RelationshipDefinitionDB.GetRelationshipDefinition(row.ModelDB.GetModel(row.CategoryDB.GetCategory(db))))
+	}
+	return rels, count, nil
+}
@@ -0,0 +1,146 @@
+package meshmodel
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+// scopedRelationshipsDB returns a database holding a global relationship, a relationship with null metadata and the
+// relationships of the organizations org-a and org-b, their kinds being the organizations they are scoped to
+func scopedRelationshipsDB(t *testing.T) *database.Handler {
+	t.Helper()
+	db, err := database.New(database.Options{Filename: filepath.Join(t.TempDir(), "mesherydb.sql"), Engine: database.SQLITE})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&v1alpha1.CategoryDB{}, &v1alpha1.ModelDB{}, &v1alpha1.RelationshipDefinitionDB{}); err != nil {
+		t.Fatal(err)
+	}
+	category := v1alpha1.CategoryDB{ID: uuid.New(), Name: "Orchestration & Management"}
+	model := v1alpha1.ModelDB{ID: uuid.New(), CategoryID: category.ID, Name: "kubernetes", Version: "v1.25.2"}
+	if err := db.Create(&category).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Create(&model).Error; err != nil {
+		t.Fatal(err)
+	}
+	rels := []v1alpha1.RelationshipDefinitionDB{
+		{ID: uuid.New(), ModelID: model.ID, TypeMeta: v1alpha1.TypeMeta{Kind: "global"}, Metadata: []byte(`{"description":"global"}`)},
+		{ID: uuid.New(), ModelID: model.ID, TypeMeta: v1alpha1.TypeMeta{Kind: "null"}, Metadata: []byte(`null`)},
+		{ID: uuid.New(), ModelID: model.ID, TypeMeta: v1alpha1.TypeMeta{Kind: "org-a"}, Metadata: []byte(`{"orgID":"org-a"}`)},
+		{ID: uuid.New(), ModelID: model.ID, TypeMeta: v1alpha1.TypeMeta{Kind: "org-b"}, Metadata: []byte(`{"description":"org-b","orgID":"org-b"}`)},
+	}
+	if err := db.Create(&rels).Error; err != nil {
+		t.Fatal(err)
+	}
+	return &db
+}
+
+func TestSetRelationshipOrg(t *testing.T) {
+	rel := v1alpha1.RelationshipDefinition{Metadata: map[string]interface{}{RelationshipOrgKey: "org-b"}}
+	SetRelationshipOrg(&rel, "org-a")
+	if got := RelationshipOrg(rel.Metadata); got != "org-a" {
+		t.Errorf("the organization of the relationship is %q, want org-a", got)
+	}
+	SetRelationshipOrg(&rel, "")
+	if _, ok := rel.Metadata[RelationshipOrgKey]; ok {
+		t.Errorf("the relationship registered without an organization is scoped to %v", rel.Metadata[RelationshipOrgKey])
+	}
+	SetRelationshipOrg(&v1alpha1.RelationshipDefinition{}, "")
+}
+
+func TestOwnedByOrg(t *testing.T) {
+	db := scopedRelationshipsDB(t)
+	tests := []struct {
+		orgID string
+		want  []string
+	}{
+		{"", []string{"global", "null"}},
+		{"org-a", []string{"org-a"}},
+		{"org-c", nil},
+	}
+	for _, tt := range tests {
+		var kinds []string
+		if err := db.Model(&v1alpha1.RelationshipDefinitionDB{}).Scopes(OwnedByOrg(tt.orgID)).Pluck("kind", &kinds).Error; err != nil {
+			t.Fatal(err)
+		}
+		sort.Strings(kinds)
+		if strings.Join(kinds, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("the relationships owned by %q are %v, want %v", tt.orgID, kinds, tt.want)
+		}
+	}
+}
+
+func TestVisibleToOrg(t *testing.T) {
+	db := scopedRelationshipsDB(t)
+	tests := []struct {
+		orgID string
+		want  []string
+	}{
+		{"", []string{"global", "null"}},
+		{"org-a", []string{"global", "null", "org-a"}},
+		{"org-c", []string{"global", "null"}},
+	}
+	for _, tt := range tests {
+		var kinds []string
+		if err := db.Model(&v1alpha1.RelationshipDefinitionDB{}).Scopes(VisibleToOrg(tt.orgID)).Pluck("kind", &kinds).Error; err != nil {
+			t.Fatal(err)
+		}
+		sort.Strings(kinds)
+		if strings.Join(kinds, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("the relationships visible to %q are %v, want %v", tt.orgID, kinds, tt.want)
+		}
+	}
+}
+
+func TestGetOrgRelationships(t *testing.T) {
+	db := scopedRelationshipsDB(t)
+	tests := []struct {
+		name   string
+		filter OrgRelationshipFilter
+		count  int64
+		want   []string
+	}{
+		{
+			name:   "The relationships of the other organizations are not counted",
+			filter: OrgRelationshipFilter{RelationshipFilter: v1alpha1.RelationshipFilter{ModelName: "kubernetes", OrderOn: "kind"}, Org: "org-a"},
+			count:  3,
+			want:   []string{"global", "null", "org-a"},
+		},
+		{
+			name:   "Pages are taken from the relationships of the organization",
+			filter: OrgRelationshipFilter{RelationshipFilter: v1alpha1.RelationshipFilter{OrderOn: "kind", Sort: "desc", Limit: 1, Offset: 1}, Org: "org-b"},
+			count:  3,
+			want:   []string{"null"},
+		},
+		{
+			name:   "Callers without an organization only get the global relationships",
+			filter: OrgRelationshipFilter{RelationshipFilter: v1alpha1.RelationshipFilter{Kind: "org", Greedy: true}},
+			count:  0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rels, count, err := GetOrgRelationships(db, tt.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var kinds []string
+			for _, rel := range rels {
+				kinds = append(kinds, rel.Kind)
+				if rel.Model.Name != "kubernetes" {
+					t.Errorf("the model of relationship %s is %q, want kubernetes", rel.Kind, rel.Model.Name)
+				}
+			}
+			if count != tt.count || strings.Join(kinds, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("GetOrgRelationships() = %v, %d, want %v, %d", kinds, count, tt.want, tt.count)
+			}
+		})
+	}
+}
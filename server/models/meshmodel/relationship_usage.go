@@ -0,0 +1,95 @@
+package meshmodel
+
+import (
+	"sort"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+// ComponentRef identifies a component of a design by its kind and the model it belongs to
+type ComponentRef struct {
+	Name  string `json:"name,omitempty"`
+	Kind  string `json:"kind"`
+	Model string `json:"model"`
+}
+
+// DesignComponents returns the components of the design
+func DesignComponents(pattern core.Pattern) []ComponentRef {
+	comps := make([]ComponentRef, 0, len(pattern.Services))
+	for _, svc := range pattern.Services {
+		if svc == nil {
+			continue
+		}
+		comps = append(comps, ComponentRef{Name: svc.Name, Kind: svc.Type, Model: svc.Model})
+	}
+	sort.Slice(comps, func(i, j int) bool {
+		return comps[i].Name < comps[j].Name
+	})
+	return comps
+}
+
+// RelationshipUsage is the number of designs in which the selectors of a relationship match a pair of components
+type RelationshipUsage struct {
+	Kind         string `json:"kind"`
+	SubType      string `json:"subType"`
+	Model        string `json:"model"`
+	ModelVersion string `json:"modelVersion"`
+	Designs      int    `json:"designs"`
+	// Org is the organization the relationship is scoped to, empty for the global relationships
+	Org string `json:"-"`
+}
+
+// CountRelationshipUsage counts, for every relationship, the designs containing a component matching one of its "from" selectors
+// and another component matching one of its "to" selectors.
+// Relationships not used by any design are reported with a count of 0, so that unused definitions can be identified.
+func CountRelationshipUsage(rels []v1alpha1.RelationshipDefinition, designs [][]ComponentRef) []RelationshipUsage {
+	usage := make([]RelationshipUsage, 0, len(rels))
+	for _, rel := range rels {
+		allow, _ := rel.Selectors["allow"].(map[string]interface{})
+		from := parseSelectors(allow["from"], rel.Model.Name)
+		to := parseSelectors(allow["to"], rel.Model.Name)
+
+		u := RelationshipUsage{
+			Kind:         rel.Kind,
+			SubType:      rel.SubType,
+			Model:        rel.Model.Name,
+			ModelVersion: rel.Model.Version,
+			Org:          RelationshipOrg(rel.Metadata),
+		}
+		for _, comps := range designs {
+			if relationshipUsedBy(from, to, comps) {
+				u.Designs++
+			}
+		}
+		usage = append(usage, u)
+	}
+	sort.SliceStable(usage, func(i, j int) bool {
+		return usage[i].Designs > usage[j].Designs
+	})
+	return usage
+}
+
+// reports whether two distinct components of the design match the from and to selectors respectively
+func relationshipUsedBy(from, to []relationshipSelector, comps []ComponentRef) bool {
+	for i, src := range comps {
+		if !matchesAnySelector(from, src) {
+			continue
+		}
+		for j, dst := range comps {
+			if i != j && matchesAnySelector(to, dst) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchesAnySelector(sels []relationshipSelector, comp ComponentRef) bool {
+	for _, sel := range sels {
+		if sel.model == comp.Model && (sel.kind == AnyComponentKind || sel.kind == comp.Kind) {
+			return true
+		}
+	}
+	return false
+}
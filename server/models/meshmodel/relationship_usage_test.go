@@ -0,0 +1,77 @@
+package meshmodel
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+func TestCountRelationshipUsage(t *testing.T) {
+	k8s := v1alpha1.Model{Name: "kubernetes", Version: "v1.25.2"}
+	network := v1alpha1.RelationshipDefinition{
+		TypeMeta: v1alpha1.TypeMeta{Kind: "Edge"},
+		Model:    k8s,
+		SubType:  "Network",
+		Selectors: selectors(
+			[]interface{}{map[string]interface{}{"kind": "Service", "model": "kubernetes"}},
+			[]interface{}{map[string]interface{}{"kind": "Deployment", "model": "kubernetes"}},
+		),
+	}
+	parent := v1alpha1.RelationshipDefinition{
+		TypeMeta: v1alpha1.TypeMeta{Kind: "Hierarchical"},
+		Model:    k8s,
+		SubType:  "Parent",
+		Selectors: selectors(
+			[]interface{}{map[string]interface{}{"kind": "Namespace", "model": "kubernetes"}},
+			[]interface{}{map[string]interface{}{"model": "kubernetes"}},
+		),
+	}
+
+	tests := []struct {
+		name    string
+		designs [][]ComponentRef
+		want    []RelationshipUsage
+	}{
+		{
+			name:    "Relationships are reported even when no design uses them",
+			designs: nil,
+			want: []RelationshipUsage{
+				{Kind: "Edge", SubType: "Network", Model: "kubernetes", ModelVersion: "v1.25.2", Designs: 0},
+				{Kind: "Hierarchical", SubType: "Parent", Model: "kubernetes", ModelVersion: "v1.25.2", Designs: 0},
+			},
+		},
+		{
+			name: "A design uses a relationship when distinct components match the from and to selectors",
+			designs: [][]ComponentRef{
+				{{Kind: "Service", Model: "kubernetes"}, {Kind: "Deployment", Model: "kubernetes"}},
+				{{Kind: "Service", Model: "kubernetes"}, {Kind: "Deployment", Model: "kubernetes"}, {Kind: "Namespace", Model: "kubernetes"}},
+				{{Kind: "Service", Model: "kubernetes"}},
+				{{Kind: "Namespace", Model: "kubernetes"}},
+				{{Kind: "Deployment", Model: "istio-base"}, {Kind: "Service", Model: "kubernetes"}},
+			},
+			want: []RelationshipUsage{
+				{Kind: "Edge", SubType: "Network", Model: "kubernetes", ModelVersion: "v1.25.2", Designs: 2},
+				{Kind: "Hierarchical", SubType: "Parent", Model: "kubernetes", ModelVersion: "v1.25.2", Designs: 1},
+			},
+		},
+		{
+			name: "Relationships are ordered by the number of designs using them",
+			designs: [][]ComponentRef{
+				{{Kind: "Namespace", Model: "kubernetes"}, {Kind: "Pod", Model: "kubernetes"}},
+			},
+			want: []RelationshipUsage{
+				{Kind: "Hierarchical", SubType: "Parent", Model: "kubernetes", ModelVersion: "v1.25.2", Designs: 1},
+				{Kind: "Edge", SubType: "Network", Model: "kubernetes", ModelVersion: "v1.25.2", Designs: 0},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CountRelationshipUsage([]v1alpha1.RelationshipDefinition{network, parent}, tt.designs)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CountRelationshipUsage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package meshmodel
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+// Kinds of the relationships a design declares between its components
+const (
+	// declared by the dependsOn field of a component
+	RelationshipKindEdge = "Edge"
+	// declared by the parent of a component in the meshmap trait
+	RelationshipKindHierarchical = "Hierarchical"
+)
+
+// DeclaredRelationship is a relationship a design declares between two of its components.
+// From is the component declaring the relationship, the dependent or child one, and To is the component it references.
+type DeclaredRelationship struct {
+	Kind string       `json:"kind"`
+	From ComponentRef `json:"from"`
+	To   ComponentRef `json:"to"`
+}
+
+// RelationshipViolation is a declared relationship which the registered relationships do not allow
+type RelationshipViolation struct {
+	DeclaredRelationship
+	// DeniedBy names the relationship whose deny selectors match the pair, it is empty when no relationship allows the pair
+	DeniedBy string `json:"deniedBy,omitempty"`
+	Message  string `json:"message"`
+}
+
+// RelationshipViolationError is the error of a design violating the constraints of the registered relationships
+type RelationshipViolationError struct {
+	Violations []RelationshipViolation
+}
+
+func (e *RelationshipViolationError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, v.Message)
+	}
+	return fmt.Sprintf("the design violates %d relationship constraint(s):\n%s", len(e.Violations), strings.Join(msgs, "\n"))
+}
+
+// DeclaredRelationships returns the relationships declared between the components of the design, ordered by component
+func DeclaredRelationships(pattern core.Pattern) []DeclaredRelationship {
+	refs := make(map[string]ComponentRef, len(pattern.Services))
+	elements := make(map[string]string)
+	for name, svc := range pattern.Services {
+		if svc == nil {
+			continue
+		}
+		refs[name] = ComponentRef{Name: svc.Name, Kind: svc.Type, Model: svc.Model}
+		if meshmap, ok := svc.Traits["meshmap"].(map[string]interface{}); ok {
+			if id, ok := meshmap["id"].(string); ok {
+				elements[id] = name
+			}
+		}
+	}
+
+	declared := make([]DeclaredRelationship, 0)
+	for name, svc := range pattern.Services {
+		if svc == nil {
+			continue
+		}
+		for _, dep := range svc.DependsOn {
+			if to, ok := refs[dep]; ok && dep != name {
+				declared = append(declared, DeclaredRelationship{Kind: RelationshipKindEdge, From: refs[name], To: to})
+			}
+		}
+		meshmap, _ := svc.Traits["meshmap"].(map[string]interface{})
+		if parent, ok := meshmap["parent"].(string); ok {
+			if to, ok := refs[elements[parent]]; ok && elements[parent] != name {
+				declared = append(declared, DeclaredRelationship{Kind: RelationshipKindHierarchical, From: refs[name], To: to})
+			}
+		}
+	}
+	sort.Slice(declared, func(i, j int) bool {
+		a, b := declared[i], declared[j]
+		if a.From.Name != b.From.Name {
+			return a.From.Name < b.From.Name
+		}
+		if a.To.Name != b.To.Name {
+			return a.To.Name < b.To.Name
+		}
+		return a.Kind < b.Kind
+	})
+	return declared
+}
+
+// ValidateDesignRelationships checks the declared relationships against the registered relationships of the same kind.
+// A declared relationship violates them when the deny selectors of a relationship match its pair of components, or when
+// relationships of its kind constrain the models of its components but none of them allows the pair.
+// The relationships between components of models no relationship of the kind refers to are not constrained.
+func ValidateDesignRelationships(declared []DeclaredRelationship, rels []v1alpha1.RelationshipDefinition) []RelationshipViolation {
+	violations := make([]RelationshipViolation, 0)
+	for _, d := range declared {
+		constrained, allowed := false, false
+		deniedBy := ""
+		for _, rel := range rels {
+			if !strings.EqualFold(rel.Kind, d.Kind) {
+				continue
+			}
+			allow, _ := rel.Selectors["allow"].(map[string]interface{})
+			deny, _ := rel.Selectors["deny"].(map[string]interface{})
+			allowFrom := parseSelectors(allow["from"], rel.Model.Name)
+			allowTo := parseSelectors(allow["to"], rel.Model.Name)
+			denyFrom := parseSelectors(deny["from"], rel.Model.Name)
+			denyTo := parseSelectors(deny["to"], rel.Model.Name)
+
+			if selectorsMatch(denyFrom, d.From) && selectorsMatch(denyTo, d.To) {
+				deniedBy = describeRelationship(rel)
+				break
+			}
+			if selectorsMatch(allowFrom, d.From) && selectorsMatch(allowTo, d.To) {
+				allowed = true
+			}
+			for _, sels := range [][]relationshipSelector{allowFrom, allowTo, denyFrom, denyTo} {
+				for _, sel := range sels {
+					if sel.model == anyModel || sel.model == d.From.Model || sel.model == d.To.Model {
+						constrained = true
+					}
+				}
+			}
+		}
+
+		switch {
+		case deniedBy != "":
+			violations = append(violations, RelationshipViolation{
+				DeclaredRelationship: d,
+				DeniedBy:             deniedBy,
+				Message: fmt.Sprintf("%s is denied by relationship %s, remove the relationship from the design",
+					describeDeclaredRelationship(d), deniedBy),
+			})
+		case constrained && !allowed:
+			violations = append(violations, RelationshipViolation{
+				DeclaredRelationship: d,
+				Message: fmt.Sprintf("%s is not allowed by any registered %s relationship, remove the relationship from the design or register a relationship allowing it",
+					describeDeclaredRelationship(d), d.Kind),
+			})
+		}
+	}
+	return violations
+}
+
+// reports whether one of the selectors matches the component, selectors of any model match the components of every model
+func selectorsMatch(sels []relationshipSelector, comp ComponentRef) bool {
+	for _, sel := range sels {
+		if (sel.model == anyModel || sel.model == comp.Model) && (sel.kind == AnyComponentKind || sel.kind == comp.Kind) {
+			return true
+		}
+	}
+	return false
+}
+
+func describeRelationship(rel v1alpha1.RelationshipDefinition) string {
+	name := rel.Kind
+	if rel.SubType != "" {
+		name += "/" + rel.SubType
+	}
+	return fmt.Sprintf("%s of model %s", name, rel.Model.Name)
+}
+
+func describeDeclaredRelationship(d DeclaredRelationship) string {
+	return fmt.Sprintf("%s relationship from %s %q (%s) to %s %q (%s)",
+		d.Kind, d.From.Kind, d.From.Name, d.From.Model, d.To.Kind, d.To.Name, d.To.Model)
+}
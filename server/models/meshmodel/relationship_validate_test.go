@@ -0,0 +1,104 @@
+package meshmodel
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+func TestDeclaredRelationships(t *testing.T) {
+	pattern := core.Pattern{
+		Services: map[string]*core.Service{
+			"ns":  {Name: "prod", Type: "Namespace", Model: "kubernetes", Traits: map[string]interface{}{"meshmap": map[string]interface{}{"id": "ns-id"}}},
+			"db":  {Name: "db", Type: "StatefulSet", Model: "kubernetes"},
+			"web": {Name: "web", Type: "Deployment", Model: "kubernetes", DependsOn: []string{"db", "missing"}, Traits: map[string]interface{}{"meshmap": map[string]interface{}{"parent": "ns-id"}}},
+		},
+	}
+	expected := []DeclaredRelationship{
+		{Kind: RelationshipKindEdge, From: ComponentRef{Name: "web", Kind: "Deployment", Model: "kubernetes"}, To: ComponentRef{Name: "db", Kind: "StatefulSet", Model: "kubernetes"}},
+		{Kind: RelationshipKindHierarchical, From: ComponentRef{Name: "web", Kind: "Deployment", Model: "kubernetes"}, To: ComponentRef{Name: "prod", Kind: "Namespace", Model: "kubernetes"}},
+	}
+	if got := DeclaredRelationships(pattern); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected declared relationships %v, got %v", expected, got)
+	}
+}
+
+func TestValidateDesignRelationships(t *testing.T) {
+	sel := func(kind, model string) interface{} {
+		return map[string]interface{}{"kind": kind, "model": model}
+	}
+	rels := []v1alpha1.RelationshipDefinition{
+		{
+			TypeMeta: v1alpha1.TypeMeta{Kind: "Hierarchical"},
+			Model:    v1alpha1.Model{Name: "kubernetes"},
+			SubType:  "parent",
+			Selectors: map[string]interface{}{
+				"allow": map[string]interface{}{
+					"from": []interface{}{sel("", "kubernetes")},
+					"to":   []interface{}{sel("Namespace", "kubernetes")},
+				},
+				"deny": map[string]interface{}{
+					"from": []interface{}{sel("Namespace", "kubernetes")},
+					"to":   []interface{}{sel("Namespace", "kubernetes")},
+				},
+			},
+		},
+	}
+	ref := func(name, kind, model string) ComponentRef {
+		return ComponentRef{Name: name, Kind: kind, Model: model}
+	}
+
+	tests := []struct {
+		name     string
+		declared DeclaredRelationship
+		deniedBy string
+		violates bool
+	}{
+		{
+			name:     "Relationships allowed by a registered relationship are valid",
+			declared: DeclaredRelationship{Kind: RelationshipKindHierarchical, From: ref("web", "Deployment", "kubernetes"), To: ref("prod", "Namespace", "kubernetes")},
+		},
+		{
+			name:     "Relationships matched by deny selectors are violations",
+			declared: DeclaredRelationship{Kind: RelationshipKindHierarchical, From: ref("dev", "Namespace", "kubernetes"), To: ref("prod", "Namespace", "kubernetes")},
+			deniedBy: "Hierarchical/parent of model kubernetes",
+			violates: true,
+		},
+		{
+			name:     "Relationships of constrained models not allowed by any relationship are violations",
+			declared: DeclaredRelationship{Kind: RelationshipKindHierarchical, From: ref("web", "Deployment", "kubernetes"), To: ref("db", "StatefulSet", "kubernetes")},
+			violates: true,
+		},
+		{
+			name:     "Relationships of kinds no relationship constrains are valid",
+			declared: DeclaredRelationship{Kind: RelationshipKindEdge, From: ref("web", "Deployment", "kubernetes"), To: ref("db", "StatefulSet", "kubernetes")},
+		},
+		{
+			name:     "Relationships of models no relationship refers to are valid",
+			declared: DeclaredRelationship{Kind: RelationshipKindHierarchical, From: ref("gw", "Gateway", "istio-base"), To: ref("vs", "VirtualService", "istio-base")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := ValidateDesignRelationships([]DeclaredRelationship{tt.declared}, rels)
+			if !tt.violates {
+				if len(violations) != 0 {
+					t.Errorf("expected no violations, got %v", violations)
+				}
+				return
+			}
+			if len(violations) != 1 {
+				t.Fatalf("expected 1 violation, got %v", violations)
+			}
+			if violations[0].DeniedBy != tt.deniedBy {
+				t.Errorf("expected the relationship to be denied by %q, got %q", tt.deniedBy, violations[0].DeniedBy)
+			}
+			if !strings.Contains(violations[0].Message, "remove the relationship from the design") {
+				t.Errorf("expected an actionable message, got %q", violations[0].Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package meshmodel
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/layer5io/meshery/server/helpers/utils"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	"github.com/layer5io/meshkit/models/meshmodel/registry"
+	"gorm.io/gorm"
+)
+
+// RelationshipVersionKey is the metadata key holding the version of a relationship definition.
+// The version starts at 1 and is bumped every time the definition is updated.
+const RelationshipVersionKey = "version"
+
+// ValidateRelationshipDefinition performs the sanity checks a relationship definition must pass before it is registered.
+// Definitions which do not conform to the relationship schema result in a *RelationshipValidationError listing every offending field.
+func ValidateRelationshipDefinition(rel v1alpha1.RelationshipDefinition) error {
+	var schemaErrs []RelationshipSchemaError
+	if rel.Kind == "" {
+		schemaErrs = append(schemaErrs, RelationshipSchemaError{Path: "/kind", Message: "relationship kind cannot be empty"})
+	}
+	if rel.Model.Name == "" {
+		schemaErrs = append(schemaErrs, RelationshipSchemaError{Path: "/model/name", Message: "relationship does not specify a model"})
+	}
+	errs, err := validateRelationshipSchema(rel)
+	if err != nil {
+		return err
+	}
+	schemaErrs = append(schemaErrs, errs...)
+	if len(schemaErrs) > 0 {
+		return &RelationshipValidationError{Kind: rel.Kind, Errors: schemaErrs}
+	}
+	return nil
+}
+
+// RegisterRelationshipsInTransaction registers all the given relationship definitions within a single database transaction.
+// If any of the definitions fails to register, none of them are persisted and the index of the failing definition is returned along with the error.
+func RegisterRelationshipsInTransaction(db *database.Handler, host registry.Host, rels []v1alpha1.RelationshipDefinition) (int, error) {
+	failedIdx := -1
+	err := db.Transaction(func(tx *gorm.DB) error {
+		rm, err := registry.NewRegistryManager(&database.Handler{DB: tx, Mutex: &sync.Mutex{}})
+		if err != nil {
+			return err
+		}
+		for i, rel := range rels {
+			if err := rm.RegisterEntity(host, rel); err != nil {
+				failedIdx = i
+				return err
+			}
+		}
+		return nil
+	})
+	return failedIdx, err
+}
+
+// DeleteRelationships removes the relationship definitions of the given kind registered under the given model,
+// along with their registry entries and revisions. When version is non empty, only the definitions of that model version are removed.
+// Only the definitions of the organization are removed, the global ones when orgID is empty, see OwnedByOrg.
+// Returns the number of relationship definitions removed.
+func DeleteRelationships(db *database.Handler, model, kind, version, orgID string) (int64, error) {
+	var ids []uuid.UUID
+	err := db.Transaction(func(tx *gorm.DB) error {
+		finder := tx.Model(&v1alpha1.RelationshipDefinitionDB{}).
+			Joins("JOIN model_dbs ON relationship_definition_dbs.model_id = model_dbs.id").
+			Where("model_dbs.name = ? AND relationship_definition_dbs.kind = ?", model, kind).
+			Scopes(OwnedByOrg(orgID))
+		if version != "" {
+			finder = finder.Where("model_dbs.version = ?", version)
+		}
+		if err := finder.Pluck("relationship_definition_dbs.id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := tx.Where("entity IN ?", ids).Delete(&registry.Registry{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("relationship_id IN ?", ids).Delete(&RelationshipRevision{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id IN ?", ids).Delete(&v1alpha1.RelationshipDefinitionDB{}).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(ids)), nil
+}
+
+// FindRelationship returns the relationship definition of the given kind registered under the given model.
+// version and subType are optional and narrow down the lookup when the same kind is registered more than once.
+// Only the definitions of the organization are looked up, the global ones when orgID is empty, see OwnedByOrg.
+// An error is returned when no definition or more than one definition matches.
+func FindRelationship(db *database.Handler, model, kind, version, subType, orgID string) (v1alpha1.RelationshipDefinitionDB, error) {
+	var rels []v1alpha1.RelationshipDefinitionDB
+	finder := db.Model(&v1alpha1.RelationshipDefinitionDB{}).
+		Select("relationship_definition_dbs.*").
+		Joins("JOIN model_dbs ON relationship_definition_dbs.model_id = model_dbs.id").
+		Where("model_dbs.name = ? AND relationship_definition_dbs.kind = ?", model, kind).
+		Scopes(OwnedByOrg(orgID))
+	if version != "" {
+		finder = finder.Where("model_dbs.version = ?", version)
+	}
+	if subType != "" {
+		finder = finder.Where("relationship_definition_dbs.sub_type = ?", subType)
+	}
+	if err := finder.Scan(&rels).Error; err != nil {
+		return v1alpha1.RelationshipDefinitionDB{}, err
+	}
+	switch len(rels) {
+	case 0:
+		return v1alpha1.RelationshipDefinitionDB{}, gorm.ErrRecordNotFound
+	case 1:
+		return rels[0], nil
+	default:
+		return v1alpha1.RelationshipDefinitionDB{}, fmt.Errorf("%d relationships of kind %s are registered for model %s, specify the model version and subType to select one", len(rels), kind, model)
+	}
+}
+
+// GetRelationshipWithModel converts the stored relationship into a RelationshipDefinition along with its model and category
+func GetRelationshipWithModel(db *database.Handler, rdb v1alpha1.RelationshipDefinitionDB) (v1alpha1.RelationshipDefinition, error) {
+	var mdb v1alpha1.ModelDB
+	if err := db.First(&mdb, "id = ?", rdb.ModelID).Error; err != nil {
+		return v1alpha1.RelationshipDefinition{}, err
+	}
+	var cdb v1alpha1.CategoryDB
+	_ = db.First(&cdb, "id = ?", mdb.CategoryID).Error
+	return rdb.GetRelationshipDefinition(mdb.GetModel(cdb.GetCategory(db))), nil
+}
+
+// UpdateRelationship applies the given changes onto the stored relationship definition and bumps its version.
+// The definition being replaced is kept as a RelationshipRevision.
+// When merge is true the changes are applied as a JSON merge patch, otherwise they replace the definition.
+// The model of a relationship cannot be changed through an update.
+func UpdateRelationship(db *database.Handler, rdb v1alpha1.RelationshipDefinitionDB, changes []byte, merge bool) (v1alpha1.RelationshipDefinition, error) {
+	existing, err := GetRelationshipWithModel(db, rdb)
+	if err != nil {
+		return v1alpha1.RelationshipDefinition{}, err
+	}
+
+	var patch map[string]interface{}
+	if err := json.Unmarshal(changes, &patch); err != nil {
+		return v1alpha1.RelationshipDefinition{}, err
+	}
+
+	current := map[string]interface{}{}
+	if merge {
+		current = utils.ToMapStringInterface(existing)
+	}
+	current = utils.MergePatch(current, patch)
+
+	byt, err := json.Marshal(current)
+	if err != nil {
+		return v1alpha1.RelationshipDefinition{}, err
+	}
+	var updated v1alpha1.RelationshipDefinition
+	if err := json.Unmarshal(byt, &updated); err != nil {
+		return v1alpha1.RelationshipDefinition{}, err
+	}
+	if updated.Model.Name != "" && updated.Model.Name != existing.Model.Name {
+		return v1alpha1.RelationshipDefinition{}, fmt.Errorf("model of relationship %s cannot be changed from %s to %s", existing.Kind, existing.Model.Name, updated.Model.Name)
+	}
+	updated.ID = existing.ID
+	updated.Model = existing.Model
+	if updated.Kind == "" {
+		updated.Kind = existing.Kind
+	}
+	if updated.Metadata == nil {
+		updated.Metadata = map[string]interface{}{}
+	}
+	updated.Metadata[RelationshipVersionKey] = RelationshipVersion(existing) + 1
+	// the provenance and the organization of a relationship are not changed by updates
+	if source := RelationshipSource(existing.Metadata); source != "" {
+		updated.Metadata[RelationshipSourceKey] = source
+	}
+	if orgID := RelationshipOrg(existing.Metadata); orgID != "" {
+		updated.Metadata[RelationshipOrgKey] = orgID
+	} else {
+		delete(updated.Metadata, RelationshipOrgKey)
+	}
+	if err := ValidateRelationshipDefinition(updated); err != nil {
+		return v1alpha1.RelationshipDefinition{}, err
+	}
+
+	urdb := updated.GetRelationshipDefinitionDB()
+	urdb.ModelID = rdb.ModelID
+	urdb.CreatedAt = rdb.CreatedAt
+	urdb.UpdatedAt = time.Now()
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := recordRelationshipRevision(tx, existing); err != nil {
+			return err
+		}
+		return tx.Save(&urdb).Error
+	})
+	if err != nil {
+		return v1alpha1.RelationshipDefinition{}, err
+	}
+	return updated, nil
+}
+
+// RelationshipVersion returns the version recorded in the relationship metadata, relationships without one are at version 1
+func RelationshipVersion(rel v1alpha1.RelationshipDefinition) int {
+	switch v := rel.Metadata[RelationshipVersionKey].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	case string:
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+	}
+	return 1
+}
+
+// RelationshipSelectorHash returns a hash of the selectors of the relationship which is independent of the ordering of their keys
+func RelationshipSelectorHash(selectors map[string]interface{}) string {
+	// encoding/json sorts map keys, so equal selectors always encode identically
+	byt, _ := json.Marshal(selectors)
+	sum := sha256.Sum256(byt)
+	return hex.EncodeToString(sum[:])
+}
+
+// FindDuplicateRelationship returns the registered relationship which has the same model, model version, kind, subType, selectors
+// and organization as the given relationship, nil is returned when there is none.
+func FindDuplicateRelationship(db *database.Handler, rel v1alpha1.RelationshipDefinition) (*v1alpha1.RelationshipDefinition, error) {
+	var candidates []v1alpha1.RelationshipDefinitionDB
+	err := db.Model(&v1alpha1.RelationshipDefinitionDB{}).
+		Select("relationship_definition_dbs.*").
+		Joins("JOIN model_dbs ON relationship_definition_dbs.model_id = model_dbs.id").
+		Where("model_dbs.name = ? AND model_dbs.version = ? AND relationship_definition_dbs.kind = ? AND relationship_definition_dbs.sub_type = ?",
+			rel.Model.Name, rel.Model.Version, rel.Kind, rel.SubType).
+		Scopes(OwnedByOrg(RelationshipOrg(rel.Metadata))).
+		Scan(&candidates).Error
+	if err != nil {
+		return nil, err
+	}
+
+	hash := RelationshipSelectorHash(rel.Selectors)
+	for _, candidate := range candidates {
+		var selectors map[string]interface{}
+		_ = json.Unmarshal(candidate.Selectors, &selectors)
+		if RelationshipSelectorHash(selectors) != hash {
+			continue
+		}
+		existing, err := GetRelationshipWithModel(db, candidate)
+		if err != nil {
+			return nil, err
+		}
+		return &existing, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,112 @@
+package meshmodel
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	"github.com/layer5io/meshkit/models/meshmodel/registry"
+)
+
+// registryDB returns a SQLite registry along with its manager
+func registryDB(t *testing.T) (*database.Handler, *registry.RegistryManager) {
+	t.Helper()
+	db, err := database.New(database.Options{Filename: filepath.Join(t.TempDir(), "mesherydb.sql"), Engine: database.SQLITE})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rm, err := registry.NewRegistryManager(&db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&RelationshipRevision{}); err != nil {
+		t.Fatal(err)
+	}
+	return &db, rm
+}
+
+// testRelationship returns an edge relationship of kubernetes scoped to the organization, global when orgID is empty
+func testRelationship(subType, orgID string, selectors map[string]interface{}) v1alpha1.RelationshipDefinition {
+	rel := v1alpha1.RelationshipDefinition{
+		TypeMeta:  v1alpha1.TypeMeta{Kind: "Edge"},
+		Model:     v1alpha1.Model{Name: "kubernetes", Version: "v1.25.2", Category: v1alpha1.Category{Name: "Orchestration & Management"}},
+		SubType:   subType,
+		Selectors: selectors,
+		Metadata:  map[string]interface{}{},
+	}
+	SetRelationshipOrg(&rel, orgID)
+	return rel
+}
+
+func registerRelationships(t *testing.T, rm *registry.RegistryManager, host registry.Host, rels ...v1alpha1.RelationshipDefinition) {
+	t.Helper()
+	for _, rel := range rels {
+		if err := rm.RegisterEntity(host, rel); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestFindDuplicateRelationship(t *testing.T) {
+	db, rm := registryDB(t)
+	service := map[string]interface{}{"kind": "Service", "model": "kubernetes"}
+	pod := map[string]interface{}{"kind": "Pod", "model": "kubernetes"}
+	selectors := map[string]interface{}{"allow": map[string]interface{}{"from": []interface{}{service}, "to": []interface{}{pod}}}
+	reordered := map[string]interface{}{"allow": map[string]interface{}{"to": []interface{}{pod}, "from": []interface{}{service}}}
+	registerRelationships(t, rm, registry.Host{Hostname: "kubernetes"}, testRelationship("Network", "org-a", selectors), testRelationship("Network", "", selectors))
+
+	tests := []struct {
+		name      string
+		rel       v1alpha1.RelationshipDefinition
+		duplicate bool
+	}{
+		{"same definition in the same organization", testRelationship("Network", "org-a", reordered), true},
+		{"same global definition", testRelationship("Network", "", selectors), true},
+		{"same definition in another organization", testRelationship("Network", "org-b", selectors), false},
+		{"other selectors", testRelationship("Network", "org-a", map[string]interface{}{"allow": map[string]interface{}{}}), false},
+		{"other subType", testRelationship("Mount", "org-a", selectors), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			existing, err := FindDuplicateRelationship(db, tt.rel)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (existing != nil) != tt.duplicate {
+				t.Fatalf("FindDuplicateRelationship() = %+v, want a duplicate = %t", existing, tt.duplicate)
+			}
+			if existing != nil && RelationshipOrg(existing.Metadata) != RelationshipOrg(tt.rel.Metadata) {
+				t.Errorf("the duplicate found is scoped to %q, want %q", RelationshipOrg(existing.Metadata), RelationshipOrg(tt.rel.Metadata))
+			}
+		})
+	}
+}
+
+func TestFindAndDeleteRelationshipsOfOrg(t *testing.T) {
+	db, rm := registryDB(t)
+	registerRelationships(t, rm, registry.Host{Hostname: "kubernetes"}, testRelationship("Network", "org-a", nil), testRelationship("Network", "org-b", nil))
+
+	if _, err := FindRelationship(db, "kubernetes", "Edge", "", "", "org-c"); err == nil {
+		t.Error("the relationships of other organizations were found for org-c")
+	}
+	rel, err := FindRelationship(db, "kubernetes", "Edge", "v1.25.2", "Network", "org-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated, err := UpdateRelationship(db, rel, []byte(`{"subType": "Mount", "metadata": {"orgID": "org-b"}}`), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if RelationshipOrg(updated.Metadata) != "org-a" || RelationshipVersion(updated) != 2 {
+		t.Errorf("the updated relationship is scoped to %q at version %d, want org-a at version 2", RelationshipOrg(updated.Metadata), RelationshipVersion(updated))
+	}
+
+	deleted, err := DeleteRelationships(db, "kubernetes", "Edge", "", "org-b")
+	if err != nil || deleted != 1 {
+		t.Fatalf("DeleteRelationships() = %d, %v, want the relationship of org-b only", deleted, err)
+	}
+	if _, err := FindRelationship(db, "kubernetes", "Edge", "", "", "org-a"); err != nil {
+		t.Errorf("the relationship of org-a was deleted along with the one of org-b: %v", err)
+	}
+}
@@ -0,0 +1,242 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/models/events"
+)
+
+// NotificationChannelType is the kind of the destinations of the notifications
+type NotificationChannelType string
+
+// Types of the notification channels
+const (
+	// SlackChannel posts the notifications to the incoming webhook of a Slack channel
+	SlackChannel NotificationChannelType = "slack"
+	// TeamsChannel posts the notifications to the incoming webhook of a Microsoft Teams channel
+	TeamsChannel NotificationChannelType = "teams"
+	// EmailChannel mails the notifications through the SMTP server of NOTIFICATION_SMTP_HOST
+	EmailChannel NotificationChannelType = "email"
+)
+
+// EventWorkspaceKey is the key of the metadata of the events holding the workspace they happened in
+const EventWorkspaceKey = "workspace"
+
+// DefaultNotificationTemplate is the template of the notifications of the rules having none
+const DefaultNotificationTemplate = `[{{ upper .Severity }}] {{ .Category }} {{ .Action }}: {{ .Description }}`
+
+// severityLevels orders the severities of the events, the notifications of a rule are sent for the events at least as
+// severe as its minimum severity
+var severityLevels = map[events.EventSeverity]int{
+	events.Debug:         0,
+	events.Informational: 1,
+	events.Success:       1,
+	events.Warning:       2,
+	events.Error:         3,
+	events.Critical:      4,
+	events.Alert:         5,
+	events.Emergency:     6,
+}
+
+var notificationTemplateFuncs = template.FuncMap{
+	"upper": func(v interface{}) string { return strings.ToUpper(fmt.Sprint(v)) },
+}
+
+// NotificationChannel is a destination of the notifications of a user: a Slack or a Teams channel, or email recipients
+type NotificationChannel struct {
+	ID     uuid.UUID               `json:"id" gorm:"primaryKey"`
+	UserID string                  `json:"user_id" gorm:"index"`
+	Name   string                  `json:"name"`
+	Type   NotificationChannelType `json:"type"`
+	// Target is the URL of the incoming webhook of Slack and Teams channels, which is a credential, and the comma
+	// separated addresses of the recipients of email channels
+	Target    string    `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NotificationChannelRequestBody is the body of the requests creating or updating a notification channel
+type NotificationChannelRequestBody struct {
+	Name   string                  `json:"name"`
+	Type   NotificationChannelType `json:"type"`
+	Target string                  `json:"target"`
+}
+
+// NotificationRule routes the events of a user, in a workspace or in every workspace, at least as severe as its minimum
+// severity and of its categories and actions, to a notification channel
+type NotificationRule struct {
+	ID        uuid.UUID `json:"id" gorm:"primaryKey"`
+	UserID    string    `json:"user_id" gorm:"index"`
+	ChannelID uuid.UUID `json:"channel_id" gorm:"index"`
+	// Workspace is the workspace of the events routed, empty for the events of every workspace
+	Workspace   string               `json:"workspace"`
+	MinSeverity events.EventSeverity `json:"min_severity"`
+	// Categories are the categories of the events routed, eg: pattern, empty for every category
+	Categories []string `json:"categories" gorm:"type:bytes;serializer:json"`
+	// Actions are the actions of the events routed, eg: deploy or drift, empty for every action
+	Actions []string `json:"actions" gorm:"type:bytes;serializer:json"`
+	// Template is the text/template of the messages, executed with the event, DefaultNotificationTemplate when empty
+	Template  string    `json:"template"`
+	Enabled   bool      `json:"enabled"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NotificationRuleRequestBody is the body of the requests creating or updating a notification rule
+type NotificationRuleRequestBody struct {
+	ChannelID   uuid.UUID            `json:"channel_id"`
+	Workspace   string               `json:"workspace"`
+	MinSeverity events.EventSeverity `json:"min_severity"`
+	Categories  []string             `json:"categories"`
+	Actions     []string             `json:"actions"`
+	Template    string               `json:"template"`
+	// Enabled is true when unset
+	Enabled *bool `json:"enabled"`
+}
+
+// NotificationMessage is a notification rendered for a channel
+type NotificationMessage struct {
+	Subject  string
+	Text     string
+	Severity events.EventSeverity
+}
+
+// Apply validates the body and sets the fields of the channel it holds
+func (c *NotificationChannel) Apply(body NotificationChannelRequestBody) error {
+	if strings.TrimSpace(body.Name) == "" {
+		return ErrInvalidNotification("name is required")
+	}
+	target := strings.TrimSpace(body.Target)
+	switch body.Type {
+	case SlackChannel, TeamsChannel:
+		u, err := url.Parse(target)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return ErrInvalidNotification(fmt.Sprintf("the target of a %s channel is the https URL of an incoming webhook", body.Type))
+		}
+		target = u.String()
+	case EmailChannel:
+		addresses, err := mail.ParseAddressList(target)
+		if err != nil || len(addresses) == 0 {
+			return ErrInvalidNotification(fmt.Sprintf("the target of an email channel is a comma separated list of addresses: %v", err))
+		}
+		recipients := make([]string, 0, len(addresses))
+		for _, a := range addresses {
+			recipients = append(recipients, a.Address)
+		}
+		target = strings.Join(recipients, ",")
+	default:
+		return ErrInvalidNotification(fmt.Sprintf("%q is not a channel type, the channel types are slack, teams and email", body.Type))
+	}
+	c.Name = strings.TrimSpace(body.Name)
+	c.Type = body.Type
+	c.Target = target
+	return nil
+}
+
+// Recipients returns the addresses of the recipients of an email channel
+func (c *NotificationChannel) Recipients() []string {
+	return strings.Split(c.Target, ",")
+}
+
+// MarshalJSON adds the target of the channel to its JSON, the path of the URL of the incoming webhooks, which authorizes
+// posting to the channel, being left out
+func (c NotificationChannel) MarshalJSON() ([]byte, error) {
+	type channel NotificationChannel
+	target := c.Target
+	if c.Type != EmailChannel {
+		if u, err := url.Parse(c.Target); err == nil {
+			target = u.Scheme + "://" + u.Host + "/..."
+		}
+	}
+	return json.Marshal(struct {
+		channel
+		Target string `json:"target"`
+	}{channel(c), target})
+}
+
+// Apply validates the body and sets the fields of the rule it holds, the channel is checked to be one of the user by the caller
+func (r *NotificationRule) Apply(body NotificationRuleRequestBody) error {
+	if body.ChannelID == uuid.Nil {
+		return ErrInvalidNotification("channel_id is required")
+	}
+	if body.MinSeverity == "" {
+		body.MinSeverity = events.Error
+	}
+	if _, ok := severityLevels[body.MinSeverity]; !ok {
+		return ErrInvalidNotification(fmt.Sprintf("%q is not a severity", body.MinSeverity))
+	}
+	if _, err := parseNotificationTemplate(body.Template); err != nil {
+		return ErrInvalidNotification(fmt.Sprintf("invalid template: %v", err))
+	}
+	r.ChannelID = body.ChannelID
+	r.Workspace = body.Workspace
+	r.MinSeverity = body.MinSeverity
+	r.Categories = body.Categories
+	r.Actions = body.Actions
+	r.Template = body.Template
+	if body.Enabled != nil {
+		r.Enabled = *body.Enabled
+	}
+	return nil
+}
+
+// Matches tells whether the event is routed by the rule
+func (r *NotificationRule) Matches(event *events.Event) bool {
+	if !r.Enabled || severityLevels[event.Severity] < severityLevels[r.MinSeverity] {
+		return false
+	}
+	if r.Workspace != "" && r.Workspace != EventWorkspace(event) {
+		return false
+	}
+	return matchesAny(r.Categories, event.Category) && matchesAny(r.Actions, event.Action)
+}
+
+// Render returns the message of the event rendered with the template of the rule
+func (r *NotificationRule) Render(event *events.Event) (NotificationMessage, error) {
+	tmpl, err := parseNotificationTemplate(r.Template)
+	if err != nil {
+		return NotificationMessage{}, err
+	}
+	var text bytes.Buffer
+	if err := tmpl.Execute(&text, event); err != nil {
+		return NotificationMessage{}, err
+	}
+	return NotificationMessage{
+		Subject:  fmt.Sprintf("Meshery: %s %s %s", strings.ToUpper(string(event.Severity)), event.Category, event.Action),
+		Text:     text.String(),
+		Severity: event.Severity,
+	}, nil
+}
+
+// EventWorkspace returns the workspace the event happened in, empty when it is not known
+func EventWorkspace(event *events.Event) string {
+	workspace, _ := event.Metadata[EventWorkspaceKey].(string)
+	return workspace
+}
+
+func parseNotificationTemplate(text string) (*template.Template, error) {
+	if strings.TrimSpace(text) == "" {
+		text = DefaultNotificationTemplate
+	}
+	return template.New("notification").Funcs(notificationTemplateFuncs).Option("missingkey=zero").Parse(text)
+}
+
+func matchesAny(values []string, value string) bool {
+	if len(values) == 0 {
+		return true
+	}
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+package models
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// NotificationPersister is the persister for the notification channels and rules
+type NotificationPersister struct {
+	DB *database.Handler
+}
+
+// SaveNotificationChannel stores the channel, generating its ID if it has none
+func (np *NotificationPersister) SaveNotificationChannel(channel *NotificationChannel) error {
+	if channel.ID == uuid.Nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+		channel.ID = id
+	}
+	return np.DB.Save(channel).Error
+}
+
+// GetNotificationChannels returns the channels of the user, most recent first
+func (np *NotificationPersister) GetNotificationChannels(userID string) ([]NotificationChannel, error) {
+	channels := []NotificationChannel{}
+	if err := np.DB.Where("user_id = ?", userID).Order("created_at desc").Find(&channels).Error; err != nil {
+		return nil, err
+	}
+	return channels, nil
+}
+
+// GetNotificationChannel returns the channel of the user with the id, or nil if there is none
+func (np *NotificationPersister) GetNotificationChannel(id uuid.UUID, userID string) (*NotificationChannel, error) {
+	var channels []NotificationChannel
+	if err := np.DB.Where("id = ? AND user_id = ?", id, userID).Limit(1).Find(&channels).Error; err != nil {
+		return nil, err
+	}
+	if len(channels) == 0 {
+		return nil, nil
+	}
+	return &channels[0], nil
+}
+
+// DeleteNotificationChannel deletes the channel of the user along with the rules routing to it, and returns whether the
+// user had such a channel
+func (np *NotificationPersister) DeleteNotificationChannel(id uuid.UUID, userID string) (bool, error) {
+	result := np.DB.Where("id = ? AND user_id = ?", id, userID).Delete(&NotificationChannel{})
+	if result.Error != nil || result.RowsAffected == 0 {
+		return false, result.Error
+	}
+	return true, np.DB.Where("channel_id = ?", id).Delete(&NotificationRule{}).Error
+}
+
+// SaveNotificationRule stores the rule, generating its ID if it has none
+func (np *NotificationPersister) SaveNotificationRule(rule *NotificationRule) error {
+	if rule.ID == uuid.Nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+		rule.ID = id
+	}
+	return np.DB.Save(rule).Error
+}
+
+// GetNotificationRules returns the rules of the user, of the workspace only unless it is empty, most recent first
+func (np *NotificationPersister) GetNotificationRules(userID, workspace string) ([]NotificationRule, error) {
+	query := np.DB.Where("user_id = ?", userID)
+	if workspace != "" {
+		query = query.Where("workspace = ?", workspace)
+	}
+	rules := []NotificationRule{}
+	if err := query.Order("created_at desc").Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// GetNotificationRule returns the rule of the user with the id, or nil if there is none
+func (np *NotificationPersister) GetNotificationRule(id uuid.UUID, userID string) (*NotificationRule, error) {
+	var rules []NotificationRule
+	if err := np.DB.Where("id = ? AND user_id = ?", id, userID).Limit(1).Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	return &rules[0], nil
+}
+
+// GetEnabledNotificationRules returns the enabled rules of the user, oldest first
+func (np *NotificationPersister) GetEnabledNotificationRules(userID string) ([]NotificationRule, error) {
+	rules := []NotificationRule{}
+	err := np.DB.Where("user_id = ? AND enabled = ?", userID, true).Order("created_at asc").Find(&rules).Error
+	return rules, err
+}
+
+// GetNotificationChannelByID returns the channel with the id, or nil if there is none
+func (np *NotificationPersister) GetNotificationChannelByID(id uuid.UUID) (*NotificationChannel, error) {
+	var channels []NotificationChannel
+	if err := np.DB.Where("id = ?", id).Limit(1).Find(&channels).Error; err != nil {
+		return nil, err
+	}
+	if len(channels) == 0 {
+		return nil, nil
+	}
+	return &channels[0], nil
+}
+
+// DeleteNotificationRule deletes the rule of the user, and returns whether the user had such a rule
+func (np *NotificationPersister) DeleteNotificationRule(id uuid.UUID, userID string) (bool, error) {
+	result := np.DB.Where("id = ? AND user_id = ?", id, userID).Delete(&NotificationRule{})
+	return result.RowsAffected > 0, result.Error
+}
@@ -0,0 +1,155 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/models/events"
+)
+
+func TestNotificationChannelApply(t *testing.T) {
+	var channel NotificationChannel
+	if err := channel.Apply(NotificationChannelRequestBody{Name: "oncall", Type: EmailChannel, Target: "Ops <ops@example.com>, sre@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := channel.Recipients(); len(got) != 2 || got[0] != "ops@example.com" || got[1] != "sre@example.com" {
+		t.Errorf("Recipients() = %v", got)
+	}
+
+	invalid := []NotificationChannelRequestBody{
+		{Type: SlackChannel, Target: "https://hooks.slack.com/services/T0/B0/x"},
+		{Name: "oncall", Type: SlackChannel, Target: "http://hooks.slack.com/services/T0/B0/x"},
+		{Name: "oncall", Type: EmailChannel, Target: "not an address"},
+		{Name: "oncall", Type: "pager", Target: "https://example.com"},
+	}
+	for _, body := range invalid {
+		if err := (&NotificationChannel{}).Apply(body); err == nil {
+			t.Errorf("Apply(%+v) succeeded", body)
+		}
+	}
+}
+
+func TestNotificationChannelJSON(t *testing.T) {
+	channel := NotificationChannel{Name: "oncall", Type: SlackChannel, Target: "https://hooks.slack.com/services/T0/B0/secret"}
+	b, err := json.Marshal(channel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "secret") || !strings.Contains(string(b), `"target":"https://hooks.slack.com/..."`) {
+		t.Errorf("the JSON of the channel is %s", b)
+	}
+}
+
+func TestNotificationRuleMatches(t *testing.T) {
+	var rule NotificationRule
+	if err := rule.Apply(NotificationRuleRequestBody{ChannelID: uuid.Must(uuid.NewV4()), Workspace: "production", Categories: []string{"pattern"}}); err != nil {
+		t.Fatal(err)
+	}
+	rule.Enabled = true
+	if rule.MinSeverity != events.Error {
+		t.Errorf("the minimum severity is %s, want error by default", rule.MinSeverity)
+	}
+
+	event := func(severity events.EventSeverity, category, workspace string) *events.Event {
+		return events.NewEvent().WithSeverity(severity).WithCategory(category).WithAction("deploy").
+			WithMetadata(map[string]interface{}{EventWorkspaceKey: workspace}).Build()
+	}
+	tests := []struct {
+		event *events.Event
+		want  bool
+	}{
+		{event(events.Error, "pattern", "production"), true},
+		{event(events.Critical, "Pattern", "production"), true},
+		{event(events.Warning, "pattern", "production"), false},
+		{event(events.Error, "connection", "production"), false},
+		{event(events.Error, "pattern", "staging"), false},
+	}
+	for _, tt := range tests {
+		if got := rule.Matches(tt.event); got != tt.want {
+			t.Errorf("Matches(%s %s in %s) = %t, want %t", tt.event.Severity, tt.event.Category, EventWorkspace(tt.event), got, tt.want)
+		}
+	}
+	rule.Enabled = false
+	if rule.Matches(tests[0].event) {
+		t.Error("a disabled rule matches")
+	}
+
+	if err := (&NotificationRule{}).Apply(NotificationRuleRequestBody{ChannelID: rule.ChannelID, MinSeverity: "fatal"}); err == nil {
+		t.Error("Apply() succeeded with an unknown severity")
+	}
+	if err := (&NotificationRule{}).Apply(NotificationRuleRequestBody{ChannelID: rule.ChannelID, Template: "{{ .Description"}); err == nil {
+		t.Error("Apply() succeeded with an invalid template")
+	}
+}
+
+func TestNotificationRuleRender(t *testing.T) {
+	event := events.NewEvent().WithSeverity(events.Error).WithCategory("pattern").WithAction("deploy").
+		WithDescription("deploy of design 'bookinfo' failed").Build()
+	message, err := (&NotificationRule{}).Render(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "[ERROR] pattern deploy: deploy of design 'bookinfo' failed"; message.Text != want {
+		t.Errorf("the default message is %q, want %q", message.Text, want)
+	}
+	message, err = (&NotificationRule{Template: "{{ .Description }} ({{ .Severity }})"}).Render(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "deploy of design 'bookinfo' failed (error)"; message.Text != want {
+		t.Errorf("the message is %q, want %q", message.Text, want)
+	}
+}
+
+func TestNotifierSend(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+	}))
+	defer server.Close()
+
+	n := NewNotifier(nil, nil, NotifierOptions{SMTP: SMTPConfig{Host: "smtp.example.com", From: "meshery@example.com"}})
+	message := NotificationMessage{Subject: "Meshery: ERROR pattern deploy", Text: "deploy failed", Severity: events.Error}
+	if err := n.Send(context.Background(), &NotificationChannel{Type: SlackChannel, Target: server.URL}, message); err != nil {
+		t.Fatal(err)
+	}
+	if body["text"] != "deploy failed" {
+		t.Errorf("slack received %v", body)
+	}
+	if err := n.Send(context.Background(), &NotificationChannel{Type: TeamsChannel, Target: server.URL}, message); err != nil {
+		t.Fatal(err)
+	}
+	if body["@type"] != "MessageCard" || body["title"] != message.Subject || body["themeColor"] != "D13438" {
+		t.Errorf("teams received %v", body)
+	}
+
+	var addr string
+	var to []string
+	var mail []byte
+	n.sendMail = func(a string, _ smtp.Auth, _ string, recipients []string, msg []byte) error {
+		addr, to, mail = a, recipients, msg
+		return nil
+	}
+	if err := n.Send(context.Background(), &NotificationChannel{Type: EmailChannel, Target: "ops@example.com,sre@example.com"}, message); err != nil {
+		t.Fatal(err)
+	}
+	if addr != "smtp.example.com:587" || len(to) != 2 || !strings.Contains(string(mail), "Subject: Meshery: ERROR pattern deploy\r\n") {
+		t.Errorf("the mail sent to %s through %s is %q", to, addr, mail)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer failing.Close()
+	if err := n.Send(context.Background(), &NotificationChannel{Type: SlackChannel, Target: failing.URL}, message); err == nil {
+		t.Error("Send() succeeded with a revoked incoming webhook")
+	}
+}
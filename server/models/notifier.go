@@ -0,0 +1,215 @@
+package models
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/internal/tracing"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+	"github.com/layer5io/meshkit/models/events"
+)
+
+// SMTPConfig is the SMTP server the notifications of the email channels are sent through
+type SMTPConfig struct {
+	Host string
+	Port int
+	// Username and Password authenticate with PLAIN when Username is set
+	Username string
+	Password string
+	From     string
+}
+
+// NotifierOptions configures the sending of the notifications
+type NotifierOptions struct {
+	SMTP SMTPConfig
+	// Timeout bounds the posts to the incoming webhooks, 10s by default
+	Timeout time.Duration
+}
+
+type notifiedEvent struct {
+	userID string
+	event  *events.Event
+}
+
+// Notifier sends the events of the users to their notification channels, according to their notification rules.
+// The notifications are sent once, the failed ones are logged.
+type Notifier struct {
+	persister *NotificationPersister
+	client    *http.Client
+	log       logger.Handler
+	smtp      SMTPConfig
+	sendMail  func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+
+	events chan notifiedEvent
+}
+
+// NewNotifier returns the notifier of the channels and rules of the database, Run has to be called for the events to be sent
+func NewNotifier(db *database.Handler, log logger.Handler, options NotifierOptions) *Notifier {
+	if options.Timeout <= 0 {
+		options.Timeout = 10 * time.Second
+	}
+	if options.SMTP.Port == 0 {
+		options.SMTP.Port = 587
+	}
+	return &Notifier{
+		persister: &NotificationPersister{DB: db},
+		client:    &http.Client{Timeout: options.Timeout, Transport: tracing.Transport(nil)},
+		log:       log,
+		smtp:      options.SMTP,
+		sendMail:  smtp.SendMail,
+		events:    make(chan notifiedEvent, 256),
+	}
+}
+
+// NotifyEvent queues the events published to the user for the notification rules of the user, it is meant to be
+// registered with Broadcast.OnPublish. NotifyEvent never blocks, the events are dropped when the queue is full.
+func (n *Notifier) NotifyEvent(userID uuid.UUID, data interface{}) {
+	event, ok := data.(*events.Event)
+	if !ok || userID == uuid.Nil {
+		return
+	}
+	select {
+	case n.events <- notifiedEvent{userID: userID.String(), event: event}:
+	default:
+		n.log.Warn(fmt.Errorf("the notification of the event %s was dropped, the queue of the notifications is full", event.ID))
+	}
+}
+
+// Run sends the notifications of the events queued, until ctx is done
+func (n *Notifier) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e := <-n.events:
+			n.route(ctx, e)
+		}
+	}
+}
+
+// route sends the event to the channels of the rules of the user matching it, once per channel, with the template of
+// the oldest rule matching it
+func (n *Notifier) route(ctx context.Context, e notifiedEvent) {
+	rules, err := n.persister.GetEnabledNotificationRules(e.userID)
+	if err != nil {
+		n.log.Error(ErrNotificationDelivery(err))
+		return
+	}
+	notified := map[uuid.UUID]bool{}
+	for i := range rules {
+		rule := &rules[i]
+		if notified[rule.ChannelID] || !rule.Matches(e.event) {
+			continue
+		}
+		notified[rule.ChannelID] = true
+		channel, err := n.persister.GetNotificationChannelByID(rule.ChannelID)
+		if err != nil || channel == nil {
+			if err != nil {
+				n.log.Error(ErrNotificationDelivery(err))
+			}
+			continue
+		}
+		message, err := rule.Render(e.event)
+		if err != nil {
+			n.log.Error(ErrNotificationDelivery(fmt.Errorf("rendering the template of the notification rule %s: %w", rule.ID, err)))
+			continue
+		}
+		if err := n.Send(ctx, channel, message); err != nil {
+			n.log.Error(err)
+		}
+	}
+}
+
+// Send sends the message to the channel
+func (n *Notifier) Send(ctx context.Context, channel *NotificationChannel, message NotificationMessage) error {
+	var err error
+	switch channel.Type {
+	case SlackChannel:
+		err = n.post(ctx, channel.Target, map[string]interface{}{"text": message.Text})
+	case TeamsChannel:
+		err = n.post(ctx, channel.Target, map[string]interface{}{
+			"@type":      "MessageCard",
+			"@context":   "https://schema.org/extensions",
+			"summary":    message.Subject,
+			"title":      message.Subject,
+			"text":       message.Text,
+			"themeColor": severityColor(message.Severity),
+		})
+	case EmailChannel:
+		err = n.mail(channel.Recipients(), message)
+	default:
+		err = fmt.Errorf("%q is not a channel type", channel.Type)
+	}
+	if err != nil {
+		return ErrNotificationDelivery(fmt.Errorf("sending to the channel %s: %w", channel.Name, err))
+	}
+	return nil
+}
+
+// post posts the payload to the incoming webhook
+func (n *Notifier) post(ctx context.Context, url string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer SafeClose(resp.Body)
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("the incoming webhook responded %s", resp.Status)
+	}
+	return nil
+}
+
+// mail sends the message to the recipients through the SMTP server
+func (n *Notifier) mail(to []string, message NotificationMessage) error {
+	if n.smtp.Host == "" || n.smtp.From == "" {
+		return fmt.Errorf("no SMTP server is configured, set NOTIFICATION_SMTP_HOST and NOTIFICATION_SMTP_FROM")
+	}
+	var auth smtp.Auth
+	if n.smtp.Username != "" {
+		auth = smtp.PlainAuth("", n.smtp.Username, n.smtp.Password, n.smtp.Host)
+	}
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", n.smtp.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", strings.NewReplacer("\r", " ", "\n", " ").Replace(message.Subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(message.Text, "\n", "\r\n"))
+	msg.WriteString("\r\n")
+	addr := net.JoinHostPort(n.smtp.Host, strconv.Itoa(n.smtp.Port))
+	return n.sendMail(addr, auth, n.smtp.From, to, msg.Bytes())
+}
+
+// severityColor returns the color of the cards of the notifications of the severity
+func severityColor(severity events.EventSeverity) string {
+	switch level := severityLevels[severity]; {
+	case severity == events.Success:
+		return "2EB67D"
+	case level >= severityLevels[events.Error]:
+		return "D13438"
+	case level == severityLevels[events.Warning]:
+		return "F2C744"
+	}
+	return "00B39F"
+}
@@ -0,0 +1,484 @@
+package models
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang-jwt/jwt"
+	"github.com/layer5io/meshery/server/internal/tracing"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// oidcStateCookie holds the state of a sign in, from the redirect to the issuer to the callback
+	oidcStateCookie = "meshery-oidc-state"
+	oidcStateTTL    = 10 * time.Minute
+	// oidcSessionIssuer is the issuer of the sessions signed by Meshery Server
+	oidcSessionIssuer = "meshery"
+)
+
+// OIDCConfig configures the sign in of the users of the local provider with an OpenID Connect issuer, eg: Dex, Keycloak or Okta
+type OIDCConfig struct {
+	// Issuer is the URL of the issuer, its configuration is discovered at /.well-known/openid-configuration
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is the callback of Meshery Server registered with the issuer, eg: https://meshery.example.com/api/user/token.
+	// The callback URL of the requests is used when empty.
+	RedirectURL string
+	// Scopes are requested along with the openid scope, eg: profile, email and groups
+	Scopes []string
+	// GroupsClaim is the claim of the ID tokens listing the groups of the users, groups by default
+	GroupsClaim string
+	// GroupRoles are the roles of the members of the groups
+	GroupRoles map[string]Role
+	// SessionTTL is the lifetime of the sessions, a day by default
+	SessionTTL time.Duration
+	// SessionSecret signs the sessions. A random secret is generated when empty, the sessions then end when Meshery Server restarts.
+	SessionSecret string
+}
+
+// oidcDiscovery is the configuration of an issuer
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// OIDCAuthenticator signs the users in with an OpenID Connect issuer, with the authorization code flow, and keeps their
+// sessions in signed cookies
+type OIDCAuthenticator struct {
+	config OIDCConfig
+	secret []byte
+	client *http.Client
+
+	mu        sync.Mutex
+	discovery *oidcDiscovery
+	keys      map[string]*rsa.PublicKey
+}
+
+// NewOIDCAuthenticator returns the authenticator of the configuration, the issuer is discovered at the first sign in
+func NewOIDCAuthenticator(config OIDCConfig) (*OIDCAuthenticator, error) {
+	config.Issuer = strings.TrimSuffix(config.Issuer, "/")
+	if config.Issuer == "" || config.ClientID == "" {
+		return nil, ErrOIDCConfig(fmt.Errorf("the issuer and the client ID are required"))
+	}
+	if config.GroupsClaim == "" {
+		config.GroupsClaim = "groups"
+	}
+	if config.SessionTTL <= 0 {
+		config.SessionTTL = 24 * time.Hour
+	}
+	secret := []byte(config.SessionSecret)
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, ErrOIDCConfig(err)
+		}
+	}
+	return &OIDCAuthenticator{
+		config: config,
+		secret: secret,
+		client: &http.Client{Timeout: 30 * time.Second, Transport: tracing.Transport(nil)},
+		keys:   map[string]*rsa.PublicKey{},
+	}, nil
+}
+
+// ParseOIDCGroupRoles parses the roles of the groups, eg: platform-admins=admin,developers=operator
+func ParseOIDCGroupRoles(s string) (map[string]Role, error) {
+	groupRoles := map[string]Role{}
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		group, name, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(group) == "" {
+			return nil, ErrOIDCConfig(fmt.Errorf("%q is not a group=role pair", pair))
+		}
+		role, err := ParseRole(name)
+		if err != nil {
+			return nil, err
+		}
+		groupRoles[strings.TrimSpace(group)] = role
+	}
+	return groupRoles, nil
+}
+
+// StartLogin redirects the user to the issuer to sign in, the issuer then redirects the user to the redirect URL, whose
+// handler calls FinishLogin. The user is sent to returnTo once signed in.
+func (a *OIDCAuthenticator) StartLogin(w http.ResponseWriter, r *http.Request, redirectURL, returnTo string) error {
+	discovery, err := a.discover(r.Context())
+	if err != nil {
+		return err
+	}
+	state, nonce, verifier := randomOIDCString(), randomOIDCString(), randomOIDCString()
+	cookie, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"state":     state,
+		"nonce":     nonce,
+		"verifier":  verifier,
+		"return_to": returnTo,
+		"exp":       time.Now().Add(oidcStateTTL).Unix(),
+	}).SignedString(a.secret)
+	if err != nil {
+		return ErrOIDCLogin(err)
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    cookie,
+		Path:     "/",
+		MaxAge:   int(oidcStateTTL.Seconds()),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	challenge := sha256.Sum256([]byte(verifier))
+	authURL := a.oauth2Config(discovery, redirectURL).AuthCodeURL(state,
+		oauth2.SetAuthURLParam("nonce", nonce),
+		oauth2.SetAuthURLParam("code_challenge", base64.RawURLEncoding.EncodeToString(challenge[:])),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	)
+	http.Redirect(w, r, authURL, http.StatusFound)
+	return nil
+}
+
+// FinishLogin handles the redirect of the issuer to the redirect URL: it exchanges the code of the request for the ID
+// token of the user, verifies it, and sets the session cookie. It returns where to send the user to.
+func (a *OIDCAuthenticator) FinishLogin(w http.ResponseWriter, r *http.Request, redirectURL string) (string, error) {
+	query := r.URL.Query()
+	if e := query.Get("error"); e != "" {
+		return "", ErrOIDCLogin(fmt.Errorf("%s: %s", e, query.Get("error_description")))
+	}
+	ck, err := r.Cookie(oidcStateCookie)
+	if err != nil {
+		return "", ErrOIDCLogin(fmt.Errorf("the sign in was not started by Meshery Server or took longer than %s", oidcStateTTL))
+	}
+	http.SetCookie(w, &http.Cookie{Name: oidcStateCookie, Path: "/", MaxAge: -1, HttpOnly: true})
+	state, err := a.parseSigned(ck.Value, false)
+	if err != nil {
+		return "", ErrOIDCLogin(err)
+	}
+	if s, _ := state["state"].(string); s == "" || s != query.Get("state") {
+		return "", ErrOIDCLogin(fmt.Errorf("the state of the callback does not match the one of the sign in"))
+	}
+
+	discovery, err := a.discover(r.Context())
+	if err != nil {
+		return "", err
+	}
+	verifier, _ := state["verifier"].(string)
+	ctx := context.WithValue(r.Context(), oauth2.HTTPClient, a.client)
+	token, err := a.oauth2Config(discovery, redirectURL).Exchange(ctx, query.Get("code"), oauth2.SetAuthURLParam("code_verifier", verifier))
+	if err != nil {
+		return "", ErrOIDCLogin(err)
+	}
+	rawIDToken, _ := token.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return "", ErrOIDCLogin(fmt.Errorf("the issuer returned no ID token"))
+	}
+	nonce, _ := state["nonce"].(string)
+	claims, err := a.verifyIDToken(r.Context(), rawIDToken, nonce)
+	if err != nil {
+		return "", ErrOIDCLogin(err)
+	}
+
+	session, expiresAt, err := a.newSession(a.userFromClaims(claims))
+	if err != nil {
+		return "", ErrOIDCLogin(err)
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     tokenName,
+		Value:    session,
+		Path:     "/",
+		Expires:  expiresAt,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	returnTo, _ := state["return_to"].(string)
+	// the user is only sent back to Meshery Server
+	if !strings.HasPrefix(returnTo, "/") || strings.HasPrefix(returnTo, "//") {
+		returnTo = "/"
+	}
+	return returnTo, nil
+}
+
+// Session returns the user of the session of the request. The sessions carried by API tokens are valid as long as the
+// API tokens are, which are verified beforehand.
+func (a *OIDCAuthenticator) Session(r *http.Request) (*User, error) {
+	token, err := SessionToken(r)
+	if err != nil {
+		return nil, ErrInvalidOIDCSession(err)
+	}
+	_, fromAPIToken := r.Context().Value(APITokenCtxKey).(*APIToken)
+	claims, err := a.parseSigned(token, fromAPIToken)
+	if err != nil {
+		return nil, ErrInvalidOIDCSession(err)
+	}
+	if iss, _ := claims["iss"].(string); iss != oidcSessionIssuer {
+		return nil, ErrInvalidOIDCSession(fmt.Errorf("the token is not a session of Meshery Server"))
+	}
+	user := &User{}
+	user.ID, _ = claims["sub"].(string)
+	user.UserID, _ = claims["user_id"].(string)
+	user.FirstName, _ = claims["first_name"].(string)
+	user.LastName, _ = claims["last_name"].(string)
+	user.Email, _ = claims["email"].(string)
+	user.AvatarURL, _ = claims["avatar_url"].(string)
+	user.Provider = a.config.Issuer
+	user.RoleNames = stringsClaim(claims["roles"])
+	return user, nil
+}
+
+// SessionToken returns the token of the session of the request
+func SessionToken(r *http.Request) (string, error) {
+	ck, err := r.Cookie(tokenName)
+	if err != nil {
+		return "", err
+	}
+	if ck.Value == "" {
+		return "", http.ErrNoCookie
+	}
+	return ck.Value, nil
+}
+
+// EndSession removes the session cookie
+func (a *OIDCAuthenticator) EndSession(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{Name: tokenName, Path: "/", MaxAge: -1, HttpOnly: true})
+}
+
+// newSession returns the signed session of the user, and when it expires
+func (a *OIDCAuthenticator) newSession(user *User) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(a.config.SessionTTL)
+	session, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss":        oidcSessionIssuer,
+		"sub":        user.ID,
+		"user_id":    user.UserID,
+		"first_name": user.FirstName,
+		"last_name":  user.LastName,
+		"email":      user.Email,
+		"avatar_url": user.AvatarURL,
+		"roles":      user.RoleNames,
+		"iat":        now.Unix(),
+		"exp":        expiresAt.Unix(),
+	}).SignedString(a.secret)
+	return session, expiresAt, err
+}
+
+// parseSigned returns the claims of the token signed by Meshery Server, whose expiry is not verified if skipExpiry is set
+func (a *OIDCAuthenticator) parseSigned(token string, skipExpiry bool) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	parser := &jwt.Parser{SkipClaimsValidation: skipExpiry}
+	_, err := parser.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing algorithm %v", t.Header["alg"])
+		}
+		return a.secret, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// verifyIDToken returns the claims of the ID token once its signature, its issuer, its audience, its expiry and its nonce are verified
+func (a *OIDCAuthenticator) verifyIDToken(ctx context.Context, rawIDToken, nonce string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(rawIDToken, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("unsupported signing algorithm %v of the ID token", t.Header["alg"])
+		}
+		kid, _ := t.Header["kid"].(string)
+		return a.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case !claims.VerifyIssuer(a.config.Issuer, true):
+		return nil, fmt.Errorf("the ID token was not issued by %s", a.config.Issuer)
+	case !claims.VerifyAudience(a.config.ClientID, true):
+		return nil, fmt.Errorf("the ID token was not issued to the client %s", a.config.ClientID)
+	case claims["nonce"] != nonce:
+		return nil, fmt.Errorf("the nonce of the ID token does not match the one of the sign in")
+	}
+	if sub, _ := claims["sub"].(string); sub == "" {
+		return nil, fmt.Errorf("the ID token has no subject")
+	}
+	return claims, nil
+}
+
+// userFromClaims returns the user of the claims of the ID token, the ID of the user being derived from the issuer and the
+// subject, and its roles from its groups
+func (a *OIDCAuthenticator) userFromClaims(claims jwt.MapClaims) *User {
+	str := func(names ...string) string {
+		for _, name := range names {
+			if s, _ := claims[name].(string); s != "" {
+				return s
+			}
+		}
+		return ""
+	}
+	sub := str("sub")
+	roles := map[string]bool{}
+	for _, group := range stringsClaim(claims[a.config.GroupsClaim]) {
+		if role, ok := a.config.GroupRoles[group]; ok {
+			roles[string(role)] = true
+		}
+	}
+	roleNames := make([]string, 0, len(roles))
+	for role := range roles {
+		roleNames = append(roleNames, role)
+	}
+	sort.Strings(roleNames)
+	return &User{
+		ID:        uuid.NewV5(uuid.NamespaceURL, a.config.Issuer+"#"+sub).String(),
+		UserID:    str("preferred_username", "email", "sub"),
+		FirstName: str("given_name", "name"),
+		LastName:  str("family_name"),
+		Email:     str("email"),
+		AvatarURL: str("picture"),
+		RoleNames: roleNames,
+	}
+}
+
+// stringsClaim returns the strings of a claim, which is either a list or a single string
+func stringsClaim(claim interface{}) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		out := make([]string, 0, len(v))
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return []string{}
+}
+
+func (a *OIDCAuthenticator) oauth2Config(discovery *oidcDiscovery, redirectURL string) *oauth2.Config {
+	scopes := []string{"openid"}
+	for _, scope := range a.config.Scopes {
+		if scope != "" && scope != "openid" {
+			scopes = append(scopes, scope)
+		}
+	}
+	if a.config.RedirectURL != "" {
+		redirectURL = a.config.RedirectURL
+	}
+	return &oauth2.Config{
+		ClientID:     a.config.ClientID,
+		ClientSecret: a.config.ClientSecret,
+		Endpoint:     oauth2.Endpoint{AuthURL: discovery.AuthorizationEndpoint, TokenURL: discovery.TokenEndpoint},
+		RedirectURL:  redirectURL,
+		Scopes:       scopes,
+	}
+}
+
+// discover returns the configuration of the issuer, which is discovered once
+func (a *OIDCAuthenticator) discover(ctx context.Context) (*oidcDiscovery, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.discovery != nil {
+		return a.discovery, nil
+	}
+	var discovery oidcDiscovery
+	if err := a.getJSON(ctx, a.config.Issuer+"/.well-known/openid-configuration", &discovery); err != nil {
+		return nil, ErrOIDCDiscovery(err, a.config.Issuer)
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != a.config.Issuer {
+		return nil, ErrOIDCDiscovery(fmt.Errorf("the configuration is the one of the issuer %s", discovery.Issuer), a.config.Issuer)
+	}
+	a.discovery = &discovery
+	return a.discovery, nil
+}
+
+// key returns the public key of the issuer with the id, the keys of the issuer are fetched again when it is unknown,
+// eg: after the issuer rotated its keys
+func (a *OIDCAuthenticator) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	discovery, err := a.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if key, ok := a.keys[kid]; ok {
+		return key, nil
+	}
+	var jwks struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := a.getJSON(ctx, discovery.JWKSURI, &jwks); err != nil {
+		return nil, ErrOIDCDiscovery(err, a.config.Issuer)
+	}
+	keys := map[string]*rsa.PublicKey{}
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		n, errN := base64.RawURLEncoding.DecodeString(k.N)
+		e, errE := base64.RawURLEncoding.DecodeString(k.E)
+		if errN != nil || errE != nil || len(e) == 0 {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	}
+	a.keys = keys
+	if key, ok := keys[kid]; ok {
+		return key, nil
+	}
+	// the tokens without key id are signed by the single key of the issuer
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("the key %q of the ID token is not a key of the issuer", kid)
+}
+
+func (a *OIDCAuthenticator) getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer SafeClose(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// randomOIDCString returns a random string for the states, the nonces and the verifiers of the sign ins
+func randomOIDCString() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
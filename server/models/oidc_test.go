@@ -0,0 +1,234 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// fakeIssuer is an OpenID Connect issuer signing the ID tokens of its claims
+type fakeIssuer struct {
+	*httptest.Server
+	key    *rsa.PrivateKey
+	claims jwt.MapClaims
+	// nonce and challenge are the ones of the last authorization request
+	nonce, challenge string
+}
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer := &fakeIssuer{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 issuer.URL,
+			"authorization_endpoint": issuer.URL + "/auth",
+			"token_endpoint":         issuer.URL + "/token",
+			"jwks_uri":               issuer.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "key-1",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		verifier := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if r.PostForm.Get("code") != "code" || base64.RawURLEncoding.EncodeToString(verifier[:]) != issuer.challenge {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		claims := jwt.MapClaims{"nonce": issuer.nonce}
+		for k, v := range issuer.claims {
+			claims[k] = v
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = "key-1"
+		idToken, err := token.SignedString(key)
+		if err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"id_token":     idToken,
+		})
+	})
+	issuer.Server = httptest.NewServer(mux)
+	t.Cleanup(issuer.Close)
+	issuer.claims = jwt.MapClaims{
+		"iss":                issuer.URL,
+		"aud":                "meshery",
+		"sub":                "0123",
+		"exp":                time.Now().Add(time.Hour).Unix(),
+		"preferred_username": "jdoe",
+		"email":              "jdoe@example.com",
+		"given_name":         "Jane",
+		"groups":             []string{"developers", "platform-admins", "sales"},
+	}
+	return issuer
+}
+
+// login signs in with the authenticator, and returns the response of the callback
+func (issuer *fakeIssuer) login(t *testing.T, a *OIDCAuthenticator) *httptest.ResponseRecorder {
+	start := httptest.NewRecorder()
+	if err := a.StartLogin(start, httptest.NewRequest(http.MethodGet, "/user/login?ref=/extension/meshmap", nil), "http://meshery/api/user/token", "/extension/meshmap"); err != nil {
+		t.Fatal(err)
+	}
+	if start.Code != http.StatusFound {
+		t.Fatalf("StartLogin() responded %d", start.Code)
+	}
+	authURL, err := url.Parse(start.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := authURL.Query()
+	if authURL.Path != "/auth" || query.Get("client_id") != "meshery" || query.Get("redirect_uri") != "http://meshery/api/user/token" || query.Get("code_challenge_method") != "S256" {
+		t.Fatalf("StartLogin() redirected to %s", authURL)
+	}
+	issuer.nonce, issuer.challenge = query.Get("nonce"), query.Get("code_challenge")
+
+	callback := httptest.NewRequest(http.MethodGet, "/api/user/token?code=code&state="+url.QueryEscape(query.Get("state")), nil)
+	for _, c := range start.Result().Cookies() {
+		callback.AddCookie(c)
+	}
+	finish := httptest.NewRecorder()
+	returnTo, err := a.FinishLogin(finish, callback, "http://meshery/api/user/token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if returnTo != "/extension/meshmap" {
+		t.Errorf("FinishLogin() returned to %q", returnTo)
+	}
+	return finish
+}
+
+func TestOIDCLogin(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	a, err := NewOIDCAuthenticator(OIDCConfig{
+		Issuer:     issuer.URL,
+		ClientID:   "meshery",
+		GroupRoles: map[string]Role{"developers": OperatorRole, "platform-admins": AdminRole},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	finish := issuer.login(t, a)
+	req := httptest.NewRequest(http.MethodGet, "/api/user", nil)
+	for _, c := range finish.Result().Cookies() {
+		req.AddCookie(c)
+	}
+	user, err := a.Session(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.UserID != "jdoe" || user.Email != "jdoe@example.com" || user.FirstName != "Jane" || user.ID == "" {
+		t.Errorf("Session() = %+v", user)
+	}
+	if want := []string{"admin", "operator"}; !reflect.DeepEqual(user.RoleNames, want) {
+		t.Errorf("the roles of the user are %v, want %v", user.RoleNames, want)
+	}
+
+	other, err := NewOIDCAuthenticator(OIDCConfig{Issuer: issuer.URL, ClientID: "meshery"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := other.Session(req); err == nil {
+		t.Error("the session signed by another secret is valid")
+	}
+}
+
+func TestOIDCLoginRejectedIDTokens(t *testing.T) {
+	tests := []struct {
+		name  string
+		claim string
+		value interface{}
+	}{
+		{"other audience", "aud", "other"},
+		{"other issuer", "iss", "https://issuer.example.com"},
+		{"expired", "exp", time.Now().Add(-time.Minute).Unix()},
+		{"replayed", "nonce", "nonce"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issuer := newFakeIssuer(t)
+			issuer.claims[tt.claim] = tt.value
+			a, err := NewOIDCAuthenticator(OIDCConfig{Issuer: issuer.URL, ClientID: "meshery"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			start := httptest.NewRecorder()
+			if err := a.StartLogin(start, httptest.NewRequest(http.MethodGet, "/user/login", nil), "http://meshery/api/user/token", "/"); err != nil {
+				t.Fatal(err)
+			}
+			authURL, _ := url.Parse(start.Header().Get("Location"))
+			issuer.nonce, issuer.challenge = authURL.Query().Get("nonce"), authURL.Query().Get("code_challenge")
+			callback := httptest.NewRequest(http.MethodGet, "/api/user/token?code=code&state="+url.QueryEscape(authURL.Query().Get("state")), nil)
+			for _, c := range start.Result().Cookies() {
+				callback.AddCookie(c)
+			}
+			if _, err := a.FinishLogin(httptest.NewRecorder(), callback, "http://meshery/api/user/token"); err == nil {
+				t.Error("FinishLogin() accepted the ID token")
+			}
+		})
+	}
+}
+
+func TestOIDCLoginForgedState(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	a, err := NewOIDCAuthenticator(OIDCConfig{Issuer: issuer.URL, ClientID: "meshery"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := httptest.NewRecorder()
+	if err := a.StartLogin(start, httptest.NewRequest(http.MethodGet, "/user/login", nil), "http://meshery/api/user/token", "/"); err != nil {
+		t.Fatal(err)
+	}
+	callback := httptest.NewRequest(http.MethodGet, "/api/user/token?code=code&state=forged", nil)
+	for _, c := range start.Result().Cookies() {
+		callback.AddCookie(c)
+	}
+	if _, err := a.FinishLogin(httptest.NewRecorder(), callback, "http://meshery/api/user/token"); err == nil {
+		t.Error("FinishLogin() accepted a forged state")
+	}
+	noCookie := httptest.NewRequest(http.MethodGet, "/api/user/token?code=code&state=forged", nil)
+	if _, err := a.FinishLogin(httptest.NewRecorder(), noCookie, "http://meshery/api/user/token"); err == nil {
+		t.Error("FinishLogin() accepted a callback without sign in")
+	}
+}
+
+func TestParseOIDCGroupRoles(t *testing.T) {
+	got, err := ParseOIDCGroupRoles("platform-admins=admin, developers=operator,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]Role{"platform-admins": AdminRole, "developers": OperatorRole}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseOIDCGroupRoles() = %v, want %v", got, want)
+	}
+	for _, s := range []string{"developers", "developers=owner", "=admin"} {
+		if _, err := ParseOIDCGroupRoles(s); err == nil {
+			t.Errorf("ParseOIDCGroupRoles(%q) succeeded", s)
+		}
+	}
+}
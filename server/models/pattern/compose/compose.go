@@ -0,0 +1,476 @@
+// Package compose converts Docker Compose files into Meshery designs
+package compose
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// File is the subset of a Docker Compose file the converter understands, see https://docs.docker.com/compose/compose-file/
+type File struct {
+	Services map[string]Service     `yaml:"services"`
+	Volumes  map[string]interface{} `yaml:"volumes,omitempty"`
+}
+
+// Service is a service of a Docker Compose file. The fields accepting several syntaxes are decoded as interface{}.
+type Service struct {
+	Image         string      `yaml:"image,omitempty"`
+	ContainerName string      `yaml:"container_name,omitempty"`
+	Command       interface{} `yaml:"command,omitempty"`
+	Entrypoint    interface{} `yaml:"entrypoint,omitempty"`
+	WorkingDir    string      `yaml:"working_dir,omitempty"`
+	// Environment is either a map of variables or a list of NAME=value
+	Environment interface{} `yaml:"environment,omitempty"`
+	// Ports are either short, [HOST:]CONTAINER[/PROTOCOL], or long entries
+	Ports  []interface{} `yaml:"ports,omitempty"`
+	Expose []interface{} `yaml:"expose,omitempty"`
+	// Volumes are either short, SOURCE:TARGET[:MODE], or long entries
+	Volumes []interface{} `yaml:"volumes,omitempty"`
+	// Links are SERVICE[:ALIAS]
+	Links []string `yaml:"links,omitempty"`
+	// DependsOn is either a list of services or a map of services to their conditions
+	DependsOn interface{}   `yaml:"depends_on,omitempty"`
+	Labels    interface{}   `yaml:"labels,omitempty"`
+	Deploy    *DeployConfig `yaml:"deploy,omitempty"`
+}
+
+// DeployConfig is the deployment configuration of a service
+type DeployConfig struct {
+	Replicas *int `yaml:"replicas,omitempty"`
+}
+
+// ResourceRef identifies a Kubernetes resource generated for a service
+type ResourceRef struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+}
+
+// Link is an edge relationship between the resources of two services, inferred from the links and the depends_on
+// of the service From
+type Link struct {
+	From ResourceRef `json:"from"`
+	To   ResourceRef `json:"to"`
+}
+
+// Conversion is the outcome of converting a Docker Compose file into Kubernetes resources
+type Conversion struct {
+	Manifests []map[string]interface{}
+	Links     []Link
+	// Warnings report the parts of the Docker Compose file which have no Kubernetes equivalent and were skipped
+	Warnings []string
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// Convert converts the services of the Docker Compose file into Kubernetes resources:
+//   - every service becomes a Deployment running its image, with its environment, command and working directory
+//   - the ports of a service are exposed by a Service, the published port being the port of the Service
+//   - named volumes become PersistentVolumeClaims, absolute bind mounts hostPath volumes and the other mounts emptyDir volumes
+//   - links and depends_on become edges from the Deployment of the service to the Service, or else the Deployment, it refers to
+func Convert(data []byte) (*Conversion, error) {
+	var file File
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, ErrConvertCompose(err)
+	}
+	if len(file.Services) == 0 {
+		return nil, ErrConvertCompose(fmt.Errorf("the Docker Compose file has no services"))
+	}
+
+	names := make([]string, 0, len(file.Services))
+	for name := range file.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	conv := &Conversion{}
+	// resources the links of the services point to
+	targets := make(map[string]ResourceRef, len(names))
+	claims := make(map[string]bool)
+	var errs []string
+
+	for _, name := range names {
+		svc := file.Services[name]
+		resName := resourceName(name)
+		if svc.Image == "" {
+			errs = append(errs, fmt.Sprintf("service %q: an image is required, building images is not supported", name))
+			continue
+		}
+
+		ports, err := parsePorts(svc.Ports, svc.Expose)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("service %q: %s", name, err))
+			continue
+		}
+		mounts, volumes, skipped, err := parseVolumes(svc.Volumes, file.Volumes, resName)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("service %q: %s", name, err))
+			continue
+		}
+		for _, source := range skipped {
+			conv.Warnings = append(conv.Warnings, fmt.Sprintf("service %q: the content of %s is not copied, it is mounted as an empty directory", name, source))
+		}
+
+		container := map[string]interface{}{
+			"name":  resName,
+			"image": svc.Image,
+		}
+		if svc.ContainerName != "" {
+			container["name"] = resourceName(svc.ContainerName)
+		}
+		// the entrypoint of an image is the command of a container, its command the arguments
+		if cmd := stringList(svc.Entrypoint); len(cmd) > 0 {
+			container["command"] = cmd
+		}
+		if args := stringList(svc.Command); len(args) > 0 {
+			container["args"] = args
+		}
+		if svc.WorkingDir != "" {
+			container["workingDir"] = svc.WorkingDir
+		}
+		if env := parseEnvironment(svc.Environment); len(env) > 0 {
+			container["env"] = env
+		}
+		if len(ports) > 0 {
+			containerPorts := make([]interface{}, 0, len(ports))
+			for _, p := range ports {
+				containerPorts = append(containerPorts, map[string]interface{}{"containerPort": p.target, "protocol": p.protocol})
+			}
+			container["ports"] = containerPorts
+		}
+		if len(mounts) > 0 {
+			container["volumeMounts"] = mounts
+		}
+
+		labels := map[string]interface{}{"app": resName}
+		for k, v := range parseLabels(svc.Labels) {
+			labels[k] = v
+		}
+		podSpec := map[string]interface{}{"containers": []interface{}{container}}
+		if len(volumes) > 0 {
+			podSpec["volumes"] = volumes
+		}
+		replicas := 1
+		if svc.Deploy != nil && svc.Deploy.Replicas != nil {
+			replicas = *svc.Deploy.Replicas
+		}
+		conv.Manifests = append(conv.Manifests, map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": resName, "labels": labels},
+			"spec": map[string]interface{}{
+				"replicas": replicas,
+				"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": resName}},
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": resName}},
+					"spec":     podSpec,
+				},
+			},
+		})
+		targets[name] = ResourceRef{Kind: "Deployment", Name: resName}
+
+		if len(ports) > 0 {
+			servicePorts := make([]interface{}, 0, len(ports))
+			for _, p := range ports {
+				servicePorts = append(servicePorts, map[string]interface{}{
+					"name":       fmt.Sprintf("%d-%s", p.port, strings.ToLower(p.protocol)),
+					"port":       p.port,
+					"targetPort": p.target,
+					"protocol":   p.protocol,
+				})
+			}
+			conv.Manifests = append(conv.Manifests, map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata":   map[string]interface{}{"name": resName, "labels": map[string]interface{}{"app": resName}},
+				"spec": map[string]interface{}{
+					"selector": map[string]interface{}{"app": resName},
+					"ports":    servicePorts,
+				},
+			})
+			targets[name] = ResourceRef{Kind: "Service", Name: resName}
+		}
+
+		for _, v := range volumes {
+			claim, _ := v.(map[string]interface{})["persistentVolumeClaim"].(map[string]interface{})
+			if claim == nil {
+				continue
+			}
+			claimName, _ := claim["claimName"].(string)
+			if claims[claimName] {
+				continue
+			}
+			claims[claimName] = true
+			conv.Manifests = append(conv.Manifests, map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "PersistentVolumeClaim",
+				"metadata":   map[string]interface{}{"name": claimName},
+				"spec": map[string]interface{}{
+					"accessModes": []interface{}{"ReadWriteOnce"},
+					"resources":   map[string]interface{}{"requests": map[string]interface{}{"storage": "1Gi"}},
+				},
+			})
+		}
+	}
+	if len(errs) > 0 {
+		return nil, ErrConvertCompose(fmt.Errorf("%s", strings.Join(errs, "\n")))
+	}
+
+	for _, name := range names {
+		svc := file.Services[name]
+		from := ResourceRef{Kind: "Deployment", Name: resourceName(name)}
+		seen := make(map[string]bool)
+		for _, dep := range append(linkedServices(svc.Links), dependencies(svc.DependsOn)...) {
+			if seen[dep] || dep == name {
+				continue
+			}
+			seen[dep] = true
+			to, ok := targets[dep]
+			if !ok {
+				conv.Warnings = append(conv.Warnings, fmt.Sprintf("service %q: links to the unknown service %q", name, dep))
+				continue
+			}
+			conv.Links = append(conv.Links, Link{From: from, To: to})
+		}
+	}
+	return conv, nil
+}
+
+type portMapping struct {
+	port     int
+	target   int
+	protocol string
+}
+
+// parsePorts returns the ports of the service, the ports which are only exposed are published on their own number
+func parsePorts(ports, expose []interface{}) ([]portMapping, error) {
+	mappings := make([]portMapping, 0, len(ports)+len(expose))
+	seen := make(map[string]bool)
+	add := func(p portMapping) {
+		key := fmt.Sprintf("%d/%s", p.port, p.protocol)
+		if !seen[key] {
+			seen[key] = true
+			mappings = append(mappings, p)
+		}
+	}
+
+	for _, p := range ports {
+		switch v := p.(type) {
+		case map[interface{}]interface{}:
+			target, err := toPort(v["target"])
+			if err != nil {
+				return nil, err
+			}
+			published := target
+			if v["published"] != nil {
+				if published, err = toPort(v["published"]); err != nil {
+					return nil, err
+				}
+			}
+			protocol, _ := v["protocol"].(string)
+			add(portMapping{port: published, target: target, protocol: normalizeProtocol(protocol)})
+		default:
+			spec := fmt.Sprint(v)
+			spec, protocol, _ := strings.Cut(spec, "/")
+			parts := strings.Split(spec, ":")
+			target, err := toPort(parts[len(parts)-1])
+			if err != nil {
+				return nil, err
+			}
+			published := target
+			if len(parts) > 1 && parts[len(parts)-2] != "" {
+				// ranges of host ports are published on the port of the container
+				if p, err := toPort(parts[len(parts)-2]); err == nil {
+					published = p
+				}
+			}
+			add(portMapping{port: published, target: target, protocol: normalizeProtocol(protocol)})
+		}
+	}
+	for _, e := range expose {
+		spec, protocol, _ := strings.Cut(fmt.Sprint(e), "/")
+		target, err := toPort(spec)
+		if err != nil {
+			return nil, err
+		}
+		add(portMapping{port: target, target: target, protocol: normalizeProtocol(protocol)})
+	}
+	return mappings, nil
+}
+
+func toPort(v interface{}) (int, error) {
+	p, err := strconv.Atoi(strings.TrimSpace(fmt.Sprint(v)))
+	if err != nil || p <= 0 || p > 65535 {
+		return 0, fmt.Errorf("invalid port %v, port ranges are not supported", v)
+	}
+	return p, nil
+}
+
+func normalizeProtocol(protocol string) string {
+	if protocol == "" {
+		return "TCP"
+	}
+	return strings.ToUpper(protocol)
+}
+
+// parseVolumes returns the volume mounts of the container and the volumes of the pod of the service,
+// along with the sources of the bind mounts which are mounted as empty directories
+func parseVolumes(entries []interface{}, named map[string]interface{}, service string) ([]interface{}, []interface{}, []string, error) {
+	mounts := make([]interface{}, 0, len(entries))
+	volumes := make([]interface{}, 0, len(entries))
+	skipped := []string{}
+	for i, e := range entries {
+		var source, target string
+		readOnly := false
+		switch v := e.(type) {
+		case map[interface{}]interface{}:
+			source, _ = v["source"].(string)
+			target, _ = v["target"].(string)
+			readOnly, _ = v["read_only"].(bool)
+		default:
+			parts := strings.Split(fmt.Sprint(v), ":")
+			switch len(parts) {
+			case 1:
+				target = parts[0]
+			case 2:
+				source, target = parts[0], parts[1]
+			default:
+				source, target = parts[0], parts[1]
+				readOnly = strings.Contains(parts[2], "ro")
+			}
+		}
+		if target == "" {
+			return nil, nil, nil, fmt.Errorf("volume %v has no target", e)
+		}
+
+		volName := fmt.Sprintf("%s-%d", service, i)
+		volume := map[string]interface{}{}
+		switch {
+		case source == "":
+			// anonymous volumes only live as long as the container
+			volume["emptyDir"] = map[string]interface{}{}
+		case strings.HasPrefix(source, "/"):
+			volume["hostPath"] = map[string]interface{}{"path": source}
+		case strings.HasPrefix(source, ".") || strings.HasPrefix(source, "~"):
+			// relative bind mounts refer to the machine running docker compose
+			volume["emptyDir"] = map[string]interface{}{}
+			skipped = append(skipped, source)
+		default:
+			if _, ok := named[source]; !ok {
+				return nil, nil, nil, fmt.Errorf("volume %q is not declared under the top-level volumes", source)
+			}
+			volName = resourceName(source)
+			volume["persistentVolumeClaim"] = map[string]interface{}{"claimName": volName}
+		}
+		volume["name"] = volName
+
+		mount := map[string]interface{}{"name": volName, "mountPath": path.Clean(target)}
+		if readOnly {
+			mount["readOnly"] = true
+		}
+		mounts = append(mounts, mount)
+		volumes = append(volumes, volume)
+	}
+	return mounts, volumes, skipped, nil
+}
+
+// parseEnvironment returns the environment variables of the container, ordered by name
+func parseEnvironment(env interface{}) []interface{} {
+	vars := map[string]string{}
+	switch v := env.(type) {
+	case map[interface{}]interface{}:
+		for k, val := range v {
+			if val == nil {
+				val = ""
+			}
+			vars[fmt.Sprint(k)] = fmt.Sprint(val)
+		}
+	case []interface{}:
+		for _, e := range v {
+			name, val, _ := strings.Cut(fmt.Sprint(e), "=")
+			vars[name] = val
+		}
+	}
+
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	out := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		out = append(out, map[string]interface{}{"name": name, "value": vars[name]})
+	}
+	return out
+}
+
+func parseLabels(labels interface{}) map[string]interface{} {
+	out := map[string]interface{}{}
+	switch v := labels.(type) {
+	case map[interface{}]interface{}:
+		for k, val := range v {
+			out[fmt.Sprint(k)] = fmt.Sprint(val)
+		}
+	case []interface{}:
+		for _, e := range v {
+			k, val, _ := strings.Cut(fmt.Sprint(e), "=")
+			out[k] = val
+		}
+	}
+	return out
+}
+
+func linkedServices(links []string) []string {
+	services := make([]string, 0, len(links))
+	for _, l := range links {
+		name, _, _ := strings.Cut(l, ":")
+		services = append(services, name)
+	}
+	return services
+}
+
+func dependencies(dependsOn interface{}) []string {
+	deps := []string{}
+	switch v := dependsOn.(type) {
+	case []interface{}:
+		for _, d := range v {
+			deps = append(deps, fmt.Sprint(d))
+		}
+	case map[interface{}]interface{}:
+		for d := range v {
+			deps = append(deps, fmt.Sprint(d))
+		}
+		sort.Strings(deps)
+	}
+	return deps
+}
+
+// stringList returns the command given either as a string or as a list
+func stringList(v interface{}) []interface{} {
+	switch val := v.(type) {
+	case string:
+		out := []interface{}{}
+		for _, f := range strings.Fields(val) {
+			out = append(out, f)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, 0, len(val))
+		for _, e := range val {
+			out = append(out, fmt.Sprint(e))
+		}
+		return out
+	}
+	return nil
+}
+
+// resourceName returns the name as a valid name of a Kubernetes resource
+func resourceName(name string) string {
+	n := invalidNameChars.ReplaceAllString(strings.ToLower(strings.ReplaceAll(name, "_", "-")), "-")
+	return strings.Trim(n, "-")
+}
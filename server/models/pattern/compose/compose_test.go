@@ -0,0 +1,145 @@
+package compose
+
+import (
+	"reflect"
+	"testing"
+)
+
+const composeFile = `
+services:
+  web:
+    image: nginx:1.25
+    ports:
+      - "8080:80"
+      - target: 443
+        published: 8443
+    environment:
+      MODE: production
+      DEBUG:
+    volumes:
+      - static:/usr/share/nginx/html:ro
+      - ./conf:/etc/nginx/conf.d
+    links:
+      - api:backend
+    depends_on:
+      - db
+  api:
+    image: example/api
+    command: serve --port 9000
+    expose:
+      - "9000"
+    environment:
+      - DB_HOST=db
+  db:
+    image: postgres:16
+    volumes:
+      - data:/var/lib/postgresql/data
+    deploy:
+      replicas: 2
+volumes:
+  static:
+  data:
+`
+
+func TestConvert(t *testing.T) {
+	conv, err := Convert([]byte(composeFile))
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+
+	kinds := make([]ResourceRef, 0, len(conv.Manifests))
+	resources := make(map[ResourceRef]map[string]interface{})
+	for _, m := range conv.Manifests {
+		ref := ResourceRef{Kind: m["kind"].(string), Name: m["metadata"].(map[string]interface{})["name"].(string)}
+		kinds = append(kinds, ref)
+		resources[ref] = m
+	}
+	wantKinds := []ResourceRef{
+		{Kind: "Deployment", Name: "api"},
+		{Kind: "Service", Name: "api"},
+		{Kind: "Deployment", Name: "db"},
+		{Kind: "PersistentVolumeClaim", Name: "data"},
+		{Kind: "Deployment", Name: "web"},
+		{Kind: "Service", Name: "web"},
+		{Kind: "PersistentVolumeClaim", Name: "static"},
+	}
+	if !reflect.DeepEqual(kinds, wantKinds) {
+		t.Fatalf("resources = %v, want %v", kinds, wantKinds)
+	}
+
+	t.Run("Ports are published by a Service", func(t *testing.T) {
+		spec := resources[ResourceRef{Kind: "Service", Name: "web"}]["spec"].(map[string]interface{})
+		want := []interface{}{
+			map[string]interface{}{"name": "8080-tcp", "port": 8080, "targetPort": 80, "protocol": "TCP"},
+			map[string]interface{}{"name": "8443-tcp", "port": 8443, "targetPort": 443, "protocol": "TCP"},
+		}
+		if !reflect.DeepEqual(spec["ports"], want) {
+			t.Errorf("ports = %v, want %v", spec["ports"], want)
+		}
+	})
+
+	t.Run("Containers get the environment, command and volumes of their service", func(t *testing.T) {
+		container := func(name string) map[string]interface{} {
+			spec := resources[ResourceRef{Kind: "Deployment", Name: name}]["spec"].(map[string]interface{})
+			pod := spec["template"].(map[string]interface{})["spec"].(map[string]interface{})
+			return pod["containers"].([]interface{})[0].(map[string]interface{})
+		}
+		web := container("web")
+		wantEnv := []interface{}{
+			map[string]interface{}{"name": "DEBUG", "value": ""},
+			map[string]interface{}{"name": "MODE", "value": "production"},
+		}
+		if !reflect.DeepEqual(web["env"], wantEnv) {
+			t.Errorf("env = %v, want %v", web["env"], wantEnv)
+		}
+		wantMounts := []interface{}{
+			map[string]interface{}{"name": "static", "mountPath": "/usr/share/nginx/html", "readOnly": true},
+			map[string]interface{}{"name": "web-1", "mountPath": "/etc/nginx/conf.d"},
+		}
+		if !reflect.DeepEqual(web["volumeMounts"], wantMounts) {
+			t.Errorf("volumeMounts = %v, want %v", web["volumeMounts"], wantMounts)
+		}
+		if args := container("api")["args"]; !reflect.DeepEqual(args, []interface{}{"serve", "--port", "9000"}) {
+			t.Errorf("args = %v, want the command of the service", args)
+		}
+		replicas := resources[ResourceRef{Kind: "Deployment", Name: "db"}]["spec"].(map[string]interface{})["replicas"]
+		if replicas != 2 {
+			t.Errorf("replicas = %v, want 2", replicas)
+		}
+	})
+
+	t.Run("Links and dependencies become edges", func(t *testing.T) {
+		want := []Link{
+			{From: ResourceRef{Kind: "Deployment", Name: "web"}, To: ResourceRef{Kind: "Service", Name: "api"}},
+			{From: ResourceRef{Kind: "Deployment", Name: "web"}, To: ResourceRef{Kind: "Deployment", Name: "db"}},
+		}
+		if !reflect.DeepEqual(conv.Links, want) {
+			t.Errorf("links = %v, want %v", conv.Links, want)
+		}
+	})
+
+	t.Run("Relative bind mounts are reported", func(t *testing.T) {
+		if len(conv.Warnings) != 1 {
+			t.Errorf("warnings = %v, want a warning for ./conf", conv.Warnings)
+		}
+	})
+}
+
+func TestConvertErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		compose string
+	}{
+		{name: "Files without services are rejected", compose: "version: '3'"},
+		{name: "Services building their image are rejected", compose: "services:\n  app:\n    build: .\n"},
+		{name: "Port ranges are rejected", compose: "services:\n  app:\n    image: app\n    ports: ['8000-8010:8000-8010']\n"},
+		{name: "Undeclared named volumes are rejected", compose: "services:\n  app:\n    image: app\n    volumes: ['data:/data']\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Convert([]byte(tt.compose)); err == nil {
+				t.Errorf("Convert() error = nil, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package compose
+
+import (
+	"github.com/layer5io/meshkit/errors"
+)
+
+// Please reference the following before contributing an error code:
+// https://docs.meshery.io/project/contributing/contributing-error
+// https://github.com/meshery/meshkit/blob/master/errors/errors.go
+const (
+	ErrConvertComposeCode = "1556"
+)
+
+func ErrConvertCompose(err error) error {
+	return errors.New(ErrConvertComposeCode, errors.Alert, []string{"Could not convert the Docker Compose file into a design"}, []string{err.Error()}, []string{"The Docker Compose file is not valid YAML", "A service builds its image instead of referring to one", "A service uses a port range or a volume which is not declared"}, []string{"Make sure the Docker Compose file is valid with `docker compose config`", "Push the images of the services to a registry and refer to them with the image field", "Publish single ports and declare the named volumes under the top-level volumes"})
+}
@@ -0,0 +1,51 @@
+package compose
+
+import (
+	"strings"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	meshmodel "github.com/layer5io/meshkit/models/meshmodel/registry"
+	"gopkg.in/yaml.v2"
+)
+
+// NewPatternFile converts the Docker Compose file into a design with the components registered for the Kubernetes
+// resources of its services. The edges between the services are declared as dependencies of the components, so that
+// linked services are deployed first. The warnings report what could not be converted.
+func NewPatternFile(name string, data []byte, reg *meshmodel.RegistryManager) (core.Pattern, []string, error) {
+	conv, err := Convert(data)
+	if err != nil {
+		return core.Pattern{}, nil, err
+	}
+
+	docs := make([]string, 0, len(conv.Manifests))
+	for _, m := range conv.Manifests {
+		byt, err := yaml.Marshal(m)
+		if err != nil {
+			return core.Pattern{}, nil, ErrConvertCompose(err)
+		}
+		docs = append(docs, string(byt))
+	}
+	pattern, err := core.NewPatternFileFromK8sManifest(strings.Join(docs, "\n---\n"), false, reg)
+	if err != nil {
+		return core.Pattern{}, nil, err
+	}
+	if name != "" {
+		pattern.Name = name
+	}
+
+	components := make(map[ResourceRef]string, len(pattern.Services))
+	for key, svc := range pattern.Services {
+		components[ResourceRef{Kind: svc.Type, Name: svc.Name}] = key
+	}
+	for _, link := range conv.Links {
+		from, ok := components[link.From]
+		if !ok {
+			continue
+		}
+		if to, ok := components[link.To]; ok {
+			svc := pattern.Services[from]
+			svc.DependsOn = append(svc.DependsOn, to)
+		}
+	}
+	return pattern, conv.Warnings, nil
+}
@@ -0,0 +1,174 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ComponentCapability tells whether the cluster of a Kubernetes context serves the kind of a component of a design
+type ComponentCapability struct {
+	Component  string `json:"component"`
+	ContextID  string `json:"contextID"`
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Deployable bool   `json:"deployable"`
+	// Reason tells why the component is not deployable, or why it is deployable though the cluster does not serve its kind yet
+	Reason string `json:"reason,omitempty"`
+}
+
+// APIResources are the kinds served by the API of a cluster
+type APIResources struct {
+	Kinds map[schema.GroupVersionKind]bool
+	// FailedGroupVersions could not be discovered, eg: the ones of aggregated APIs whose service is down.
+	// Their kinds can neither be told served nor missing.
+	FailedGroupVersions map[schema.GroupVersion]bool
+}
+
+// ComponentCapabilities returns whether the cluster of the context, which serves the API resources, can deploy each of the
+// components, ordered by component. The kinds defined by the CustomResourceDefinitions among the components are deployable,
+// the definitions being provisioned along with the design.
+func ComponentCapabilities(comps []v1alpha1.Component, contextID string, served APIResources) []ComponentCapability {
+	defined := definedKinds(comps)
+	capabilities := make([]ComponentCapability, 0, len(comps))
+	for _, comp := range comps {
+		gvk := componentGVK(comp)
+		capability := ComponentCapability{
+			Component:  comp.Name,
+			ContextID:  contextID,
+			APIVersion: gvk.GroupVersion().String(),
+			Kind:       gvk.Kind,
+			Deployable: true,
+		}
+		switch {
+		case served.Kinds[gvk]:
+		case defined[gvk] != "":
+			capability.Reason = fmt.Sprintf("%s is defined by the CustomResourceDefinition %s of the design", gvk.Kind, defined[gvk])
+		case served.FailedGroupVersions[gvk.GroupVersion()]:
+			capability.Reason = fmt.Sprintf("the API %s of the cluster could not be discovered", capability.APIVersion)
+		default:
+			capability.Deployable = false
+			capability.Reason = missingKindReason(gvk, served)
+		}
+		capabilities = append(capabilities, capability)
+	}
+	sort.SliceStable(capabilities, func(i, j int) bool {
+		return capabilities[i].Component < capabilities[j].Component
+	})
+	return capabilities
+}
+
+// UndeployableComponents returns the capabilities of the components which are not deployable
+func UndeployableComponents(capabilities []ComponentCapability) []ComponentCapability {
+	undeployable := []ComponentCapability{}
+	for _, c := range capabilities {
+		if !c.Deployable {
+			undeployable = append(undeployable, c)
+		}
+	}
+	return undeployable
+}
+
+// CapabilityMatrix is the capability of every component of a design in every Kubernetes context
+type CapabilityMatrix struct {
+	// Deployable is false when some of the components cannot be deployed to some of the contexts
+	Deployable bool `json:"deployable"`
+	// Components are the capabilities of the components by name, by context id
+	Components   map[string]map[string]ComponentCapability `json:"components"`
+	Undeployable []ComponentCapability                     `json:"undeployable"`
+}
+
+// NewCapabilityMatrix returns the matrix of the capabilities
+func NewCapabilityMatrix(capabilities []ComponentCapability) CapabilityMatrix {
+	matrix := CapabilityMatrix{
+		Components:   make(map[string]map[string]ComponentCapability),
+		Undeployable: UndeployableComponents(capabilities),
+	}
+	matrix.Deployable = len(matrix.Undeployable) == 0
+	for _, c := range capabilities {
+		if matrix.Components[c.Component] == nil {
+			matrix.Components[c.Component] = make(map[string]ComponentCapability)
+		}
+		matrix.Components[c.Component][c.ContextID] = c
+	}
+	return matrix
+}
+
+// componentGVK returns the group, the version and the kind of the Kubernetes resource of the component
+func componentGVK(comp v1alpha1.Component) schema.GroupVersionKind {
+	apiVersion := v1alpha1.GetAPIVersionFromComponent(comp)
+	if apiVersion == "" {
+		apiVersion = comp.Spec.APIVersion
+	}
+	kind := v1alpha1.GetKindFromComponent(comp)
+	if kind == "" {
+		kind = comp.Spec.Type
+	}
+	return schema.FromAPIVersionAndKind(apiVersion, kind)
+}
+
+// definedKinds returns the kinds served by the versions of the CustomResourceDefinitions among the components, with the
+// names of the components defining them
+func definedKinds(comps []v1alpha1.Component) map[schema.GroupVersionKind]string {
+	defined := make(map[schema.GroupVersionKind]string)
+	for _, comp := range comps {
+		if componentGVK(comp).GroupKind() != (schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}) {
+			continue
+		}
+		settings := comp.Spec.Settings
+		if Format {
+			settings = Format.DePrettify(settings, false)
+		}
+		group, _ := nestedValue(settings, "spec", "group").(string)
+		kind, _ := nestedValue(settings, "spec", "names", "kind").(string)
+		versions, _ := nestedValue(settings, "spec", "versions").([]interface{})
+		for _, v := range versions {
+			version, _ := v.(map[string]interface{})
+			name, _ := version["name"].(string)
+			if served, ok := version["served"].(bool); name == "" || (ok && !served) {
+				continue
+			}
+			defined[schema.GroupVersionKind{Group: group, Version: name, Kind: kind}] = comp.Name
+		}
+	}
+	return defined
+}
+
+// missingKindReason tells why the kind is not served by the cluster: the version of the kind is not served, or the whole
+// API group is missing, which is the case of the custom resources of the operators not installed in the cluster
+func missingKindReason(gvk schema.GroupVersionKind, served APIResources) string {
+	versions := map[string]bool{}
+	groupServed := false
+	for k := range served.Kinds {
+		if k.Group != gvk.Group {
+			continue
+		}
+		groupServed = true
+		if k.Kind == gvk.Kind {
+			versions[k.Version] = true
+		}
+	}
+	switch {
+	case len(versions) > 0:
+		servedVersions := make([]string, 0, len(versions))
+		for v := range versions {
+			servedVersions = append(servedVersions, v)
+		}
+		sort.Strings(servedVersions)
+		return fmt.Sprintf("the cluster serves %s at the versions %s of %s, not at %s", gvk.Kind, strings.Join(servedVersions, ", "), groupName(gvk.Group), gvk.Version)
+	case groupServed:
+		return fmt.Sprintf("the cluster does not serve %s in %s", gvk.Kind, groupName(gvk.Group))
+	}
+	return fmt.Sprintf("the cluster does not serve %s, the operator or the CustomResourceDefinitions providing %s may not be installed", groupName(gvk.Group), gvk.Kind)
+}
+
+// groupName returns how the API group is named in the reasons, the legacy group being the core API group
+func groupName(group string) string {
+	if group == "" {
+		return "the core API group"
+	}
+	return "the API group " + group
+}
@@ -0,0 +1,74 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func capabilityComponent(name, apiVersion, kind string, settings map[string]interface{}) v1alpha1.Component {
+	return v1alpha1.Component{
+		ObjectMeta: v1.ObjectMeta{Name: name},
+		Spec: v1alpha1.ComponentSpec{
+			Type:       kind,
+			APIVersion: apiVersion,
+			Settings:   settings,
+		},
+	}
+}
+
+func TestComponentCapabilities(t *testing.T) {
+	comps := []v1alpha1.Component{
+		capabilityComponent("web", "apps/v1", "Deployment", nil),
+		capabilityComponent("gateway", "networking.istio.io/v1beta1", "Gateway", nil),
+		capabilityComponent("hpa", "autoscaling/v2beta1", "HorizontalPodAutoscaler", nil),
+		capabilityComponent("backup", "example.com/v1", "Backup", nil),
+		capabilityComponent("backups-crd", "apiextensions.k8s.io/v1", "CustomResourceDefinition", map[string]interface{}{
+			"spec": map[string]interface{}{
+				"group": "example.com",
+				"names": map[string]interface{}{"kind": "Backup"},
+				"versions": []interface{}{
+					map[string]interface{}{"name": "v1", "served": true},
+					map[string]interface{}{"name": "v1alpha1", "served": false},
+				},
+			},
+		}),
+		capabilityComponent("usage", "metrics.k8s.io/v1beta1", "PodMetrics", nil),
+	}
+	served := APIResources{
+		Kinds: map[schema.GroupVersionKind]bool{
+			{Group: "apps", Version: "v1", Kind: "Deployment"}:                               true,
+			{Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler"}:           true,
+			{Group: "autoscaling", Version: "v1", Kind: "HorizontalPodAutoscaler"}:           true,
+			{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}: true,
+		},
+		FailedGroupVersions: map[schema.GroupVersion]bool{{Group: "metrics.k8s.io", Version: "v1beta1"}: true},
+	}
+
+	want := []ComponentCapability{
+		{Component: "backup", ContextID: "ctx", APIVersion: "example.com/v1", Kind: "Backup", Deployable: true, Reason: "Backup is defined by the CustomResourceDefinition backups-crd of the design"},
+		{Component: "backups-crd", ContextID: "ctx", APIVersion: "apiextensions.k8s.io/v1", Kind: "CustomResourceDefinition", Deployable: true},
+		{Component: "gateway", ContextID: "ctx", APIVersion: "networking.istio.io/v1beta1", Kind: "Gateway", Reason: "the cluster does not serve the API group networking.istio.io, the operator or the CustomResourceDefinitions providing Gateway may not be installed"},
+		{Component: "hpa", ContextID: "ctx", APIVersion: "autoscaling/v2beta1", Kind: "HorizontalPodAutoscaler", Reason: "the cluster serves HorizontalPodAutoscaler at the versions v1, v2 of the API group autoscaling, not at v2beta1"},
+		{Component: "usage", ContextID: "ctx", APIVersion: "metrics.k8s.io/v1beta1", Kind: "PodMetrics", Deployable: true, Reason: "the API metrics.k8s.io/v1beta1 of the cluster could not be discovered"},
+		{Component: "web", ContextID: "ctx", APIVersion: "apps/v1", Kind: "Deployment", Deployable: true},
+	}
+	got := ComponentCapabilities(comps, "ctx", served)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ComponentCapabilities() =\n%+v\nwant\n%+v", got, want)
+	}
+
+	matrix := NewCapabilityMatrix(got)
+	if matrix.Deployable {
+		t.Errorf("NewCapabilityMatrix().Deployable = true, want false")
+	}
+	if len(matrix.Undeployable) != 2 || matrix.Undeployable[0].Component != "gateway" || matrix.Undeployable[1].Component != "hpa" {
+		t.Errorf("NewCapabilityMatrix().Undeployable = %+v, want gateway and hpa", matrix.Undeployable)
+	}
+	if c := matrix.Components["web"]["ctx"]; !c.Deployable {
+		t.Errorf("NewCapabilityMatrix().Components[web][ctx] = %+v, want deployable", c)
+	}
+}
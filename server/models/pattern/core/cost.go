@@ -0,0 +1,228 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// HoursPerMonth is the average number of hours in a month the monthly costs are computed with
+const HoursPerMonth = 730
+
+// Prices are the prices of the resources requested by the components of a design, for an hour
+type Prices struct {
+	Currency      string  `json:"currency"`
+	CPUCoreHour   float64 `json:"cpuCoreHour"`
+	MemoryGiBHour float64 `json:"memoryGiBHour"`
+}
+
+// Pricing provides the prices the cost of a design is estimated with, eg: configured per unit prices or the prices of
+// the instances of a cloud
+type Pricing interface {
+	Prices(context.Context) (Prices, error)
+}
+
+// Prices implements Pricing with the configured prices
+func (p Prices) Prices(context.Context) (Prices, error) {
+	return p, nil
+}
+
+// PricingAPI fetches the prices from a pricing API, which responds to GET requests to its URL with the prices as JSON,
+// eg: a service translating the prices of a cloud for the instances of a cluster into prices per unit
+type PricingAPI struct {
+	URL    string
+	Client *http.Client
+}
+
+func (p PricingAPI) Prices(ctx context.Context) (Prices, error) {
+	client := p.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.URL, nil)
+	if err != nil {
+		return Prices{}, ErrFetchPrices(err, p.URL)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return Prices{}, ErrFetchPrices(err, p.URL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Prices{}, ErrFetchPrices(fmt.Errorf("the pricing API responded with %s", resp.Status), p.URL)
+	}
+	var prices Prices
+	if err := json.NewDecoder(resp.Body).Decode(&prices); err != nil {
+		return Prices{}, ErrFetchPrices(err, p.URL)
+	}
+	return prices, nil
+}
+
+// CostEstimate is the estimated cost of running the components of a design
+type CostEstimate struct {
+	Prices
+	Components  []ComponentCost `json:"components"`
+	HourlyCost  float64         `json:"hourlyCost"`
+	MonthlyCost float64         `json:"monthlyCost"`
+}
+
+// ComponentCost is the estimated cost of running the pods of a component, computed from the CPU and memory they request
+type ComponentCost struct {
+	Component string `json:"component"`
+	Kind      string `json:"kind"`
+	Replicas  int64  `json:"replicas"`
+	// CPU cores and GiB of memory requested by all the replicas
+	CPUCores    float64 `json:"cpuCores"`
+	MemoryGiB   float64 `json:"memoryGiB"`
+	HourlyCost  float64 `json:"hourlyCost"`
+	MonthlyCost float64 `json:"monthlyCost"`
+	// Note qualifies the cost, eg: the cost of a DaemonSet is for every node of the cluster
+	Note string `json:"note,omitempty"`
+}
+
+// workloadPodSpec describes where the kinds of workloads declare the spec of their pods and their number
+type workloadPodSpec struct {
+	podSpec  []string
+	replicas []string
+	note     string
+}
+
+var workloadPodSpecs = map[string]workloadPodSpec{
+	"Pod":                   {podSpec: []string{"spec"}},
+	"Deployment":            {podSpec: []string{"spec", "template", "spec"}, replicas: []string{"spec", "replicas"}},
+	"ReplicaSet":            {podSpec: []string{"spec", "template", "spec"}, replicas: []string{"spec", "replicas"}},
+	"StatefulSet":           {podSpec: []string{"spec", "template", "spec"}, replicas: []string{"spec", "replicas"}},
+	"ReplicationController": {podSpec: []string{"spec", "template", "spec"}, replicas: []string{"spec", "replicas"}},
+	"DaemonSet":             {podSpec: []string{"spec", "template", "spec"}, note: "per node of the cluster"},
+	"Job":                   {podSpec: []string{"spec", "template", "spec"}, replicas: []string{"spec", "parallelism"}, note: "while the job runs"},
+	"CronJob": {
+		podSpec:  []string{"spec", "jobTemplate", "spec", "template", "spec"},
+		replicas: []string{"spec", "jobTemplate", "spec", "parallelism"},
+		note:     "while a scheduled job runs",
+	},
+}
+
+// EstimateCost estimates the cost of running the workloads among the components, eg: Deployments or Jobs, from the CPU
+// and memory their pods request, falling back to their limits like Kubernetes does. The components which are not
+// workloads are not part of the estimate. Components are ordered by name.
+func EstimateCost(comps []v1alpha1.Component, prices Prices) CostEstimate {
+	estimate := CostEstimate{Prices: prices, Components: []ComponentCost{}}
+	for _, comp := range comps {
+		kind := v1alpha1.GetKindFromComponent(comp)
+		if kind == "" {
+			kind = comp.Spec.Type
+		}
+		workload, ok := workloadPodSpecs[kind]
+		if !ok {
+			continue
+		}
+		settings := comp.Spec.Settings
+		if Format {
+			settings = Format.DePrettify(settings, false)
+		}
+
+		cost := ComponentCost{Component: comp.Name, Kind: kind, Replicas: 1, Note: workload.note}
+		if replicas, ok := nestedValue(settings, workload.replicas...).(float64); ok {
+			cost.Replicas = int64(replicas)
+		} else if replicas, ok := nestedValue(settings, workload.replicas...).(int); ok {
+			cost.Replicas = int64(replicas)
+		}
+		podSpec, _ := nestedValue(settings, workload.podSpec...).(map[string]interface{})
+		cpu, memory := podRequests(podSpec)
+		cost.CPUCores = cpu * float64(cost.Replicas)
+		cost.MemoryGiB = memory / (1 << 30) * float64(cost.Replicas)
+		hourly := cost.CPUCores*prices.CPUCoreHour + cost.MemoryGiB*prices.MemoryGiBHour
+		if cost.CPUCores == 0 && cost.MemoryGiB == 0 && cost.Note == "" {
+			cost.Note = "its pods request no CPU or memory"
+		}
+
+		cost.CPUCores = roundCost(cost.CPUCores)
+		cost.MemoryGiB = roundCost(cost.MemoryGiB)
+		cost.HourlyCost = roundCost(hourly)
+		cost.MonthlyCost = roundCost(hourly * HoursPerMonth)
+		estimate.HourlyCost += hourly
+		estimate.Components = append(estimate.Components, cost)
+	}
+	estimate.MonthlyCost = roundCost(estimate.HourlyCost * HoursPerMonth)
+	estimate.HourlyCost = roundCost(estimate.HourlyCost)
+	sort.Slice(estimate.Components, func(i, j int) bool {
+		return estimate.Components[i].Component < estimate.Components[j].Component
+	})
+	return estimate
+}
+
+// podRequests returns the CPU cores and bytes of memory requested by a pod: the sum of the requests of its containers,
+// or the largest request of its init containers if it is larger, as they run one after the other
+func podRequests(podSpec map[string]interface{}) (cpu, memory float64) {
+	containers, _ := podSpec["containers"].([]interface{})
+	for _, c := range containers {
+		ccpu, cmemory := containerRequests(c)
+		cpu += ccpu
+		memory += cmemory
+	}
+	initContainers, _ := podSpec["initContainers"].([]interface{})
+	for _, c := range initContainers {
+		ccpu, cmemory := containerRequests(c)
+		cpu = math.Max(cpu, ccpu)
+		memory = math.Max(memory, cmemory)
+	}
+	return cpu, memory
+}
+
+func containerRequests(container interface{}) (cpu, memory float64) {
+	c, _ := container.(map[string]interface{})
+	resources, _ := c["resources"].(map[string]interface{})
+	requests, _ := resources["requests"].(map[string]interface{})
+	limits, _ := resources["limits"].(map[string]interface{})
+	quantity := func(name string) float64 {
+		if q, ok := parseQuantity(requests[name]); ok {
+			return q
+		}
+		q, _ := parseQuantity(limits[name])
+		return q
+	}
+	return quantity("cpu"), quantity("memory")
+}
+
+func parseQuantity(v interface{}) (float64, bool) {
+	switch val := v.(type) {
+	case string:
+		q, err := resource.ParseQuantity(val)
+		if err != nil {
+			return 0, false
+		}
+		return q.AsApproximateFloat64(), true
+	case float64:
+		return val, true
+	case int:
+		return float64(val), true
+	case int64:
+		return float64(val), true
+	}
+	return 0, false
+}
+
+func nestedValue(obj map[string]interface{}, path ...string) interface{} {
+	if len(path) == 0 {
+		return nil
+	}
+	var v interface{} = obj
+	for _, key := range path {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = m[key]
+	}
+	return v
+}
+
+func roundCost(v float64) float64 {
+	return math.Round(v*10000) / 10000
+}
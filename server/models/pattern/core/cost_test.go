@@ -0,0 +1,82 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func costComponent(name, kind string, settings map[string]interface{}) v1alpha1.Component {
+	return v1alpha1.Component{
+		TypeMeta:   v1.TypeMeta{Kind: "Component", APIVersion: "core.oam.dev/v1alpha2"},
+		ObjectMeta: v1.ObjectMeta{Name: name},
+		Spec: v1alpha1.ComponentSpec{
+			Type:       kind,
+			APIVersion: "apps/v1",
+			Settings:   settings,
+		},
+	}
+}
+
+func container(requests, limits map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name": "app",
+		"resources": map[string]interface{}{
+			"requests": requests,
+			"limits":   limits,
+		},
+	}
+}
+
+func TestEstimateCost(t *testing.T) {
+	prices := Prices{Currency: "USD", CPUCoreHour: 0.04, MemoryGiBHour: 0.005}
+	comps := []v1alpha1.Component{
+		costComponent("web", "Deployment", map[string]interface{}{
+			"spec": map[string]interface{}{
+				"replicas": float64(3),
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							container(map[string]interface{}{"cpu": "500m", "memory": "1Gi"}, nil),
+							// the limits stand for the requests which are not set
+							container(nil, map[string]interface{}{"cpu": "250m", "memory": "512Mi"}),
+						},
+					},
+				},
+			},
+		}),
+		costComponent("agent", "DaemonSet", map[string]interface{}{
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							container(map[string]interface{}{"cpu": float64(1)}, nil),
+						},
+						// init containers run before the containers, the largest request counts
+						"initContainers": []interface{}{
+							container(map[string]interface{}{"cpu": "2", "memory": "2Gi"}, nil),
+						},
+					},
+				},
+			},
+		}),
+		costComponent("web-svc", "Service", map[string]interface{}{}),
+	}
+
+	estimate := EstimateCost(comps, prices)
+	want := []ComponentCost{
+		{Component: "agent", Kind: "DaemonSet", Replicas: 1, CPUCores: 2, MemoryGiB: 2, HourlyCost: 0.09, MonthlyCost: 65.7, Note: "per node of the cluster"},
+		{Component: "web", Kind: "Deployment", Replicas: 3, CPUCores: 2.25, MemoryGiB: 4.5, HourlyCost: 0.1125, MonthlyCost: 82.125},
+	}
+	if !reflect.DeepEqual(estimate.Components, want) {
+		t.Errorf("components = %+v, want %+v", estimate.Components, want)
+	}
+	if estimate.HourlyCost != 0.2025 || estimate.MonthlyCost != 147.825 {
+		t.Errorf("cost = %v hourly, %v monthly, want 0.2025 hourly, 147.825 monthly", estimate.HourlyCost, estimate.MonthlyCost)
+	}
+	if estimate.Currency != "USD" {
+		t.Errorf("currency = %s, want USD", estimate.Currency)
+	}
+}
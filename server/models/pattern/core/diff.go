@@ -0,0 +1,227 @@
+package core
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/layer5io/meshery/server/models/pattern/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// Operations the deployment of a design performs on a Kubernetes resource
+const (
+	ResourceCreate = "create"
+	ResourceUpdate = "update"
+	ResourceDelete = "delete"
+	// the resource is deployed as described by the design
+	ResourceUnchanged = "none"
+)
+
+// ResourceChange is the change the deployment of a component makes to a resource of a Kubernetes context
+type ResourceChange struct {
+	Component  string        `json:"component"`
+	ContextID  string        `json:"contextID"`
+	APIVersion string        `json:"apiVersion"`
+	Kind       string        `json:"kind"`
+	Name       string        `json:"name"`
+	Namespace  string        `json:"namespace,omitempty"`
+	Operation  string        `json:"operation"`
+	Fields     []FieldChange `json:"fields,omitempty"`
+}
+
+// PatternIDLabel labels the Kubernetes resources deployed by a design with the id of the design, so that the resources
+// of the components removed from the design since it was deployed can be found
+const PatternIDLabel = "design.meshmodel.io/id"
+
+// FieldChange is a field of a resource updated by the deployment
+type FieldChange struct {
+	// Dot separated path of the field in the resource, eg: spec.replicas
+	Path string      `json:"path"`
+	Live interface{} `json:"live,omitempty"`
+	// Desired is nil when the field is removed from the resource
+	Desired interface{} `json:"desired,omitempty"`
+}
+
+// Changes made to a component of a design by a revision of the design
+const (
+	ComponentAdded    = "added"
+	ComponentRemoved  = "removed"
+	ComponentModified = "modified"
+)
+
+// PatternDiff is the difference between two revisions of a design
+type PatternDiff struct {
+	// Fields of the design other than its components, eg: name or variables.replicas.default
+	Fields     []ValueChange     `json:"fields"`
+	Components []ComponentChange `json:"components"`
+}
+
+// ComponentChange is a component of the design added, removed or modified by a revision of the design
+type ComponentChange struct {
+	Name   string        `json:"name"`
+	Change string        `json:"change"`
+	Fields []ValueChange `json:"fields,omitempty"`
+}
+
+// ValueChange is a field changed by a revision of a design
+type ValueChange struct {
+	// Dot separated path of the field, eg: settings.spec.replicas
+	Path string `json:"path"`
+	// From is nil when the field is added by the revision
+	From interface{} `json:"from,omitempty"`
+	// To is nil when the field is removed by the revision
+	To interface{} `json:"to,omitempty"`
+}
+
+// DiffPatternFiles returns the changes made to a design from one of its revisions to another, ordered by name and path.
+// The fields of the components added or removed are listed as well. Lists are compared as a whole.
+func DiffPatternFiles(from, to []byte) (PatternDiff, error) {
+	fromDesign, err := patternFileFields(from)
+	if err != nil {
+		return PatternDiff{}, err
+	}
+	toDesign, err := patternFileFields(to)
+	if err != nil {
+		return PatternDiff{}, err
+	}
+	fromServices, _ := fromDesign["services"].(map[string]interface{})
+	toServices, _ := toDesign["services"].(map[string]interface{})
+	delete(fromDesign, "services")
+	delete(toDesign, "services")
+
+	return PatternDiff{
+		Fields:     diffFields(fromDesign, toDesign),
+		Components: diffComponents(fromServices, toServices),
+	}, nil
+}
+
+// DiffManifests returns the changes made to the manifests rendered for the components of a design by the dry run of one
+// of its revisions to those rendered by the dry run of another, ordered by name and path. The manifests of a component
+// are keyed by the Kubernetes context they are rendered for, which leads the paths of the fields.
+// The fields the Kubernetes server sets on every dry run, eg: metadata.uid, are ignored.
+func DiffManifests(from, to map[string]interface{}) []ComponentChange {
+	return diffComponents(stripRenderedManifests(from), stripRenderedManifests(to))
+}
+
+// diffComponents returns the components added, removed or modified from one set of components to another, ordered by name
+func diffComponents(from, to map[string]interface{}) []ComponentChange {
+	names := make([]string, 0, len(from)+len(to))
+	for name := range from {
+		names = append(names, name)
+	}
+	for name := range to {
+		if _, ok := from[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := []ComponentChange{}
+	for _, name := range names {
+		f, inFrom := from[name]
+		t, inTo := to[name]
+		fromComp, _ := f.(map[string]interface{})
+		toComp, _ := t.(map[string]interface{})
+		change := ComponentChange{Name: name, Fields: diffFields(fromComp, toComp)}
+		switch {
+		case !inFrom:
+			change.Change = ComponentAdded
+		case !inTo:
+			change.Change = ComponentRemoved
+		case len(change.Fields) != 0:
+			change.Change = ComponentModified
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// fields of the metadata of the manifests the Kubernetes server sets on every dry run
+var renderedMetadataFields = []string{"uid", "creationTimestamp", "resourceVersion", "generation", "managedFields"}
+
+// stripRenderedManifests copies the manifests of the components by context without the fields the Kubernetes server
+// sets on every dry run and without their status
+func stripRenderedManifests(manifests map[string]interface{}) map[string]interface{} {
+	stripped := make(map[string]interface{}, len(manifests))
+	for name, m := range manifests {
+		byContext, _ := m.(map[string]interface{})
+		comp := make(map[string]interface{}, len(byContext))
+		for ctxID, mf := range byContext {
+			manifest, _ := mf.(map[string]interface{})
+			copied := make(map[string]interface{}, len(manifest))
+			for k, v := range manifest {
+				copied[k] = v
+			}
+			delete(copied, "status")
+			if metadata, ok := manifest["metadata"].(map[string]interface{}); ok {
+				md := make(map[string]interface{}, len(metadata))
+				for k, v := range metadata {
+					md[k] = v
+				}
+				for _, field := range renderedMetadataFields {
+					delete(md, field)
+				}
+				copied["metadata"] = md
+			}
+			comp[ctxID] = copied
+		}
+		stripped[name] = comp
+	}
+	return stripped
+}
+
+// patternFileFields parses the pattern file into a map, an empty pattern file is an empty design
+func patternFileFields(patternFile []byte) (map[string]interface{}, error) {
+	var design interface{}
+	if err := yaml.Unmarshal(patternFile, &design); err != nil {
+		return nil, err
+	}
+	fields, _ := utils.ConvertMapInterfaceMapString(design).(map[string]interface{})
+	if fields == nil {
+		fields = make(map[string]interface{})
+	}
+	return fields, nil
+}
+
+// diffFields returns the leaves of the objects which differ, ordered by path
+func diffFields(from, to map[string]interface{}) []ValueChange {
+	fromFields := make(map[string]interface{})
+	flattenFields("", from, fromFields)
+	toFields := make(map[string]interface{})
+	flattenFields("", to, toFields)
+
+	changes := []ValueChange{}
+	for path, f := range fromFields {
+		if t, ok := toFields[path]; !ok || !reflect.DeepEqual(f, t) {
+			changes = append(changes, ValueChange{Path: path, From: f, To: toFields[path]})
+		}
+	}
+	for path, t := range toFields {
+		if _, ok := fromFields[path]; !ok {
+			changes = append(changes, ValueChange{Path: path, To: t})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
+
+// flattenFields stores the leaves of the object by their dot separated path, empty maps and nil values are not leaves
+func flattenFields(prefix string, obj map[string]interface{}, fields map[string]interface{}) {
+	for k, v := range obj {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		switch val := v.(type) {
+		case map[string]interface{}:
+			flattenFields(path, val, fields)
+		case nil:
+		default:
+			fields[path] = val
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffPatternFiles(t *testing.T) {
+	from := `
+name: web
+variables:
+  replicas:
+    default: 1
+services:
+  web:
+    type: Deployment
+    settings:
+      spec:
+        replicas: 1
+        ports: [80]
+  cache:
+    type: Deployment
+`
+	to := `
+name: web-app
+variables:
+  replicas:
+    default: 1
+services:
+  web:
+    type: Deployment
+    settings:
+      spec:
+        ports: [80, 443]
+  cache:
+    type: Deployment
+  db:
+    type: StatefulSet
+`
+
+	diff, err := DiffPatternFiles([]byte(from), []byte(to))
+	if err != nil {
+		t.Fatalf("DiffPatternFiles() error = %v", err)
+	}
+	want := PatternDiff{
+		Fields: []ValueChange{{Path: "name", From: "web", To: "web-app"}},
+		Components: []ComponentChange{
+			{Name: "db", Change: ComponentAdded, Fields: []ValueChange{{Path: "type", To: "StatefulSet"}}},
+			{Name: "web", Change: ComponentModified, Fields: []ValueChange{
+				{Path: "settings.spec.ports", From: []interface{}{80}, To: []interface{}{80, 443}},
+				{Path: "settings.spec.replicas", From: 1},
+			}},
+		},
+	}
+	if !reflect.DeepEqual(diff, want) {
+		t.Errorf("DiffPatternFiles() = %+v, want %+v", diff, want)
+	}
+
+	t.Run("The first revision adds every component", func(t *testing.T) {
+		diff, err := DiffPatternFiles(nil, []byte(from))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(diff.Components) != 2 || diff.Components[0].Change != ComponentAdded || diff.Components[1].Change != ComponentAdded {
+			t.Errorf("components = %+v, want cache and web added", diff.Components)
+		}
+	})
+
+	t.Run("Identical revisions have no changes", func(t *testing.T) {
+		diff, err := DiffPatternFiles([]byte(to), []byte(to))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(diff.Fields) != 0 || len(diff.Components) != 0 {
+			t.Errorf("DiffPatternFiles() = %+v, want no changes", diff)
+		}
+	})
+}
+
+func TestDiffManifests(t *testing.T) {
+	deployment := func(replicas float64, uid string) map[string]interface{} {
+		return map[string]interface{}{
+			"kind": "Deployment",
+			"metadata": map[string]interface{}{
+				"name": "web",
+				"uid":  uid,
+			},
+			"spec":   map[string]interface{}{"replicas": replicas},
+			"status": map[string]interface{}{},
+		}
+	}
+	from := map[string]interface{}{
+		"web":   map[string]interface{}{"ctx": deployment(1, "a")},
+		"cache": map[string]interface{}{"ctx": deployment(1, "b")},
+	}
+	to := map[string]interface{}{
+		"web":   map[string]interface{}{"ctx": deployment(3, "c")},
+		"cache": map[string]interface{}{"ctx": deployment(1, "d")},
+		"db":    map[string]interface{}{"ctx": map[string]interface{}{"kind": "StatefulSet"}},
+	}
+
+	want := []ComponentChange{
+		{Name: "db", Change: ComponentAdded, Fields: []ValueChange{{Path: "ctx.kind", To: "StatefulSet"}}},
+		{Name: "web", Change: ComponentModified, Fields: []ValueChange{{Path: "ctx.spec.replicas", From: float64(1), To: float64(3)}}},
+	}
+	if got := DiffManifests(from, to); !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffManifests() = %+v, want %+v", got, want)
+	}
+	if uid := from["web"].(map[string]interface{})["ctx"].(map[string]interface{})["metadata"].(map[string]interface{})["uid"]; uid != "a" {
+		t.Error("DiffManifests() modified the manifests")
+	}
+}
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"fmt"
+
 	"github.com/layer5io/meshkit/errors"
 )
 
@@ -8,10 +10,14 @@ import (
 // https://docs.meshery.io/project/contributing/contributing-error
 // https://github.com/meshery/meshkit/blob/master/errors/errors.go
 const (
-	ErrGetK8sComponentsCode     = "1400"
-	ErrParseK8sManifestCode     = "1401"
-	ErrCreatePatternServiceCode = "1402"
-	ErrPatternFromCytoscapeCode = "1403"
+	ErrGetK8sComponentsCode         = "1400"
+	ErrParseK8sManifestCode         = "1401"
+	ErrCreatePatternServiceCode     = "1402"
+	ErrPatternFromCytoscapeCode     = "1403"
+	ErrInvalidVariablesCode         = "1555"
+	ErrUnsupportedSchemaVersionCode = "1564"
+	ErrFetchPricesCode              = "1568"
+	ErrUndeployableComponentsCode   = "1597"
 )
 
 func ErrGetK8sComponents(err error) error {
@@ -29,3 +35,23 @@ func ErrCreatePatternService(err error) error {
 func ErrPatternFromCytoscape(err error) error {
 	return errors.New(ErrPatternFromCytoscapeCode, errors.Alert, []string{"Could not create design file from given cytoscape"}, []string{err.Error()}, []string{"Invalid cytoscape body", "Service name is empty for one or more services", "_data does not have correct data"}, []string{"Make sure cytoscape is valid", "Check if valid service name was passed in the request", "Make sure _data field has \"settings\" field"})
 }
+
+func ErrInvalidVariables(err error) error {
+	return errors.New(ErrInvalidVariablesCode, errors.Alert, []string{"Could not render the variables of the design"}, []string{err.Error()}, []string{"A required variable was not supplied a value", "The value of a variable does not match its type or enum", "The design refers to an undeclared variable or the reference is not a valid template"}, []string{"Supply the values of the required variables when deploying the design", "Make sure the values match the schema under \"variables\" in the design", "Refer to the variables as {{ .vars.<name> }}"})
+}
+
+func ErrUnsupportedSchemaVersion(version string) error {
+	return errors.New(ErrUnsupportedSchemaVersionCode, errors.Alert, []string{fmt.Sprintf("The schema version %s of the design is not supported", version)}, []string{fmt.Sprintf("Meshery Server reads designs of the schema versions up to %s", CurrentSchemaVersion)}, []string{"The design was written by a newer version of Meshery.", "The schemaVersion of the design is misspelled."}, []string{"Upgrade Meshery Server.", "Make sure the schemaVersion of the design is one of the supported versions."})
+}
+
+func ErrFetchPrices(err error, url string) error {
+	return errors.New(ErrFetchPricesCode, errors.Alert, []string{"Could not fetch the prices the cost of the design is estimated with"}, []string{err.Error()}, []string{fmt.Sprintf("The pricing API %s is unreachable or did not respond with the prices", url)}, []string{"Make sure COST_PRICING_URL points to a pricing API responding with the currency, cpuCoreHour and memoryGiBHour prices as JSON", "Unset COST_PRICING_URL to estimate the cost with the configured prices"})
+}
+
+func ErrUndeployableComponents(undeployable []ComponentCapability) error {
+	details := make([]string, 0, len(undeployable))
+	for _, c := range undeployable {
+		details = append(details, fmt.Sprintf("%s (%s %s) cannot be deployed to the Kubernetes context %s: %s", c.Component, c.APIVersion, c.Kind, c.ContextID, c.Reason))
+	}
+	return errors.New(ErrUndeployableComponentsCode, errors.Alert, []string{"The clusters do not serve the kinds of some of the components of the design"}, details, []string{"The operators or the CustomResourceDefinitions providing the kinds of the components are not installed in the clusters", "The clusters run a version of Kubernetes which does not serve the API versions of the components"}, []string{"Install the operators the components depend on, or add their CustomResourceDefinitions to the design", "Update the API versions of the components to ones served by the clusters, see the capabilities of the design"})
+}
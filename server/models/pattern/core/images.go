@@ -0,0 +1,47 @@
+package core
+
+import (
+	"sort"
+
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+)
+
+// ContainerImages returns the images of the containers and init containers of the pods of the workloads among the
+// components, by component. The images of a component are ordered and unique.
+func ContainerImages(comps []v1alpha1.Component) map[string][]string {
+	images := make(map[string][]string)
+	for _, comp := range comps {
+		kind := v1alpha1.GetKindFromComponent(comp)
+		if kind == "" {
+			kind = comp.Spec.Type
+		}
+		workload, ok := workloadPodSpecs[kind]
+		if !ok {
+			continue
+		}
+		settings := comp.Spec.Settings
+		if Format {
+			settings = Format.DePrettify(settings, false)
+		}
+		podSpec, _ := nestedValue(settings, workload.podSpec...).(map[string]interface{})
+
+		unique := make(map[string]bool)
+		for _, key := range []string{"initContainers", "containers"} {
+			containers, _ := podSpec[key].([]interface{})
+			for _, c := range containers {
+				container, _ := c.(map[string]interface{})
+				if image, _ := container["image"].(string); image != "" {
+					unique[image] = true
+				}
+			}
+		}
+		if len(unique) == 0 {
+			continue
+		}
+		for image := range unique {
+			images[comp.Name] = append(images[comp.Name], image)
+		}
+		sort.Strings(images[comp.Name])
+	}
+	return images
+}
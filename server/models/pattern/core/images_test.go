@@ -0,0 +1,33 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+)
+
+func TestContainerImages(t *testing.T) {
+	comps := []v1alpha1.Component{
+		costComponent("web", "Deployment", map[string]interface{}{
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{"name": "app", "image": "nginx:1.25"},
+							map[string]interface{}{"name": "proxy", "image": "envoyproxy/envoy:v1.28"},
+						},
+						"initContainers": []interface{}{
+							map[string]interface{}{"name": "init", "image": "nginx:1.25"},
+						},
+					},
+				},
+			},
+		}),
+		costComponent("web-svc", "Service", map[string]interface{}{}),
+	}
+	want := map[string][]string{"web": {"envoyproxy/envoy:v1.28", "nginx:1.25"}}
+	if got := ContainerImages(comps); !reflect.DeepEqual(got, want) {
+		t.Errorf("ContainerImages() = %v, want %v", got, want)
+	}
+}
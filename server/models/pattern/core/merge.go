@@ -0,0 +1,143 @@
+package core
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Sides of a merge a conflict can be resolved with
+const (
+	MergeOurs   = "ours"
+	MergeTheirs = "theirs"
+)
+
+// MergeConflict is a field of a component both merged designs changed differently
+type MergeConflict struct {
+	// Component is the name of the component of the design, empty for the fields of the design itself
+	Component string `json:"component"`
+	// Dot separated path of the field in the component, empty when one design deleted the component the other modified
+	Path   string      `json:"path"`
+	Base   interface{} `json:"base,omitempty"`
+	Ours   interface{} `json:"ours,omitempty"`
+	Theirs interface{} `json:"theirs,omitempty"`
+}
+
+// MergeResolution resolves the conflict of a field by taking the value of one of the merged designs
+type MergeResolution struct {
+	Component string `json:"component"`
+	Path      string `json:"path"`
+	// Take is either MergeOurs or MergeTheirs
+	Take string `json:"take"`
+}
+
+// MergeResult is the merged design and the conflicts left unresolved, whose fields are taken from our design
+type MergeResult struct {
+	PatternFile []byte
+	Conflicts   []MergeConflict
+}
+
+// mergeValue is a value of a merged design, ok is false when the design does not have the field
+type mergeValue struct {
+	v  interface{}
+	ok bool
+}
+
+type merger struct {
+	resolutions map[[2]string]string
+	conflicts   []MergeConflict
+}
+
+// MergePatternFiles merges the changes made to the base design by our design and theirs, field by field.
+// The fields changed by only one of the designs are taken from it, while the fields both designs changed differently
+// are conflicts, unless resolved by the resolutions. Without a base design, the fields only one of the designs has are
+// taken from it and every other difference is a conflict. Lists are merged as a whole.
+func MergePatternFiles(base, ours, theirs []byte, resolutions []MergeResolution) (MergeResult, error) {
+	designs := make([]map[string]interface{}, 0, 3)
+	for _, patternFile := range [][]byte{base, ours, theirs} {
+		design, err := patternFileFields(patternFile)
+		if err != nil {
+			return MergeResult{}, err
+		}
+		designs = append(designs, design)
+	}
+
+	m := &merger{resolutions: make(map[[2]string]string, len(resolutions))}
+	for _, r := range resolutions {
+		m.resolutions[[2]string{r.Component, r.Path}] = r.Take
+	}
+	merged := m.merge(nil,
+		mergeValue{designs[0], len(base) != 0},
+		mergeValue{designs[1], true},
+		mergeValue{designs[2], true},
+	)
+
+	byt, err := yaml.Marshal(merged.v)
+	if err != nil {
+		return MergeResult{}, err
+	}
+	sort.Slice(m.conflicts, func(i, j int) bool {
+		if m.conflicts[i].Component != m.conflicts[j].Component {
+			return m.conflicts[i].Component < m.conflicts[j].Component
+		}
+		return m.conflicts[i].Path < m.conflicts[j].Path
+	})
+	if m.conflicts == nil {
+		m.conflicts = []MergeConflict{}
+	}
+	return MergeResult{PatternFile: byt, Conflicts: m.conflicts}, nil
+}
+
+func (m *merger) merge(path []string, base, ours, theirs mergeValue) mergeValue {
+	if equalMergeValues(ours, theirs) || equalMergeValues(base, theirs) {
+		return ours
+	}
+	if equalMergeValues(base, ours) {
+		return theirs
+	}
+
+	ourFields, oursIsMap := ours.v.(map[string]interface{})
+	theirFields, theirsIsMap := theirs.v.(map[string]interface{})
+	if ours.ok && theirs.ok && oursIsMap && theirsIsMap {
+		baseFields, _ := base.v.(map[string]interface{})
+		merged := make(map[string]interface{}, len(ourFields))
+		keys := make(map[string]bool, len(ourFields)+len(theirFields))
+		for k := range ourFields {
+			keys[k] = true
+		}
+		for k := range theirFields {
+			keys[k] = true
+		}
+		for k := range keys {
+			// copy the path, as the recursive calls append to it
+			fieldPath := append(append(make([]string, 0, len(path)+1), path...), k)
+			b, bok := baseFields[k]
+			o, ook := ourFields[k]
+			t, tok := theirFields[k]
+			if v := m.merge(fieldPath, mergeValue{b, bok}, mergeValue{o, ook}, mergeValue{t, tok}); v.ok {
+				merged[k] = v.v
+			}
+		}
+		return mergeValue{merged, true}
+	}
+
+	conflict := MergeConflict{Path: strings.Join(path, "."), Base: base.v, Ours: ours.v, Theirs: theirs.v}
+	if len(path) >= 2 && path[0] == "services" {
+		conflict.Component = path[1]
+		conflict.Path = strings.Join(path[2:], ".")
+	}
+	switch m.resolutions[[2]string{conflict.Component, conflict.Path}] {
+	case MergeOurs:
+		return ours
+	case MergeTheirs:
+		return theirs
+	}
+	m.conflicts = append(m.conflicts, conflict)
+	return ours
+}
+
+func equalMergeValues(a, b mergeValue) bool {
+	return a.ok == b.ok && (!a.ok || reflect.DeepEqual(a.v, b.v))
+}
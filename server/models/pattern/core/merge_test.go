@@ -0,0 +1,117 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestMergePatternFiles(t *testing.T) {
+	base := `
+name: web
+services:
+  web:
+    type: Deployment
+    settings:
+      spec:
+        replicas: 1
+        image: nginx:1.24
+  cache:
+    type: Deployment
+  queue:
+    type: Deployment
+`
+	ours := `
+name: web
+services:
+  web:
+    type: Deployment
+    settings:
+      spec:
+        replicas: 2
+        image: nginx:1.25
+  cache:
+    type: Deployment
+    labels:
+      tier: cache
+  db:
+    type: StatefulSet
+`
+	theirs := `
+name: shop
+services:
+  web:
+    type: Deployment
+    settings:
+      spec:
+        replicas: 3
+        image: nginx:1.24
+  queue:
+    type: Deployment
+`
+
+	t.Run("Changes made by one of the designs are merged", func(t *testing.T) {
+		result, err := MergePatternFiles([]byte(base), []byte(ours), []byte(theirs), nil)
+		if err != nil {
+			t.Fatalf("MergePatternFiles() error = %v", err)
+		}
+		wantConflicts := []MergeConflict{
+			{Component: "cache", Path: "", Base: map[string]interface{}{"type": "Deployment"}, Ours: map[string]interface{}{"type": "Deployment", "labels": map[string]interface{}{"tier": "cache"}}},
+			{Component: "web", Path: "settings.spec.replicas", Base: 1, Ours: 2, Theirs: 3},
+		}
+		if !reflect.DeepEqual(result.Conflicts, wantConflicts) {
+			t.Errorf("conflicts = %+v, want %+v", result.Conflicts, wantConflicts)
+		}
+
+		var merged map[string]interface{}
+		if err := yaml.Unmarshal(result.PatternFile, &merged); err != nil {
+			t.Fatal(err)
+		}
+		services := merged["services"].(map[interface{}]interface{})
+		if merged["name"] != "shop" || services["db"] == nil || services["queue"] != nil || services["cache"] == nil {
+			t.Errorf("merged = %v", merged)
+		}
+		spec := services["web"].(map[interface{}]interface{})["settings"].(map[interface{}]interface{})["spec"].(map[interface{}]interface{})
+		if spec["image"] != "nginx:1.25" || spec["replicas"] != 2 {
+			t.Errorf("spec = %v, want our image and replicas", spec)
+		}
+	})
+
+	t.Run("Conflicts are resolved by the resolutions", func(t *testing.T) {
+		result, err := MergePatternFiles([]byte(base), []byte(ours), []byte(theirs), []MergeResolution{
+			{Component: "web", Path: "settings.spec.replicas", Take: MergeTheirs},
+			{Component: "cache", Take: MergeTheirs},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(result.Conflicts) != 0 {
+			t.Errorf("conflicts = %+v, want none", result.Conflicts)
+		}
+		var merged map[string]interface{}
+		if err := yaml.Unmarshal(result.PatternFile, &merged); err != nil {
+			t.Fatal(err)
+		}
+		services := merged["services"].(map[interface{}]interface{})
+		spec := services["web"].(map[interface{}]interface{})["settings"].(map[interface{}]interface{})["spec"].(map[interface{}]interface{})
+		if spec["replicas"] != 3 || services["cache"] != nil {
+			t.Errorf("merged = %v, want their replicas and cache deleted", merged)
+		}
+	})
+
+	t.Run("Without a base only the fields both designs have can conflict", func(t *testing.T) {
+		result, err := MergePatternFiles(nil, []byte(ours), []byte(theirs), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var paths []string
+		for _, c := range result.Conflicts {
+			paths = append(paths, c.Component+":"+c.Path)
+		}
+		want := []string{":name", "web:settings.spec.image", "web:settings.spec.replicas"}
+		if !reflect.DeepEqual(paths, want) {
+			t.Errorf("conflicts = %v, want %v", paths, want)
+		}
+	})
+}
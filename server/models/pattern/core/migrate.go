@@ -0,0 +1,146 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshery/server/models/pattern/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// Versions of the format of pattern files, declared by their schemaVersion.
+// Pattern files without schemaVersion are of the first version, SchemaVersionV1Alpha1.
+const (
+	// SchemaVersionV1Alpha1 is the format of the designs created with OAM, whose services name their model as a suffix
+	// of their type, eg: IstioMesh.ISTIO, and may declare the OAM apiVersion core.oam.dev/v1alpha1
+	SchemaVersionV1Alpha1 = "v1alpha1"
+	// SchemaVersionV1Alpha2 is the format of the designs whose services refer to the components of the registry by their
+	// type, model, version and apiVersion
+	SchemaVersionV1Alpha2 = "v1alpha2"
+
+	// CurrentSchemaVersion is the version pattern files are upgraded to when they are read
+	CurrentSchemaVersion = SchemaVersionV1Alpha2
+)
+
+const oamAPIVersion = "core.oam.dev/v1alpha1"
+
+// patternMigration upgrades a pattern file from a version of the format to the next one. The pattern file is
+// migrated as a document rather than as a Pattern, so that the fields it does not know about are kept.
+type patternMigration struct {
+	from    string
+	to      string
+	migrate func(doc map[string]interface{}, report *MigrationReport)
+}
+
+// migrations in the order of the versions of the format
+var migrations = []patternMigration{
+	{from: SchemaVersionV1Alpha1, to: SchemaVersionV1Alpha2, migrate: migrateV1Alpha1},
+}
+
+// MigrationReport describes the migration of a pattern file to the current version of the format
+type MigrationReport struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// Changes made by the migration
+	Changes []string `json:"changes,omitempty"`
+	// Incompatibilities are the parts of the pattern file which could not be migrated, and need to be fixed by hand
+	Incompatibilities []string `json:"incompatibilities,omitempty"`
+}
+
+// Migrated tells if the pattern file was of an older version of the format
+func (r MigrationReport) Migrated() bool {
+	return r.From != r.To
+}
+
+// MigratePatternFile upgrades the YAML or JSON pattern file to CurrentSchemaVersion, and returns it as YAML along with
+// the report of the migration. Pattern files of the current version are returned as they are. Pattern files of a
+// version the server does not know, eg: written by a newer server, are rejected.
+func MigratePatternFile(patternFile []byte) ([]byte, MigrationReport, error) {
+	report := MigrationReport{From: CurrentSchemaVersion, To: CurrentSchemaVersion}
+	var raw interface{}
+	if err := yaml.Unmarshal(patternFile, &raw); err != nil {
+		return nil, report, err
+	}
+	doc, ok := utils.ConvertMapInterfaceMapString(raw).(map[string]interface{})
+	if !ok {
+		// not a pattern file, it is left to the parsing of the pattern to reject it
+		return patternFile, report, nil
+	}
+
+	version, _ := doc["schemaVersion"].(string)
+	if version == "" {
+		version = SchemaVersionV1Alpha1
+	}
+	report.From = version
+	if version == CurrentSchemaVersion {
+		return patternFile, report, nil
+	}
+
+	start := -1
+	for i, m := range migrations {
+		if m.from == version {
+			start = i
+			break
+		}
+	}
+	if start == -1 {
+		return nil, report, ErrUnsupportedSchemaVersion(version)
+	}
+	for _, m := range migrations[start:] {
+		m.migrate(doc, &report)
+		doc["schemaVersion"] = m.to
+	}
+
+	byt, err := yaml.Marshal(doc)
+	if err != nil {
+		return nil, report, err
+	}
+	return byt, report, nil
+}
+
+// migrateV1Alpha1 drops the OAM apiVersion of the services, and moves the model from the suffix of their type to their model
+func migrateV1Alpha1(doc map[string]interface{}, report *MigrationReport) {
+	services, _ := doc["services"].(map[string]interface{})
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		svc, ok := services[name].(map[string]interface{})
+		if !ok {
+			report.Incompatibilities = append(report.Incompatibilities, fmt.Sprintf("service %s is not an object", name))
+			continue
+		}
+		if apiVersion, _ := svc["apiVersion"].(string); apiVersion == oamAPIVersion {
+			delete(svc, "apiVersion")
+			report.Changes = append(report.Changes, fmt.Sprintf("service %s: removed the OAM apiVersion %s", name, oamAPIVersion))
+		}
+
+		typ, _ := svc["type"].(string)
+		if typ == "" {
+			report.Incompatibilities = append(report.Incompatibilities, fmt.Sprintf("service %s has no type", name))
+			continue
+		}
+		model, _ := svc["model"].(string)
+		if kind, suffix, ok := strings.Cut(typ, "."); ok {
+			svc["type"] = kind
+			report.Changes = append(report.Changes, fmt.Sprintf("service %s: changed the type %s to %s", name, typ, kind))
+			switch {
+			case model == "":
+				model = strings.ToLower(suffix)
+				svc["model"] = model
+				report.Changes = append(report.Changes, fmt.Sprintf("service %s: set the model to %s", name, model))
+			case !strings.EqualFold(model, suffix):
+				report.Incompatibilities = append(report.Incompatibilities, fmt.Sprintf("service %s: its type %s names the model %s but its model is %s", name, typ, suffix, model))
+			}
+			typ = kind
+		}
+		if model == "" && typ == "Application" {
+			svc["model"] = "core"
+			report.Changes = append(report.Changes, fmt.Sprintf("service %s: set the model to core", name))
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+
+	meshkiterrors "github.com/layer5io/meshkit/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const v1alpha1Pattern = `
+name: mesh
+services:
+  istio:
+    type: IstioMesh.ISTIO
+    apiVersion: core.oam.dev/v1alpha1
+    settings:
+      profile: demo
+  app:
+    type: Application
+  web:
+    type: Deployment.K8s
+    model: linkerd
+`
+
+func TestMigratePatternFile(t *testing.T) {
+	migrated, report, err := MigratePatternFile([]byte(v1alpha1Pattern))
+	if err != nil {
+		t.Fatalf("MigratePatternFile() error = %v", err)
+	}
+	if report.From != SchemaVersionV1Alpha1 || report.To != CurrentSchemaVersion || !report.Migrated() {
+		t.Errorf("report = %+v, want a migration from %s to %s", report, SchemaVersionV1Alpha1, CurrentSchemaVersion)
+	}
+
+	t.Run("Services refer to the components by their type and model", func(t *testing.T) {
+		var doc map[string]interface{}
+		if err := yaml.Unmarshal(migrated, &doc); err != nil {
+			t.Fatal(err)
+		}
+		if doc["schemaVersion"] != CurrentSchemaVersion {
+			t.Errorf("schemaVersion = %v, want %s", doc["schemaVersion"], CurrentSchemaVersion)
+		}
+		services := doc["services"].(map[interface{}]interface{})
+		want := map[interface{}]interface{}{
+			"type":     "IstioMesh",
+			"model":    "istio",
+			"settings": map[interface{}]interface{}{"profile": "demo"},
+		}
+		if !reflect.DeepEqual(services["istio"], want) {
+			t.Errorf("istio = %v, want %v", services["istio"], want)
+		}
+		if app := services["app"].(map[interface{}]interface{}); app["model"] != "core" {
+			t.Errorf("app = %v, want the core model", app)
+		}
+	})
+
+	t.Run("Conflicting models are reported", func(t *testing.T) {
+		want := []string{"service web: its type Deployment.K8s names the model K8s but its model is linkerd"}
+		if !reflect.DeepEqual(report.Incompatibilities, want) {
+			t.Errorf("incompatibilities = %v, want %v", report.Incompatibilities, want)
+		}
+	})
+
+	t.Run("Pattern files of the current version are left as they are", func(t *testing.T) {
+		again, report, err := MigratePatternFile(migrated)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if report.Migrated() || string(again) != string(migrated) {
+			t.Errorf("the pattern file was migrated again: %+v", report)
+		}
+	})
+}
+
+func TestMigratePatternFileUnsupportedVersion(t *testing.T) {
+	_, _, err := MigratePatternFile([]byte("name: mesh\nschemaVersion: v2\n"))
+	if err == nil || meshkiterrors.GetCode(err) != ErrUnsupportedSchemaVersionCode {
+		t.Errorf("MigratePatternFile() error = %v, want %s", err, ErrUnsupportedSchemaVersionCode)
+	}
+}
@@ -147,8 +147,13 @@ type DryRunFailureCause struct {
 type Pattern struct {
 	// Name is the human-readable, display-friendly descriptor of the pattern
 	Name string `yaml:"name,omitempty" json:"name,omitempty"`
+	// SchemaVersion is the version of the format of the pattern file, see MigratePatternFile
+	SchemaVersion string `yaml:"schemaVersion,omitempty" json:"schemaVersion,omitempty"`
 	//Vars will be used to configure the pattern when it is imported from other patterns.
+	//They are also the values of the Variables of the pattern.
 	Vars map[string]interface{} `yaml:"vars,omitempty" json:"vars,omitempty"`
+	// Variables declares the variables the services of the pattern refer to as {{ .vars.<name> }}
+	Variables map[string]*Variable `yaml:"variables,omitempty" json:"variables,omitempty"`
 	// PatternID is the moniker use to uniquely identify any given pattern
 	// Convention: SMP-###-v#.#.#
 	PatternID string              `yaml:"patternID,omitempty" json:"patternID,omitempty"`
@@ -182,6 +187,11 @@ type Service struct {
 
 // NewPatternFile takes in raw yaml and encodes it into a construct
 func NewPatternFile(yml []byte) (af Pattern, err error) {
+	// pattern files of older versions of the format are upgraded to the current one
+	yml, _, err = MigratePatternFile(yml)
+	if err != nil {
+		return af, err
+	}
 	err = yaml.Unmarshal(yml, &af)
 	if err != nil {
 		return af, err
@@ -357,8 +367,9 @@ func NewPatternFileFromCytoscapeJSJSON(name string, byt []byte) (Pattern, error)
 	}
 	// Convert cytoscape struct to patternfile
 	pf := Pattern{
-		Name:     name,
-		Services: make(map[string]*Service),
+		Name:          name,
+		SchemaVersion: CurrentSchemaVersion,
+		Services:      make(map[string]*Service),
 	}
 	dependsOnMap := make(map[string][]string, 0) //used to figure out dependencies from traits.meshmap.parent
 	eleToSvc := make(map[string]string)          //used to map cyto element ID uniquely to the name of the service created.
@@ -478,8 +489,9 @@ func manifestIsEmpty(manifests []string) bool {
 // Note: If modified, make sure this function always returns a meshkit error
 func NewPatternFileFromK8sManifest(data string, ignoreErrors bool, reg *meshmodel.RegistryManager) (Pattern, error) {
 	pattern := Pattern{
-		Name:     "Autogenerated",
-		Services: map[string]*Service{},
+		Name:          "Autogenerated",
+		SchemaVersion: CurrentSchemaVersion,
+		Services:      map[string]*Service{},
 	}
 
 	manifests := strings.Split(data, "\n---\n")
@@ -0,0 +1,205 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// States of the rollout of the resources of the components of a deployed design
+const (
+	// RolloutPending is the state of the resources which do not exist yet
+	RolloutPending     = "pending"
+	RolloutProgressing = "progressing"
+	RolloutReady       = "ready"
+	RolloutFailed      = "failed"
+)
+
+// the reasons the containers of a pod wait for, which they do not recover from without a change of the pod
+var failedContainerReasons = map[string]bool{
+	"CrashLoopBackOff":           true,
+	"ErrImagePull":               true,
+	"ImagePullBackOff":           true,
+	"InvalidImageName":           true,
+	"CreateContainerConfigError": true,
+	"CreateContainerError":       true,
+}
+
+// ComponentRollout is the status of the rollout of the resource of a component of a deployed design
+type ComponentRollout struct {
+	Component string `json:"component"`
+	ContextID string `json:"contextID"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Status    string `json:"status"`
+	Message   string `json:"message,omitempty"`
+}
+
+// DesignRollout is the status of the rollout of a deployed design and of the resources of its components
+type DesignRollout struct {
+	Status     string             `json:"status"`
+	Components []ComponentRollout `json:"components"`
+}
+
+// RolloutStatus tells the state of the rollout of the live resource of the kind and why, with the semantics of
+// kubectl rollout status for deployments, statefulsets and daemonsets. Pods are ready once their containers are,
+// jobs once they complete, and the resources of the other kinds as soon as they exist.
+func RolloutStatus(kind string, live map[string]interface{}) (string, string) {
+	switch kind {
+	case "Deployment":
+		return deploymentRolloutStatus(live)
+	case "StatefulSet":
+		return statefulSetRolloutStatus(live)
+	case "DaemonSet":
+		return daemonSetRolloutStatus(live)
+	case "Pod":
+		return podRolloutStatus(live)
+	case "Job":
+		return jobRolloutStatus(live)
+	}
+	return RolloutReady, ""
+}
+
+// DesignRolloutStatus tells the state of the rollout of a design from the states of its components: failed as soon as
+// one of them failed, ready once all of them are
+func DesignRolloutStatus(rollouts []ComponentRollout) string {
+	status := RolloutReady
+	for _, rollout := range rollouts {
+		switch rollout.Status {
+		case RolloutFailed:
+			return RolloutFailed
+		case RolloutReady:
+		default:
+			status = RolloutProgressing
+		}
+	}
+	return status
+}
+
+func deploymentRolloutStatus(live map[string]interface{}) (string, string) {
+	if !generationObserved(live) {
+		return RolloutProgressing, "waiting for the update of the deployment to be observed"
+	}
+	if condition := statusCondition(live, "Progressing"); condition != nil && condition["reason"] == "ProgressDeadlineExceeded" {
+		return RolloutFailed, fmt.Sprintf("the rollout exceeded its progress deadline: %v", condition["message"])
+	}
+	replicas := specReplicas(live)
+	updated := nestedInt(live, "status", "updatedReplicas")
+	current := nestedInt(live, "status", "replicas")
+	available := nestedInt(live, "status", "availableReplicas")
+	switch {
+	case updated < replicas:
+		return RolloutProgressing, fmt.Sprintf("%d of %d new replicas have been updated", updated, replicas)
+	case current > updated:
+		return RolloutProgressing, fmt.Sprintf("%d old replicas are pending termination", current-updated)
+	case available < updated:
+		return RolloutProgressing, fmt.Sprintf("%d of %d updated replicas are available", available, updated)
+	}
+	return RolloutReady, fmt.Sprintf("%d of %d replicas are available", available, replicas)
+}
+
+func statefulSetRolloutStatus(live map[string]interface{}) (string, string) {
+	if !generationObserved(live) {
+		return RolloutProgressing, "waiting for the update of the statefulset to be observed"
+	}
+	replicas := specReplicas(live)
+	ready := nestedInt(live, "status", "readyReplicas")
+	if ready < replicas {
+		return RolloutProgressing, fmt.Sprintf("%d of %d replicas are ready", ready, replicas)
+	}
+	strategy, _ := nestedValue(live, "spec", "updateStrategy", "type").(string)
+	current, _ := nestedValue(live, "status", "currentRevision").(string)
+	update, _ := nestedValue(live, "status", "updateRevision").(string)
+	if strategy != "OnDelete" && current != update {
+		return RolloutProgressing, fmt.Sprintf("%d of %d replicas are updated to revision %s", nestedInt(live, "status", "updatedReplicas"), replicas, update)
+	}
+	return RolloutReady, fmt.Sprintf("%d of %d replicas are ready", ready, replicas)
+}
+
+func daemonSetRolloutStatus(live map[string]interface{}) (string, string) {
+	if !generationObserved(live) {
+		return RolloutProgressing, "waiting for the update of the daemonset to be observed"
+	}
+	desired := nestedInt(live, "status", "desiredNumberScheduled")
+	updated := nestedInt(live, "status", "updatedNumberScheduled")
+	available := nestedInt(live, "status", "numberAvailable")
+	switch {
+	case updated < desired:
+		return RolloutProgressing, fmt.Sprintf("%d of %d pods have been updated", updated, desired)
+	case available < desired:
+		return RolloutProgressing, fmt.Sprintf("%d of %d updated pods are available", available, desired)
+	}
+	return RolloutReady, fmt.Sprintf("%d of %d pods are available", available, desired)
+}
+
+func podRolloutStatus(live map[string]interface{}) (string, string) {
+	switch phase, _ := nestedValue(live, "status", "phase").(string); phase {
+	case "Succeeded":
+		return RolloutReady, "the pod completed"
+	case "Failed":
+		reason, _ := nestedValue(live, "status", "reason").(string)
+		return RolloutFailed, strings.TrimSpace("the pod failed " + reason)
+	}
+	for _, key := range []string{"initContainerStatuses", "containerStatuses"} {
+		statuses, _ := nestedValue(live, "status", key).([]interface{})
+		for _, s := range statuses {
+			status, _ := s.(map[string]interface{})
+			reason, _ := nestedValue(status, "state", "waiting", "reason").(string)
+			if failedContainerReasons[reason] {
+				message, _ := nestedValue(status, "state", "waiting", "message").(string)
+				return RolloutFailed, strings.TrimSpace(fmt.Sprintf("the container %v is waiting: %s %s", status["name"], reason, message))
+			}
+		}
+	}
+	if condition := statusCondition(live, "Ready"); condition != nil && condition["status"] == "True" {
+		return RolloutReady, "the containers of the pod are ready"
+	}
+	return RolloutProgressing, "waiting for the containers of the pod to be ready"
+}
+
+func jobRolloutStatus(live map[string]interface{}) (string, string) {
+	if condition := statusCondition(live, "Failed"); condition != nil && condition["status"] == "True" {
+		return RolloutFailed, fmt.Sprintf("the job failed: %v", condition["message"])
+	}
+	if condition := statusCondition(live, "Complete"); condition != nil && condition["status"] == "True" {
+		return RolloutReady, "the job completed"
+	}
+	return RolloutProgressing, fmt.Sprintf("%d pods of the job succeeded", nestedInt(live, "status", "succeeded"))
+}
+
+// generationObserved tells if the controller of the resource observed its latest generation
+func generationObserved(live map[string]interface{}) bool {
+	return nestedInt(live, "status", "observedGeneration") >= nestedInt(live, "metadata", "generation")
+}
+
+// specReplicas returns the replicas of the spec of the resource, which default to 1
+func specReplicas(live map[string]interface{}) int64 {
+	if nestedValue(live, "spec", "replicas") == nil {
+		return 1
+	}
+	return nestedInt(live, "spec", "replicas")
+}
+
+// statusCondition returns the condition of the status of the resource of the type, nil if it has none
+func statusCondition(live map[string]interface{}, typ string) map[string]interface{} {
+	conditions, _ := nestedValue(live, "status", "conditions").([]interface{})
+	for _, c := range conditions {
+		condition, _ := c.(map[string]interface{})
+		if condition["type"] == typ {
+			return condition
+		}
+	}
+	return nil
+}
+
+func nestedInt(obj map[string]interface{}, path ...string) int64 {
+	switch val := nestedValue(obj, path...).(type) {
+	case float64:
+		return int64(val)
+	case int:
+		return int64(val)
+	case int64:
+		return val
+	}
+	return 0
+}
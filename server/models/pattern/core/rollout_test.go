@@ -0,0 +1,129 @@
+package core
+
+import "testing"
+
+func TestRolloutStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		kind string
+		live map[string]interface{}
+		want string
+	}{
+		{
+			name: "Deployment with its update not observed yet",
+			kind: "Deployment",
+			live: map[string]interface{}{
+				"metadata": map[string]interface{}{"generation": float64(2)},
+				"status":   map[string]interface{}{"observedGeneration": float64(1)},
+			},
+			want: RolloutProgressing,
+		},
+		{
+			name: "Deployment with old replicas",
+			kind: "Deployment",
+			live: map[string]interface{}{
+				"spec":   map[string]interface{}{"replicas": float64(2)},
+				"status": map[string]interface{}{"replicas": float64(3), "updatedReplicas": float64(2), "availableReplicas": float64(2)},
+			},
+			want: RolloutProgressing,
+		},
+		{
+			name: "Deployment with its replicas available",
+			kind: "Deployment",
+			live: map[string]interface{}{
+				"spec":   map[string]interface{}{"replicas": float64(2)},
+				"status": map[string]interface{}{"replicas": float64(2), "updatedReplicas": float64(2), "availableReplicas": float64(2)},
+			},
+			want: RolloutReady,
+		},
+		{
+			name: "Deployment past its progress deadline",
+			kind: "Deployment",
+			live: map[string]interface{}{
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded"},
+					},
+				},
+			},
+			want: RolloutFailed,
+		},
+		{
+			name: "StatefulSet with a pending revision",
+			kind: "StatefulSet",
+			live: map[string]interface{}{
+				"status": map[string]interface{}{"readyReplicas": float64(1), "currentRevision": "web-1", "updateRevision": "web-2"},
+			},
+			want: RolloutProgressing,
+		},
+		{
+			name: "DaemonSet with unavailable pods",
+			kind: "DaemonSet",
+			live: map[string]interface{}{
+				"status": map[string]interface{}{"desiredNumberScheduled": float64(3), "updatedNumberScheduled": float64(3), "numberAvailable": float64(2)},
+			},
+			want: RolloutProgressing,
+		},
+		{
+			name: "Pod failing to pull its image",
+			kind: "Pod",
+			live: map[string]interface{}{
+				"status": map[string]interface{}{
+					"phase": "Pending",
+					"containerStatuses": []interface{}{
+						map[string]interface{}{"name": "app", "state": map[string]interface{}{"waiting": map[string]interface{}{"reason": "ImagePullBackOff"}}},
+					},
+				},
+			},
+			want: RolloutFailed,
+		},
+		{
+			name: "Pod with ready containers",
+			kind: "Pod",
+			live: map[string]interface{}{
+				"status": map[string]interface{}{
+					"phase":      "Running",
+					"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": "True"}},
+				},
+			},
+			want: RolloutReady,
+		},
+		{
+			name: "Completed Job",
+			kind: "Job",
+			live: map[string]interface{}{
+				"status": map[string]interface{}{
+					"conditions": []interface{}{map[string]interface{}{"type": "Complete", "status": "True"}},
+				},
+			},
+			want: RolloutReady,
+		},
+		{
+			name: "Service",
+			kind: "Service",
+			live: map[string]interface{}{},
+			want: RolloutReady,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, message := RolloutStatus(tt.kind, tt.live); got != tt.want {
+				t.Errorf("RolloutStatus() = %s (%s), want %s", got, message, tt.want)
+			}
+		})
+	}
+}
+
+func TestDesignRolloutStatus(t *testing.T) {
+	rollouts := []ComponentRollout{{Status: RolloutReady}, {Status: RolloutPending}}
+	if got := DesignRolloutStatus(rollouts); got != RolloutProgressing {
+		t.Errorf("DesignRolloutStatus() = %s, want %s", got, RolloutProgressing)
+	}
+	rollouts = append(rollouts, ComponentRollout{Status: RolloutFailed})
+	if got := DesignRolloutStatus(rollouts); got != RolloutFailed {
+		t.Errorf("DesignRolloutStatus() = %s, want %s", got, RolloutFailed)
+	}
+	if got := DesignRolloutStatus(rollouts[:1]); got != RolloutReady {
+		t.Errorf("DesignRolloutStatus() = %s, want %s", got, RolloutReady)
+	}
+}
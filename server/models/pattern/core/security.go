@@ -0,0 +1,189 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+)
+
+// Severities of the findings of the security analysis of a design
+const (
+	SecurityCritical = "critical"
+	SecurityHigh     = "high"
+	SecurityMedium   = "medium"
+	SecurityLow      = "low"
+)
+
+// the points a finding of each severity takes from the score of a design
+var securityPenalties = map[string]int{
+	SecurityCritical: 30,
+	SecurityHigh:     15,
+	SecurityMedium:   5,
+	SecurityLow:      2,
+}
+
+var securitySeverityOrder = map[string]int{
+	SecurityCritical: 0,
+	SecurityHigh:     1,
+	SecurityMedium:   2,
+	SecurityLow:      3,
+}
+
+// SecurityReport is the security posture of the components of a design
+type SecurityReport struct {
+	// Score goes from 100, for designs without findings, down to 0
+	Score    int               `json:"score"`
+	Summary  map[string]int    `json:"summary"`
+	Findings []SecurityFinding `json:"findings"`
+}
+
+// SecurityFinding is an insecure setting of a component of a design
+type SecurityFinding struct {
+	Component string `json:"component"`
+	Container string `json:"container,omitempty"`
+	Rule      string `json:"rule"`
+	Severity  string `json:"severity"`
+	Message   string `json:"message"`
+	// Dot separated path of the setting in the component, eg: spec.template.spec.hostNetwork
+	Path string `json:"path"`
+}
+
+// AnalyzeSecurity checks the pods of the workloads among the components for insecure settings, eg: privileged
+// containers, hostPath volumes, containers without resource limits or images without a pinned tag, and scores them.
+// Findings are ordered by severity, component and path.
+func AnalyzeSecurity(comps []v1alpha1.Component) SecurityReport {
+	report := SecurityReport{Summary: map[string]int{}, Findings: []SecurityFinding{}}
+	for _, comp := range comps {
+		kind := v1alpha1.GetKindFromComponent(comp)
+		if kind == "" {
+			kind = comp.Spec.Type
+		}
+		workload, ok := workloadPodSpecs[kind]
+		if !ok {
+			continue
+		}
+		settings := comp.Spec.Settings
+		if Format {
+			settings = Format.DePrettify(settings, false)
+		}
+		podSpec, _ := nestedValue(settings, workload.podSpec...).(map[string]interface{})
+		report.Findings = append(report.Findings, analyzePodSpec(comp.Name, strings.Join(workload.podSpec, "."), podSpec)...)
+	}
+
+	report.Score = 100
+	for _, finding := range report.Findings {
+		report.Summary[finding.Severity]++
+		report.Score -= securityPenalties[finding.Severity]
+	}
+	if report.Score < 0 {
+		report.Score = 0
+	}
+	sort.SliceStable(report.Findings, func(i, j int) bool {
+		a, b := report.Findings[i], report.Findings[j]
+		if a.Severity != b.Severity {
+			return securitySeverityOrder[a.Severity] < securitySeverityOrder[b.Severity]
+		}
+		if a.Component != b.Component {
+			return a.Component < b.Component
+		}
+		return a.Path < b.Path
+	})
+	return report
+}
+
+func analyzePodSpec(component, path string, podSpec map[string]interface{}) []SecurityFinding {
+	findings := []SecurityFinding{}
+	add := func(container, rule, severity, message, path string) {
+		findings = append(findings, SecurityFinding{
+			Component: component,
+			Container: container,
+			Rule:      rule,
+			Severity:  severity,
+			Message:   message,
+			Path:      path,
+		})
+	}
+
+	for _, host := range []string{"hostNetwork", "hostPID", "hostIPC"} {
+		if enabled, _ := podSpec[host].(bool); enabled {
+			add("", "host-namespace", SecurityHigh, fmt.Sprintf("the pods share the %s namespace of the node", strings.TrimPrefix(host, "host")), path+"."+host)
+		}
+	}
+	volumes, _ := podSpec["volumes"].([]interface{})
+	for i, v := range volumes {
+		volume, _ := v.(map[string]interface{})
+		if _, ok := volume["hostPath"]; ok {
+			name, _ := volume["name"].(string)
+			add("", "host-path-volume", SecurityHigh, fmt.Sprintf("the volume %s mounts a path of the node", name), fmt.Sprintf("%s.volumes[%d].hostPath", path, i))
+		}
+	}
+	podSecurityContext, _ := podSpec["securityContext"].(map[string]interface{})
+
+	for _, key := range []string{"initContainers", "containers"} {
+		containers, _ := podSpec[key].([]interface{})
+		for i, c := range containers {
+			container, _ := c.(map[string]interface{})
+			name, _ := container["name"].(string)
+			containerPath := fmt.Sprintf("%s.%s[%d]", path, key, i)
+			securityContext, _ := container["securityContext"].(map[string]interface{})
+
+			if privileged, _ := securityContext["privileged"].(bool); privileged {
+				add(name, "privileged-container", SecurityCritical, "the container runs privileged, with all the capabilities of the node", containerPath+".securityContext.privileged")
+			}
+			if escalation, _ := securityContext["allowPrivilegeEscalation"].(bool); escalation {
+				add(name, "privilege-escalation", SecurityMedium, "the processes of the container can gain more privileges than their parent", containerPath+".securityContext.allowPrivilegeEscalation")
+			}
+			capabilities, _ := securityContext["capabilities"].(map[string]interface{})
+			added, _ := capabilities["add"].([]interface{})
+			for _, capability := range added {
+				if c, _ := capability.(string); c == "ALL" || c == "SYS_ADMIN" || c == "NET_ADMIN" {
+					add(name, "dangerous-capability", SecurityHigh, fmt.Sprintf("the container is granted the %s capability", c), containerPath+".securityContext.capabilities.add")
+				}
+			}
+			if runsAsRoot(securityContext, podSecurityContext) {
+				add(name, "run-as-root", SecurityMedium, "the container runs as root", containerPath+".securityContext.runAsUser")
+			}
+
+			limits, _ := nestedValue(container, "resources", "limits").(map[string]interface{})
+			for _, resource := range []string{"cpu", "memory"} {
+				if _, ok := limits[resource]; !ok {
+					add(name, "missing-resource-limits", SecurityMedium, fmt.Sprintf("the container has no %s limit, it can starve the other workloads of the node", resource), containerPath+".resources.limits."+resource)
+				}
+			}
+
+			image, _ := container["image"].(string)
+			if image != "" && !pinnedImage(image) {
+				add(name, "unpinned-image", SecurityMedium, fmt.Sprintf("the image %s is not pinned to a tag other than latest or to a digest", image), containerPath+".image")
+			}
+		}
+	}
+	return findings
+}
+
+// runsAsRoot tells if the container runs as the user 0, as set by the security context of the container or of its pod
+func runsAsRoot(securityContext, podSecurityContext map[string]interface{}) bool {
+	for _, sc := range []map[string]interface{}{securityContext, podSecurityContext} {
+		switch user := sc["runAsUser"].(type) {
+		case float64:
+			return user == 0
+		case int:
+			return user == 0
+		case int64:
+			return user == 0
+		}
+	}
+	return false
+}
+
+// pinnedImage tells if the image is referred to by digest or by a tag other than latest
+func pinnedImage(image string) bool {
+	if strings.Contains(image, "@") {
+		return true
+	}
+	// the tag follows the last colon after the last slash, the colons before it separate the port of the registry
+	name := image[strings.LastIndex(image, "/")+1:]
+	i := strings.LastIndex(name, ":")
+	return i != -1 && name[i+1:] != "latest"
+}
@@ -0,0 +1,93 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+)
+
+func TestAnalyzeSecurity(t *testing.T) {
+	limits := map[string]interface{}{"limits": map[string]interface{}{"cpu": "500m", "memory": "256Mi"}}
+	comps := []v1alpha1.Component{
+		costComponent("agent", "DaemonSet", map[string]interface{}{
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"hostNetwork": true,
+						"volumes": []interface{}{
+							map[string]interface{}{"name": "logs", "hostPath": map[string]interface{}{"path": "/var/log"}},
+						},
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name":            "agent",
+								"image":           "registry:5000/agent",
+								"resources":       limits,
+								"securityContext": map[string]interface{}{"privileged": true},
+							},
+						},
+					},
+				},
+			},
+		}),
+		costComponent("web", "Deployment", map[string]interface{}{
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{
+								"name":      "app",
+								"image":     "nginx:1.25",
+								"resources": map[string]interface{}{"limits": map[string]interface{}{"cpu": "1"}},
+							},
+						},
+					},
+				},
+			},
+		}),
+		costComponent("secure", "Pod", map[string]interface{}{
+			"spec": map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx@sha256:abc", "resources": limits},
+				},
+			},
+		}),
+	}
+
+	report := AnalyzeSecurity(comps)
+	var rules []string
+	for _, finding := range report.Findings {
+		rules = append(rules, finding.Component+"/"+finding.Rule)
+	}
+	want := []string{
+		"agent/privileged-container",
+		"agent/host-namespace",
+		"agent/host-path-volume",
+		"agent/unpinned-image",
+		"web/missing-resource-limits",
+	}
+	if !reflect.DeepEqual(rules, want) {
+		t.Errorf("findings = %v, want %v", rules, want)
+	}
+	if report.Score != 30 {
+		t.Errorf("score = %d, want 30", report.Score)
+	}
+	if report.Findings[4].Path != "spec.template.spec.containers[0].resources.limits.memory" {
+		t.Errorf("path = %s, want the memory limit of the container", report.Findings[4].Path)
+	}
+}
+
+func TestPinnedImage(t *testing.T) {
+	for image, want := range map[string]bool{
+		"nginx":                    false,
+		"nginx:latest":             false,
+		"registry:5000/nginx":      false,
+		"nginx:1.25":               true,
+		"registry:5000/nginx:1.25": true,
+		"nginx@sha256:abc":         true,
+	} {
+		if got := pinnedImage(image); got != want {
+			t.Errorf("pinnedImage(%s) = %v, want %v", image, got, want)
+		}
+	}
+}
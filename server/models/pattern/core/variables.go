@@ -0,0 +1,294 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/layer5io/meshery/server/models/pattern/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// Types of the variables of a design
+const (
+	VariableString  = "string"
+	VariableInteger = "integer"
+	VariableNumber  = "number"
+	VariableBoolean = "boolean"
+	VariableObject  = "object"
+	VariableArray   = "array"
+)
+
+// Variable is the schema of a variable of a design. The variables are referred to in the design
+// as {{ .vars.<name> }} and rendered with the values supplied at deploy time, or their defaults.
+type Variable struct {
+	// Type is the type of the values of the variable, string if empty
+	Type        string `yaml:"type,omitempty" json:"type,omitempty"`
+	Description string `yaml:"description,omitempty" json:"description,omitempty"`
+	// Default is the value of the variable when no value is supplied
+	Default interface{} `yaml:"default,omitempty" json:"default,omitempty"`
+	// Required variables without a default must be supplied a value
+	Required bool `yaml:"required,omitempty" json:"required,omitempty"`
+	// Enum restricts the values of the variable, if not empty
+	Enum []interface{} `yaml:"enum,omitempty" json:"enum,omitempty"`
+}
+
+var (
+	variableNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// matches strings made of a single reference to a variable, which are rendered to the value of the variable
+	variableRefRegex = regexp.MustCompile(`^\s*\{\{-?\s*\.vars((?:\.[A-Za-z_][A-Za-z0-9_]*)+)\s*-?\}\}\s*$`)
+)
+
+// ResolveVariables returns the values of the variables of the pattern, the values of pattern.Vars taking precedence over
+// the defaults of the variables. Values supplied as strings, eg: from a query string, are converted to the type of their
+// variable. The values which are missing, of the wrong type or not among the values of the enum of their variable are
+// reported together.
+func (p *Pattern) ResolveVariables() (map[string]interface{}, error) {
+	values := make(map[string]interface{}, len(p.Variables))
+	var errs []string
+
+	names := make([]string, 0, len(p.Variables))
+	for name := range p.Variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		v := p.Variables[name]
+		if v == nil {
+			v = &Variable{}
+		}
+		if !variableNameRegex.MatchString(name) {
+			errs = append(errs, fmt.Sprintf("variable %q: name must start with a letter or an underscore and contain only letters, digits and underscores", name))
+			continue
+		}
+		value, ok := p.Vars[name]
+		if !ok || value == nil {
+			value, ok = v.Default, v.Default != nil
+		}
+		if !ok {
+			if v.Required {
+				errs = append(errs, fmt.Sprintf("variable %q: a value is required", name))
+			}
+			continue
+		}
+		value, err := convertVariable(v.Type, value)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("variable %q: %s", name, err))
+			continue
+		}
+		if len(v.Enum) > 0 && !inEnum(v.Type, value, v.Enum) {
+			errs = append(errs, fmt.Sprintf("variable %q: value %v is not one of %v", name, value, v.Enum))
+			continue
+		}
+		values[name] = value
+	}
+	// the values of undeclared variables are available as well, eg: the ones imported from other designs
+	for name, value := range p.Vars {
+		if _, ok := p.Variables[name]; !ok {
+			values[name] = value
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, ErrInvalidVariables(fmt.Errorf("%s", strings.Join(errs, "\n")))
+	}
+	return values, nil
+}
+
+// RenderVariables renders the references to the variables in the services of the pattern with the values of the variables.
+// A string made of a single reference is replaced by the value of the variable, keeping its type, while references
+// inside of a string are replaced by the textual representation of the values.
+func (p *Pattern) RenderVariables() error {
+	values, err := p.ResolveVariables()
+	if err != nil {
+		return err
+	}
+	r := &variableRenderer{data: map[string]interface{}{"vars": values}}
+
+	names := make([]string, 0, len(p.Services))
+	for name := range p.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		svc := p.Services[name]
+		if svc == nil {
+			continue
+		}
+		svc.Namespace = r.renderString(name+".namespace", svc.Namespace)
+		svc.Version = r.renderString(name+".version", svc.Version)
+		for i, dep := range svc.DependsOn {
+			svc.DependsOn[i] = r.renderString(fmt.Sprintf("%s.dependsOn.%d", name, i), dep)
+		}
+		for k, v := range svc.Labels {
+			svc.Labels[k] = r.renderString(name+".labels."+k, v)
+		}
+		for k, v := range svc.Annotations {
+			svc.Annotations[k] = r.renderString(name+".annotations."+k, v)
+		}
+		for k, v := range svc.Settings {
+			svc.Settings[k] = r.render(name+".settings."+k, v)
+		}
+		for k, v := range svc.Traits {
+			svc.Traits[k] = r.render(name+".traits."+k, v)
+		}
+	}
+	if len(r.errs) > 0 {
+		return ErrInvalidVariables(fmt.Errorf("%s", strings.Join(r.errs, "\n")))
+	}
+	return nil
+}
+
+type variableRenderer struct {
+	data map[string]interface{}
+	errs []string
+}
+
+func (r *variableRenderer) render(path string, v interface{}) interface{} {
+	switch val := v.(type) {
+	case string:
+		return r.renderValue(path, val)
+	case map[string]interface{}:
+		for k, e := range val {
+			val[k] = r.render(path+"."+k, e)
+		}
+	case []interface{}:
+		for i, e := range val {
+			val[i] = r.render(fmt.Sprintf("%s.%d", path, i), e)
+		}
+	}
+	return v
+}
+
+// renderValue renders the string, a string made of a single reference is rendered to the value of the variable
+func (r *variableRenderer) renderValue(path, s string) interface{} {
+	if !strings.Contains(s, "{{") {
+		return s
+	}
+	if m := variableRefRegex.FindStringSubmatch(s); m != nil {
+		var value interface{} = r.data["vars"]
+		for _, key := range strings.Split(strings.TrimPrefix(m[1], "."), ".") {
+			obj, ok := value.(map[string]interface{})
+			if !ok {
+				value = nil
+				break
+			}
+			if value, ok = obj[key]; !ok {
+				break
+			}
+		}
+		if value == nil {
+			r.errs = append(r.errs, fmt.Sprintf("%s: no value for %s", path, strings.TrimSpace(s)))
+			return s
+		}
+		return value
+	}
+	return r.renderString(path, s)
+}
+
+func (r *variableRenderer) renderString(path, s string) string {
+	if !strings.Contains(s, "{{") {
+		return s
+	}
+	tmpl, err := template.New(path).Option("missingkey=error").Parse(s)
+	if err != nil {
+		r.errs = append(r.errs, fmt.Sprintf("%s: %s", path, err))
+		return s
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, r.data); err != nil {
+		r.errs = append(r.errs, fmt.Sprintf("%s: %s", path, err))
+		return s
+	}
+	return buf.String()
+}
+
+// convertVariable converts the value to the type of the variable
+func convertVariable(typ string, value interface{}) (interface{}, error) {
+	s, isString := value.(string)
+	switch typ {
+	case "", VariableString:
+		switch value.(type) {
+		case string:
+			return s, nil
+		case int, int64, float64, bool:
+			// scalars written unquoted in the design
+			return fmt.Sprint(value), nil
+		}
+		return nil, fmt.Errorf("value %v is not a string", value)
+	case VariableInteger:
+		switch v := value.(type) {
+		case int:
+			return int64(v), nil
+		case int64:
+			return v, nil
+		case float64:
+			// integers decoded from JSON
+			if v == math.Trunc(v) {
+				return int64(v), nil
+			}
+		case string:
+			if i, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64); err == nil {
+				return i, nil
+			}
+		}
+		return nil, fmt.Errorf("value %v is not an integer", value)
+	case VariableNumber:
+		switch v := value.(type) {
+		case int:
+			return float64(v), nil
+		case int64:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		case string:
+			if f, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+				return f, nil
+			}
+		}
+		return nil, fmt.Errorf("value %v is not a number", value)
+	case VariableBoolean:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			if b, err := strconv.ParseBool(strings.TrimSpace(v)); err == nil {
+				return b, nil
+			}
+		}
+		return nil, fmt.Errorf("value %v is not a boolean", value)
+	case VariableObject, VariableArray:
+		if isString {
+			// objects and arrays are supplied as YAML or JSON
+			var decoded interface{}
+			if err := yaml.Unmarshal([]byte(s), &decoded); err != nil {
+				return nil, fmt.Errorf("value %q is not a valid %s: %s", s, typ, err)
+			}
+			value = decoded
+		}
+		value = utils.ConvertMapInterfaceMapString(value)
+		if _, ok := value.(map[string]interface{}); typ == VariableObject && !ok {
+			return nil, fmt.Errorf("value %v is not an object", value)
+		}
+		if _, ok := value.([]interface{}); typ == VariableArray && !ok {
+			return nil, fmt.Errorf("value %v is not an array", value)
+		}
+		return value, nil
+	}
+	return nil, fmt.Errorf("unknown type %q", typ)
+}
+
+func inEnum(typ string, value interface{}, enum []interface{}) bool {
+	for _, e := range enum {
+		if e, err := convertVariable(typ, e); err == nil && fmt.Sprint(e) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
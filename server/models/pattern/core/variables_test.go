@@ -0,0 +1,117 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResolveVariables(t *testing.T) {
+	variables := map[string]*Variable{
+		"replicas": {Type: VariableInteger, Default: 1},
+		"env":      {Enum: []interface{}{"dev", "prod"}, Required: true},
+		"debug":    {Type: VariableBoolean},
+		"limits":   {Type: VariableObject},
+	}
+
+	tests := []struct {
+		name    string
+		vars    map[string]interface{}
+		want    map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "Defaults are used for the variables without values",
+			vars: map[string]interface{}{"env": "dev"},
+			want: map[string]interface{}{"replicas": int64(1), "env": "dev"},
+		},
+		{
+			name: "Values supplied as strings are converted to the type of their variable",
+			vars: map[string]interface{}{"env": "prod", "replicas": "3", "debug": "true", "limits": "{cpu: 500m}", "imported": 2},
+			want: map[string]interface{}{
+				"replicas": int64(3),
+				"env":      "prod",
+				"debug":    true,
+				"limits":   map[string]interface{}{"cpu": "500m"},
+				"imported": 2,
+			},
+		},
+		{
+			name:    "Required variables must be supplied a value",
+			vars:    map[string]interface{}{},
+			wantErr: true,
+		},
+		{
+			name:    "Values must be among the values of the enum",
+			vars:    map[string]interface{}{"env": "staging"},
+			wantErr: true,
+		},
+		{
+			name:    "Values must match the type of their variable",
+			vars:    map[string]interface{}{"env": "dev", "replicas": "three"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Pattern{Variables: variables, Vars: tt.vars}
+			got, err := p.ResolveVariables()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolveVariables() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ResolveVariables() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderVariables(t *testing.T) {
+	newPattern := func(settings map[string]interface{}) *Pattern {
+		return &Pattern{
+			Variables: map[string]*Variable{
+				"replicas": {Type: VariableInteger, Default: 2},
+				"env":      {Default: "dev"},
+			},
+			Vars: map[string]interface{}{"env": "prod"},
+			Services: map[string]*Service{
+				"web": {
+					Namespace: "{{ .vars.env }}",
+					Labels:    map[string]string{"app": "web-{{ .vars.env }}"},
+					Settings:  settings,
+				},
+			},
+		}
+	}
+
+	t.Run("References are rendered with the values of the variables", func(t *testing.T) {
+		p := newPattern(map[string]interface{}{
+			"spec": map[string]interface{}{
+				"replicas": "{{ .vars.replicas }}",
+				"args":     []interface{}{"--env={{ .vars.env }}", "--verbose"},
+			},
+		})
+		if err := p.RenderVariables(); err != nil {
+			t.Fatalf("RenderVariables() error = %v", err)
+		}
+		svc := p.Services["web"]
+		want := map[string]interface{}{
+			"spec": map[string]interface{}{
+				"replicas": int64(2),
+				"args":     []interface{}{"--env=prod", "--verbose"},
+			},
+		}
+		if !reflect.DeepEqual(svc.Settings, want) {
+			t.Errorf("settings = %v, want %v", svc.Settings, want)
+		}
+		if svc.Namespace != "prod" || svc.Labels["app"] != "web-prod" {
+			t.Errorf("namespace = %q, labels = %v, want the rendered values", svc.Namespace, svc.Labels)
+		}
+	})
+
+	t.Run("References to undeclared variables fail the rendering", func(t *testing.T) {
+		p := newPattern(map[string]interface{}{"image": "nginx:{{ .vars.tag }}", "port": "{{ .vars.port }}"})
+		if err := p.RenderVariables(); err == nil {
+			t.Errorf("RenderVariables() error = nil, want an error")
+		}
+	})
+}
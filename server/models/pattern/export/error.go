@@ -0,0 +1,18 @@
+package export
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshkit/errors"
+)
+
+// Please reference the following before contributing an error code:
+// https://docs.meshery.io/project/contributing/contributing-error
+// https://github.com/meshery/meshkit/blob/master/errors/errors.go
+const (
+	ErrExportPatternCode = "1558"
+)
+
+func ErrExportPattern(err error, format string) error {
+	return errors.New(ErrExportPatternCode, errors.Alert, []string{fmt.Sprintf("Could not export the design to %s", format)}, []string{err.Error()}, []string{"A required variable of the design has no value", "A component of the design is not a Kubernetes resource", "The name of an environment is not a valid directory name"}, []string{"Supply values for the required variables of the design", "Remove the components without an apiVersion and a kind from the design", "Name the environments with lower case alphanumerics and dashes"})
+}
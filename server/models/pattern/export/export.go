@@ -0,0 +1,168 @@
+// Package export renders designs into the formats of the Kubernetes deployment tools
+package export
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+// Manifest is the Kubernetes resource of a component of a design
+type Manifest struct {
+	// Service is the key of the component in the services of the design
+	Service   string
+	Kind      string
+	Name      string
+	Namespace string
+	Object    map[string]interface{}
+}
+
+var nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Manifests returns the Kubernetes resources of the components of the design, sorted by kind and name.
+// Annotation components, which are only drawn on the canvas, are skipped.
+// The values of the resources are normalized to their JSON representation, so that they can be compared.
+func Manifests(pattern core.Pattern) ([]Manifest, error) {
+	manifests := make([]Manifest, 0, len(pattern.Services))
+	for key, svc := range pattern.Services {
+		if svc == nil || svc.IsAnnotation {
+			continue
+		}
+		if svc.APIVersion == "" || svc.Type == "" {
+			return nil, fmt.Errorf("component %s has no apiVersion or kind", key)
+		}
+
+		object := map[string]interface{}{}
+		for k, v := range svc.Settings {
+			if k == "apiVersion" || k == "kind" || k == "metadata" || k == "status" {
+				continue
+			}
+			object[k] = v
+		}
+		metadata := map[string]interface{}{"name": svc.Name}
+		if svc.Namespace != "" {
+			metadata["namespace"] = svc.Namespace
+		}
+		if len(svc.Labels) > 0 {
+			metadata["labels"] = svc.Labels
+		}
+		if len(svc.Annotations) > 0 {
+			metadata["annotations"] = svc.Annotations
+		}
+		object["apiVersion"] = svc.APIVersion
+		object["kind"] = svc.Type
+		object["metadata"] = metadata
+
+		byt, err := json.Marshal(object)
+		if err != nil {
+			return nil, err
+		}
+		normalized := map[string]interface{}{}
+		if err := json.Unmarshal(byt, &normalized); err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, Manifest{
+			Service:   key,
+			Kind:      svc.Type,
+			Name:      svc.Name,
+			Namespace: svc.Namespace,
+			Object:    normalized,
+		})
+	}
+	sort.Slice(manifests, func(i, j int) bool {
+		if manifests[i].Kind != manifests[j].Kind {
+			return manifests[i].Kind < manifests[j].Kind
+		}
+		if manifests[i].Name != manifests[j].Name {
+			return manifests[i].Name < manifests[j].Name
+		}
+		return manifests[i].Namespace < manifests[j].Namespace
+	})
+	return manifests, nil
+}
+
+// renderPattern parses the design and renders its variables with the given values, which take precedence over
+// the values saved in the design
+func renderPattern(patternFile []byte, vars map[string]interface{}) (core.Pattern, error) {
+	pattern, err := core.NewPatternFile(patternFile)
+	if err != nil {
+		return core.Pattern{}, err
+	}
+	if len(vars) > 0 && pattern.Vars == nil {
+		pattern.Vars = map[string]interface{}{}
+	}
+	for k, v := range vars {
+		pattern.Vars[k] = v
+	}
+	if err := pattern.RenderVariables(); err != nil {
+		return core.Pattern{}, err
+	}
+	return pattern, nil
+}
+
+// fileNames returns unique file names for the manifests, eg: deployment-web.yaml
+func fileNames(manifests []Manifest) []string {
+	names := make([]string, 0, len(manifests))
+	used := make(map[string]int)
+	for _, m := range manifests {
+		name := dnsName(m.Kind + "-" + m.Name)
+		if n := used[name]; n > 0 {
+			used[name]++
+			name = fmt.Sprintf("%s-%d", name, n)
+		} else {
+			used[name] = 1
+		}
+		names = append(names, name+".yaml")
+	}
+	return names
+}
+
+// dnsName converts the name into a lower case name made of alphanumerics and dashes
+func dnsName(name string) string {
+	name = strings.Trim(nonAlphanumeric.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if name == "" {
+		return "design"
+	}
+	return name
+}
+
+// writeArchive writes the files as a gzip compressed tarball to w, in the order of their paths
+func writeArchive(w io.Writer, files map[string][]byte) error {
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	now := time.Now()
+	for _, p := range paths {
+		err := tw.WriteHeader(&tar.Header{
+			Name:    p,
+			Mode:    0644,
+			Size:    int64(len(files[p])),
+			ModTime: now,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := tw.Write(files[p]); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gzw.Close()
+}
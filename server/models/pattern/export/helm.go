@@ -0,0 +1,123 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+type helmChart struct {
+	APIVersion  string `yaml:"apiVersion"`
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	Type        string `yaml:"type"`
+	Version     string `yaml:"version"`
+}
+
+type helmComponent struct {
+	Namespace   string                 `yaml:"namespace,omitempty"`
+	Labels      interface{}            `yaml:"labels,omitempty"`
+	Annotations interface{}            `yaml:"annotations,omitempty"`
+	Settings    map[string]interface{} `yaml:"settings,omitempty"`
+}
+
+// the template of a component, its values are under .Values.components.<kind>-<name>
+const helmTemplate = `{{- $component := index .Values.components %q }}
+apiVersion: %s
+kind: %s
+metadata:
+  name: %s
+{{- with $component.namespace }}
+  namespace: {{ . }}
+{{- end }}
+{{- with $component.labels }}
+  labels:
+{{ toYaml . | indent 4 }}
+{{- end }}
+{{- with $component.annotations }}
+  annotations:
+{{ toYaml . | indent 4 }}
+{{- end }}
+{{- with $component.settings }}
+{{ toYaml . }}
+{{- end }}
+`
+
+// Helm writes the design as a gzip compressed tarball of a Helm chart:
+//
+//	<design>/Chart.yaml
+//	<design>/values.yaml
+//	<design>/templates/<kind>-<name>.yaml
+//
+// The design is rendered with the values of the variables saved in the design, or their defaults.
+// The namespace, labels, annotations and settings of every component are extracted into the values of the chart,
+// under components.<kind>-<name>, so that they can be overridden at install time. Components without a namespace are
+// installed in the namespace of the release.
+func Helm(w io.Writer, name string, patternFile []byte) error {
+	pattern, err := renderPattern(patternFile, nil)
+	if err != nil {
+		return ErrExportPattern(err, "helm")
+	}
+	manifests, err := Manifests(pattern)
+	if err != nil {
+		return ErrExportPattern(err, "helm")
+	}
+	if len(manifests) == 0 {
+		return ErrExportPattern(fmt.Errorf("the design has no Kubernetes resources"), "helm")
+	}
+
+	root := dnsName(name)
+	files := make(map[string][]byte)
+	chart, err := yaml.Marshal(helmChart{
+		APIVersion:  "v2",
+		Name:        root,
+		Description: fmt.Sprintf("Helm chart of the Meshery design %s", name),
+		Type:        "application",
+		Version:     "0.1.0",
+	})
+	if err != nil {
+		return ErrExportPattern(err, "helm")
+	}
+	files[path.Join(root, "Chart.yaml")] = chart
+
+	components := make(map[string]helmComponent, len(manifests))
+	for i, file := range fileNames(manifests) {
+		m := manifests[i]
+		key := strings.TrimSuffix(file, ".yaml")
+		metadata, _ := m.Object["metadata"].(map[string]interface{})
+		settings := make(map[string]interface{}, len(m.Object))
+		for k, v := range m.Object {
+			if k != "apiVersion" && k != "kind" && k != "metadata" {
+				settings[k] = v
+			}
+		}
+		components[key] = helmComponent{
+			Namespace:   m.Namespace,
+			Labels:      metadata["labels"],
+			Annotations: metadata["annotations"],
+			Settings:    settings,
+		}
+
+		apiVersion, _ := m.Object["apiVersion"].(string)
+		files[path.Join(root, "templates", file)] = []byte(fmt.Sprintf(helmTemplate, key, yamlScalar(apiVersion), yamlScalar(m.Kind), yamlScalar(m.Name)))
+	}
+	values, err := yaml.Marshal(map[string]interface{}{"components": components})
+	if err != nil {
+		return ErrExportPattern(err, "helm")
+	}
+	files[path.Join(root, "values.yaml")] = values
+
+	if err := writeArchive(w, files); err != nil {
+		return ErrExportPattern(err, "helm")
+	}
+	return nil
+}
+
+// yamlScalar quotes the string as a YAML scalar, if needed
+func yamlScalar(s string) string {
+	byt, _ := yaml.Marshal(s)
+	return strings.TrimSpace(string(byt))
+}
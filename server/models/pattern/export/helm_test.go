@@ -0,0 +1,87 @@
+package export
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+)
+
+func TestHelm(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Helm(&buf, "Web", []byte(design)); err != nil {
+		t.Fatalf("Helm() error = %v", err)
+	}
+	chart, err := loader.LoadArchive(&buf)
+	if err != nil {
+		t.Fatalf("the chart cannot be loaded: %v", err)
+	}
+	if chart.Metadata.Name != "web" || chart.Metadata.APIVersion != "v2" {
+		t.Errorf("metadata = %+v", chart.Metadata)
+	}
+
+	render := func(overrides map[string]interface{}) map[string]map[interface{}]interface{} {
+		t.Helper()
+		vals, err := chartutil.ToRenderValues(chart, overrides, chartutil.ReleaseOptions{Name: "web", Namespace: "shop"}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := engine.Render(chart, vals)
+		if err != nil {
+			t.Fatalf("the chart cannot be rendered: %v", err)
+		}
+		resources := make(map[string]map[interface{}]interface{})
+		for name, manifest := range out {
+			var resource map[interface{}]interface{}
+			if err := yaml.Unmarshal([]byte(manifest), &resource); err != nil {
+				t.Fatalf("%s is not valid YAML: %v", name, err)
+			}
+			resources[name] = resource
+		}
+		return resources
+	}
+
+	t.Run("Templates render the components of the design", func(t *testing.T) {
+		resources := render(nil)
+		if len(resources) != 2 {
+			t.Fatalf("resources = %v, want a Deployment and a Service", resources)
+		}
+		deploy := resources["web/templates/deployment-web.yaml"]
+		want := map[interface{}]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[interface{}]interface{}{
+				"name":      "web",
+				"namespace": "shop",
+				"labels":    map[interface{}]interface{}{"tier": "free"},
+			},
+			"spec": map[interface{}]interface{}{
+				"replicas": 1,
+				"template": map[interface{}]interface{}{
+					"spec": map[interface{}]interface{}{
+						"containers": []interface{}{map[interface{}]interface{}{"name": "web", "image": "nginx:1.25"}},
+					},
+				},
+			},
+		}
+		if !reflect.DeepEqual(deploy, want) {
+			t.Errorf("deployment = %v, want %v", deploy, want)
+		}
+	})
+
+	t.Run("Settings are overridden by the values", func(t *testing.T) {
+		resources := render(map[string]interface{}{
+			"components": map[string]interface{}{
+				"deployment-web": map[string]interface{}{"settings": map[string]interface{}{"spec": map[string]interface{}{"replicas": 3}}},
+			},
+		})
+		spec := resources["web/templates/deployment-web.yaml"]["spec"].(map[interface{}]interface{})
+		if spec["replicas"] != 3 || spec["template"] == nil {
+			t.Errorf("spec = %v, want the replicas of the values merged into the settings", spec)
+		}
+	})
+}
@@ -0,0 +1,181 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+)
+
+const kustomizeAPIVersion = "kustomize.config.k8s.io/v1beta1"
+
+var environmentNameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+type kustomization struct {
+	APIVersion string           `yaml:"apiVersion"`
+	Kind       string           `yaml:"kind"`
+	Resources  []string         `yaml:"resources,omitempty"`
+	Patches    []kustomizePatch `yaml:"patches,omitempty"`
+}
+
+type kustomizePatch struct {
+	Path    string          `yaml:"path"`
+	Target  kustomizeTarget `yaml:"target"`
+	Options map[string]bool `yaml:"options,omitempty"`
+}
+
+type kustomizeTarget struct {
+	Kind      string `yaml:"kind"`
+	Name      string `yaml:"name"`
+	Namespace string `yaml:"namespace,omitempty"`
+}
+
+// Kustomize writes the design as a gzip compressed tarball of a Kustomize base and of an overlay for every environment:
+//
+//	<design>/base/kustomization.yaml
+//	<design>/base/<kind>-<name>.yaml
+//	<design>/overlays/<environment>/kustomization.yaml
+//	<design>/overlays/<environment>/<kind>-<name>.yaml
+//
+// The base is rendered with the values of the variables saved in the design, or their defaults.
+// An overlay is rendered with the values of the variables of its environment, and patches the resources of the base
+// which differ with strategic merge patches.
+func Kustomize(w io.Writer, name string, patternFile []byte, environments map[string]map[string]interface{}) error {
+	pattern, err := renderPattern(patternFile, nil)
+	if err != nil {
+		return ErrExportPattern(err, "kustomize")
+	}
+	base, err := Manifests(pattern)
+	if err != nil {
+		return ErrExportPattern(err, "kustomize")
+	}
+	if len(base) == 0 {
+		return ErrExportPattern(fmt.Errorf("the design has no Kubernetes resources"), "kustomize")
+	}
+
+	root := dnsName(name)
+	files := make(map[string][]byte)
+	baseFiles := fileNames(base)
+	for i, m := range base {
+		byt, err := yaml.Marshal(m.Object)
+		if err != nil {
+			return ErrExportPattern(err, "kustomize")
+		}
+		files[path.Join(root, "base", baseFiles[i])] = byt
+	}
+	if err := addKustomization(files, path.Join(root, "base"), kustomization{Resources: baseFiles}); err != nil {
+		return ErrExportPattern(err, "kustomize")
+	}
+
+	envs := make([]string, 0, len(environments))
+	for env := range environments {
+		if !environmentNameRegex.MatchString(env) {
+			return ErrExportPattern(fmt.Errorf("environment %q: name must be made of lower case alphanumerics and dashes", env), "kustomize")
+		}
+		envs = append(envs, env)
+	}
+	sort.Strings(envs)
+
+	for _, env := range envs {
+		pattern, err := renderPattern(patternFile, environments[env])
+		if err != nil {
+			return ErrExportPattern(fmt.Errorf("environment %s: %w", env, err), "kustomize")
+		}
+		overlay, err := Manifests(pattern)
+		if err != nil {
+			return ErrExportPattern(fmt.Errorf("environment %s: %w", env, err), "kustomize")
+		}
+		byService := make(map[string]Manifest, len(overlay))
+		for _, m := range overlay {
+			byService[m.Service] = m
+		}
+
+		dir := path.Join(root, "overlays", env)
+		k := kustomization{Resources: []string{"../../base"}}
+		for i, m := range base {
+			o, ok := byService[m.Service]
+			if !ok {
+				continue
+			}
+			patch, ok := mergePatch(m.Object, o.Object)
+			if !ok {
+				continue
+			}
+			patch["apiVersion"] = o.Object["apiVersion"]
+			patch["kind"] = o.Object["kind"]
+			metadata, _ := patch["metadata"].(map[string]interface{})
+			if metadata == nil {
+				metadata = map[string]interface{}{}
+				patch["metadata"] = metadata
+			}
+			metadata["name"] = o.Name
+			byt, err := yaml.Marshal(patch)
+			if err != nil {
+				return ErrExportPattern(err, "kustomize")
+			}
+			files[path.Join(dir, baseFiles[i])] = byt
+
+			p := kustomizePatch{
+				Path:   baseFiles[i],
+				Target: kustomizeTarget{Kind: m.Kind, Name: m.Name, Namespace: m.Namespace},
+			}
+			if o.Name != m.Name {
+				p.Options = map[string]bool{"allowNameChange": true}
+			}
+			k.Patches = append(k.Patches, p)
+		}
+		if err := addKustomization(files, dir, k); err != nil {
+			return ErrExportPattern(err, "kustomize")
+		}
+	}
+
+	if err := writeArchive(w, files); err != nil {
+		return ErrExportPattern(err, "kustomize")
+	}
+	return nil
+}
+
+func addKustomization(files map[string][]byte, dir string, k kustomization) error {
+	k.APIVersion = kustomizeAPIVersion
+	k.Kind = "Kustomization"
+	byt, err := yaml.Marshal(k)
+	if err != nil {
+		return err
+	}
+	files[path.Join(dir, "kustomization.yaml")] = byt
+	return nil
+}
+
+// mergePatch returns the patch turning the object from into the object to, and whether they differ.
+// Objects are patched field by field, other values are replaced and the fields missing from to are removed with null.
+func mergePatch(from, to map[string]interface{}) (map[string]interface{}, bool) {
+	patch := map[string]interface{}{}
+	for k, v := range to {
+		fv, ok := from[k]
+		if !ok {
+			patch[k] = v
+			continue
+		}
+		fm, fok := fv.(map[string]interface{})
+		tm, tok := v.(map[string]interface{})
+		if fok && tok {
+			if p, ok := mergePatch(fm, tm); ok {
+				patch[k] = p
+			}
+			continue
+		}
+		if !reflect.DeepEqual(fv, v) {
+			patch[k] = v
+		}
+	}
+	for k := range from {
+		if _, ok := to[k]; !ok {
+			patch[k] = nil
+		}
+	}
+	return patch, len(patch) > 0
+}
@@ -0,0 +1,183 @@
+package export
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+const design = `
+name: Web
+variables:
+  replicas:
+    type: integer
+    default: 1
+  tier:
+    default: free
+services:
+  web:
+    type: Deployment
+    apiVersion: apps/v1
+    namespace: shop
+    labels:
+      tier: "{{ .vars.tier }}"
+    settings:
+      spec:
+        replicas: "{{ .vars.replicas }}"
+        template:
+          spec:
+            containers:
+              - name: web
+                image: nginx:1.25
+  web-svc:
+    name: web
+    type: Service
+    apiVersion: v1
+    namespace: shop
+    settings:
+      spec:
+        ports:
+          - port: 80
+  note:
+    type: Text
+    isAnnotation: true
+`
+
+func readArchive(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	gzr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("gzip.NewReader() error = %v", err)
+	}
+	tr := tar.NewReader(gzr)
+	files := make(map[string]string)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		if err != nil {
+			t.Fatalf("tar.Next() error = %v", err)
+		}
+		byt, _ := io.ReadAll(tr)
+		files[hdr.Name] = string(byt)
+	}
+}
+
+func TestKustomize(t *testing.T) {
+	var buf bytes.Buffer
+	err := Kustomize(&buf, "Web", []byte(design), map[string]map[string]interface{}{
+		"dev":  {},
+		"prod": {"replicas": 3, "tier": "paid"},
+	})
+	if err != nil {
+		t.Fatalf("Kustomize() error = %v", err)
+	}
+	files := readArchive(t, buf.Bytes())
+
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	wantNames := map[string]bool{
+		"web/base/kustomization.yaml":           true,
+		"web/base/deployment-web.yaml":          true,
+		"web/base/service-web.yaml":             true,
+		"web/overlays/dev/kustomization.yaml":   true,
+		"web/overlays/prod/kustomization.yaml":  true,
+		"web/overlays/prod/deployment-web.yaml": true,
+	}
+	if len(files) != len(wantNames) {
+		t.Fatalf("files = %v, want %v", names, wantNames)
+	}
+	for _, name := range names {
+		if !wantNames[name] {
+			t.Fatalf("files = %v, want %v", names, wantNames)
+		}
+	}
+
+	t.Run("The base is rendered with the defaults of the variables", func(t *testing.T) {
+		var deploy map[string]interface{}
+		if err := yaml.Unmarshal([]byte(files["web/base/deployment-web.yaml"]), &deploy); err != nil {
+			t.Fatal(err)
+		}
+		spec := deploy["spec"].(map[interface{}]interface{})
+		if spec["replicas"] != 1 || deploy["kind"] != "Deployment" || deploy["apiVersion"] != "apps/v1" {
+			t.Errorf("deployment = %v", deploy)
+		}
+		var k kustomization
+		if err := yaml.Unmarshal([]byte(files["web/base/kustomization.yaml"]), &k); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(k.Resources, []string{"deployment-web.yaml", "service-web.yaml"}) {
+			t.Errorf("resources = %v", k.Resources)
+		}
+	})
+
+	t.Run("Overlays patch the resources their variables change", func(t *testing.T) {
+		var patch map[string]interface{}
+		if err := yaml.Unmarshal([]byte(files["web/overlays/prod/deployment-web.yaml"]), &patch); err != nil {
+			t.Fatal(err)
+		}
+		want := map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[interface{}]interface{}{
+				"name":   "web",
+				"labels": map[interface{}]interface{}{"tier": "paid"},
+			},
+			"spec": map[interface{}]interface{}{"replicas": 3},
+		}
+		if !reflect.DeepEqual(patch, want) {
+			t.Errorf("patch = %v, want %v", patch, want)
+		}
+
+		var k kustomization
+		if err := yaml.Unmarshal([]byte(files["web/overlays/prod/kustomization.yaml"]), &k); err != nil {
+			t.Fatal(err)
+		}
+		wantPatches := []kustomizePatch{{
+			Path:   "deployment-web.yaml",
+			Target: kustomizeTarget{Kind: "Deployment", Name: "web", Namespace: "shop"},
+		}}
+		if !reflect.DeepEqual(k.Resources, []string{"../../base"}) || !reflect.DeepEqual(k.Patches, wantPatches) {
+			t.Errorf("kustomization = %+v", k)
+		}
+	})
+}
+
+func TestKustomizeErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		design       string
+		environments map[string]map[string]interface{}
+	}{
+		{name: "Environments must be valid directory names", design: design, environments: map[string]map[string]interface{}{"../prod": {}}},
+		{name: "Values must match the type of their variable", design: design, environments: map[string]map[string]interface{}{"prod": {"replicas": "three"}}},
+		{name: "Designs must have Kubernetes resources", design: "name: empty\nservices: {}\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Kustomize(io.Discard, "web", []byte(tt.design), tt.environments); err == nil {
+				t.Errorf("Kustomize() error = nil, want an error")
+			}
+		})
+	}
+}
+
+func TestMergePatch(t *testing.T) {
+	from := map[string]interface{}{"a": 1.0, "b": map[string]interface{}{"c": "x", "d": "y"}, "e": []interface{}{1.0}}
+	to := map[string]interface{}{"a": 1.0, "b": map[string]interface{}{"c": "z"}, "e": []interface{}{1.0, 2.0}, "f": true}
+	want := map[string]interface{}{"b": map[string]interface{}{"c": "z", "d": nil}, "e": []interface{}{1.0, 2.0}, "f": true}
+	if got, ok := mergePatch(from, to); !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("mergePatch() = %v, want %v", got, want)
+	}
+	if _, ok := mergePatch(from, from); ok {
+		t.Errorf("mergePatch() of equal objects reports a difference")
+	}
+}
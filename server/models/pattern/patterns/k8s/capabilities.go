@@ -0,0 +1,47 @@
+package k8s
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// APIResources returns the kinds served by the API of the cluster. The group versions which could not be discovered, eg:
+// the ones of aggregated APIs whose service is down, are returned as failed rather than failing the discovery.
+func APIResources(client *meshkube.Client) (core.APIResources, error) {
+	resources := core.APIResources{
+		Kinds:               make(map[schema.GroupVersionKind]bool),
+		FailedGroupVersions: make(map[schema.GroupVersion]bool),
+	}
+	_, lists, err := client.KubeClient.Discovery().ServerGroupsAndResources()
+	if err != nil {
+		var failed *discovery.ErrGroupDiscoveryFailed
+		if !errors.As(err, &failed) {
+			return resources, ErrDiscoverAPIResources(err)
+		}
+		for gv := range failed.Groups {
+			resources.FailedGroupVersions[gv] = true
+		}
+	}
+	for _, list := range lists {
+		if list == nil {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, r := range list.APIResources {
+			// subresources, eg: deployments/scale, have the kinds of other resources
+			if strings.Contains(r.Name, "/") {
+				continue
+			}
+			resources.Kinds[gv.WithKind(r.Kind)] = true
+		}
+	}
+	return resources, nil
+}
@@ -0,0 +1,151 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+// lastAppliedAnnotation holds the configuration a resource was last applied with by kubectl apply
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// Diff returns the change deploying, or undeploying if isDelete is true, the component makes to the live resource of the cluster
+func Diff(ctx context.Context, client *meshkube.Client, comp v1alpha1.Component, isDelete bool) (core.ResourceChange, error) {
+	resource := createK8sResourceStructure(comp)
+	change := core.ResourceChange{
+		Component:  comp.Name,
+		APIVersion: v1alpha1.GetAPIVersionFromComponent(comp),
+		Kind:       v1alpha1.GetKindFromComponent(comp),
+		Name:       comp.ObjectMeta.Name,
+		Namespace:  comp.Namespace,
+	}
+
+	path, err := resourcePath(resource, comp.Namespace)
+	if err != nil {
+		return change, err
+	}
+	raw, err := client.KubeClient.RESTClient().Get().AbsPath(path, comp.ObjectMeta.Name).Do(ctx).Raw()
+	if errors.IsNotFound(err) {
+		change.Operation = core.ResourceCreate
+		if isDelete {
+			change.Operation = core.ResourceUnchanged
+		}
+		return change, nil
+	}
+	if err != nil {
+		return change, ErrFetchLiveResource(err, comp.Name)
+	}
+	if isDelete {
+		change.Operation = core.ResourceDelete
+		return change, nil
+	}
+
+	var live map[string]interface{}
+	if err := json.Unmarshal(raw, &live); err != nil {
+		return change, ErrFetchLiveResource(err, comp.Name)
+	}
+	// the desired resource is normalized to the types of decoded JSON, so that it compares with the live one
+	var desired map[string]interface{}
+	byt, err := json.Marshal(resource)
+	if err != nil {
+		return change, err
+	}
+	if err := json.Unmarshal(byt, &desired); err != nil {
+		return change, err
+	}
+	var lastApplied map[string]interface{}
+	annotations, _ := nestedMap(live, "metadata")["annotations"].(map[string]interface{})
+	if cfg, ok := annotations[lastAppliedAnnotation].(string); ok {
+		_ = json.Unmarshal([]byte(cfg), &lastApplied)
+	}
+
+	change.Fields = ThreeWayDiff(lastApplied, live, desired)
+	change.Operation = core.ResourceUpdate
+	if len(change.Fields) == 0 {
+		change.Operation = core.ResourceUnchanged
+	}
+	return change, nil
+}
+
+// ThreeWayDiff returns the fields of the live resource the desired one changes, ordered by path.
+// Like kubectl diff, the fields of the live resource the desired one does not set are left as they are,
+// unless they were set by the last applied configuration, in which case applying the desired resource removes them.
+// Lists are compared as a whole.
+func ThreeWayDiff(lastApplied, live, desired map[string]interface{}) []core.FieldChange {
+	liveFields := make(map[string]interface{})
+	flatten("", live, liveFields)
+	desiredFields := make(map[string]interface{})
+	flatten("", desired, desiredFields)
+	lastAppliedFields := make(map[string]interface{})
+	flatten("", lastApplied, lastAppliedFields)
+
+	changes := []core.FieldChange{}
+	for path, d := range desiredFields {
+		l, ok := liveFields[path]
+		if !ok || !reflect.DeepEqual(l, d) {
+			changes = append(changes, core.FieldChange{Path: path, Live: l, Desired: d})
+		}
+	}
+	for path := range lastAppliedFields {
+		if _, ok := desiredFields[path]; ok {
+			continue
+		}
+		if l, ok := liveFields[path]; ok {
+			changes = append(changes, core.FieldChange{Path: path, Live: l})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes
+}
+
+// flatten stores the leaves of the object by their dot separated path, empty maps and nil values are not leaves
+func flatten(prefix string, obj map[string]interface{}, fields map[string]interface{}) {
+	for k, v := range obj {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		switch val := v.(type) {
+		case map[string]interface{}:
+			flatten(path, val, fields)
+		case nil:
+		default:
+			fields[path] = val
+		}
+	}
+}
+
+func nestedMap(obj map[string]interface{}, key string) map[string]interface{} {
+	m, _ := obj[key].(map[string]interface{})
+	return m
+}
+
+// resourcePath returns the path of the API of the Kubernetes server serving the kind of the resource
+func resourcePath(k8sResource map[string]interface{}, namespace string) (string, error) {
+	aV, _ := k8sResource["apiVersion"].(string)
+	kind, _ := k8sResource["kind"].(string)
+	if aV == "" || kind == "" {
+		return "", ErrDryRun(fmt.Errorf("invalid resource or namespace not provided"), "\"kind\" and \"apiVersion\" cannot be empty")
+	}
+
+	// for non-core resources, the endpoint should use 'apis' instead of 'api'
+	apiString := "api"
+	if len(strings.Split(aV, "/")) > 1 {
+		apiString = apiString + "s"
+	}
+
+	if namespace != "" {
+		return fmt.Sprintf("/%s/%s/namespaces/%s/%s", apiString, aV, namespace, kindToResource(kind)), nil
+	}
+	return fmt.Sprintf("/%s/%s/%s", apiString, aV, kindToResource(kind)), nil
+}
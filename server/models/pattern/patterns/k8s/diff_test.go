@@ -0,0 +1,71 @@
+package k8s
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+func TestThreeWayDiff(t *testing.T) {
+	live := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "web",
+			"resourceVersion": "42",
+			"labels":          map[string]interface{}{"app": "web", "tier": "frontend"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": float64(1),
+			"ports":    []interface{}{float64(80)},
+		},
+		"status": map[string]interface{}{"readyReplicas": float64(1)},
+	}
+
+	tests := []struct {
+		name        string
+		lastApplied map[string]interface{}
+		desired     map[string]interface{}
+		expected    []core.FieldChange
+	}{
+		{
+			name: "Fields set only by the server are left as they are",
+			desired: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "web"},
+				"spec":     map[string]interface{}{"replicas": float64(1), "ports": []interface{}{float64(80)}},
+			},
+			expected: []core.FieldChange{},
+		},
+		{
+			name: "Changed and added fields are updated",
+			desired: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "web", "labels": map[string]interface{}{"version": "v2"}},
+				"spec":     map[string]interface{}{"replicas": float64(3), "ports": []interface{}{float64(80), float64(443)}},
+			},
+			expected: []core.FieldChange{
+				{Path: "metadata.labels.version", Desired: "v2"},
+				{Path: "spec.ports", Live: []interface{}{float64(80)}, Desired: []interface{}{float64(80), float64(443)}},
+				{Path: "spec.replicas", Live: float64(1), Desired: float64(3)},
+			},
+		},
+		{
+			name: "Fields last applied but no longer desired are removed",
+			lastApplied: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "web", "labels": map[string]interface{}{"tier": "frontend"}},
+			},
+			desired: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "web"},
+			},
+			expected: []core.FieldChange{
+				{Path: "metadata.labels.tier", Live: "frontend"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ThreeWayDiff(tt.lastApplied, live, tt.desired); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected changes %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -11,7 +11,14 @@ import (
 )
 
 const (
-	ErrDryRunCode = "1536"
+	ErrDryRunCode               = "1536"
+	ErrFetchLiveResourceCode    = "1551"
+	ErrOrphanResourcesCode      = "1566"
+	ErrDeleteOrphanResourceCode = "1567"
+	ErrExecCode                 = "1588"
+	ErrPodLogsCode              = "1590"
+	ErrKubernetesEventsCode     = "1592"
+	ErrDiscoverAPIResourcesCode = "1596"
 )
 
 func isErrKubeStatusErr(err error) bool {
@@ -62,3 +69,31 @@ func formatKubeStatusErrToMeshkitErr(status *[]byte, componentName string) error
 func ErrDryRun(err error, obj string) error {
 	return errors.New(ErrDryRunCode, errors.Alert, []string{"error performing a dry run on the design"}, []string{err.Error()}, []string{obj}, []string{})
 }
+
+func ErrFetchLiveResource(err error, obj string) error {
+	return errors.New(ErrFetchLiveResourceCode, errors.Alert, []string{"error fetching the live resource of the component from the cluster"}, []string{err.Error()}, []string{obj}, []string{"Ensure the Kubernetes cluster is reachable and Meshery has permission to read the resource."})
+}
+
+func ErrOrphanResources(err error, patternID string) error {
+	return errors.New(ErrOrphanResourcesCode, errors.Alert, []string{"error finding the resources of the design which are not part of it anymore"}, []string{err.Error()}, []string{fmt.Sprintf("The resources labeled with the id %s of the design could not be listed.", patternID)}, []string{"Ensure the Kubernetes cluster is reachable and Meshery has permission to list its resources."})
+}
+
+func ErrDeleteOrphanResource(err error, obj string) error {
+	return errors.New(ErrDeleteOrphanResourceCode, errors.Alert, []string{"error deleting a resource which is not part of the design anymore"}, []string{err.Error()}, []string{obj}, []string{"Ensure Meshery has permission to delete the resource, or delete it by hand."})
+}
+
+func ErrExec(err error, pod string) error {
+	return errors.New(ErrExecCode, errors.Alert, []string{"error running a command in a container of the pod"}, []string{err.Error()}, []string{fmt.Sprintf("The pod %s could not be read or the command could not be started in it.", pod)}, []string{"Ensure the Kubernetes cluster is reachable and Meshery has permission to read the pod and to create its exec subresource."})
+}
+
+func ErrPodLogs(err error, obj string) error {
+	return errors.New(ErrPodLogsCode, errors.Alert, []string{"error streaming the logs of the pods of the component"}, []string{err.Error()}, []string{fmt.Sprintf("The pods of %s could not be listed or their logs could not be read.", obj)}, []string{"Ensure the Kubernetes cluster is reachable and Meshery has permission to list the pods and to read their logs."})
+}
+
+func ErrKubernetesEvents(err error) error {
+	return errors.New(ErrKubernetesEventsCode, errors.Alert, []string{"error reading the Kubernetes events of the resources of the deployed designs"}, []string{err.Error()}, []string{"The events of the cluster or the objects they are about could not be listed."}, []string{"Ensure the Kubernetes cluster is reachable and Meshery has permission to list the events and to read the resources of the designs."})
+}
+
+func ErrDiscoverAPIResources(err error) error {
+	return errors.New(ErrDiscoverAPIResourcesCode, errors.Alert, []string{"Could not discover the API resources served by the cluster"}, []string{err.Error()}, []string{"The Kubernetes API server is unreachable", "The user of the kubeconfig is not allowed to discover the API resources"}, []string{"Make sure the cluster is reachable from Meshery Server", "Make sure the user of the kubeconfig is allowed to get the /api and /apis endpoints"})
+}
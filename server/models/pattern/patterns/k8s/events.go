@@ -0,0 +1,139 @@
+package k8s
+
+import (
+	"context"
+	"time"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ComponentEvent is a Kubernetes event of a resource of a component of a deployed design, eg: the failure to pull the
+// image of a pod of the deployment of the component
+type ComponentEvent struct {
+	UID string `json:"uid"`
+	// PatternID is the id of the design the resource of the component is labeled with
+	PatternID string `json:"pattern_id"`
+	// Component and ComponentKind are the name and the kind of the resource of the component
+	Component     string `json:"component"`
+	ComponentKind string `json:"component_kind"`
+	// Kind, Name and Namespace are the object of the event, the resource of the component or one of the objects it controls
+	Kind      string    `json:"kind"`
+	Name      string    `json:"name"`
+	Namespace string    `json:"namespace"`
+	Type      string    `json:"type"`
+	Reason    string    `json:"reason"`
+	Message   string    `json:"message"`
+	Count     int32     `json:"count"`
+	LastSeen  time.Time `json:"last_seen"`
+}
+
+// DesignEvents returns the warning events of the cluster seen since the time, whose objects are resources of deployed designs
+// or are controlled by one of them
+func DesignEvents(ctx context.Context, client *meshkube.Client, since time.Time) ([]ComponentEvent, error) {
+	list, err := client.KubeClient.CoreV1().Events(metav1.NamespaceAll).List(ctx, metav1.ListOptions{FieldSelector: "type=" + corev1.EventTypeWarning})
+	if err != nil {
+		return nil, ErrKubernetesEvents(err)
+	}
+	events, err := correlateEvents(ctx, list.Items, since, func(ctx context.Context, ref corev1.ObjectReference) (metav1.Object, error) {
+		return getObject(ctx, client, ref.APIVersion, ref.Kind, ref.Namespace, ref.Name)
+	}, func(namespace string) ownerFunc {
+		return ownerGetter(client, namespace)
+	})
+	if err != nil {
+		return nil, ErrKubernetesEvents(err)
+	}
+	return events, nil
+}
+
+// correlateEvents returns the events seen since the time whose objects, fetched with fetch, have a design owner,
+// see designOwner. The owners of the objects of a namespace are fetched with the ownerFunc of the namespace.
+func correlateEvents(ctx context.Context, events []corev1.Event, since time.Time, fetch func(context.Context, corev1.ObjectReference) (metav1.Object, error), owners func(namespace string) ownerFunc) ([]ComponentEvent, error) {
+	// the objects of the events are fetched once, many events being about the same pods
+	designOwners := map[corev1.ObjectReference]metav1.Object{}
+	correlated := []ComponentEvent{}
+	for _, ev := range events {
+		lastSeen := eventTime(ev)
+		if lastSeen.Before(since) {
+			continue
+		}
+		ref := corev1.ObjectReference{
+			APIVersion: ev.InvolvedObject.APIVersion,
+			Kind:       ev.InvolvedObject.Kind,
+			Namespace:  ev.InvolvedObject.Namespace,
+			Name:       ev.InvolvedObject.Name,
+		}
+		owner, ok := designOwners[ref]
+		if !ok {
+			obj, err := fetch(ctx, ref)
+			if err == nil {
+				owner, err = designOwner(ctx, obj, owners(ref.Namespace))
+			}
+			if err != nil && !isGone(err) {
+				return nil, err
+			}
+			designOwners[ref] = owner
+		}
+		if owner == nil {
+			continue
+		}
+		correlated = append(correlated, ComponentEvent{
+			UID:           string(ev.UID),
+			PatternID:     owner.GetLabels()[core.PatternIDLabel],
+			Component:     owner.GetName(),
+			ComponentKind: kindOf(owner),
+			Kind:          ev.InvolvedObject.Kind,
+			Name:          ev.InvolvedObject.Name,
+			Namespace:     ev.InvolvedObject.Namespace,
+			Type:          ev.Type,
+			Reason:        ev.Reason,
+			Message:       ev.Message,
+			Count:         eventCount(ev),
+			LastSeen:      lastSeen,
+		})
+	}
+	return correlated, nil
+}
+
+// eventTime returns the last time the event was seen, which is set by different fields depending on the version of the
+// events API the event was recorded with
+func eventTime(ev corev1.Event) time.Time {
+	switch {
+	case ev.Series != nil && !ev.Series.LastObservedTime.IsZero():
+		return ev.Series.LastObservedTime.Time
+	case !ev.LastTimestamp.IsZero():
+		return ev.LastTimestamp.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	case !ev.FirstTimestamp.IsZero():
+		return ev.FirstTimestamp.Time
+	}
+	return ev.CreationTimestamp.Time
+}
+
+// eventCount returns the number of times the event was seen
+func eventCount(ev corev1.Event) int32 {
+	if ev.Series != nil && ev.Series.Count > 0 {
+		return ev.Series.Count
+	}
+	if ev.Count > 0 {
+		return ev.Count
+	}
+	return 1
+}
+
+// isGone reports whether the error is the one of an object which does not exist anymore or cannot be read
+func isGone(err error) bool {
+	return apierrors.IsNotFound(err) || apierrors.IsForbidden(err)
+}
+
+// kindOf returns the kind of the object, if it knows it
+func kindOf(obj metav1.Object) string {
+	if typed, ok := obj.(interface{ GetKind() string }); ok {
+		return typed.GetKind()
+	}
+	return ""
+}
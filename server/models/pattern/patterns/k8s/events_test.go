@@ -0,0 +1,103 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func warningEvent(uid, kind, name, reason string, lastSeen time.Time) corev1.Event {
+	return corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{UID: types.UID(uid)},
+		InvolvedObject: corev1.ObjectReference{APIVersion: "v1", Kind: kind, Namespace: "default", Name: name},
+		Type:           corev1.EventTypeWarning,
+		Reason:         reason,
+		Count:          3,
+		LastTimestamp:  metav1.NewTime(lastSeen),
+	}
+}
+
+func TestCorrelateEvents(t *testing.T) {
+	const patternID = "4f6c5c4e-6a8e-4b2e-9c1f-2d8d1e3b6a7f"
+	objects := map[string]metav1.Object{
+		"web":            controlledObject("Deployment", "web", map[string]string{core.PatternIDLabel: patternID}, ""),
+		"web-rs":         controlledObject("ReplicaSet", "web-rs", nil, "web"),
+		"web-pod":        controlledObject("Pod", "web-pod", nil, "web-rs"),
+		"standalone-pod": controlledObject("Pod", "standalone-pod", nil, ""),
+	}
+	getOwner := func(_ context.Context, ref metav1.OwnerReference) (metav1.Object, error) {
+		if obj, ok := objects[ref.Name]; ok {
+			return obj, nil
+		}
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "controllers"}, ref.Name)
+	}
+	fetched := 0
+	fetch := func(_ context.Context, ref corev1.ObjectReference) (metav1.Object, error) {
+		fetched++
+		if obj, ok := objects[ref.Name]; ok {
+			return obj, nil
+		}
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, ref.Name)
+	}
+
+	since := time.Now().Add(-time.Minute)
+	recent := time.Now()
+	events := []corev1.Event{
+		warningEvent("pull", "Pod", "web-pod", "Failed", recent),
+		warningEvent("backoff", "Pod", "web-pod", "BackOff", recent),
+		warningEvent("rollout", "Deployment", "web", "ProgressDeadlineExceeded", recent),
+		warningEvent("old", "Pod", "web-pod", "FailedScheduling", since.Add(-time.Minute)),
+		warningEvent("standalone", "Pod", "standalone-pod", "Failed", recent),
+		warningEvent("deleted", "Pod", "deleted-pod", "Failed", recent),
+	}
+	got, err := correlateEvents(context.Background(), events, since, fetch, func(string) ownerFunc { return getOwner })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{"pull": "Pod", "backoff": "Pod", "rollout": "Deployment"}
+	if len(got) != len(want) {
+		t.Fatalf("correlateEvents() = %+v, want the events %v", got, want)
+	}
+	for _, ev := range got {
+		if kind, ok := want[ev.UID]; !ok || ev.Kind != kind {
+			t.Errorf("correlateEvents() returned event %s of %s", ev.UID, ev.Kind)
+		}
+		if ev.PatternID != patternID || ev.Component != "web" || ev.ComponentKind != "Deployment" || ev.Count != 3 {
+			t.Errorf("event %s correlated to component %s %s of design %s with count %d", ev.UID, ev.ComponentKind, ev.Component, ev.PatternID, ev.Count)
+		}
+	}
+	// the objects of the events are fetched once
+	if fetched != 4 {
+		t.Errorf("fetched the objects of the events %d times, want 4", fetched)
+	}
+}
+
+func TestEventTime(t *testing.T) {
+	first := time.Date(2023, 9, 1, 10, 0, 0, 0, time.UTC)
+	last := first.Add(time.Hour)
+	tests := []struct {
+		name string
+		ev   corev1.Event
+		want time.Time
+	}{
+		{"last timestamp", corev1.Event{FirstTimestamp: metav1.NewTime(first), LastTimestamp: metav1.NewTime(last)}, last},
+		{"series", corev1.Event{EventTime: metav1.NewMicroTime(first), Series: &corev1.EventSeries{Count: 2, LastObservedTime: metav1.NewMicroTime(last)}}, last},
+		{"event time", corev1.Event{EventTime: metav1.NewMicroTime(first)}, first},
+		{"creation", corev1.Event{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(first)}}, first},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := eventTime(tt.ev); !got.Equal(tt.want) {
+				t.Errorf("eventTime() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
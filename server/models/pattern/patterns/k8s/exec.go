@@ -0,0 +1,188 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+// maxOwnerDepth is the number of controllers walked up from a pod to find the resource of the design, eg: its
+// replicaset and the deployment of the replicaset
+const maxOwnerDepth = 4
+
+// TerminalSize is the size of the terminal a command runs in
+type TerminalSize struct {
+	Width  uint16 `json:"cols"`
+	Height uint16 `json:"rows"`
+}
+
+// ExecOptions are the container and the command run by Exec, and the streams of the command
+type ExecOptions struct {
+	Namespace string
+	Pod       string
+	// Container is the container of the pod the command runs in, the default container of the pod if it is empty
+	Container string
+	Command   []string
+	Stdin     io.Reader
+	// Stdout receives both the output and the errors of the command, which runs in a terminal
+	Stdout io.Writer
+	// Resize receives the size of the terminal whenever it is resized, the size of the terminal is left as is if it is nil
+	Resize <-chan TerminalSize
+}
+
+// CanExec reports whether the credentials of the client allow running commands in the pod, and the reason why if they do not
+func CanExec(ctx context.Context, client *meshkube.Client, namespace, pod string) (bool, string, error) {
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   namespace,
+				Verb:        "create",
+				Resource:    "pods",
+				Subresource: "exec",
+				Name:        pod,
+			},
+		},
+	}
+	review, err := client.KubeClient.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return false, "", ErrExec(err, pod)
+	}
+	return review.Status.Allowed, review.Status.Reason, nil
+}
+
+// IsDesignPod reports whether the pod is a resource of the deployed design with the id, that is whether the pod or one of
+// its controllers, eg: its deployment, is labeled with the id of the design
+func IsDesignPod(ctx context.Context, client *meshkube.Client, patternID, namespace, name string) (bool, error) {
+	pod, err := client.KubeClient.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, ErrExec(err, name)
+	}
+	owned, err := ownedByDesign(ctx, pod, patternID, ownerGetter(client, namespace))
+	if err != nil {
+		return false, ErrExec(err, name)
+	}
+	return owned, nil
+}
+
+// ownedByDesign reports whether the design owner of the object, see designOwner, is the resource of the design with the id
+func ownedByDesign(ctx context.Context, obj metav1.Object, patternID string, getOwner ownerFunc) (bool, error) {
+	if patternID == "" {
+		return false, nil
+	}
+	owner, err := designOwner(ctx, obj, getOwner)
+	if err != nil || owner == nil {
+		return false, err
+	}
+	return owner.GetLabels()[core.PatternIDLabel] == patternID, nil
+}
+
+// ownerFunc returns the controller of an object with the owner reference
+type ownerFunc func(context.Context, metav1.OwnerReference) (metav1.Object, error)
+
+// designOwner walks up the controllers of the object, fetched with getOwner, until it finds one labeled with the id of a design,
+// the resource of a component of the design, eg: the deployment of the replicaset of a pod. It returns nil if there is none.
+// The controllers which do not exist or cannot be read end the walk.
+func designOwner(ctx context.Context, obj metav1.Object, getOwner ownerFunc) (metav1.Object, error) {
+	for depth := 0; ; depth++ {
+		if obj.GetLabels()[core.PatternIDLabel] != "" {
+			return obj, nil
+		}
+		ref := metav1.GetControllerOf(obj)
+		if ref == nil || depth == maxOwnerDepth {
+			return nil, nil
+		}
+		owner, err := getOwner(ctx, *ref)
+		if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		obj = owner
+	}
+}
+
+// ownerGetter returns the ownerFunc fetching the controllers of the objects of the namespace from the cluster
+func ownerGetter(client *meshkube.Client, namespace string) ownerFunc {
+	return func(ctx context.Context, ref metav1.OwnerReference) (metav1.Object, error) {
+		return getObject(ctx, client, ref.APIVersion, ref.Kind, namespace, ref.Name)
+	}
+}
+
+// getObject fetches the object of any kind from the cluster
+func getObject(ctx context.Context, client *meshkube.Client, apiVersion, kind, namespace, name string) (*unstructured.Unstructured, error) {
+	path, err := resourcePath(map[string]interface{}{"apiVersion": apiVersion, "kind": kind}, namespace)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := client.KubeClient.RESTClient().Get().AbsPath(path, name).Do(ctx).Raw()
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{}
+	return obj, obj.UnmarshalJSON(raw)
+}
+
+// Exec runs the command in a terminal in the container of the pod until the command exits or ctx is done.
+// The error of a command which exited with a non zero code is a utilexec.ExitError, see ExitCode.
+func Exec(ctx context.Context, client *meshkube.Client, opts ExecOptions) error {
+	req := client.KubeClient.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(opts.Namespace).
+		Name(opts.Pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: opts.Container,
+			Command:   opts.Command,
+			Stdin:     opts.Stdin != nil,
+			Stdout:    true,
+			TTY:       true,
+		}, scheme.ParameterCodec)
+	executor, err := remotecommand.NewSPDYExecutor(&client.RestConfig, http.MethodPost, req.URL())
+	if err != nil {
+		return ErrExec(err, opts.Pod)
+	}
+	streamOpts := remotecommand.StreamOptions{Stdin: opts.Stdin, Stdout: opts.Stdout, Tty: true}
+	if opts.Resize != nil {
+		streamOpts.TerminalSizeQueue = sizeQueue(opts.Resize)
+	}
+	return executor.StreamWithContext(ctx, streamOpts)
+}
+
+// ExitCode returns the exit code of the command run by Exec from its error, or -1 if the command did not exit
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitStatus()
+	}
+	return -1
+}
+
+// sizeQueue passes the sizes of the terminal to the command, until the channel is closed
+type sizeQueue <-chan TerminalSize
+
+func (q sizeQueue) Next() *remotecommand.TerminalSize {
+	size, ok := <-q
+	if !ok {
+		return nil
+	}
+	return &remotecommand.TerminalSize{Width: size.Width, Height: size.Height}
+}
@@ -0,0 +1,71 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func controlledObject(kind, name string, labels map[string]string, controller string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetLabels(labels)
+	if controller != "" {
+		isController := true
+		obj.SetOwnerReferences([]metav1.OwnerReference{
+			{APIVersion: "v1", Kind: "ConfigMap", Name: "not-a-controller"},
+			{APIVersion: "apps/v1", Kind: "Controller", Name: controller, Controller: &isController},
+		})
+	}
+	return obj
+}
+
+func TestOwnedByDesign(t *testing.T) {
+	const patternID = "4f6c5c4e-6a8e-4b2e-9c1f-2d8d1e3b6a7f"
+	designLabels := map[string]string{core.PatternIDLabel: patternID}
+	objects := map[string]*unstructured.Unstructured{
+		"deployment":       controlledObject("Deployment", "deployment", designLabels, ""),
+		"replicaset":       controlledObject("ReplicaSet", "replicaset", nil, "deployment"),
+		"other-deployment": controlledObject("Deployment", "other-deployment", map[string]string{core.PatternIDLabel: "other"}, ""),
+		"other-replicaset": controlledObject("ReplicaSet", "other-replicaset", nil, "other-deployment"),
+		"loop":             controlledObject("ReplicaSet", "loop", nil, "loop"),
+	}
+	getOwner := func(_ context.Context, ref metav1.OwnerReference) (metav1.Object, error) {
+		if obj, ok := objects[ref.Name]; ok {
+			return obj, nil
+		}
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "controllers"}, ref.Name)
+	}
+
+	tests := []struct {
+		name      string
+		pod       *unstructured.Unstructured
+		patternID string
+		want      bool
+	}{
+		{"labeled pod", controlledObject("Pod", "pod", designLabels, ""), patternID, true},
+		{"pod of a deployment of the design", controlledObject("Pod", "pod", nil, "replicaset"), patternID, true},
+		{"pod of a deployment of another design", controlledObject("Pod", "pod", nil, "other-replicaset"), patternID, false},
+		{"pod without controller", controlledObject("Pod", "pod", nil, ""), patternID, false},
+		{"pod of a deleted controller", controlledObject("Pod", "pod", nil, "deleted"), patternID, false},
+		{"pod of a cycle of controllers", controlledObject("Pod", "pod", nil, "loop"), patternID, false},
+		{"design deployed without being saved", controlledObject("Pod", "pod", map[string]string{core.PatternIDLabel: ""}, ""), "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ownedByDesign(context.Background(), tt.pod, tt.patternID, getOwner)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("ownedByDesign() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -44,26 +44,11 @@ func DryRunHelper(client *meshkube.Client, comp v1alpha1.Component) (st map[stri
 // does dry-run on the kubernetes server for the given k8s resource and returns the Status object returned by the k8s server
 // TODO: add more tests for this function
 func dryRun(rClient rest.Interface, k8sResource map[string]interface{}, namespace string) (st map[string]interface{}, success bool, err error) {
-	if k8sResource["kind"] == "" || k8sResource["apiVersion"] == "" {
-		err = ErrDryRun(fmt.Errorf("invalid resource or namespace not provided"), "\"kind\" and \"apiVersion\" cannot be empty")
+	path, err := resourcePath(k8sResource, namespace)
+	if err != nil {
 		return
 	}
 
-	aV := k8sResource["apiVersion"].(string)
-	// for non-core resources, the endpoint should use 'apis' instead of 'api'
-	apiString := "api"
-	if len(strings.Split(aV, "/")) > 1 {
-		apiString = apiString + "s"
-	}
-
-	var path string
-
-	if namespace != "" {
-		path = fmt.Sprintf("/%s/%s/namespaces/%s/%s", apiString, aV, namespace, kindToResource(k8sResource["kind"].(string)))
-	} else {
-		path = fmt.Sprintf("/%s/%s/%s", apiString, aV, kindToResource(k8sResource["kind"].(string)))
-	}
-
 	data, err := json.Marshal(k8sResource)
 	if err != nil {
 		err = models.ErrMarshal(err, "k8s resource")
@@ -76,6 +61,8 @@ func dryRun(rClient rest.Interface, k8sResource map[string]interface{}, namespac
 	// ignoring the error since this client-go treats failure of dryRun as an error
 	resp, err := res.Raw()
 	switch err.(type) {
+	case nil:
+		st, success, err = formatDryRunResponse(resp, err)
 	case *errors.StatusError:
 		st, success, err = formatDryRunResponse(resp, err)
 	case *errors.UnexpectedObjectError:
@@ -86,6 +73,26 @@ func dryRun(rClient rest.Interface, k8sResource map[string]interface{}, namespac
 	return
 }
 
+// RenderManifest returns the Kubernetes resource deploying the component would apply.
+// The resource is rendered by the Kubernetes server with a dry run when the client is not nil, so that it includes
+// the defaults and the mutations of the admission controllers, and is rendered locally when the server cannot be reached.
+// An error is returned if the server rejects the resource.
+func RenderManifest(client *meshkube.Client, comp v1alpha1.Component) (map[string]interface{}, error) {
+	resource := createK8sResourceStructure(comp)
+	if client == nil {
+		return resource, nil
+	}
+	st, success, err := dryRun(client.KubeClient.RESTClient(), resource, comp.Namespace)
+	if err != nil {
+		return resource, nil
+	}
+	if !success {
+		msg, _ := st["message"].(string)
+		return nil, ErrDryRun(fmt.Errorf("%s rejected by the Kubernetes server", comp.Name), msg)
+	}
+	return st, nil
+}
+
 func kindToResource(kind string) string {
 	return strings.ToLower(kind) + "s"
 }
@@ -118,7 +125,7 @@ func formatDryRunResponse(resp []byte, err error) (status map[string]interface{}
 
 	e := json.Unmarshal(resp, &status)
 	if e != nil {
-		meshkiterr = models.ErrMarshal(err, fmt.Sprintf("cannot serialize Status object from the server: %s", e.Error()))
+		meshkiterr = models.ErrMarshal(e, "Status object from the Kubernetes server")
 		return
 	}
 	if status == nil || status["kind"] == nil {
@@ -0,0 +1,154 @@
+package k8s
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// maxLogLineSize is the size of the longest line of the logs of a container, longer lines end the stream of the container
+const maxLogLineSize = 1024 * 1024
+
+// LogLine is a line of the logs of a container of a pod of a component
+type LogLine struct {
+	Pod       string `json:"pod"`
+	Container string `json:"container"`
+	Line      string `json:"line,omitempty"`
+	// Error is set instead of Line when the logs of the container could not be streamed until their end
+	Error string `json:"error,omitempty"`
+}
+
+// ComponentPods returns the pods of the live resource of the component: the pod itself for a pod, and the pods selected by
+// the selector of the resource for the workloads, eg: deployments, and the services.
+// A component whose resource does not exist, eg: it was deleted, has no pods.
+func ComponentPods(ctx context.Context, client *meshkube.Client, comp v1alpha1.Component) ([]corev1.Pod, error) {
+	namespace := comp.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+	kind := v1alpha1.GetKindFromComponent(comp)
+	if kind == "Pod" {
+		pod, err := client.KubeClient.CoreV1().Pods(namespace).Get(ctx, comp.ObjectMeta.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, ErrFetchLiveResource(err, comp.Name)
+		}
+		return []corev1.Pod{*pod}, nil
+	}
+
+	path, err := resourcePath(map[string]interface{}{"apiVersion": v1alpha1.GetAPIVersionFromComponent(comp), "kind": kind}, namespace)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := client.KubeClient.RESTClient().Get().AbsPath(path, comp.ObjectMeta.Name).Do(ctx).Raw()
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, ErrFetchLiveResource(err, comp.Name)
+	}
+	var live map[string]interface{}
+	if err := json.Unmarshal(raw, &live); err != nil {
+		return nil, ErrFetchLiveResource(err, comp.Name)
+	}
+	selector, err := podSelector(live)
+	if err != nil {
+		return nil, ErrPodLogs(err, comp.Name)
+	}
+	pods, err := client.KubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, ErrPodLogs(err, comp.Name)
+	}
+	return pods.Items, nil
+}
+
+// podSelector returns the selector of the pods of the live resource: the label selector of spec.selector for the
+// workloads, or the map of labels of spec.selector for the services and the replication controllers
+func podSelector(live map[string]interface{}) (labels.Selector, error) {
+	kind, _ := live["kind"].(string)
+	raw, ok := nestedMap(live, "spec")["selector"].(map[string]interface{})
+	if !ok || len(raw) == 0 {
+		return nil, fmt.Errorf("%s resources do not select pods", kind)
+	}
+	_, hasMatchLabels := raw["matchLabels"]
+	_, hasMatchExpressions := raw["matchExpressions"]
+	if !hasMatchLabels && !hasMatchExpressions {
+		set := labels.Set{}
+		for k, v := range raw {
+			value, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid selector of label %s", k)
+			}
+			set[k] = value
+		}
+		return labels.SelectorFromSet(set), nil
+	}
+
+	byt, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var selector metav1.LabelSelector
+	if err := json.Unmarshal(byt, &selector); err != nil {
+		return nil, err
+	}
+	return metav1.LabelSelectorAsSelector(&selector)
+}
+
+// StreamLogs sends the lines of the logs of the containers of the pods to lines, interleaved as they are read, until the
+// logs end or ctx is done. The options apply to the logs of every container, their container is ignored.
+// Once a stream of logs cannot be read, a line with its error is sent and the other streams go on.
+func StreamLogs(ctx context.Context, client *meshkube.Client, pods []corev1.Pod, opts corev1.PodLogOptions, lines chan<- LogLine) {
+	var wg sync.WaitGroup
+	for _, pod := range pods {
+		for _, container := range pod.Spec.Containers {
+			containerOpts := opts
+			containerOpts.Container = container.Name
+			wg.Add(1)
+			go func(pod corev1.Pod, opts corev1.PodLogOptions) {
+				defer wg.Done()
+				if err := streamContainerLogs(ctx, client, pod, &opts, lines); err != nil && ctx.Err() == nil {
+					send(ctx, lines, LogLine{Pod: pod.Name, Container: opts.Container, Error: ErrPodLogs(err, pod.Name).Error()})
+				}
+			}(pod, containerOpts)
+		}
+	}
+	wg.Wait()
+}
+
+func streamContainerLogs(ctx context.Context, client *meshkube.Client, pod corev1.Pod, opts *corev1.PodLogOptions, lines chan<- LogLine) error {
+	stream, err := client.KubeClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogLineSize)
+	for scanner.Scan() {
+		if !send(ctx, lines, LogLine{Pod: pod.Name, Container: opts.Container, Line: scanner.Text()}) {
+			return nil
+		}
+	}
+	return scanner.Err()
+}
+
+// send sends the line unless ctx is done first, and reports whether it was sent
+func send(ctx context.Context, lines chan<- LogLine, line LogLine) bool {
+	select {
+	case lines <- line:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,65 @@
+package k8s
+
+import "testing"
+
+func TestPodSelector(t *testing.T) {
+	tests := []struct {
+		name    string
+		live    map[string]interface{}
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "deployment",
+			live: map[string]interface{}{"kind": "Deployment", "spec": map[string]interface{}{
+				"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "web"}},
+			}},
+			want: "app=web",
+		},
+		{
+			name: "deployment with expressions",
+			live: map[string]interface{}{"kind": "Deployment", "spec": map[string]interface{}{
+				"selector": map[string]interface{}{"matchExpressions": []interface{}{
+					map[string]interface{}{"key": "tier", "operator": "In", "values": []interface{}{"api", "web"}},
+				}},
+			}},
+			want: "tier in (api,web)",
+		},
+		{
+			name: "service",
+			live: map[string]interface{}{"kind": "Service", "spec": map[string]interface{}{
+				"selector": map[string]interface{}{"app": "web", "tier": "frontend"},
+			}},
+			want: "app=web,tier=frontend",
+		},
+		{
+			name:    "configmap",
+			live:    map[string]interface{}{"kind": "ConfigMap", "data": map[string]interface{}{"key": "value"}},
+			wantErr: true,
+		},
+		{
+			name: "service without selector",
+			live: map[string]interface{}{"kind": "Service", "spec": map[string]interface{}{
+				"type": "ExternalName",
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := podSelector(tt.live)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("podSelector() = %s, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("podSelector() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
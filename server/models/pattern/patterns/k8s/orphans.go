@@ -0,0 +1,111 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// Orphan is a resource labeled with the id of a design which is none of the components of the design
+type Orphan struct {
+	core.ResourceChange
+	resource schema.GroupVersionResource
+}
+
+// Orphans returns the resources of the cluster labeled with the id of the design which are none of the given components
+// of the design, eg: the resources of the components removed from the design since it was deployed.
+// Resources owned by other resources are left to the garbage collection of their owners.
+func Orphans(ctx context.Context, client *meshkube.Client, patternID string, comps []v1alpha1.Component) ([]Orphan, error) {
+	lists, err := client.KubeClient.Discovery().ServerPreferredResources()
+	// the groups which could not be discovered, eg: of unavailable aggregated APIs, are skipped
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, ErrOrphanResources(err, patternID)
+	}
+	lists = discovery.FilteredBy(discovery.SupportsAllVerbs{Verbs: []string{"list", "delete"}}, lists)
+
+	// the namespaces of the components by group, kind and name, components without namespace match any namespace
+	components := make(map[string][]string, len(comps))
+	for _, comp := range comps {
+		gv, _ := schema.ParseGroupVersion(v1alpha1.GetAPIVersionFromComponent(comp))
+		key := orphanKey(gv.Group, v1alpha1.GetKindFromComponent(comp), comp.ObjectMeta.Name)
+		components[key] = append(components[key], comp.Namespace)
+	}
+
+	selector := fmt.Sprintf("%s=%s", core.PatternIDLabel, patternID)
+	orphans := []Orphan{}
+	for _, list := range lists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, res := range list.APIResources {
+			// subresources, eg: deployments/scale, are not resources of their own
+			if strings.Contains(res.Name, "/") {
+				continue
+			}
+			gvr := gv.WithResource(res.Name)
+			items, err := client.DynamicKubeClient.Resource(gvr).Namespace("").List(ctx, metav1.ListOptions{LabelSelector: selector})
+			if errors.IsNotFound(err) || errors.IsForbidden(err) || errors.IsMethodNotSupported(err) {
+				continue
+			}
+			if err != nil {
+				return nil, ErrOrphanResources(err, patternID)
+			}
+			for _, item := range items.Items {
+				if len(item.GetOwnerReferences()) > 0 || item.GetDeletionTimestamp() != nil {
+					continue
+				}
+				if isComponent(components[orphanKey(gv.Group, res.Kind, item.GetName())], item.GetNamespace()) {
+					continue
+				}
+				orphans = append(orphans, Orphan{
+					ResourceChange: core.ResourceChange{
+						APIVersion: list.GroupVersion,
+						Kind:       res.Kind,
+						Name:       item.GetName(),
+						Namespace:  item.GetNamespace(),
+						Operation:  core.ResourceDelete,
+					},
+					resource: gvr,
+				})
+			}
+		}
+	}
+	sort.Slice(orphans, func(i, j int) bool {
+		return orphanKey(orphans[i].Namespace, orphans[i].Kind, orphans[i].Name) < orphanKey(orphans[j].Namespace, orphans[j].Kind, orphans[j].Name)
+	})
+	return orphans, nil
+}
+
+// DeleteOrphan deletes the orphaned resource along with the resources it owns, a resource already deleted is not an error
+func DeleteOrphan(ctx context.Context, client *meshkube.Client, orphan Orphan) error {
+	propagation := metav1.DeletePropagationBackground
+	err := client.DynamicKubeClient.Resource(orphan.resource).Namespace(orphan.Namespace).
+		Delete(ctx, orphan.Name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+	if err != nil && !errors.IsNotFound(err) {
+		return ErrDeleteOrphanResource(err, fmt.Sprintf("%s %s", orphan.Kind, orphan.Name))
+	}
+	return nil
+}
+
+func orphanKey(group, kind, name string) string {
+	return group + "/" + kind + "/" + name
+}
+
+func isComponent(namespaces []string, namespace string) bool {
+	for _, ns := range namespaces {
+		if ns == "" || ns == namespace {
+			return true
+		}
+	}
+	return false
+}
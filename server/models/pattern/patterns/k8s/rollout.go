@@ -0,0 +1,42 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Rollout returns the status of the rollout of the live resource of the cluster the component of the change was deployed to
+func Rollout(ctx context.Context, client *meshkube.Client, change core.ResourceChange) (core.ComponentRollout, error) {
+	rollout := core.ComponentRollout{
+		Component: change.Component,
+		ContextID: change.ContextID,
+		Kind:      change.Kind,
+		Name:      change.Name,
+		Namespace: change.Namespace,
+	}
+
+	path, err := resourcePath(map[string]interface{}{"apiVersion": change.APIVersion, "kind": change.Kind}, change.Namespace)
+	if err != nil {
+		return rollout, err
+	}
+	raw, err := client.KubeClient.RESTClient().Get().AbsPath(path, change.Name).Do(ctx).Raw()
+	if errors.IsNotFound(err) {
+		rollout.Status = core.RolloutPending
+		rollout.Message = "the resource does not exist yet"
+		return rollout, nil
+	}
+	if err != nil {
+		return rollout, ErrFetchLiveResource(err, change.Component)
+	}
+
+	var live map[string]interface{}
+	if err := json.Unmarshal(raw, &live); err != nil {
+		return rollout, ErrFetchLiveResource(err, change.Component)
+	}
+	rollout.Status, rollout.Message = core.RolloutStatus(change.Kind, live)
+	return rollout, nil
+}
@@ -0,0 +1,83 @@
+package planner
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+// Dependencies returns the services each service of the pattern depends on, which have to be deployed before it.
+// Besides the ones it explicitly depends on, a service depends on:
+//  1. Its parent in the design, referenced by the "parent" field of its meshmap trait
+//  2. The Namespace it is deployed in, if the pattern defines it
+//  3. The CustomResourceDefinition of its kind, if the pattern defines it
+func Dependencies(pattern core.Pattern) map[string][]string {
+	elementToSvc := make(map[string]string)
+	namespaces := make(map[string]string)
+	crds := make(map[string]string)
+	for name, svc := range pattern.Services {
+		if id, ok := meshmapTrait(svc)["id"].(string); ok {
+			elementToSvc[id] = name
+		}
+		switch svc.Type {
+		case "Namespace":
+			namespaces[svc.Name] = name
+		case "CustomResourceDefinition":
+			if group, kind, ok := crdGroupKind(svc); ok {
+				crds[group+"/"+kind] = name
+			}
+		}
+	}
+
+	deps := make(map[string][]string)
+	for name, svc := range pattern.Services {
+		seen := map[string]bool{name: true}
+		add := func(dep string) {
+			if dep == "" || seen[dep] {
+				return
+			}
+			if _, ok := pattern.Services[dep]; !ok {
+				return
+			}
+			seen[dep] = true
+			deps[name] = append(deps[name], dep)
+		}
+
+		for _, dep := range svc.DependsOn {
+			add(dep)
+		}
+		if parent, ok := meshmapTrait(svc)["parent"].(string); ok {
+			add(elementToSvc[parent])
+		}
+		add(namespaces[svc.Namespace])
+		if group := apiGroup(svc.APIVersion); group != "" {
+			add(crds[group+"/"+svc.Type])
+		}
+		sort.Strings(deps[name])
+	}
+	return deps
+}
+
+func meshmapTrait(svc *core.Service) map[string]interface{} {
+	m, _ := svc.Traits["meshmap"].(map[string]interface{})
+	return m
+}
+
+// crdGroupKind returns the group and kind of the custom resources defined by the CustomResourceDefinition
+func crdGroupKind(svc *core.Service) (string, string, bool) {
+	spec, _ := svc.Settings["spec"].(map[string]interface{})
+	group, _ := spec["group"].(string)
+	names, _ := spec["names"].(map[string]interface{})
+	kind, _ := names["kind"].(string)
+	return group, kind, group != "" && kind != ""
+}
+
+// apiGroup returns the group of the apiVersion, which is empty for the core group
+func apiGroup(apiVersion string) string {
+	i := strings.LastIndex(apiVersion, "/")
+	if i < 0 {
+		return ""
+	}
+	return apiVersion[:i]
+}
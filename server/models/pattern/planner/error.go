@@ -0,0 +1,15 @@
+package planner
+
+import (
+	"strings"
+
+	"github.com/layer5io/meshkit/errors"
+)
+
+const (
+	ErrCyclicPlanCode = "1552"
+)
+
+func ErrCyclicPlan(cycle []string) error {
+	return errors.New(ErrCyclicPlanCode, errors.Alert, []string{"infeasible execution: detected cycle in the plan"}, []string{"cyclic dependency between the components: " + strings.Join(cycle, " -> ")}, []string{"The components depend on each other through the dependsOn field, their parent in the design, their namespace or their CustomResourceDefinition"}, []string{"Remove one of the dependencies between the components of the cycle"})
+}
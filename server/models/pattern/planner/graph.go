@@ -1,6 +1,7 @@
 package planner
 
 import (
+	"sort"
 	"sync"
 	"sync/atomic"
 
@@ -77,6 +78,59 @@ func (g *Graph) DetectCycle() bool {
 	return !g.topologicalSort(func(_ string, _ core.Service) bool { return true })
 }
 
+// FindCycle returns the nodes of a cycle of the graph, starting and ending with the same node,
+// or nil if the graph has none
+func (g *Graph) FindCycle() []string {
+	g.RLock()
+	defer g.RUnlock()
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(g.Nodes))
+	var path []string
+	var visit func(node string) []string
+	visit = func(node string) []string {
+		state[node] = visiting
+		path = append(path, node)
+		for _, next := range g.Edges[node] {
+			switch state[next] {
+			case visiting:
+				for i, n := range path {
+					if n == next {
+						return append(append([]string{}, path[i:]...), next)
+					}
+				}
+			case unvisited:
+				if cycle := visit(next); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[node] = visited
+		return nil
+	}
+
+	// nodes are visited in order, so that the same cycle is reported every time
+	nodes := make([]string, 0, len(g.Nodes))
+	for node := range g.Nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	for _, node := range nodes {
+		if state[node] != unvisited {
+			continue
+		}
+		if cycle := visit(node); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
 // Traverse traverses the graph in topological sorted order
 // and executes the visit function on each visited node
 func (g *Graph) Traverse(fn VisitFn) {
@@ -1,7 +1,10 @@
 package planner
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
 )
 
 func TestGraph_DetectCycle(t *testing.T) {
@@ -82,3 +85,20 @@ func TestGraph_DetectCycle(t *testing.T) {
 		})
 	}
 }
+
+func TestGraph_FindCycle(t *testing.T) {
+	g := NewGraph()
+	for _, node := range []string{"1", "2", "3", "4"} {
+		g.AddNode(node, core.Service{})
+	}
+	g.AddEdge("1", "2").AddEdge("2", "3").AddEdge("3", "4")
+	if cycle := g.FindCycle(); cycle != nil {
+		t.Errorf("expected no cycle, got %v", cycle)
+	}
+
+	g.AddEdge("4", "2")
+	expected := []string{"2", "3", "4", "2"}
+	if cycle := g.FindCycle(); !reflect.DeepEqual(cycle, expected) {
+		t.Errorf("expected cycle %v, got %v", expected, cycle)
+	}
+}
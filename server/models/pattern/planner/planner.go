@@ -13,6 +13,14 @@ func (p *Plan) IsFeasible() bool {
 	return !p.DetectCycle()
 }
 
+// Validate returns an error naming the components of a cycle of the plan, if it has one
+func (p *Plan) Validate() error {
+	if cycle := p.FindCycle(); cycle != nil {
+		return ErrCyclicPlan(cycle)
+	}
+	return nil
+}
+
 // Execute traverses the plan and calls the callback function
 // on each of the node
 func (p *Plan) Execute(cb func(string, core.Service) bool) error {
@@ -21,7 +29,8 @@ func (p *Plan) Execute(cb func(string, core.Service) bool) error {
 	return nil
 }
 
-// CreatePlan takes in the application components and creates a plan of execution for it
+// CreatePlan takes in the application components and creates a plan of execution for it,
+// in which every component is executed after its Dependencies, or before them if invert is true
 func CreatePlan(pattern core.Pattern, invert bool) (*Plan, error) {
 	g := NewGraph()
 
@@ -29,8 +38,8 @@ func CreatePlan(pattern core.Pattern, invert bool) (*Plan, error) {
 		g.AddNode(name, *svc)
 	}
 
-	for name, svc := range pattern.Services {
-		for _, dep := range svc.DependsOn {
+	for name, deps := range Dependencies(pattern) {
+		for _, dep := range deps {
 			from := dep
 			to := name
 
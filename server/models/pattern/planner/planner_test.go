@@ -0,0 +1,95 @@
+package planner
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+func TestDependencies(t *testing.T) {
+	pattern := core.Pattern{
+		Services: map[string]*core.Service{
+			"ns": {Name: "demo", Type: "Namespace", APIVersion: "v1"},
+			"crd": {Name: "widgets.example.com", Type: "CustomResourceDefinition", APIVersion: "apiextensions.k8s.io/v1", Settings: map[string]interface{}{
+				"spec": map[string]interface{}{"group": "example.com", "names": map[string]interface{}{"kind": "Widget"}},
+			}},
+			"widget": {Name: "widget", Type: "Widget", APIVersion: "example.com/v1", Namespace: "demo"},
+			"app": {Name: "app", Type: "Deployment", APIVersion: "apps/v1", Namespace: "demo", Traits: map[string]interface{}{
+				"meshmap": map[string]interface{}{"id": "app-id", "parent": "widget-id"},
+			}},
+			"svc": {Name: "svc", Type: "Service", APIVersion: "v1", DependsOn: []string{"app", "missing"}},
+		},
+	}
+	pattern.Services["widget"].Traits = map[string]interface{}{"meshmap": map[string]interface{}{"id": "widget-id"}}
+
+	expected := map[string][]string{
+		"widget": {"crd", "ns"},
+		"app":    {"ns", "widget"},
+		"svc":    {"app"},
+	}
+	if got := Dependencies(pattern); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected dependencies %v, got %v", expected, got)
+	}
+}
+
+func TestPlanExecuteOrder(t *testing.T) {
+	pattern := core.Pattern{
+		Services: map[string]*core.Service{
+			"ns":     {Name: "demo", Type: "Namespace", APIVersion: "v1"},
+			"app":    {Name: "app", Type: "Deployment", APIVersion: "apps/v1", Namespace: "demo"},
+			"config": {Name: "config", Type: "ConfigMap", APIVersion: "v1", Namespace: "demo"},
+			"svc":    {Name: "svc", Type: "Service", APIVersion: "v1", Namespace: "demo", DependsOn: []string{"app"}},
+		},
+	}
+
+	for _, invert := range []bool{false, true} {
+		plan, err := CreatePlan(pattern, invert)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := plan.Validate(); err != nil {
+			t.Fatal(err)
+		}
+		var mu sync.Mutex
+		position := map[string]int{}
+		_ = plan.Execute(func(name string, _ core.Service) bool {
+			mu.Lock()
+			defer mu.Unlock()
+			position[name] = len(position)
+			return true
+		})
+		before := func(a, b string) bool {
+			if invert {
+				return position[a] > position[b]
+			}
+			return position[a] < position[b]
+		}
+		for _, pair := range [][2]string{{"ns", "app"}, {"ns", "config"}, {"ns", "svc"}, {"app", "svc"}} {
+			if !before(pair[0], pair[1]) {
+				t.Errorf("invert %v: expected %s to be executed before %s, got order %v", invert, pair[0], pair[1], position)
+			}
+		}
+	}
+}
+
+func TestPlanValidateCycle(t *testing.T) {
+	pattern := core.Pattern{
+		Services: map[string]*core.Service{
+			"ns":  {Name: "demo", Type: "Namespace", APIVersion: "v1", DependsOn: []string{"app"}},
+			"app": {Name: "app", Type: "Deployment", APIVersion: "apps/v1", Namespace: "demo"},
+		},
+	}
+	plan, err := CreatePlan(pattern, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := plan.Validate(); err == nil {
+		t.Fatal("expected the plan to be infeasible")
+	}
+	expected := []string{"app", "ns", "app"}
+	if got := plan.FindCycle(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected cycle %v, got %v", expected, got)
+	}
+}
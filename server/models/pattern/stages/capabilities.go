@@ -0,0 +1,44 @@
+package stages
+
+import (
+	"context"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+const CapabilitiesKey = "capabilities"
+
+// Capabilities checks whether the clusters serve the kinds of the components of the pattern and stores the capability of
+// every component in every cluster in the `Other` placeholder, before any of them is provisioned.
+// With enforce set, the deployment is terminated when some of the components cannot be deployed.
+func Capabilities(_ ServiceInfoProvider, act ServiceActionProvider, enforce bool) ChainStageFunction {
+	return func(ctx context.Context, data *Data, err error, next ChainStageNextFunction) {
+		if err != nil {
+			act.Terminate(err)
+			return
+		}
+		comps := applicationComponents(data)
+		if err := ctx.Err(); err != nil {
+			act.Terminate(err)
+			return
+		}
+		capabilities, err := act.Capabilities(ctx, comps)
+		if err != nil {
+			act.Terminate(err)
+			return
+		}
+		data.Lock.Lock()
+		if data.Other == nil {
+			data.Other = make(map[string]interface{})
+		}
+		data.Other[CapabilitiesKey] = capabilities
+		data.Lock.Unlock()
+		if undeployable := core.UndeployableComponents(capabilities); enforce && len(undeployable) > 0 {
+			act.Terminate(core.ErrUndeployableComponents(undeployable))
+			return
+		}
+		if next != nil {
+			next(data, nil)
+		}
+	}
+}
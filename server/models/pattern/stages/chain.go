@@ -1,93 +1,551 @@
 package stages
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/layer5io/meshery/server/models/pattern/core"
 	meshmodel "github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
 )
 
+// tracer emits the spans of the chain, they are exported by the tracer provider registered with otel
+var tracer = otel.Tracer("github.com/layer5io/meshery/server/models/pattern/stages")
+
 // Data is the struct that will be passed on each stage
 type Data struct {
 	Pattern                        *core.Pattern
 	PatternSvcWorkloadCapabilities map[string]meshmodel.ComponentDefinition
 	PatternSvcTraitCapabilities    map[string][]core.TraitCapability //Deprecated. This will be removed and is currently being used to carry properties
 
+	// DryRun makes the provisioning stages render the manifests they would apply instead of applying them
+	DryRun bool
+
 	// Other is for passing metadata across different stages
 	Lock  sync.Mutex
 	Other map[string]interface{}
 }
 
-// ChainStageFunction is the type for function that will be invoked on each stage of the chain
-type ChainStageFunction func(data *Data, err error, next ChainStageNextFunction)
+// ChainStageFunction is the type for function that will be invoked on each stage of the chain.
+// Long-running stages should honor the cancellation and deadline of ctx.
+// A stage fails by passing an error to next, the error policy of the stage decides whether the following stages run.
+type ChainStageFunction func(ctx context.Context, data *Data, err error, next ChainStageNextFunction)
 
 type ChainStageNextFunction func(data *Data, err error)
 
+// ChainStageCompensation undoes the work of a stage when the chain is rolled back, err is the error of the stage which failed.
+// Compensations are invoked even if ctx was cancelled, so that a cancelled deployment can be undone.
+type ChainStageCompensation func(ctx context.Context, data *Data, err error) error
+
+// ChainMiddleware wraps a stage function with a cross-cutting concern such as logging or metrics.
+// The wrapping function decides whether and how the wrapped one is invoked, it must call next exactly as a stage does.
+type ChainMiddleware func(ChainStageFunction) ChainStageFunction
+
 // ChainStages type represents a slice of ChainStageFunction
-type ChainStages []ChainStageNextFunction
+type ChainStages []ChainStageFunction
+
+// ErrorPolicy decides how the chain proceeds when a stage passes an error to next
+type ErrorPolicy int
+
+const (
+	// FailFast stops the chain at the failed stage, the remaining stages are not invoked.
+	// This is the policy of chains and stages which do not configure one.
+	FailFast ErrorPolicy = iota
+	// ContinueOnError records the error and invokes the next stage as if the failed stage succeeded,
+	// for stages whose failure must not stop the deployment.
+	ContinueOnError
+	// Rollback stops the chain like FailFast and undoes the work of the stages which ran: their compensations
+	// are invoked in reverse order, starting with the failed stage as it may have done part of its work,
+	// and the metadata they stored in data.Other is discarded.
+	// A stage terminating the chain is rolled back as well, its compensation and the ones of the stages which ran
+	// before it are passed ErrTerminated.
+	Rollback
+)
+
+// ErrTerminated is passed to the compensations of a chain rolled back because a stage terminated it
+var ErrTerminated = errors.New("the chain was terminated")
+
+// StageError is an error passed to next by a stage of the chain
+type StageError struct {
+	// Stage is the position of the failed stage in the chain
+	Stage int
+	Err   error
+}
+
+func (e *StageError) Error() string {
+	return fmt.Sprintf("stage %d: %s", e.Stage, e.Err)
+}
+
+func (e *StageError) Unwrap() error {
+	return e.Err
+}
+
+// PanicError is the error of a stage which panicked, the chain proceeds according to the error policy of the stage
+type PanicError struct {
+	// Value passed to panic
+	Value interface{}
+	// Stack of the goroutine which panicked
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// recoverStage converts a panic of the stage into a PanicError stored in err, it must be deferred
+func recoverStage(err *error) {
+	if v := recover(); v != nil {
+		*err = &PanicError{Value: v, Stack: debug.Stack()}
+	}
+}
+
+// runStage invokes the stage function and returns the PanicError of the stage if it panicked
+func runStage(ctx context.Context, fn ChainStageFunction, data *Data, err error, next ChainStageNextFunction) (panicErr error) {
+	defer recoverStage(&panicErr)
+	fn(ctx, data, err, next)
+	return nil
+}
+
+// ChainResult is the outcome of processing a chain
+type ChainResult struct {
+	// Errors are the errors reported by the stages in the order they ran, including the ones tolerated by ContinueOnError
+	Errors []*StageError
+	// RolledBack is true when a failed stage with the Rollback policy undid the work of the stages which ran
+	RolledBack bool
+	// CompensationErrors are the errors returned by the compensations of the rolled back stages
+	CompensationErrors []*StageError
+	// CheckpointErrors are the errors of the checkpoints which could not be saved, they do not stop the chain
+	CheckpointErrors []*StageError
+	// Resumed are the names of the stages completed by the interrupted processing, see Resume
+	Resumed []string
+	// Ran are the names of the stages which ran, in the order they ran
+	Ran []string
+	// Skipped are the names of the stages skipped because of their predicate
+	Skipped []string
+	// Timings are the durations of the stages which ran, in the order they ran
+	Timings []StageTiming
+
+	err error
+}
+
+// StageTiming is the time spent in a stage of the chain
+type StageTiming struct {
+	Stage int    `json:"stage"`
+	Name  string `json:"name"`
+	// Duration of the stage in nanoseconds, excluding the stages it invoked through next
+	Duration time.Duration `json:"duration"`
+}
+
+// Err returns the error processing stopped with, either the error of the context
+// or the error of the stage which stopped the chain. It is nil when every failed stage was tolerated.
+func (r *ChainResult) Err() error {
+	return r.err
+}
+
+// ChainStagePredicate decides whether a stage runs, it is evaluated with the data passed on by the previous stage
+type ChainStagePredicate func(data *Data) bool
+
+// ChainStage is a stage of the chain along with the options of its invocation
+type ChainStage struct {
+	// Name of the stage in the result and the traces, defaults to the name of the function which created Fn
+	Name string
+	Fn   ChainStageFunction
+	// When skips the stage if it returns false, a nil When always runs the stage
+	When ChainStagePredicate
+	// Compensate undoes the work of the stage on rollback
+	Compensate ChainStageCompensation
+	// ErrorPolicy overrides the policy of the chain for this stage
+	ErrorPolicy *ErrorPolicy
+}
 
 // Chain allows to add any number of stages to be added to itself
-// allowing "chaining" all of those functions
+// allowing "chaining" all of those functions.
+//
+// The zero value is an empty chain ready to use. A chain is safe for concurrent use,
+// stages can be added while the chain is being processed and the chain can be processed
+// from multiple goroutines at once, as pattern deployments can be triggered in parallel.
 type Chain struct {
-	stages ChainStages
-	nexts  ChainStages
-
-	mu sync.Mutex
+	mu           sync.Mutex
+	stages       []ChainStage
+	policy       ErrorPolicy
+	checkpointer Checkpointer
+	progress     ProgressFunc
+	middlewares  []ChainMiddleware
 }
 
 // CreateChain returns a pointer to the chain object
 func CreateChain() *Chain {
 	return &Chain{
-		stages: make(ChainStages, 0),
-		nexts:  make(ChainStages, 0),
+		stages: make([]ChainStage, 0),
 	}
 }
 
 // Add adds a function to the chain and returns a pointer to the Chain object
 func (ch *Chain) Add(fn ChainStageFunction) *Chain {
-	ch.mu.Lock()
-	defer ch.mu.Unlock()
+	return ch.AddStage(ChainStage{Fn: fn})
+}
 
-	// Add the next function for "fn"
-	ch.nexts = append(ch.nexts, nil)
+// AddNamed adds a function to the chain under name, the stage is skipped when the predicate when returns false
+func (ch *Chain) AddNamed(name string, fn ChainStageFunction, when ChainStagePredicate) *Chain {
+	return ch.AddStage(ChainStage{Name: name, Fn: fn, When: when})
+}
 
-	nextIdxStageFn := len(ch.nexts) - 1
+// AddWithErrorPolicy adds a function to the chain which fails according to policy instead of the policy of the chain
+func (ch *Chain) AddWithErrorPolicy(fn ChainStageFunction, policy ErrorPolicy) *Chain {
+	return ch.AddStage(ChainStage{Fn: fn, ErrorPolicy: &policy})
+}
 
-	// Create the stage function
-	stageFn := func(data *Data, err error) {
-		fn(data, err, ch.nexts[nextIdxStageFn])
+// AddWithCompensation adds a function to the chain along with the compensation undoing its work on rollback
+func (ch *Chain) AddWithCompensation(fn ChainStageFunction, compensate ChainStageCompensation) *Chain {
+	return ch.AddStage(ChainStage{Fn: fn, Compensate: compensate})
+}
+
+// AddParallel adds a group of independent functions to the chain, see Parallel
+func (ch *Chain) AddParallel(fns ...ChainStageFunction) *Chain {
+	names := make([]string, 0, len(fns))
+	for _, fn := range fns {
+		names = append(names, stageName(fn))
 	}
+	return ch.AddStage(ChainStage{
+		Name: fmt.Sprintf("parallel(%s)", strings.Join(names, ", ")),
+		Fn:   Parallel(fns...),
+	})
+}
 
-	// Modify next function of previous stage to point
-	// to the newly added "fn"
-	if nextIdxStageFn > 0 {
-		ch.nexts[nextIdxStageFn-1] = stageFn
+// AddStage adds the stage to the chain and returns a pointer to the Chain object
+func (ch *Chain) AddStage(stage ChainStage) *Chain {
+	if stage.Name == "" {
+		stage.Name = stageName(stage.Fn)
 	}
 
-	// Add the stageFn to stages
-	ch.stages = append(ch.stages, stageFn)
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
 
+	ch.stages = append(ch.stages, stage)
 	return ch
 }
 
-// Process takes in a plan and starts the chain of the functions
-//
-// Returns a pointer to the Chain object
-func (ch *Chain) Process(data *Data) *Chain {
+// Use adds middlewares wrapping every stage of the chain, including the ones added before Use was called.
+// The middlewares added first are the outermost ones. The ctx passed to the middlewares carries the name
+// of the stage, see StageNameFromContext. The functions of a parallel stage are wrapped as a whole.
+func (ch *Chain) Use(mws ...ChainMiddleware) *Chain {
 	ch.mu.Lock()
 	defer ch.mu.Unlock()
 
-	if len(ch.stages) > 0 {
-		ch.stages[0](data, nil)
-	}
+	ch.middlewares = append(ch.middlewares, mws...)
+	return ch
+}
+
+// WithCheckpointer sets the checkpointer saving the progress of the chain after every completed stage
+func (ch *Chain) WithCheckpointer(cp Checkpointer) *Chain {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
 
+	ch.checkpointer = cp
 	return ch
 }
 
+// WithProgress sets the function receiving the progress of the stages and of the components they process, see ReportComponentProgress
+func (ch *Chain) WithProgress(fn ProgressFunc) *Chain {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	ch.progress = fn
+	return ch
+}
+
+// WithErrorPolicy sets the policy applied to the failures of the stages added without one
+func (ch *Chain) WithErrorPolicy(policy ErrorPolicy) *Chain {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	ch.policy = policy
+	return ch
+}
+
+// Process takes in a plan and starts the chain of the functions.
+//
+// The stages are invoked in the order they were added, each stage continues the chain by calling next,
+// a stage returning without calling next terminates the chain. next must be called before the stage returns,
+// the last stage is passed a nil next.
+// Processing works on the stages added until Process is called, stages added afterwards only take
+// part in the subsequent calls.
+//
+// Stages whose predicate returns false are skipped, the following stage is passed the data and error the skipped one was passed.
+// A stage fails when it passes an error to next or panics, the error policy of the stage decides whether the chain goes on.
+// The panics of the stages are recovered and reported as a PanicError.
+// The remaining stages are not invoked once ctx is cancelled, in which case the result reports the error of ctx.
+//
+// Every stage is timed and traced with a span named after the stage, child of a span covering the whole chain,
+// and its progress is reported to the ProgressFunc of the chain as it starts and finishes.
+func (ch *Chain) Process(ctx context.Context, data *Data) *ChainResult {
+	return ch.process(ctx, data, nil)
+}
+
+// Resume continues an interrupted processing from the data of its last checkpoint, the stages named in completed are not invoked again.
+// The completed stages are not compensated if the resumed processing is rolled back.
+func (ch *Chain) Resume(ctx context.Context, data *Data, completed []string) *ChainResult {
+	return ch.process(ctx, data, completed)
+}
+
+func (ch *Chain) process(ctx context.Context, data *Data, completed []string) *ChainResult {
+	stages, defaultPolicy, checkpointer, progress := ch.snapshot()
+	result := &ChainResult{}
+	done := make(map[string]bool, len(completed))
+	for _, name := range completed {
+		done[name] = true
+	}
+	completed = append([]string{}, completed...)
+
+	ctx, span := tracer.Start(ctx, "pattern.chain")
+	defer func() {
+		if result.err != nil {
+			span.RecordError(result.err)
+			span.SetStatus(codes.Error, result.err.Error())
+		}
+		span.End()
+	}()
+
+	// the metadata before any stage ran, restored by the Rollback policy
+	var other map[string]interface{}
+	data.Lock.Lock()
+	if data.Other != nil {
+		other = make(map[string]interface{}, len(data.Other))
+		for k, v := range data.Other {
+			other[k] = v
+		}
+	}
+	data.Lock.Unlock()
+
+	var err error
+	// the position of the stages which ran, compensated on rollback
+	var ran []int
+	for i, stage := range stages {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			result.err = ctxErr
+			return result
+		}
+		if done[stage.Name] {
+			result.Resumed = append(result.Resumed, stage.Name)
+			continue
+		}
+		reporter := &progressReporter{fn: progress, stage: i, name: stage.Name}
+		if stage.When != nil && !stage.When(data) {
+			result.Skipped = append(result.Skipped, stage.Name)
+			reporter.report(Progress{Status: ProgressSkipped})
+			continue
+		}
+		ran = append(ran, i)
+		result.Ran = append(result.Ran, stage.Name)
+		proceed := false
+		var next ChainStageNextFunction
+		if i < len(stages)-1 {
+			next = func(d *Data, e error) {
+				proceed = true
+				data, err = d, e
+			}
+		}
+		stageCtx, stageSpan := tracer.Start(ctx, stage.Name, trace.WithAttributes(attribute.Int("meshery.chain.stage", i)))
+		stageCtx = context.WithValue(stageCtx, progressKey{}, reporter)
+		reporter.report(Progress{Status: ProgressStarted})
+		start := time.Now()
+		if panicErr := runStage(stageCtx, stage.Fn, data, err, next); panicErr != nil {
+			// the stage failed, even if it called next before panicking
+			proceed = true
+			err = panicErr
+			stageSpan.SetAttributes(attribute.String("exception.stacktrace", string(panicErr.(*PanicError).Stack)))
+		}
+		duration := time.Since(start)
+		result.Timings = append(result.Timings, StageTiming{Stage: i, Name: stage.Name, Duration: duration})
+		switch {
+		case proceed && err != nil:
+			stageSpan.RecordError(err)
+			stageSpan.SetStatus(codes.Error, err.Error())
+			reporter.report(Progress{Status: ProgressFailed, Error: err.Error(), Duration: duration})
+		case proceed || next == nil:
+			reporter.report(Progress{Status: ProgressCompleted, Duration: duration})
+		default:
+			reporter.report(Progress{Status: ProgressTerminated, Duration: duration})
+		}
+		stageSpan.End()
+		policy := defaultPolicy
+		if stage.ErrorPolicy != nil {
+			policy = *stage.ErrorPolicy
+		}
+		if !proceed {
+			// stages terminate the chain when they fail, reporting their error through the action provider
+			if next != nil && policy == Rollback {
+				rollback(ctx, result, stages, ran, data, other, ErrTerminated)
+			}
+			break
+		}
+		if err == nil {
+			completed = append(completed, stage.Name)
+			checkpoint(ctx, checkpointer, result, i, completed, data)
+			continue
+		}
+
+		stageErr := &StageError{Stage: i, Err: err}
+		result.Errors = append(result.Errors, stageErr)
+		switch policy {
+		case ContinueOnError:
+			err = nil
+			completed = append(completed, stage.Name)
+			checkpoint(ctx, checkpointer, result, i, completed, data)
+		case Rollback:
+			rollback(ctx, result, stages, ran, data, other, err)
+			result.err = stageErr
+			return result
+		default:
+			result.err = stageErr
+			return result
+		}
+	}
+
+	return result
+}
+
+// Parallel returns a stage running the functions concurrently, the chain continues once all of them have returned.
+//
+// The functions share the data of the chain and must hold data.Lock while accessing it, the data they pass to next is ignored.
+// The first of them failing or panicking cancels the ctx of the others and its error is passed on by the stage,
+// and the chain is terminated if any of them returns without calling next.
+func Parallel(fns ...ChainStageFunction) ChainStageFunction {
+	return func(ctx context.Context, data *Data, err error, next ChainStageNextFunction) {
+		g, gctx := errgroup.WithContext(ctx)
+		proceed := make([]bool, len(fns))
+		for i, fn := range fns {
+			i, fn := i, fn
+			g.Go(func() error {
+				fnCtx, span := tracer.Start(gctx, stageName(fn))
+				defer span.End()
+
+				var fnErr error
+				if panicErr := runStage(fnCtx, fn, data, err, func(_ *Data, e error) {
+					proceed[i] = true
+					fnErr = e
+				}); panicErr != nil {
+					proceed[i] = true
+					fnErr = panicErr
+				}
+				if fnErr != nil {
+					span.RecordError(fnErr)
+					span.SetStatus(codes.Error, fnErr.Error())
+				}
+				return fnErr
+			})
+		}
+		groupErr := g.Wait()
+
+		for _, p := range proceed {
+			if !p {
+				return
+			}
+		}
+		if next != nil {
+			next(data, groupErr)
+		}
+	}
+}
+
+// stageName names the stage after the function which created it, e.g. Provision for the closure returned by Provision
+func stageName(fn ChainStageFunction) string {
+	f := runtime.FuncForPC(reflect.ValueOf(fn).Pointer())
+	if f == nil {
+		return "stage"
+	}
+	name := f.Name()
+	// strip the import path and package of the function
+	name = name[strings.LastIndex(name, "/")+1:]
+	if i := strings.Index(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	// strip the suffix of closures
+	if i := strings.Index(name, ".func"); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// checkpoint saves the progress of the chain once the stage at position i completed
+func checkpoint(ctx context.Context, cp Checkpointer, result *ChainResult, i int, completed []string, data *Data) {
+	if cp == nil {
+		return
+	}
+	snapshot, err := MarshalData(data)
+	if err == nil {
+		err = cp.SaveCheckpoint(ctx, completed, snapshot)
+	}
+	if err != nil {
+		result.CheckpointErrors = append(result.CheckpointErrors, &StageError{Stage: i, Err: err})
+	}
+}
+
+// rollback undoes the work of the stages which ran and restores the metadata of data.Other to other
+func rollback(ctx context.Context, result *ChainResult, stages []ChainStage, ran []int, data *Data, other map[string]interface{}, err error) {
+	result.CompensationErrors = compensate(ctx, stages, ran, data, err)
+	data.Lock.Lock()
+	data.Other = other
+	data.Lock.Unlock()
+	result.RolledBack = true
+}
+
+// compensate invokes the compensations of the stages which ran in reverse order
+func compensate(ctx context.Context, stages []ChainStage, ran []int, data *Data, err error) []*StageError {
+	// the deployment is undone even if it failed because it was cancelled
+	ctx = context.WithoutCancel(ctx)
+
+	var errs []*StageError
+	for j := len(ran) - 1; j >= 0; j-- {
+		i := ran[j]
+		if stages[i].Compensate == nil {
+			continue
+		}
+		if cerr := runCompensation(ctx, stages[i].Compensate, data, err); cerr != nil {
+			errs = append(errs, &StageError{Stage: i, Err: cerr})
+		}
+	}
+	return errs
+}
+
+// runCompensation invokes the compensation and returns its error, or its PanicError if it panicked
+func runCompensation(ctx context.Context, fn ChainStageCompensation, data *Data, err error) (cerr error) {
+	defer recoverStage(&cerr)
+	return fn(ctx, data, err)
+}
+
 // Clear clears the chain and returns a pointer to the chain object
 func (ch *Chain) Clear() *Chain {
-	ch.stages = []ChainStageNextFunction{}
-	ch.nexts = []ChainStageNextFunction{}
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
 
+	ch.stages = []ChainStage{}
 	return ch
 }
+
+// snapshot returns a copy of the stages, wrapped by the middlewares, and the options of the chain
+// so that processing does not hold the lock while stages run
+func (ch *Chain) snapshot() ([]ChainStage, ErrorPolicy, Checkpointer, ProgressFunc) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	stages := make([]ChainStage, len(ch.stages))
+	copy(stages, ch.stages)
+	for i := range stages {
+		for j := len(ch.middlewares) - 1; j >= 0; j-- {
+			stages[i].Fn = ch.middlewares[j](stages[i].Fn)
+		}
+	}
+	return stages, ch.policy, ch.checkpointer, ch.progress
+}
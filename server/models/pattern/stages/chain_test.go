@@ -0,0 +1,636 @@
+package stages
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// appendStage records its name in data.Other["order"] and continues the chain
+func appendStage(name string) ChainStageFunction {
+	return func(_ context.Context, data *Data, err error, next ChainStageNextFunction) {
+		data.Lock.Lock()
+		order, _ := data.Other["order"].([]string)
+		data.Other["order"] = append(order, name)
+		data.Lock.Unlock()
+		if next != nil {
+			next(data, err)
+		}
+	}
+}
+
+func processedOrder(data *Data) []string {
+	order, _ := data.Other["order"].([]string)
+	return order
+}
+
+func TestChainProcess(t *testing.T) {
+	t.Run("Zero value chain is usable", func(t *testing.T) {
+		var ch Chain
+		data := &Data{Other: map[string]interface{}{}}
+		ch.Add(appendStage("a")).Add(appendStage("b")).Process(context.Background(), data)
+		if got := processedOrder(data); !reflect.DeepEqual(got, []string{"a", "b"}) {
+			t.Errorf("expected stages [a b] to run, got %v", got)
+		}
+	})
+
+	t.Run("Stage not calling next terminates the chain", func(t *testing.T) {
+		data := &Data{Other: map[string]interface{}{}}
+		CreateChain().
+			Add(appendStage("a")).
+			Add(func(_ context.Context, data *Data, err error, next ChainStageNextFunction) {}).
+			Add(appendStage("c")).
+			Process(context.Background(), data)
+		if got := processedOrder(data); !reflect.DeepEqual(got, []string{"a"}) {
+			t.Errorf("expected stages [a] to run, got %v", got)
+		}
+	})
+
+	t.Run("Last stage is passed a nil next", func(t *testing.T) {
+		CreateChain().
+			Add(appendStage("a")).
+			Add(func(_ context.Context, data *Data, err error, next ChainStageNextFunction) {
+				if next != nil {
+					t.Error("expected the last stage to be passed a nil next")
+				}
+			}).
+			Process(context.Background(), &Data{Other: map[string]interface{}{}})
+	})
+
+	t.Run("Stages added while processing do not take part in the running process", func(t *testing.T) {
+		ch := CreateChain()
+		data := &Data{Other: map[string]interface{}{}}
+		ch.Add(func(ctx context.Context, d *Data, err error, next ChainStageNextFunction) {
+			ch.Add(appendStage("late"))
+			appendStage("a")(ctx, d, err, next)
+		}).Process(context.Background(), data)
+		if got := processedOrder(data); !reflect.DeepEqual(got, []string{"a"}) {
+			t.Errorf("expected stages [a] to run, got %v", got)
+		}
+	})
+}
+
+// failStage records its name and fails with err
+func failStage(name string, err error) ChainStageFunction {
+	return func(ctx context.Context, data *Data, _ error, next ChainStageNextFunction) {
+		appendStage(name)(ctx, data, nil, func(d *Data, _ error) {
+			next(d, err)
+		})
+	}
+}
+
+func TestChainErrorPolicy(t *testing.T) {
+	stageErr := errors.New("failed")
+
+	tests := []struct {
+		name       string
+		chain      func() *Chain
+		order      []string
+		errs       int
+		stopped    bool
+		rolledBack bool
+	}{
+		{
+			name: "Failed stage stops the chain by default",
+			chain: func() *Chain {
+				return CreateChain().Add(appendStage("a")).Add(failStage("b", stageErr)).Add(appendStage("c"))
+			},
+			order:   []string{"a", "b"},
+			errs:    1,
+			stopped: true,
+		},
+		{
+			name: "Failed stage tolerated by the chain policy does not stop the chain",
+			chain: func() *Chain {
+				return CreateChain().WithErrorPolicy(ContinueOnError).
+					Add(failStage("a", stageErr)).Add(failStage("b", stageErr)).Add(appendStage("c"))
+			},
+			order: []string{"a", "b", "c"},
+			errs:  2,
+		},
+		{
+			name: "Stage policy overrides the chain policy",
+			chain: func() *Chain {
+				return CreateChain().
+					Add(appendStage("a")).
+					AddWithErrorPolicy(failStage("telemetry", stageErr), ContinueOnError).
+					Add(failStage("validate", stageErr)).
+					Add(appendStage("c"))
+			},
+			order:   []string{"a", "telemetry", "validate"},
+			errs:    2,
+			stopped: true,
+		},
+		{
+			name: "Rollback discards the metadata of the completed stages",
+			chain: func() *Chain {
+				return CreateChain().WithErrorPolicy(Rollback).Add(appendStage("a")).Add(failStage("b", stageErr)).Add(appendStage("c"))
+			},
+			errs:       1,
+			stopped:    true,
+			rolledBack: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := &Data{Other: map[string]interface{}{}}
+			result := tt.chain().Process(context.Background(), data)
+			if got := processedOrder(data); !reflect.DeepEqual(got, tt.order) {
+				t.Errorf("expected stages %v to run, got %v", tt.order, got)
+			}
+			if len(result.Errors) != tt.errs {
+				t.Errorf("expected %d stage errors, got %d", tt.errs, len(result.Errors))
+			}
+			for _, err := range result.Errors {
+				if !errors.Is(err, stageErr) {
+					t.Errorf("expected stage error to wrap %v, got %v", stageErr, err)
+				}
+			}
+			if stopped := result.Err() != nil; stopped != tt.stopped {
+				t.Errorf("expected stopped to be %t, got error %v", tt.stopped, result.Err())
+			}
+			if result.RolledBack != tt.rolledBack {
+				t.Errorf("expected rolled back to be %t, got %t", tt.rolledBack, result.RolledBack)
+			}
+		})
+	}
+}
+
+func TestChainCompensation(t *testing.T) {
+	stageErr := errors.New("failed")
+	compensateErr := errors.New("cannot undo")
+	var compensated []string
+	compensation := func(name string, err error) ChainStageCompensation {
+		return func(ctx context.Context, data *Data, failure error) error {
+			if failure != stageErr {
+				t.Errorf("expected compensation of %s to be passed %v, got %v", name, stageErr, failure)
+			}
+			compensated = append(compensated, name)
+			return err
+		}
+	}
+
+	data := &Data{Other: map[string]interface{}{"before": true}}
+	result := CreateChain().
+		WithErrorPolicy(Rollback).
+		AddWithCompensation(appendStage("a"), compensation("a", nil)).
+		Add(appendStage("b")).
+		AddWithCompensation(appendStage("c"), compensation("c", compensateErr)).
+		AddWithCompensation(failStage("d", stageErr), compensation("d", nil)).
+		AddWithCompensation(appendStage("e"), compensation("e", nil)).
+		Process(context.Background(), data)
+
+	if expected := []string{"d", "c", "a"}; !reflect.DeepEqual(compensated, expected) {
+		t.Errorf("expected stages %v to be compensated, got %v", expected, compensated)
+	}
+	if !result.RolledBack {
+		t.Error("expected the chain to be rolled back")
+	}
+	if len(result.CompensationErrors) != 1 || result.CompensationErrors[0].Stage != 2 || !errors.Is(result.CompensationErrors[0], compensateErr) {
+		t.Errorf("expected the compensation error of stage 2, got %v", result.CompensationErrors)
+	}
+	if !reflect.DeepEqual(data.Other, map[string]interface{}{"before": true}) {
+		t.Errorf("expected the metadata of the stages to be discarded, got %v", data.Other)
+	}
+}
+
+func TestChainTerminationRollback(t *testing.T) {
+	var compensated []string
+	compensation := func(name string) ChainStageCompensation {
+		return func(ctx context.Context, data *Data, failure error) error {
+			if !errors.Is(failure, ErrTerminated) {
+				t.Errorf("expected compensation of %s to be passed %v, got %v", name, ErrTerminated, failure)
+			}
+			compensated = append(compensated, name)
+			return nil
+		}
+	}
+	// the stage fails the way Provision does when the services can not be planned: it terminates the chain
+	terminate := func(_ context.Context, data *Data, _ error, _ ChainStageNextFunction) {
+		data.Lock.Lock()
+		data.Other["provisioned"] = true
+		data.Lock.Unlock()
+	}
+
+	data := &Data{Other: map[string]interface{}{"before": true}}
+	result := CreateChain().
+		WithErrorPolicy(Rollback).
+		AddWithCompensation(appendStage("pre-install"), compensation("pre-install")).
+		AddWithCompensation(terminate, compensation("provision")).
+		AddWithCompensation(appendStage("post-install"), compensation("post-install")).
+		Process(context.Background(), data)
+
+	if expected := []string{"provision", "pre-install"}; !reflect.DeepEqual(compensated, expected) {
+		t.Errorf("expected stages %v to be compensated, got %v", expected, compensated)
+	}
+	if !result.RolledBack {
+		t.Error("expected the chain to be rolled back")
+	}
+	if !reflect.DeepEqual(data.Other, map[string]interface{}{"before": true}) {
+		t.Errorf("expected the metadata of the stages to be discarded, got %v", data.Other)
+	}
+
+	// the stages which ran are only compensated under the Rollback policy
+	compensated = nil
+	result = CreateChain().
+		AddWithCompensation(appendStage("pre-install"), compensation("pre-install")).
+		AddWithCompensation(terminate, compensation("provision")).
+		Add(appendStage("post-install")).
+		Process(context.Background(), &Data{Other: map[string]interface{}{}})
+	if result.RolledBack || len(compensated) > 0 {
+		t.Errorf("expected no stage to be compensated without the Rollback policy, got %v", compensated)
+	}
+}
+
+func TestChainConditionalStages(t *testing.T) {
+	stageErr := errors.New("failed")
+	never := func(*Data) bool { return false }
+	// runs only once the stage a ran
+	afterA := func(data *Data) bool { return len(processedOrder(data)) > 0 }
+
+	var compensated []string
+	data := &Data{Other: map[string]interface{}{}}
+	result := CreateChain().
+		AddNamed("dry-run", appendStage("dry-run"), never).
+		AddStage(ChainStage{
+			Name: "a",
+			Fn:   appendStage("a"),
+			When: afterA,
+			Compensate: func(context.Context, *Data, error) error {
+				compensated = append(compensated, "a")
+				return nil
+			},
+		}).
+		AddStage(ChainStage{Fn: appendStage("b")}).
+		AddNamed("c", appendStage("c"), afterA).
+		AddStage(ChainStage{
+			Name: "d",
+			Fn:   failStage("d", stageErr),
+			Compensate: func(context.Context, *Data, error) error {
+				compensated = append(compensated, "d")
+				return nil
+			},
+		}).
+		Add(appendStage("e")).
+		Process(context.Background(), data)
+
+	if expected := []string{"b", "c", "d"}; !reflect.DeepEqual(processedOrder(data), expected) {
+		t.Errorf("expected stages %v to run, got %v", expected, processedOrder(data))
+	}
+	if expected := []string{"appendStage", "c", "d"}; !reflect.DeepEqual(result.Ran, expected) {
+		t.Errorf("expected the result to report %v as ran, got %v", expected, result.Ran)
+	}
+	if expected := []string{"dry-run", "a"}; !reflect.DeepEqual(result.Skipped, expected) {
+		t.Errorf("expected the result to report %v as skipped, got %v", expected, result.Skipped)
+	}
+
+	result = CreateChain().
+		WithErrorPolicy(Rollback).
+		AddStage(ChainStage{
+			Name: "a",
+			Fn:   appendStage("a"),
+			When: never,
+			Compensate: func(context.Context, *Data, error) error {
+				compensated = append(compensated, "a")
+				return nil
+			},
+		}).
+		AddStage(ChainStage{
+			Name: "d",
+			Fn:   failStage("d", stageErr),
+			Compensate: func(context.Context, *Data, error) error {
+				compensated = append(compensated, "d")
+				return nil
+			},
+		}).
+		Add(appendStage("e")).
+		Process(context.Background(), &Data{Other: map[string]interface{}{}})
+	if !result.RolledBack || !reflect.DeepEqual(compensated, []string{"d"}) {
+		t.Errorf("expected only the stages which ran to be compensated, got %v", compensated)
+	}
+}
+
+func TestChainParallel(t *testing.T) {
+	stageErr := errors.New("failed")
+	// every member waits for all of them to start, which only happens if they run concurrently
+	var started sync.WaitGroup
+	member := func(name string, err error) ChainStageFunction {
+		started.Add(1)
+		return func(ctx context.Context, data *Data, _ error, next ChainStageNextFunction) {
+			started.Done()
+			started.Wait()
+			appendStage(name)(ctx, data, nil, func(d *Data, _ error) {
+				next(d, err)
+			})
+		}
+	}
+
+	data := &Data{Other: map[string]interface{}{}}
+	result := CreateChain().
+		Add(appendStage("a")).
+		AddParallel(member("b1", nil), member("b2", nil), member("b3", nil)).
+		Add(appendStage("c")).
+		Process(context.Background(), data)
+	order := processedOrder(data)
+	if len(order) != 5 || order[0] != "a" || order[4] != "c" {
+		t.Errorf("expected the group to run between stages a and c, got %v", order)
+	}
+	if result.Err() != nil {
+		t.Errorf("expected no error, got %v", result.Err())
+	}
+
+	data = &Data{Other: map[string]interface{}{}}
+	result = CreateChain().
+		AddParallel(member("b1", nil), member("b2", stageErr)).
+		Add(appendStage("c")).
+		Process(context.Background(), data)
+	if !errors.Is(result.Err(), stageErr) {
+		t.Errorf("expected the group to fail with %v, got %v", stageErr, result.Err())
+	}
+	if got := len(processedOrder(data)); got != 2 {
+		t.Errorf("expected only the members of the group to run, got %v", processedOrder(data))
+	}
+
+	data = &Data{Other: map[string]interface{}{}}
+	CreateChain().
+		AddParallel(appendStage("b1"), func(context.Context, *Data, error, ChainStageNextFunction) {}).
+		Add(appendStage("c")).
+		Process(context.Background(), data)
+	if got := processedOrder(data); !reflect.DeepEqual(got, []string{"b1"}) {
+		t.Errorf("expected a member not calling next to terminate the chain, got %v", got)
+	}
+}
+
+type checkpoints struct {
+	completed [][]string
+	snapshots [][]byte
+}
+
+func (c *checkpoints) SaveCheckpoint(_ context.Context, completed []string, snapshot []byte) error {
+	c.completed = append(c.completed, append([]string{}, completed...))
+	c.snapshots = append(c.snapshots, snapshot)
+	return nil
+}
+
+func TestChainCheckpointAndResume(t *testing.T) {
+	interrupt := func(_ context.Context, _ *Data, _ error, _ ChainStageNextFunction) {}
+	cp := &checkpoints{}
+	CreateChain().
+		WithCheckpointer(cp).
+		AddNamed("a", appendStage("a"), nil).
+		AddNamed("b", appendStage("b"), nil).
+		AddNamed("c", interrupt, nil).
+		AddNamed("d", appendStage("d"), nil).
+		Process(context.Background(), &Data{Other: map[string]interface{}{}})
+
+	expected := [][]string{{"a"}, {"a", "b"}}
+	if !reflect.DeepEqual(cp.completed, expected) {
+		t.Fatalf("expected checkpoints %v, got %v", expected, cp.completed)
+	}
+
+	data, err := UnmarshalData(cp.snapshots[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored := data.Other["order"]; !reflect.DeepEqual(restored, []interface{}{"a", "b"}) {
+		t.Errorf("expected the snapshot to hold the data of stages [a b], got %v", restored)
+	}
+	result := CreateChain().
+		WithCheckpointer(cp).
+		AddNamed("a", appendStage("a"), nil).
+		AddNamed("b", appendStage("b"), nil).
+		AddNamed("c", appendStage("c"), nil).
+		AddNamed("d", appendStage("d"), nil).
+		Resume(context.Background(), data, cp.completed[1])
+
+	if got := processedOrder(data); !reflect.DeepEqual(got, []string{"c", "d"}) {
+		t.Errorf("expected stages [c d] to run, got %v", got)
+	}
+	if !reflect.DeepEqual(result.Resumed, []string{"a", "b"}) || !reflect.DeepEqual(result.Ran, []string{"c", "d"}) {
+		t.Errorf("expected stages [c d] to run after [a b], got %v after %v", result.Ran, result.Resumed)
+	}
+	if last := cp.completed[len(cp.completed)-1]; !reflect.DeepEqual(last, []string{"a", "b", "c"}) {
+		t.Errorf("expected the resumed processing to be checkpointed, got %v", last)
+	}
+}
+
+func TestChainTimings(t *testing.T) {
+	stageErr := errors.New("failed")
+	result := CreateChain().
+		Add(appendStage("a")).
+		AddWithErrorPolicy(failStage("b", stageErr), ContinueOnError).
+		Add(func(_ context.Context, data *Data, err error, next ChainStageNextFunction) {}).
+		Add(appendStage("d")).
+		Process(context.Background(), &Data{Other: map[string]interface{}{}})
+
+	var names []string
+	for i, timing := range result.Timings {
+		if timing.Stage != i {
+			t.Errorf("expected timing %d to be of stage %d, got %d", i, i, timing.Stage)
+		}
+		names = append(names, timing.Name)
+	}
+	if expected := []string{"appendStage", "failStage", "TestChainTimings"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected timings of stages %v, got %v", expected, names)
+	}
+}
+
+func TestChainProgress(t *testing.T) {
+	var progress []string
+	result := CreateChain().
+		AddNamed("a", appendStage("a"), nil).
+		AddNamed("skipped", appendStage("skipped"), func(*Data) bool { return false }).
+		AddNamed("components", func(ctx context.Context, data *Data, err error, next ChainStageNextFunction) {
+			ReportComponentProgress(ctx, "web", ProgressStarted, 0, nil)
+			ReportComponentProgress(ctx, "web", ProgressFailed, time.Millisecond, errors.New("unreachable"))
+			next(data, err)
+		}, nil).
+		AddNamed("terminate", func(context.Context, *Data, error, ChainStageNextFunction) {}, nil).
+		AddNamed("d", appendStage("d"), nil).
+		WithProgress(func(p Progress) {
+			entry := fmt.Sprintf("%d:%s:%s", p.Stage, p.StageName, p.Status)
+			if p.Component != "" {
+				entry += fmt.Sprintf(":%s:%s", p.Component, p.Error)
+			}
+			progress = append(progress, entry)
+		}).
+		Process(context.Background(), &Data{Other: map[string]interface{}{}})
+	if err := result.Err(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	expected := []string{
+		"0:a:started", "0:a:completed",
+		"1:skipped:skipped",
+		"2:components:started", "2:components:started:web:", "2:components:failed:web:unreachable", "2:components:completed",
+		"3:terminate:started", "3:terminate:terminated",
+	}
+	if !reflect.DeepEqual(progress, expected) {
+		t.Errorf("expected progress %v, got %v", expected, progress)
+	}
+
+	// components reporting progress outside of a chain are ignored
+	ReportComponentProgress(context.Background(), "web", ProgressStarted, 0, nil)
+}
+
+func TestChainMiddleware(t *testing.T) {
+	var calls []string
+	trace := func(label string) ChainMiddleware {
+		return func(fn ChainStageFunction) ChainStageFunction {
+			return func(ctx context.Context, data *Data, err error, next ChainStageNextFunction) {
+				calls = append(calls, label+">"+StageNameFromContext(ctx))
+				fn(ctx, data, err, next)
+				calls = append(calls, label+"<"+StageNameFromContext(ctx))
+			}
+		}
+	}
+	// a middleware can skip the stage and continue the chain in its place
+	skipB := func(fn ChainStageFunction) ChainStageFunction {
+		return func(ctx context.Context, data *Data, err error, next ChainStageNextFunction) {
+			if StageNameFromContext(ctx) == "b" {
+				next(data, err)
+				return
+			}
+			fn(ctx, data, err, next)
+		}
+	}
+
+	chain := CreateChain().AddNamed("a", appendStage("a"), nil)
+	chain.Use(trace("outer"), trace("inner")).Use(skipB)
+	chain.AddNamed("b", appendStage("b"), nil).AddNamed("c", appendStage("c"), nil)
+
+	data := &Data{Other: map[string]interface{}{}}
+	if err := chain.Process(context.Background(), data).Err(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if order := processedOrder(data); !reflect.DeepEqual(order, []string{"a", "c"}) {
+		t.Errorf("expected stages [a c] to run, got %v", order)
+	}
+	expected := []string{
+		"outer>a", "inner>a", "inner<a", "outer<a",
+		"outer>b", "inner>b", "inner<b", "outer<b",
+		"outer>c", "inner>c", "inner<c", "outer<c",
+	}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("expected calls %v, got %v", expected, calls)
+	}
+}
+
+func TestChainPanicRecovery(t *testing.T) {
+	panicStage := func(context.Context, *Data, error, ChainStageNextFunction) {
+		panic("boom")
+	}
+	assertPanic := func(t *testing.T, err error) {
+		t.Helper()
+		var panicErr *PanicError
+		if !errors.As(err, &panicErr) {
+			t.Fatalf("expected a PanicError, got %v", err)
+		}
+		if panicErr.Value != "boom" || len(panicErr.Stack) == 0 {
+			t.Errorf("expected the panic value and stack, got %v and %d bytes of stack", panicErr.Value, len(panicErr.Stack))
+		}
+	}
+
+	t.Run("Panics stop the chain by default", func(t *testing.T) {
+		data := &Data{Other: map[string]interface{}{}}
+		result := CreateChain().Add(appendStage("a")).Add(panicStage).Add(appendStage("c")).Process(context.Background(), data)
+		assertPanic(t, result.Err())
+		if order := processedOrder(data); !reflect.DeepEqual(order, []string{"a"}) {
+			t.Errorf("expected stages [a] to run, got %v", order)
+		}
+	})
+
+	t.Run("Panics are tolerated by ContinueOnError", func(t *testing.T) {
+		data := &Data{Other: map[string]interface{}{}}
+		result := CreateChain().Add(appendStage("a")).AddWithErrorPolicy(panicStage, ContinueOnError).Add(appendStage("c")).Process(context.Background(), data)
+		if err := result.Err(); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(result.Errors) != 1 {
+			t.Fatalf("expected 1 stage error, got %v", result.Errors)
+		}
+		assertPanic(t, result.Errors[0])
+		if order := processedOrder(data); !reflect.DeepEqual(order, []string{"a", "c"}) {
+			t.Errorf("expected stages [a c] to run, got %v", order)
+		}
+	})
+
+	t.Run("Panicking stages and compensations are rolled back", func(t *testing.T) {
+		var compensated []string
+		result := CreateChain().
+			AddWithCompensation(appendStage("a"), func(context.Context, *Data, error) error {
+				compensated = append(compensated, "a")
+				return nil
+			}).
+			AddWithCompensation(panicStage, func(context.Context, *Data, error) error {
+				panic("boom")
+			}).
+			WithErrorPolicy(Rollback).
+			Process(context.Background(), &Data{Other: map[string]interface{}{}})
+		assertPanic(t, result.Err())
+		if !result.RolledBack || !reflect.DeepEqual(compensated, []string{"a"}) {
+			t.Errorf("expected stage a to be compensated, got %v", compensated)
+		}
+		if len(result.CompensationErrors) != 1 {
+			t.Fatalf("expected 1 compensation error, got %v", result.CompensationErrors)
+		}
+		assertPanic(t, result.CompensationErrors[0])
+	})
+
+	t.Run("Panics of parallel functions fail the stage", func(t *testing.T) {
+		result := CreateChain().AddParallel(appendStage("a"), panicStage).Add(appendStage("c")).Process(context.Background(), &Data{Other: map[string]interface{}{}})
+		assertPanic(t, result.Err())
+	})
+}
+
+func TestChainProcessCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	data := &Data{Other: map[string]interface{}{}}
+	result := CreateChain().
+		Add(appendStage("a")).
+		Add(func(ctx context.Context, data *Data, err error, next ChainStageNextFunction) {
+			cancel()
+			appendStage("b")(ctx, data, err, next)
+		}).
+		Add(appendStage("c")).
+		Process(ctx, data)
+	if err := result.Err(); err != context.Canceled {
+		t.Errorf("expected error %v, got %v", context.Canceled, err)
+	}
+	if got := processedOrder(data); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("expected stages [a b] to run, got %v", got)
+	}
+}
+
+func TestChainConcurrentUse(t *testing.T) {
+	ch := CreateChain().Add(appendStage("first"))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			ch.Add(appendStage("added"))
+		}()
+		go func() {
+			defer wg.Done()
+			data := &Data{Other: map[string]interface{}{}}
+			ch.Process(context.Background(), data)
+			if order := processedOrder(data); len(order) == 0 || order[0] != "first" {
+				t.Errorf("expected the first stage to run first, got %v", order)
+			}
+		}()
+	}
+	wg.Wait()
+
+	data := &Data{Other: map[string]interface{}{}}
+	ch.Process(context.Background(), data)
+	if got := len(processedOrder(data)); got != 21 {
+		t.Errorf("expected 21 stages to run, got %d", got)
+	}
+}
@@ -0,0 +1,68 @@
+package stages
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	meshmodel "github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+// Checkpointer persists the progress of a chain after every completed stage,
+// so that a processing interrupted by a crash or a restart can be resumed with Chain.Resume
+type Checkpointer interface {
+	// SaveCheckpoint records the names of the stages completed so far and the snapshot of the data they produced, see MarshalData
+	SaveCheckpoint(ctx context.Context, completed []string, snapshot []byte) error
+}
+
+type dataSnapshot struct {
+	Pattern                        *core.Pattern                            `json:"pattern"`
+	PatternSvcWorkloadCapabilities map[string]meshmodel.ComponentDefinition `json:"workloadCapabilities,omitempty"`
+	PatternSvcTraitCapabilities    map[string][]core.TraitCapability        `json:"traitCapabilities,omitempty"`
+	Other                          map[string]json.RawMessage               `json:"other,omitempty"`
+}
+
+// MarshalData returns the snapshot of the data checkpointed by the chain.
+// The values of data.Other which cannot be marshalled to JSON are left out of the snapshot.
+func MarshalData(data *Data) ([]byte, error) {
+	data.Lock.Lock()
+	defer data.Lock.Unlock()
+
+	other := make(map[string]json.RawMessage, len(data.Other))
+	for k, v := range data.Other {
+		byt, err := json.Marshal(v)
+		if err != nil {
+			continue
+		}
+		other[k] = byt
+	}
+	return json.Marshal(dataSnapshot{
+		Pattern:                        data.Pattern,
+		PatternSvcWorkloadCapabilities: data.PatternSvcWorkloadCapabilities,
+		PatternSvcTraitCapabilities:    data.PatternSvcTraitCapabilities,
+		Other:                          other,
+	})
+}
+
+// UnmarshalData restores the data from its snapshot.
+// The values of data.Other are restored as decoded from JSON, stages reading them back must not rely on their original type.
+func UnmarshalData(snapshot []byte) (*Data, error) {
+	var s dataSnapshot
+	if err := json.Unmarshal(snapshot, &s); err != nil {
+		return nil, err
+	}
+	other := make(map[string]interface{}, len(s.Other))
+	for k, raw := range s.Other {
+		var v interface{}
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, err
+		}
+		other[k] = v
+	}
+	return &Data{
+		Pattern:                        s.Pattern,
+		PatternSvcWorkloadCapabilities: s.PatternSvcWorkloadCapabilities,
+		PatternSvcTraitCapabilities:    s.PatternSvcTraitCapabilities,
+		Other:                          other,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package stages
+
+import (
+	"context"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+const CostEstimateKey = "costEstimate"
+
+// Cost estimates the cost of running the components of the pattern with the prices of the pricing, and stores
+// the estimate in the `Other` placeholder, before any of them is provisioned
+func Cost(_ ServiceInfoProvider, act ServiceActionProvider, pricing core.Pricing) ChainStageFunction {
+	return func(ctx context.Context, data *Data, err error, next ChainStageNextFunction) {
+		if err != nil {
+			act.Terminate(err)
+			return
+		}
+		prices, err := pricing.Prices(ctx)
+		if err != nil {
+			act.Terminate(err)
+			return
+		}
+		estimate := core.EstimateCost(applicationComponents(data), prices)
+		data.Lock.Lock()
+		if data.Other == nil {
+			data.Other = make(map[string]interface{})
+		}
+		data.Other[CostEstimateKey] = estimate
+		data.Lock.Unlock()
+		if next != nil {
+			next(data, nil)
+		}
+	}
+}
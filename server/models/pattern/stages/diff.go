@@ -0,0 +1,35 @@
+package stages
+
+import "context"
+
+const ChangesetKey = "changeset"
+
+// Diff compares the components of the pattern against the resources of the clusters and stores
+// the changes the deployment makes to them in the `Other` placeholder, before any of them is provisioned
+func Diff(_ ServiceInfoProvider, act ServiceActionProvider) ChainStageFunction {
+	return func(ctx context.Context, data *Data, err error, next ChainStageNextFunction) {
+		if err != nil {
+			act.Terminate(err)
+			return
+		}
+		comps := applicationComponents(data)
+		if err := ctx.Err(); err != nil {
+			act.Terminate(err)
+			return
+		}
+		changes, err := act.Diff(ctx, comps)
+		if err != nil {
+			act.Terminate(err)
+			return
+		}
+		data.Lock.Lock()
+		if data.Other == nil {
+			data.Other = make(map[string]interface{})
+		}
+		data.Other[ChangesetKey] = changes
+		data.Lock.Unlock()
+		if next != nil {
+			next(data, nil)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package stages
 
 import (
+	"context"
 	"github.com/layer5io/meshery/server/helpers"
 	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
 )
@@ -21,26 +22,15 @@ var mesheryDefinedAPIVersions = map[string]bool{
 // stores that information in the `Other` placeholder for future use.
 // This is in contrast with the Validation stage where the Validation errors terminate the chain.
 func DryRun(_ ServiceInfoProvider, act ServiceActionProvider) ChainStageFunction {
-	return func(data *Data, err error, next ChainStageNextFunction) {
+	return func(ctx context.Context, data *Data, err error, next ChainStageNextFunction) {
 		if err != nil {
 			act.Terminate(err)
 			return
 		}
-		var comps []v1alpha1.Component
-		processAnnotations(data.Pattern)
-		for name, svc := range data.Pattern.Services {
-			if mesheryDefinedAPIVersions[svc.APIVersion] {
-				continue
-			}
-			comp, err := data.Pattern.GetApplicationComponent(name)
-			if err != nil {
-				continue
-			}
-			comp.ObjectMeta.Annotations = helpers.MergeStringMaps(
-				v1alpha1.GetAnnotationsForWorkload(data.PatternSvcWorkloadCapabilities[name]),
-				comp.ObjectMeta.Annotations,
-			)
-			comps = append(comps, comp)
+		comps := applicationComponents(data)
+		if err := ctx.Err(); err != nil {
+			act.Terminate(err)
+			return
 		}
 		resp, err := act.DryRun(comps)
 		if err != nil {
@@ -58,3 +48,24 @@ func DryRun(_ ServiceInfoProvider, act ServiceActionProvider) ChainStageFunction
 		}
 	}
 }
+
+// applicationComponents returns the components of the Kubernetes resources described by the services of the pattern
+func applicationComponents(data *Data) []v1alpha1.Component {
+	var comps []v1alpha1.Component
+	processAnnotations(data.Pattern)
+	for name, svc := range data.Pattern.Services {
+		if mesheryDefinedAPIVersions[svc.APIVersion] {
+			continue
+		}
+		comp, err := data.Pattern.GetApplicationComponent(name)
+		if err != nil {
+			continue
+		}
+		comp.ObjectMeta.Annotations = helpers.MergeStringMaps(
+			v1alpha1.GetAnnotationsForWorkload(data.PatternSvcWorkloadCapabilities[name]),
+			comp.ObjectMeta.Annotations,
+		)
+		comps = append(comps, comp)
+	}
+	return comps
+}
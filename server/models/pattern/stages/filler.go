@@ -1,6 +1,7 @@
 package stages
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -24,7 +25,7 @@ func init() {
 
 // Filler - filler stage processes the pattern to subsitute Pattern
 func Filler(skipPrintLogs bool) ChainStageFunction {
-	return func(data *Data, err error, next ChainStageNextFunction) {
+	return func(_ context.Context, data *Data, err error, next ChainStageNextFunction) {
 		if err != nil {
 			next(data, err)
 			return
@@ -1,6 +1,7 @@
 package stages
 
 import (
+	"context"
 	"testing"
 
 	"github.com/layer5io/meshery/server/models/pattern/core"
@@ -80,7 +81,7 @@ services:
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			Filler(true)(tt.args.data, tt.args.err, tt.args.next)
+			Filler(true)(context.Background(), tt.args.data, tt.args.err, tt.args.next)
 		})
 	}
 }
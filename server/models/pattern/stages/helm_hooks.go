@@ -0,0 +1,147 @@
+package stages
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+// Annotations of the resources of a Helm chart implementing hooks, see https://helm.sh/docs/topics/charts_hooks/
+const (
+	HelmHookAnnotation             = "helm.sh/hook"
+	HelmHookWeightAnnotation       = "helm.sh/hook-weight"
+	HelmHookDeletePolicyAnnotation = "helm.sh/hook-delete-policy"
+)
+
+// Hooks of a Helm chart run when deploying a design
+const (
+	HelmHookPreInstall  = "pre-install"
+	HelmHookPostInstall = "post-install"
+)
+
+// delete policy removing the resources of a hook once it succeeded
+const helmHookSucceeded = "hook-succeeded"
+
+// HelmHook provisions the resources of the design annotated with the given Helm hook, so that the resources of designs
+// originating from a Helm chart are provisioned in the same order as by Helm.
+// The resources are provisioned by ascending hook weight, the ones of the same weight in the order of their dependencies,
+// and the hook fails as soon as the resources of a weight fail. Unlike Helm, the stage does not wait for the resources
+// to become ready. The resources of a succeeded hook whose delete policy is hook-succeeded are deprovisioned.
+// Hooks are only run when deploying a design.
+func HelmHook(hook string, prov ServiceInfoProvider, act ServiceActionProvider) ChainStageFunction {
+	return func(ctx context.Context, data *Data, err error, next ChainStageNextFunction) {
+		if err != nil {
+			act.Terminate(err)
+			return
+		}
+		if prov.IsDelete() {
+			if next != nil {
+				next(data, nil)
+			}
+			return
+		}
+
+		processAnnotations(data.Pattern)
+
+		for _, names := range helmHookGroups(*data.Pattern, hook) {
+			services := make(map[string]*core.Service, len(names))
+			for _, name := range names {
+				services[name] = data.Pattern.Services[name]
+			}
+			errs, err := provisionServices(ctx, data, services, prov, act)
+			if err != nil {
+				act.Terminate(err)
+				return
+			}
+			if len(errs) > 0 {
+				if next != nil {
+					next(data, mergeErrors(errs))
+				}
+				return
+			}
+
+			if data.DryRun {
+				continue
+			}
+			deleted := []string{}
+			for _, name := range names {
+				if hasHelmHookDeletePolicy(data.Pattern.Services[name], helmHookSucceeded) {
+					deleted = append(deleted, name)
+				}
+			}
+			if errs := deprovisionServices(data, act, deleted); len(errs) > 0 {
+				if next != nil {
+					next(data, mergeErrors(errs))
+				}
+				return
+			}
+		}
+
+		if next != nil {
+			next(data, nil)
+		}
+	}
+}
+
+// helmHookGroups returns the names of the services annotated with the hook, grouped by ascending hook weight
+func helmHookGroups(pattern core.Pattern, hook string) [][]string {
+	byWeight := make(map[int][]string)
+	for name, svc := range pattern.Services {
+		if svc == nil || !hasHelmHook(svc, hook) {
+			continue
+		}
+		// Helm considers the resources without a valid weight to have a weight of 0
+		weight, _ := strconv.Atoi(strings.TrimSpace(svc.Annotations[HelmHookWeightAnnotation]))
+		byWeight[weight] = append(byWeight[weight], name)
+	}
+
+	weights := make([]int, 0, len(byWeight))
+	for weight := range byWeight {
+		weights = append(weights, weight)
+	}
+	sort.Ints(weights)
+
+	groups := make([][]string, 0, len(weights))
+	for _, weight := range weights {
+		names := byWeight[weight]
+		sort.Strings(names)
+		groups = append(groups, names)
+	}
+	return groups
+}
+
+// provisionedWithRelease reports whether the service is provisioned by the Provision stage along with the resources
+// which are not Helm hooks. When deploying, the resources of the hooks are provisioned by the stages of the install hooks
+// or, for the other hooks, not at all. When undeploying, the resources of install hooks are deprovisioned along with
+// the other resources, unless they were already deleted once their hook succeeded.
+func provisionedWithRelease(svc *core.Service, isDelete bool) bool {
+	if svc.Annotations[HelmHookAnnotation] == "" {
+		return true
+	}
+	if !isDelete {
+		return false
+	}
+	return (hasHelmHook(svc, HelmHookPreInstall) || hasHelmHook(svc, HelmHookPostInstall)) &&
+		!hasHelmHookDeletePolicy(svc, helmHookSucceeded)
+}
+
+func hasHelmHook(svc *core.Service, hook string) bool {
+	return containsListItem(svc.Annotations[HelmHookAnnotation], hook)
+}
+
+func hasHelmHookDeletePolicy(svc *core.Service, policy string) bool {
+	return containsListItem(svc.Annotations[HelmHookDeletePolicyAnnotation], policy)
+}
+
+// containsListItem reports whether the comma separated list contains the item
+func containsListItem(list, item string) bool {
+	for _, v := range strings.Split(list, ",") {
+		if strings.TrimSpace(v) == item {
+			return true
+		}
+	}
+	return false
+}
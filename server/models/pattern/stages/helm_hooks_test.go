@@ -0,0 +1,102 @@
+package stages
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+func TestHelmHookGroups(t *testing.T) {
+	hooked := func(hook, weight string) *core.Service {
+		annotations := map[string]string{HelmHookAnnotation: hook}
+		if weight != "" {
+			annotations[HelmHookWeightAnnotation] = weight
+		}
+		return &core.Service{Annotations: annotations}
+	}
+	pattern := core.Pattern{
+		Services: map[string]*core.Service{
+			"deployment": {},
+			"crds":       hooked("pre-install", "-5"),
+			"secret":     hooked("pre-install,pre-upgrade", ""),
+			"config":     hooked(" pre-install ", "invalid"),
+			"migrate":    hooked("pre-install", "10"),
+			"test":       hooked("test", ""),
+			"notify":     hooked("post-install", "1"),
+		},
+	}
+
+	tests := []struct {
+		name string
+		hook string
+		want [][]string
+	}{
+		{
+			name: "Resources of the hook are grouped by ascending weight",
+			hook: HelmHookPreInstall,
+			want: [][]string{{"crds"}, {"config", "secret"}, {"migrate"}},
+		},
+		{
+			name: "Resources of other hooks are excluded",
+			hook: HelmHookPostInstall,
+			want: [][]string{{"notify"}},
+		},
+		{
+			name: "No groups are returned for hooks without resources",
+			hook: "pre-delete",
+			want: [][]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := helmHookGroups(pattern, tt.hook); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("helmHookGroups() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProvisionedWithRelease(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		isDelete    bool
+		want        bool
+	}{
+		{
+			name: "Resources which are not hooks are deployed with the release",
+			want: true,
+		},
+		{
+			name:        "Resources of hooks are not deployed with the release",
+			annotations: map[string]string{HelmHookAnnotation: "post-install"},
+			want:        false,
+		},
+		{
+			name:        "Resources of install hooks are undeployed with the release",
+			annotations: map[string]string{HelmHookAnnotation: "pre-install"},
+			isDelete:    true,
+			want:        true,
+		},
+		{
+			name:        "Resources deleted once their hook succeeded are not undeployed",
+			annotations: map[string]string{HelmHookAnnotation: "pre-install", HelmHookDeletePolicyAnnotation: "before-hook-creation,hook-succeeded"},
+			isDelete:    true,
+			want:        false,
+		},
+		{
+			name:        "Resources of hooks which are never deployed are not undeployed",
+			annotations: map[string]string{HelmHookAnnotation: "test"},
+			isDelete:    true,
+			want:        false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := provisionedWithRelease(&core.Service{Annotations: tt.annotations}, tt.isDelete); got != tt.want {
+				t.Errorf("provisionedWithRelease() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package stages
+
+import (
+	"context"
+
+	"github.com/layer5io/meshery/server/models/imagescan"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+const ImageScanKey = "imageScan"
+
+// ImageScan scans the container images of the components of the pattern for vulnerabilities, generating their SBOMs if
+// the policy says so, and stores the result in the `Other` placeholder before any of them is provisioned.
+// With enforce set, the deployment is terminated when the images fail the policy.
+func ImageScan(_ ServiceInfoProvider, act ServiceActionProvider, policy *imagescan.Policy, enforce bool) ChainStageFunction {
+	return func(ctx context.Context, data *Data, err error, next ChainStageNextFunction) {
+		if err != nil {
+			act.Terminate(err)
+			return
+		}
+		result := policy.ScanImages(ctx, core.ContainerImages(applicationComponents(data)))
+		data.Lock.Lock()
+		if data.Other == nil {
+			data.Other = make(map[string]interface{})
+		}
+		data.Other[ImageScanKey] = result
+		data.Lock.Unlock()
+		if enforce && !result.Passed() {
+			act.Terminate(imagescan.ErrImagesFailedPolicy(result.Violations))
+			return
+		}
+		if next != nil {
+			next(data, nil)
+		}
+	}
+}
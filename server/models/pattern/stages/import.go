@@ -1,6 +1,7 @@
 package stages
 
 import (
+	"context"
 	"crypto/sha1"
 	"fmt"
 	"io"
@@ -52,7 +53,7 @@ func (ss *servicestack) runOnStack(fn func(svc *core.Service)) {
 	}
 }
 func Import(_ ServiceInfoProvider, act ServiceActionProvider) ChainStageFunction {
-	return func(data *Data, err error, next ChainStageNextFunction) {
+	return func(ctx context.Context, data *Data, err error, next ChainStageNextFunction) {
 		if err != nil {
 			act.Terminate(err)
 			return
@@ -73,13 +74,19 @@ func Import(_ ServiceInfoProvider, act ServiceActionProvider) ChainStageFunction
 					})
 				}
 				//At the end of this processing, importingServiceStack will be empty and nonImportingServiceStack will have all the services in it with proper dependson and references set
-				err = process(importingServiceStack, nonImportingServiceStack, vars)
+				err = process(ctx, importingServiceStack, nonImportingServiceStack, vars)
 				if err != nil {
 					act.Terminate(err)
 					return
 				}
 				data.Pattern.Services = stackToServices(nonImportingServiceStack)
-				data.Pattern.Vars = vars
+				// the values of the variables of the design are kept along with the imported ones
+				if data.Pattern.Vars == nil {
+					data.Pattern.Vars = map[string]interface{}{}
+				}
+				for k, v := range vars {
+					data.Pattern.Vars[k] = v
+				}
 				patternYaml, err := yaml.Marshal(data.Pattern)
 				if err != nil {
 					if err != nil {
@@ -112,7 +119,7 @@ func stackToServices(s *servicestack) (services map[string]*core.Service) {
 	}
 	return
 }
-func process(imp *servicestack, nonimp *servicestack, vars map[string]interface{}) error {
+func process(ctx context.Context, imp *servicestack, nonimp *servicestack, vars map[string]interface{}) error {
 	for !imp.isEmpty() {
 		sw := imp.pop()
 		url, ok := matchImportPattern(sw.svc.Type)
@@ -120,7 +127,7 @@ func process(imp *servicestack, nonimp *servicestack, vars map[string]interface{
 			nonimp.push(sw)
 			continue
 		}
-		p, err := getPatternFromLocation(url)
+		p, err := getPatternFromLocation(ctx, url)
 		if err != nil {
 			return err
 		}
@@ -244,12 +251,17 @@ func removeInDependsOn(old string, do *[]string) {
 	}
 }
 
-func getPatternFromLocation(loc string) (p core.Pattern, err error) {
+func getPatternFromLocation(ctx context.Context, loc string) (p core.Pattern, err error) {
 	if strings.HasPrefix(loc, "https://") {
-		resp, err := http.Get(loc)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, loc, nil)
+		if err != nil {
+			return p, err
+		}
+		resp, err := http.DefaultClient.Do(req)
 		if err != nil {
 			return p, err
 		}
+		defer resp.Body.Close()
 		if resp.StatusCode != http.StatusOK {
 			return p, fmt.Errorf("got non ok HTTP response %d for URL: %s", resp.StatusCode, loc)
 		}
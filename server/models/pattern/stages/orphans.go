@@ -0,0 +1,39 @@
+package stages
+
+import "context"
+
+const OrphansKey = "orphans"
+
+// Orphans finds the resources of the clusters labeled with the id of the design which are not among its components
+// anymore, eg: the resources of the components removed from the design since it was deployed, and stores them in the
+// `Other` placeholder. With deleteOrphans set, the design is being undeployed and they are deleted along with it.
+func Orphans(_ ServiceInfoProvider, act ServiceActionProvider, deleteOrphans bool) ChainStageFunction {
+	return func(ctx context.Context, data *Data, err error, next ChainStageNextFunction) {
+		if err != nil {
+			act.Terminate(err)
+			return
+		}
+		// designs which were never saved have no id their resources are labeled with
+		if data.Pattern.PatternID == "" {
+			if next != nil {
+				next(data, nil)
+			}
+			return
+		}
+		comps := applicationComponents(data)
+		if err := ctx.Err(); err != nil {
+			act.Terminate(err)
+			return
+		}
+		orphans, err := act.Orphans(ctx, data.Pattern.PatternID, comps, deleteOrphans)
+		data.Lock.Lock()
+		if data.Other == nil {
+			data.Other = make(map[string]interface{})
+		}
+		data.Other[OrphansKey] = orphans
+		data.Lock.Unlock()
+		if next != nil {
+			next(data, err)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package stages
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+)
+
+// orphansProvider returns its orphans, recording the components it is called with and whether they were deleted
+type orphansProvider struct {
+	ServiceActionProvider
+	orphans    []core.ResourceChange
+	err        error
+	called     bool
+	patternID  string
+	components []string
+	deleted    bool
+	terminated error
+}
+
+func (p *orphansProvider) Terminate(err error) {
+	p.terminated = err
+}
+
+func (p *orphansProvider) Orphans(_ context.Context, patternID string, comps []v1alpha1.Component, deleteOrphans bool) ([]core.ResourceChange, error) {
+	p.called, p.patternID, p.deleted = true, patternID, deleteOrphans
+	for _, comp := range comps {
+		p.components = append(p.components, comp.Name)
+	}
+	return p.orphans, p.err
+}
+
+func orphansData(patternID string) *Data {
+	id := uuid.Must(uuid.NewV4())
+	return &Data{Pattern: &core.Pattern{
+		PatternID: patternID,
+		Services: map[string]*core.Service{
+			"web": {ID: &id, Name: "web", Type: "Deployment", APIVersion: "apps/v1", Namespace: "default"},
+		},
+	}}
+}
+
+func TestOrphans(t *testing.T) {
+	orphan := core.ResourceChange{APIVersion: "v1", Kind: "Service", Name: "db", Namespace: "default", Operation: core.ResourceDelete}
+
+	t.Run("The orphans of a design are stored and deleted along with its undeployment", func(t *testing.T) {
+		act := &orphansProvider{orphans: []core.ResourceChange{orphan}}
+		data := orphansData("design")
+		var nextErr error
+		Orphans(nil, act, true)(context.Background(), data, nil, func(_ *Data, err error) { nextErr = err })
+		if !act.called || act.patternID != "design" || !act.deleted || !reflect.DeepEqual(act.components, []string{"web"}) {
+			t.Errorf("the orphans were looked up for design %q with the components %v and deleted = %t", act.patternID, act.components, act.deleted)
+		}
+		if got := data.Other[OrphansKey]; !reflect.DeepEqual(got, []core.ResourceChange{orphan}) || nextErr != nil {
+			t.Errorf("the orphans stored are %v and the stage failed with %v", got, nextErr)
+		}
+	})
+
+	t.Run("The failure to look up the orphans fails the stage", func(t *testing.T) {
+		act := &orphansProvider{err: errors.New("forbidden")}
+		var nextErr error
+		Orphans(nil, act, false)(context.Background(), orphansData("design"), nil, func(_ *Data, err error) { nextErr = err })
+		if nextErr != act.err || act.deleted {
+			t.Errorf("the stage failed with %v, want %v", nextErr, act.err)
+		}
+	})
+
+	t.Run("The resources of a design which was never saved are not looked up", func(t *testing.T) {
+		act := &orphansProvider{}
+		nextCalled := false
+		Orphans(nil, act, true)(context.Background(), orphansData(""), nil, func(_ *Data, err error) { nextCalled = err == nil })
+		if act.called || !nextCalled {
+			t.Errorf("the orphans of an unsaved design were looked up = %t, the next stage ran = %t", act.called, nextCalled)
+		}
+	})
+
+	t.Run("The stages failing before terminate the chain", func(t *testing.T) {
+		act := &orphansProvider{}
+		err := errors.New("provision failed")
+		Orphans(nil, act, true)(context.Background(), orphansData("design"), err, func(*Data, error) {
+			t.Error("the next stage ran after a failure")
+		})
+		if act.terminated != err || act.called {
+			t.Errorf("the chain was terminated with %v and the orphans were looked up = %t", act.terminated, act.called)
+		}
+	})
+
+	t.Run("A cancelled deployment does not delete resources", func(t *testing.T) {
+		act := &orphansProvider{}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		Orphans(nil, act, true)(ctx, orphansData("design"), nil, nil)
+		if act.called || !errors.Is(act.terminated, context.Canceled) {
+			t.Errorf("the orphans of a cancelled deployment were looked up = %t, terminated with %v", act.called, act.terminated)
+		}
+	})
+}
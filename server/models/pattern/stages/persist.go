@@ -1,6 +1,7 @@
 package stages
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -8,7 +9,7 @@ import (
 )
 
 func Persist(_ ServiceInfoProvider, act ServiceActionProvider) ChainStageFunction {
-	return func(data *Data, err error, next ChainStageNextFunction) {
+	return func(_ context.Context, data *Data, err error, next ChainStageNextFunction) {
 		if err != nil {
 			act.Terminate(err)
 			return
@@ -0,0 +1,75 @@
+package stages
+
+import (
+	"context"
+	"time"
+)
+
+// ProgressStatus is the state of a stage, or of a component processed by a stage, reported by a Progress
+type ProgressStatus string
+
+const (
+	ProgressStarted   ProgressStatus = "started"
+	ProgressCompleted ProgressStatus = "completed"
+	ProgressFailed    ProgressStatus = "failed"
+	ProgressSkipped   ProgressStatus = "skipped"
+	// the stage returned without calling next, terminating the chain
+	ProgressTerminated ProgressStatus = "terminated"
+)
+
+// Progress is an update on the processing of a chain, reported as its stages and the components they process start and finish
+type Progress struct {
+	// Stage is the position of the stage in the chain
+	Stage     int    `json:"stage"`
+	StageName string `json:"stageName"`
+	// Component is empty for the updates on the stage itself
+	Component string         `json:"component,omitempty"`
+	Status    ProgressStatus `json:"status"`
+	Error     string         `json:"error,omitempty"`
+	// Duration of the stage or component in nanoseconds, set once it finished
+	Duration time.Duration `json:"duration,omitempty"`
+	Time     time.Time     `json:"time"`
+}
+
+// ProgressFunc receives the progress of a chain. It is invoked synchronously by the stages, concurrently by the ones
+// processing components in parallel, so it must be safe for concurrent use and should not block.
+type ProgressFunc func(Progress)
+
+type progressKey struct{}
+
+// progressReporter reports the progress of the components processed by the stage running with the context carrying it
+type progressReporter struct {
+	fn    ProgressFunc
+	stage int
+	name  string
+}
+
+func (r *progressReporter) report(p Progress) {
+	if r == nil || r.fn == nil {
+		return
+	}
+	p.Stage = r.stage
+	p.StageName = r.name
+	p.Time = time.Now()
+	r.fn(p)
+}
+
+// StageNameFromContext returns the name of the stage running with ctx, or an empty string outside of a chain
+func StageNameFromContext(ctx context.Context) string {
+	r, _ := ctx.Value(progressKey{}).(*progressReporter)
+	if r == nil {
+		return ""
+	}
+	return r.name
+}
+
+// ReportComponentProgress reports the progress of a component processed by the stage running with ctx,
+// it does nothing if the chain has no ProgressFunc
+func ReportComponentProgress(ctx context.Context, component string, status ProgressStatus, duration time.Duration, err error) {
+	r, _ := ctx.Value(progressKey{}).(*progressReporter)
+	p := Progress{Component: component, Status: status, Duration: duration}
+	if err != nil {
+		p.Error = err.Error()
+	}
+	r.report(p)
+}
@@ -1,8 +1,11 @@
 package stages
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/layer5io/meshery/server/helpers"
 	"github.com/layer5io/meshery/server/models/pattern/core"
@@ -10,6 +13,9 @@ import (
 	meshmodelv1alpha1 "github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
 	meshmodel "github.com/layer5io/meshkit/models/meshmodel/registry"
 	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type CompConfigPair struct {
@@ -20,8 +26,14 @@ type CompConfigPair struct {
 
 const ProvisionSuffixKey = ".isProvisioned"
 
+// RenderedManifestsKey stores the manifests rendered by the Provision stage of a dry run, by service and Kubernetes context
+const RenderedManifestsKey = "renderedManifests"
+
+// ProvisionConfigSuffixKey stores the CompConfigPair a service was provisioned with, in order to revert it on rollback
+const ProvisionConfigSuffixKey = ".provisionConfig"
+
 func Provision(prov ServiceInfoProvider, act ServiceActionProvider) ChainStageFunction {
-	return func(data *Data, err error, next ChainStageNextFunction) {
+	return func(ctx context.Context, data *Data, err error, next ChainStageNextFunction) {
 		if err != nil {
 			act.Terminate(err)
 			return
@@ -29,78 +41,190 @@ func Provision(prov ServiceInfoProvider, act ServiceActionProvider) ChainStageFu
 
 		processAnnotations(data.Pattern)
 
-		// Create provision plan
-		plan, err := planner.CreatePlan(*data.Pattern, prov.IsDelete())
+		// the resources of Helm hooks are provisioned by the stages of their hooks, if at all
+		services := make(map[string]*core.Service, len(data.Pattern.Services))
+		for name, svc := range data.Pattern.Services {
+			if provisionedWithRelease(svc, prov.IsDelete()) {
+				services[name] = svc
+			}
+		}
+
+		errs, err := provisionServices(ctx, data, services, prov, act)
 		if err != nil {
 			act.Terminate(err)
 			return
 		}
 
-		// Check feasibility of the generated plan
-		if !plan.IsFeasible() {
-			act.Terminate(fmt.Errorf("infeasible execution: detected cycle in the plan"))
-			return
+		if next != nil {
+			next(data, mergeErrors(errs))
 		}
+	}
+}
 
-		config, err := data.Pattern.GenerateApplicationConfiguration()
-		if err != nil {
-			act.Terminate(fmt.Errorf("failed to generate application configuration: %s", err))
-			return
+// provisionServices provisions the given services of the design in the order of their dependencies and returns the errors
+// of the services which failed. The error returned is the one preventing the services from being provisioned at all.
+func provisionServices(ctx context.Context, data *Data, services map[string]*core.Service, prov ServiceInfoProvider, act ServiceActionProvider) ([]error, error) {
+	pattern := *data.Pattern
+	pattern.Services = services
+
+	// Create provision plan
+	plan, err := planner.CreatePlan(pattern, prov.IsDelete())
+	if err != nil {
+		return nil, err
+	}
+
+	// Check feasibility of the generated plan
+	if err := plan.Validate(); err != nil {
+		return nil, err
+	}
+
+	config, err := data.Pattern.GenerateApplicationConfiguration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate application configuration: %s", err)
+	}
+
+	var mu sync.Mutex
+	errs := []error{}
+
+	provisionService := func(name string, svc core.Service) error {
+		// Components already provisioned are kept, the remaining ones are not provisioned once the deployment is cancelled
+		if err := ctx.Err(); err != nil {
+			return err
 		}
+		ccp := CompConfigPair{}
 
-		errs := []error{}
+		// Create application component
+		comp, err := data.Pattern.GetApplicationComponent(name)
+		if err != nil {
+			return err
+		}
 
-		// Execute the plan
-		_ = plan.Execute(func(name string, svc core.Service) bool {
-			ccp := CompConfigPair{}
+		// Generate hosts list
+		ccp.Hosts = generateHosts(
+			data.PatternSvcWorkloadCapabilities[name],
+			data.PatternSvcTraitCapabilities[name],
+			act.GetRegistry(),
+		)
 
-			// Create application component
-			comp, err := data.Pattern.GetApplicationComponent(name)
-			if err != nil {
-				return false
-			}
+		// Get annotations for the component and merge with existing, if any
+		comp.ObjectMeta.SetAnnotations(helpers.MergeStringMaps(
+			v1alpha1.GetAnnotationsForWorkload(data.PatternSvcWorkloadCapabilities[name]),
+			comp.GetAnnotations(),
+			getAdditionalAnnotations(data.Pattern),
+		))
+		comp.ObjectMeta.SetLabels(helpers.MergeStringMaps(
+			comp.GetLabels(),
+			getAdditionalLabels(data.Pattern),
+		))
+		if core.Format { //deprettify the component before deploying
+			comp.Spec.Settings = core.Format.DePrettify(comp.Spec.Settings, false)
+		}
+		ccp.Component = comp
+		// Add configuration only if traits are applied to the component
+		if len(svc.Traits) > 0 {
+			ccp.Configuration = config
+		}
 
-			// Generate hosts list
-			ccp.Hosts = generateHosts(
-				data.PatternSvcWorkloadCapabilities[name],
-				data.PatternSvcTraitCapabilities[name],
-				act.GetRegistry(),
-			)
-
-			// Get annotations for the component and merge with existing, if any
-			comp.ObjectMeta.SetAnnotations(helpers.MergeStringMaps(
-				v1alpha1.GetAnnotationsForWorkload(data.PatternSvcWorkloadCapabilities[name]),
-				comp.GetAnnotations(),
-				getAdditionalAnnotations(data.Pattern),
-			))
-			if core.Format { //deprettify the component before deploying
-				comp.Spec.Settings = core.Format.DePrettify(comp.Spec.Settings, false)
+		if data.DryRun {
+			// components defined by meshery are not deployed as Kubernetes resources
+			if mesheryDefinedAPIVersions[svc.APIVersion] {
+				return nil
 			}
-			ccp.Component = comp
-			// Add configuration only if traits are applied to the component
-			if len(svc.Traits) > 0 {
-				ccp.Configuration = config
-			}
-
-			msg, err := act.Provision(ccp)
+			manifests, err := act.Render(ccp)
 			if err != nil {
-				errs = append(errs, err)
-				return false
+				return err
 			}
 			data.Lock.Lock()
-			// Store that this service was provisioned successfully
-			data.Other[fmt.Sprintf("%s%s", name, ProvisionSuffixKey)] = msg
+			rendered, _ := data.Other[RenderedManifestsKey].(map[string]map[string]interface{})
+			if rendered == nil {
+				rendered = make(map[string]map[string]interface{})
+				data.Other[RenderedManifestsKey] = rendered
+			}
+			rendered[name] = manifests
 			data.Lock.Unlock()
+			return nil
+		}
 
-			return true
-		})
+		msg, err := act.Provision(ccp)
+		if err != nil {
+			return err
+		}
+		data.Lock.Lock()
+		// Store that this service was provisioned successfully
+		data.Other[fmt.Sprintf("%s%s", name, ProvisionSuffixKey)] = msg
+		data.Other[fmt.Sprintf("%s%s", name, ProvisionConfigSuffixKey)] = ccp
+		data.Lock.Unlock()
 
-		if next != nil {
-			next(data, mergeErrors(errs))
+		return nil
+	}
+
+	// Execute the plan, the services which do not depend on each other are provisioned concurrently
+	_ = plan.Execute(func(name string, svc core.Service) bool {
+		ReportComponentProgress(ctx, name, ProgressStarted, 0, nil)
+		// the span covers applying the resources of the component to the Kubernetes clusters
+		_, span := tracer.Start(ctx, "provision "+name, trace.WithAttributes(
+			attribute.String("meshery.component.type", svc.Type),
+			attribute.String("meshery.component.model", svc.Model),
+			attribute.Bool("meshery.dry_run", data.DryRun),
+		))
+		defer span.End()
+		start := time.Now()
+		if err := provisionService(name, svc); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			ReportComponentProgress(ctx, name, ProgressFailed, time.Since(start), err)
+			mu.Lock()
+			errs = append(errs, err)
+			mu.Unlock()
+			return false
+		}
+		ReportComponentProgress(ctx, name, ProgressCompleted, time.Since(start), nil)
+		return true
+	})
+
+	return errs, nil
+}
+
+// Deprovision is the compensation of the Provision stage, it reverts the provisioning of the services provisioned successfully
+func Deprovision(act ServiceActionProvider) ChainStageCompensation {
+	return func(_ context.Context, data *Data, _ error) error {
+		data.Lock.Lock()
+		names := []string{}
+		for k, v := range data.Other {
+			if _, ok := v.(CompConfigPair); ok && strings.HasSuffix(k, ProvisionConfigSuffixKey) {
+				names = append(names, strings.TrimSuffix(k, ProvisionConfigSuffixKey))
+			}
 		}
+		data.Lock.Unlock()
+
+		return mergeErrors(deprovisionServices(data, act, names))
 	}
 }
 
+// deprovisionServices reverts the provisioning of the given services, the ones reverted successfully are no longer
+// recorded as provisioned so that several stages compensating the same deployment do not revert them twice
+func deprovisionServices(data *Data, act ServiceActionProvider, names []string) []error {
+	errs := []error{}
+	for _, name := range names {
+		data.Lock.Lock()
+		ccp, ok := data.Other[name+ProvisionConfigSuffixKey].(CompConfigPair)
+		data.Lock.Unlock()
+		if !ok {
+			continue
+		}
+		msg, err := act.Deprovision(ccp)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		act.Log(msg)
+		data.Lock.Lock()
+		delete(data.Other, name+ProvisionConfigSuffixKey)
+		data.Lock.Unlock()
+	}
+	return errs
+}
+
 func processAnnotations(pattern *core.Pattern) {
 	for name, svc := range pattern.Services {
 		if svc.IsAnnotation {
@@ -156,3 +280,12 @@ func getAdditionalAnnotations(pattern *core.Pattern) map[string]string {
 	annotations[fmt.Sprintf("%s.id", v1alpha1.MesheryAnnotationPrefix)] = pattern.PatternID
 	return annotations
 }
+
+// getAdditionalLabels returns the labels the resources of the design are found by when it is undeployed
+func getAdditionalLabels(pattern *core.Pattern) map[string]string {
+	labels := make(map[string]string, 1)
+	if pattern.PatternID != "" {
+		labels[core.PatternIDLabel] = pattern.PatternID
+	}
+	return labels
+}
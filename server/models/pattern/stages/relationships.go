@@ -0,0 +1,40 @@
+package stages
+
+import (
+	"context"
+
+	"github.com/layer5io/meshery/server/models/meshmodel"
+	meshmodelv1alpha1 "github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+)
+
+// ValidateRelationships rejects patterns whose components declare relationships, through their dependsOn field or their parent,
+// which the registered relationships deny or do not allow. The error lists every violation along with how to fix it.
+// Only the relationships visible to the organization of the user, the global ones and those of the organization, apply.
+func ValidateRelationships(_ ServiceInfoProvider, act ServiceActionProvider) ChainStageFunction {
+	return func(ctx context.Context, data *Data, err error, next ChainStageNextFunction) {
+		if err != nil {
+			act.Terminate(err)
+			return
+		}
+
+		// relationships cannot be checked without a registry
+		if reg := act.GetOrgScopedRegistry(); reg != nil {
+			entities, _, _ := reg.GetEntities(&meshmodel.OrgRelationshipFilter{Org: act.GetOrgID()})
+			rels := make([]meshmodelv1alpha1.RelationshipDefinition, 0, len(entities))
+			for _, entity := range entities {
+				if rel, ok := entity.(meshmodelv1alpha1.RelationshipDefinition); ok {
+					rels = append(rels, rel)
+				}
+			}
+			violations := meshmodel.ValidateDesignRelationships(meshmodel.DeclaredRelationships(*data.Pattern), rels)
+			if len(violations) > 0 {
+				act.Terminate(&meshmodel.RelationshipViolationError{Violations: violations})
+				return
+			}
+		}
+
+		if next != nil {
+			next(data, nil)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package stages
+
+import (
+	"context"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+const SecurityReportKey = "securityReport"
+
+// SecurityAnalysis checks the components of the pattern for insecure settings and stores the scored findings in the
+// `Other` placeholder, before any of them is provisioned. The findings do not stop the deployment.
+func SecurityAnalysis(_ ServiceInfoProvider, act ServiceActionProvider) ChainStageFunction {
+	return func(ctx context.Context, data *Data, err error, next ChainStageNextFunction) {
+		if err != nil {
+			act.Terminate(err)
+			return
+		}
+		report := core.AnalyzeSecurity(applicationComponents(data))
+		data.Lock.Lock()
+		if data.Other == nil {
+			data.Other = make(map[string]interface{})
+		}
+		data.Other[SecurityReportKey] = report
+		data.Lock.Unlock()
+		if next != nil {
+			next(data, nil)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package stages
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/gofrs/uuid"
@@ -10,9 +11,13 @@ const UpdateSuffixKey = ".isUpdate"
 
 // ServiceIdentifier takes in a service identity provider and returns a ChainStageFunction
 func ServiceIdentifierAndMutator(prov ServiceInfoProvider, act ServiceActionProvider) ChainStageFunction {
-	return func(data *Data, err error, next ChainStageNextFunction) {
+	return func(ctx context.Context, data *Data, err error, next ChainStageNextFunction) {
 		// Find the ID of the resources
 		for svcName, svc := range data.Pattern.Services {
+			if err := ctx.Err(); err != nil {
+				act.Terminate(err)
+				return
+			}
 			id, err := prov.GetMesheryPatternResource(
 				svcName,
 				svc.Namespace,
@@ -1,7 +1,10 @@
 package stages
 
 import (
+	"context"
+
 	"github.com/gofrs/uuid"
+	registry "github.com/layer5io/meshery/server/models/meshmodel"
 	"github.com/layer5io/meshery/server/models/pattern/core"
 	meshmodel "github.com/layer5io/meshkit/models/meshmodel/registry"
 	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
@@ -23,8 +26,21 @@ type ServiceActionProvider interface {
 	Terminate(error)
 	Log(msg string)
 	Provision(CompConfigPair) (string, error)
+	Deprovision(CompConfigPair) (string, error)            // Reverts Provision, deleting the deployed component or deploying the deleted one
+	Render(CompConfigPair) (map[string]interface{}, error) // Returns the manifests Provision would apply, for every Kubernetes context
 	GetRegistry() *meshmodel.RegistryManager
+	// Returns the registry looking up the relationships of a registry.OrgRelationshipFilter, nil without a registry
+	GetOrgScopedRegistry() registry.EntitiesGetter
+	// Returns the organization of the user the design is processed for, empty when the user has none
+	GetOrgID() string
 	Persist(string, core.Service, bool) error
 	DryRun([]v1alpha1.Component) (map[string]map[string]core.DryRunResponseWrapper, error)
 	Mutate(*core.Pattern) //Uses pre-defined policies/configuration to mutate the pattern
+	// Returns the changes deploying the components makes to the resources of every Kubernetes context
+	Diff(context.Context, []v1alpha1.Component) ([]core.ResourceChange, error)
+	// Returns the resources of every Kubernetes context labeled with the id of the design which are none of the components,
+	// deleting them if the bool is true
+	Orphans(context.Context, string, []v1alpha1.Component, bool) ([]core.ResourceChange, error)
+	// Returns whether every Kubernetes context serves the kinds of the components
+	Capabilities(context.Context, []v1alpha1.Component) ([]core.ComponentCapability, error)
 }
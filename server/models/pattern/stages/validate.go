@@ -2,11 +2,10 @@ package stages
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
+	registry "github.com/layer5io/meshery/server/models/meshmodel"
 	"github.com/layer5io/meshery/server/models/pattern/core"
-	"github.com/layer5io/meshery/server/models/pattern/jsonschema"
 	"github.com/layer5io/meshery/server/models/pattern/resource/selector"
 	meshmodel "github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
 	"gopkg.in/yaml.v2"
@@ -63,7 +62,7 @@ func formatValue(path string, val map[string]interface{}) error {
 func Validator(prov ServiceInfoProvider, act ServiceActionProvider, skipValidation bool) ChainStageFunction {
 	s := selector.New(act.GetRegistry(), prov)
 
-	return func(data *Data, err error, next ChainStageNextFunction) {
+	return func(ctx context.Context, data *Data, err error, next ChainStageNextFunction) {
 		if err != nil {
 			act.Terminate(err)
 			return
@@ -73,6 +72,10 @@ func Validator(prov ServiceInfoProvider, act ServiceActionProvider, skipValidati
 		data.PatternSvcTraitCapabilities = map[string][]core.TraitCapability{}
 
 		for svcName, svc := range data.Pattern.Services {
+			if err := ctx.Err(); err != nil {
+				act.Terminate(err)
+				return
+			}
 			wc, err := s.Workload(svc.Type, svc.Version, svc.Model, svc.APIVersion)
 			if err != nil {
 				act.Terminate(err)
@@ -129,27 +132,5 @@ func Validator(prov ServiceInfoProvider, act ServiceActionProvider, skipValidati
 }
 
 func validateWorkload(comp map[string]interface{}, wc meshmodel.ComponentDefinition) error {
-	schemaByt := []byte(wc.Schema)
-	// Create schema validator from the schema
-	rs := jsonschema.GlobalJSONSchema()
-	if err := json.Unmarshal(schemaByt, rs); err != nil {
-		return fmt.Errorf("failed to create schema: %s", err)
-	}
-
-	// Create json settings
-	jsonSettings, err := json.Marshal(comp)
-	if err != nil {
-		return fmt.Errorf("failed to generate schema from the PatternFile settings: %s", err)
-	}
-
-	// Validate the json against the schema
-	errs, err := rs.ValidateBytes(context.TODO(), jsonSettings)
-	if err != nil {
-		return fmt.Errorf("error occurred during schema validation: %s", err)
-	}
-	if len(errs) > 0 {
-		return fmt.Errorf("invalid settings: %s", errs)
-	}
-
-	return nil
+	return registry.ValidateComponentSettings(comp, wc.Schema)
 }
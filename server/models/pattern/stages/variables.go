@@ -0,0 +1,23 @@
+package stages
+
+import (
+	"context"
+)
+
+// Variables renders the references to the variables of the design with the values supplied at deploy time,
+// so that the same design can be deployed to several environments
+func Variables() ChainStageFunction {
+	return func(_ context.Context, data *Data, err error, next ChainStageNextFunction) {
+		if err != nil {
+			next(data, err)
+			return
+		}
+
+		data.Lock.Lock()
+		err = data.Pattern.RenderVariables()
+		data.Lock.Unlock()
+		if next != nil {
+			next(data, err)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package terraform
+
+import (
+	"github.com/layer5io/meshkit/errors"
+)
+
+// Please reference the following before contributing an error code:
+// https://docs.meshery.io/project/contributing/contributing-error
+// https://github.com/meshery/meshkit/blob/master/errors/errors.go
+const (
+	ErrConvertTerraformCode = "1557"
+)
+
+func ErrConvertTerraform(err error) error {
+	return errors.New(ErrConvertTerraformCode, errors.Alert, []string{"Could not convert the Terraform configuration into a design"}, []string{err.Error()}, []string{"The Terraform configuration is not valid HCL", "The configuration has no resources of the kubernetes or helm providers", "The chart of a helm_release could not be fetched or rendered"}, []string{"Make sure the configuration is valid with `terraform validate`", "Import configurations declaring kubernetes_* or helm_release resources", "Make sure the repository and the chart of the release are reachable from the Meshery Server"})
+}
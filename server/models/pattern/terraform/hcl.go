@@ -0,0 +1,471 @@
+package terraform
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Body is the content of an HCL file or of a block
+type Body struct {
+	Attributes map[string]interface{}
+	Blocks     []*Block
+}
+
+// Block is an HCL block, eg: resource "kubernetes_deployment_v1" "web" { ... }
+type Block struct {
+	Type   string
+	Labels []string
+	Body   *Body
+}
+
+// Expression is an HCL expression which is not a literal value, eg: a reference, a function call or a conditional.
+// It is kept as written, the converter resolving the references it understands.
+type Expression string
+
+// ParseHCL parses the subset of the HCL native syntax used by Terraform configurations.
+// Attributes are parsed into strings, numbers, booleans, nil, []interface{}, map[string]interface{} or, for any other
+// expression, an Expression. The interpolations of template strings are kept as written, eg: "${var.name}-web".
+func ParseHCL(src []byte) (*Body, error) {
+	p := &hclParser{src: []rune(string(src)), line: 1}
+	return p.parseBody(false)
+}
+
+type hclParser struct {
+	src  []rune
+	pos  int
+	line int
+}
+
+func (p *hclParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: %s", p.line, fmt.Sprintf(format, args...))
+}
+
+func (p *hclParser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *hclParser) peekAt(i int) rune {
+	if p.pos+i >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos+i]
+}
+
+func (p *hclParser) peek() rune {
+	return p.peekAt(0)
+}
+
+func (p *hclParser) next() rune {
+	c := p.src[p.pos]
+	p.pos++
+	if c == '\n' {
+		p.line++
+	}
+	return c
+}
+
+// skipSpace skips the blanks and the comments, and the newlines if newlines is set
+func (p *hclParser) skipSpace(newlines bool) {
+	for !p.eof() {
+		c := p.peek()
+		switch {
+		case c == '\n' && newlines, c == ' ', c == '\t', c == '\r':
+			p.next()
+		case c == '#', c == '/' && p.peekAt(1) == '/':
+			for !p.eof() && p.peek() != '\n' {
+				p.next()
+			}
+		case c == '/' && p.peekAt(1) == '*':
+			p.pos += 2
+			for !p.eof() && !(p.peek() == '*' && p.peekAt(1) == '/') {
+				p.next()
+			}
+			p.pos += 2
+		default:
+			return
+		}
+	}
+}
+
+func isIdentStart(c rune) bool {
+	return unicode.IsLetter(c) || c == '_'
+}
+
+func (p *hclParser) identifier() string {
+	if p.eof() || !isIdentStart(p.peek()) {
+		return ""
+	}
+	start := p.pos
+	for !p.eof() && (unicode.IsLetter(p.peek()) || unicode.IsDigit(p.peek()) || p.peek() == '_' || p.peek() == '-') {
+		p.pos++
+	}
+	return string(p.src[start:p.pos])
+}
+
+func (p *hclParser) parseBody(nested bool) (*Body, error) {
+	body := &Body{Attributes: map[string]interface{}{}}
+	for {
+		p.skipSpace(true)
+		if p.eof() {
+			if nested {
+				return nil, p.errorf("unexpected end of file, missing }")
+			}
+			return body, nil
+		}
+		if p.peek() == '}' {
+			if !nested {
+				return nil, p.errorf("unexpected }")
+			}
+			p.next()
+			return body, nil
+		}
+
+		name := p.identifier()
+		if name == "" {
+			return nil, p.errorf("expected an attribute or a block, found %q", p.peek())
+		}
+		p.skipSpace(false)
+		if p.peek() == '=' && p.peekAt(1) != '=' {
+			p.next()
+			v, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			body.Attributes[name] = v
+			continue
+		}
+
+		block := &Block{Type: name}
+		for block.Body == nil {
+			p.skipSpace(false)
+			switch c := p.peek(); {
+			case c == '"':
+				label, err := p.parseString()
+				if err != nil {
+					return nil, err
+				}
+				block.Labels = append(block.Labels, label)
+			case isIdentStart(c):
+				block.Labels = append(block.Labels, p.identifier())
+			case c == '{':
+				p.next()
+				b, err := p.parseBody(true)
+				if err != nil {
+					return nil, err
+				}
+				block.Body = b
+			default:
+				return nil, p.errorf("unexpected %q in block %s", c, name)
+			}
+		}
+		body.Blocks = append(body.Blocks, block)
+	}
+}
+
+// atExprEnd reports whether the parser is at the end of an expression, after blanks
+func (p *hclParser) atExprEnd() bool {
+	save, line := p.pos, p.line
+	p.skipSpace(false)
+	c := p.peek()
+	end := p.eof() || c == '\n' || c == ',' || c == ']' || c == '}' || c == ')' || c == '#' || (c == '/' && (p.peekAt(1) == '/' || p.peekAt(1) == '*'))
+	p.pos, p.line = save, line
+	return end
+}
+
+func (p *hclParser) parseExpr() (interface{}, error) {
+	p.skipSpace(false)
+	start, line := p.pos, p.line
+
+	var v interface{}
+	var err error
+	switch c := p.peek(); {
+	case c == '"':
+		v, err = p.parseString()
+	case c == '<' && p.peekAt(1) == '<':
+		v, err = p.parseHeredoc()
+	case c == '[' && !p.isForExpr():
+		v, err = p.parseTuple()
+	case c == '{' && !p.isForExpr():
+		v, err = p.parseObject()
+	case unicode.IsDigit(c) || (c == '-' && unicode.IsDigit(p.peekAt(1))):
+		v, err = p.parseNumber()
+	case isIdentStart(c):
+		switch id := p.identifier(); id {
+		case "true":
+			v = true
+		case "false":
+			v = false
+		case "null":
+			v = nil
+		default:
+			v, err = nil, errNotLiteral
+		}
+	default:
+		err = errNotLiteral
+	}
+	if err == nil && p.atExprEnd() {
+		return v, nil
+	}
+	if err != nil && err != errNotLiteral {
+		return nil, err
+	}
+	// the literal is part of a larger expression
+	p.pos, p.line = start, line
+	return p.parseRaw()
+}
+
+var errNotLiteral = fmt.Errorf("not a literal")
+
+// isForExpr reports whether the bracket opens a for expression
+func (p *hclParser) isForExpr() bool {
+	save, line := p.pos, p.line
+	defer func() { p.pos, p.line = save, line }()
+	p.next()
+	p.skipSpace(true)
+	return p.identifier() == "for" && (p.peek() == ' ' || p.peek() == '\t')
+}
+
+// parseRaw returns the expression as written, up to its end
+func (p *hclParser) parseRaw() (interface{}, error) {
+	start := p.pos
+	depth := 0
+	for !p.eof() {
+		c := p.peek()
+		if depth == 0 && (c == '\n' || c == ',' || c == '#' || (c == '/' && (p.peekAt(1) == '/' || p.peekAt(1) == '*'))) {
+			break
+		}
+		switch c {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			if depth == 0 {
+				goto end
+			}
+			depth--
+		case '"':
+			if _, err := p.parseString(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		p.next()
+	}
+end:
+	raw := strings.TrimSpace(string(p.src[start:p.pos]))
+	if raw == "" {
+		return nil, p.errorf("expected an expression")
+	}
+	return Expression(raw), nil
+}
+
+func (p *hclParser) parseString() (string, error) {
+	p.next() // opening quote
+	var sb strings.Builder
+	for {
+		if p.eof() || p.peek() == '\n' {
+			return "", p.errorf("unterminated string")
+		}
+		c := p.next()
+		switch {
+		case c == '"':
+			return sb.String(), nil
+		case c == '\\':
+			if p.eof() {
+				return "", p.errorf("unterminated string")
+			}
+			switch e := p.next(); e {
+			case 'n':
+				sb.WriteRune('\n')
+			case 't':
+				sb.WriteRune('\t')
+			case 'r':
+				sb.WriteRune('\r')
+			case 'u':
+				if p.pos+4 > len(p.src) {
+					return "", p.errorf("invalid unicode escape")
+				}
+				r, err := strconv.ParseUint(string(p.src[p.pos:p.pos+4]), 16, 32)
+				if err != nil {
+					return "", p.errorf("invalid unicode escape")
+				}
+				p.pos += 4
+				sb.WriteRune(rune(r))
+			default:
+				sb.WriteRune(e)
+			}
+		case (c == '$' || c == '%') && p.peek() == '{':
+			// interpolations and directives are kept as written
+			sb.WriteRune(c)
+			depth := 0
+			for !p.eof() {
+				t := p.next()
+				sb.WriteRune(t)
+				if t == '{' {
+					depth++
+				} else if t == '}' {
+					depth--
+					if depth == 0 {
+						break
+					}
+				}
+			}
+		default:
+			sb.WriteRune(c)
+		}
+	}
+}
+
+func (p *hclParser) parseHeredoc() (string, error) {
+	p.pos += 2
+	indent := false
+	if p.peek() == '-' {
+		indent = true
+		p.next()
+	}
+	marker := p.identifier()
+	if marker == "" {
+		return "", p.errorf("expected the marker of the heredoc")
+	}
+	for !p.eof() && p.peek() != '\n' {
+		p.next()
+	}
+	if !p.eof() {
+		p.next()
+	}
+	var lines []string
+	for {
+		if p.eof() {
+			return "", p.errorf("unterminated heredoc %s", marker)
+		}
+		start := p.pos
+		for !p.eof() && p.peek() != '\n' {
+			p.next()
+		}
+		line := strings.TrimRight(string(p.src[start:p.pos]), "\r")
+		if strings.TrimSpace(line) == marker {
+			break
+		}
+		lines = append(lines, line)
+		if !p.eof() {
+			p.next()
+		}
+	}
+	if indent {
+		lines = trimCommonIndent(lines)
+	}
+	if len(lines) == 0 {
+		return "", nil
+	}
+	return strings.Join(lines, "\n") + "\n", nil
+}
+
+func trimCommonIndent(lines []string) []string {
+	common := -1
+	for _, l := range lines {
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+		n := len(l) - len(strings.TrimLeft(l, " \t"))
+		if common < 0 || n < common {
+			common = n
+		}
+	}
+	out := make([]string, 0, len(lines))
+	for _, l := range lines {
+		if len(l) >= common && common > 0 {
+			l = l[common:]
+		}
+		out = append(out, l)
+	}
+	return out
+}
+
+func (p *hclParser) parseNumber() (interface{}, error) {
+	start := p.pos
+	if p.peek() == '-' {
+		p.next()
+	}
+	for !p.eof() && (unicode.IsDigit(p.peek()) || p.peek() == '.' || p.peek() == 'e' || p.peek() == 'E') {
+		p.next()
+	}
+	s := string(p.src[start:p.pos])
+	if i, err := strconv.Atoi(s); err == nil {
+		return i, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, errNotLiteral
+	}
+	return f, nil
+}
+
+func (p *hclParser) parseTuple() (interface{}, error) {
+	p.next()
+	items := []interface{}{}
+	for {
+		p.skipSpace(true)
+		if p.eof() {
+			return nil, p.errorf("unterminated list")
+		}
+		if p.peek() == ']' {
+			p.next()
+			return items, nil
+		}
+		v, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, v)
+		p.skipSpace(true)
+		if p.peek() == ',' {
+			p.next()
+		} else if p.peek() != ']' {
+			return nil, p.errorf("expected , or ] in list, found %q", p.peek())
+		}
+	}
+}
+
+func (p *hclParser) parseObject() (interface{}, error) {
+	p.next()
+	obj := map[string]interface{}{}
+	for {
+		p.skipSpace(true)
+		if p.eof() {
+			return nil, p.errorf("unterminated object")
+		}
+		if p.peek() == '}' {
+			p.next()
+			return obj, nil
+		}
+
+		var key string
+		switch c := p.peek(); {
+		case c == '"':
+			k, err := p.parseString()
+			if err != nil {
+				return nil, err
+			}
+			key = k
+		case isIdentStart(c):
+			key = p.identifier()
+		default:
+			return nil, errNotLiteral
+		}
+		p.skipSpace(false)
+		if c := p.peek(); c != '=' && c != ':' {
+			return nil, p.errorf("expected = or : after key %s", key)
+		}
+		p.next()
+		v, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		obj[key] = v
+		p.skipSpace(false)
+		if p.peek() == ',' {
+			p.next()
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package terraform
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	meshmodel "github.com/layer5io/meshkit/models/meshmodel/registry"
+	"github.com/layer5io/meshkit/utils/kubernetes"
+	"gopkg.in/yaml.v2"
+)
+
+// NewPatternFile converts the Terraform configuration into a design with the components registered for the Kubernetes
+// resources it declares. The charts of the helm_release resources are rendered into the resources they install.
+// The references between the resources are declared as dependencies of the components, so that the resources
+// referred to are deployed first. The warnings report what could not be converted.
+func NewPatternFile(name string, data []byte, reg *meshmodel.RegistryManager) (core.Pattern, []string, error) {
+	conv, err := Convert(data)
+	if err != nil {
+		return core.Pattern{}, nil, err
+	}
+
+	docs := make([]string, 0, len(conv.Manifests))
+	for _, m := range conv.Manifests {
+		byt, err := yaml.Marshal(m)
+		if err != nil {
+			return core.Pattern{}, nil, ErrConvertTerraform(err)
+		}
+		docs = append(docs, string(byt))
+	}
+	warnings := conv.Warnings
+	for _, release := range conv.Releases {
+		manifest, err := kubernetes.ConvertHelmChartToK8sManifest(kubernetes.ApplyHelmChartConfig{
+			Namespace:   release.Namespace,
+			ReleaseName: release.Name,
+			ChartLocation: kubernetes.HelmChartLocation{
+				Repository: release.Repository,
+				Chart:      release.Chart,
+				Version:    release.Version,
+			},
+		})
+		if err != nil {
+			return core.Pattern{}, nil, ErrConvertTerraform(fmt.Errorf("helm_release %s: %w", release.Name, err))
+		}
+		if len(release.Values) > 0 {
+			warnings = append(warnings, fmt.Sprintf("helm_release %s: the chart is rendered with its default values", release.Name))
+		}
+		for _, doc := range strings.Split(string(manifest), "\n---") {
+			if doc = withNamespace(doc, release.Namespace); doc != "" {
+				docs = append(docs, doc)
+			}
+		}
+	}
+
+	pattern, err := core.NewPatternFileFromK8sManifest(strings.Join(docs, "\n---\n"), false, reg)
+	if err != nil {
+		return core.Pattern{}, nil, err
+	}
+	if name != "" {
+		pattern.Name = name
+	}
+
+	components := make(map[ResourceRef]string, len(pattern.Services))
+	for key, svc := range pattern.Services {
+		components[ResourceRef{Kind: svc.Type, Name: svc.Name, Namespace: svc.Namespace}] = key
+	}
+	component := func(ref ResourceRef) (string, bool) {
+		if key, ok := components[ref]; ok {
+			return key, true
+		}
+		// resources declared without a namespace are deployed in the default namespace
+		ref.Namespace = "default"
+		key, ok := components[ref]
+		return key, ok
+	}
+	for _, link := range conv.Links {
+		from, ok := component(link.From)
+		if !ok {
+			continue
+		}
+		if to, ok := component(link.To); ok {
+			svc := pattern.Services[from]
+			svc.DependsOn = append(svc.DependsOn, to)
+		}
+	}
+	return pattern, warnings, nil
+}
+
+// withNamespace sets the namespace of the release on the namespaced resources rendered by its chart without one
+func withNamespace(doc, namespace string) string {
+	if strings.TrimSpace(doc) == "" {
+		return ""
+	}
+	var resource map[string]interface{}
+	if err := yaml.Unmarshal([]byte(doc), &resource); err != nil || resource == nil {
+		return doc
+	}
+	if namespace == "" || resource["kind"] == "Namespace" {
+		return doc
+	}
+	metadata, ok := resource["metadata"].(map[interface{}]interface{})
+	if !ok || metadata["namespace"] != nil {
+		return doc
+	}
+	metadata["namespace"] = namespace
+	byt, err := yaml.Marshal(resource)
+	if err != nil {
+		return doc
+	}
+	return string(byt)
+}
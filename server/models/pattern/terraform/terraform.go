@@ -0,0 +1,536 @@
+// Package terraform converts Terraform configurations using the kubernetes and helm providers into Meshery designs
+package terraform
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ResourceRef identifies a Kubernetes resource declared by a Terraform configuration
+type ResourceRef struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// Link is an edge relationship between two resources, inferred from the depends_on of the resource From
+// and from the references of its attributes to the resource To
+type Link struct {
+	From ResourceRef `json:"from"`
+	To   ResourceRef `json:"to"`
+}
+
+// HelmRelease is a chart installed by a helm_release resource
+type HelmRelease struct {
+	Name       string
+	Namespace  string
+	Repository string
+	Chart      string
+	Version    string
+	// Values override the values of the chart, from the values and set attributes of the release
+	Values map[string]interface{}
+}
+
+// Conversion is the outcome of converting a Terraform configuration into Kubernetes resources
+type Conversion struct {
+	Manifests []map[string]interface{}
+	Releases  []HelmRelease
+	Links     []Link
+	// Warnings report the resources and expressions which could not be converted and were skipped
+	Warnings []string
+}
+
+// the blocks of the kubernetes provider which are repeated for every element of a list, by the name of the list
+var listBlocks = map[string]string{
+	"container":             "containers",
+	"init_container":        "initContainers",
+	"port":                  "ports",
+	"env":                   "env",
+	"env_from":              "envFrom",
+	"volume":                "volumes",
+	"volume_mount":          "volumeMounts",
+	"volume_claim_template": "volumeClaimTemplates",
+	"toleration":            "tolerations",
+	"image_pull_secrets":    "imagePullSecrets",
+	"host_aliases":          "hostAliases",
+	"match_expressions":     "matchExpressions",
+	"rule":                  "rules",
+	"subject":               "subjects",
+	"path":                  "paths",
+	"tls":                   "tls",
+	"ingress":               "ingress",
+	"egress":                "egress",
+	"from":                  "from",
+	"to":                    "to",
+	"items":                 "items",
+	"metric":                "metrics",
+}
+
+// the attributes of the resources which configure Terraform rather than the Kubernetes resource
+var terraformAttributes = map[string]bool{
+	"count":                  true,
+	"for_each":               true,
+	"provider":               true,
+	"depends_on":             true,
+	"lifecycle":              true,
+	"timeouts":               true,
+	"wait_for_rollout":       true,
+	"wait_for_load_balancer": true,
+	"wait_for_completion":    true,
+	"wait_for":               true,
+	"field_manager":          true,
+	"computed_fields":        true,
+}
+
+// API versions of the kinds of the kubernetes provider, the kinds missing are in the core group
+var apiVersions = map[string]string{
+	"Deployment":              "apps/v1",
+	"StatefulSet":             "apps/v1",
+	"DaemonSet":               "apps/v1",
+	"ReplicaSet":              "apps/v1",
+	"Job":                     "batch/v1",
+	"CronJob":                 "batch/v1",
+	"Ingress":                 "networking.k8s.io/v1",
+	"IngressClass":            "networking.k8s.io/v1",
+	"NetworkPolicy":           "networking.k8s.io/v1",
+	"Role":                    "rbac.authorization.k8s.io/v1",
+	"RoleBinding":             "rbac.authorization.k8s.io/v1",
+	"ClusterRole":             "rbac.authorization.k8s.io/v1",
+	"ClusterRoleBinding":      "rbac.authorization.k8s.io/v1",
+	"HorizontalPodAutoscaler": "autoscaling/v2",
+	"StorageClass":            "storage.k8s.io/v1",
+	"PodDisruptionBudget":     "policy/v1",
+	"PriorityClass":           "scheduling.k8s.io/v1",
+}
+
+var (
+	versionSuffix = regexp.MustCompile(`_v[0-9]+(?:(?:alpha|beta)[0-9]+)?$`)
+	// references to the attributes of resources, variables and locals
+	referenceRegex = regexp.MustCompile(`\b((?:var|local)\.[A-Za-z0-9_-]+|[a-z][a-z0-9_]*\.[A-Za-z0-9_-]+(?:(?:\.|\[)[A-Za-z0-9_"\[\].-]*)?)`)
+	interpolation  = regexp.MustCompile(`\$\{([^}]*)\}`)
+)
+
+type resource struct {
+	address string
+	typ     string
+	body    *Body
+	ref     ResourceRef
+}
+
+type converter struct {
+	variables map[string]interface{}
+	locals    map[string]interface{}
+	resources map[string]*resource
+	conv      *Conversion
+	// resources referred to by the resource being converted
+	refs map[string]bool
+}
+
+// Convert converts the resources of the kubernetes and helm providers of the Terraform configuration:
+//   - kubernetes_manifest resources are converted to their manifest
+//   - the other resources of the kubernetes provider, eg: kubernetes_deployment_v1, are converted to the resource of
+//     the corresponding kind, their nested blocks becoming the fields of the resource
+//   - helm_release resources are converted to the releases of their charts
+//
+// References to variables and locals are replaced by their default values, and references to the names and namespaces
+// of other resources by the names and namespaces of the resources. The other expressions are kept as written and reported.
+func Convert(data []byte) (*Conversion, error) {
+	file, err := ParseHCL(data)
+	if err != nil {
+		return nil, ErrConvertTerraform(err)
+	}
+
+	c := &converter{
+		variables: map[string]interface{}{},
+		locals:    map[string]interface{}{},
+		resources: map[string]*resource{},
+		conv:      &Conversion{},
+	}
+	var resources []*resource
+	for _, b := range file.Blocks {
+		switch {
+		case b.Type == "variable" && len(b.Labels) == 1:
+			if v, ok := b.Body.Attributes["default"]; ok {
+				c.variables[b.Labels[0]] = v
+			}
+		case b.Type == "locals":
+			for k, v := range b.Body.Attributes {
+				c.locals[k] = v
+			}
+		case b.Type == "resource" && len(b.Labels) == 2:
+			r := &resource{address: b.Labels[0] + "." + b.Labels[1], typ: b.Labels[0], body: b.Body}
+			if !strings.HasPrefix(r.typ, "kubernetes_") && r.typ != "helm_release" {
+				c.warnf("resource %s: resources of the %s provider are not supported", r.address, strings.Split(r.typ, "_")[0])
+				continue
+			}
+			resources = append(resources, r)
+			c.resources[r.address] = r
+		}
+	}
+	sort.Slice(resources, func(i, j int) bool { return resources[i].address < resources[j].address })
+	if len(resources) == 0 {
+		return nil, ErrConvertTerraform(fmt.Errorf("the configuration has no resources of the kubernetes or helm providers"))
+	}
+
+	// the names of the resources are resolved first, so that the resources referring to them can be converted
+	for _, r := range resources {
+		r.ref = c.resourceRef(r)
+	}
+
+	for _, r := range resources {
+		c.refs = map[string]bool{}
+		switch {
+		case r.typ == "helm_release":
+			c.convertRelease(r)
+		case r.typ == "kubernetes_manifest":
+			manifest, ok := c.resolve(r.address, r.body.Attributes["manifest"]).(map[string]interface{})
+			if !ok {
+				c.warnf("resource %s: the manifest is not an object", r.address)
+				continue
+			}
+			c.conv.Manifests = append(c.conv.Manifests, manifest)
+		default:
+			c.conv.Manifests = append(c.conv.Manifests, c.convertResource(r))
+		}
+
+		if deps, ok := r.body.Attributes["depends_on"].([]interface{}); ok {
+			for _, d := range deps {
+				if e, ok := d.(Expression); ok {
+					c.refs[resourceAddress(string(e))] = true
+				}
+			}
+		}
+		if r.typ == "helm_release" {
+			continue
+		}
+		addresses := make([]string, 0, len(c.refs))
+		for address := range c.refs {
+			addresses = append(addresses, address)
+		}
+		sort.Strings(addresses)
+		for _, address := range addresses {
+			if to, ok := c.resources[address]; ok && to != r && to.typ != "helm_release" {
+				c.conv.Links = append(c.conv.Links, Link{From: r.ref, To: to.ref})
+			}
+		}
+	}
+	return c.conv, nil
+}
+
+func (c *converter) warnf(format string, args ...interface{}) {
+	c.conv.Warnings = append(c.conv.Warnings, fmt.Sprintf(format, args...))
+}
+
+// resourceRef returns the kind, name and namespace of the resource
+func (c *converter) resourceRef(r *resource) ResourceRef {
+	var kind string
+	var metadata map[string]interface{}
+	switch r.typ {
+	case "helm_release":
+		name, _ := c.resolveQuiet(r.body.Attributes["name"]).(string)
+		namespace, _ := c.resolveQuiet(r.body.Attributes["namespace"]).(string)
+		return ResourceRef{Kind: "HelmRelease", Name: name, Namespace: namespace}
+	case "kubernetes_manifest":
+		manifest, _ := c.resolveQuiet(r.body.Attributes["manifest"]).(map[string]interface{})
+		kind, _ = manifest["kind"].(string)
+		metadata, _ = manifest["metadata"].(map[string]interface{})
+	default:
+		kind = kindOf(r.typ)
+		for _, b := range r.body.Blocks {
+			if b.Type == "metadata" {
+				metadata = map[string]interface{}{}
+				for k, v := range b.Body.Attributes {
+					metadata[k] = c.resolveQuiet(v)
+				}
+			}
+		}
+	}
+	name, _ := metadata["name"].(string)
+	namespace, _ := metadata["namespace"].(string)
+	return ResourceRef{Kind: kind, Name: name, Namespace: namespace}
+}
+
+func (c *converter) convertResource(r *resource) map[string]interface{} {
+	manifest := c.convertBody(r.address, r.body, true)
+	kind := r.ref.Kind
+	manifest["kind"] = kind
+	apiVersion, ok := apiVersions[kind]
+	if !ok {
+		apiVersion = "v1"
+	}
+	manifest["apiVersion"] = apiVersion
+	// the kubernetes provider encodes the data of secrets
+	if kind == "Secret" {
+		if data, ok := manifest["data"]; ok {
+			manifest["stringData"] = data
+			delete(manifest, "data")
+		}
+	}
+	return manifest
+}
+
+// convertBody converts the attributes and nested blocks of a resource of the kubernetes provider into the fields of the resource
+func (c *converter) convertBody(path string, body *Body, top bool) map[string]interface{} {
+	out := map[string]interface{}{}
+	for name, v := range body.Attributes {
+		if top && terraformAttributes[name] {
+			continue
+		}
+		out[camelCase(name)] = c.resolve(path+"."+name, v)
+	}
+
+	blocks := map[string][]interface{}{}
+	var types []string
+	for _, b := range body.Blocks {
+		if top && terraformAttributes[b.Type] {
+			continue
+		}
+		if _, ok := blocks[b.Type]; !ok {
+			types = append(types, b.Type)
+		}
+		blocks[b.Type] = append(blocks[b.Type], c.convertBody(path+"."+b.Type, b.Body, false))
+	}
+	for _, typ := range types {
+		items := blocks[typ]
+		switch list, ok := listBlocks[typ]; {
+		case ok:
+			out[list] = items
+		case len(items) == 1:
+			out[camelCase(typ)] = items[0]
+		default:
+			out[camelCase(typ)] = items
+		}
+	}
+	return out
+}
+
+func (c *converter) convertRelease(r *resource) {
+	attr := func(name string) string {
+		s, _ := c.resolve(r.address+"."+name, r.body.Attributes[name]).(string)
+		return s
+	}
+	release := HelmRelease{
+		Name:       r.ref.Name,
+		Namespace:  r.ref.Namespace,
+		Repository: attr("repository"),
+		Chart:      attr("chart"),
+		Version:    attr("version"),
+		Values:     map[string]interface{}{},
+	}
+	if release.Chart == "" {
+		c.warnf("resource %s: the release has no chart", r.address)
+		return
+	}
+	if release.Repository == "" && !strings.Contains(release.Chart, "://") {
+		c.warnf("resource %s: charts on the local filesystem are not supported", r.address)
+		return
+	}
+
+	values, _ := c.resolve(r.address+".values", r.body.Attributes["values"]).([]interface{})
+	for _, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			c.warnf("resource %s: values %v are not a YAML document", r.address, v)
+			continue
+		}
+		var m map[string]interface{}
+		if err := yaml.Unmarshal([]byte(s), &m); err != nil {
+			c.warnf("resource %s: invalid values: %s", r.address, err)
+			continue
+		}
+		mergeValues(release.Values, toStringKeys(m).(map[string]interface{}))
+	}
+	for _, b := range r.body.Blocks {
+		if b.Type != "set" && b.Type != "set_sensitive" {
+			continue
+		}
+		name, _ := c.resolve(r.address+".set", b.Body.Attributes["name"]).(string)
+		if name == "" {
+			continue
+		}
+		setValue(release.Values, strings.Split(name, "."), c.resolve(r.address+".set", b.Body.Attributes["value"]))
+	}
+	c.conv.Releases = append(c.conv.Releases, release)
+}
+
+// resolve resolves the references of the value, the expressions which cannot be resolved are reported
+func (c *converter) resolve(path string, v interface{}) interface{} {
+	return c.resolveValue(v, func(expr string) {
+		c.warnf("%s: the expression %s is kept as written", path, expr)
+	})
+}
+
+// resolveQuiet resolves the references of the value without reporting the expressions which cannot be resolved
+func (c *converter) resolveQuiet(v interface{}) interface{} {
+	refs := c.refs
+	defer func() { c.refs = refs }()
+	c.refs = map[string]bool{}
+	return c.resolveValue(v, func(string) {})
+}
+
+func (c *converter) resolveValue(v interface{}, unresolved func(string)) interface{} {
+	switch val := v.(type) {
+	case Expression:
+		if r, ok := c.resolveReference(string(val)); ok {
+			return r
+		}
+		unresolved(string(val))
+		return "${" + string(val) + "}"
+	case string:
+		if m := interpolation.FindStringSubmatch(val); m != nil && m[0] == val {
+			return c.resolveValue(Expression(strings.TrimSpace(m[1])), unresolved)
+		}
+		return interpolation.ReplaceAllStringFunc(val, func(s string) string {
+			expr := strings.TrimSpace(s[2 : len(s)-1])
+			if r, ok := c.resolveReference(expr); ok {
+				return fmt.Sprint(r)
+			}
+			unresolved(expr)
+			return s
+		})
+	case []interface{}:
+		out := make([]interface{}, 0, len(val))
+		for _, e := range val {
+			out = append(out, c.resolveValue(e, unresolved))
+		}
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(val))
+		for k, e := range val {
+			out[k] = c.resolveValue(e, unresolved)
+		}
+		return out
+	}
+	return v
+}
+
+// resolveReference resolves a reference to a variable, a local or the name or namespace of a resource.
+// The resources referred to are recorded, even if the reference cannot be resolved.
+func (c *converter) resolveReference(expr string) (interface{}, bool) {
+	for _, m := range referenceRegex.FindAllString(expr, -1) {
+		if address := resourceAddress(m); c.resources[address] != nil {
+			c.refs[address] = true
+		}
+	}
+	if !referenceRegex.MatchString(expr) || referenceRegex.FindString(expr) != expr {
+		return nil, false
+	}
+
+	parts := strings.SplitN(expr, ".", 2)
+	switch parts[0] {
+	case "var":
+		v, ok := c.variables[parts[1]]
+		if !ok {
+			return nil, false
+		}
+		return c.resolveQuiet(v), true
+	case "local":
+		v, ok := c.locals[parts[1]]
+		if !ok {
+			return nil, false
+		}
+		return c.resolveQuiet(v), true
+	}
+
+	r, ok := c.resources[resourceAddress(expr)]
+	if !ok {
+		return nil, false
+	}
+	attr := strings.NewReplacer("[0]", "", ".0.", ".", `"`, "").Replace(strings.TrimPrefix(expr, r.address))
+	switch {
+	case attr == ".metadata.name" && r.ref.Name != "", attr == ".name" && r.ref.Name != "":
+		return r.ref.Name, true
+	case attr == ".metadata.namespace" && r.ref.Namespace != "", attr == ".namespace" && r.ref.Namespace != "":
+		return r.ref.Namespace, true
+	case attr == ".manifest.metadata.name" && r.ref.Name != "":
+		return r.ref.Name, true
+	}
+	return nil, false
+}
+
+// resourceAddress returns the address of the resource a reference refers to, eg: kubernetes_service_v1.web
+func resourceAddress(ref string) string {
+	parts := strings.SplitN(ref, ".", 3)
+	if len(parts) < 2 {
+		return ref
+	}
+	name := parts[1]
+	if i := strings.IndexAny(name, "["); i >= 0 {
+		name = name[:i]
+	}
+	return parts[0] + "." + name
+}
+
+// kindOf returns the kind of the resources of a resource type of the kubernetes provider, eg: kubernetes_config_map_v1 => ConfigMap
+func kindOf(typ string) string {
+	name := versionSuffix.ReplaceAllString(strings.TrimPrefix(typ, "kubernetes_"), "")
+	var sb strings.Builder
+	for _, w := range strings.Split(name, "_") {
+		if w == "" {
+			continue
+		}
+		sb.WriteString(strings.ToUpper(w[:1]) + w[1:])
+	}
+	return sb.String()
+}
+
+// camelCase converts the snake_case name of an attribute of the kubernetes provider into the name of the field
+func camelCase(name string) string {
+	parts := strings.Split(name, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+func setValue(values map[string]interface{}, path []string, v interface{}) {
+	for _, key := range path[:len(path)-1] {
+		next, ok := values[key].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			values[key] = next
+		}
+		values = next
+	}
+	values[path[len(path)-1]] = v
+}
+
+func mergeValues(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if sm, ok := v.(map[string]interface{}); ok {
+			if dm, ok := dst[k].(map[string]interface{}); ok {
+				mergeValues(dm, sm)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
+
+func toStringKeys(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(val))
+		for k, e := range val {
+			out[fmt.Sprint(k)] = toStringKeys(e)
+		}
+		return out
+	case map[string]interface{}:
+		for k, e := range val {
+			val[k] = toStringKeys(e)
+		}
+		return val
+	case []interface{}:
+		for i, e := range val {
+			val[i] = toStringKeys(e)
+		}
+		return val
+	}
+	return v
+}
@@ -0,0 +1,240 @@
+package terraform
+
+import (
+	"reflect"
+	"testing"
+)
+
+const configuration = `
+variable "namespace" {
+  type    = string
+  default = "shop"
+}
+
+locals {
+  labels = {
+    app = "web"
+  }
+}
+
+/* the frontend of the shop */
+resource "kubernetes_deployment_v1" "web" {
+  metadata {
+    name      = "web"
+    namespace = var.namespace
+    labels    = local.labels
+  }
+
+  spec {
+    replicas = 2
+    selector {
+      match_labels = local.labels
+    }
+    template {
+      metadata {
+        labels = local.labels
+      }
+      spec {
+        container {
+          name  = "web"
+          image = "nginx:1.25"
+          args  = ["--config", "/etc/web/${kubernetes_config_map_v1.web.metadata[0].name}.conf"]
+          port {
+            container_port = 80
+          }
+          env {
+            name  = "MODE"
+            value = "production"
+          }
+        }
+      }
+    }
+  }
+
+  wait_for_rollout = false
+  depends_on       = [kubernetes_secret_v1.web]
+}
+
+resource "kubernetes_config_map_v1" "web" {
+  metadata {
+    name      = "web-config"
+    namespace = var.namespace
+  }
+  data = {
+    "web.conf" = <<-EOT
+      listen 80;
+    EOT
+  }
+}
+
+resource "kubernetes_secret_v1" "web" {
+  metadata {
+    name      = "web"
+    namespace = var.namespace
+  }
+  data = {
+    token = "s3cr3t" # encoded by the provider
+  }
+}
+
+resource "kubernetes_manifest" "monitor" {
+  manifest = {
+    apiVersion = "monitoring.coreos.com/v1"
+    kind       = "ServiceMonitor"
+    metadata = {
+      name      = "web"
+      namespace = var.namespace
+    }
+    spec = {
+      replicas = length(var.namespace)
+    }
+  }
+}
+
+resource "helm_release" "redis" {
+  name       = "redis"
+  namespace  = var.namespace
+  repository = "https://charts.bitnami.com/bitnami"
+  chart      = "redis"
+  version    = "18.1.0"
+  values     = [<<EOT
+auth:
+  enabled: false
+EOT
+  ]
+  set {
+    name  = "replica.replicaCount"
+    value = 1
+  }
+}
+
+resource "aws_s3_bucket" "assets" {
+  bucket = "assets"
+}
+`
+
+func TestConvert(t *testing.T) {
+	conv, err := Convert([]byte(configuration))
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+
+	resources := make(map[string]map[string]interface{})
+	for _, m := range conv.Manifests {
+		resources[m["kind"].(string)] = m
+	}
+	if len(resources) != 4 {
+		t.Fatalf("resources = %v, want a Deployment, a ConfigMap, a Secret and a ServiceMonitor", resources)
+	}
+
+	t.Run("Blocks of the kubernetes provider become the fields of the resource", func(t *testing.T) {
+		deploy := resources["Deployment"]
+		if deploy["apiVersion"] != "apps/v1" {
+			t.Errorf("apiVersion = %v, want apps/v1", deploy["apiVersion"])
+		}
+		if _, ok := deploy["waitForRollout"]; ok {
+			t.Errorf("the attributes of Terraform are converted: %v", deploy)
+		}
+		metadata := deploy["metadata"].(map[string]interface{})
+		if metadata["namespace"] != "shop" || !reflect.DeepEqual(metadata["labels"], map[string]interface{}{"app": "web"}) {
+			t.Errorf("metadata = %v, want the resolved variables and locals", metadata)
+		}
+		spec := deploy["spec"].(map[string]interface{})
+		if !reflect.DeepEqual(spec["selector"], map[string]interface{}{"matchLabels": map[string]interface{}{"app": "web"}}) {
+			t.Errorf("selector = %v", spec["selector"])
+		}
+		pod := spec["template"].(map[string]interface{})["spec"].(map[string]interface{})
+		want := []interface{}{map[string]interface{}{
+			"name":  "web",
+			"image": "nginx:1.25",
+			"args":  []interface{}{"--config", "/etc/web/web-config.conf"},
+			"ports": []interface{}{map[string]interface{}{"containerPort": 80}},
+			"env":   []interface{}{map[string]interface{}{"name": "MODE", "value": "production"}},
+		}}
+		if !reflect.DeepEqual(pod["containers"], want) {
+			t.Errorf("containers = %v, want %v", pod["containers"], want)
+		}
+	})
+
+	t.Run("Keys of the maps are kept as written", func(t *testing.T) {
+		data := resources["ConfigMap"]["data"]
+		if !reflect.DeepEqual(data, map[string]interface{}{"web.conf": "listen 80;\n"}) {
+			t.Errorf("data = %v", data)
+		}
+		secret := resources["Secret"]
+		if !reflect.DeepEqual(secret["stringData"], map[string]interface{}{"token": "s3cr3t"}) || secret["data"] != nil {
+			t.Errorf("secret = %v, want the data as stringData", secret)
+		}
+	})
+
+	t.Run("Manifests are kept with the expressions which cannot be resolved", func(t *testing.T) {
+		monitor := resources["ServiceMonitor"]
+		if monitor["metadata"].(map[string]interface{})["namespace"] != "shop" {
+			t.Errorf("metadata = %v", monitor["metadata"])
+		}
+		if replicas := monitor["spec"].(map[string]interface{})["replicas"]; replicas != "${length(var.namespace)}" {
+			t.Errorf("replicas = %v, want the expression", replicas)
+		}
+		if len(conv.Warnings) != 2 {
+			t.Errorf("warnings = %v, want warnings for aws_s3_bucket.assets and the length expression", conv.Warnings)
+		}
+	})
+
+	t.Run("Releases merge their values and set blocks", func(t *testing.T) {
+		want := []HelmRelease{{
+			Name:       "redis",
+			Namespace:  "shop",
+			Repository: "https://charts.bitnami.com/bitnami",
+			Chart:      "redis",
+			Version:    "18.1.0",
+			Values: map[string]interface{}{
+				"auth":    map[string]interface{}{"enabled": false},
+				"replica": map[string]interface{}{"replicaCount": 1},
+			},
+		}}
+		if !reflect.DeepEqual(conv.Releases, want) {
+			t.Errorf("releases = %v, want %v", conv.Releases, want)
+		}
+	})
+
+	t.Run("References and dependencies become edges", func(t *testing.T) {
+		want := []Link{
+			{From: ResourceRef{Kind: "Deployment", Name: "web", Namespace: "shop"}, To: ResourceRef{Kind: "ConfigMap", Name: "web-config", Namespace: "shop"}},
+			{From: ResourceRef{Kind: "Deployment", Name: "web", Namespace: "shop"}, To: ResourceRef{Kind: "Secret", Name: "web", Namespace: "shop"}},
+		}
+		if !reflect.DeepEqual(conv.Links, want) {
+			t.Errorf("links = %v, want %v", conv.Links, want)
+		}
+	})
+}
+
+func TestConvertErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+	}{
+		{name: "Invalid HCL is rejected", config: `resource "kubernetes_namespace_v1" "shop" {`},
+		{name: "Unterminated strings are rejected", config: "locals {\n  name = \"shop\n}\n"},
+		{name: "Configurations without Kubernetes resources are rejected", config: `resource "aws_s3_bucket" "assets" {}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Convert([]byte(tt.config)); err == nil {
+				t.Errorf("Convert() error = nil, want an error")
+			}
+		})
+	}
+}
+
+func TestKindOf(t *testing.T) {
+	for typ, want := range map[string]string{
+		"kubernetes_namespace":                    "Namespace",
+		"kubernetes_config_map_v1":                "ConfigMap",
+		"kubernetes_horizontal_pod_autoscaler_v2": "HorizontalPodAutoscaler",
+		"kubernetes_cron_job_v1beta1":             "CronJob",
+	} {
+		if got := kindOf(typ); got != want {
+			t.Errorf("kindOf(%s) = %s, want %s", typ, got, want)
+		}
+	}
+}
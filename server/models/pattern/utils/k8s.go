@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/layer5io/meshery/server/helpers"
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -50,10 +49,13 @@ func CreateK8sResource(
 
 	obj := &unstructured.Unstructured{Object: resourceMap}
 
-	obj.SetLabels(helpers.MergeStringMaps(obj.GetLabels(), map[string]string{
-		"controller": "meshery",
-		"source":     "pattern",
-	}))
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels["controller"] = "meshery"
+	labels["source"] = "pattern"
+	obj.SetLabels(labels)
 
 	gvr := schema.GroupVersionResource{
 		Group:    group,
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// Statuses of a pattern deployment
+const (
+	PatternDeploymentQueued    = "queued"
+	PatternDeploymentRunning   = "running"
+	PatternDeploymentCompleted = "completed"
+	PatternDeploymentFailed    = "failed"
+	// PatternDeploymentInterrupted is the status of the deployments stopped by the shutdown of Meshery Server
+	PatternDeploymentInterrupted = "interrupted"
+)
+
+// PatternDeployment is the progress of a design deployment, checkpointed after every stage of the pattern engine
+// so that a deployment interrupted by a restart or a crash of the server can be resumed from its last completed stage
+type PatternDeployment struct {
+	ID     uuid.UUID `json:"id" gorm:"primaryKey"`
+	UserID string    `json:"user_id" gorm:"index"`
+	Name   string    `json:"name"`
+	Status string    `json:"status"`
+	// ServerID identifies the Meshery Server running the deployment, among the servers sharing the database
+	ServerID string `json:"server_id" gorm:"index"`
+	// Options the deployment was requested with, the resumed deployment runs with the same ones
+	IsDelete bool `json:"is_delete"`
+	SkipCRD  bool `json:"skip_crd"`
+	Rollback bool `json:"rollback"`
+	// CompletedStages is the JSON list of the names of the completed stages
+	CompletedStages string `json:"-"`
+	// Snapshot of the data produced by the completed stages
+	Snapshot []byte `json:"-"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Completed returns the names of the completed stages
+func (pd *PatternDeployment) Completed() []string {
+	completed := []string{}
+	_ = json.Unmarshal([]byte(pd.CompletedStages), &completed)
+	return completed
+}
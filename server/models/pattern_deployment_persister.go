@@ -0,0 +1,89 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// PatternDeploymentPersister is the persister for the progress of the design deployments
+type PatternDeploymentPersister struct {
+	DB *database.Handler
+	// ServerID identifies the Meshery Server the deployments are saved and run by, it must be stable across restarts
+	ServerID string
+}
+
+// SavePatternDeployment stores the deployment, generating its ID if it has none.
+// Deployments without a server are recorded as run by the server of the persister.
+func (pdp *PatternDeploymentPersister) SavePatternDeployment(pd *PatternDeployment) error {
+	if pd.ServerID == "" {
+		pd.ServerID = pdp.ServerID
+	}
+	if pd.ID == uuid.Nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+		pd.ID = id
+	}
+	return pdp.DB.Save(pd).Error
+}
+
+// GetPatternDeployment returns the deployment with the ID, or nil if there is none
+func (pdp *PatternDeploymentPersister) GetPatternDeployment(id uuid.UUID) (*PatternDeployment, error) {
+	var deployments []PatternDeployment
+	if err := pdp.DB.Where("id = ?", id).Limit(1).Find(&deployments).Error; err != nil {
+		return nil, err
+	}
+	if len(deployments) == 0 {
+		return nil, nil
+	}
+	return &deployments[0], nil
+}
+
+// SetPatternDeploymentStatus updates the status of the deployment.
+// The server updating the status is the one running the deployment from then on, eg: the server resuming it.
+func (pdp *PatternDeploymentPersister) SetPatternDeploymentStatus(id uuid.UUID, status string) error {
+	updates := map[string]interface{}{"status": status}
+	if pdp.ServerID != "" {
+		updates["server_id"] = pdp.ServerID
+	}
+	return pdp.DB.Model(&PatternDeployment{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// InterruptPatternDeployments sets the status of the queued and running deployments of the server to interrupted, so
+// that the deployments left unfinished by a crash or a shutdown of Meshery Server are reported as resumable.
+// The deployments run by the other servers sharing the database are left alone, those recorded before the servers
+// were identified are interrupted by any server.
+func (pdp *PatternDeploymentPersister) InterruptPatternDeployments() (int64, error) {
+	result := pdp.DB.Model(&PatternDeployment{}).
+		Where("status IN ?", []string{PatternDeploymentQueued, PatternDeploymentRunning}).
+		Where("server_id IN ?", []string{pdp.ServerID, ""}).
+		Update("status", PatternDeploymentInterrupted)
+	return result.RowsAffected, result.Error
+}
+
+// Checkpointer returns the checkpointer of the pattern engine chain saving the progress of the deployment with the ID
+func (pdp *PatternDeploymentPersister) Checkpointer(id uuid.UUID) *PatternDeploymentCheckpointer {
+	return &PatternDeploymentCheckpointer{persister: pdp, id: id}
+}
+
+// PatternDeploymentCheckpointer saves the progress of a deployment after every completed stage of the pattern engine
+type PatternDeploymentCheckpointer struct {
+	persister *PatternDeploymentPersister
+	id        uuid.UUID
+}
+
+// SaveCheckpoint records the completed stages of the deployment and the snapshot of the data they produced
+func (pdc *PatternDeploymentCheckpointer) SaveCheckpoint(_ context.Context, completed []string, snapshot []byte) error {
+	stages, err := json.Marshal(completed)
+	if err != nil {
+		return err
+	}
+	return pdc.persister.DB.Model(&PatternDeployment{}).Where("id = ?", pdc.id).Updates(map[string]interface{}{
+		"completed_stages": string(stages),
+		"snapshot":         snapshot,
+	}).Error
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/models/imagescan"
+)
+
+// PatternImageScan is the latest scan of a container image of a saved design
+type PatternImageScan struct {
+	ID        uuid.UUID `json:"id" gorm:"primaryKey"`
+	PatternID string    `json:"pattern_id" gorm:"index"`
+	Image     string    `json:"image"`
+	Scanner   string    `json:"scanner"`
+	// Report is the JSON report of the scan, without the SBOM
+	Report string `json:"-"`
+	// SBOM is the CycloneDX JSON SBOM of the image, empty when it was not generated
+	SBOM      string    `json:"-"`
+	ScannedAt time.Time `json:"scanned_at"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ScanReport returns the report of the scan of the image
+func (pis *PatternImageScan) ScanReport() imagescan.Report {
+	var report imagescan.Report
+	_ = json.Unmarshal([]byte(pis.Report), &report)
+	return report
+}
+
+// MarshalJSON includes the report of the scan in the JSON of the scan, the SBOM is left out
+func (pis PatternImageScan) MarshalJSON() ([]byte, error) {
+	type patternImageScan PatternImageScan
+	return json.Marshal(struct {
+		patternImageScan
+		HasSBOM bool             `json:"has_sbom"`
+		Report  imagescan.Report `json:"report"`
+	}{patternImageScan(pis), pis.SBOM != "", pis.ScanReport()})
+}
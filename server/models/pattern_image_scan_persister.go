@@ -0,0 +1,67 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/models/imagescan"
+	"github.com/layer5io/meshkit/database"
+)
+
+// PatternImageScanPersister is the persister for the scans of the images of the saved designs
+type PatternImageScanPersister struct {
+	DB *database.Handler
+}
+
+// SavePatternImageScans attaches the reports of the scans of the images to the design, replacing the previous scans
+// of the same images
+func (pisp *PatternImageScanPersister) SavePatternImageScans(patternID string, reports []imagescan.Report) error {
+	for _, report := range reports {
+		var scans []PatternImageScan
+		if err := pisp.DB.Where("pattern_id = ? AND image = ?", patternID, report.Image).Limit(1).Find(&scans).Error; err != nil {
+			return err
+		}
+		scan := PatternImageScan{PatternID: patternID, Image: report.Image}
+		if len(scans) > 0 {
+			scan = scans[0]
+		} else {
+			id, err := uuid.NewV4()
+			if err != nil {
+				return ErrGenerateUUID(err)
+			}
+			scan.ID = id
+		}
+		scan.SBOM = string(report.SBOM)
+		report.SBOM = nil
+		byt, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+		scan.Scanner = report.Scanner
+		scan.Report = string(byt)
+		scan.ScannedAt = report.ScannedAt
+		if err := pisp.DB.Save(&scan).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetPatternImageScans returns the scans of the images of the design, ordered by image
+func (pisp *PatternImageScanPersister) GetPatternImageScans(patternID string) ([]PatternImageScan, error) {
+	scans := []PatternImageScan{}
+	err := pisp.DB.Where("pattern_id = ?", patternID).Order("image").Find(&scans).Error
+	return scans, err
+}
+
+// GetPatternImageScan returns the scan of the image of the design, or nil if there is none
+func (pisp *PatternImageScanPersister) GetPatternImageScan(patternID, image string) (*PatternImageScan, error) {
+	var scans []PatternImageScan
+	if err := pisp.DB.Where("pattern_id = ? AND image = ?", patternID, image).Limit(1).Find(&scans).Error; err != nil {
+		return nil, err
+	}
+	if len(scans) == 0 {
+		return nil, nil
+	}
+	return &scans[0], nil
+}
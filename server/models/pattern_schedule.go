@@ -0,0 +1,69 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// Actions a pattern schedule performs on its design
+const (
+	PatternScheduleDeploy   = "deploy"
+	PatternScheduleUndeploy = "undeploy"
+)
+
+// PatternSchedule deploys or undeploys a design on the Kubernetes contexts at the times of a cron expression,
+// eg: to spin up a performance environment every night and tear it down every morning
+type PatternSchedule struct {
+	ID        uuid.UUID `json:"id" gorm:"primaryKey"`
+	UserID    string    `json:"user_id" gorm:"index"`
+	PatternID uuid.UUID `json:"pattern_id" gorm:"index"`
+	Action    string    `json:"action"`
+	// CronExpression is the standard five field cron expression of the schedule, eg: 0 20 * * MON-FRI
+	CronExpression string `json:"cron_expression"`
+	// Timezone is the IANA name of the location the cron expression is evaluated in, UTC when empty
+	Timezone string `json:"timezone"`
+	// ContextIDs is the JSON list of the ids of the Kubernetes contexts the design is deployed to
+	ContextIDs string `json:"-"`
+	Paused     bool   `json:"paused"`
+
+	// The user and the provider of the session the schedule was created or resumed with, the runs use them
+	// as the schedule runs without a session
+	UserName     string `json:"-"`
+	ProviderName string `json:"-"`
+	Token        string `json:"-"`
+
+	NextRunAt     *time.Time `json:"next_run_at,omitempty" gorm:"index"`
+	LastRunAt     *time.Time `json:"last_run_at,omitempty"`
+	LastRunStatus string     `json:"last_run_status,omitempty"`
+	LastRunError  string     `json:"last_run_error,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Contexts returns the ids of the Kubernetes contexts of the schedule
+func (ps *PatternSchedule) Contexts() []string {
+	contexts := []string{}
+	_ = json.Unmarshal([]byte(ps.ContextIDs), &contexts)
+	return contexts
+}
+
+// MarshalJSON includes the ids of the Kubernetes contexts in the JSON of the schedule
+func (ps PatternSchedule) MarshalJSON() ([]byte, error) {
+	type patternSchedule PatternSchedule
+	return json.Marshal(struct {
+		patternSchedule
+		Contexts []string `json:"contexts"`
+	}{patternSchedule(ps), ps.Contexts()})
+}
+
+// PatternScheduleRequestBody refers to the type of request body that CreatePatternScheduleHandler would receive
+type PatternScheduleRequestBody struct {
+	PatternID      string   `json:"pattern_id"`
+	Action         string   `json:"action"`
+	CronExpression string   `json:"cron_expression"`
+	Timezone       string   `json:"timezone,omitempty"`
+	Contexts       []string `json:"contexts"`
+}
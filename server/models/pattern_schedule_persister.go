@@ -0,0 +1,68 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// PatternSchedulePersister is the persister for the schedules of design deployments
+type PatternSchedulePersister struct {
+	DB *database.Handler
+}
+
+// SavePatternSchedule stores the schedule, generating its ID if it has none
+func (psp *PatternSchedulePersister) SavePatternSchedule(ps *PatternSchedule) error {
+	if ps.ID == uuid.Nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+		ps.ID = id
+	}
+	return psp.DB.Save(ps).Error
+}
+
+// GetPatternSchedule returns the schedule with the ID, or nil if there is none
+func (psp *PatternSchedulePersister) GetPatternSchedule(id uuid.UUID) (*PatternSchedule, error) {
+	var schedules []PatternSchedule
+	if err := psp.DB.Where("id = ?", id).Limit(1).Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+	if len(schedules) == 0 {
+		return nil, nil
+	}
+	return &schedules[0], nil
+}
+
+// GetPatternSchedules returns the schedules of the user, of the design if patternID is not nil
+func (psp *PatternSchedulePersister) GetPatternSchedules(userID string, patternID uuid.UUID) ([]PatternSchedule, error) {
+	query := psp.DB.Where("user_id = ?", userID)
+	if patternID != uuid.Nil {
+		query = query.Where("pattern_id = ?", patternID)
+	}
+	schedules := []PatternSchedule{}
+	err := query.Order("created_at").Find(&schedules).Error
+	return schedules, err
+}
+
+// GetDuePatternSchedules returns the schedules not paused whose next run is due at the time
+func (psp *PatternSchedulePersister) GetDuePatternSchedules(at time.Time) ([]PatternSchedule, error) {
+	schedules := []PatternSchedule{}
+	err := psp.DB.Where("paused = ? AND next_run_at IS NOT NULL AND next_run_at <= ?", false, at).Order("next_run_at").Find(&schedules).Error
+	return schedules, err
+}
+
+// DeletePatternSchedule deletes the schedule
+func (psp *PatternSchedulePersister) DeletePatternSchedule(id uuid.UUID) error {
+	return psp.DB.Where("id = ?", id).Delete(&PatternSchedule{}).Error
+}
+
+// SetPatternScheduleRunStatus updates the outcome of the last run of the schedule, leaving the rest of it as it is
+func (psp *PatternSchedulePersister) SetPatternScheduleRunStatus(id uuid.UUID, status, runErr string) error {
+	return psp.DB.Model(&PatternSchedule{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"last_run_status": status,
+		"last_run_error":  runErr,
+	}).Error
+}
@@ -32,6 +32,12 @@ func (ppp *PerformanceProfilePersister) GetPerformanceProfiles(_, search, order
 	count := int64(0)
 	profiles := []*PerformanceProfile{}
 
+	// SQLite stores the times as text, which DATETIME normalizes, PostgreSQL compares them as timestamps
+	lastRun := "DATETIME(MAX(meshery_results.test_start_time))"
+	if IsPostgres(ppp.DB.DB) {
+		lastRun = "MAX(meshery_results.test_start_time)"
+	}
+
 	query := ppp.DB.
 		Select(`
 		id, name, load_generators,
@@ -39,7 +45,7 @@ func (ppp *PerformanceProfilePersister) GetPerformanceProfiles(_, search, order
 		duration, request_headers, request_cookies,
 		request_body, content_type, created_at,
 		updated_at, (?) as last_run, (?) as total_results`,
-			ppp.DB.Table("meshery_results").Select("DATETIME(MAX(meshery_results.test_start_time))").Where("performance_profile = performance_profiles.id"),
+			ppp.DB.Table("meshery_results").Select(lastRun).Where("performance_profile = performance_profiles.id"),
 			ppp.DB.Table("meshery_results").Select("COUNT(meshery_results.name)").Where("performance_profile = performance_profiles.id"),
 		).
 		Order(order)
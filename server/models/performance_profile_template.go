@@ -0,0 +1,223 @@
+package models
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/internal/sql"
+)
+
+// PerformanceLoadPattern is how the load of the performance tests is generated
+type PerformanceLoadPattern string
+
+// Load patterns of the performance tests
+const (
+	// ConstantLoad sends the requests at the QPS of the profile
+	ConstantLoad PerformanceLoadPattern = "constant"
+	// MaxLoad sends the requests as fast as the connections allow, the QPS of the profile being 0
+	MaxLoad PerformanceLoadPattern = "max"
+)
+
+// PerformanceProfileTemplate is a reusable set of the load options and of the SLOs of performance profiles, which are
+// created from it against endpoints, see Instantiate
+type PerformanceProfileTemplate struct {
+	ID uuid.UUID `json:"id" gorm:"primaryKey"`
+	// UserID is empty for the templates seeded by Meshery Server, which are built in and read-only
+	UserID            string                 `json:"user_id" gorm:"index"`
+	Builtin           bool                   `json:"builtin"`
+	Name              string                 `json:"name"`
+	Description       string                 `json:"description"`
+	LoadGenerator     LoadGenerator          `json:"load_generator"`
+	LoadPattern       PerformanceLoadPattern `json:"load_pattern"`
+	ConcurrentRequest int                    `json:"concurrent_request"`
+	QPS               int                    `json:"qps"`
+	Duration          string                 `json:"duration"`
+	SLOs              []PerformanceSLO       `json:"slos" gorm:"type:bytes;serializer:json"`
+	CreatedAt         time.Time              `json:"created_at"`
+	UpdatedAt         time.Time              `json:"updated_at"`
+}
+
+// PerformanceProfileTemplateRequestBody is the body of the requests creating or updating a performance profile template
+type PerformanceProfileTemplateRequestBody struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// LoadGenerator is fortio when empty
+	LoadGenerator LoadGenerator `json:"load_generator"`
+	// LoadPattern is constant when empty
+	LoadPattern       PerformanceLoadPattern `json:"load_pattern"`
+	ConcurrentRequest int                    `json:"concurrent_request"`
+	QPS               int                    `json:"qps"`
+	Duration          string                 `json:"duration"`
+	SLOs              []PerformanceSLO       `json:"slos"`
+}
+
+// PerformanceProfileTemplateInstance is the body of the requests creating a performance profile from a template
+type PerformanceProfileTemplateInstance struct {
+	// Name is the one of the template when empty
+	Name      string   `json:"name"`
+	Endpoints []string `json:"endpoints"`
+	// DesignID is the design the endpoints are served by, if any
+	DesignID       *uuid.UUID `json:"design_id,omitempty"`
+	ServiceMesh    string     `json:"service_mesh"`
+	RequestHeaders string     `json:"request_headers"`
+	RequestCookies string     `json:"request_cookies"`
+	RequestBody    string     `json:"request_body"`
+	ContentType    string     `json:"content_type"`
+}
+
+// Apply validates the body and sets the fields of the template it holds
+func (t *PerformanceProfileTemplate) Apply(body PerformanceProfileTemplateRequestBody) error {
+	if strings.TrimSpace(body.Name) == "" {
+		return ErrInvalidPerfProfileTemplate("name is required")
+	}
+	if body.LoadGenerator == "" {
+		body.LoadGenerator = FortioLG
+	}
+	switch body.LoadGenerator {
+	case FortioLG, Wrk2LG, NighthawkLG:
+	default:
+		return ErrInvalidPerfProfileTemplate(fmt.Sprintf("%q is not a load generator, the load generators are fortio, wrk2 and nighthawk", body.LoadGenerator))
+	}
+	if body.LoadPattern == "" {
+		body.LoadPattern = ConstantLoad
+	}
+	switch body.LoadPattern {
+	case ConstantLoad:
+		if body.QPS < 1 {
+			return ErrInvalidPerfProfileTemplate("the qps of a constant load must be positive")
+		}
+	case MaxLoad:
+		if body.QPS != 0 {
+			return ErrInvalidPerfProfileTemplate("the qps of a max load must be 0")
+		}
+	default:
+		return ErrInvalidPerfProfileTemplate(fmt.Sprintf("%q is not a load pattern, the load patterns are %s and %s", body.LoadPattern, ConstantLoad, MaxLoad))
+	}
+	if body.ConcurrentRequest < 1 {
+		return ErrInvalidPerfProfileTemplate("the concurrent requests must be positive")
+	}
+	if d, err := time.ParseDuration(body.Duration); err != nil || d <= 0 {
+		return ErrInvalidPerfProfileTemplate(fmt.Sprintf("%q is not a positive duration, eg: 30s or 5m", body.Duration))
+	}
+	if err := validatePerformanceSLOs(body.SLOs); err != nil {
+		return err
+	}
+
+	t.Name = strings.TrimSpace(body.Name)
+	t.Description = body.Description
+	t.LoadGenerator = body.LoadGenerator
+	t.LoadPattern = body.LoadPattern
+	t.ConcurrentRequest = body.ConcurrentRequest
+	t.QPS = body.QPS
+	t.Duration = body.Duration
+	t.SLOs = body.SLOs
+	if t.SLOs == nil {
+		t.SLOs = []PerformanceSLO{}
+	}
+	return nil
+}
+
+// Instantiate returns the performance profile testing the endpoints with the load options of the template, the load
+// pattern and the SLOs of the template are kept in the metadata of the profile along with the ID of the template
+func (t *PerformanceProfileTemplate) Instantiate(instance PerformanceProfileTemplateInstance) (*PerformanceProfile, error) {
+	if len(instance.Endpoints) == 0 {
+		return nil, ErrInvalidPerfProfileTemplate("at least one endpoint is required")
+	}
+	for _, endpoint := range instance.Endpoints {
+		u, err := url.Parse(endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, ErrInvalidPerfProfileTemplate(fmt.Sprintf("%q is not an http or https URL", endpoint))
+		}
+	}
+	name := strings.TrimSpace(instance.Name)
+	if name == "" {
+		name = t.Name
+	}
+
+	metadata := sql.Map{
+		"template_id":      t.ID.String(),
+		"load_pattern":     string(t.LoadPattern),
+		PerformanceSLOsKey: t.SLOs,
+	}
+	if instance.DesignID != nil {
+		metadata["design_id"] = instance.DesignID.String()
+	}
+	return &PerformanceProfile{
+		Name:              name,
+		LoadGenerators:    []string{t.LoadGenerator.Name()},
+		Endpoints:         instance.Endpoints,
+		ServiceMesh:       instance.ServiceMesh,
+		ConcurrentRequest: t.ConcurrentRequest,
+		QPS:               t.QPS,
+		Duration:          t.Duration,
+		RequestHeaders:    instance.RequestHeaders,
+		RequestCookies:    instance.RequestCookies,
+		RequestBody:       instance.RequestBody,
+		ContentType:       instance.ContentType,
+		Metadata:          metadata,
+	}, nil
+}
+
+// builtinPerformanceProfileTemplate returns a template seeded by Meshery Server, its ID is derived from its name for the
+// seeding to update it rather than to duplicate it
+func builtinPerformanceProfileTemplate(t PerformanceProfileTemplate) PerformanceProfileTemplate {
+	t.ID = uuid.NewV5(uuid.NamespaceURL, "meshery.io/performance-profile-templates/"+t.Name)
+	t.Builtin = true
+	return t
+}
+
+// BuiltinPerformanceProfileTemplates are the templates of the common performance tests seeded by Meshery Server
+var BuiltinPerformanceProfileTemplates = []PerformanceProfileTemplate{
+	builtinPerformanceProfileTemplate(PerformanceProfileTemplate{
+		Name:              "Smoke test",
+		Description:       "A light load checking that the endpoints serve the requests.",
+		LoadGenerator:     FortioLG,
+		LoadPattern:       ConstantLoad,
+		ConcurrentRequest: 2,
+		QPS:               10,
+		Duration:          "30s",
+		SLOs:              []PerformanceSLO{{Metric: SLOLatencyP99, Threshold: 500}, {Metric: SLOErrorRate, Threshold: 1}},
+	}),
+	builtinPerformanceProfileTemplate(PerformanceProfileTemplate{
+		Name:              "Load test",
+		Description:       "The expected load of production, checking the latencies the users experience.",
+		LoadGenerator:     FortioLG,
+		LoadPattern:       ConstantLoad,
+		ConcurrentRequest: 16,
+		QPS:               100,
+		Duration:          "5m",
+		SLOs:              []PerformanceSLO{{Metric: SLOLatencyP90, Threshold: 100}, {Metric: SLOLatencyP99, Threshold: 250}, {Metric: SLOErrorRate, Threshold: 1}},
+	}),
+	builtinPerformanceProfileTemplate(PerformanceProfileTemplate{
+		Name:              "Stress test",
+		Description:       "As many requests as the endpoints serve, finding their maximum throughput.",
+		LoadGenerator:     FortioLG,
+		LoadPattern:       MaxLoad,
+		ConcurrentRequest: 64,
+		Duration:          "5m",
+		SLOs:              []PerformanceSLO{{Metric: SLOErrorRate, Threshold: 5}},
+	}),
+	builtinPerformanceProfileTemplate(PerformanceProfileTemplate{
+		Name:              "Soak test",
+		Description:       "A moderate load for an hour, finding the leaks and the degradations over time.",
+		LoadGenerator:     FortioLG,
+		LoadPattern:       ConstantLoad,
+		ConcurrentRequest: 8,
+		QPS:               50,
+		Duration:          "1h",
+		SLOs:              []PerformanceSLO{{Metric: SLOLatencyP99, Threshold: 300}, {Metric: SLOErrorRate, Threshold: 0.5}},
+	}),
+	builtinPerformanceProfileTemplate(PerformanceProfileTemplate{
+		Name:              "Latency baseline",
+		Description:       "A constant load measured with the coordinated omission correction of wrk2, the baseline of the latencies.",
+		LoadGenerator:     Wrk2LG,
+		LoadPattern:       ConstantLoad,
+		ConcurrentRequest: 4,
+		QPS:               200,
+		Duration:          "2m",
+		SLOs:              []PerformanceSLO{{Metric: SLOLatencyP50, Threshold: 50}, {Metric: SLOLatencyP90, Threshold: 100}, {Metric: SLOLatencyP99, Threshold: 200}},
+	}),
+}
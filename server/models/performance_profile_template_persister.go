@@ -0,0 +1,70 @@
+package models
+
+import (
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+	"gorm.io/gorm/clause"
+)
+
+// PerformanceProfileTemplatePersister is the persister for the performance profile templates
+type PerformanceProfileTemplatePersister struct {
+	DB *database.Handler
+}
+
+// SeedPerformanceProfileTemplates stores the built-in templates, updating the ones seeded by the previous releases
+func (ptp *PerformanceProfileTemplatePersister) SeedPerformanceProfileTemplates() error {
+	templates := make([]PerformanceProfileTemplate, len(BuiltinPerformanceProfileTemplates))
+	copy(templates, BuiltinPerformanceProfileTemplates)
+	return ptp.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		UpdateAll: true,
+	}).Create(&templates).Error
+}
+
+// SavePerformanceProfileTemplate stores the template, generating its ID if it has none
+func (ptp *PerformanceProfileTemplatePersister) SavePerformanceProfileTemplate(template *PerformanceProfileTemplate) error {
+	if template.ID == uuid.Nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+		template.ID = id
+	}
+	return ptp.DB.Save(template).Error
+}
+
+// GetPerformanceProfileTemplates returns the built-in templates and the ones of the user whose name contains search,
+// the built-in ones first and then by name
+func (ptp *PerformanceProfileTemplatePersister) GetPerformanceProfileTemplates(userID, search string) ([]PerformanceProfileTemplate, error) {
+	query := ptp.DB.Where("builtin = ? OR user_id = ?", true, userID)
+	if search != "" {
+		query = query.Where("lower(name) like ?", "%"+strings.ToLower(search)+"%")
+	}
+	templates := []PerformanceProfileTemplate{}
+	if err := query.Order("builtin desc, name asc").Find(&templates).Error; err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// GetPerformanceProfileTemplate returns the built-in template or the template of the user with the id, or nil if there
+// is none
+func (ptp *PerformanceProfileTemplatePersister) GetPerformanceProfileTemplate(id uuid.UUID, userID string) (*PerformanceProfileTemplate, error) {
+	var templates []PerformanceProfileTemplate
+	if err := ptp.DB.Where("id = ? AND (builtin = ? OR user_id = ?)", id, true, userID).Limit(1).Find(&templates).Error; err != nil {
+		return nil, err
+	}
+	if len(templates) == 0 {
+		return nil, nil
+	}
+	return &templates[0], nil
+}
+
+// DeletePerformanceProfileTemplate deletes the template of the user, and returns whether the user had such a template,
+// the built-in templates are not deleted
+func (ptp *PerformanceProfileTemplatePersister) DeletePerformanceProfileTemplate(id uuid.UUID, userID string) (bool, error) {
+	result := ptp.DB.Where("id = ? AND user_id = ? AND builtin = ?", id, userID, false).Delete(&PerformanceProfileTemplate{})
+	return result.RowsAffected > 0, result.Error
+}
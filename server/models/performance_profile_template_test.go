@@ -0,0 +1,142 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+)
+
+func TestPerformanceProfileTemplateApply(t *testing.T) {
+	var template PerformanceProfileTemplate
+	err := template.Apply(PerformanceProfileTemplateRequestBody{
+		Name:              " checkout ",
+		QPS:               50,
+		ConcurrentRequest: 8,
+		Duration:          "2m",
+		SLOs:              []PerformanceSLO{{Metric: SLOLatencyP99, Threshold: 300}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if template.Name != "checkout" || template.LoadGenerator != FortioLG || template.LoadPattern != ConstantLoad {
+		t.Errorf("Apply() = %+v", template)
+	}
+
+	valid := PerformanceProfileTemplateRequestBody{Name: "checkout", QPS: 50, ConcurrentRequest: 8, Duration: "2m"}
+	invalid := []func(*PerformanceProfileTemplateRequestBody){
+		func(b *PerformanceProfileTemplateRequestBody) { b.Name = "" },
+		func(b *PerformanceProfileTemplateRequestBody) { b.LoadGenerator = "jmeter" },
+		func(b *PerformanceProfileTemplateRequestBody) { b.LoadPattern = "ramp" },
+		func(b *PerformanceProfileTemplateRequestBody) { b.QPS = 0 },
+		func(b *PerformanceProfileTemplateRequestBody) { b.LoadPattern = MaxLoad },
+		func(b *PerformanceProfileTemplateRequestBody) { b.ConcurrentRequest = 0 },
+		func(b *PerformanceProfileTemplateRequestBody) { b.Duration = "30" },
+		func(b *PerformanceProfileTemplateRequestBody) {
+			b.SLOs = []PerformanceSLO{{Metric: "p95", Threshold: 100}}
+		},
+		func(b *PerformanceProfileTemplateRequestBody) {
+			b.SLOs = []PerformanceSLO{{Metric: SLOErrorRate, Threshold: 150}}
+		},
+		func(b *PerformanceProfileTemplateRequestBody) {
+			b.SLOs = []PerformanceSLO{{Metric: SLOLatencyP99, Threshold: 100}, {Metric: SLOLatencyP99, Threshold: 200}}
+		},
+	}
+	for i, modify := range invalid {
+		body := valid
+		modify(&body)
+		if err := (&PerformanceProfileTemplate{}).Apply(body); err == nil {
+			t.Errorf("Apply() of the invalid body %d = %+v succeeded", i, body)
+		}
+	}
+	for _, builtin := range BuiltinPerformanceProfileTemplates {
+		body := PerformanceProfileTemplateRequestBody{
+			Name:              builtin.Name,
+			LoadGenerator:     builtin.LoadGenerator,
+			LoadPattern:       builtin.LoadPattern,
+			ConcurrentRequest: builtin.ConcurrentRequest,
+			QPS:               builtin.QPS,
+			Duration:          builtin.Duration,
+			SLOs:              builtin.SLOs,
+		}
+		if err := (&PerformanceProfileTemplate{}).Apply(body); err != nil {
+			t.Errorf("the built-in template %s is invalid: %v", builtin.Name, err)
+		}
+	}
+}
+
+func TestPerformanceProfileTemplateInstantiate(t *testing.T) {
+	template := BuiltinPerformanceProfileTemplates[0]
+	design := uuid.Must(uuid.NewV4())
+	profile, err := template.Instantiate(PerformanceProfileTemplateInstance{
+		Endpoints: []string{"http://checkout.shop.svc:8080/health"},
+		DesignID:  &design,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.Name != template.Name || profile.QPS != template.QPS || profile.Duration != template.Duration || profile.LoadGenerators[0] != "fortio" {
+		t.Errorf("Instantiate() = %+v", profile)
+	}
+	if profile.Metadata["template_id"] != template.ID.String() || profile.Metadata["design_id"] != design.String() {
+		t.Errorf("the metadata of the profile is %v", profile.Metadata)
+	}
+
+	for _, endpoints := range [][]string{nil, {"checkout.shop.svc:8080"}} {
+		if _, err := template.Instantiate(PerformanceProfileTemplateInstance{Endpoints: endpoints}); err == nil {
+			t.Errorf("Instantiate() against %v succeeded", endpoints)
+		}
+	}
+}
+
+func TestPerformanceProfileTemplatePersister(t *testing.T) {
+	for engine, db := range testDatabases(t) {
+		t.Run(engine, func(t *testing.T) {
+			if err := db.AutoMigrate(&PerformanceProfileTemplate{}); err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() {
+				_ = db.Migrator().DropTable(&PerformanceProfileTemplate{})
+			})
+
+			persister := &PerformanceProfileTemplatePersister{DB: db}
+			for i := 0; i < 2; i++ {
+				if err := persister.SeedPerformanceProfileTemplates(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			user, other := uuid.Must(uuid.NewV4()).String(), uuid.Must(uuid.NewV4()).String()
+			template := &PerformanceProfileTemplate{UserID: user, Name: "checkout"}
+			if err := persister.SavePerformanceProfileTemplate(template); err != nil {
+				t.Fatal(err)
+			}
+
+			templates, err := persister.GetPerformanceProfileTemplates(user, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(templates) != len(BuiltinPerformanceProfileTemplates)+1 || templates[len(templates)-1].ID != template.ID {
+				t.Errorf("the templates of the user are %+v, want the built-in ones, seeded once, and the one of the user", templates)
+			}
+			if len(templates[0].SLOs) == 0 {
+				t.Errorf("the SLOs of the built-in template %s were not stored", templates[0].Name)
+			}
+			if templates, err := persister.GetPerformanceProfileTemplates(other, "check"); err != nil || len(templates) != 0 {
+				t.Errorf("the templates of another user are %+v, %v", templates, err)
+			}
+
+			builtin := BuiltinPerformanceProfileTemplates[0].ID
+			if got, err := persister.GetPerformanceProfileTemplate(builtin, other); err != nil || got == nil || !got.Builtin {
+				t.Errorf("GetPerformanceProfileTemplate() of a built-in template = %+v, %v", got, err)
+			}
+			if got, err := persister.GetPerformanceProfileTemplate(template.ID, other); err != nil || got != nil {
+				t.Errorf("GetPerformanceProfileTemplate() of the template of another user = %+v, %v", got, err)
+			}
+			if deleted, err := persister.DeletePerformanceProfileTemplate(builtin, ""); err != nil || deleted {
+				t.Errorf("DeletePerformanceProfileTemplate() of a built-in template = %t, %v", deleted, err)
+			}
+			if deleted, err := persister.DeletePerformanceProfileTemplate(template.ID, user); err != nil || !deleted {
+				t.Errorf("DeletePerformanceProfileTemplate() = %t, %v", deleted, err)
+			}
+		})
+	}
+}
@@ -13,8 +13,9 @@ import (
 type PerformanceProfile struct {
 	ID *uuid.UUID `json:"id,omitempty"`
 
-	Name              string         `json:"name,omitempty"`
-	LastRun           *sql.Time      `json:"last_run,omitempty" gorm:"type:datetime"`
+	Name string `json:"name,omitempty"`
+	// LastRun is the start of the latest result of the profile, it is computed when the profiles are queried
+	LastRun           *sql.Time      `json:"last_run,omitempty" gorm:"->;-:migration"`
 	Schedule          *uuid.UUID     `json:"schedule,omitempty"`
 	LoadGenerators    pq.StringArray `json:"load_generators,omitempty" gorm:"type:text[]"`
 	Endpoints         pq.StringArray `json:"endpoints,omitempty" gorm:"type:text[]"`
@@ -0,0 +1,221 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// PerformanceSLOMetric is a metric of the results of the performance tests an SLO is set on
+type PerformanceSLOMetric string
+
+// Metrics of the SLOs, the latencies are in milliseconds and the error rate is a percentage of the requests
+const (
+	SLOLatencyP50  PerformanceSLOMetric = "p50"
+	SLOLatencyP90  PerformanceSLOMetric = "p90"
+	SLOLatencyP99  PerformanceSLOMetric = "p99"
+	SLOLatencyP999 PerformanceSLOMetric = "p99.9"
+	SLOLatencyMax  PerformanceSLOMetric = "max"
+	SLOErrorRate   PerformanceSLOMetric = "error_rate"
+	SLOQPS         PerformanceSLOMetric = "qps"
+)
+
+// PerformanceSLOMetrics are the metrics the SLOs can be set on
+var PerformanceSLOMetrics = []PerformanceSLOMetric{SLOLatencyP50, SLOLatencyP90, SLOLatencyP99, SLOLatencyP999, SLOLatencyMax, SLOErrorRate, SLOQPS}
+
+const (
+	// PerformanceSLOsKey is the key of the SLOs in the metadata of the performance profiles
+	PerformanceSLOsKey = "slos"
+	// PerformanceSLOReportKey is the key of the evaluation of the SLOs of the profile in the runner results of the
+	// performance results, see PerformanceSLOReport
+	PerformanceSLOReportKey = "slo_report"
+)
+
+// PerformanceSLO is an objective on a metric of the results of the performance tests: the latencies and the error rate
+// must be below the threshold, the QPS at least the threshold
+type PerformanceSLO struct {
+	Metric    PerformanceSLOMetric `json:"metric"`
+	Threshold float64              `json:"threshold"`
+}
+
+// String returns the objective, eg: p99 < 250
+func (s PerformanceSLO) String() string {
+	if s.Metric == SLOQPS {
+		return fmt.Sprintf("%s >= %g", s.Metric, s.Threshold)
+	}
+	return fmt.Sprintf("%s < %g", s.Metric, s.Threshold)
+}
+
+// Met tells whether the value of the metric meets the objective
+func (s PerformanceSLO) Met(value float64) bool {
+	if s.Metric == SLOQPS {
+		return value >= s.Threshold
+	}
+	return value < s.Threshold
+}
+
+func validatePerformanceSLOs(slos []PerformanceSLO) error {
+	seen := map[PerformanceSLOMetric]bool{}
+	for _, slo := range slos {
+		known := false
+		for _, m := range PerformanceSLOMetrics {
+			known = known || slo.Metric == m
+		}
+		if !known {
+			return ErrInvalidPerfSLO(fmt.Sprintf("%q is not an SLO metric, the metrics are %v", slo.Metric, PerformanceSLOMetrics))
+		}
+		if seen[slo.Metric] {
+			return ErrInvalidPerfSLO(fmt.Sprintf("there are several SLOs on %s", slo.Metric))
+		}
+		seen[slo.Metric] = true
+		if slo.Threshold <= 0 || (slo.Metric == SLOErrorRate && slo.Threshold > 100) {
+			return ErrInvalidPerfSLO(fmt.Sprintf("%g is not a threshold of %s", slo.Threshold, slo.Metric))
+		}
+	}
+	return nil
+}
+
+// GetSLOs returns the validated SLOs of the metadata of the profile, none when it has no SLOs
+func (p *PerformanceProfile) GetSLOs() ([]PerformanceSLO, error) {
+	value, ok := p.Metadata[PerformanceSLOsKey]
+	if !ok || value == nil {
+		return nil, nil
+	}
+	// the SLOs are decoded as generic JSON when the profile is read from the provider
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, ErrInvalidPerfSLO(err.Error())
+	}
+	var slos []PerformanceSLO
+	if err := json.Unmarshal(b, &slos); err != nil {
+		return nil, ErrInvalidPerfSLO(fmt.Sprintf("the %s of the metadata are not a list of metrics and thresholds", PerformanceSLOsKey))
+	}
+	if err := validatePerformanceSLOs(slos); err != nil {
+		return nil, err
+	}
+	return slos, nil
+}
+
+// PerformanceSLOResult is the evaluation of an SLO against a performance result
+type PerformanceSLOResult struct {
+	PerformanceSLO
+	// Value is the value of the metric in the result, nil when the result does not have it, eg: the p99.9 latency of
+	// the results of wrk2, the SLO is then not met
+	Value  *float64 `json:"value"`
+	Passed bool     `json:"passed"`
+}
+
+// String returns the objective along with the value of the metric, eg: p99 < 250 (312.4)
+func (r PerformanceSLOResult) String() string {
+	if r.Value == nil {
+		return r.PerformanceSLO.String() + " (not measured)"
+	}
+	return fmt.Sprintf("%s (%g)", r.PerformanceSLO, *r.Value)
+}
+
+// PerformanceSLOReport is the evaluation of the SLOs of a profile against a result of the profile, the result passes
+// when every SLO is met
+type PerformanceSLOReport struct {
+	Passed bool                   `json:"passed"`
+	SLOs   []PerformanceSLOResult `json:"slos"`
+}
+
+// Violations returns the SLOs which are not met
+func (r *PerformanceSLOReport) Violations() []PerformanceSLOResult {
+	violations := []PerformanceSLOResult{}
+	for _, slo := range r.SLOs {
+		if !slo.Passed {
+			violations = append(violations, slo)
+		}
+	}
+	return violations
+}
+
+// EvaluatePerformanceSLOs evaluates the SLOs against the runner results of a performance test, in the format of the
+// results of fortio which the results of the other load generators are converted to
+func EvaluatePerformanceSLOs(slos []PerformanceSLO, runnerResults map[string]interface{}) *PerformanceSLOReport {
+	metrics := performanceResultMetrics(runnerResults)
+	report := &PerformanceSLOReport{Passed: true, SLOs: []PerformanceSLOResult{}}
+	for _, slo := range slos {
+		result := PerformanceSLOResult{PerformanceSLO: slo}
+		if value, ok := metrics[slo.Metric]; ok {
+			result.Value = &value
+			result.Passed = slo.Met(value)
+		}
+		report.Passed = report.Passed && result.Passed
+		report.SLOs = append(report.SLOs, result)
+	}
+	return report
+}
+
+// performanceResultMetrics returns the metrics of the SLOs the runner results have
+func performanceResultMetrics(runnerResults map[string]interface{}) map[PerformanceSLOMetric]float64 {
+	var results struct {
+		ActualQPS float64
+		// RetCodes are the counts of the responses by status code, -1 for the requests which could not be sent
+		RetCodes          map[string]int64
+		DurationHistogram struct {
+			Count       int64
+			Max         float64
+			Percentiles []struct {
+				Percentile float64
+				Value      float64
+			}
+		}
+	}
+	metrics := map[PerformanceSLOMetric]float64{}
+	b, err := json.Marshal(runnerResults)
+	if err != nil || json.Unmarshal(b, &results) != nil {
+		return metrics
+	}
+
+	if results.DurationHistogram.Count > 0 {
+		// the durations are in seconds
+		metrics[SLOLatencyMax] = results.DurationHistogram.Max * 1000
+		for _, p := range results.DurationHistogram.Percentiles {
+			switch p.Percentile {
+			case 50:
+				metrics[SLOLatencyP50] = p.Value * 1000
+			case 90:
+				metrics[SLOLatencyP90] = p.Value * 1000
+			case 99:
+				metrics[SLOLatencyP99] = p.Value * 1000
+			case 99.9:
+				metrics[SLOLatencyP999] = p.Value * 1000
+			}
+		}
+		metrics[SLOQPS] = results.ActualQPS
+	}
+
+	var total, failed int64
+	for code, count := range results.RetCodes {
+		total += count
+		if status, err := strconv.Atoi(code); err != nil || status < 200 || status >= 300 {
+			failed += count
+		}
+	}
+	if total > 0 {
+		metrics[SLOErrorRate] = float64(failed) / float64(total) * 100
+	}
+	return metrics
+}
+
+// SLOReport returns the evaluation of the SLOs of the profile of the result, nil when the profile had no SLOs
+func (m *MesheryResult) SLOReport() *PerformanceSLOReport {
+	value, ok := m.Result[PerformanceSLOReportKey]
+	if !ok || value == nil {
+		return nil
+	}
+	if report, ok := value.(*PerformanceSLOReport); ok {
+		return report
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var report PerformanceSLOReport
+	if err := json.Unmarshal(b, &report); err != nil {
+		return nil
+	}
+	return &report
+}
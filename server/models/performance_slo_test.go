@@ -0,0 +1,121 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// fortioResults are runner results of fortio, with 4 failed requests out of 200
+const fortioResults = `{
+	"ActualQPS": 98.5,
+	"RetCodes": {"200": 196, "503": 3, "-1": 1},
+	"DurationHistogram": {
+		"Count": 200,
+		"Max": 0.4,
+		"Percentiles": [
+			{"Percentile": 50, "Value": 0.012},
+			{"Percentile": 90, "Value": 0.08},
+			{"Percentile": 99, "Value": 0.3125}
+		]
+	}
+}`
+
+func TestEvaluatePerformanceSLOs(t *testing.T) {
+	var runnerResults map[string]interface{}
+	if err := json.Unmarshal([]byte(fortioResults), &runnerResults); err != nil {
+		t.Fatal(err)
+	}
+	report := EvaluatePerformanceSLOs([]PerformanceSLO{
+		{Metric: SLOLatencyP50, Threshold: 20},
+		{Metric: SLOLatencyP99, Threshold: 250},
+		{Metric: SLOErrorRate, Threshold: 5},
+		{Metric: SLOQPS, Threshold: 100},
+		{Metric: SLOLatencyP999, Threshold: 1000},
+	}, runnerResults)
+	if report.Passed {
+		t.Fatal("the result passed")
+	}
+
+	want := map[PerformanceSLOMetric]struct {
+		value  float64
+		passed bool
+	}{
+		SLOLatencyP50: {12, true},
+		SLOLatencyP99: {312.5, false},
+		SLOErrorRate:  {2, true},
+		SLOQPS:        {98.5, false},
+	}
+	for _, slo := range report.SLOs {
+		w, ok := want[slo.Metric]
+		if !ok {
+			if slo.Value != nil || slo.Passed {
+				t.Errorf("the unmeasured SLO %s = %s, want not met", slo.Metric, slo)
+			}
+			continue
+		}
+		if slo.Value == nil || *slo.Value < w.value-1e-9 || *slo.Value > w.value+1e-9 || slo.Passed != w.passed {
+			t.Errorf("the SLO %s = %s, passed %t, want %g, passed %t", slo.Metric, slo, slo.Passed, w.value, w.passed)
+		}
+	}
+	if violations := report.Violations(); len(violations) != 3 {
+		t.Errorf("the violations are %v, want the p99, qps and p99.9 SLOs", violations)
+	}
+
+	if report := EvaluatePerformanceSLOs([]PerformanceSLO{{Metric: SLOLatencyP90, Threshold: 100}}, runnerResults); !report.Passed {
+		t.Errorf("the result did not pass %v", report.SLOs)
+	}
+}
+
+func TestPerformanceProfileSLOs(t *testing.T) {
+	profile := &PerformanceProfile{}
+	if slos, err := profile.GetSLOs(); err != nil || slos != nil {
+		t.Errorf("GetSLOs() of a profile without SLOs = %v, %v", slos, err)
+	}
+
+	// the metadata of the profiles read from the providers is generic JSON
+	if err := json.Unmarshal([]byte(`{"metadata": {"slos": [{"metric": "p99", "threshold": 250}]}}`), profile); err != nil {
+		t.Fatal(err)
+	}
+	slos, err := profile.GetSLOs()
+	if err != nil || len(slos) != 1 || slos[0] != (PerformanceSLO{Metric: SLOLatencyP99, Threshold: 250}) {
+		t.Errorf("GetSLOs() = %v, %v", slos, err)
+	}
+
+	for _, metadata := range []string{
+		`{"slos": "p99 < 250"}`,
+		`{"slos": [{"metric": "p95", "threshold": 250}]}`,
+		`{"slos": [{"metric": "p99", "threshold": -1}]}`,
+	} {
+		profile := &PerformanceProfile{}
+		if err := json.Unmarshal([]byte(metadata), &profile.Metadata); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := profile.GetSLOs(); err == nil {
+			t.Errorf("GetSLOs() of the metadata %s succeeded", metadata)
+		}
+	}
+}
+
+func TestMesheryResultSLOReport(t *testing.T) {
+	report := &PerformanceSLOReport{SLOs: []PerformanceSLOResult{{PerformanceSLO: PerformanceSLO{Metric: SLOErrorRate, Threshold: 1}}}}
+	result := &MesheryResult{Result: map[string]interface{}{PerformanceSLOReportKey: report}}
+	if result.SLOReport() != report {
+		t.Errorf("SLOReport() = %v, want %v", result.SLOReport(), report)
+	}
+
+	// the results read from the providers hold the report as generic JSON
+	b, err := json.Marshal(result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result = &MesheryResult{}
+	if err := json.Unmarshal(b, result); err != nil {
+		t.Fatal(err)
+	}
+	if got := result.SLOReport(); got == nil || got.Passed || len(got.SLOs) != 1 || got.SLOs[0].Metric != SLOErrorRate {
+		t.Errorf("SLOReport() of the decoded result = %+v", got)
+	}
+	if (&MesheryResult{Result: map[string]interface{}{}}).SLOReport() != nil {
+		t.Error("SLOReport() of a result without SLOs is not nil")
+	}
+}
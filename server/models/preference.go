@@ -69,6 +69,8 @@ type Preference struct {
 	UpdatedAt                 time.Time              `json:"updated_at,omitempty"`
 	UsersExtensionPreferences map[string]interface{} `json:"usersExtensionPreferences,omitempty"`
 	RemoteProviderPreferences map[string]interface{} `json:"remoteProviderPreferences,omitempty"`
+	// DriftAutoReconcile deploys the designs whose resources drifted from them again, when the drift is detected
+	DriftAutoReconcile bool `json:"driftAutoReconcile"`
 }
 
 func init() {
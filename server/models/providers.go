@@ -262,6 +262,8 @@ const (
 
 	CloneMesheryPatterns Feature = "clone-meshery-patterns" // /patterns/clone
 
+	MesheryPatternRevisions Feature = "meshery-pattern-revisions" // /patterns/revisions
+
 	CloneMesheryFilters Feature = "clone-meshery-filters" // /filters/clone
 
 	ShareDesigns Feature = "share-designs"
@@ -298,6 +300,18 @@ const (
 	// UserPrefsCtxKey is the context key for persisting user preferences to context
 	PerfObjCtxKey ContextKey = "perf_obj"
 
+	// AuditRecordCtxKey is the context key of the entry of the audit log of the request
+	AuditRecordCtxKey ContextKey = "auditrecord"
+
+	// APITokenCtxKey is the context key of the API token the request is authenticated with, if any
+	APITokenCtxKey ContextKey = "apitoken"
+
+	// AuthorizerCtxKey is the context key of the Authorizer of the user of the request
+	AuthorizerCtxKey ContextKey = "authorizer"
+
+	// OrgIDCtxKey is the context key of the organization of the user of the request, empty when the user has none
+	OrgIDCtxKey ContextKey = "orgid"
+
 	KubeClustersKey   ContextKey = "kubeclusters"
 	AllKubeClusterKey ContextKey = "allkubeclusters"
 
@@ -337,6 +351,13 @@ func (caps Capabilities) GetEndpointForFeature(feature Feature) (string, bool) {
 	return "", false
 }
 
+// OrgScopedProvider is implemented by the providers whose users belong to an organization,
+// resources registered by a user of such a provider can be scoped to the user's organization.
+type OrgScopedProvider interface {
+	// GetOrgID returns the organization of the user the request is made by, empty when the user does not belong to one
+	GetOrgID(req *http.Request) (string, error)
+}
+
 // Provider - interface for providers
 type Provider interface {
 	PreferencePersister
@@ -408,6 +429,8 @@ type Provider interface {
 	DeleteMesheryPatterns(req *http.Request, patterns MesheryPatternDeleteRequestBody) ([]byte, error)
 	CloneMesheryPattern(req *http.Request, patternID string, clonePatternRequest *MesheryClonePatternRequestBody) ([]byte, error)
 	GetMesheryPattern(req *http.Request, patternID string) ([]byte, error)
+	GetMesheryPatternRevisions(req *http.Request, patternID string) ([]byte, error)
+	RestoreMesheryPatternRevision(req *http.Request, patternID string, revision int) ([]byte, error)
 	RemotePatternFile(req *http.Request, resourceURL, path string, save bool) ([]byte, error)
 	SaveMesheryPatternResource(token string, resource *PatternResource) (*PatternResource, error)
 	GetMesheryPatternResource(token, resourceID string) (*PatternResource, error)
@@ -450,6 +473,7 @@ type Provider interface {
 	GetConnections(req *http.Request, userID string, page, pageSize int, search, order string) (*ConnectionPage, error)
 	GetConnectionsByKind(req *http.Request, userID string, page, pageSize int, search, order, connectionKind string) (*map[string]interface{}, error)
 	GetConnectionsStatus(req *http.Request, userID string) (*ConnectionsStatusPage, error)
+	GetConnectionByID(req *http.Request, connectionID uuid.UUID) (*Connection, error)
 	UpdateConnection(req *http.Request, conn *Connection) (*Connection, error)
 	UpdateConnectionById(req *http.Request, conn *ConnectionPayload, connId string) (*Connection, error)
 	DeleteConnection(req *http.Request, connID uuid.UUID) (*Connection, error)
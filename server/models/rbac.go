@@ -0,0 +1,177 @@
+package models
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// Role is the role of a user, granting the permissions of the lower roles along with its own
+type Role string
+
+// Roles of the users of Meshery Server, from the lowest to the highest
+const (
+	// ViewerRole reads the designs, the clusters and the results without modifying them
+	ViewerRole Role = "viewer"
+	// OperatorRole edits and deploys the designs, the filters and the applications, and runs the performance tests
+	OperatorRole Role = "operator"
+	// AdminRole manages Meshery Server along with the roles of the users
+	AdminRole Role = "admin"
+)
+
+// Permission is an action on the API of Meshery Server the roles grant
+type Permission string
+
+// Permissions granted by the roles, see RolePermissions
+const (
+	// ViewPermission reads the entities, and runs the analyses of the designs which do not modify them, eg: lint and cost
+	ViewPermission Permission = "view"
+	// EditPermission creates, updates and deletes the designs, the filters, the applications, the models and the profiles
+	EditPermission Permission = "edit"
+	// DeployPermission deploys and undeploys the designs, connects the clusters and runs the performance tests
+	DeployPermission Permission = "deploy"
+	// ManageSystemPermission reads the audit log and the logs of Meshery Server, and resets its database
+	ManageSystemPermission Permission = "manage_system"
+	// ManageRolesPermission binds the roles to the users
+	ManageRolesPermission Permission = "manage_roles"
+)
+
+// roles are the roles from the lowest to the highest, with the permissions each of them adds to the lower ones
+var roles = []struct {
+	role        Role
+	permissions []Permission
+}{
+	{ViewerRole, []Permission{ViewPermission}},
+	{OperatorRole, []Permission{EditPermission, DeployPermission}},
+	{AdminRole, []Permission{ManageSystemPermission, ManageRolesPermission}},
+}
+
+// AllWorkspaces is the workspace of the bindings of the roles applying to every workspace
+const AllWorkspaces = ""
+
+// WorkspaceHeader is the header of the requests telling the workspace they act in, the workspace query parameter can be used instead
+const WorkspaceHeader = "X-Meshery-Workspace"
+
+// ParseRole returns the role of the name, whatever its case
+func ParseRole(name string) (Role, error) {
+	role := Role(strings.ToLower(strings.TrimSpace(name)))
+	if role.rank() < 0 {
+		return "", ErrInvalidRole(name)
+	}
+	return role, nil
+}
+
+// rank returns the position of the role from the lowest role, -1 if the role is unknown
+func (r Role) rank() int {
+	for i, def := range roles {
+		if def.role == r {
+			return i
+		}
+	}
+	return -1
+}
+
+// Permissions returns the permissions granted by the role, none if the role is unknown
+func (r Role) Permissions() []Permission {
+	permissions := []Permission{}
+	for i := 0; i <= r.rank(); i++ {
+		permissions = append(permissions, roles[i].permissions...)
+	}
+	return permissions
+}
+
+// Can reports whether the role grants the permission
+func (r Role) Can(permission Permission) bool {
+	for _, p := range r.Permissions() {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// RoleDefinition is a role along with the permissions it grants
+type RoleDefinition struct {
+	Role        Role         `json:"role"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// RoleDefinitions returns the roles from the lowest to the highest, with their permissions
+func RoleDefinitions() []RoleDefinition {
+	definitions := make([]RoleDefinition, 0, len(roles))
+	for _, def := range roles {
+		definitions = append(definitions, RoleDefinition{Role: def.role, Permissions: def.role.Permissions()})
+	}
+	return definitions
+}
+
+// RoleBinding grants a role to a user in a workspace, or in every workspace if its workspace is AllWorkspaces
+type RoleBinding struct {
+	ID     uuid.UUID `json:"id" gorm:"primaryKey"`
+	UserID string    `json:"user_id" gorm:"uniqueIndex:idx_role_binding_user_workspace"`
+	// Workspace is the workspace the role is granted in, empty for every workspace
+	Workspace string `json:"workspace" gorm:"uniqueIndex:idx_role_binding_user_workspace"`
+	Role      Role   `json:"role"`
+	// CreatedBy is the ID of the user who bound the role
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// RoleBindingRequestBody is the body of the requests binding a role to a user
+type RoleBindingRequestBody struct {
+	UserID    string `json:"user_id"`
+	Workspace string `json:"workspace"`
+	Role      string `json:"role"`
+}
+
+// UserPermissions are the role and the permissions of a user in a workspace, the UI hides the actions they do not grant
+type UserPermissions struct {
+	UserID    string `json:"user_id"`
+	Workspace string `json:"workspace"`
+	Role      Role   `json:"role"`
+	// Enforced is false when the roles are not enforced, every user is then granted every permission
+	Enforced    bool         `json:"enforced"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// EffectiveRole returns the highest of the roles of the user in the workspace: the roles of its bindings to the workspace
+// and to every workspace, and the roles among the role names of the user given by the provider. The users with none of
+// them have the default role.
+func EffectiveRole(user *User, bindings []RoleBinding, workspace string, defaultRole Role) Role {
+	candidates := []Role{}
+	for _, b := range bindings {
+		if b.UserID == user.ID && (b.Workspace == AllWorkspaces || b.Workspace == workspace) {
+			candidates = append(candidates, b.Role)
+		}
+	}
+	for _, name := range user.RoleNames {
+		if role, err := ParseRole(name); err == nil {
+			candidates = append(candidates, role)
+		}
+	}
+	if len(candidates) == 0 {
+		return defaultRole
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].rank() > candidates[j].rank()
+	})
+	return candidates[0]
+}
+
+// Authorizer returns an error when the user of the request it was created for is not granted the permission, in the
+// workspace of the request and by the scopes of its API token if any
+type Authorizer func(Permission) error
+
+// Authorize returns an error when the user of the context is not granted the permission, the contexts of the calls
+// which were not authenticated with the session of a user grant no permission
+func Authorize(ctx context.Context, permission Permission) error {
+	authorizer, ok := ctx.Value(AuthorizerCtxKey).(Authorizer)
+	if !ok || authorizer == nil {
+		return ErrUnauthorizedContext(permission)
+	}
+	return authorizer(permission)
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+	"gorm.io/gorm/clause"
+)
+
+// RoleBindingPersister is the persister for the bindings of the roles to the users
+type RoleBindingPersister struct {
+	DB *database.Handler
+}
+
+// SaveRoleBinding binds the role to the user in the workspace, replacing the role the user had in the workspace
+func (rbp *RoleBindingPersister) SaveRoleBinding(binding *RoleBinding) error {
+	if binding.ID == uuid.Nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+		binding.ID = id
+	}
+	err := rbp.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "workspace"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role", "created_by", "updated_at"}),
+	}).Create(binding).Error
+	if err != nil {
+		return err
+	}
+	// the binding replaced keeps its ID
+	return rbp.DB.Where("user_id = ? AND workspace = ?", binding.UserID, binding.Workspace).First(binding).Error
+}
+
+// GetRoleBindings returns the bindings of the user, of every user if userID is empty, in the workspace and in every
+// workspace, in every workspace if workspace is empty
+func (rbp *RoleBindingPersister) GetRoleBindings(userID, workspace string) ([]RoleBinding, error) {
+	query := rbp.DB.Model(&RoleBinding{})
+	if userID != "" {
+		query = query.Where("user_id = ?", userID)
+	}
+	if workspace != "" {
+		query = query.Where("workspace IN ?", []string{AllWorkspaces, workspace})
+	}
+	bindings := []RoleBinding{}
+	if err := query.Order("user_id, workspace").Find(&bindings).Error; err != nil {
+		return nil, err
+	}
+	return bindings, nil
+}
+
+// DeleteRoleBinding deletes the binding, and returns whether it existed
+func (rbp *RoleBindingPersister) DeleteRoleBinding(id uuid.UUID) (bool, error) {
+	result := rbp.DB.Delete(&RoleBinding{ID: id})
+	return result.RowsAffected > 0, result.Error
+}
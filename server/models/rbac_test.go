@@ -0,0 +1,82 @@
+package models
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestRolePermissions(t *testing.T) {
+	tests := []struct {
+		role Role
+		want []Permission
+	}{
+		{ViewerRole, []Permission{ViewPermission}},
+		{OperatorRole, []Permission{ViewPermission, EditPermission, DeployPermission}},
+		{AdminRole, []Permission{ViewPermission, EditPermission, DeployPermission, ManageSystemPermission, ManageRolesPermission}},
+		{"owner", []Permission{}},
+	}
+	for _, tt := range tests {
+		if got := tt.role.Permissions(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%q.Permissions() = %v, want %v", tt.role, got, tt.want)
+		}
+	}
+	if ViewerRole.Can(EditPermission) || !OperatorRole.Can(DeployPermission) || OperatorRole.Can(ManageRolesPermission) {
+		t.Error("the roles grant the wrong permissions")
+	}
+}
+
+func TestParseRole(t *testing.T) {
+	if role, err := ParseRole(" Operator "); err != nil || role != OperatorRole {
+		t.Errorf("ParseRole(\" Operator \") = %q, %v", role, err)
+	}
+	if _, err := ParseRole("owner"); err == nil {
+		t.Error("ParseRole(\"owner\") did not fail")
+	}
+}
+
+func TestEffectiveRole(t *testing.T) {
+	bindings := []RoleBinding{
+		{UserID: "alice", Workspace: AllWorkspaces, Role: ViewerRole},
+		{UserID: "alice", Workspace: "staging", Role: OperatorRole},
+		{UserID: "bob", Workspace: "staging", Role: AdminRole},
+	}
+	tests := []struct {
+		name      string
+		user      *User
+		workspace string
+		want      Role
+	}{
+		{"binding to every workspace", &User{ID: "alice"}, "production", ViewerRole},
+		{"binding to the workspace", &User{ID: "alice"}, "staging", OperatorRole},
+		{"no workspace", &User{ID: "alice"}, AllWorkspaces, ViewerRole},
+		{"default role", &User{ID: "carol"}, "staging", ViewerRole},
+		{"binding to another workspace", &User{ID: "bob"}, "production", ViewerRole},
+		{"role of the provider", &User{ID: "alice", RoleNames: []string{"meshmap", "admin"}}, "production", AdminRole},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EffectiveRole(tt.user, bindings, tt.workspace, ViewerRole); got != tt.want {
+				t.Errorf("EffectiveRole() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	if err := Authorize(context.Background(), ViewPermission); err == nil {
+		t.Error("Authorize() without the authorizer of a user succeeded")
+	}
+	ctx := context.WithValue(context.Background(), AuthorizerCtxKey, Authorizer(func(permission Permission) error {
+		if !ViewerRole.Can(permission) {
+			return ErrInvalidAPITokenScope(permission)
+		}
+		return nil
+	}))
+	if err := Authorize(ctx, ViewPermission); err != nil {
+		t.Errorf("Authorize(view) = %v", err)
+	}
+	if err := Authorize(ctx, DeployPermission); err == nil {
+		t.Error("Authorize(deploy) of a viewer succeeded")
+	}
+}
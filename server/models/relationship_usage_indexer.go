@@ -0,0 +1,146 @@
+package models
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+	"github.com/layer5io/meshkit/models/meshmodel/core/v1alpha1"
+	meshmodelregistry "github.com/layer5io/meshkit/models/meshmodel/registry"
+)
+
+// RelationshipUsageReport is the outcome of indexing the saved designs against the registered relationships
+type RelationshipUsageReport struct {
+	IndexedAt *time.Time `json:"indexed_at"`
+	// Number of designs indexed
+	Designs int `json:"designs"`
+	// Number of designs using at least one relationship of the kind, keyed by relationship kind
+	ByKind   map[string]int `json:"by_kind"`
+	Page     int            `json:"page"`
+	PageSize int            `json:"page_size"`
+	// Number of relationships reported, Relationships being the requested page of them
+	Count         int64                         `json:"total_count"`
+	Relationships []meshmodel.RelationshipUsage `json:"relationships"`
+}
+
+// RelationshipUsageIndexer periodically indexes the designs saved in Meshery Database against the selectors of
+// the registered relationships, so that relationship definitions not used by any design can be identified.
+type RelationshipUsageIndexer struct {
+	db       *database.Handler
+	registry *meshmodelregistry.RegistryManager
+	log      logger.Handler
+
+	mx        sync.RWMutex
+	indexedAt *time.Time
+	designs   int
+	usage     []meshmodel.RelationshipUsage
+	// the relationships used by every design, so that the designs using the relationships visible to an organization
+	// can be counted by kind
+	designUsage [][]meshmodel.RelationshipUsage
+}
+
+func NewRelationshipUsageIndexer(db *database.Handler, registry *meshmodelregistry.RegistryManager, log logger.Handler) *RelationshipUsageIndexer {
+	return &RelationshipUsageIndexer{
+		db:       db,
+		registry: registry,
+		log:      log,
+	}
+}
+
+// Run indexes the designs immediately and then at every interval until ctx is cancelled
+func (rui *RelationshipUsageIndexer) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := rui.Index(); err != nil {
+			rui.log.Error(ErrIndexRelationshipUsage(err))
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Index rebuilds the relationship usage report from the saved designs.
+// Designs which cannot be parsed are skipped.
+func (rui *RelationshipUsageIndexer) Index() error {
+	var patterns []MesheryPattern
+	if err := rui.db.Model(&MesheryPattern{}).Select("id, pattern_file").Find(&patterns).Error; err != nil {
+		return err
+	}
+
+	designs := make([][]meshmodel.ComponentRef, 0, len(patterns))
+	for _, p := range patterns {
+		pattern, err := core.NewPatternFile([]byte(p.PatternFile))
+		if err != nil {
+			continue
+		}
+		designs = append(designs, meshmodel.DesignComponents(pattern))
+	}
+
+	entities, _, _ := rui.registry.GetEntities(&v1alpha1.RelationshipFilter{})
+	rels := make([]v1alpha1.RelationshipDefinition, 0, len(entities))
+	for _, entity := range entities {
+		if rel, ok := entity.(v1alpha1.RelationshipDefinition); ok {
+			rels = append(rels, rel)
+		}
+	}
+
+	usage := meshmodel.CountRelationshipUsage(rels, designs)
+	designUsage := make([][]meshmodel.RelationshipUsage, 0, len(designs))
+	for _, comps := range designs {
+		var used []meshmodel.RelationshipUsage
+		for _, u := range meshmodel.CountRelationshipUsage(rels, [][]meshmodel.ComponentRef{comps}) {
+			if u.Designs > 0 {
+				used = append(used, u)
+			}
+		}
+		designUsage = append(designUsage, used)
+	}
+
+	now := time.Now()
+	rui.mx.Lock()
+	defer rui.mx.Unlock()
+	rui.indexedAt = &now
+	rui.designs = len(designs)
+	rui.usage = usage
+	rui.designUsage = designUsage
+	return nil
+}
+
+// Report returns the outcome of the latest indexing for the members of the organization, only the usage of the
+// relationships visible to them, the global ones and those of the organization, is reported
+func (rui *RelationshipUsageIndexer) Report(orgID string) RelationshipUsageReport {
+	rui.mx.RLock()
+	defer rui.mx.RUnlock()
+	report := RelationshipUsageReport{
+		IndexedAt:     rui.indexedAt,
+		Designs:       rui.designs,
+		ByKind:        map[string]int{},
+		Relationships: []meshmodel.RelationshipUsage{},
+	}
+	for _, u := range rui.usage {
+		if meshmodel.RelationshipVisibleTo(u.Org, orgID) {
+			report.Relationships = append(report.Relationships, u)
+		}
+	}
+	report.Count = int64(len(report.Relationships))
+	for _, used := range rui.designUsage {
+		kinds := make(map[string]bool)
+		for _, u := range used {
+			if meshmodel.RelationshipVisibleTo(u.Org, orgID) {
+				kinds[u.Kind] = true
+			}
+		}
+		for kind := range kinds {
+			report.ByKind[kind]++
+		}
+	}
+	return report
+}
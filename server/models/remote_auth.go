@@ -15,6 +15,8 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt"
+	"github.com/layer5io/meshery/server/internal/metrics"
+	"github.com/layer5io/meshery/server/internal/tracing"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	"golang.org/x/oauth2"
@@ -95,13 +97,16 @@ func (l *RemoteProvider) doRequestHelper(req *http.Request, tokenString string)
 	if err != nil {
 		return nil, ErrTokenDecode(err)
 	}
-	c := &http.Client{}
+	c := &http.Client{Transport: tracing.Transport(nil)}
 	req.Header.Set("Authorization", fmt.Sprintf("bearer %s", token.AccessToken))
 	req.Header.Set("SystemID", viper.GetString("INSTANCE_ID")) // Adds the system id to the header for event tracking
+	start := time.Now()
 	resp, err := c.Do(req)
 	if err != nil {
+		metrics.ObserveProviderRequest(l.ProviderName, req.Method, 0, time.Since(start))
 		return nil, ErrTokenClientCheck(err)
 	}
+	metrics.ObserveProviderRequest(l.ProviderName, req.Method, resp.StatusCode, time.Since(start))
 	return resp, nil
 }
 
@@ -271,6 +276,20 @@ func (l *RemoteProvider) VerifyToken(tokenString string) (*jwt.MapClaims, error)
 	return &claims, nil
 }
 
+// GetOrgID - returns the organization claimed by the provider token of the request
+func (l *RemoteProvider) GetOrgID(req *http.Request) (string, error) {
+	ts, err := l.GetToken(req)
+	if err != nil {
+		return "", err
+	}
+	claims, err := l.VerifyToken(ts)
+	if err != nil {
+		return "", err
+	}
+	orgID, _ := (*claims)["org_id"].(string)
+	return orgID, nil
+}
+
 func (l *RemoteProvider) revokeToken(tokenString string) error {
 	jsonData := make(map[string]string)
 	token, err := l.DecodeTokenData(tokenString)
@@ -1780,6 +1780,73 @@ func (l *RemoteProvider) CloneMesheryPattern(req *http.Request, patternID string
 	return nil, fmt.Errorf("error while cloning design - Status code: %d, Body: %s", resp.StatusCode, bdr)
 }
 
+// GetMesheryPatternRevisions gets the revisions of the pattern for the given patternID
+func (l *RemoteProvider) GetMesheryPatternRevisions(req *http.Request, patternID string) ([]byte, error) {
+	if !l.Capabilities.IsSupported(MesheryPatternRevisions) {
+		logrus.Error("operation not available")
+		return nil, ErrInvalidCapability("MesheryPatternRevisions", l.ProviderName)
+	}
+
+	ep, _ := l.Capabilities.GetEndpointForFeature(MesheryPatternRevisions)
+
+	logrus.Infof("attempting to fetch design revisions from cloud for id: %s", patternID)
+
+	remoteProviderURL, _ := url.Parse(fmt.Sprintf("%s%s/%s", l.RemoteProviderURL, ep, patternID))
+	logrus.Debugf("constructed design revisions url: %s", remoteProviderURL.String())
+	cReq, _ := http.NewRequest(http.MethodGet, remoteProviderURL.String(), nil)
+
+	return l.doPatternRevisionsRequest(req, cReq, patternID)
+}
+
+// RestoreMesheryPatternRevision restores the pattern for the given patternID to the given revision
+func (l *RemoteProvider) RestoreMesheryPatternRevision(req *http.Request, patternID string, revision int) ([]byte, error) {
+	if !l.Capabilities.IsSupported(MesheryPatternRevisions) {
+		logrus.Error("operation not available")
+		return nil, ErrInvalidCapability("MesheryPatternRevisions", l.ProviderName)
+	}
+
+	ep, _ := l.Capabilities.GetEndpointForFeature(MesheryPatternRevisions)
+
+	logrus.Infof("attempting to restore revision %d of design with id: %s", revision, patternID)
+
+	remoteProviderURL, _ := url.Parse(fmt.Sprintf("%s%s/%s/%d/restore", l.RemoteProviderURL, ep, patternID, revision))
+	logrus.Debugf("constructed design revision url: %s", remoteProviderURL.String())
+	cReq, _ := http.NewRequest(http.MethodPost, remoteProviderURL.String(), nil)
+
+	return l.doPatternRevisionsRequest(req, cReq, patternID)
+}
+
+func (l *RemoteProvider) doPatternRevisionsRequest(req, cReq *http.Request, patternID string) ([]byte, error) {
+	tokenString, err := l.GetToken(req)
+	if err != nil {
+		logrus.Errorf("unable to get design revisions: %v", err)
+		return nil, err
+	}
+	resp, err := l.DoRequest(cReq, tokenString)
+	if err != nil {
+		if resp == nil {
+			return nil, ErrUnreachableRemoteProvider(err)
+		}
+		logrus.Errorf("unable to get design revisions: %v", err)
+		return nil, ErrFetch(err, "design revisions:"+patternID, resp.StatusCode)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	bdr, err := io.ReadAll(resp.Body)
+	if err != nil {
+		logrus.Errorf("unable to read response body: %v", err)
+		return nil, ErrDataRead(err, "design revisions:"+patternID)
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		logrus.Infof("design revisions successfully retrieved from remote provider")
+		return bdr, nil
+	}
+	logrus.Errorf("error while fetching design revisions: %s", bdr)
+	return nil, ErrFetch(fmt.Errorf("could not retrieve design revisions from remote provider"), fmt.Sprint(bdr), resp.StatusCode)
+}
+
 // PublishMesheryPattern publishes a meshery pattern with the given id to catalog
 func (l *RemoteProvider) PublishCatalogPattern(req *http.Request, publishPatternRequest *MesheryCatalogPatternRequestBody) ([]byte, error) {
 	if !l.Capabilities.IsSupported(MesheryPatternsCatalog) {
@@ -3688,6 +3755,46 @@ func (l *RemoteProvider) GetConnectionsStatus(req *http.Request, userID string)
 	return &cp, nil
 }
 
+// GetConnectionByID - to get a saved connection using the connection id
+func (l *RemoteProvider) GetConnectionByID(req *http.Request, connectionID uuid.UUID) (*Connection, error) {
+	if !l.Capabilities.IsSupported(PersistConnection) {
+		logrus.Error("operation not available")
+		return nil, ErrInvalidCapability("PersistConnection", l.ProviderName)
+	}
+	ep, _ := l.Capabilities.GetEndpointForFeature(PersistConnection)
+	remoteProviderURL, _ := url.Parse(fmt.Sprintf("%s%s/%s", l.RemoteProviderURL, ep, connectionID))
+	logrus.Debugf("Making request to : %s", remoteProviderURL.String())
+	cReq, _ := http.NewRequest(http.MethodGet, remoteProviderURL.String(), nil)
+	tokenString, err := l.GetToken(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.DoRequest(cReq, tokenString)
+	if err != nil {
+		if resp == nil {
+			return nil, ErrUnreachableRemoteProvider(err)
+		}
+		return nil, ErrFetch(err, "Connection", resp.StatusCode)
+	}
+	defer resp.Body.Close()
+
+	bdr, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ErrDataRead(err, "Connection")
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var conn Connection
+		if err = json.Unmarshal(bdr, &conn); err != nil {
+			return nil, ErrUnmarshal(err, "connection")
+		}
+		return &conn, nil
+	}
+
+	return nil, ErrFetch(fmt.Errorf("failed to get the connection"), string(bdr), resp.StatusCode)
+}
+
 // UpdateConnection - to update an existing connection
 func (l *RemoteProvider) UpdateConnection(req *http.Request, connection *Connection) (*Connection, error) {
 	if !l.Capabilities.IsSupported(PersistConnection) {
@@ -0,0 +1,125 @@
+package models
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/errors"
+)
+
+// InFlightDeployment is a design deployment running while Meshery Server shuts down
+type InFlightDeployment struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	StartedAt time.Time `json:"started_at"`
+
+	cancel context.CancelCauseFunc
+	done   chan struct{}
+}
+
+// ShutdownManager drains the design deployments when Meshery Server shuts down.
+// Once draining starts no new deployment is accepted, the running ones get a deadline to finish,
+// past which they are cancelled so that they stop at their next checkpoint and can be resumed after the restart.
+type ShutdownManager struct {
+	// time the cancelled deployments are given to reach their checkpoint
+	checkpointGrace time.Duration
+
+	mx          sync.Mutex
+	draining    bool
+	deployments map[uuid.UUID]*InFlightDeployment
+}
+
+// NewShutdownManager returns a manager giving the deployments cancelled by Drain checkpointGrace to return
+func NewShutdownManager(checkpointGrace time.Duration) *ShutdownManager {
+	return &ShutdownManager{
+		checkpointGrace: checkpointGrace,
+		deployments:     make(map[uuid.UUID]*InFlightDeployment),
+	}
+}
+
+// Track registers the deployment with the ID as running until the returned function is called.
+// The deployment must run with the returned context, which is cancelled with ErrServerShuttingDown as cause
+// when the deployment outlives the deadline of the drain. Track fails with ErrServerShuttingDown once draining started.
+func (sm *ShutdownManager) Track(ctx context.Context, id uuid.UUID, name string) (context.Context, func(), error) {
+	sm.mx.Lock()
+	defer sm.mx.Unlock()
+	if sm.draining {
+		return nil, nil, ErrServerShuttingDown()
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	d := &InFlightDeployment{ID: id, Name: name, StartedAt: time.Now(), cancel: cancel, done: make(chan struct{})}
+	sm.deployments[id] = d
+	var once sync.Once
+	return ctx, func() {
+		once.Do(func() {
+			sm.mx.Lock()
+			delete(sm.deployments, id)
+			sm.mx.Unlock()
+			cancel(nil)
+			close(d.done)
+		})
+	}, nil
+}
+
+// Draining reports whether Meshery Server is shutting down
+func (sm *ShutdownManager) Draining() bool {
+	sm.mx.Lock()
+	defer sm.mx.Unlock()
+	return sm.draining
+}
+
+// InFlight returns the deployments running, in no particular order
+func (sm *ShutdownManager) InFlight() []InFlightDeployment {
+	sm.mx.Lock()
+	defer sm.mx.Unlock()
+	deployments := make([]InFlightDeployment, 0, len(sm.deployments))
+	for _, d := range sm.deployments {
+		deployments = append(deployments, InFlightDeployment{ID: d.ID, Name: d.Name, StartedAt: d.StartedAt})
+	}
+	return deployments
+}
+
+// Drain stops accepting deployments and waits for the running ones to finish until ctx is done.
+// The deployments still running are then cancelled and given the checkpoint grace period to return.
+// The deployments which did not return by then are returned, their state is the one of their last checkpoint.
+func (sm *ShutdownManager) Drain(ctx context.Context) []InFlightDeployment {
+	sm.mx.Lock()
+	sm.draining = true
+	running := make([]*InFlightDeployment, 0, len(sm.deployments))
+	for _, d := range sm.deployments {
+		running = append(running, d)
+	}
+	sm.mx.Unlock()
+
+	if waitDeployments(ctx, running) {
+		return nil
+	}
+	for _, d := range running {
+		d.cancel(ErrServerShuttingDown())
+	}
+	graceCtx, cancel := context.WithTimeout(context.Background(), sm.checkpointGrace)
+	defer cancel()
+	waitDeployments(graceCtx, running)
+	return sm.InFlight()
+}
+
+// InterruptedByShutdown reports whether the context returned by Track was cancelled by the drain
+func InterruptedByShutdown(ctx context.Context) bool {
+	cause, ok := context.Cause(ctx).(*errors.Error)
+	return ok && cause.Code == ErrServerShuttingDownCode
+}
+
+// waitDeployments waits for the deployments to return until ctx is done and reports whether all of them did
+func waitDeployments(ctx context.Context, deployments []*InFlightDeployment) bool {
+	for _, d := range deployments {
+		select {
+		case <-d.done:
+		case <-ctx.Done():
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/errors"
+)
+
+func TestShutdownManagerDrain(t *testing.T) {
+	sm := NewShutdownManager(time.Second)
+	_, done, err := sm.Track(context.Background(), uuid.Must(uuid.NewV4()), "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sm.InFlight()) != 1 {
+		t.Fatalf("the deployments in flight are %+v, want the tracked one", sm.InFlight())
+	}
+
+	drained := make(chan []InFlightDeployment)
+	go func() { drained <- sm.Drain(context.Background()) }()
+	done()
+	done()
+	if interrupted := <-drained; len(interrupted) != 0 {
+		t.Errorf("the deployments which finished during the drain are returned as interrupted: %+v", interrupted)
+	}
+	if !sm.Draining() {
+		t.Error("Meshery Server is not draining once Drain returned")
+	}
+	if _, _, err := sm.Track(context.Background(), uuid.Must(uuid.NewV4()), "db"); errors.GetCode(err) != ErrServerShuttingDownCode {
+		t.Errorf("a deployment started during the drain returned %v, want ErrServerShuttingDown", err)
+	}
+}
+
+func TestShutdownManagerDrainDeadline(t *testing.T) {
+	sm := NewShutdownManager(50 * time.Millisecond)
+	// the deployment returns at its next checkpoint once it is cancelled
+	checkpointed, doneCheckpointed, err := sm.Track(context.Background(), uuid.Must(uuid.NewV4()), "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	interruptedByShutdown := make(chan bool, 1)
+	go func() {
+		<-checkpointed.Done()
+		interruptedByShutdown <- InterruptedByShutdown(checkpointed)
+		doneCheckpointed()
+	}()
+	// the deployment does not return within the checkpoint grace period
+	stuckID := uuid.Must(uuid.NewV4())
+	stuck, doneStuck, err := sm.Track(context.Background(), stuckID, "db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer doneStuck()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	interrupted := sm.Drain(ctx)
+	if len(interrupted) != 1 || interrupted[0].ID != stuckID {
+		t.Errorf("the interrupted deployments are %+v, want the one which did not reach its checkpoint", interrupted)
+	}
+	if !<-interruptedByShutdown {
+		t.Error("the deployment cancelled by the drain is not reported as interrupted by the shutdown")
+	}
+	if !InterruptedByShutdown(stuck) {
+		t.Error("the context of the deployment still running was not cancelled by the drain")
+	}
+}
+
+func TestInterruptedByShutdown(t *testing.T) {
+	sm := NewShutdownManager(time.Second)
+	ctx, done, err := sm.Track(context.Background(), uuid.Must(uuid.NewV4()), "web")
+	if err != nil {
+		t.Fatal(err)
+	}
+	done()
+	if InterruptedByShutdown(ctx) {
+		t.Error("the deployment which finished is reported as interrupted by the shutdown")
+	}
+	parent, cancel := context.WithCancel(context.Background())
+	ctx, done, err = sm.Track(parent, uuid.Must(uuid.NewV4()), "db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer done()
+	cancel()
+	if InterruptedByShutdown(ctx) {
+		t.Error("the deployment cancelled by its request is reported as interrupted by the shutdown")
+	}
+}
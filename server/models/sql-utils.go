@@ -10,6 +10,8 @@ import (
 	"github.com/layer5io/meshkit/logger"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
 )
 
 // sanitizeOrderInput takes in the "order by" query, a validColums
@@ -60,16 +62,56 @@ func setNewDBInstance() {
 		os.Exit(1)
 	}
 
-	dbHandler, err = database.New(database.Options{
-		Filename: fmt.Sprintf("file:%s/mesherydb.sql?cache=private&mode=rwc&_busy_timeout=10000&_journal_mode=WAL", viper.GetString("USER_DATA_FOLDER")),
-		Engine:   database.SQLITE,
-		Logger:   log,
-	})
+	switch engine := viper.GetString("DB_ENGINE"); engine {
+	case "", database.SQLITE:
+		dbHandler, err = database.New(database.Options{
+			Filename: fmt.Sprintf("file:%s/mesherydb.sql?cache=private&mode=rwc&_busy_timeout=10000&_journal_mode=WAL", viper.GetString("USER_DATA_FOLDER")),
+			Engine:   database.SQLITE,
+			Logger:   log,
+		})
+	case database.POSTGRES:
+		dbHandler, err = newPostgresHandler(log)
+	default:
+		err = fmt.Errorf("unsupported database engine %q, the supported engines are %s and %s", engine, database.SQLITE, database.POSTGRES)
+	}
 	if err != nil {
 		logrus.Fatal(err)
 	}
 }
 
+// newPostgresHandler connects to the PostgreSQL database configured with DB_DSN, or with DB_HOST, DB_PORT, DB_USER,
+// DB_PASSWORD, DB_NAME and DB_SSLMODE, so that the replicas of Meshery Server share their state
+func newPostgresHandler(log logger.Handler) (database.Handler, error) {
+	dsn := viper.GetString("DB_DSN")
+	if dsn == "" {
+		dsn = fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+			viper.GetString("DB_HOST"),
+			viper.GetString("DB_PORT"),
+			viper.GetString("DB_USER"),
+			viper.GetString("DB_PASSWORD"),
+			viper.GetString("DB_NAME"),
+			viper.GetString("DB_SSLMODE"),
+		)
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: log.DatabaseLogger()})
+	if err != nil {
+		return database.Handler{}, database.ErrDatabaseOpen(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return database.Handler{}, database.ErrDatabaseOpen(err)
+	}
+	sqlDB.SetMaxOpenConns(viper.GetInt("DB_MAX_OPEN_CONNS"))
+	sqlDB.SetMaxIdleConns(viper.GetInt("DB_MAX_IDLE_CONNS"))
+	sqlDB.SetConnMaxLifetime(viper.GetDuration("DB_CONN_MAX_LIFETIME"))
+	return database.Handler{DB: db, Mutex: &sync.Mutex{}}, nil
+}
+
+// IsPostgres reports whether the database is PostgreSQL rather than SQLite, for the queries whose SQL differs between them
+func IsPostgres(db *gorm.DB) bool {
+	return db.Dialector.Name() == database.POSTGRES
+}
+
 func GetNewDBInstance() *database.Handler {
 	setNewDBInstance()
 	return &dbHandler
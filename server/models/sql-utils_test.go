@@ -0,0 +1,104 @@
+package models
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+	"github.com/spf13/viper"
+)
+
+// testDatabases returns the databases the queries are tested against: a SQLite database in a temporary directory,
+// and the PostgreSQL database of MESHERY_TEST_POSTGRES_DSN when it is set
+func testDatabases(t *testing.T) map[string]*database.Handler {
+	t.Helper()
+	dbs := map[string]*database.Handler{}
+
+	sqlite, err := database.New(database.Options{Filename: filepath.Join(t.TempDir(), "mesherydb.sql"), Engine: database.SQLITE})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dbs[database.SQLITE] = &sqlite
+
+	if dsn := os.Getenv("MESHERY_TEST_POSTGRES_DSN"); dsn != "" {
+		log, err := logger.New("meshery-test", logger.Options{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		viper.Set("DB_DSN", dsn)
+		defer viper.Set("DB_DSN", "")
+		postgres, err := newPostgresHandler(log)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !IsPostgres(postgres.DB) {
+			t.Fatalf("dialector %s is not postgres", postgres.DB.Dialector.Name())
+		}
+		dbs[database.POSTGRES] = &postgres
+	}
+	return dbs
+}
+
+func TestDialectQueries(t *testing.T) {
+	for engine, db := range testDatabases(t) {
+		t.Run(engine, func(t *testing.T) {
+			if err := db.AutoMigrate(&PerformanceProfile{}, &MesheryResult{}, &PatternDeployment{}); err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() {
+				_ = db.Migrator().DropTable(&MesheryResult{}, &PerformanceProfile{}, &PatternDeployment{})
+			})
+
+			profiles := &PerformanceProfilePersister{DB: db}
+			id := uuid.Must(uuid.NewV4())
+			if err := profiles.SavePerformanceProfile(id, &PerformanceProfile{ID: &id, Name: "Istio Load", Endpoints: []string{"http://productpage:9080"}}); err != nil {
+				t.Fatal(err)
+			}
+			b, err := profiles.GetPerformanceProfiles("", "istio", "last_run desc", 0, 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var page PerformanceProfilePage
+			if err := json.Unmarshal(b, &page); err != nil {
+				t.Fatal(err)
+			}
+			if page.TotalCount != 1 || len(page.Profiles) != 1 || page.Profiles[0].Name != "Istio Load" {
+				t.Errorf("profiles matching istio = %s, want the saved profile", b)
+			}
+
+			deployments := &PatternDeploymentPersister{DB: db, ServerID: "meshery-a"}
+			running := &PatternDeployment{Name: "bookinfo", Status: PatternDeploymentRunning, CompletedStages: "[]"}
+			completed := &PatternDeployment{Name: "httpbin", Status: PatternDeploymentCompleted, CompletedStages: "[]"}
+			other := &PatternDeployment{Name: "emojivoto", Status: PatternDeploymentRunning, ServerID: "meshery-b", CompletedStages: "[]"}
+			for _, d := range []*PatternDeployment{running, completed, other} {
+				if err := deployments.SavePatternDeployment(d); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if n, err := deployments.InterruptPatternDeployments(); err != nil || n != 1 {
+				t.Fatalf("InterruptPatternDeployments() = %d, %v, want 1", n, err)
+			}
+			for d, want := range map[*PatternDeployment]string{running: PatternDeploymentInterrupted, other: PatternDeploymentRunning} {
+				got, err := deployments.GetPatternDeployment(d.ID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got.Status != want {
+					t.Errorf("status of the deployment %s of %s = %s, want %s", d.Name, d.ServerID, got.Status, want)
+				}
+			}
+
+			// the server resuming a deployment runs it from then on
+			if err := deployments.SetPatternDeploymentStatus(other.ID, PatternDeploymentRunning); err != nil {
+				t.Fatal(err)
+			}
+			if got, err := deployments.GetPatternDeployment(other.ID); err != nil || got.ServerID != "meshery-a" {
+				t.Errorf("the resumed deployment is run by %+v, %v, want meshery-a", got, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package models
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshkit/models/events"
+)
+
+// WebhookEventType is the type of the events delivered to the webhooks
+type WebhookEventType string
+
+// Types of the events delivered to the webhooks
+const (
+	// WebhookDeploymentFinished is sent when the deployment or the undeployment of a design finishes, successfully or not
+	WebhookDeploymentFinished WebhookEventType = "deployment.finished"
+	// WebhookDriftDetected is sent when the resources of a deployed design drift from the design
+	WebhookDriftDetected WebhookEventType = "drift.detected"
+	// WebhookRelationshipRegistered is sent when a relationship is registered, by a registrant or through the API
+	WebhookRelationshipRegistered WebhookEventType = "relationship.registered"
+)
+
+// WebhookEventTypes are the types of the events delivered to the webhooks
+var WebhookEventTypes = []WebhookEventType{WebhookDeploymentFinished, WebhookDriftDetected, WebhookRelationshipRegistered}
+
+// Headers of the deliveries of the webhooks
+const (
+	WebhookEventHeader     = "X-Meshery-Event"
+	WebhookDeliveryHeader  = "X-Meshery-Delivery"
+	WebhookTimestampHeader = "X-Meshery-Timestamp"
+	// WebhookSignatureHeader holds sha256=<hex HMAC-SHA256 of "<timestamp>.<body>" with the secret of the webhook>
+	WebhookSignatureHeader = "X-Meshery-Signature"
+)
+
+// Statuses of the deliveries of the webhooks
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// Webhook is a URL of a user the events of the types it subscribes to are delivered to
+type Webhook struct {
+	ID     uuid.UUID `json:"id" gorm:"primaryKey"`
+	UserID string    `json:"user_id" gorm:"index"`
+	// OrgID is the organization of the user when the webhook was saved, the relationships registered in the other
+	// organizations are not delivered to the webhook
+	OrgID string `json:"org_id,omitempty" gorm:"index"`
+	Name  string `json:"name"`
+	URL   string `json:"url"`
+	// EventTypes is the comma separated list of the types of the events delivered to the webhook, see WebhookEventType
+	EventTypes string `json:"-"`
+	// Secret signs the deliveries, it is only returned when the webhook is created
+	Secret    string    `json:"-"`
+	Enabled   bool      `json:"enabled"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// WebhookRequestBody is the body of the requests creating or updating a webhook
+type WebhookRequestBody struct {
+	Name       string             `json:"name"`
+	URL        string             `json:"url"`
+	EventTypes []WebhookEventType `json:"event_types"`
+	// Secret signs the deliveries, a random secret is generated when it is empty
+	Secret string `json:"secret"`
+	// Enabled is true when unset
+	Enabled *bool `json:"enabled"`
+}
+
+// CreatedWebhook is a webhook along with its secret, which is only returned when the webhook is created
+type CreatedWebhook struct {
+	Webhook
+	Secret string `json:"secret"`
+}
+
+// WebhookDelivery is the delivery of an event to a webhook, which is attempted again until it succeeds or fails too often
+type WebhookDelivery struct {
+	ID        uuid.UUID        `json:"id" gorm:"primaryKey"`
+	WebhookID uuid.UUID        `json:"webhook_id" gorm:"index"`
+	EventType WebhookEventType `json:"event_type"`
+	// Payload is the JSON body delivered, see WebhookPayload
+	Payload  string `json:"payload"`
+	Status   string `json:"status" gorm:"index"`
+	Attempts int    `json:"attempts"`
+	// StatusCode is the status code of the response to the last attempt, 0 when the webhook could not be reached
+	StatusCode    int        `json:"status_code"`
+	Error         string     `json:"error,omitempty"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty" gorm:"index"`
+	DeliveredAt   *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// WebhookDeliveriesPage is a page of the deliveries of a webhook
+type WebhookDeliveriesPage struct {
+	Page       int               `json:"page"`
+	PageSize   int               `json:"page_size"`
+	TotalCount int64             `json:"total_count"`
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
+
+// WebhookPayload is the body of the deliveries
+type WebhookPayload struct {
+	ID        uuid.UUID        `json:"id"`
+	Type      WebhookEventType `json:"type"`
+	Timestamp time.Time        `json:"timestamp"`
+	Data      interface{}      `json:"data"`
+}
+
+// EventTypeList returns the types of the events delivered to the webhook
+func (w *Webhook) EventTypeList() []WebhookEventType {
+	types := []WebhookEventType{}
+	for _, t := range strings.Split(w.EventTypes, ",") {
+		if t != "" {
+			types = append(types, WebhookEventType(t))
+		}
+	}
+	return types
+}
+
+// Subscribes tells whether the events of the type are delivered to the webhook
+func (w *Webhook) Subscribes(eventType WebhookEventType) bool {
+	for _, t := range w.EventTypeList() {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply validates the body and sets the fields of the webhook it holds. Unless allowPrivateNetworks is true, the URLs
+// whose host is, or resolves to, an address of a private network are rejected, see PublicWebhookIP.
+func (w *Webhook) Apply(body WebhookRequestBody, allowPrivateNetworks bool) error {
+	if strings.TrimSpace(body.Name) == "" {
+		return ErrInvalidWebhook("name is required")
+	}
+	u, err := url.Parse(body.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidWebhook(fmt.Sprintf("%q is not an http or https URL", body.URL))
+	}
+	if !allowPrivateNetworks {
+		if err := checkWebhookHost(u.Hostname()); err != nil {
+			return err
+		}
+	}
+	if len(body.EventTypes) == 0 {
+		return ErrInvalidWebhook("at least one event type is required")
+	}
+	types := make([]string, 0, len(body.EventTypes))
+	for _, t := range body.EventTypes {
+		known := false
+		for _, k := range WebhookEventTypes {
+			known = known || t == k
+		}
+		if !known {
+			return ErrInvalidWebhook(fmt.Sprintf("%q is not an event type, the event types are %v", t, WebhookEventTypes))
+		}
+		types = append(types, string(t))
+	}
+	w.Name = strings.TrimSpace(body.Name)
+	w.URL = u.String()
+	w.EventTypes = strings.Join(types, ",")
+	if body.Secret != "" {
+		w.Secret = body.Secret
+	}
+	if body.Enabled != nil {
+		w.Enabled = *body.Enabled
+	}
+	return nil
+}
+
+// webhookSharedNetwork is the shared address space of carrier-grade NAT, which some clusters use for their pods and services
+var webhookSharedNetwork = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// PublicWebhookIP reports whether the webhooks are allowed to reach the address: the loopback, link-local, private,
+// multicast and unspecified addresses are of the network of Meshery Server, eg: 169.254.169.254 of the metadata of the
+// cloud instances, and the cluster IPs
+func PublicWebhookIP(ip net.IP) bool {
+	return ip != nil && !ip.IsLoopback() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() && !ip.IsPrivate() &&
+		!ip.IsMulticast() && !ip.IsInterfaceLocalMulticast() && !ip.IsUnspecified() && !webhookSharedNetwork.Contains(ip)
+}
+
+// checkWebhookHost returns an error when the host is, or resolves to, an address the webhooks are not allowed to reach.
+// The hosts which cannot be resolved are accepted, the deliveries check the addresses they dial anyway.
+func checkWebhookHost(host string) error {
+	if ip := net.ParseIP(host); ip != nil {
+		if !PublicWebhookIP(ip) {
+			return ErrWebhookPrivateAddress(host)
+		}
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil
+	}
+	for _, addr := range addrs {
+		if !PublicWebhookIP(addr.IP) {
+			return ErrWebhookPrivateAddress(fmt.Sprintf("%s (%s)", addr.IP, host))
+		}
+	}
+	return nil
+}
+
+// MarshalJSON adds the event types to the JSON of the webhook
+func (w Webhook) MarshalJSON() ([]byte, error) {
+	type webhook Webhook
+	return json.Marshal(struct {
+		webhook
+		EventTypes []WebhookEventType `json:"event_types"`
+	}{webhook(w), w.EventTypeList()})
+}
+
+// MarshalJSON adds the event types and the secret to the JSON of the webhook, the one of the embedded webhook being promoted otherwise
+func (w CreatedWebhook) MarshalJSON() ([]byte, error) {
+	type webhook Webhook
+	return json.Marshal(struct {
+		webhook
+		EventTypes []WebhookEventType `json:"event_types"`
+		Secret     string             `json:"secret"`
+	}{webhook(w.Webhook), w.EventTypeList(), w.Secret})
+}
+
+// NewWebhookSecret returns a random secret to sign the deliveries with
+func NewWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// SignWebhookPayload returns the signature of the payload delivered at the time, the value of WebhookSignatureHeader.
+// The receivers verify it by computing the HMAC-SHA256 of the timestamp header, a dot and the body with the secret.
+func SignWebhookPayload(secret string, timestamp time.Time, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookEventTypeOfEvent returns the type of the webhook events of the event, empty when it is not delivered to webhooks
+func WebhookEventTypeOfEvent(event *events.Event) WebhookEventType {
+	if event.Category != "pattern" {
+		return ""
+	}
+	switch strings.ToLower(event.Action) {
+	case "deploy", "undeploy":
+		return WebhookDeploymentFinished
+	case "drift":
+		return WebhookDriftDetected
+	}
+	return ""
+}
+
+// WebhookEventTypeOfRegistryEvent returns the type of the webhook events of the registry event, empty when it is not
+// delivered to webhooks
+func WebhookEventTypeOfRegistryEvent(event meshmodel.RegistryEvent) WebhookEventType {
+	if event.Action == meshmodel.RegistryEventRegistered && event.EntityType == meshmodel.RegistryEntityRelationship {
+		return WebhookRelationshipRegistered
+	}
+	return ""
+}
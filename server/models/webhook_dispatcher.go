@@ -0,0 +1,312 @@
+package models
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/internal/tracing"
+	"github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+	"github.com/layer5io/meshkit/models/events"
+)
+
+const (
+	// webhookPollInterval is how often the deliveries due are looked up
+	webhookPollInterval = 5 * time.Second
+	// webhookBatchSize is the number of deliveries attempted at once
+	webhookBatchSize = 20
+	// webhookMaxBackoff caps the delay between the attempts of a delivery
+	webhookMaxBackoff = 6 * time.Hour
+	// webhookErrorLength truncates the errors recorded for the attempts
+	webhookErrorLength = 512
+)
+
+// WebhookDispatcherOptions configures the deliveries of the webhooks
+type WebhookDispatcherOptions struct {
+	// MaxAttempts is the number of attempts of a delivery before it fails, 5 by default
+	MaxAttempts int
+	// Backoff is the delay before the second attempt, doubled after every attempt, 30s by default
+	Backoff time.Duration
+	// Timeout bounds every attempt, 10s by default
+	Timeout time.Duration
+	// Retention is how long the deliveries are kept once succeeded or failed, they are kept forever when it is 0
+	Retention time.Duration
+	// AllowPrivateNetworks lets the deliveries reach the addresses of private networks, see PublicWebhookIP. When it is
+	// false the deliveries do not go through the proxy of the environment, whose requests could not be checked.
+	AllowPrivateNetworks bool
+}
+
+type webhookEvent struct {
+	eventType WebhookEventType
+	userID    string
+	// orgID restricts the event to the webhooks of the organization, it is delivered to every organization when empty
+	orgID string
+	data  interface{}
+}
+
+// WebhookDispatcher delivers the events to the webhooks subscribing to them. The deliveries are stored before being
+// attempted, so that the failed ones, or the ones interrupted by a restart, are attempted again with an exponential backoff.
+type WebhookDispatcher struct {
+	persister *WebhookPersister
+	client    *http.Client
+	log       logger.Handler
+	options   WebhookDispatcherOptions
+	now       func() time.Time
+
+	events chan webhookEvent
+}
+
+// NewWebhookDispatcher returns the dispatcher of the webhooks of the database, Run has to be called for the events to be delivered
+func NewWebhookDispatcher(db *database.Handler, log logger.Handler, options WebhookDispatcherOptions) *WebhookDispatcher {
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = 5
+	}
+	if options.Backoff <= 0 {
+		options.Backoff = 30 * time.Second
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = 10 * time.Second
+	}
+	return &WebhookDispatcher{
+		persister: &WebhookPersister{DB: db},
+		client:    &http.Client{Timeout: options.Timeout, Transport: tracing.Transport(webhookTransport(options.AllowPrivateNetworks))},
+		log:       log,
+		options:   options,
+		now:       time.Now,
+		events:    make(chan webhookEvent, 256),
+	}
+}
+
+// webhookTransport returns the transport of the deliveries, which refuses to connect to the addresses of private networks
+// unless allowPrivateNetworks is true. The address is checked once resolved, when it is dialed, so that a host resolving
+// to another address than when the webhook was saved cannot reach them either.
+func webhookTransport(allowPrivateNetworks bool) http.RoundTripper {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if allowPrivateNetworks {
+		return transport
+	}
+	transport.Proxy = nil
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if !PublicWebhookIP(net.ParseIP(host)) {
+				return ErrWebhookPrivateAddress(host)
+			}
+			return nil
+		},
+	}
+	transport.DialContext = dialer.DialContext
+	return transport
+}
+
+// Notify queues the event for the webhooks of the user subscribing to its type, the ones of every user when the user is
+// empty. Notify never blocks, the events are dropped when the queue is full.
+func (d *WebhookDispatcher) Notify(eventType WebhookEventType, userID string, data interface{}) {
+	d.queue(webhookEvent{eventType: eventType, userID: userID, data: data})
+}
+
+func (d *WebhookDispatcher) queue(event webhookEvent) {
+	select {
+	case d.events <- event:
+	default:
+		d.log.Warn(fmt.Errorf("the webhook event %s was dropped, the queue of the webhooks is full", event.eventType))
+	}
+}
+
+// NotifyEvent queues the events published to the user for the webhooks, it is meant to be registered with Broadcast.OnPublish
+func (d *WebhookDispatcher) NotifyEvent(userID uuid.UUID, data interface{}) {
+	event, ok := data.(*events.Event)
+	if !ok || userID == uuid.Nil {
+		return
+	}
+	if eventType := WebhookEventTypeOfEvent(event); eventType != "" {
+		d.Notify(eventType, userID.String(), event)
+	}
+}
+
+// NotifyRegistryEvent queues the changes of the registry for the webhooks of the users who can see the entity: the
+// webhooks of the organization of the relationship, or of every user for the global ones. It is meant to be registered
+// with RegistryEventsChannel.OnPublish
+func (d *WebhookDispatcher) NotifyRegistryEvent(event meshmodel.RegistryEvent) {
+	if eventType := WebhookEventTypeOfRegistryEvent(event); eventType != "" {
+		d.queue(webhookEvent{eventType: eventType, orgID: event.Org, data: event})
+	}
+}
+
+// Run stores the deliveries of the events notified and attempts the ones due, until ctx is done
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	poll := time.NewTicker(webhookPollInterval)
+	defer poll.Stop()
+	prune := time.NewTicker(time.Hour)
+	defer prune.Stop()
+	d.deliverDue(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-d.events:
+			d.enqueue(event)
+			d.deliverDue(ctx)
+		case <-poll.C:
+			d.deliverDue(ctx)
+		case <-prune.C:
+			if d.options.Retention > 0 {
+				if _, err := d.persister.DeleteWebhookDeliveriesBefore(d.now().Add(-d.options.Retention)); err != nil {
+					d.log.Error(ErrWebhookDelivery(err))
+				}
+			}
+		}
+	}
+}
+
+// enqueue stores a pending delivery of the event for each of the webhooks subscribing to it
+func (d *WebhookDispatcher) enqueue(event webhookEvent) {
+	webhooks, err := d.persister.GetSubscribedWebhooks(event.eventType, event.userID, event.orgID)
+	if err != nil {
+		d.log.Error(ErrWebhookDelivery(err))
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		d.log.Error(ErrWebhookDelivery(err))
+		return
+	}
+	now := d.now()
+	payload, err := json.Marshal(WebhookPayload{ID: id, Type: event.eventType, Timestamp: now, Data: event.data})
+	if err != nil {
+		d.log.Error(ErrWebhookDelivery(err))
+		return
+	}
+	for _, webhook := range webhooks {
+		delivery := &WebhookDelivery{
+			WebhookID:     webhook.ID,
+			EventType:     event.eventType,
+			Payload:       string(payload),
+			Status:        WebhookDeliveryPending,
+			NextAttemptAt: &now,
+		}
+		if err := d.persister.SaveWebhookDelivery(delivery); err != nil {
+			d.log.Error(ErrWebhookDelivery(err))
+		}
+	}
+}
+
+// deliverDue attempts the deliveries due concurrently
+func (d *WebhookDispatcher) deliverDue(ctx context.Context) {
+	deliveries, err := d.persister.GetDueWebhookDeliveries(d.now(), webhookBatchSize)
+	if err != nil {
+		d.log.Error(ErrWebhookDelivery(err))
+		return
+	}
+	var wg sync.WaitGroup
+	for i := range deliveries {
+		wg.Add(1)
+		go func(delivery *WebhookDelivery) {
+			defer wg.Done()
+			d.attempt(ctx, delivery)
+		}(&deliveries[i])
+	}
+	wg.Wait()
+}
+
+// attempt delivers the payload to the webhook, and records the outcome of the attempt
+func (d *WebhookDispatcher) attempt(ctx context.Context, delivery *WebhookDelivery) {
+	webhook, err := d.persister.GetWebhookByID(delivery.WebhookID)
+	if err != nil {
+		d.log.Error(ErrWebhookDelivery(err))
+		return
+	}
+
+	delivery.Attempts++
+	delivery.StatusCode = 0
+	switch {
+	case webhook == nil || !webhook.Enabled:
+		err = fmt.Errorf("the webhook is deleted or disabled")
+		delivery.Attempts = d.options.MaxAttempts
+	default:
+		delivery.StatusCode, err = d.post(ctx, webhook, delivery)
+	}
+
+	now := d.now()
+	switch {
+	case err == nil:
+		delivery.Status = WebhookDeliverySucceeded
+		delivery.Error = ""
+		delivery.DeliveredAt = &now
+		delivery.NextAttemptAt = nil
+	case delivery.Attempts >= d.options.MaxAttempts:
+		delivery.Status = WebhookDeliveryFailed
+		delivery.Error = truncateWebhookError(err)
+		delivery.NextAttemptAt = nil
+	default:
+		next := now.Add(d.backoff(delivery.Attempts))
+		delivery.Error = truncateWebhookError(err)
+		delivery.NextAttemptAt = &next
+	}
+	if err := d.persister.SaveWebhookDelivery(delivery); err != nil {
+		d.log.Error(ErrWebhookDelivery(err))
+	}
+}
+
+// post sends the signed payload of the delivery to the webhook, and returns the status code of the response
+func (d *WebhookDispatcher) post(ctx context.Context, webhook *Webhook, delivery *WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewBufferString(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := d.now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Meshery-Webhooks")
+	req.Header.Set(WebhookEventHeader, string(delivery.EventType))
+	req.Header.Set(WebhookDeliveryHeader, delivery.ID.String())
+	req.Header.Set(WebhookTimestampHeader, fmt.Sprint(timestamp.Unix()))
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(webhook.Secret, timestamp, []byte(delivery.Payload)))
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer SafeClose(resp.Body)
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("the webhook responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff returns the delay after the attempt of a delivery
+func (d *WebhookDispatcher) backoff(attempts int) time.Duration {
+	delay := d.options.Backoff
+	for i := 1; i < attempts && delay < webhookMaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > webhookMaxBackoff {
+		delay = webhookMaxBackoff
+	}
+	return delay
+}
+
+func truncateWebhookError(err error) string {
+	msg := err.Error()
+	if len(msg) > webhookErrorLength {
+		msg = msg[:webhookErrorLength]
+	}
+	return msg
+}
@@ -0,0 +1,150 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// WebhookPersister is the persister for the webhooks and their deliveries
+type WebhookPersister struct {
+	DB *database.Handler
+}
+
+// SaveWebhook stores the webhook, generating its ID if it has none
+func (wp *WebhookPersister) SaveWebhook(webhook *Webhook) error {
+	if webhook.ID == uuid.Nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+		webhook.ID = id
+	}
+	return wp.DB.Save(webhook).Error
+}
+
+// GetWebhooks returns the webhooks of the user, most recent first
+func (wp *WebhookPersister) GetWebhooks(userID string) ([]Webhook, error) {
+	webhooks := []Webhook{}
+	if err := wp.DB.Where("user_id = ?", userID).Order("created_at desc").Find(&webhooks).Error; err != nil {
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// GetWebhook returns the webhook of the user with the id, or nil if there is none
+func (wp *WebhookPersister) GetWebhook(id uuid.UUID, userID string) (*Webhook, error) {
+	var webhooks []Webhook
+	if err := wp.DB.Where("id = ? AND user_id = ?", id, userID).Limit(1).Find(&webhooks).Error; err != nil {
+		return nil, err
+	}
+	if len(webhooks) == 0 {
+		return nil, nil
+	}
+	return &webhooks[0], nil
+}
+
+// GetWebhookByID returns the webhook with the id, or nil if there is none
+func (wp *WebhookPersister) GetWebhookByID(id uuid.UUID) (*Webhook, error) {
+	var webhooks []Webhook
+	if err := wp.DB.Where("id = ?", id).Limit(1).Find(&webhooks).Error; err != nil {
+		return nil, err
+	}
+	if len(webhooks) == 0 {
+		return nil, nil
+	}
+	return &webhooks[0], nil
+}
+
+// GetSubscribedWebhooks returns the enabled webhooks subscribing to the events of the type, the ones of the user only
+// unless the user is empty, and the ones of the organization only unless the organization is empty
+func (wp *WebhookPersister) GetSubscribedWebhooks(eventType WebhookEventType, userID, orgID string) ([]Webhook, error) {
+	query := wp.DB.Where("enabled = ?", true)
+	if userID != "" {
+		query = query.Where("user_id = ?", userID)
+	}
+	if orgID != "" {
+		query = query.Where("org_id = ?", orgID)
+	}
+	var webhooks []Webhook
+	if err := query.Find(&webhooks).Error; err != nil {
+		return nil, err
+	}
+	subscribed := []Webhook{}
+	for _, w := range webhooks {
+		if w.Subscribes(eventType) {
+			subscribed = append(subscribed, w)
+		}
+	}
+	return subscribed, nil
+}
+
+// DeleteWebhook deletes the webhook of the user along with its deliveries, and returns whether the user had such a webhook
+func (wp *WebhookPersister) DeleteWebhook(id uuid.UUID, userID string) (bool, error) {
+	result := wp.DB.Where("id = ? AND user_id = ?", id, userID).Delete(&Webhook{})
+	if result.Error != nil || result.RowsAffected == 0 {
+		return false, result.Error
+	}
+	return true, wp.DB.Where("webhook_id = ?", id).Delete(&WebhookDelivery{}).Error
+}
+
+// SaveWebhookDelivery stores the delivery, generating its ID if it has none
+func (wp *WebhookPersister) SaveWebhookDelivery(delivery *WebhookDelivery) error {
+	if delivery.ID == uuid.Nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+		delivery.ID = id
+	}
+	return wp.DB.Save(delivery).Error
+}
+
+// GetWebhookDeliveries returns a page of the deliveries of the webhook with the status, of any status when it is empty,
+// most recent first, along with their total count. Every delivery from the offset is returned when the limit is 0.
+func (wp *WebhookPersister) GetWebhookDeliveries(webhookID uuid.UUID, status string, offset, limit int) ([]WebhookDelivery, int64, error) {
+	query := wp.DB.Model(&WebhookDelivery{}).Where("webhook_id = ?", webhookID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+	query = query.Order("created_at desc").Offset(offset)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	deliveries := []WebhookDelivery{}
+	if err := query.Find(&deliveries).Error; err != nil {
+		return nil, 0, err
+	}
+	return deliveries, count, nil
+}
+
+// GetWebhookDelivery returns the delivery of the webhook with the id, or nil if there is none
+func (wp *WebhookPersister) GetWebhookDelivery(id, webhookID uuid.UUID) (*WebhookDelivery, error) {
+	var deliveries []WebhookDelivery
+	if err := wp.DB.Where("id = ? AND webhook_id = ?", id, webhookID).Limit(1).Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+	return &deliveries[0], nil
+}
+
+// GetDueWebhookDeliveries returns the pending deliveries whose next attempt is due at the time, oldest first
+func (wp *WebhookPersister) GetDueWebhookDeliveries(t time.Time, limit int) ([]WebhookDelivery, error) {
+	deliveries := []WebhookDelivery{}
+	err := wp.DB.Where("status = ? AND next_attempt_at <= ?", WebhookDeliveryPending, t).
+		Order("next_attempt_at asc").Limit(limit).Find(&deliveries).Error
+	return deliveries, err
+}
+
+// DeleteWebhookDeliveriesBefore deletes the deliveries which are not pending anymore created before the time, and returns their count
+func (wp *WebhookPersister) DeleteWebhookDeliveriesBefore(t time.Time) (int64, error) {
+	result := wp.DB.Where("status <> ? AND created_at < ?", WebhookDeliveryPending, t).Delete(&WebhookDelivery{})
+	return result.RowsAffected, result.Error
+}
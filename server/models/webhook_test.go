@@ -0,0 +1,217 @@
+package models
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/models/meshmodel"
+	"github.com/layer5io/meshkit/logger"
+	"github.com/layer5io/meshkit/models/events"
+)
+
+func TestWebhookApply(t *testing.T) {
+	var webhook Webhook
+	err := webhook.Apply(WebhookRequestBody{
+		Name:       " ci ",
+		URL:        "https://ci.example.com/hooks/meshery",
+		EventTypes: []WebhookEventType{WebhookDeploymentFinished, WebhookDriftDetected},
+	}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if webhook.Name != "ci" || !webhook.Subscribes(WebhookDriftDetected) || webhook.Subscribes(WebhookRelationshipRegistered) {
+		t.Errorf("Apply() = %+v", webhook)
+	}
+
+	invalid := []WebhookRequestBody{
+		{URL: "https://ci.example.com", EventTypes: []WebhookEventType{WebhookDriftDetected}},
+		{Name: "ci", URL: "ftp://ci.example.com", EventTypes: []WebhookEventType{WebhookDriftDetected}},
+		{Name: "ci", URL: "https://ci.example.com"},
+		{Name: "ci", URL: "https://ci.example.com", EventTypes: []WebhookEventType{"deployment.started"}},
+	}
+	for _, body := range invalid {
+		if err := (&Webhook{}).Apply(body, false); err == nil {
+			t.Errorf("Apply(%+v) succeeded", body)
+		}
+	}
+
+	for _, u := range []string{"http://127.0.0.1:9081/hooks", "http://localhost:9081", "http://169.254.169.254/latest/meta-data", "http://10.96.0.1", "http://[::1]:8080", "http://[fd00::1]", "http://100.64.0.10"} {
+		body := WebhookRequestBody{Name: "ci", URL: u, EventTypes: []WebhookEventType{WebhookDriftDetected}}
+		if err := (&Webhook{}).Apply(body, false); err == nil {
+			t.Errorf("Apply() of the internal URL %s succeeded", u)
+		}
+		if err := (&Webhook{}).Apply(body, true); err != nil {
+			t.Errorf("Apply() of the internal URL %s when the private networks are allowed = %v", u, err)
+		}
+	}
+}
+
+func TestPublicWebhookIP(t *testing.T) {
+	for ip, want := range map[string]bool{
+		"93.184.216.34": true, "2606:2800:220:1::": true, "127.0.0.1": false, "10.0.0.1": false, "172.16.5.4": false,
+		"192.168.1.1": false, "169.254.169.254": false, "100.64.1.1": false, "0.0.0.0": false, "::1": false,
+		"fe80::1": false, "fd00::1": false, "::ffff:127.0.0.1": false, "224.0.0.1": false,
+	} {
+		if got := PublicWebhookIP(net.ParseIP(ip)); got != want {
+			t.Errorf("PublicWebhookIP(%s) = %t, want %t", ip, got, want)
+		}
+	}
+}
+
+func TestWebhookJSON(t *testing.T) {
+	webhook := Webhook{Name: "ci", EventTypes: "drift.detected", Secret: "whsec_secret"}
+	b, err := json.Marshal(webhook)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "whsec_secret") || !strings.Contains(string(b), `"event_types":["drift.detected"]`) {
+		t.Errorf("the JSON of the webhook is %s", b)
+	}
+	b, err = json.Marshal(CreatedWebhook{Webhook: webhook, Secret: webhook.Secret})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"secret":"whsec_secret"`) || !strings.Contains(string(b), `"name":"ci"`) {
+		t.Errorf("the JSON of the created webhook is %s", b)
+	}
+}
+
+func TestWebhookEventTypes(t *testing.T) {
+	tests := []struct {
+		event *events.Event
+		want  WebhookEventType
+	}{
+		{events.NewEvent().WithCategory("pattern").WithAction("Deploy").Build(), WebhookDeploymentFinished},
+		{events.NewEvent().WithCategory("pattern").WithAction("Undeploy").Build(), WebhookDeploymentFinished},
+		{events.NewEvent().WithCategory("pattern").WithAction("drift").Build(), WebhookDriftDetected},
+		{events.NewEvent().WithCategory("pattern").WithAction("Dry Run").Build(), ""},
+		{events.NewEvent().WithCategory("connection").WithAction("deploy").Build(), ""},
+	}
+	for _, tt := range tests {
+		if got := WebhookEventTypeOfEvent(tt.event); got != tt.want {
+			t.Errorf("WebhookEventTypeOfEvent(%s %s) = %q, want %q", tt.event.Category, tt.event.Action, got, tt.want)
+		}
+	}
+	registered := meshmodel.RegistryEvent{Action: meshmodel.RegistryEventRegistered, EntityType: meshmodel.RegistryEntityRelationship}
+	if got := WebhookEventTypeOfRegistryEvent(registered); got != WebhookRelationshipRegistered {
+		t.Errorf("WebhookEventTypeOfRegistryEvent(%+v) = %q", registered, got)
+	}
+	registered.EntityType = meshmodel.RegistryEntityComponent
+	if got := WebhookEventTypeOfRegistryEvent(registered); got != "" {
+		t.Errorf("WebhookEventTypeOfRegistryEvent(%+v) = %q", registered, got)
+	}
+}
+
+func TestWebhookDelivery(t *testing.T) {
+	var received *http.Request
+	var body []byte
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	d := NewWebhookDispatcher(nil, nil, WebhookDispatcherOptions{AllowPrivateNetworks: true})
+	webhook := &Webhook{URL: server.URL, Secret: "whsec_secret", Enabled: true}
+	delivery := &WebhookDelivery{ID: uuid.Must(uuid.NewV4()), EventType: WebhookDriftDetected, Payload: `{"type":"drift.detected"}`}
+	code, err := d.post(context.Background(), webhook, delivery)
+	if err != nil || code != http.StatusOK {
+		t.Fatalf("post() = %d, %v", code, err)
+	}
+	if string(body) != delivery.Payload || received.Header.Get(WebhookEventHeader) != "drift.detected" || received.Header.Get(WebhookDeliveryHeader) != delivery.ID.String() {
+		t.Errorf("the webhook received %s with the headers %v", body, received.Header)
+	}
+	mac := hmac.New(sha256.New, []byte("whsec_secret"))
+	mac.Write([]byte(received.Header.Get(WebhookTimestampHeader) + "." + string(body)))
+	if want := "sha256=" + hex.EncodeToString(mac.Sum(nil)); received.Header.Get(WebhookSignatureHeader) != want {
+		t.Errorf("the signature is %s, want %s", received.Header.Get(WebhookSignatureHeader), want)
+	}
+
+	status = http.StatusServiceUnavailable
+	if code, err := d.post(context.Background(), webhook, delivery); err == nil || code != http.StatusServiceUnavailable {
+		t.Errorf("post() = %d, %v, want an error", code, err)
+	}
+}
+
+func TestWebhookDeliveryPrivateAddress(t *testing.T) {
+	reached := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached = true
+	}))
+	defer server.Close()
+
+	// the host is checked once resolved, so that a name resolving to a private address is refused as well
+	u := strings.Replace(server.URL, "127.0.0.1", "localhost", 1)
+	d := NewWebhookDispatcher(nil, nil, WebhookDispatcherOptions{})
+	delivery := &WebhookDelivery{ID: uuid.Must(uuid.NewV4()), EventType: WebhookDriftDetected, Payload: `{}`}
+	for _, target := range []string{server.URL, u} {
+		code, err := d.post(context.Background(), &Webhook{URL: target, Enabled: true}, delivery)
+		if err == nil || code != 0 || reached {
+			t.Errorf("post() to %s = %d, %v, reached = %t, want the address refused", target, code, err, reached)
+		}
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	d := NewWebhookDispatcher(nil, nil, WebhookDispatcherOptions{Backoff: time.Minute})
+	for attempts, want := range map[int]time.Duration{1: time.Minute, 2: 2 * time.Minute, 4: 8 * time.Minute, 20: webhookMaxBackoff} {
+		if got := d.backoff(attempts); got != want {
+			t.Errorf("backoff(%d) = %s, want %s", attempts, got, want)
+		}
+	}
+}
+
+func TestWebhookRegistryEventScoping(t *testing.T) {
+	for engine, db := range testDatabases(t) {
+		t.Run(engine, func(t *testing.T) {
+			if err := db.AutoMigrate(&Webhook{}, &WebhookDelivery{}); err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() {
+				_ = db.Migrator().DropTable(&Webhook{}, &WebhookDelivery{})
+			})
+			log, err := logger.New("meshery-test", logger.Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := NewWebhookDispatcher(db, log, WebhookDispatcherOptions{})
+			webhooks := map[string]*Webhook{"org-a": {UserID: "alice", OrgID: "org-a"}, "org-b": {UserID: "bob", OrgID: "org-b"}, "": {UserID: "carol"}}
+			for _, webhook := range webhooks {
+				webhook.Name, webhook.URL, webhook.EventTypes, webhook.Enabled = "ci", "https://ci.example.com", string(WebhookRelationshipRegistered), true
+				if err := d.persister.SaveWebhook(webhook); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			tests := []struct {
+				org  string
+				want map[string]int64
+			}{
+				{"org-a", map[string]int64{"org-a": 1, "org-b": 0, "": 0}},
+				{"", map[string]int64{"org-a": 2, "org-b": 1, "": 1}},
+			}
+			for _, tt := range tests {
+				d.NotifyRegistryEvent(meshmodel.RegistryEvent{Action: meshmodel.RegistryEventRegistered, EntityType: meshmodel.RegistryEntityRelationship, Kind: "Edge", Org: tt.org})
+				d.enqueue(<-d.events)
+				for org, want := range tt.want {
+					if _, count, err := d.persister.GetWebhookDeliveries(webhooks[org].ID, "", 0, 0); err != nil || count != want {
+						t.Errorf("after a relationship registered in %q, the webhook of %q has %d deliveries, %v, want %d", tt.org, org, count, err, want)
+					}
+				}
+			}
+		})
+	}
+}
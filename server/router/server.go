@@ -2,6 +2,7 @@ package router
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 
@@ -9,13 +10,22 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/layer5io/meshery/server/handlers"
+	"github.com/layer5io/meshery/server/internal/compression"
+	"github.com/layer5io/meshery/server/internal/metrics"
+	"github.com/layer5io/meshery/server/internal/mtls"
+	"github.com/layer5io/meshery/server/internal/ratelimit"
+	"github.com/layer5io/meshery/server/internal/redact"
+	"github.com/layer5io/meshery/server/internal/tracing"
 	"github.com/layer5io/meshery/server/models"
+	"github.com/spf13/viper"
 )
 
 // Router represents Meshery router
 type Router struct {
-	S    *mux.Router
-	port int
+	S      *mux.Router
+	port   int
+	srv    *http.Server
+	tlsSrv *http.Server
 }
 
 // NewRouter returns a new ServeMux with app routes.
@@ -25,10 +35,35 @@ func NewRouter(_ context.Context, h models.HandlerInterface, port int, g http.Ha
 	gMux.Handle("/api/system/graphql/query", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GraphqlMiddleware(g)), models.ProviderAuth))).Methods("GET", "POST")
 	gMux.Handle("/api/system/graphql/playground", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GraphqlMiddleware(gp)), models.ProviderAuth))).Methods("GET", "POST")
 
+	// probed by load balancers, Prometheus and mesheryctl without a session
+	gMux.HandleFunc("/healthz", h.HealthzHandler).
+		Methods("GET")
+	gMux.HandleFunc("/readyz", h.ReadyzHandler).
+		Methods("GET")
+	gMux.Handle("/metrics", metrics.Handler()).
+		Methods("GET")
 	gMux.HandleFunc("/api/system/version", h.ServerVersionHandler).
 		Methods("GET")
 	gMux.Handle("/api/extension/version", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ExtensionsVersionHandler), models.ProviderAuth))).
 		Methods("GET")
+	gMux.Handle("/api/system/audit", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetAuditRecordsHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/rbac/roles", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetRolesHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/rbac/bindings", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetRoleBindingsHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/rbac/bindings", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.SaveRoleBindingHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/rbac/bindings/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteRoleBindingHandler), models.ProviderAuth))).
+		Methods("DELETE")
+	gMux.Handle("/api/system/jobs", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetJobsHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/system/jobs/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetJobHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/system/jobs/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.CancelJobHandler), models.ProviderAuth))).
+		Methods("DELETE")
+	gMux.Handle("/api/system/logs", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetComponentLogsHandler), models.ProviderAuth))).
+		Methods("GET")
 	gMux.Handle("/api/system/database", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetSystemDatabase), models.ProviderAuth))).
 		Methods("GET")
 	gMux.Handle("/api/system/database/reset", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ResetSystemDatabase), models.ProviderAuth))).
@@ -56,6 +91,46 @@ func NewRouter(_ context.Context, h models.HandlerInterface, port int, g http.Ha
 
 	gMux.Handle("/api/user", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.UserHandler), models.ProviderAuth))).
 		Methods("GET")
+	gMux.Handle("/api/user/permissions", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetUserPermissionsHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/user/api-tokens", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetAPITokensHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/user/api-tokens", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.CreateAPITokenHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/user/api-tokens/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.RevokeAPITokenHandler), models.ProviderAuth))).
+		Methods("DELETE")
+
+	gMux.Handle("/api/webhooks", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetWebhooksHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/webhooks", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.CreateWebhookHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/webhooks/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.UpdateWebhookHandler), models.ProviderAuth))).
+		Methods("PUT")
+	gMux.Handle("/api/webhooks/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteWebhookHandler), models.ProviderAuth))).
+		Methods("DELETE")
+	gMux.Handle("/api/webhooks/{id}/deliveries", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetWebhookDeliveriesHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/webhooks/{id}/deliveries/{deliveryId}/redeliver", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.RedeliverWebhookDeliveryHandler), models.ProviderAuth))).
+		Methods("POST")
+
+	gMux.Handle("/api/notifications/channels", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetNotificationChannelsHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/notifications/channels", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.CreateNotificationChannelHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/notifications/channels/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.UpdateNotificationChannelHandler), models.ProviderAuth))).
+		Methods("PUT")
+	gMux.Handle("/api/notifications/channels/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteNotificationChannelHandler), models.ProviderAuth))).
+		Methods("DELETE")
+	gMux.Handle("/api/notifications/channels/{id}/test", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.TestNotificationChannelHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/notifications/rules", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetNotificationRulesHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/notifications/rules", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.CreateNotificationRuleHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/notifications/rules/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.UpdateNotificationRuleHandler), models.ProviderAuth))).
+		Methods("PUT")
+	gMux.Handle("/api/notifications/rules/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteNotificationRuleHandler), models.ProviderAuth))).
+		Methods("DELETE")
 	gMux.Handle("/api/user/profile/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetUserByIDHandler), models.ProviderAuth))).
 		Methods("GET")
 
@@ -85,6 +160,16 @@ func NewRouter(_ context.Context, h models.HandlerInterface, port int, g http.Ha
 		Methods("GET")
 	gMux.Handle("/api/system/kubernetes/contexts/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteContext), models.ProviderAuth))).
 		Methods("DELETE")
+	gMux.Handle("/api/system/kubernetes/metadata", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetClustersMetadataHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/system/kubernetes/metadata/discover", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DiscoverClustersHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/system/kubernetes/contexts/{id}/metadata", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetClusterMetadataHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/system/kubernetes/contexts/{id}/metadata", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.UpdateClusterMetadataHandler), models.ProviderAuth))).
+		Methods("PUT")
+	gMux.Handle("/api/system/kubernetes/contexts/{id}/metadata", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteClusterMetadataHandler), models.ProviderAuth))).
+		Methods("DELETE")
 
 	gMux.Handle("/api/perf/profile", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.LoadTestHandler), models.ProviderAuth))).
 		Methods("GET", "POST")
@@ -92,6 +177,8 @@ func NewRouter(_ context.Context, h models.HandlerInterface, port int, g http.Ha
 		Methods("GET")
 	gMux.Handle("/api/perf/profile/result/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetResultHandler), models.ProviderAuth))).
 		Methods("GET")
+	gMux.Handle("/api/perf/profile/result/{id}/slo", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetResultSLOHandler), models.ProviderAuth))).
+		Methods("GET")
 	gMux.Handle("/api/mesh", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetSMPServiceMeshes), models.ProviderAuth))).
 		Methods("GET")
 
@@ -118,6 +205,8 @@ func NewRouter(_ context.Context, h models.HandlerInterface, port int, g http.Ha
 		Methods("PUT")
 	gMux.Handle("/api/events/status/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.UpdateEventStatus), models.ProviderAuth))).
 		Methods("PUT")
+	gMux.Handle("/api/events/acknowledge", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.AcknowledgeEvents), models.ProviderAuth))).
+		Methods("POST")
 	gMux.Handle("/api/events/bulk", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.BulkDeleteEvent), models.ProviderAuth))).
 		Methods("DELETE")
 	gMux.Handle("/api/events/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteEvent), models.ProviderAuth))).
@@ -150,6 +239,64 @@ func NewRouter(_ context.Context, h models.HandlerInterface, port int, g http.Ha
 		Methods("POST")
 	gMux.Handle("/api/pattern/deploy", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.KubernetesMiddleware(h.PatternFileHandler)), models.ProviderAuth))).
 		Methods("POST", "DELETE")
+	gMux.Handle("/api/pattern/deploy/queue", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeploymentQueueHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/deploy/{id}/resume", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.KubernetesMiddleware(h.ResumePatternDeploymentHandler)), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/diff", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.KubernetesMiddleware(h.PatternDiffHandler)), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/rollout", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.KubernetesMiddleware(h.PatternRolloutHandler)), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/undeploy/preview", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.KubernetesMiddleware(h.PatternUndeployPreviewHandler)), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/cost", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.KubernetesMiddleware(h.PatternCostHandler)), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/security", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.KubernetesMiddleware(h.PatternSecurityHandler)), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/capabilities", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.KubernetesMiddleware(h.PatternCapabilitiesHandler)), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/image-scan", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.KubernetesMiddleware(h.ScanPatternImagesHandler)), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/gitops", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.CreateGitOpsLinkHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/gitops", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetGitOpsLinksHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/gitops/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteGitOpsLinkHandler), models.ProviderAuth))).
+		Methods("DELETE")
+	gMux.Handle("/api/pattern/gitops/{id}/sync", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.SyncGitOpsLinkHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/gitops/{id}/webhook", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GitOpsWebhookHandler), models.NoAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/schedules", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.CreatePatternScheduleHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/schedules", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetPatternSchedulesHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/schedules/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeletePatternScheduleHandler), models.ProviderAuth))).
+		Methods("DELETE")
+	gMux.Handle("/api/pattern/schedules/{id}/pause", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PausePatternScheduleHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/schedules/{id}/resume", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ResumePatternScheduleHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/drift", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetPatternDriftHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/drift/{id}/check", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.CheckPatternDriftHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/drift/{id}/reconcile", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ReconcilePatternDriftHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/deployed/{id}/exec", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ExecDeployedPatternHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/migrate", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.MigrateMesheryPatternsHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/merge", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.MergeMesheryPatternsHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/evaluate", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PatternEvaluateHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/lint", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PatternLintHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/import/compose", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ImportComposePatternHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/import/terraform", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ImportTerraformPatternHandler), models.ProviderAuth))).
+		Methods("POST")
 	gMux.Handle("/api/pattern", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PatternFileRequestHandler), models.ProviderAuth))).
 		Methods("POST", "GET")
 	gMux.Handle("/api/pattern/catalog", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetCatalogMesheryPatternsHandler), models.ProviderAuth))).
@@ -158,6 +305,18 @@ func NewRouter(_ context.Context, h models.HandlerInterface, port int, g http.Ha
 		Methods("DELETE")
 	gMux.Handle("/api/pattern/catalog/publish", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.PublishCatalogPatternHandler), models.ProviderAuth))).
 		Methods("POST")
+	gMux.Handle("/api/pattern/{id}/revisions", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetMesheryPatternRevisionsHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/{id}/revisions/diff", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetMesheryPatternRevisionsDiffHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/{id}/revisions/{revision}/restore", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.RestoreMesheryPatternRevisionHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/{id}/image-scans", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetPatternImageScansHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/{id}/image-scans/sbom", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetPatternImageSBOMHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/{id}/export", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ExportMesheryPatternHandler), models.ProviderAuth))).
+		Methods("POST")
 	gMux.Handle("/api/pattern/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetMesheryPatternHandler), models.ProviderAuth))).
 		Methods("GET")
 	gMux.Handle("/api/pattern/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteMesheryPatternHandler), models.ProviderAuth))).
@@ -172,36 +331,60 @@ func NewRouter(_ context.Context, h models.HandlerInterface, port int, g http.Ha
 	gMux.Handle("/api/schema/resource/{resourceName}", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.HandleResourceSchemas), models.NoAuth))).
 		Methods("GET")
 
+	// the registrants registering entities without session have to call over mutual TLS when REGISTRY_REQUIRE_MTLS is set
+	registrant := func(next http.Handler) http.Handler {
+		if viper.GetBool("REGISTRY_REQUIRE_MTLS") {
+			return mtls.RequireClientCertificate(next)
+		}
+		return next
+	}
 	gMux.Handle("/api/meshmodels/validate", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.ValidationHandler), models.NoAuth))).Methods("POST")
-	gMux.Handle("/api/meshmodels/components", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.RegisterMeshmodelComponents), models.NoAuth))).Methods("POST") //This should also be left with NoAuth
-	gMux.Handle("/api/meshmodel/components/register", h.ProviderMiddleware((http.HandlerFunc(h.RegisterMeshmodelComponents)))).Methods("POST")                        //For backwards compatibility with previous registrants
-	gMux.Handle("/api/meshmodels/components", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetAllMeshmodelComponents), models.NoAuth))).Methods("GET")
-
-	gMux.Handle("/api/meshmodels/categories", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetMeshmodelCategories), models.NoAuth))).Methods("GET")
-	gMux.Handle("/api/meshmodels/models", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetMeshmodelModels), models.NoAuth))).Methods("GET")
-	gMux.Handle("/api/meshmodels/models/{model}", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetMeshmodelModelsByName), models.NoAuth))).Methods("GET")
-
-	gMux.Handle("/api/meshmodels/categories/{category}", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetMeshmodelCategoriesByName), models.NoAuth))).Methods("GET")
-	gMux.Handle("/api/meshmodels/categories/{category}/models", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetMeshmodelModelsByCategories), models.NoAuth))).Methods("GET")
-	gMux.Handle("/api/meshmodels/categories/{category}/models/{model}", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetMeshmodelModelsByCategoriesByModel), models.NoAuth))).Methods("GET")
-	gMux.Handle("/api/meshmodels/categories/{category}/models/{model}/components", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetMeshmodelComponentByModelByCategory), models.NoAuth))).Methods("GET")
-	gMux.Handle("/api/meshmodels/categories/{category}/models/{model}/components/{name}", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetMeshmodelComponentsByNameByModelByCategory), models.NoAuth))).Methods("GET")
-	gMux.Handle("/api/meshmodels/categories/{category}/components", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetMeshmodelComponentByCategory), models.NoAuth))).Methods("GET")
-	gMux.Handle("/api/meshmodels/categories/{category}/components/{name}", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetMeshmodelComponentsByNameByCategory), models.NoAuth))).Methods("GET")
-
-	gMux.Handle("/api/meshmodels/components/{name}", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetAllMeshmodelComponentsByName), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/components", h.ProviderMiddleware(h.AuthMiddleware(registrant(http.HandlerFunc(h.RegisterMeshmodelComponents)), models.NoAuth))).Methods("POST") //This should also be left with NoAuth
+	gMux.Handle("/api/meshmodel/components/register", h.ProviderMiddleware(registrant(http.HandlerFunc(h.RegisterMeshmodelComponents)))).Methods("POST")                          //For backwards compatibility with previous registrants
+	gMux.Handle("/api/meshmodels/components", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetAllMeshmodelComponents)), models.NoAuth))).Methods("GET")
+
+	gMux.Handle("/api/meshmodels/categories", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetMeshmodelCategories)), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/models", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetMeshmodelModels)), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/models/{model}", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetMeshmodelModelsByName)), models.NoAuth))).Methods("GET")
+
+	gMux.Handle("/api/meshmodels/categories/{category}", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetMeshmodelCategoriesByName)), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/categories/{category}/models", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetMeshmodelModelsByCategories)), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/categories/{category}/models/{model}", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetMeshmodelModelsByCategoriesByModel)), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/categories/{category}/models/{model}/components", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetMeshmodelComponentByModelByCategory)), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/categories/{category}/models/{model}/components/{name}", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetMeshmodelComponentsByNameByModelByCategory)), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/categories/{category}/components", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetMeshmodelComponentByCategory)), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/categories/{category}/components/{name}", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetMeshmodelComponentsByNameByCategory)), models.NoAuth))).Methods("GET")
+
+	gMux.Handle("/api/meshmodels/components/{name}", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetAllMeshmodelComponentsByName)), models.NoAuth))).Methods("GET")
 	gMux.Handle("/api/meshmodels/generate", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.MeshModelGenerationHandler), models.NoAuth))).Methods("POST")
-	gMux.Handle("/api/meshmodels/relationships", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetAllMeshmodelRelationships), models.NoAuth))).Methods("GET")
-
-	gMux.Handle("/api/meshmodels/models/{model}/components", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetMeshmodelComponentByModel), models.NoAuth))).Methods("GET")
-	gMux.Handle("/api/meshmodels/models/{model}/components/{name}", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetMeshmodelComponentsByNameByModel), models.NoAuth))).Methods("GET")
-
-	gMux.Handle("/api/meshmodels/models/{model}/relationships", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetAllMeshmodelRelationships), models.NoAuth))).Methods("GET")
-	gMux.Handle("/api/meshmodels/models/{model}/relationships/{name}", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetMeshmodelRelationshipByName), models.NoAuth))).Methods("GET")
-	gMux.Handle("/api/meshmodels/relationships", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.RegisterMeshmodelRelationships), models.NoAuth))).Methods("POST") //This should also be left with NoAuth
-
-	gMux.Handle("/api/meshmodels/models/{model}/policies", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetAllMeshmodelPolicies), models.NoAuth))).Methods("GET")
-	gMux.Handle("/api/meshmodels/models/{model}/policies{name}", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetAllMeshmodelPoliciesByName), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/generate/crd", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.KubernetesMiddleware(h.CRDComponentGenerationHandler)), models.ProviderAuth))).Methods("POST")
+	gMux.Handle("/api/meshmodels/relationships", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetAllMeshmodelRelationships)), models.NoAuth))).Methods("GET")
+
+	gMux.Handle("/api/meshmodels/models/{model}/components", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetMeshmodelComponentByModel)), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/models/{model}/components/{name}", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetMeshmodelComponentsByNameByModel)), models.NoAuth))).Methods("GET")
+
+	gMux.Handle("/api/meshmodels/models/{model}/relationships", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetAllMeshmodelRelationships)), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/models/{model}/relationships/graph", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetMeshmodelRelationshipsGraph)), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/models/{model}/relationships/export", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.ExportMeshmodelRelationships)), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/models/{model}/relationships/{name}", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetMeshmodelRelationshipByName)), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/models/{model}/relationships/{name}/history", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetMeshmodelRelationshipHistory)), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/models/{model}/relationships/{name}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteMeshmodelRelationship), models.ProviderAuth))).Methods("DELETE")
+	gMux.Handle("/api/meshmodels/models/{model}/relationships/{name}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.UpdateMeshmodelRelationship), models.ProviderAuth))).Methods("PUT", "PATCH")
+	gMux.Handle("/api/meshmodels/relationships", h.ProviderMiddleware(h.AuthMiddleware(registrant(http.HandlerFunc(h.RegisterMeshmodelRelationships)), models.NoAuth))).Methods("POST") //This should also be left with NoAuth
+	gMux.Handle("/api/meshmodels/relationships/evaluate", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.EvaluateMeshmodelRelationship), models.NoAuth))).Methods("POST")
+	gMux.Handle("/api/meshmodels/relationships/lint", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.LintMeshmodelRelationships), models.NoAuth))).Methods("POST")
+	gMux.Handle("/api/meshmodels/relationships/provenance", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetMeshmodelRelationshipsProvenance)), models.ProviderAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/relationships/usage", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetMeshmodelRelationshipsUsage), models.ProviderAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/events", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetMeshmodelEvents), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/relationships/policies", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.GetMeshmodelRelationshipPolicies), models.ProviderAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/relationships/policies", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.SaveMeshmodelRelationshipPolicy), models.ProviderAuth))).Methods("POST")
+	gMux.Handle("/api/meshmodels/relationships/policies/{name}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteMeshmodelRelationshipPolicy), models.ProviderAuth))).Methods("DELETE")
+	gMux.Handle("/api/meshmodels/relationships/bulk", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.RegisterMeshmodelRelationshipsBulk), models.ProviderAuth))).Methods("POST")
+	gMux.Handle("/api/meshmodels/export", h.ProviderMiddleware(h.AuthMiddleware(http.HandlerFunc(h.ExportRegistryBundle), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/import", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ImportRegistryBundle), models.ProviderAuth))).Methods("POST")
+
+	gMux.Handle("/api/meshmodels/models/{model}/policies", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetAllMeshmodelPolicies)), models.NoAuth))).Methods("GET")
+	gMux.Handle("/api/meshmodels/models/{model}/policies{name}", h.ProviderMiddleware(h.AuthMiddleware(h.ETagMiddleware(http.HandlerFunc(h.GetAllMeshmodelPoliciesByName)), models.NoAuth))).Methods("GET")
 
 	gMux.Handle("/api/filter/deploy", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.KubernetesMiddleware(h.FilterFileHandler)), models.ProviderAuth))).
 		Methods("POST", "DELETE")
@@ -258,6 +441,19 @@ func NewRouter(_ context.Context, h models.HandlerInterface, port int, g http.Ha
 	gMux.Handle("/api/user/performance/profiles/{id}/results", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.FetchResultsHandler), models.ProviderAuth))).
 		Methods("GET")
 
+	gMux.Handle("/api/user/performance/templates", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetPerformanceProfileTemplatesHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/user/performance/templates", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.CreatePerformanceProfileTemplateHandler), models.ProviderAuth))).
+		Methods("POST")
+	gMux.Handle("/api/user/performance/templates/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetPerformanceProfileTemplateHandler), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/user/performance/templates/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.UpdatePerformanceProfileTemplateHandler), models.ProviderAuth))).
+		Methods("PUT")
+	gMux.Handle("/api/user/performance/templates/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeletePerformanceProfileTemplateHandler), models.ProviderAuth))).
+		Methods("DELETE")
+	gMux.Handle("/api/user/performance/templates/{id}/instantiate", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.InstantiatePerformanceProfileTemplateHandler), models.ProviderAuth))).
+		Methods("POST")
+
 	gMux.Handle("/api/user/schedules", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetSchedulesHandler), models.ProviderAuth))).
 		Methods("GET")
 	gMux.Handle("/api/user/schedules/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetScheduleHandler), models.ProviderAuth))).
@@ -364,6 +560,10 @@ func NewRouter(_ context.Context, h models.HandlerInterface, port int, g http.Ha
 		Methods("PUT")
 	gMux.Handle("/api/integrations/connections/{connectionId}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteConnection), models.ProviderAuth))).
 		Methods("DELETE")
+	gMux.Handle("/api/integrations/connections/{connectionId}/transitions", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetConnectionTransitions), models.ProviderAuth))).
+		Methods("GET")
+	gMux.Handle("/api/integrations/connections/{connectionId}/transitions", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.TransitionConnection), models.ProviderAuth))).
+		Methods("POST")
 
 	// Swagger Interactive Playground
 	swaggerOpts := middleware.SwaggerUIOpts{SpecURL: "./swagger.yaml"}
@@ -378,9 +578,24 @@ func NewRouter(_ context.Context, h models.HandlerInterface, port int, g http.Ha
 		}), models.ProviderAuth))).
 		Methods("GET")
 
+	middlewares := []mux.MiddlewareFunc{tracing.Middleware, metrics.Middleware, compression.Middleware}
+	// the responses are redacted before being compressed
+	if viper.GetBool("REDACT_RESPONSES") {
+		middlewares = append(middlewares, redact.Middleware(viper.GetStringSlice("REDACT_EXEMPT_PATHS")...))
+	}
+	// the calls rejected by the rate limiter are not recorded in the audit log
+	middlewares = append(middlewares, ratelimit.Middleware(ratelimit.Config{
+		Token:          ratelimit.Quota{Rate: viper.GetFloat64("RATE_LIMIT_TOKEN_RPS"), Burst: viper.GetInt("RATE_LIMIT_TOKEN_BURST")},
+		IP:             ratelimit.Quota{Rate: viper.GetFloat64("RATE_LIMIT_IP_RPS"), Burst: viper.GetInt("RATE_LIMIT_IP_BURST")},
+		PathPrefix:     "/api/",
+		TrustedProxies: viper.GetStringSlice("RATE_LIMIT_TRUSTED_PROXIES"),
+	}), h.AuditMiddleware)
+	gMux.Use(middlewares...)
+
 	return &Router{
 		S:    gMux,
 		port: port,
+		srv:  &http.Server{Addr: fmt.Sprintf(":%d", port), Handler: gMux},
 	}
 }
 
@@ -395,5 +610,27 @@ func (r *Router) Run() error {
 	// 	IdleTimeout:    0, //time.Second,
 	// }
 	// return s.ListenAndServe()
-	return http.ListenAndServe(fmt.Sprintf(":%d", r.port), r.S)
+	return r.srv.ListenAndServe()
+}
+
+// WithTLS makes RunTLS serve the routes over TLS on the port as well, eg: to the registrants authenticated with mutual TLS
+func (r *Router) WithTLS(port int, config *tls.Config) *Router {
+	r.tlsSrv = &http.Server{Addr: fmt.Sprintf(":%d", port), Handler: r.S, TLSConfig: config}
+	return r
+}
+
+// RunTLS starts the https server configured by WithTLS
+func (r *Router) RunTLS() error {
+	return r.tlsSrv.ListenAndServeTLS("", "")
+}
+
+// Shutdown stops accepting requests and waits for the active ones to complete until ctx is done,
+// Run returns http.ErrServerClosed once it is called
+func (r *Router) Shutdown(ctx context.Context) error {
+	if r.tlsSrv != nil {
+		if err := r.tlsSrv.Shutdown(ctx); err != nil {
+			return err
+		}
+	}
+	return r.srv.Shutdown(ctx)
 }
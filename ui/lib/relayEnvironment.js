@@ -2,20 +2,52 @@ import { createClient } from 'graphql-ws';
 import { Environment, Network, Observable, RecordSource, Store } from 'relay-runtime';
 import { promisifiedDataFetch } from './data-fetch';
 
-function fetchQuery(operation, variables) {
+const PERSISTED_QUERY_NOT_FOUND = 'PersistedQueryNotFound';
+
+// the hashes of the queries are computed once, the server keeps the queries sent once and runs them from their hash
+const queryHashes = new Map();
+
+async function hashQuery(query) {
+  if (queryHashes.has(query)) {
+    return queryHashes.get(query);
+  }
+  // crypto.subtle is only available in secure contexts, the queries are then sent in full
+  if (typeof window === 'undefined' || !window.crypto?.subtle) {
+    return null;
+  }
+  const digest = await window.crypto.subtle.digest('SHA-256', new TextEncoder().encode(query));
+  const hash = Array.from(new Uint8Array(digest))
+    .map((b) => b.toString(16).padStart(2, '0'))
+    .join('');
+  queryHashes.set(query, hash);
+  return hash;
+}
+
+function postQuery(body) {
   return promisifiedDataFetch('/api/system/graphql/query', {
     method: 'POST',
     credentials: 'include',
     headers: {
       'Content-Type': 'application/json',
     },
-    body: JSON.stringify({
-      query: operation.text,
-      variables,
-    }),
+    body: JSON.stringify(body),
   });
 }
 
+async function fetchQuery(operation, variables) {
+  // the mutations are not persisted by the server, they are always sent in full
+  const hash = operation.operationKind === 'mutation' ? null : await hashQuery(operation.text);
+  if (!hash) {
+    return postQuery({ query: operation.text, variables });
+  }
+  const extensions = { persistedQuery: { version: 1, sha256Hash: hash } };
+  const result = await postQuery({ variables, extensions });
+  if (!result?.errors?.some((err) => err.message === PERSISTED_QUERY_NOT_FOUND)) {
+    return result;
+  }
+  return postQuery({ query: operation.text, variables, extensions });
+}
+
 export let subscriptionClient;
 
 if (typeof window !== 'undefined') {